@@ -19,6 +19,7 @@ var (
 	ErrRefresh        error             = errors.New("Failed to refresh")
 	ErrValidate       error             = errors.New("Failed to validate token")
 	ErrLogout         error             = errors.New("Failed to log out")
+	ErrRevokeTokens   error             = errors.New("Failed to revoke tokens")
 	ErrDecodeResponse func(error) error = func(err error) error {
 		return fmt.Errorf("Failed to decode response: %w", err)
 	}
@@ -201,6 +202,28 @@ func (s *AuthService) Logout(ctx context.Context, refreshToken string) error {
 	return nil
 }
 
+func (s *AuthService) RevokeUserTokens(ctx context.Context, userID string) error {
+	url := fmt.Sprintf("%s/users/%s/tokens", s.baseURL, userID)
+
+	s.log.Info(ctx, "Making revoke tokens request", "url", url)
+
+	method := http.MethodDelete
+	resp, err := s.makeRequest(ctx, method, url, nil)
+	if err != nil {
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url)
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusNoContent {
+		err = ErrRevokeTokens
+		s.log.Error(ctx, err.Error())
+		return err
+	}
+
+	return nil
+}
+
 func (s *AuthService) makeRequest(ctx context.Context, method, url string, data any) (*http.Response, error) {
 	jsonBody, err := json.Marshal(data)
 	if err != nil {
@@ -3,13 +3,17 @@ package http
 import (
 	"aggregator/internal/common/logger"
 	"aggregator/internal/dto"
+	"aggregator/internal/middleware"
 	"aggregator/internal/service/todo"
 	"bytes"
 	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"net/http"
+	"net/url"
+	"strconv"
 	"time"
 )
 
@@ -23,22 +27,80 @@ var (
 	ErrGetBoards    error = errors.New("failed to get boards")
 	ErrGetColumns   error = errors.New("failed to get columns")
 	ErrGetCards     error = errors.New("failed to get cards")
+	ErrGetBoard     error = errors.New("failed to get board")
+	ErrGetColumn    error = errors.New("failed to get column")
+	ErrGetSwimlanes error = errors.New("failed to get swimlanes")
+	ErrGetSwimlane  error = errors.New("failed to get swimlane")
 	ErrGetCard      error = errors.New("failed to get card")
+	ErrGetAncestors error = errors.New("failed to get card ancestors")
 	ErrCreateBoard  error = errors.New("failed to create board")
 	ErrCreateColumn error = errors.New("failed to create column")
+	ErrCreateLane   error = errors.New("failed to create swimlane")
 	ErrCreateCard   error = errors.New("failed to create card")
 	ErrUpdateBoard  error = errors.New("failed to update board")
 	ErrUpdateColumn error = errors.New("failed to update column")
+	ErrUpdateLane   error = errors.New("failed to update swimlane")
 	ErrUpdateCard   error = errors.New("failed to update card")
+	ErrSetParent    error = errors.New("failed to set card parent")
 	ErrDeleteBoard  error = errors.New("failed to delete board")
 	ErrDeleteColumn error = errors.New("failed to delete column")
+	ErrDeleteLane   error = errors.New("failed to delete swimlane")
 	ErrDeleteCard   error = errors.New("failed to delete card")
+	ErrBulkCards    error = errors.New("failed to apply card operations")
+	ErrMoveColumn   error = errors.New("failed to move column")
+	ErrMergeBoards  error = errors.New("failed to merge boards")
+	ErrGetRecent    error = errors.New("failed to get recent boards")
+	ErrStarBoard    error = errors.New("failed to star board")
+	ErrUnstarBoard  error = errors.New("failed to unstar board")
+	ErrRecordView   error = errors.New("failed to record board view")
+	ErrWatchBoard   error = errors.New("failed to watch board")
+	ErrStartTimer   error = errors.New("failed to start timer")
+	ErrStopTimer    error = errors.New("failed to stop timer")
+	ErrLogTime      error = errors.New("failed to log time")
+	ErrGetEntries   error = errors.New("failed to get time entries")
+	ErrTimeReport   error = errors.New("failed to get time report")
+	ErrAnalytics    error = errors.New("failed to get board analytics")
+	ErrBoardStats   error = errors.New("failed to get board stats")
+	ErrCreateSprint error = errors.New("failed to create sprint")
+	ErrGetSprint    error = errors.New("failed to get sprint")
+	ErrGetSprints   error = errors.New("failed to get sprints")
+	ErrSprintCards  error = errors.New("failed to get sprint cards")
+	ErrAddToSprint  error = errors.New("failed to add card to sprint")
+	ErrSprintRemove error = errors.New("failed to remove card from sprint")
+	ErrCloseSprint  error = errors.New("failed to close sprint")
+	ErrBurndown     error = errors.New("failed to get sprint burndown")
+	ErrCalendarKey  error = errors.New("failed to regenerate calendar token")
+	ErrCalendar     error = errors.New("failed to get calendar cards")
+	ErrCreateSpace  error = errors.New("failed to create workspace")
+	ErrGetSpaces    error = errors.New("failed to get workspaces")
+	ErrGetMembers   error = errors.New("failed to get workspace members")
+	ErrAddMember    error = errors.New("failed to add workspace member")
+	ErrUpdateMember error = errors.New("failed to update workspace member")
+	ErrRemoveMember error = errors.New("failed to remove workspace member")
+	ErrSpaceBoards  error = errors.New("failed to get workspace boards")
+	ErrTransfer     error = errors.New("failed to transfer boards")
+	ErrDeleteData   error = errors.New("failed to delete user data")
+	ErrAddWatch     error = errors.New("failed to add watch")
+	ErrRemoveWatch  error = errors.New("failed to remove watch")
+	ErrNotifyCard   error = errors.New("failed to notify card watchers")
+	ErrGetInbox     error = errors.New("failed to get notifications")
+	ErrMarkRead     error = errors.New("failed to mark notifications read")
+	ErrAddTemplate  error = errors.New("failed to create card template")
+	ErrGetTemplate  error = errors.New("failed to get card template")
+	ErrGetTemplates error = errors.New("failed to get card templates")
+	ErrEditTemplate error = errors.New("failed to update card template")
+	ErrDropTemplate error = errors.New("failed to delete card template")
+	ErrGetQuota     error = errors.New("failed to get user quota")
+	ErrSetQuota     error = errors.New("failed to set quota override")
+	ErrDeleteQuota  error = errors.New("failed to delete quota override")
 )
 
 type TodoService struct {
 	baseURL    string
 	httpClient *http.Client
-	log        logger.Logger
+	// streamClient has no timeout: streams last until either side hangs up.
+	streamClient *http.Client
+	log          logger.Logger
 }
 
 func NewTodoService(baseURL string, timeout time.Duration, logger logger.Logger) todo.TodoService {
@@ -47,14 +109,36 @@ func NewTodoService(baseURL string, timeout time.Duration, logger logger.Logger)
 		httpClient: &http.Client{
 			Timeout: timeout,
 		},
-		log: logger,
+		streamClient: &http.Client{},
+		log:          logger,
 	}
 }
 
 func (s *TodoService) GetNewCards(ctx context.Context, from, to time.Time) ([]dto.Card, error) {
-	fromStr := from.Format(layout)
-	toStr := to.Format(layout)
-	url := fmt.Sprintf("%s/cards/new?from=%s&to=%s", s.baseURL, fromStr, toStr)
+	values := url.Values{}
+	values.Set("from", from.Format(layout))
+	values.Set("to", to.Format(layout))
+
+	return fetchPages[dto.Card](ctx, s, "/cards/new", values, 0, ErrGetNewCards)
+}
+
+func (s *TodoService) GetBoards(ctx context.Context, userID string) ([]dto.Board, error) {
+	values := url.Values{}
+	values.Set("user_id", userID)
+
+	return fetchPages[dto.Board](ctx, s, "/boards", values, 0, ErrGetBoards)
+}
+
+func (s *TodoService) GetMarkedBoards(ctx context.Context, userID string) ([]dto.MarkedBoard, error) {
+	values := url.Values{}
+	values.Set("user_id", userID)
+	values.Set("sort", dto.BoardsByStar)
+
+	return fetchPages[dto.MarkedBoard](ctx, s, "/boards", values, 0, ErrGetBoards)
+}
+
+func (s *TodoService) GetRecentBoards(ctx context.Context, userID string) ([]dto.MarkedBoard, error) {
+	url := fmt.Sprintf("%s/boards/recent?user_id=%s", s.baseURL, userID)
 
 	method := http.MethodGet
 	resp, err := s.makeRequest(ctx, method, url, nil)
@@ -65,23 +149,44 @@ func (s *TodoService) GetNewCards(ctx context.Context, from, to time.Time) ([]dt
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		err = ErrGetNewCards
+		err = ErrGetRecent
 		s.log.Error(ctx, err.Error())
 		return nil, err
 	}
 
-	var cards []dto.Card
-	if err := json.NewDecoder(resp.Body).Decode(&cards); err != nil {
+	var boards []dto.MarkedBoard
+	if err := json.NewDecoder(resp.Body).Decode(&boards); err != nil {
 		err = ErrDecodeResponse(err)
 		s.log.Error(ctx, err.Error())
 		return nil, err
 	}
 
-	return cards, nil
+	return boards, nil
 }
 
-func (s *TodoService) GetBoards(ctx context.Context, userID string) ([]dto.Board, error) {
-	url := fmt.Sprintf("%s/boards?user_id=%s", s.baseURL, userID)
+func (s *TodoService) GetColumns(ctx context.Context, boardID string) ([]dto.Column, error) {
+	values := url.Values{}
+	values.Set("board_id", boardID)
+
+	return fetchPages[dto.Column](ctx, s, "/columns", values, 0, ErrGetColumns)
+}
+
+func (s *TodoService) GetSwimlanes(ctx context.Context, boardID string) ([]dto.Swimlane, error) {
+	values := url.Values{}
+	values.Set("board_id", boardID)
+
+	return fetchPages[dto.Swimlane](ctx, s, "/swimlanes", values, 0, ErrGetSwimlanes)
+}
+
+func (s *TodoService) GetCards(ctx context.Context, query dto.CardQuery) ([]dto.Card, error) {
+	max, _ := strconv.Atoi(query.Limit)
+	query.Limit = ""
+
+	return fetchPages[dto.Card](ctx, s, "/cards", query.Values(), max, ErrGetCards)
+}
+
+func (s *TodoService) GetBoard(ctx context.Context, id string) (*dto.Board, error) {
+	url := fmt.Sprintf("%s/boards/%s", s.baseURL, id)
 
 	method := http.MethodGet
 	resp, err := s.makeRequest(ctx, method, url, nil)
@@ -89,26 +194,25 @@ func (s *TodoService) GetBoards(ctx context.Context, userID string) ([]dto.Board
 		s.log.Error(ctx, "Error making the request", "method", method, "url", url)
 		return nil, err
 	}
-	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		err = ErrGetBoards
+		err = ErrGetBoard
 		s.log.Error(ctx, err.Error())
 		return nil, err
 	}
 
-	var boards []dto.Board
-	if err := json.NewDecoder(resp.Body).Decode(&boards); err != nil {
+	var board dto.Board
+	if err := json.NewDecoder(resp.Body).Decode(&board); err != nil {
 		err = ErrDecodeResponse(err)
 		s.log.Error(ctx, err.Error())
 		return nil, err
 	}
 
-	return boards, nil
+	return &board, nil
 }
 
-func (s *TodoService) GetColumns(ctx context.Context, boardID string) ([]dto.Column, error) {
-	url := fmt.Sprintf("%s/columns?board_id=%s", s.baseURL, boardID)
+func (s *TodoService) GetColumn(ctx context.Context, id string) (*dto.Column, error) {
+	url := fmt.Sprintf("%s/columns/%s", s.baseURL, id)
 
 	method := http.MethodGet
 	resp, err := s.makeRequest(ctx, method, url, nil)
@@ -117,25 +221,24 @@ func (s *TodoService) GetColumns(ctx context.Context, boardID string) ([]dto.Col
 		return nil, err
 	}
 
-	defer resp.Body.Close()
 	if resp.StatusCode != http.StatusOK {
-		err = ErrGetColumns
+		err = ErrGetColumn
 		s.log.Error(ctx, err.Error())
 		return nil, err
 	}
 
-	var columns []dto.Column
-	if err := json.NewDecoder(resp.Body).Decode(&columns); err != nil {
+	var column dto.Column
+	if err := json.NewDecoder(resp.Body).Decode(&column); err != nil {
 		err = ErrDecodeResponse(err)
 		s.log.Error(ctx, err.Error())
 		return nil, err
 	}
 
-	return columns, nil
+	return &column, nil
 }
 
-func (s *TodoService) GetCards(ctx context.Context, columnID string) ([]dto.Card, error) {
-	url := fmt.Sprintf("%s/cards?column_id=%s", s.baseURL, columnID)
+func (s *TodoService) GetSwimlane(ctx context.Context, id string) (*dto.Swimlane, error) {
+	url := fmt.Sprintf("%s/swimlanes/%s", s.baseURL, id)
 
 	method := http.MethodGet
 	resp, err := s.makeRequest(ctx, method, url, nil)
@@ -145,19 +248,19 @@ func (s *TodoService) GetCards(ctx context.Context, columnID string) ([]dto.Card
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		err = ErrGetCards
+		err = ErrGetSwimlane
 		s.log.Error(ctx, err.Error())
 		return nil, err
 	}
 
-	var cards []dto.Card
-	if err := json.NewDecoder(resp.Body).Decode(&cards); err != nil {
+	var swimlane dto.Swimlane
+	if err := json.NewDecoder(resp.Body).Decode(&swimlane); err != nil {
 		err = ErrDecodeResponse(err)
 		s.log.Error(ctx, err.Error())
 		return nil, err
 	}
 
-	return cards, nil
+	return &swimlane, nil
 }
 
 func (s *TodoService) GetCard(ctx context.Context, id string) (*dto.Card, error) {
@@ -186,6 +289,32 @@ func (s *TodoService) GetCard(ctx context.Context, id string) (*dto.Card, error)
 	return &card, nil
 }
 
+func (s *TodoService) GetCardAncestors(ctx context.Context, id string) ([]dto.Card, error) {
+	url := fmt.Sprintf("%s/cards/%s/ancestors", s.baseURL, id)
+
+	method := http.MethodGet
+	resp, err := s.makeRequest(ctx, method, url, nil)
+	if err != nil {
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url)
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		err = ErrGetAncestors
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	var cards []dto.Card
+	if err := json.NewDecoder(resp.Body).Decode(&cards); err != nil {
+		err = ErrDecodeResponse(err)
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	return cards, nil
+}
+
 func (s *TodoService) CreateBoard(ctx context.Context, board dto.Board) error {
 	url := fmt.Sprintf("%s/boards", s.baseURL)
 
@@ -198,6 +327,18 @@ func (s *TodoService) CreateBoard(ctx context.Context, board dto.Board) error {
 		return err
 	}
 
+	if resp.StatusCode == http.StatusForbidden {
+		err = todo.ErrWorkspaceAccess
+		s.log.Error(ctx, err.Error())
+		return err
+	}
+
+	if resp.StatusCode == http.StatusUnprocessableEntity {
+		err = todo.ErrQuotaExceeded
+		s.log.Error(ctx, err.Error())
+		return err
+	}
+
 	if resp.StatusCode != http.StatusCreated {
 		err = ErrCreateBoard
 		s.log.Error(ctx, err.Error())
@@ -219,6 +360,12 @@ func (s *TodoService) CreateColumn(ctx context.Context, column dto.Column) error
 		return err
 	}
 
+	if resp.StatusCode == http.StatusUnprocessableEntity {
+		err = todo.ErrQuotaExceeded
+		s.log.Error(ctx, err.Error())
+		return err
+	}
+
 	if resp.StatusCode != http.StatusCreated {
 		err = ErrCreateColumn
 		s.log.Error(ctx, err.Error())
@@ -228,10 +375,10 @@ func (s *TodoService) CreateColumn(ctx context.Context, column dto.Column) error
 	return nil
 }
 
-func (s *TodoService) CreateCard(ctx context.Context, card dto.Card) error {
-	url := fmt.Sprintf("%s/cards", s.baseURL)
+func (s *TodoService) CreateSwimlane(ctx context.Context, swimlane dto.Swimlane) error {
+	url := fmt.Sprintf("%s/swimlanes", s.baseURL)
 
-	data := card
+	data := swimlane
 
 	method := http.MethodPost
 	resp, err := s.makeRequest(ctx, method, url, data)
@@ -241,7 +388,7 @@ func (s *TodoService) CreateCard(ctx context.Context, card dto.Card) error {
 	}
 
 	if resp.StatusCode != http.StatusCreated {
-		err = ErrCreateCard
+		err = ErrCreateLane
 		s.log.Error(ctx, err.Error())
 		return err
 	}
@@ -249,24 +396,101 @@ func (s *TodoService) CreateCard(ctx context.Context, card dto.Card) error {
 	return nil
 }
 
+func (s *TodoService) CreateCard(ctx context.Context, card dto.Card) (*dto.Card, error) {
+	url := fmt.Sprintf("%s/cards", s.baseURL)
+
+	data := card
+
+	method := http.MethodPost
+	resp, err := s.makeRequest(ctx, method, url, data)
+	if err != nil {
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url, "data", data)
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusUnprocessableEntity {
+		err = todo.ErrQuotaExceeded
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusCreated {
+		err = ErrCreateCard
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	var created dto.Card
+	if err := json.NewDecoder(resp.Body).Decode(&created); err != nil {
+		err = ErrDecodeResponse(err)
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	return &created, nil
+}
+
+// CreateCardFromTemplate posts the card like CreateCard, with the template
+// to make it from and the username {{user}} stands for.
+func (s *TodoService) CreateCardFromTemplate(ctx context.Context, templateID string, card dto.Card, username string) (*dto.Card, error) {
+	url := fmt.Sprintf("%s/cards", s.baseURL)
+
+	data := struct {
+		dto.Card
+		TemplateID string `json:"template_id"`
+		Username   string `json:"username,omitempty"`
+	}{card, templateID, username}
+
+	method := http.MethodPost
+	resp, err := s.makeRequest(ctx, method, url, data)
+	if err != nil {
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url, "data", data)
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if err := templateError(resp, http.StatusCreated, ErrCreateCard); err != nil {
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	var created dto.Card
+	if err := json.NewDecoder(resp.Body).Decode(&created); err != nil {
+		err = ErrDecodeResponse(err)
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	return &created, nil
+}
+
 func (s *TodoService) UpdateBoard(ctx context.Context, board *dto.Board) error {
 	url := fmt.Sprintf("%s/boards", s.baseURL)
 
 	data := board
 
 	method := http.MethodPut
-	resp, err := s.makeRequest(ctx, method, url, data)
+	resp, err := s.makeConditionalRequest(ctx, method, url, data, board.Version)
 	if err != nil {
 		s.log.Error(ctx, "Error making the request", "method", method, "url", url, "data", data)
 		return err
 	}
 
+	if resp.StatusCode == http.StatusPreconditionFailed {
+		err = todo.ErrVersionConflict
+		s.log.Error(ctx, err.Error())
+		return err
+	}
+
 	if resp.StatusCode != http.StatusOK {
 		err = ErrUpdateBoard
 		s.log.Error(ctx, err.Error())
 		return err
 	}
 
+	board.Version = versionFromETag(resp, board.Version)
+
 	return nil
 }
 
@@ -276,35 +500,49 @@ func (s *TodoService) UpdateColumn(ctx context.Context, column *dto.Column) erro
 	data := column
 
 	method := http.MethodPut
-	resp, err := s.makeRequest(ctx, method, url, data)
+	resp, err := s.makeConditionalRequest(ctx, method, url, data, column.Version)
 	if err != nil {
 		s.log.Error(ctx, "Error making the request", "method", method, "url", url, "data", data)
 		return err
 	}
 
+	if resp.StatusCode == http.StatusPreconditionFailed {
+		err = todo.ErrVersionConflict
+		s.log.Error(ctx, err.Error())
+		return err
+	}
+
 	if resp.StatusCode != http.StatusOK {
 		err = ErrUpdateColumn
 		s.log.Error(ctx, err.Error())
 		return err
 	}
 
+	column.Version = versionFromETag(resp, column.Version)
+
 	return nil
 }
 
-func (s *TodoService) UpdateCard(ctx context.Context, card *dto.Card) error {
-	url := fmt.Sprintf("%s/cards", s.baseURL)
+func (s *TodoService) MoveColumn(ctx context.Context, column *dto.Column) error {
+	url := fmt.Sprintf("%s/columns/board", s.baseURL)
 
-	data := card
+	data := column
 
 	method := http.MethodPut
-	resp, err := s.makeRequest(ctx, method, url, data)
+	resp, err := s.makeConditionalRequest(ctx, method, url, data, column.Version)
 	if err != nil {
 		s.log.Error(ctx, "Error making the request", "method", method, "url", url, "data", data)
 		return err
 	}
+	defer resp.Body.Close()
 
-	if resp.StatusCode != http.StatusOK {
-		err = ErrUpdateCard
+	if err := reorganiseError(resp, ErrMoveColumn); err != nil {
+		s.log.Error(ctx, err.Error())
+		return err
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(column); err != nil {
+		err = ErrDecodeResponse(err)
 		s.log.Error(ctx, err.Error())
 		return err
 	}
@@ -312,18 +550,67 @@ func (s *TodoService) UpdateCard(ctx context.Context, card *dto.Card) error {
 	return nil
 }
 
-func (s *TodoService) DeleteBoard(ctx context.Context, id string) error {
-	url := fmt.Sprintf("%s/boards?id=%s", s.baseURL, id)
+func (s *TodoService) MergeBoards(ctx context.Context, req dto.MergeBoardsRequest) (*dto.BoardMerge, error) {
+	url := fmt.Sprintf("%s/boards/merge", s.baseURL)
 
-	method := http.MethodDelete
-	resp, err := s.makeRequest(ctx, method, url, nil)
+	data := req
+
+	method := http.MethodPost
+	resp, err := s.makeRequest(ctx, method, url, data)
 	if err != nil {
-		s.log.Error(ctx, "Error making the request", "method", method, "url", url)
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url, "data", data)
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if err := reorganiseError(resp, ErrMergeBoards); err != nil {
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	var merge dto.BoardMerge
+	if err := json.NewDecoder(resp.Body).Decode(&merge); err != nil {
+		err = ErrDecodeResponse(err)
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	return &merge, nil
+}
+
+// reorganiseError is the error a column move or a board merge answered with
+// resp failed with, if any; failed stands for the unexpected statuses.
+func reorganiseError(resp *http.Response, failed error) error {
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return nil
+	case http.StatusBadRequest:
+		return todo.ErrInvalidMove
+	case http.StatusForbidden:
+		return todo.ErrBoardAccess
+	case http.StatusPreconditionFailed:
+		return todo.ErrVersionConflict
+	case http.StatusUnprocessableEntity:
+		return todo.ErrQuotaExceeded
+	}
+
+	return failed
+}
+
+func (s *TodoService) StarBoard(ctx context.Context, userID, boardID string) error {
+	url := fmt.Sprintf("%s/boards/%s/star", s.baseURL, boardID)
+
+	data := map[string]string{"user_id": userID}
+
+	method := http.MethodPut
+	resp, err := s.makeRequest(ctx, method, url, data)
+	if err != nil {
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url, "data", data)
 		return err
 	}
+	defer resp.Body.Close()
 
-	if resp.StatusCode != http.StatusOK {
-		err = ErrDeleteBoard
+	if err := boardMarkError(resp, ErrStarBoard); err != nil {
 		s.log.Error(ctx, err.Error())
 		return err
 	}
@@ -331,8 +618,8 @@ func (s *TodoService) DeleteBoard(ctx context.Context, id string) error {
 	return nil
 }
 
-func (s *TodoService) DeleteColumn(ctx context.Context, id string) error {
-	url := fmt.Sprintf("%s/columns?id=%s", s.baseURL, id)
+func (s *TodoService) UnstarBoard(ctx context.Context, userID, boardID string) error {
+	url := fmt.Sprintf("%s/boards/%s/star?user_id=%s", s.baseURL, boardID, userID)
 
 	method := http.MethodDelete
 	resp, err := s.makeRequest(ctx, method, url, nil)
@@ -340,9 +627,10 @@ func (s *TodoService) DeleteColumn(ctx context.Context, id string) error {
 		s.log.Error(ctx, "Error making the request", "method", method, "url", url)
 		return err
 	}
+	defer resp.Body.Close()
 
-	if resp.StatusCode != http.StatusOK {
-		err = ErrDeleteColumn
+	if resp.StatusCode != http.StatusNoContent {
+		err = ErrUnstarBoard
 		s.log.Error(ctx, err.Error())
 		return err
 	}
@@ -350,18 +638,20 @@ func (s *TodoService) DeleteColumn(ctx context.Context, id string) error {
 	return nil
 }
 
-func (s *TodoService) DeleteCard(ctx context.Context, id string) error {
-	url := fmt.Sprintf("%s/cards?id=%s", s.baseURL, id)
+func (s *TodoService) RecordBoardView(ctx context.Context, userID, boardID string) error {
+	url := fmt.Sprintf("%s/boards/%s/views", s.baseURL, boardID)
 
-	method := http.MethodDelete
-	resp, err := s.makeRequest(ctx, method, url, nil)
+	data := map[string]string{"user_id": userID}
+
+	method := http.MethodPost
+	resp, err := s.makeRequest(ctx, method, url, data)
 	if err != nil {
-		s.log.Error(ctx, "Error making the request", "method", method, "url", url)
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url, "data", data)
 		return err
 	}
+	defer resp.Body.Close()
 
-	if resp.StatusCode != http.StatusOK {
-		err = ErrDeleteCard
+	if err := boardMarkError(resp, ErrRecordView); err != nil {
 		s.log.Error(ctx, err.Error())
 		return err
 	}
@@ -369,29 +659,1629 @@ func (s *TodoService) DeleteCard(ctx context.Context, id string) error {
 	return nil
 }
 
-func (s *TodoService) makeRequest(ctx context.Context, method, url string, data any) (*http.Response, error) {
-	jsonBody, err := json.Marshal(data)
+// boardMarkError maps the status of a star or a view of a board to an error.
+func boardMarkError(resp *http.Response, failed error) error {
+	switch resp.StatusCode {
+	case http.StatusNoContent:
+		return nil
+	case http.StatusForbidden:
+		return todo.ErrBoardAccess
+	}
+
+	return failed
+}
+
+func (s *TodoService) UpdateSwimlane(ctx context.Context, swimlane *dto.Swimlane) error {
+	url := fmt.Sprintf("%s/swimlanes", s.baseURL)
+
+	data := swimlane
+
+	method := http.MethodPut
+	resp, err := s.makeConditionalRequest(ctx, method, url, data, swimlane.Version)
 	if err != nil {
-		err = fmt.Errorf("error marshaling user data: %w", err)
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url, "data", data)
+		return err
+	}
+
+	if resp.StatusCode == http.StatusPreconditionFailed {
+		err = todo.ErrVersionConflict
 		s.log.Error(ctx, err.Error())
-		return nil, err
+		return err
 	}
 
-	req, err := http.NewRequestWithContext(ctx, method, url, bytes.NewBuffer(jsonBody))
-	if err != nil {
-		err = fmt.Errorf("error creating request: %w", err)
+	if resp.StatusCode != http.StatusOK {
+		err = ErrUpdateLane
 		s.log.Error(ctx, err.Error())
-		return nil, err
+		return err
 	}
 
-	req.Header.Set("Content-Type", "application/json")
+	swimlane.Version = versionFromETag(resp, swimlane.Version)
 
-	resp, err := s.httpClient.Do(req)
+	return nil
+}
+
+func (s *TodoService) UpdateCard(ctx context.Context, card *dto.Card) error {
+	url := fmt.Sprintf("%s/cards", s.baseURL)
+
+	data := card
+
+	method := http.MethodPut
+	resp, err := s.makeConditionalRequest(ctx, method, url, data, card.Version)
 	if err != nil {
-		err = fmt.Errorf("error sending request: %w", err)
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url, "data", data)
+		return err
+	}
+
+	if resp.StatusCode == http.StatusPreconditionFailed {
+		err = todo.ErrVersionConflict
 		s.log.Error(ctx, err.Error())
-		return nil, err
+		return err
 	}
 
-	return resp, nil
+	if resp.StatusCode == http.StatusUnprocessableEntity {
+		err = todo.ErrQuotaExceeded
+		s.log.Error(ctx, err.Error())
+		return err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		err = ErrUpdateCard
+		s.log.Error(ctx, err.Error())
+		return err
+	}
+
+	card.Version = versionFromETag(resp, card.Version)
+
+	return nil
+}
+
+func (s *TodoService) SetCardParent(ctx context.Context, card *dto.Card) error {
+	url := fmt.Sprintf("%s/cards/parent", s.baseURL)
+
+	data := dto.SetCardParentRequest{ID: card.ID, ParentID: card.ParentID}
+
+	method := http.MethodPut
+	resp, err := s.makeConditionalRequest(ctx, method, url, data, card.Version)
+	if err != nil {
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url, "data", data)
+		return err
+	}
+
+	if resp.StatusCode == http.StatusPreconditionFailed {
+		err = todo.ErrVersionConflict
+		s.log.Error(ctx, err.Error())
+		return err
+	}
+
+	if resp.StatusCode == http.StatusConflict {
+		err = todo.ErrCardParent
+		s.log.Error(ctx, err.Error())
+		return err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		err = ErrSetParent
+		s.log.Error(ctx, err.Error())
+		return err
+	}
+
+	card.Version = versionFromETag(resp, card.Version)
+
+	return nil
+}
+
+func (s *TodoService) DeleteBoard(ctx context.Context, id string, version int) error {
+	url := fmt.Sprintf("%s/boards?id=%s", s.baseURL, id)
+
+	method := http.MethodDelete
+	resp, err := s.makeConditionalRequest(ctx, method, url, nil, version)
+	if err != nil {
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url)
+		return err
+	}
+
+	if resp.StatusCode == http.StatusPreconditionFailed {
+		err = todo.ErrVersionConflict
+		s.log.Error(ctx, err.Error())
+		return err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		err = ErrDeleteBoard
+		s.log.Error(ctx, err.Error())
+		return err
+	}
+
+	return nil
+}
+
+func (s *TodoService) DeleteColumn(ctx context.Context, id string, version int) error {
+	url := fmt.Sprintf("%s/columns?id=%s", s.baseURL, id)
+
+	method := http.MethodDelete
+	resp, err := s.makeConditionalRequest(ctx, method, url, nil, version)
+	if err != nil {
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url)
+		return err
+	}
+
+	if resp.StatusCode == http.StatusPreconditionFailed {
+		err = todo.ErrVersionConflict
+		s.log.Error(ctx, err.Error())
+		return err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		err = ErrDeleteColumn
+		s.log.Error(ctx, err.Error())
+		return err
+	}
+
+	return nil
+}
+
+func (s *TodoService) DeleteSwimlane(ctx context.Context, id string, version int) error {
+	url := fmt.Sprintf("%s/swimlanes?id=%s", s.baseURL, id)
+
+	method := http.MethodDelete
+	resp, err := s.makeConditionalRequest(ctx, method, url, nil, version)
+	if err != nil {
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url)
+		return err
+	}
+
+	if resp.StatusCode == http.StatusPreconditionFailed {
+		err = todo.ErrVersionConflict
+		s.log.Error(ctx, err.Error())
+		return err
+	}
+
+	if resp.StatusCode == http.StatusConflict {
+		err = todo.ErrLastSwimlane
+		s.log.Error(ctx, err.Error())
+		return err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		err = ErrDeleteLane
+		s.log.Error(ctx, err.Error())
+		return err
+	}
+
+	return nil
+}
+
+func (s *TodoService) DeleteCard(ctx context.Context, id string, version int) error {
+	url := fmt.Sprintf("%s/cards?id=%s", s.baseURL, id)
+
+	method := http.MethodDelete
+	resp, err := s.makeConditionalRequest(ctx, method, url, nil, version)
+	if err != nil {
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url)
+		return err
+	}
+
+	if resp.StatusCode == http.StatusPreconditionFailed {
+		err = todo.ErrVersionConflict
+		s.log.Error(ctx, err.Error())
+		return err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		err = ErrDeleteCard
+		s.log.Error(ctx, err.Error())
+		return err
+	}
+
+	return nil
+}
+
+func (s *TodoService) BulkCards(ctx context.Context, req dto.BulkCardsRequest) (*dto.BulkCardsResponse, error) {
+	url := fmt.Sprintf("%s/cards/bulk", s.baseURL)
+
+	data := req
+
+	method := http.MethodPost
+	resp, err := s.makeRequest(ctx, method, url, data)
+	if err != nil {
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url, "data", data)
+		return nil, err
+	}
+
+	if resp.StatusCode == http.StatusBadRequest {
+		err = todo.ErrInvalidBulk
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		err = ErrBulkCards
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	var res dto.BulkCardsResponse
+	if err := json.NewDecoder(resp.Body).Decode(&res); err != nil {
+		err = ErrDecodeResponse(err)
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+func (s *TodoService) StartTimer(ctx context.Context, req dto.StartTimerRequest) (*dto.TimeEntry, error) {
+	url := fmt.Sprintf("%s/timer/start", s.baseURL)
+
+	data := req
+
+	method := http.MethodPost
+	resp, err := s.makeRequest(ctx, method, url, data)
+	if err != nil {
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url, "data", data)
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusConflict {
+		err = todo.ErrTimerRunning
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	if resp.StatusCode == http.StatusForbidden {
+		err = todo.ErrBoardAccess
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusCreated {
+		err = ErrStartTimer
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	var entry dto.TimeEntry
+	if err := json.NewDecoder(resp.Body).Decode(&entry); err != nil {
+		err = ErrDecodeResponse(err)
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	return &entry, nil
+}
+
+func (s *TodoService) StopTimer(ctx context.Context, req dto.StopTimerRequest) (*dto.TimeEntry, error) {
+	url := fmt.Sprintf("%s/timer/stop", s.baseURL)
+
+	data := req
+
+	method := http.MethodPost
+	resp, err := s.makeRequest(ctx, method, url, data)
+	if err != nil {
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url, "data", data)
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		err = todo.ErrNoTimerRunning
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		err = ErrStopTimer
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	var entry dto.TimeEntry
+	if err := json.NewDecoder(resp.Body).Decode(&entry); err != nil {
+		err = ErrDecodeResponse(err)
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	return &entry, nil
+}
+
+func (s *TodoService) LogTime(ctx context.Context, req dto.LogTimeRequest) (*dto.TimeEntry, error) {
+	url := fmt.Sprintf("%s/time-entries", s.baseURL)
+
+	data := req
+
+	method := http.MethodPost
+	resp, err := s.makeRequest(ctx, method, url, data)
+	if err != nil {
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url, "data", data)
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusBadRequest {
+		err = todo.ErrInvalidTime
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	if resp.StatusCode == http.StatusForbidden {
+		err = todo.ErrBoardAccess
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusCreated {
+		err = ErrLogTime
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	var entry dto.TimeEntry
+	if err := json.NewDecoder(resp.Body).Decode(&entry); err != nil {
+		err = ErrDecodeResponse(err)
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	return &entry, nil
+}
+
+func (s *TodoService) GetCardTimeEntries(ctx context.Context, cardID string) ([]dto.TimeEntry, error) {
+	url := fmt.Sprintf("%s/cards/%s/time-entries", s.baseURL, cardID)
+
+	method := http.MethodGet
+	resp, err := s.makeRequest(ctx, method, url, nil)
+	if err != nil {
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url)
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusForbidden {
+		err = todo.ErrBoardAccess
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		err = ErrGetEntries
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	var entries []dto.TimeEntry
+	if err := json.NewDecoder(resp.Body).Decode(&entries); err != nil {
+		err = ErrDecodeResponse(err)
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	return entries, nil
+}
+
+func (s *TodoService) GetTimeReport(ctx context.Context, query dto.TimeReportQuery) ([]dto.TimeReportRow, error) {
+	url := fmt.Sprintf("%s/reports/time?%s", s.baseURL, query.Values().Encode())
+
+	method := http.MethodGet
+	resp, err := s.makeRequest(ctx, method, url, nil)
+	if err != nil {
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url)
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusBadRequest {
+		err = todo.ErrInvalidTime
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	if resp.StatusCode == http.StatusForbidden {
+		err = todo.ErrBoardAccess
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		err = ErrTimeReport
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	var rows []dto.TimeReportRow
+	if err := json.NewDecoder(resp.Body).Decode(&rows); err != nil {
+		err = ErrDecodeResponse(err)
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	return rows, nil
+}
+
+func (s *TodoService) GetBoardAnalytics(ctx context.Context, boardID, from, to string) (*dto.BoardAnalytics, error) {
+	values := url.Values{}
+	values.Set("from", from)
+	values.Set("to", to)
+
+	url := fmt.Sprintf("%s/boards/%s/analytics?%s", s.baseURL, boardID, values.Encode())
+
+	method := http.MethodGet
+	resp, err := s.makeRequest(ctx, method, url, nil)
+	if err != nil {
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url)
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusBadRequest {
+		err = todo.ErrInvalidPeriod
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	if resp.StatusCode == http.StatusForbidden {
+		err = todo.ErrBoardAccess
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		err = ErrAnalytics
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	var analytics dto.BoardAnalytics
+	if err := json.NewDecoder(resp.Body).Decode(&analytics); err != nil {
+		err = ErrDecodeResponse(err)
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	return &analytics, nil
+}
+
+func (s *TodoService) GetBoardStats(ctx context.Context, userID, boardID, from, to, staleDays string) (*dto.BoardStats, error) {
+	values := url.Values{}
+	values.Set("user_id", userID)
+	values.Set("from", from)
+	values.Set("to", to)
+	if staleDays != "" {
+		values.Set("stale_days", staleDays)
+	}
+
+	url := fmt.Sprintf("%s/boards/%s/stats?%s", s.baseURL, boardID, values.Encode())
+
+	method := http.MethodGet
+	resp, err := s.makeRequest(ctx, method, url, nil)
+	if err != nil {
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url)
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusBadRequest:
+		err = todo.ErrInvalidStats
+	case http.StatusForbidden:
+		err = todo.ErrBoardAccess
+	case http.StatusNotFound:
+		err = todo.ErrBoardNotFound
+	default:
+		err = ErrBoardStats
+	}
+
+	if err != nil {
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	var stats dto.BoardStats
+	if err := json.NewDecoder(resp.Body).Decode(&stats); err != nil {
+		err = ErrDecodeResponse(err)
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	return &stats, nil
+}
+
+func (s *TodoService) CreateSprint(ctx context.Context, req dto.CreateSprintRequest) (*dto.Sprint, error) {
+	url := fmt.Sprintf("%s/sprints", s.baseURL)
+
+	data := req
+
+	method := http.MethodPost
+	resp, err := s.makeRequest(ctx, method, url, data)
+	if err != nil {
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url, "data", data)
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusBadRequest {
+		err = todo.ErrInvalidSprint
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	if resp.StatusCode == http.StatusForbidden {
+		err = todo.ErrBoardAccess
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusCreated {
+		err = ErrCreateSprint
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	var sprint dto.Sprint
+	if err := json.NewDecoder(resp.Body).Decode(&sprint); err != nil {
+		err = ErrDecodeResponse(err)
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	return &sprint, nil
+}
+
+func (s *TodoService) GetSprint(ctx context.Context, id string) (*dto.Sprint, error) {
+	url := fmt.Sprintf("%s/sprints/%s", s.baseURL, id)
+
+	method := http.MethodGet
+	resp, err := s.makeRequest(ctx, method, url, nil)
+	if err != nil {
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url)
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusForbidden {
+		err = todo.ErrBoardAccess
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		err = ErrGetSprint
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	var sprint dto.Sprint
+	if err := json.NewDecoder(resp.Body).Decode(&sprint); err != nil {
+		err = ErrDecodeResponse(err)
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	return &sprint, nil
+}
+
+func (s *TodoService) GetBoardSprints(ctx context.Context, boardID string) ([]dto.Sprint, error) {
+	url := fmt.Sprintf("%s/sprints?board_id=%s", s.baseURL, boardID)
+
+	method := http.MethodGet
+	resp, err := s.makeRequest(ctx, method, url, nil)
+	if err != nil {
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url)
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusForbidden {
+		err = todo.ErrBoardAccess
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		err = ErrGetSprints
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	var sprints []dto.Sprint
+	if err := json.NewDecoder(resp.Body).Decode(&sprints); err != nil {
+		err = ErrDecodeResponse(err)
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	return sprints, nil
+}
+
+func (s *TodoService) GetSprintCards(ctx context.Context, sprintID string) ([]dto.SprintCard, error) {
+	url := fmt.Sprintf("%s/sprints/%s/cards", s.baseURL, sprintID)
+
+	method := http.MethodGet
+	resp, err := s.makeRequest(ctx, method, url, nil)
+	if err != nil {
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url)
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusForbidden {
+		err = todo.ErrBoardAccess
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		err = ErrSprintCards
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	var cards []dto.SprintCard
+	if err := json.NewDecoder(resp.Body).Decode(&cards); err != nil {
+		err = ErrDecodeResponse(err)
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	return cards, nil
+}
+
+func (s *TodoService) AddSprintCard(ctx context.Context, sprintID string, req dto.AddSprintCardRequest) (*dto.SprintCard, error) {
+	url := fmt.Sprintf("%s/sprints/%s/cards", s.baseURL, sprintID)
+
+	data := req
+
+	method := http.MethodPost
+	resp, err := s.makeRequest(ctx, method, url, data)
+	if err != nil {
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url, "data", data)
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusBadRequest {
+		err = todo.ErrInvalidSprint
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	if resp.StatusCode == http.StatusConflict {
+		err = todo.ErrSprintClosed
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	if resp.StatusCode == http.StatusForbidden {
+		err = todo.ErrBoardAccess
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusCreated {
+		err = ErrAddToSprint
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	var card dto.SprintCard
+	if err := json.NewDecoder(resp.Body).Decode(&card); err != nil {
+		err = ErrDecodeResponse(err)
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	return &card, nil
+}
+
+func (s *TodoService) RemoveSprintCard(ctx context.Context, sprintID, cardID string) error {
+	url := fmt.Sprintf("%s/sprints/%s/cards/%s", s.baseURL, sprintID, cardID)
+
+	method := http.MethodDelete
+	resp, err := s.makeRequest(ctx, method, url, nil)
+	if err != nil {
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url)
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusForbidden {
+		err = todo.ErrBoardAccess
+		s.log.Error(ctx, err.Error())
+		return err
+	}
+
+	if resp.StatusCode != http.StatusNoContent {
+		err = ErrSprintRemove
+		s.log.Error(ctx, err.Error())
+		return err
+	}
+
+	return nil
+}
+
+func (s *TodoService) CloseSprint(ctx context.Context, sprintID string, req dto.CloseSprintRequest) (*dto.CloseSprintResponse, error) {
+	url := fmt.Sprintf("%s/sprints/%s/close", s.baseURL, sprintID)
+
+	data := req
+
+	method := http.MethodPost
+	resp, err := s.makeRequest(ctx, method, url, data)
+	if err != nil {
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url, "data", data)
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusBadRequest {
+		err = todo.ErrInvalidSprint
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	if resp.StatusCode == http.StatusConflict {
+		err = todo.ErrSprintClosed
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	if resp.StatusCode == http.StatusForbidden {
+		err = todo.ErrBoardAccess
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		err = ErrCloseSprint
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	var closed dto.CloseSprintResponse
+	if err := json.NewDecoder(resp.Body).Decode(&closed); err != nil {
+		err = ErrDecodeResponse(err)
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	return &closed, nil
+}
+
+func (s *TodoService) GetSprintBurndown(ctx context.Context, sprintID string) ([]dto.BurndownPoint, error) {
+	url := fmt.Sprintf("%s/sprints/%s/burndown", s.baseURL, sprintID)
+
+	method := http.MethodGet
+	resp, err := s.makeRequest(ctx, method, url, nil)
+	if err != nil {
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url)
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusForbidden {
+		err = todo.ErrBoardAccess
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		err = ErrBurndown
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	var points []dto.BurndownPoint
+	if err := json.NewDecoder(resp.Body).Decode(&points); err != nil {
+		err = ErrDecodeResponse(err)
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	return points, nil
+}
+
+func (s *TodoService) CreateCardTemplate(ctx context.Context, req dto.CardTemplateRequest) (*dto.CardTemplate, error) {
+	url := fmt.Sprintf("%s/templates", s.baseURL)
+
+	return s.writeCardTemplate(ctx, http.MethodPost, url, req, http.StatusCreated, ErrAddTemplate)
+}
+
+func (s *TodoService) GetCardTemplate(ctx context.Context, id string) (*dto.CardTemplate, error) {
+	url := fmt.Sprintf("%s/templates/%s", s.baseURL, id)
+
+	method := http.MethodGet
+	resp, err := s.makeRequest(ctx, method, url, nil)
+	if err != nil {
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url)
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if err := templateError(resp, http.StatusOK, ErrGetTemplate); err != nil {
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	var template dto.CardTemplate
+	if err := json.NewDecoder(resp.Body).Decode(&template); err != nil {
+		err = ErrDecodeResponse(err)
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	return &template, nil
+}
+
+func (s *TodoService) GetBoardCardTemplates(ctx context.Context, boardID string) ([]dto.CardTemplate, error) {
+	url := fmt.Sprintf("%s/templates?board_id=%s", s.baseURL, boardID)
+
+	method := http.MethodGet
+	resp, err := s.makeRequest(ctx, method, url, nil)
+	if err != nil {
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url)
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusForbidden {
+		err = todo.ErrBoardAccess
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		err = ErrGetTemplates
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	var templates []dto.CardTemplate
+	if err := json.NewDecoder(resp.Body).Decode(&templates); err != nil {
+		err = ErrDecodeResponse(err)
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	return templates, nil
+}
+
+func (s *TodoService) UpdateCardTemplate(ctx context.Context, id string, req dto.CardTemplateRequest) (*dto.CardTemplate, error) {
+	url := fmt.Sprintf("%s/templates/%s", s.baseURL, id)
+
+	return s.writeCardTemplate(ctx, http.MethodPut, url, req, http.StatusOK, ErrEditTemplate)
+}
+
+func (s *TodoService) DeleteCardTemplate(ctx context.Context, id string) error {
+	url := fmt.Sprintf("%s/templates/%s", s.baseURL, id)
+
+	method := http.MethodDelete
+	resp, err := s.makeRequest(ctx, method, url, nil)
+	if err != nil {
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url)
+		return err
+	}
+	defer resp.Body.Close()
+
+	if err := templateError(resp, http.StatusNoContent, ErrDropTemplate); err != nil {
+		s.log.Error(ctx, err.Error())
+		return err
+	}
+
+	return nil
+}
+
+func (s *TodoService) writeCardTemplate(ctx context.Context, method, url string, req dto.CardTemplateRequest, ok int, failed error) (*dto.CardTemplate, error) {
+	data := req
+
+	resp, err := s.makeRequest(ctx, method, url, data)
+	if err != nil {
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url, "data", data)
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if err := templateError(resp, ok, failed); err != nil {
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	var template dto.CardTemplate
+	if err := json.NewDecoder(resp.Body).Decode(&template); err != nil {
+		err = ErrDecodeResponse(err)
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	return &template, nil
+}
+
+// templateError maps the status of a card template request expecting ok to
+// an error; failed stands for the unexpected statuses.
+func templateError(resp *http.Response, ok int, failed error) error {
+	switch resp.StatusCode {
+	case ok:
+		return nil
+	case http.StatusBadRequest:
+		return todo.ErrInvalidCardTemplate
+	case http.StatusForbidden:
+		return todo.ErrBoardAccess
+	case http.StatusNotFound:
+		return todo.ErrCardTemplateNotFound
+	case http.StatusConflict:
+		return todo.ErrCardTemplateExists
+	case http.StatusUnprocessableEntity:
+		return todo.ErrQuotaExceeded
+	}
+
+	return failed
+}
+
+func (s *TodoService) CreateWorkspace(ctx context.Context, req dto.CreateWorkspaceRequest) (*dto.Workspace, error) {
+	url := fmt.Sprintf("%s/workspaces", s.baseURL)
+
+	data := req
+
+	method := http.MethodPost
+	resp, err := s.makeRequest(ctx, method, url, data)
+	if err != nil {
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url, "data", data)
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if err := workspaceError(resp, http.StatusCreated, ErrCreateSpace); err != nil {
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	var workspace dto.Workspace
+	if err := json.NewDecoder(resp.Body).Decode(&workspace); err != nil {
+		err = ErrDecodeResponse(err)
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	return &workspace, nil
+}
+
+func (s *TodoService) GetWorkspaces(ctx context.Context, userID string) ([]dto.WorkspaceMembership, error) {
+	url := fmt.Sprintf("%s/workspaces?user_id=%s", s.baseURL, userID)
+
+	method := http.MethodGet
+	resp, err := s.makeRequest(ctx, method, url, nil)
+	if err != nil {
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url)
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		err = ErrGetSpaces
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	var workspaces []dto.WorkspaceMembership
+	if err := json.NewDecoder(resp.Body).Decode(&workspaces); err != nil {
+		err = ErrDecodeResponse(err)
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	return workspaces, nil
+}
+
+func (s *TodoService) GetWorkspaceMembers(ctx context.Context, userID, workspaceID string) ([]dto.WorkspaceMember, error) {
+	url := fmt.Sprintf("%s/workspaces/%s/members?user_id=%s", s.baseURL, workspaceID, userID)
+
+	method := http.MethodGet
+	resp, err := s.makeRequest(ctx, method, url, nil)
+	if err != nil {
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url)
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if err := workspaceError(resp, http.StatusOK, ErrGetMembers); err != nil {
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	var members []dto.WorkspaceMember
+	if err := json.NewDecoder(resp.Body).Decode(&members); err != nil {
+		err = ErrDecodeResponse(err)
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	return members, nil
+}
+
+func (s *TodoService) AddWorkspaceMember(ctx context.Context, workspaceID string, req dto.WorkspaceMemberRequest) error {
+	return s.changeWorkspaceMember(ctx, http.MethodPost, workspaceID, req, ErrAddMember)
+}
+
+func (s *TodoService) UpdateWorkspaceMember(ctx context.Context, workspaceID string, req dto.WorkspaceMemberRequest) error {
+	return s.changeWorkspaceMember(ctx, http.MethodPut, workspaceID, req, ErrUpdateMember)
+}
+
+func (s *TodoService) changeWorkspaceMember(ctx context.Context, method, workspaceID string, req dto.WorkspaceMemberRequest, failed error) error {
+	url := fmt.Sprintf("%s/workspaces/%s/members", s.baseURL, workspaceID)
+
+	data := req
+
+	resp, err := s.makeRequest(ctx, method, url, data)
+	if err != nil {
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url, "data", data)
+		return err
+	}
+	defer resp.Body.Close()
+
+	if err := workspaceError(resp, http.StatusNoContent, failed); err != nil {
+		s.log.Error(ctx, err.Error())
+		return err
+	}
+
+	return nil
+}
+
+func (s *TodoService) RemoveWorkspaceMember(ctx context.Context, actorID, workspaceID, userID string) error {
+	url := fmt.Sprintf("%s/workspaces/%s/members?actor_id=%s&user_id=%s", s.baseURL, workspaceID, actorID, userID)
+
+	method := http.MethodDelete
+	resp, err := s.makeRequest(ctx, method, url, nil)
+	if err != nil {
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url)
+		return err
+	}
+	defer resp.Body.Close()
+
+	if err := workspaceError(resp, http.StatusNoContent, ErrRemoveMember); err != nil {
+		s.log.Error(ctx, err.Error())
+		return err
+	}
+
+	return nil
+}
+
+func (s *TodoService) GetWorkspaceBoards(ctx context.Context, userID, workspaceID string) ([]dto.Board, error) {
+	values := url.Values{}
+	values.Set("user_id", userID)
+
+	return fetchPages[dto.Board](ctx, s, "/workspaces/"+workspaceID+"/boards", values, 0, ErrSpaceBoards)
+}
+
+// workspaceError maps the status of a workspace request expecting ok to an
+// error; failed stands for the unexpected statuses.
+func workspaceError(resp *http.Response, ok int, failed error) error {
+	switch resp.StatusCode {
+	case ok:
+		return nil
+	case http.StatusBadRequest:
+		return todo.ErrInvalidWorkspace
+	case http.StatusForbidden:
+		return todo.ErrWorkspaceAccess
+	case http.StatusNotFound:
+		return todo.ErrWorkspaceNotFound
+	case http.StatusConflict:
+		return todo.ErrWorkspaceConflict
+	}
+
+	return failed
+}
+
+func (s *TodoService) RegenerateCalendarToken(ctx context.Context, userID string) (*dto.CalendarToken, error) {
+	url := fmt.Sprintf("%s/calendar/token", s.baseURL)
+
+	data := map[string]string{"user_id": userID}
+
+	method := http.MethodPost
+	resp, err := s.makeRequest(ctx, method, url, data)
+	if err != nil {
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url, "data", data)
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusCreated {
+		err = ErrCalendarKey
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	var token dto.CalendarToken
+	if err := json.NewDecoder(resp.Body).Decode(&token); err != nil {
+		err = ErrDecodeResponse(err)
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	return &token, nil
+}
+
+func (s *TodoService) GetCalendarCards(ctx context.Context, token string) ([]dto.CalendarCard, error) {
+	reqURL := fmt.Sprintf("%s/calendar/%s/cards", s.baseURL, url.PathEscape(token))
+
+	method := http.MethodGet
+	resp, err := s.makeRequest(ctx, method, reqURL, nil)
+	if err != nil {
+		// The token is a secret; it is not logged.
+		s.log.Error(ctx, "Error making the request", "method", method)
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		err = todo.ErrCalendarTokenNotFound
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		err = ErrCalendar
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	var cards []dto.CalendarCard
+	if err := json.NewDecoder(resp.Body).Decode(&cards); err != nil {
+		err = ErrDecodeResponse(err)
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	return cards, nil
+}
+
+func (s *TodoService) WatchBoard(ctx context.Context, boardID, lastEventID string) (io.ReadCloser, error) {
+	url := fmt.Sprintf("%s/boards/%s/events", s.baseURL, boardID)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		err = fmt.Errorf("error creating request: %w", err)
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	req.Header.Set("Accept", "text/event-stream")
+	setCaller(ctx, req)
+	if lastEventID != "" {
+		req.Header.Set("Last-Event-ID", lastEventID)
+	}
+
+	resp, err := s.streamClient.Do(req)
+	if err != nil {
+		err = fmt.Errorf("error sending request: %w", err)
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	if err := watchError(resp, todo.ErrBoardNotFound, ErrWatchBoard); err != nil {
+		resp.Body.Close()
+		s.log.Error(ctx, err.Error(), "status", resp.StatusCode)
+		return nil, err
+	}
+
+	return resp.Body, nil
+}
+
+func (s *TodoService) TransferBoard(ctx context.Context, boardID, toUserID string) (*dto.Board, error) {
+	url := fmt.Sprintf("%s/boards/%s/owner", s.baseURL, boardID)
+
+	data := map[string]string{"user_id": toUserID}
+
+	method := http.MethodPut
+	resp, err := s.makeRequest(ctx, method, url, data)
+	if err != nil {
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url, "data", data)
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if err := transferError(resp); err != nil {
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	var board dto.Board
+	if err := json.NewDecoder(resp.Body).Decode(&board); err != nil {
+		err = ErrDecodeResponse(err)
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	return &board, nil
+}
+
+func (s *TodoService) TransferUserBoards(ctx context.Context, fromUserID, toUserID string, boardIDs []string) ([]dto.Board, error) {
+	url := fmt.Sprintf("%s/users/%s/boards/transfer", s.baseURL, fromUserID)
+
+	data := map[string]any{"user_id": toUserID, "board_ids": boardIDs}
+
+	method := http.MethodPost
+	resp, err := s.makeRequest(ctx, method, url, data)
+	if err != nil {
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url, "data", data)
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if err := transferError(resp); err != nil {
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	var boards []dto.Board
+	if err := json.NewDecoder(resp.Body).Decode(&boards); err != nil {
+		err = ErrDecodeResponse(err)
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	return boards, nil
+}
+
+// transferError maps the status of a board transfer to an error.
+func transferError(resp *http.Response) error {
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return nil
+	case http.StatusBadRequest:
+		return todo.ErrInvalidTransfer
+	case http.StatusForbidden:
+		return todo.ErrBoardAccess
+	case http.StatusNotFound:
+		return todo.ErrBoardNotFound
+	case http.StatusConflict:
+		return todo.ErrVersionConflict
+	case http.StatusUnprocessableEntity:
+		return todo.ErrQuotaExceeded
+	}
+
+	return ErrTransfer
+}
+
+func (s *TodoService) DeleteUserData(ctx context.Context, userID string) error {
+	url := fmt.Sprintf("%s/users/%s", s.baseURL, userID)
+
+	method := http.MethodDelete
+	resp, err := s.makeRequest(ctx, method, url, nil)
+	if err != nil {
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url)
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusNoContent {
+		err = ErrDeleteData
+		s.log.Error(ctx, err.Error())
+		return err
+	}
+
+	return nil
+}
+
+func (s *TodoService) GetUserQuota(ctx context.Context, userID string) (*dto.UserQuota, error) {
+	url := fmt.Sprintf("%s/users/%s/quota", s.baseURL, userID)
+
+	return s.requestUserQuota(ctx, http.MethodGet, url, nil, ErrGetQuota)
+}
+
+func (s *TodoService) SetQuotaOverride(ctx context.Context, userID string, req dto.QuotaOverrideRequest) (*dto.UserQuota, error) {
+	url := fmt.Sprintf("%s/users/%s/quota", s.baseURL, userID)
+
+	return s.requestUserQuota(ctx, http.MethodPut, url, req, ErrSetQuota)
+}
+
+func (s *TodoService) DeleteQuotaOverride(ctx context.Context, userID string) error {
+	url := fmt.Sprintf("%s/users/%s/quota", s.baseURL, userID)
+
+	method := http.MethodDelete
+	resp, err := s.makeRequest(ctx, method, url, nil)
+	if err != nil {
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url)
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		err = todo.ErrQuotaOverrideNotFound
+		s.log.Error(ctx, err.Error())
+		return err
+	}
+
+	if resp.StatusCode != http.StatusNoContent {
+		err = ErrDeleteQuota
+		s.log.Error(ctx, err.Error())
+		return err
+	}
+
+	return nil
+}
+
+func (s *TodoService) requestUserQuota(ctx context.Context, method, url string, data any, failed error) (*dto.UserQuota, error) {
+	resp, err := s.makeRequest(ctx, method, url, data)
+	if err != nil {
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url, "data", data)
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusBadRequest {
+		err = todo.ErrInvalidQuota
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		err = failed
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	var quota dto.UserQuota
+	if err := json.NewDecoder(resp.Body).Decode(&quota); err != nil {
+		err = ErrDecodeResponse(err)
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	return &quota, nil
+}
+
+func (s *TodoService) AddBoardWatch(ctx context.Context, userID, boardID string) error {
+	return s.addWatch(ctx, fmt.Sprintf("%s/boards/%s/watchers", s.baseURL, boardID), userID, todo.ErrBoardNotFound)
+}
+
+func (s *TodoService) AddCardWatch(ctx context.Context, userID, cardID string) error {
+	return s.addWatch(ctx, fmt.Sprintf("%s/cards/%s/watchers", s.baseURL, cardID), userID, todo.ErrCardNotFound)
+}
+
+func (s *TodoService) addWatch(ctx context.Context, url, userID string, notFound error) error {
+	data := map[string]string{"user_id": userID}
+
+	method := http.MethodPut
+	resp, err := s.makeRequest(ctx, method, url, data)
+	if err != nil {
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url, "data", data)
+		return err
+	}
+	defer resp.Body.Close()
+
+	if err := watchError(resp, notFound, ErrAddWatch); err != nil {
+		s.log.Error(ctx, err.Error())
+		return err
+	}
+
+	return nil
+}
+
+func (s *TodoService) RemoveBoardWatch(ctx context.Context, userID, boardID string) error {
+	return s.removeWatch(ctx, fmt.Sprintf("%s/boards/%s/watchers?user_id=%s", s.baseURL, boardID, userID), todo.ErrBoardNotFound)
+}
+
+func (s *TodoService) RemoveCardWatch(ctx context.Context, userID, cardID string) error {
+	return s.removeWatch(ctx, fmt.Sprintf("%s/cards/%s/watchers?user_id=%s", s.baseURL, cardID, userID), todo.ErrCardNotFound)
+}
+
+func (s *TodoService) removeWatch(ctx context.Context, url string, notFound error) error {
+	method := http.MethodDelete
+	resp, err := s.makeRequest(ctx, method, url, nil)
+	if err != nil {
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url)
+		return err
+	}
+	defer resp.Body.Close()
+
+	if err := watchError(resp, notFound, ErrRemoveWatch); err != nil {
+		s.log.Error(ctx, err.Error())
+		return err
+	}
+
+	return nil
+}
+
+// watchError maps the status of a watch change, an event stream or a card
+// notification to an error; notFound stands for the board or card the
+// request named.
+func watchError(resp *http.Response, notFound, failed error) error {
+	switch resp.StatusCode {
+	case http.StatusOK, http.StatusCreated, http.StatusNoContent:
+		return nil
+	case http.StatusBadRequest:
+		return todo.ErrInvalidWatch
+	case http.StatusForbidden:
+		return todo.ErrBoardAccess
+	case http.StatusNotFound:
+		return notFound
+	case http.StatusConflict:
+		return todo.ErrWatchExists
+	}
+
+	return failed
+}
+
+func (s *TodoService) NotifyCard(ctx context.Context, req dto.NotifyCardRequest) ([]dto.Notification, error) {
+	url := fmt.Sprintf("%s/notifications", s.baseURL)
+
+	data := req
+
+	method := http.MethodPost
+	resp, err := s.makeRequest(ctx, method, url, data)
+	if err != nil {
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url, "data", data)
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if err := watchError(resp, todo.ErrCardNotFound, ErrNotifyCard); err != nil {
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	var notifications []dto.Notification
+	if err := json.NewDecoder(resp.Body).Decode(&notifications); err != nil {
+		err = ErrDecodeResponse(err)
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	return notifications, nil
+}
+
+func (s *TodoService) GetNotifications(ctx context.Context, userID string, unreadOnly bool, limit int) ([]dto.Notification, error) {
+	values := url.Values{}
+	values.Set("user_id", userID)
+	if unreadOnly {
+		values.Set("unread", "true")
+	}
+
+	return fetchPages[dto.Notification](ctx, s, "/notifications", values, limit, ErrGetInbox)
+}
+
+func (s *TodoService) MarkNotificationsRead(ctx context.Context, req dto.MarkNotificationsReadRequest) error {
+	url := fmt.Sprintf("%s/notifications/read", s.baseURL)
+
+	data := req
+
+	method := http.MethodPost
+	resp, err := s.makeRequest(ctx, method, url, data)
+	if err != nil {
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url, "data", data)
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusNoContent {
+		err = ErrMarkRead
+		s.log.Error(ctx, err.Error())
+		return err
+	}
+
+	return nil
+}
+
+// fetchPages follows the cursors of a todo service listing and collects its
+// items, stopping early once max items are read if max is positive.
+func fetchPages[T any](ctx context.Context, s *TodoService, path string, values url.Values, max int, errGet error) ([]T, error) {
+	var items []T
+
+	for {
+		if max > 0 {
+			values.Set("limit", strconv.Itoa(max-len(items)))
+		}
+
+		reqURL := fmt.Sprintf("%s%s?%s", s.baseURL, path, values.Encode())
+
+		method := http.MethodGet
+		resp, err := s.makeRequest(ctx, method, reqURL, nil)
+		if err != nil {
+			s.log.Error(ctx, "Error making the request", "method", method, "url", reqURL)
+			return nil, err
+		}
+
+		if resp.StatusCode != http.StatusOK {
+			resp.Body.Close()
+			err = pageError(resp, errGet)
+			s.log.Error(ctx, err.Error())
+			return nil, err
+		}
+
+		var page dto.Page[T]
+		err = json.NewDecoder(resp.Body).Decode(&page)
+		resp.Body.Close()
+		if err != nil {
+			err = ErrDecodeResponse(err)
+			s.log.Error(ctx, err.Error())
+			return nil, err
+		}
+
+		items = append(items, page.Items...)
+
+		if page.NextCursor == "" || (max > 0 && len(items) >= max) {
+			return items, nil
+		}
+
+		values.Set("cursor", page.NextCursor)
+	}
+}
+
+// pageError maps the status of a refused page to an error; errGet stands
+// for the unexpected statuses. Only the workspace board listing answers 403
+// or 404.
+func pageError(resp *http.Response, errGet error) error {
+	switch resp.StatusCode {
+	case http.StatusForbidden:
+		return todo.ErrWorkspaceAccess
+	case http.StatusNotFound:
+		return todo.ErrWorkspaceNotFound
+	}
+
+	return errGet
+}
+
+// versionFromETag reads the version the todo service reports after a write,
+// keeping fallback if the response carries no usable tag.
+func versionFromETag(resp *http.Response, fallback int) int {
+	tag, err := strconv.Unquote(resp.Header.Get("ETag"))
+	if err != nil {
+		return fallback
+	}
+
+	version, err := strconv.Atoi(tag)
+	if err != nil {
+		return fallback
+	}
+
+	return version
+}
+
+func (s *TodoService) makeRequest(ctx context.Context, method, url string, data any) (*http.Response, error) {
+	return s.makeConditionalRequest(ctx, method, url, data, 0)
+}
+
+// makeConditionalRequest sends an If-Match header with version unless it is
+// zero, making the todo service refuse the write on a version mismatch.
+func (s *TodoService) makeConditionalRequest(ctx context.Context, method, url string, data any, version int) (*http.Response, error) {
+	jsonBody, err := json.Marshal(data)
+	if err != nil {
+		err = fmt.Errorf("error marshaling user data: %w", err)
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, url, bytes.NewBuffer(jsonBody))
+	if err != nil {
+		err = fmt.Errorf("error creating request: %w", err)
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	if version != 0 {
+		req.Header.Set("If-Match", strconv.Quote(strconv.Itoa(version)))
+	}
+	setCaller(ctx, req)
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		err = fmt.Errorf("error sending request: %w", err)
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// setCaller tells the todo service who the request is made for, so that it
+// can check their access to the boards reached. Requests made for nobody,
+// such as calendar feeds, go without.
+func setCaller(ctx context.Context, req *http.Request) {
+	if userID, ok := middleware.GetUserIDFromContext(ctx); ok {
+		req.Header.Set("X-User-ID", userID)
+	}
+	if role, ok := middleware.GetRoleFromContext(ctx); ok {
+		req.Header.Set("X-User-Role", role)
+	}
 }
@@ -10,6 +10,7 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"net/url"
 	"time"
 )
 
@@ -17,6 +18,8 @@ const layout string = "02-01-2006"
 
 var (
 	ErrGetNewUsers    error             = errors.New("failed to get new users")
+	ErrGetUser        error             = errors.New("failed to get user")
+	ErrDeleteUser     error             = errors.New("failed to delete user")
 	ErrDecodeResponse func(error) error = func(err error) error {
 		return fmt.Errorf("Failed to decode response: %w", err)
 	}
@@ -69,6 +72,98 @@ func (s *UserService) GetNewUsers(ctx context.Context, from, to time.Time) ([]dt
 	return users, nil
 }
 
+func (s *UserService) GetUserByID(ctx context.Context, id string) (*dto.User, error) {
+	url := fmt.Sprintf("%s/users/%s", s.baseURL, id)
+
+	method := http.MethodGet
+	resp, err := s.makeRequest(ctx, method, url, nil)
+	if err != nil {
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url)
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusBadRequest, http.StatusNotFound:
+		err = user.ErrUserNotFound
+		s.log.Error(ctx, err.Error(), "id", id)
+		return nil, err
+	default:
+		err = ErrGetUser
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	var u dto.User
+	if err := json.NewDecoder(resp.Body).Decode(&u); err != nil {
+		err = ErrDecodeResponse(err)
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	return &u, nil
+}
+
+func (s *UserService) GetUserByUsername(ctx context.Context, username string) (*dto.User, error) {
+	url := fmt.Sprintf("%s/users?username=%s", s.baseURL, url.QueryEscape(username))
+
+	method := http.MethodGet
+	resp, err := s.makeRequest(ctx, method, url, nil)
+	if err != nil {
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url)
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		err = user.ErrUserNotFound
+		s.log.Error(ctx, err.Error(), "username", username)
+		return nil, err
+	default:
+		err = ErrGetUser
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	var users []dto.User
+	if err := json.NewDecoder(resp.Body).Decode(&users); err != nil {
+		err = ErrDecodeResponse(err)
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	if len(users) == 0 {
+		err = user.ErrUserNotFound
+		s.log.Error(ctx, err.Error(), "username", username)
+		return nil, err
+	}
+
+	return &users[0], nil
+}
+
+func (s *UserService) DeleteUser(ctx context.Context, id string) error {
+	url := fmt.Sprintf("%s/users?id=%s", s.baseURL, id)
+
+	method := http.MethodDelete
+	resp, err := s.makeRequest(ctx, method, url, nil)
+	if err != nil {
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url)
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		err = ErrDeleteUser
+		s.log.Error(ctx, err.Error())
+		return err
+	}
+
+	return nil
+}
+
 func (s *UserService) makeRequest(ctx context.Context, method, url string, data any) (*http.Response, error) {
 	jsonBody, err := json.Marshal(data)
 	if err != nil {
@@ -14,26 +14,94 @@ func InitializeV1Routes(router *mux.Router, aggHandler *v1.AggregatorHandler, au
 	router.HandleFunc("/api/v1/validate", aggHandler.Validate).Methods("POST")
 	router.HandleFunc("/api/v1/logout", aggHandler.Logout).Methods("POST")
 
+	// The token in the path stands in for a login, for calendar apps.
+	router.HandleFunc("/api/v1/calendar/{token}.ics", aggHandler.GetCalendarFeed).Methods("GET")
+
 	authRoutes := router.PathPrefix("/api/v1").Subrouter()
 	authRoutes.Use(authMiddleware.Middleware)
 
-	authRoutes.HandleFunc("/boards", aggHandler.GetBoards).Methods("GET")      // Boards
-	authRoutes.HandleFunc("/board/{id}", aggHandler.GetBoard).Methods("GET")   // Columns + cards
-	authRoutes.HandleFunc("/column/{id}", aggHandler.GetColumn).Methods("GET") // Cards
-	authRoutes.HandleFunc("/card/{id}", aggHandler.GetCard).Methods("GET")     // Card + description
+	authRoutes.HandleFunc("/boards", aggHandler.GetBoards).Methods("GET")                     // Boards, ?sort=starred for starred and recent first
+	authRoutes.HandleFunc("/boards/recent", aggHandler.GetRecentBoards).Methods("GET")        // Boards the caller opened lately
+	authRoutes.HandleFunc("/boards/{id}", aggHandler.GetBoardByID).Methods("GET")             // Board itself
+	authRoutes.HandleFunc("/columns/{id}", aggHandler.GetColumnByID).Methods("GET")           // Column itself
+	authRoutes.HandleFunc("/swimlanes/{id}", aggHandler.GetSwimlaneByID).Methods("GET")       // Swimlane itself
+	authRoutes.HandleFunc("/board/{id}", aggHandler.GetBoard).Methods("GET")                  // Lanes + columns + cards
+	authRoutes.HandleFunc("/board/{id}/cards", aggHandler.GetBoardCards).Methods("GET")       // Filtered cards of a board
+	authRoutes.HandleFunc("/board/{id}/events", aggHandler.WatchBoard).Methods("GET")         // Live changes of a board
+	authRoutes.HandleFunc("/column/{id}", aggHandler.GetColumn).Methods("GET")                // Cards
+	authRoutes.HandleFunc("/card/{id}", aggHandler.GetCard).Methods("GET")                    // Card + description, ?render=html for it as HTML
+	authRoutes.HandleFunc("/card/{id}/children", aggHandler.GetCardChildren).Methods("GET")   // Sub-cards
+	authRoutes.HandleFunc("/card/{id}/ancestors", aggHandler.GetCardAncestors).Methods("GET") // Parent up to the root
+	authRoutes.HandleFunc("/card/{id}/time-entries", aggHandler.GetCardTimeEntries).Methods("GET")
+	authRoutes.HandleFunc("/board/{id}/analytics", aggHandler.GetBoardAnalytics).Methods("GET")
+	authRoutes.HandleFunc("/board/{id}/stats", aggHandler.GetBoardStats).Methods("GET") // For board members, ?from=&to=&stale_days=
+	authRoutes.HandleFunc("/board/{id}/sprints", aggHandler.GetBoardSprints).Methods("GET")
+	authRoutes.HandleFunc("/sprint/{id}", aggHandler.GetSprint).Methods("GET")
+	authRoutes.HandleFunc("/sprint/{id}/cards", aggHandler.GetSprintCards).Methods("GET")
+	authRoutes.HandleFunc("/sprint/{id}/burndown", aggHandler.GetSprintBurndown).Methods("GET")
+	authRoutes.HandleFunc("/board/{id}/templates", aggHandler.GetBoardCardTemplates).Methods("GET")
+	authRoutes.HandleFunc("/template/{id}", aggHandler.GetCardTemplate).Methods("GET")
+	authRoutes.HandleFunc("/report/time", aggHandler.GetTimeReport).Methods("GET") // By board or by caller, per day
+	authRoutes.HandleFunc("/workspaces", aggHandler.GetWorkspaces).Methods("GET")  // Workspaces of the caller, with their role
+	authRoutes.HandleFunc("/workspace/{id}/members", aggHandler.GetWorkspaceMembers).Methods("GET")
+	authRoutes.HandleFunc("/workspace/{id}/boards", aggHandler.GetWorkspaceBoards).Methods("GET") // Every board, for admins
 
 	authRoutes.HandleFunc("/board", aggHandler.CreateBoard).Methods("POST")
 	authRoutes.HandleFunc("/column", aggHandler.CreateColumn).Methods("POST")
-	authRoutes.HandleFunc("/card", aggHandler.CreateCard).Methods("POST")
+	authRoutes.HandleFunc("/swimlane", aggHandler.CreateSwimlane).Methods("POST")
+	authRoutes.HandleFunc("/card", aggHandler.CreateCard).Methods("POST") // template_id in the body makes it from a template
 
 	authRoutes.HandleFunc("/board", aggHandler.UpdateBoard).Methods("PUT")
 	authRoutes.HandleFunc("/column", aggHandler.UpdateColumn).Methods("PUT")
+	authRoutes.HandleFunc("/swimlane", aggHandler.UpdateSwimlane).Methods("PUT")
 	authRoutes.HandleFunc("/card", aggHandler.UpdateCard).Methods("PUT")
+	authRoutes.HandleFunc("/card/parent", aggHandler.SetCardParent).Methods("PUT")
+	authRoutes.HandleFunc("/column/board", aggHandler.MoveColumn).Methods("PUT") // To another board, with its cards
 
 	authRoutes.HandleFunc("/board/{id}", aggHandler.DeleteBoard).Methods("DELETE")
 	authRoutes.HandleFunc("/column/{id}", aggHandler.DeleteColumn).Methods("DELETE")
+	authRoutes.HandleFunc("/swimlane/{id}", aggHandler.DeleteSwimlane).Methods("DELETE")
 	authRoutes.HandleFunc("/card/{id}", aggHandler.DeleteCard).Methods("DELETE")
 
+	authRoutes.HandleFunc("/cards/bulk", aggHandler.BulkCards).Methods("POST")
+	authRoutes.HandleFunc("/boards/merge", aggHandler.MergeBoards).Methods("POST") // Every column of one board into another
+
+	authRoutes.HandleFunc("/board/{id}/star", aggHandler.StarBoard).Methods("PUT")
+	authRoutes.HandleFunc("/board/{id}/star", aggHandler.UnstarBoard).Methods("DELETE")
+
+	authRoutes.HandleFunc("/board/{id}/watch", aggHandler.AddBoardWatch).Methods("PUT")
+	authRoutes.HandleFunc("/board/{id}/watch", aggHandler.RemoveBoardWatch).Methods("DELETE")
+	authRoutes.HandleFunc("/card/{id}/watch", aggHandler.AddCardWatch).Methods("PUT")
+	authRoutes.HandleFunc("/card/{id}/watch", aggHandler.RemoveCardWatch).Methods("DELETE")
+	authRoutes.HandleFunc("/notifications", aggHandler.GetNotifications).Methods("GET")            // Inbox of the caller, ?unread=true&limit=
+	authRoutes.HandleFunc("/notifications/read", aggHandler.MarkNotificationsRead).Methods("POST") // The ids given, or every one
+
+	authRoutes.HandleFunc("/timer/start", aggHandler.StartTimer).Methods("POST")
+	authRoutes.HandleFunc("/timer/stop", aggHandler.StopTimer).Methods("POST")
+	authRoutes.HandleFunc("/time-entry", aggHandler.LogTime).Methods("POST")
+
+	authRoutes.HandleFunc("/sprint", aggHandler.CreateSprint).Methods("POST")
+	authRoutes.HandleFunc("/sprint/{id}/cards", aggHandler.AddSprintCard).Methods("POST")
+	authRoutes.HandleFunc("/sprint/{id}/cards/{card_id}", aggHandler.RemoveSprintCard).Methods("DELETE")
+	authRoutes.HandleFunc("/sprint/{id}/close", aggHandler.CloseSprint).Methods("POST")
+
+	authRoutes.HandleFunc("/template", aggHandler.CreateCardTemplate).Methods("POST")
+	authRoutes.HandleFunc("/template/{id}", aggHandler.UpdateCardTemplate).Methods("PUT")
+	authRoutes.HandleFunc("/template/{id}", aggHandler.DeleteCardTemplate).Methods("DELETE")
+
+	authRoutes.HandleFunc("/workspace", aggHandler.CreateWorkspace).Methods("POST")
+	authRoutes.HandleFunc("/workspace/{id}/members", aggHandler.AddWorkspaceMember).Methods("POST")
+	authRoutes.HandleFunc("/workspace/{id}/members", aggHandler.UpdateWorkspaceMember).Methods("PUT")
+	authRoutes.HandleFunc("/workspace/{id}/members/{user_id}", aggHandler.RemoveWorkspaceMember).Methods("DELETE")
+
+	authRoutes.HandleFunc("/calendar/token", aggHandler.RegenerateCalendarToken).Methods("POST")
+
+	authRoutes.HandleFunc("/board/{id}/owner", aggHandler.TransferBoard).Methods("PUT")         // For admins
+	authRoutes.HandleFunc("/user/{id}", aggHandler.DeleteUser).Methods("DELETE")                // For admins, ?transfer_to= keeps the boards
+	authRoutes.HandleFunc("/user/{id}/quota", aggHandler.GetUserQuota).Methods("GET")           // For admins
+	authRoutes.HandleFunc("/user/{id}/quota", aggHandler.SetQuotaOverride).Methods("PUT")       // For admins
+	authRoutes.HandleFunc("/user/{id}/quota", aggHandler.DeleteQuotaOverride).Methods("DELETE") // For admins
+
 	authRoutes.HandleFunc("/stats/{from}/{to}", aggHandler.GetStats).Methods("GET")
 	authRoutes.HandleFunc("/stats/{from}", aggHandler.GetStats).Methods("GET")
 	authRoutes.HandleFunc("/stats", aggHandler.GetStats).Methods("GET")
@@ -0,0 +1,43 @@
+package markdown
+
+import (
+	"bytes"
+	"html"
+	"regexp"
+
+	"github.com/microcosm-cc/bluemonday"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/extension"
+	gmhtml "github.com/yuin/goldmark/renderer/html"
+)
+
+// md renders GitHub flavoured Markdown: task lists, autolinks, tables and
+// strikethrough. Raw HTML in the source is left out rather than passed on.
+var md = goldmark.New(
+	goldmark.WithExtensions(extension.GFM),
+	goldmark.WithRendererOptions(gmhtml.WithHardWraps()),
+)
+
+// policy is what may reach a browser: the usual user generated content,
+// plus the disabled checkboxes of task lists.
+var policy = func() *bluemonday.Policy {
+	p := bluemonday.UGCPolicy()
+	p.AllowAttrs("type").Matching(regexp.MustCompile(`^checkbox$`)).OnElements("input")
+	p.AllowAttrs("checked", "disabled").Matching(regexp.MustCompile(`^$`)).OnElements("input")
+	return p
+}()
+
+// Render renders a Markdown text to HTML safe to embed in a page. A text
+// that cannot be rendered comes back escaped as a single paragraph.
+func Render(src string) string {
+	if src == "" {
+		return ""
+	}
+
+	var buf bytes.Buffer
+	if err := md.Convert([]byte(src), &buf); err != nil {
+		return "<p>" + html.EscapeString(src) + "</p>"
+	}
+
+	return policy.Sanitize(buf.String())
+}
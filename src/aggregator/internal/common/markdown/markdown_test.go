@@ -0,0 +1,78 @@
+package markdown_test
+
+import (
+	"aggregator/internal/common/markdown"
+	"testing"
+
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/runner"
+)
+
+func TestRender(t *testing.T) {
+	runner.Run(t, "TestRender", func(pt provider.T) {
+		tests := []struct {
+			name        string
+			src         string
+			contains    []string
+			notContains []string
+		}{
+			{
+				name: "empty",
+				src:  "",
+			},
+			{
+				name:     "emphasis and hard wraps",
+				src:      "**bold** and *em*\nnext line",
+				contains: []string{"<strong>bold</strong>", "<em>em</em>", "<br>"},
+			},
+			{
+				name: "task list",
+				src:  "- [ ] todo\n- [x] done",
+				contains: []string{
+					`<input disabled="" type="checkbox"> todo`,
+					`<input checked="" disabled="" type="checkbox"> done`,
+				},
+			},
+			{
+				name:     "autolink",
+				src:      "see https://example.com/a?b=c",
+				contains: []string{`<a href="https://example.com/a?b=c" rel="nofollow">`},
+			},
+			{
+				name:        "raw html left out",
+				src:         "<script>alert(1)</script>\n\n<img src=x onerror=alert(1)>",
+				notContains: []string{"<script", "<img", "onerror"},
+			},
+			{
+				name:        "javascript link dropped",
+				src:         "[click](javascript:alert(1))",
+				contains:    []string{"click"},
+				notContains: []string{"javascript:", "<a"},
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				t.Parallel()
+
+				runner.Run(t, tt.name, func(pt provider.T) {
+					pt.WithNewStep("Call Render", func(sCtx provider.StepCtx) {
+						html := markdown.Render(tt.src)
+
+						if tt.src == "" {
+							sCtx.Assert().Empty(html)
+						}
+
+						for _, want := range tt.contains {
+							sCtx.Assert().Contains(html, want)
+						}
+
+						for _, unwanted := range tt.notContains {
+							sCtx.Assert().NotContains(html, unwanted)
+						}
+					})
+				})
+			})
+		}
+	})
+}
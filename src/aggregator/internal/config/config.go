@@ -21,8 +21,7 @@ type LogConfig struct {
 }
 
 type PaginationConfig struct {
-	Limit  int `toml:"limit"`
-	Offset int `toml:"offset"`
+	Limit int `toml:"limit"`
 }
 
 type AggregatorConfig struct {
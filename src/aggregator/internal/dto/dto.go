@@ -2,6 +2,7 @@ package dto
 
 import (
 	"aggregator/internal/entity"
+	"net/url"
 	"time"
 
 	"github.com/google/uuid"
@@ -34,10 +35,103 @@ type Card struct {
 	ID          uuid.UUID `json:"id"`
 	UserID      uuid.UUID `json:"user_id"`
 	ColumnID    uuid.UUID `json:"column_id"`
+	SwimlaneID  uuid.UUID `json:"swimlane_id"`
+	ParentID    uuid.UUID `json:"parent_id,omitempty"`
 	Title       string    `json:"title"`
 	Description string    `json:"description,omitempty"`
-	Position    float64   `json:"position"`
-	CreatedAt   time.Time `json:"created_at"`
+	// DescriptionHTML is the description rendered from Markdown to
+	// sanitised HTML, filled in only when a listing asks for it.
+	DescriptionHTML string     `json:"description_html,omitempty"`
+	Position        float64    `json:"position"`
+	Priority        int        `json:"priority"`
+	AssigneeID      uuid.UUID  `json:"assignee_id,omitempty"`
+	DueDate         *time.Time `json:"due_date,omitempty"`
+	Labels          []string   `json:"labels,omitempty"`
+	ArchivedAt      *time.Time `json:"archived_at,omitempty"`
+	Version         int        `json:"version"`
+	CreatedAt       time.Time  `json:"created_at"`
+	UpdatedAt       time.Time  `json:"updated_at"`
+
+	ChildCount     int `json:"child_count"`
+	DoneChildCount int `json:"done_child_count"`
+}
+
+// Page is one page of a todo service listing.
+type Page[T any] struct {
+	Items      []T    `json:"items"`
+	NextCursor string `json:"next_cursor,omitempty"`
+}
+
+// CardQuery holds the card listing parameters understood by the todo
+// service. Values are forwarded as they are; the todo service validates them.
+// Limit caps the total number of cards, which may span several pages.
+type CardQuery struct {
+	BoardID     string
+	ColumnID    string
+	SwimlaneID  string
+	ParentID    string
+	Priority    []string
+	UserID      string
+	AssigneeID  string
+	Label       string
+	Text        string
+	CreatedFrom string
+	CreatedTo   string
+	UpdatedFrom string
+	UpdatedTo   string
+	DueFrom     string
+	DueTo       string
+	Archived    string
+	Sort        string
+	Order       string
+	Limit       string
+}
+
+func (q *CardQuery) params() map[string]*string {
+	return map[string]*string{
+		"board_id":     &q.BoardID,
+		"column_id":    &q.ColumnID,
+		"swimlane_id":  &q.SwimlaneID,
+		"parent_id":    &q.ParentID,
+		"user_id":      &q.UserID,
+		"assignee_id":  &q.AssigneeID,
+		"label":        &q.Label,
+		"q":            &q.Text,
+		"created_from": &q.CreatedFrom,
+		"created_to":   &q.CreatedTo,
+		"updated_from": &q.UpdatedFrom,
+		"updated_to":   &q.UpdatedTo,
+		"due_from":     &q.DueFrom,
+		"due_to":       &q.DueTo,
+		"archived":     &q.Archived,
+		"sort":         &q.Sort,
+		"order":        &q.Order,
+		"limit":        &q.Limit,
+	}
+}
+
+// CardQueryFromValues picks the card listing parameters out of a query
+// string, ignoring anything else.
+func CardQueryFromValues(values url.Values) CardQuery {
+	var q CardQuery
+	for name, field := range q.params() {
+		*field = values.Get(name)
+	}
+	q.Priority = values["priority"]
+	return q
+}
+
+func (q CardQuery) Values() url.Values {
+	values := url.Values{}
+	for name, field := range q.params() {
+		if *field != "" {
+			values.Set(name, *field)
+		}
+	}
+	for _, p := range q.Priority {
+		values.Add("priority", p)
+	}
+	return values
 }
 
 func CardToEntity(cardDTO *Card) entity.Card {
@@ -58,17 +152,63 @@ func ToCardEntities(cardDTOs []Card) []entity.Card {
 }
 
 type Board struct {
-	ID     uuid.UUID `json:"id"`
-	UserID uuid.UUID `json:"user_id"`
-	Title  string    `json:"title"`
+	ID          uuid.UUID  `json:"id"`
+	UserID      uuid.UUID  `json:"user_id"`
+	WorkspaceID *uuid.UUID `json:"workspace_id,omitempty"`
+	Title       string     `json:"title"`
+	Version     int        `json:"version"`
+}
+
+// MarkedBoard is a board with the marks the user left on it: a star and the
+// last time they opened it, if lately.
+type MarkedBoard struct {
+	Board
+	Starred   bool       `json:"starred"`
+	StarredAt *time.Time `json:"starred_at,omitempty"`
+	ViewedAt  *time.Time `json:"viewed_at,omitempty"`
 }
 
+// Board list sort modes. BoardsByStar puts starred boards first, then the
+// recently viewed ones.
+const (
+	BoardsByCreation = "created"
+	BoardsByStar     = "starred"
+)
+
 type Column struct {
 	ID       uuid.UUID `json:"id"`
 	UserID   uuid.UUID `json:"user_id"`
 	BoardID  uuid.UUID `json:"board_id"`
 	Title    string    `json:"title"`
 	Position float64   `json:"position"`
+	Done     bool      `json:"done"`
+	Version  int       `json:"version"`
+}
+
+type Swimlane struct {
+	ID       uuid.UUID `json:"id"`
+	UserID   uuid.UUID `json:"user_id"`
+	BoardID  uuid.UUID `json:"board_id"`
+	Title    string    `json:"title"`
+	Position float64   `json:"position"`
+	Version  int       `json:"version"`
+}
+
+// BoardSnapshot is a whole board at once: its cards grouped by swimlane, then
+// by column, both in position order.
+type BoardSnapshot struct {
+	Board     Board          `json:"board"`
+	Swimlanes []LaneSnapshot `json:"swimlanes"`
+}
+
+type LaneSnapshot struct {
+	Swimlane
+	Columns []ColumnSnapshot `json:"columns"`
+}
+
+type ColumnSnapshot struct {
+	Column
+	Cards []Card `json:"cards"`
 }
 
 type RegisterRequest struct {
@@ -108,19 +248,39 @@ type RefreshResponse struct {
 	AccessToken string `json:"access_token"`
 }
 
+// CreateBoardRequest puts the board in the personal workspace of the user
+// when it has no workspace ID.
 type CreateBoardRequest struct {
-	Title string `json:"title"`
+	WorkspaceID *uuid.UUID `json:"workspace_id,omitempty"`
+	Title       string     `json:"title"`
 }
 
 type CreateColumnRequest struct {
 	BoardID uuid.UUID `json:"board_id"`
 	Title   string    `json:"title"`
+	Done    bool      `json:"done,omitempty"`
+}
+
+type CreateSwimlaneRequest struct {
+	BoardID  uuid.UUID `json:"board_id"`
+	Title    string    `json:"title"`
+	Position float64   `json:"position,omitempty"`
 }
 
 type CreateCardRequest struct {
-	ColumnID    uuid.UUID `json:"column_id"`
-	Title       string    `json:"title"`
-	Description string    `json:"description,omitempty"`
+	ColumnID    uuid.UUID  `json:"column_id"`
+	SwimlaneID  uuid.UUID  `json:"swimlane_id,omitempty"`
+	ParentID    uuid.UUID  `json:"parent_id,omitempty"`
+	Title       string     `json:"title"`
+	Description string     `json:"description,omitempty"`
+	Priority    int        `json:"priority,omitempty"`
+	AssigneeID  uuid.UUID  `json:"assignee_id,omitempty"`
+	DueDate     *time.Time `json:"due_date,omitempty"`
+	Labels      []string   `json:"labels,omitempty"`
+
+	// TemplateID makes a new card from a template of its board; updates
+	// leave it out.
+	TemplateID *uuid.UUID `json:"template_id,omitempty"`
 }
 
 type UpdateBoardRequest struct {
@@ -133,7 +293,449 @@ type UpdateColumnRequest struct {
 	CreateColumnRequest
 }
 
+// MoveColumnRequest moves a column to the end of another board; the column
+// version goes in the If-Match header.
+type MoveColumnRequest struct {
+	ID      uuid.UUID `json:"id"`
+	BoardID uuid.UUID `json:"board_id"`
+}
+
+// Column merge strategies: what a board merge does with a source column
+// titled like a column of the target board.
+const (
+	MergeColumnsCombine = "combine" // its cards join that column
+	MergeColumnsKeep    = "keep"    // it moves as it is
+	MergeColumnsRename  = "rename"  // it moves, titled after its board
+)
+
+type MergeBoardsRequest struct {
+	UserID   uuid.UUID `json:"user_id"`
+	SourceID uuid.UUID `json:"source_id"`
+	TargetID uuid.UUID `json:"target_id"`
+	Strategy string    `json:"strategy"`
+}
+
+type BoardMerge struct {
+	SourceID uuid.UUID `json:"source_id"`
+	TargetID uuid.UUID `json:"target_id"`
+	Strategy string    `json:"strategy"`
+	Moved    []Column  `json:"moved"`
+	Combined []Column  `json:"combined"`
+}
+
+type UpdateSwimlaneRequest struct {
+	ID uuid.UUID `json:"id"`
+	CreateSwimlaneRequest
+}
+
 type UpdateCardRequest struct {
 	ID uuid.UUID `json:"id"`
 	CreateCardRequest
 }
+
+// SetCardParentRequest puts a card under another one; an empty parent_id
+// moves it back to the top level.
+type SetCardParentRequest struct {
+	ID       uuid.UUID `json:"id"`
+	ParentID uuid.UUID `json:"parent_id,omitempty"`
+}
+
+// CardOp is one item of a bulk card request, forwarded to the todo service
+// as it is. Archiving a card with sub-cards needs Cascade set.
+type CardOp struct {
+	Op         string    `json:"op"`
+	CardID     uuid.UUID `json:"card_id"`
+	Version    int       `json:"version,omitempty"`
+	ColumnID   uuid.UUID `json:"column_id,omitempty"`
+	Label      string    `json:"label,omitempty"`
+	AssigneeID uuid.UUID `json:"assignee_id,omitempty"`
+	Cascade    *bool     `json:"cascade,omitempty"`
+}
+
+type BulkCardsRequest struct {
+	AllOrNothing bool     `json:"all_or_nothing"`
+	Ops          []CardOp `json:"ops"`
+}
+
+type CardOpResult struct {
+	Index  int       `json:"index"`
+	CardID uuid.UUID `json:"card_id"`
+	Status string    `json:"status"`
+	Error  string    `json:"error,omitempty"`
+}
+
+type BulkCardsResponse struct {
+	Results []CardOpResult `json:"results"`
+}
+
+// TimeEntry is time a user spent on a card, Duration in seconds. A running
+// timer has no EndedAt and counts up to now.
+type TimeEntry struct {
+	ID        uuid.UUID  `json:"id"`
+	CardID    uuid.UUID  `json:"card_id"`
+	UserID    uuid.UUID  `json:"user_id"`
+	StartedAt time.Time  `json:"started_at"`
+	EndedAt   *time.Time `json:"ended_at,omitempty"`
+	Duration  int64      `json:"duration"`
+	Note      string     `json:"note,omitempty"`
+	CreatedAt time.Time  `json:"created_at"`
+}
+
+// StartTimerRequest and the other time requests take the user from the
+// token; a user_id in the body is overwritten.
+type StartTimerRequest struct {
+	UserID uuid.UUID `json:"user_id"`
+	CardID uuid.UUID `json:"card_id"`
+	Note   string    `json:"note,omitempty"`
+}
+
+type StopTimerRequest struct {
+	UserID uuid.UUID `json:"user_id"`
+}
+
+type LogTimeRequest struct {
+	UserID    uuid.UUID `json:"user_id"`
+	CardID    uuid.UUID `json:"card_id"`
+	StartedAt time.Time `json:"started_at"`
+	EndedAt   time.Time `json:"ended_at"`
+	Note      string    `json:"note,omitempty"`
+}
+
+// TimeReportQuery selects the time logged on a board, by a user or both,
+// from one DD-MM-YYYY date to another, both included.
+type TimeReportQuery struct {
+	BoardID string
+	UserID  string
+	From    string
+	To      string
+}
+
+func (q TimeReportQuery) Values() url.Values {
+	values := url.Values{}
+	if q.BoardID != "" {
+		values.Set("board_id", q.BoardID)
+	}
+	if q.UserID != "" {
+		values.Set("user_id", q.UserID)
+	}
+	values.Set("from", q.From)
+	values.Set("to", q.To)
+	return values
+}
+
+// FlowTimes sums up how long the cards done over a period took, in seconds.
+type FlowTimes struct {
+	Cards   int   `json:"cards"`
+	Average int64 `json:"average"`
+	P50     int64 `json:"p50"`
+	P85     int64 `json:"p85"`
+	P95     int64 `json:"p95"`
+}
+
+type WeekThroughput struct {
+	WeekStart time.Time `json:"week_start"`
+	Cards     int       `json:"cards"`
+}
+
+// FlowSeries has the number of cards in a column at the end of each day.
+type FlowSeries struct {
+	ColumnID    uuid.UUID `json:"column_id"`
+	ColumnTitle string    `json:"column_title"`
+	Counts      []int     `json:"counts"`
+}
+
+// BoardAnalytics describes how cards flowed across a board from one date to
+// the day before To; each Flow series has a count per day of Days.
+type BoardAnalytics struct {
+	BoardID    uuid.UUID        `json:"board_id"`
+	From       time.Time        `json:"from"`
+	To         time.Time        `json:"to"`
+	LeadTime   FlowTimes        `json:"lead_time"`
+	CycleTime  FlowTimes        `json:"cycle_time"`
+	Throughput []WeekThroughput `json:"throughput"`
+	Days       []time.Time      `json:"days"`
+	Flow       []FlowSeries     `json:"flow"`
+}
+
+type ColumnCards struct {
+	ColumnID    uuid.UUID `json:"column_id"`
+	ColumnTitle string    `json:"column_title"`
+	Done        bool      `json:"done"`
+	Cards       int       `json:"cards"`
+}
+
+type DayCards struct {
+	Day       time.Time `json:"day"`
+	Created   int       `json:"created"`
+	Completed int       `json:"completed"`
+}
+
+type StaleCard struct {
+	CardID      uuid.UUID  `json:"card_id"`
+	ColumnID    uuid.UUID  `json:"column_id"`
+	ColumnTitle string     `json:"column_title"`
+	Title       string     `json:"title"`
+	AssigneeID  *uuid.UUID `json:"assignee_id,omitempty"`
+	UpdatedAt   time.Time  `json:"updated_at"`
+}
+
+// Contributor counts the cards a user created and the cards assigned to
+// them completed. Username is filled in by the aggregator.
+type Contributor struct {
+	UserID    uuid.UUID `json:"user_id"`
+	Username  string    `json:"username,omitempty"`
+	Created   int       `json:"created"`
+	Completed int       `json:"completed"`
+}
+
+// BoardStats sums up a board for its members from one date to the day
+// before To; Columns and Stale are as of now.
+type BoardStats struct {
+	BoardID      uuid.UUID     `json:"board_id"`
+	From         time.Time     `json:"from"`
+	To           time.Time     `json:"to"`
+	Columns      []ColumnCards `json:"columns"`
+	Days         []DayCards    `json:"days"`
+	StaleDays    int           `json:"stale_days"`
+	Stale        []StaleCard   `json:"stale"`
+	Contributors []Contributor `json:"contributors"`
+}
+
+type CreateSprintRequest struct {
+	BoardID   uuid.UUID `json:"board_id"`
+	Name      string    `json:"name"`
+	StartDate time.Time `json:"start_date"`
+	EndDate   time.Time `json:"end_date"`
+}
+
+// Sprint runs from StartDate to EndDate, both days included.
+type Sprint struct {
+	ID        uuid.UUID  `json:"id"`
+	BoardID   uuid.UUID  `json:"board_id"`
+	Name      string     `json:"name"`
+	StartDate time.Time  `json:"start_date"`
+	EndDate   time.Time  `json:"end_date"`
+	ClosedAt  *time.Time `json:"closed_at,omitempty"`
+	CreatedAt time.Time  `json:"created_at"`
+}
+
+type AddSprintCardRequest struct {
+	CardID uuid.UUID `json:"card_id"`
+	Points int       `json:"points"`
+}
+
+// SprintCard is a card taken into a sprint, worth Points story points.
+type SprintCard struct {
+	SprintID  uuid.UUID `json:"sprint_id"`
+	CardID    uuid.UUID `json:"card_id"`
+	CardTitle string    `json:"card_title,omitempty"`
+	Points    int       `json:"points"`
+	Done      bool      `json:"done"`
+	AddedAt   time.Time `json:"added_at"`
+}
+
+// CloseSprintRequest names the sprint to carry unfinished cards over to; by
+// default it is the next open sprint of the board.
+type CloseSprintRequest struct {
+	NextSprintID *uuid.UUID `json:"next_sprint_id,omitempty"`
+}
+
+type CloseSprintResponse struct {
+	NextSprint *Sprint `json:"next_sprint,omitempty"`
+	Carried    int     `json:"carried"`
+}
+
+// BurndownPoint is the work left in a sprint at the end of a day.
+type BurndownPoint struct {
+	Day         time.Time `json:"day"`
+	Cards       int       `json:"cards"`
+	Points      int       `json:"points"`
+	IdealPoints float64   `json:"ideal_points"`
+}
+
+// Checklist is a titled list of items a card made from a template gets as a
+// Markdown task list.
+type Checklist struct {
+	Title string   `json:"title"`
+	Items []string `json:"items"`
+}
+
+// CardTemplateRequest creates a template of the board or, on update, sets
+// everything but the board of one. The title pattern and description may
+// hold {{date}}, {{user}}, {{board}}, {{title}} and {{description}}.
+type CardTemplateRequest struct {
+	BoardID      uuid.UUID   `json:"board_id,omitempty"`
+	UserID       uuid.UUID   `json:"user_id,omitempty"`
+	Name         string      `json:"name"`
+	TitlePattern string      `json:"title_pattern,omitempty"`
+	Description  string      `json:"description,omitempty"`
+	Labels       []string    `json:"labels,omitempty"`
+	Checklists   []Checklist `json:"checklists,omitempty"`
+}
+
+type CardTemplate struct {
+	ID           uuid.UUID   `json:"id"`
+	BoardID      uuid.UUID   `json:"board_id"`
+	Name         string      `json:"name"`
+	TitlePattern string      `json:"title_pattern,omitempty"`
+	Description  string      `json:"description,omitempty"`
+	Labels       []string    `json:"labels,omitempty"`
+	Checklists   []Checklist `json:"checklists,omitempty"`
+	CreatedBy    uuid.UUID   `json:"created_by"`
+	CreatedAt    time.Time   `json:"created_at"`
+	UpdatedAt    time.Time   `json:"updated_at"`
+}
+
+// Quota bounds what a user may keep; zero is no bound.
+type Quota struct {
+	BoardsPerUser     int `json:"boards_per_user"`
+	ColumnsPerBoard   int `json:"columns_per_board"`
+	CardsPerColumn    int `json:"cards_per_column"`
+	DescriptionLength int `json:"description_length"`
+}
+
+// QuotaOverrideRequest sets the bounds it has for the user; the ones it
+// leaves out stay as configured.
+type QuotaOverrideRequest struct {
+	BoardsPerUser     *int `json:"boards_per_user,omitempty"`
+	ColumnsPerBoard   *int `json:"columns_per_board,omitempty"`
+	CardsPerColumn    *int `json:"cards_per_column,omitempty"`
+	DescriptionLength *int `json:"description_length,omitempty"`
+}
+
+type QuotaOverride struct {
+	QuotaOverrideRequest
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// UserQuota is the quota the user is held to and the override it comes
+// from, if any.
+type UserQuota struct {
+	UserID   uuid.UUID      `json:"user_id"`
+	Quota    Quota          `json:"quota"`
+	Override *QuotaOverride `json:"override,omitempty"`
+}
+
+// TimeReportRow totals the time a user logged on a card over a day,
+// Duration in seconds.
+type TimeReportRow struct {
+	Day       time.Time `json:"day"`
+	UserID    uuid.UUID `json:"user_id"`
+	CardID    uuid.UUID `json:"card_id"`
+	CardTitle string    `json:"card_title"`
+	Duration  int64     `json:"duration"`
+}
+
+// Kinds of entries a calendar feed can be restricted to; by default it has
+// both an event and a to-do per card.
+const (
+	CalendarKindEvent = "event"
+	CalendarKindTodo  = "todo"
+)
+
+// CalendarToken opens the calendar feed at FeedPath without logging in.
+// Only the response regenerating the token carries it.
+type CalendarToken struct {
+	Token     string    `json:"token"`
+	FeedPath  string    `json:"feed_path"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// CalendarCard is a card with a due date in the calendar feed of a user.
+type CalendarCard struct {
+	CardID      uuid.UUID `json:"card_id"`
+	BoardID     uuid.UUID `json:"board_id"`
+	BoardTitle  string    `json:"board_title"`
+	Title       string    `json:"title"`
+	Description string    `json:"description,omitempty"`
+	DueDate     time.Time `json:"due_date"`
+	Done        bool      `json:"done"`
+	Version     int       `json:"version"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// Workspace member roles. Admins manage the members and see every board of
+// the workspace, members read and write its boards and viewers only read
+// them.
+const (
+	RoleAdmin  = "admin"
+	RoleMember = "member"
+	RoleViewer = "viewer"
+)
+
+type CreateWorkspaceRequest struct {
+	UserID uuid.UUID `json:"user_id"`
+	Name   string    `json:"name"`
+}
+
+// Workspace groups boards and the members who may use them. Every user has
+// a personal one, holding the boards created without a workspace.
+type Workspace struct {
+	ID        uuid.UUID `json:"id"`
+	Name      string    `json:"name"`
+	Personal  bool      `json:"personal"`
+	CreatedBy uuid.UUID `json:"created_by"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// WorkspaceMembership is a workspace with the role the user asking has in
+// it.
+type WorkspaceMembership struct {
+	Workspace
+	Role string `json:"role"`
+}
+
+// WorkspaceMemberRequest adds a member or changes their role on behalf of
+// the admin ActorID.
+type WorkspaceMemberRequest struct {
+	ActorID uuid.UUID `json:"actor_id"`
+	UserID  uuid.UUID `json:"user_id"`
+	Role    string    `json:"role"`
+}
+
+type WorkspaceMember struct {
+	UserID    uuid.UUID `json:"user_id"`
+	Role      string    `json:"role"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// Notification kinds: a mention, or for watchers the card activity they are
+// told of.
+const (
+	NotificationMention     = "mention"
+	NotificationCardCreated = "card.created"
+	NotificationCardUpdated = "card.updated"
+)
+
+// NotifyCardRequest asks the todo service to tell the users mentioned and
+// the watchers of a card what ActorID did on it.
+type NotifyCardRequest struct {
+	ActorID   uuid.UUID   `json:"actor_id"`
+	CardID    uuid.UUID   `json:"card_id"`
+	Kind      string      `json:"kind"`
+	Mentioned []uuid.UUID `json:"mentioned,omitempty"`
+}
+
+// Notification is an entry of the inbox of a user. ActorName is filled in
+// by the aggregator, when the user service knows the actor.
+type Notification struct {
+	ID        uuid.UUID  `json:"id"`
+	UserID    uuid.UUID  `json:"user_id"`
+	ActorID   uuid.UUID  `json:"actor_id"`
+	ActorName string     `json:"actor_name,omitempty"`
+	Kind      string     `json:"kind"`
+	BoardID   uuid.UUID  `json:"board_id"`
+	CardID    uuid.UUID  `json:"card_id"`
+	CardTitle string     `json:"card_title"`
+	Read      bool       `json:"read"`
+	ReadAt    *time.Time `json:"read_at,omitempty"`
+	CreatedAt time.Time  `json:"created_at"`
+}
+
+// MarkNotificationsReadRequest marks the notifications with the ids, or all
+// of them without any, read.
+type MarkNotificationsReadRequest struct {
+	UserID uuid.UUID   `json:"user_id,omitempty"`
+	IDs    []uuid.UUID `json:"ids,omitempty"`
+}
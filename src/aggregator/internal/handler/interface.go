@@ -10,20 +10,89 @@ type AggregatorHandler interface {
 	Logout(w http.ResponseWriter, r *http.Request)
 
 	GetBoards(w http.ResponseWriter, r *http.Request)
+	GetRecentBoards(w http.ResponseWriter, r *http.Request)
 	GetBoard(w http.ResponseWriter, r *http.Request)
+	GetBoardByID(w http.ResponseWriter, r *http.Request)
 	GetColumn(w http.ResponseWriter, r *http.Request)
+	GetColumnByID(w http.ResponseWriter, r *http.Request)
+	GetSwimlaneByID(w http.ResponseWriter, r *http.Request)
+	GetBoardCards(w http.ResponseWriter, r *http.Request)
 	GetCard(w http.ResponseWriter, r *http.Request)
+	GetCardChildren(w http.ResponseWriter, r *http.Request)
+	GetCardAncestors(w http.ResponseWriter, r *http.Request)
 	GetStats(w http.ResponseWriter, r *http.Request)
 
 	CreateBoard(w http.ResponseWriter, r *http.Request)
 	CreateColumn(w http.ResponseWriter, r *http.Request)
+	CreateSwimlane(w http.ResponseWriter, r *http.Request)
 	CreateCard(w http.ResponseWriter, r *http.Request)
 
 	UpdateBoard(w http.ResponseWriter, r *http.Request)
 	UpdateColumn(w http.ResponseWriter, r *http.Request)
+	UpdateSwimlane(w http.ResponseWriter, r *http.Request)
 	UpdateCard(w http.ResponseWriter, r *http.Request)
+	SetCardParent(w http.ResponseWriter, r *http.Request)
 
 	DeleteBoard(w http.ResponseWriter, r *http.Request)
 	DeleteColumn(w http.ResponseWriter, r *http.Request)
+	DeleteSwimlane(w http.ResponseWriter, r *http.Request)
 	DeleteCard(w http.ResponseWriter, r *http.Request)
+
+	BulkCards(w http.ResponseWriter, r *http.Request)
+
+	MoveColumn(w http.ResponseWriter, r *http.Request)
+	MergeBoards(w http.ResponseWriter, r *http.Request)
+
+	StarBoard(w http.ResponseWriter, r *http.Request)
+	UnstarBoard(w http.ResponseWriter, r *http.Request)
+
+	AddBoardWatch(w http.ResponseWriter, r *http.Request)
+	RemoveBoardWatch(w http.ResponseWriter, r *http.Request)
+	AddCardWatch(w http.ResponseWriter, r *http.Request)
+	RemoveCardWatch(w http.ResponseWriter, r *http.Request)
+	GetNotifications(w http.ResponseWriter, r *http.Request)
+	MarkNotificationsRead(w http.ResponseWriter, r *http.Request)
+
+	StartTimer(w http.ResponseWriter, r *http.Request)
+	StopTimer(w http.ResponseWriter, r *http.Request)
+	LogTime(w http.ResponseWriter, r *http.Request)
+	GetCardTimeEntries(w http.ResponseWriter, r *http.Request)
+	GetTimeReport(w http.ResponseWriter, r *http.Request)
+	GetBoardAnalytics(w http.ResponseWriter, r *http.Request)
+	GetBoardStats(w http.ResponseWriter, r *http.Request)
+
+	CreateSprint(w http.ResponseWriter, r *http.Request)
+	GetSprint(w http.ResponseWriter, r *http.Request)
+	GetBoardSprints(w http.ResponseWriter, r *http.Request)
+	GetSprintCards(w http.ResponseWriter, r *http.Request)
+	AddSprintCard(w http.ResponseWriter, r *http.Request)
+	RemoveSprintCard(w http.ResponseWriter, r *http.Request)
+	CloseSprint(w http.ResponseWriter, r *http.Request)
+	GetSprintBurndown(w http.ResponseWriter, r *http.Request)
+
+	CreateCardTemplate(w http.ResponseWriter, r *http.Request)
+	GetCardTemplate(w http.ResponseWriter, r *http.Request)
+	GetBoardCardTemplates(w http.ResponseWriter, r *http.Request)
+	UpdateCardTemplate(w http.ResponseWriter, r *http.Request)
+	DeleteCardTemplate(w http.ResponseWriter, r *http.Request)
+
+	CreateWorkspace(w http.ResponseWriter, r *http.Request)
+	GetWorkspaces(w http.ResponseWriter, r *http.Request)
+	GetWorkspaceMembers(w http.ResponseWriter, r *http.Request)
+	AddWorkspaceMember(w http.ResponseWriter, r *http.Request)
+	UpdateWorkspaceMember(w http.ResponseWriter, r *http.Request)
+	RemoveWorkspaceMember(w http.ResponseWriter, r *http.Request)
+	GetWorkspaceBoards(w http.ResponseWriter, r *http.Request)
+
+	RegenerateCalendarToken(w http.ResponseWriter, r *http.Request)
+	GetCalendarFeed(w http.ResponseWriter, r *http.Request)
+
+	WatchBoard(w http.ResponseWriter, r *http.Request)
+
+	TransferBoard(w http.ResponseWriter, r *http.Request)
+	DeleteUser(w http.ResponseWriter, r *http.Request)
+
+	GetUserQuota(w http.ResponseWriter, r *http.Request)
+	SetQuotaOverride(w http.ResponseWriter, r *http.Request)
+	DeleteQuotaOverride(w http.ResponseWriter, r *http.Request)
 }
@@ -1,12 +1,17 @@
 package v1
 
 import (
+	"aggregator/internal/common/markdown"
 	"aggregator/internal/dto"
 	"aggregator/internal/middleware"
+	"aggregator/internal/service/todo"
+	"aggregator/internal/service/user"
 	"aggregator/internal/usecase"
+	"context"
 	"encoding/json"
 	"errors"
 	"net/http"
+	"strconv"
 	"time"
 
 	"github.com/google/uuid"
@@ -21,6 +26,17 @@ var (
 	ErrBadUserID          error = errors.New("couldn't parse userID")
 	ErrNoRole             error = errors.New("couldn't get role from context")
 	ErrNotAdmin           error = errors.New("not admin")
+	ErrNoIfMatch          error = errors.New("If-Match header is required")
+	ErrInvalidIfMatch     error = errors.New("invalid If-Match header")
+	ErrNoStreaming        error = errors.New("streaming is not supported")
+	ErrInvalidKind        error = errors.New("invalid kind, expected event or todo")
+	ErrInvalidBoardSort   error = errors.New("invalid sort, expected created or starred")
+	ErrInvalidInboxLimit  error = errors.New("invalid limit, expected 1 to 200")
+)
+
+const (
+	defaultInboxLimit = 50
+	maxInboxLimit     = 200
 )
 
 type AggregatorHandler struct {
@@ -120,7 +136,36 @@ func (h *AggregatorHandler) GetBoards(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	boards, err := h.uc.GetBoards(r.Context(), userID)
+	switch r.URL.Query().Get("sort") {
+	case "", dto.BoardsByCreation:
+		boards, err := h.uc.GetBoards(r.Context(), userID)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusConflict)
+			return
+		}
+
+		json.NewEncoder(w).Encode(boards)
+	case dto.BoardsByStar:
+		boards, err := h.uc.GetMarkedBoards(r.Context(), userID)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusConflict)
+			return
+		}
+
+		json.NewEncoder(w).Encode(boards)
+	default:
+		http.Error(w, ErrInvalidBoardSort.Error(), http.StatusBadRequest)
+	}
+}
+
+func (h *AggregatorHandler) GetRecentBoards(w http.ResponseWriter, r *http.Request) {
+	userID, ok := middleware.GetUserIDFromContext(r.Context())
+	if !ok {
+		http.Error(w, ErrNoUserID.Error(), http.StatusUnauthorized)
+		return
+	}
+
+	boards, err := h.uc.GetRecentBoards(r.Context(), userID)
 	if err != nil {
 		http.Error(w, err.Error(), http.StatusConflict)
 		return
@@ -129,27 +174,235 @@ func (h *AggregatorHandler) GetBoards(w http.ResponseWriter, r *http.Request) {
 	json.NewEncoder(w).Encode(boards)
 }
 
+func (h *AggregatorHandler) StarBoard(w http.ResponseWriter, r *http.Request) {
+	userID, ok := middleware.GetUserIDFromContext(r.Context())
+	if !ok {
+		http.Error(w, ErrNoUserID.Error(), http.StatusUnauthorized)
+		return
+	}
+
+	err := h.uc.StarBoard(r.Context(), userID, mux.Vars(r)["id"])
+	if errors.Is(err, todo.ErrBoardAccess) {
+		http.Error(w, err.Error(), http.StatusForbidden)
+		return
+	}
+
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusConflict)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *AggregatorHandler) UnstarBoard(w http.ResponseWriter, r *http.Request) {
+	userID, ok := middleware.GetUserIDFromContext(r.Context())
+	if !ok {
+		http.Error(w, ErrNoUserID.Error(), http.StatusUnauthorized)
+		return
+	}
+
+	err := h.uc.UnstarBoard(r.Context(), userID, mux.Vars(r)["id"])
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusConflict)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *AggregatorHandler) AddBoardWatch(w http.ResponseWriter, r *http.Request) {
+	h.changeWatch(w, r, h.uc.AddBoardWatch)
+}
+
+func (h *AggregatorHandler) RemoveBoardWatch(w http.ResponseWriter, r *http.Request) {
+	h.changeWatch(w, r, h.uc.RemoveBoardWatch)
+}
+
+func (h *AggregatorHandler) AddCardWatch(w http.ResponseWriter, r *http.Request) {
+	h.changeWatch(w, r, h.uc.AddCardWatch)
+}
+
+func (h *AggregatorHandler) RemoveCardWatch(w http.ResponseWriter, r *http.Request) {
+	h.changeWatch(w, r, h.uc.RemoveCardWatch)
+}
+
+// changeWatch has the caller watch, or stop watching, the board or card in
+// the path through change.
+func (h *AggregatorHandler) changeWatch(w http.ResponseWriter, r *http.Request, change func(ctx context.Context, userID, id string) error) {
+	userID, ok := middleware.GetUserIDFromContext(r.Context())
+	if !ok {
+		http.Error(w, ErrNoUserID.Error(), http.StatusUnauthorized)
+		return
+	}
+
+	err := change(r.Context(), userID, mux.Vars(r)["id"])
+	if err != nil {
+		http.Error(w, err.Error(), watchStatus(err))
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// watchStatus is the status of a failed watch change or event stream. Only
+// an existing watch is a conflict; the todo service failing otherwise is a
+// bad gateway.
+func watchStatus(err error) int {
+	switch {
+	case errors.Is(err, todo.ErrInvalidWatch):
+		return http.StatusBadRequest
+	case errors.Is(err, todo.ErrBoardAccess):
+		return http.StatusForbidden
+	case errors.Is(err, todo.ErrBoardNotFound), errors.Is(err, todo.ErrCardNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, todo.ErrWatchExists):
+		return http.StatusConflict
+	}
+
+	return http.StatusBadGateway
+}
+
+func (h *AggregatorHandler) GetNotifications(w http.ResponseWriter, r *http.Request) {
+	userID, ok := middleware.GetUserIDFromContext(r.Context())
+	if !ok {
+		http.Error(w, ErrNoUserID.Error(), http.StatusUnauthorized)
+		return
+	}
+
+	query := r.URL.Query()
+
+	limit := defaultInboxLimit
+	if limitStr := query.Get("limit"); limitStr != "" {
+		var err error
+		limit, err = strconv.Atoi(limitStr)
+		if err != nil || limit < 1 || limit > maxInboxLimit {
+			http.Error(w, ErrInvalidInboxLimit.Error(), http.StatusBadRequest)
+			return
+		}
+	}
+
+	notifications, err := h.uc.GetNotifications(r.Context(), userID, query.Get("unread") == "true", limit)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusConflict)
+		return
+	}
+
+	json.NewEncoder(w).Encode(notifications)
+}
+
+func (h *AggregatorHandler) MarkNotificationsRead(w http.ResponseWriter, r *http.Request) {
+	var req dto.MarkNotificationsReadRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, ErrInvalidRequestBody.Error(), http.StatusBadRequest)
+		return
+	}
+
+	userID, ok := middleware.GetUserIDFromContext(r.Context())
+	if !ok {
+		http.Error(w, ErrNoUserID.Error(), http.StatusUnauthorized)
+		return
+	}
+
+	err := h.uc.MarkNotificationsRead(r.Context(), userID, req.IDs)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusConflict)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
 func (h *AggregatorHandler) GetBoard(w http.ResponseWriter, r *http.Request) {
 	boardID := mux.Vars(r)["id"]
 
-	columns, err := h.uc.GetColumns(r.Context(), boardID)
+	userID, ok := middleware.GetUserIDFromContext(r.Context())
+	if !ok {
+		http.Error(w, ErrNoUserID.Error(), http.StatusUnauthorized)
+		return
+	}
+
+	snapshot, err := h.uc.GetBoardSnapshot(r.Context(), userID, boardID)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusConflict)
+		return
+	}
+
+	for _, lane := range snapshot.Swimlanes {
+		for _, column := range lane.Columns {
+			renderDescriptions(r, column.Cards)
+		}
+	}
+
+	json.NewEncoder(w).Encode(snapshot)
+}
+
+func (h *AggregatorHandler) GetBoardByID(w http.ResponseWriter, r *http.Request) {
+	boardID := mux.Vars(r)["id"]
+
+	board, err := h.uc.GetBoard(r.Context(), boardID)
 	if err != nil {
 		http.Error(w, err.Error(), http.StatusConflict)
 		return
 	}
 
-	json.NewEncoder(w).Encode(columns)
+	w.Header().Set("ETag", etag(board.Version))
+	json.NewEncoder(w).Encode(board)
 }
 
 func (h *AggregatorHandler) GetColumn(w http.ResponseWriter, r *http.Request) {
+	query := dto.CardQueryFromValues(r.URL.Query())
+	query.ColumnID = mux.Vars(r)["id"]
+
+	cards, err := h.uc.GetCards(r.Context(), query)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusConflict)
+		return
+	}
+
+	renderDescriptions(r, cards)
+
+	json.NewEncoder(w).Encode(cards)
+}
+
+func (h *AggregatorHandler) GetColumnByID(w http.ResponseWriter, r *http.Request) {
 	columnID := mux.Vars(r)["id"]
 
-	cards, err := h.uc.GetCards(r.Context(), columnID)
+	column, err := h.uc.GetColumn(r.Context(), columnID)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusConflict)
+		return
+	}
+
+	w.Header().Set("ETag", etag(column.Version))
+	json.NewEncoder(w).Encode(column)
+}
+
+func (h *AggregatorHandler) GetSwimlaneByID(w http.ResponseWriter, r *http.Request) {
+	swimlaneID := mux.Vars(r)["id"]
+
+	swimlane, err := h.uc.GetSwimlane(r.Context(), swimlaneID)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusConflict)
+		return
+	}
+
+	w.Header().Set("ETag", etag(swimlane.Version))
+	json.NewEncoder(w).Encode(swimlane)
+}
+
+func (h *AggregatorHandler) GetBoardCards(w http.ResponseWriter, r *http.Request) {
+	query := dto.CardQueryFromValues(r.URL.Query())
+	query.BoardID = mux.Vars(r)["id"]
+
+	cards, err := h.uc.GetCards(r.Context(), query)
 	if err != nil {
 		http.Error(w, err.Error(), http.StatusConflict)
 		return
 	}
 
+	renderDescriptions(r, cards)
+
 	json.NewEncoder(w).Encode(cards)
 }
 
@@ -162,19 +415,48 @@ func (h *AggregatorHandler) GetCard(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if renderHTML(r) {
+		card.DescriptionHTML = markdown.Render(card.Description)
+	}
+
+	w.Header().Set("ETag", etag(card.Version))
 	json.NewEncoder(w).Encode(card)
 }
 
-func (h *AggregatorHandler) GetStats(w http.ResponseWriter, r *http.Request) {
-	// XXX: Role check better should be in another role checking middleware
-	role, ok := middleware.GetRoleFromContext(r.Context())
-	if !ok {
-		http.Error(w, ErrNoRole.Error(), http.StatusUnauthorized)
+// GetCardChildren lists the sub-cards of a card, filtered like the cards of
+// a board.
+func (h *AggregatorHandler) GetCardChildren(w http.ResponseWriter, r *http.Request) {
+	query := dto.CardQueryFromValues(r.URL.Query())
+	query.ParentID = mux.Vars(r)["id"]
+
+	cards, err := h.uc.GetCards(r.Context(), query)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusConflict)
 		return
 	}
 
-	if role != "admin" {
-		http.Error(w, ErrNotAdmin.Error(), http.StatusUnauthorized)
+	renderDescriptions(r, cards)
+
+	json.NewEncoder(w).Encode(cards)
+}
+
+// GetCardAncestors lists the parent of a card up to the root of its tree.
+func (h *AggregatorHandler) GetCardAncestors(w http.ResponseWriter, r *http.Request) {
+	cardID := mux.Vars(r)["id"]
+
+	cards, err := h.uc.GetCardAncestors(r.Context(), cardID)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusConflict)
+		return
+	}
+
+	renderDescriptions(r, cards)
+
+	json.NewEncoder(w).Encode(cards)
+}
+
+func (h *AggregatorHandler) GetStats(w http.ResponseWriter, r *http.Request) {
+	if !requireAdmin(w, r) {
 		return
 	}
 
@@ -220,11 +502,23 @@ func (h *AggregatorHandler) CreateBoard(w http.ResponseWriter, r *http.Request)
 	}
 
 	board := dto.Board{
-		UserID: userID,
-		Title:  req.Title,
+		UserID:      userID,
+		WorkspaceID: req.WorkspaceID,
+		Title:       req.Title,
 	}
 
 	err = h.uc.CreateBoard(r.Context(), board)
+
+	if errors.Is(err, todo.ErrWorkspaceAccess) {
+		http.Error(w, err.Error(), http.StatusForbidden)
+		return
+	}
+
+	if errors.Is(err, todo.ErrQuotaExceeded) {
+		http.Error(w, err.Error(), http.StatusUnprocessableEntity)
+		return
+	}
+
 	if err != nil {
 		http.Error(w, err.Error(), http.StatusConflict)
 		return
@@ -256,10 +550,52 @@ func (h *AggregatorHandler) CreateColumn(w http.ResponseWriter, r *http.Request)
 		UserID:  userID,
 		BoardID: req.BoardID,
 		Title:   req.Title,
+		Done:    req.Done,
 	}
 
 	err = h.uc.CreateColumn(r.Context(), column)
 
+	if errors.Is(err, todo.ErrQuotaExceeded) {
+		http.Error(w, err.Error(), http.StatusUnprocessableEntity)
+		return
+	}
+
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusConflict)
+		return
+	}
+
+	w.WriteHeader(http.StatusCreated)
+}
+
+func (h *AggregatorHandler) CreateSwimlane(w http.ResponseWriter, r *http.Request) {
+	var req dto.CreateSwimlaneRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, ErrInvalidRequestBody.Error(), http.StatusBadRequest)
+		return
+	}
+
+	userIDstr, ok := middleware.GetUserIDFromContext(r.Context())
+	if !ok {
+		http.Error(w, ErrNoUserID.Error(), http.StatusUnauthorized)
+		return
+	}
+
+	userID, err := uuid.Parse(userIDstr)
+	if err != nil {
+		http.Error(w, ErrBadUserID.Error(), http.StatusUnauthorized)
+		return
+	}
+
+	swimlane := dto.Swimlane{
+		UserID:   userID,
+		BoardID:  req.BoardID,
+		Title:    req.Title,
+		Position: req.Position,
+	}
+
+	err = h.uc.CreateSwimlane(r.Context(), swimlane)
+
 	if err != nil {
 		http.Error(w, err.Error(), http.StatusConflict)
 		return
@@ -290,18 +626,31 @@ func (h *AggregatorHandler) CreateCard(w http.ResponseWriter, r *http.Request) {
 	card := dto.Card{
 		UserID:      userID,
 		ColumnID:    req.ColumnID,
+		SwimlaneID:  req.SwimlaneID,
+		ParentID:    req.ParentID,
 		Title:       req.Title,
 		Description: req.Description,
+		Priority:    req.Priority,
+		AssigneeID:  req.AssigneeID,
+		DueDate:     req.DueDate,
+		Labels:      req.Labels,
 	}
 
-	err = h.uc.CreateCard(r.Context(), card)
+	var created *dto.Card
+	if req.TemplateID != nil {
+		created, err = h.uc.CreateCardFromTemplate(r.Context(), req.TemplateID.String(), card)
+	} else {
+		created, err = h.uc.CreateCard(r.Context(), card)
+	}
 
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusConflict)
+		http.Error(w, err.Error(), templateStatus(err))
 		return
 	}
 
 	w.WriteHeader(http.StatusCreated)
+
+	json.NewEncoder(w).Encode(created)
 }
 
 func (h *AggregatorHandler) UpdateBoard(w http.ResponseWriter, r *http.Request) {
@@ -311,16 +660,30 @@ func (h *AggregatorHandler) UpdateBoard(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
+	version, status, err := ifMatch(r)
+	if err != nil {
+		http.Error(w, err.Error(), status)
+		return
+	}
+
 	board := dto.Board{
-		ID:    req.ID,
-		Title: req.Title,
+		ID:      req.ID,
+		Title:   req.Title,
+		Version: version,
+	}
+
+	err = h.uc.UpdateBoard(r.Context(), &board)
+	if errors.Is(err, todo.ErrVersionConflict) {
+		http.Error(w, err.Error(), http.StatusPreconditionFailed)
+		return
 	}
 
-	err := h.uc.UpdateBoard(r.Context(), &board)
 	if err != nil {
 		http.Error(w, err.Error(), http.StatusConflict)
 		return
 	}
+
+	w.Header().Set("ETag", etag(board.Version))
 }
 
 func (h *AggregatorHandler) UpdateColumn(w http.ResponseWriter, r *http.Request) {
@@ -342,19 +705,70 @@ func (h *AggregatorHandler) UpdateColumn(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
+	version, status, err := ifMatch(r)
+	if err != nil {
+		http.Error(w, err.Error(), status)
+		return
+	}
+
 	column := dto.Column{
 		ID:      req.ID,
 		UserID:  userID,
 		BoardID: req.BoardID,
 		Title:   req.Title,
+		Done:    req.Done,
+		Version: version,
 	}
 
 	err = h.uc.UpdateColumn(r.Context(), &column)
 
+	if errors.Is(err, todo.ErrVersionConflict) {
+		http.Error(w, err.Error(), http.StatusPreconditionFailed)
+		return
+	}
+
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusConflict)
+		return
+	}
+
+	w.Header().Set("ETag", etag(column.Version))
+}
+
+func (h *AggregatorHandler) UpdateSwimlane(w http.ResponseWriter, r *http.Request) {
+	var req dto.UpdateSwimlaneRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, ErrInvalidRequestBody.Error(), http.StatusBadRequest)
+		return
+	}
+
+	version, status, err := ifMatch(r)
+	if err != nil {
+		http.Error(w, err.Error(), status)
+		return
+	}
+
+	swimlane := dto.Swimlane{
+		ID:       req.ID,
+		BoardID:  req.BoardID,
+		Title:    req.Title,
+		Position: req.Position,
+		Version:  version,
+	}
+
+	err = h.uc.UpdateSwimlane(r.Context(), &swimlane)
+
+	if errors.Is(err, todo.ErrVersionConflict) {
+		http.Error(w, err.Error(), http.StatusPreconditionFailed)
+		return
+	}
+
 	if err != nil {
 		http.Error(w, err.Error(), http.StatusConflict)
 		return
 	}
+
+	w.Header().Set("ETag", etag(swimlane.Version))
 }
 
 func (h *AggregatorHandler) UpdateCard(w http.ResponseWriter, r *http.Request) {
@@ -376,26 +790,95 @@ func (h *AggregatorHandler) UpdateCard(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	version, status, err := ifMatch(r)
+	if err != nil {
+		http.Error(w, err.Error(), status)
+		return
+	}
+
 	card := dto.Card{
 		ID:          req.ID,
 		UserID:      userID,
 		ColumnID:    req.ColumnID,
+		SwimlaneID:  req.SwimlaneID,
 		Title:       req.Title,
 		Description: req.Description,
+		Priority:    req.Priority,
+		AssigneeID:  req.AssigneeID,
+		DueDate:     req.DueDate,
+		Labels:      req.Labels,
+		Version:     version,
 	}
 
 	err = h.uc.UpdateCard(r.Context(), &card)
 
+	if errors.Is(err, todo.ErrVersionConflict) {
+		http.Error(w, err.Error(), http.StatusPreconditionFailed)
+		return
+	}
+
+	if errors.Is(err, todo.ErrQuotaExceeded) {
+		http.Error(w, err.Error(), http.StatusUnprocessableEntity)
+		return
+	}
+
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusConflict)
+		return
+	}
+
+	w.Header().Set("ETag", etag(card.Version))
+}
+
+func (h *AggregatorHandler) SetCardParent(w http.ResponseWriter, r *http.Request) {
+	var req dto.SetCardParentRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, ErrInvalidRequestBody.Error(), http.StatusBadRequest)
+		return
+	}
+
+	version, status, err := ifMatch(r)
+	if err != nil {
+		http.Error(w, err.Error(), status)
+		return
+	}
+
+	card := dto.Card{
+		ID:       req.ID,
+		ParentID: req.ParentID,
+		Version:  version,
+	}
+
+	err = h.uc.SetCardParent(r.Context(), &card)
+
+	if errors.Is(err, todo.ErrVersionConflict) {
+		http.Error(w, err.Error(), http.StatusPreconditionFailed)
+		return
+	}
+
 	if err != nil {
 		http.Error(w, err.Error(), http.StatusConflict)
 		return
 	}
+
+	w.Header().Set("ETag", etag(card.Version))
 }
 
 func (h *AggregatorHandler) DeleteBoard(w http.ResponseWriter, r *http.Request) {
 	id := mux.Vars(r)["id"]
 
-	err := h.uc.DeleteBoard(r.Context(), id)
+	version, status, err := ifMatch(r)
+	if err != nil {
+		http.Error(w, err.Error(), status)
+		return
+	}
+
+	err = h.uc.DeleteBoard(r.Context(), id, version)
+
+	if errors.Is(err, todo.ErrVersionConflict) {
+		http.Error(w, err.Error(), http.StatusPreconditionFailed)
+		return
+	}
 
 	if err != nil {
 		http.Error(w, err.Error(), http.StatusConflict)
@@ -406,7 +889,40 @@ func (h *AggregatorHandler) DeleteBoard(w http.ResponseWriter, r *http.Request)
 func (h *AggregatorHandler) DeleteColumn(w http.ResponseWriter, r *http.Request) {
 	id := mux.Vars(r)["id"]
 
-	err := h.uc.DeleteColumn(r.Context(), id)
+	version, status, err := ifMatch(r)
+	if err != nil {
+		http.Error(w, err.Error(), status)
+		return
+	}
+
+	err = h.uc.DeleteColumn(r.Context(), id, version)
+
+	if errors.Is(err, todo.ErrVersionConflict) {
+		http.Error(w, err.Error(), http.StatusPreconditionFailed)
+		return
+	}
+
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusConflict)
+		return
+	}
+}
+
+func (h *AggregatorHandler) DeleteSwimlane(w http.ResponseWriter, r *http.Request) {
+	id := mux.Vars(r)["id"]
+
+	version, status, err := ifMatch(r)
+	if err != nil {
+		http.Error(w, err.Error(), status)
+		return
+	}
+
+	err = h.uc.DeleteSwimlane(r.Context(), id, version)
+
+	if errors.Is(err, todo.ErrVersionConflict) {
+		http.Error(w, err.Error(), http.StatusPreconditionFailed)
+		return
+	}
 
 	if err != nil {
 		http.Error(w, err.Error(), http.StatusConflict)
@@ -417,10 +933,1049 @@ func (h *AggregatorHandler) DeleteColumn(w http.ResponseWriter, r *http.Request)
 func (h *AggregatorHandler) DeleteCard(w http.ResponseWriter, r *http.Request) {
 	id := mux.Vars(r)["id"]
 
-	err := h.uc.DeleteCard(r.Context(), id)
+	version, status, err := ifMatch(r)
+	if err != nil {
+		http.Error(w, err.Error(), status)
+		return
+	}
+
+	err = h.uc.DeleteCard(r.Context(), id, version)
+
+	if errors.Is(err, todo.ErrVersionConflict) {
+		http.Error(w, err.Error(), http.StatusPreconditionFailed)
+		return
+	}
+
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusConflict)
+		return
+	}
+}
+
+func (h *AggregatorHandler) BulkCards(w http.ResponseWriter, r *http.Request) {
+	var req dto.BulkCardsRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, ErrInvalidRequestBody.Error(), http.StatusBadRequest)
+		return
+	}
+
+	res, err := h.uc.BulkCards(r.Context(), req)
+
+	if errors.Is(err, todo.ErrInvalidBulk) {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusConflict)
+		return
+	}
+
+	json.NewEncoder(w).Encode(res)
+}
+
+func (h *AggregatorHandler) MoveColumn(w http.ResponseWriter, r *http.Request) {
+	var req dto.MoveColumnRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, ErrInvalidRequestBody.Error(), http.StatusBadRequest)
+		return
+	}
+
+	userID, status, err := userIDFromContext(r)
+	if err != nil {
+		http.Error(w, err.Error(), status)
+		return
+	}
+
+	version, status, err := ifMatch(r)
+	if err != nil {
+		http.Error(w, err.Error(), status)
+		return
+	}
+
+	column := dto.Column{
+		ID:      req.ID,
+		UserID:  userID,
+		BoardID: req.BoardID,
+		Version: version,
+	}
+
+	err = h.uc.MoveColumn(r.Context(), &column)
+
+	if err != nil {
+		http.Error(w, err.Error(), reorganiseStatus(err))
+		return
+	}
+
+	w.Header().Set("ETag", etag(column.Version))
+
+	json.NewEncoder(w).Encode(column)
+}
+
+func (h *AggregatorHandler) MergeBoards(w http.ResponseWriter, r *http.Request) {
+	var req dto.MergeBoardsRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, ErrInvalidRequestBody.Error(), http.StatusBadRequest)
+		return
+	}
+
+	userID, status, err := userIDFromContext(r)
+	if err != nil {
+		http.Error(w, err.Error(), status)
+		return
+	}
+	req.UserID = userID
+
+	merge, err := h.uc.MergeBoards(r.Context(), req)
+
+	if err != nil {
+		http.Error(w, err.Error(), reorganiseStatus(err))
+		return
+	}
+
+	json.NewEncoder(w).Encode(merge)
+}
+
+// reorganiseStatus is the status of a failed column move or board merge.
+func reorganiseStatus(err error) int {
+	switch {
+	case errors.Is(err, todo.ErrInvalidMove):
+		return http.StatusBadRequest
+	case errors.Is(err, todo.ErrBoardAccess):
+		return http.StatusForbidden
+	case errors.Is(err, todo.ErrVersionConflict):
+		return http.StatusPreconditionFailed
+	case errors.Is(err, todo.ErrQuotaExceeded):
+		return http.StatusUnprocessableEntity
+	case errors.Is(err, todo.ErrBoardAccess):
+		return http.StatusForbidden
+	}
+
+	return http.StatusConflict
+}
+
+func (h *AggregatorHandler) StartTimer(w http.ResponseWriter, r *http.Request) {
+	var req dto.StartTimerRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, ErrInvalidRequestBody.Error(), http.StatusBadRequest)
+		return
+	}
+
+	userID, status, err := userIDFromContext(r)
+	if err != nil {
+		http.Error(w, err.Error(), status)
+		return
+	}
+	req.UserID = userID
+
+	entry, err := h.uc.StartTimer(r.Context(), req)
+
+	if errors.Is(err, todo.ErrBoardAccess) {
+		http.Error(w, err.Error(), http.StatusForbidden)
+		return
+	}
+
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusConflict)
+		return
+	}
+
+	w.WriteHeader(http.StatusCreated)
+
+	json.NewEncoder(w).Encode(entry)
+}
+
+func (h *AggregatorHandler) StopTimer(w http.ResponseWriter, r *http.Request) {
+	userID, status, err := userIDFromContext(r)
+	if err != nil {
+		http.Error(w, err.Error(), status)
+		return
+	}
+
+	entry, err := h.uc.StopTimer(r.Context(), dto.StopTimerRequest{UserID: userID})
+
+	if errors.Is(err, todo.ErrNoTimerRunning) {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusConflict)
+		return
+	}
+
+	json.NewEncoder(w).Encode(entry)
+}
+
+func (h *AggregatorHandler) LogTime(w http.ResponseWriter, r *http.Request) {
+	var req dto.LogTimeRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, ErrInvalidRequestBody.Error(), http.StatusBadRequest)
+		return
+	}
+
+	userID, status, err := userIDFromContext(r)
+	if err != nil {
+		http.Error(w, err.Error(), status)
+		return
+	}
+	req.UserID = userID
+
+	entry, err := h.uc.LogTime(r.Context(), req)
+
+	if errors.Is(err, todo.ErrInvalidTime) {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if errors.Is(err, todo.ErrBoardAccess) {
+		http.Error(w, err.Error(), http.StatusForbidden)
+		return
+	}
+
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusConflict)
+		return
+	}
+
+	w.WriteHeader(http.StatusCreated)
+
+	json.NewEncoder(w).Encode(entry)
+}
+
+func (h *AggregatorHandler) GetCardTimeEntries(w http.ResponseWriter, r *http.Request) {
+	cardID := mux.Vars(r)["id"]
+
+	entries, err := h.uc.GetCardTimeEntries(r.Context(), cardID)
+
+	if errors.Is(err, todo.ErrBoardAccess) {
+		http.Error(w, err.Error(), http.StatusForbidden)
+		return
+	}
+
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusConflict)
+		return
+	}
+
+	json.NewEncoder(w).Encode(entries)
+}
+
+// GetTimeReport reports the time logged on the board named by board_id, or
+// else the time the caller logged, by day from one DD-MM-YYYY date to
+// another.
+func (h *AggregatorHandler) GetTimeReport(w http.ResponseWriter, r *http.Request) {
+	values := r.URL.Query()
+
+	query := dto.TimeReportQuery{
+		BoardID: values.Get("board_id"),
+		From:    values.Get("from"),
+		To:      values.Get("to"),
+	}
+
+	if query.BoardID == "" {
+		userID, ok := middleware.GetUserIDFromContext(r.Context())
+		if !ok {
+			http.Error(w, ErrNoUserID.Error(), http.StatusUnauthorized)
+			return
+		}
+		query.UserID = userID
+	}
+
+	rows, err := h.uc.GetTimeReport(r.Context(), query)
+
+	if errors.Is(err, todo.ErrInvalidTime) {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if errors.Is(err, todo.ErrBoardAccess) {
+		http.Error(w, err.Error(), http.StatusForbidden)
+		return
+	}
+
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusConflict)
+		return
+	}
+
+	json.NewEncoder(w).Encode(rows)
+}
+
+// GetBoardAnalytics reports on the flow of cards across a board from one
+// DD-MM-YYYY date to another.
+func (h *AggregatorHandler) GetBoardAnalytics(w http.ResponseWriter, r *http.Request) {
+	boardID := mux.Vars(r)["id"]
+	values := r.URL.Query()
+
+	analytics, err := h.uc.GetBoardAnalytics(r.Context(), boardID, values.Get("from"), values.Get("to"))
+
+	if errors.Is(err, todo.ErrInvalidPeriod) {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if errors.Is(err, todo.ErrBoardAccess) {
+		http.Error(w, err.Error(), http.StatusForbidden)
+		return
+	}
 
 	if err != nil {
 		http.Error(w, err.Error(), http.StatusConflict)
 		return
 	}
+
+	json.NewEncoder(w).Encode(analytics)
+}
+
+// GetBoardStats sums up a board for a member of it from one DD-MM-YYYY date
+// to another; stale_days sets how long a card goes untouched to be stale.
+func (h *AggregatorHandler) GetBoardStats(w http.ResponseWriter, r *http.Request) {
+	userID, ok := middleware.GetUserIDFromContext(r.Context())
+	if !ok {
+		http.Error(w, ErrNoUserID.Error(), http.StatusUnauthorized)
+		return
+	}
+
+	boardID := mux.Vars(r)["id"]
+	values := r.URL.Query()
+
+	stats, err := h.uc.GetBoardStats(r.Context(), userID, boardID, values.Get("from"), values.Get("to"), values.Get("stale_days"))
+
+	if err != nil {
+		http.Error(w, err.Error(), statsStatus(err))
+		return
+	}
+
+	json.NewEncoder(w).Encode(stats)
+}
+
+// statsStatus is the status of a failed board stats request.
+func statsStatus(err error) int {
+	switch {
+	case errors.Is(err, todo.ErrInvalidStats):
+		return http.StatusBadRequest
+	case errors.Is(err, todo.ErrBoardAccess):
+		return http.StatusForbidden
+	case errors.Is(err, todo.ErrBoardNotFound):
+		return http.StatusNotFound
+	}
+
+	return http.StatusConflict
+}
+
+func (h *AggregatorHandler) CreateSprint(w http.ResponseWriter, r *http.Request) {
+	var req dto.CreateSprintRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, ErrInvalidRequestBody.Error(), http.StatusBadRequest)
+		return
+	}
+
+	sprint, err := h.uc.CreateSprint(r.Context(), req)
+
+	if errors.Is(err, todo.ErrInvalidSprint) {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if errors.Is(err, todo.ErrBoardAccess) {
+		http.Error(w, err.Error(), http.StatusForbidden)
+		return
+	}
+
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusConflict)
+		return
+	}
+
+	w.WriteHeader(http.StatusCreated)
+
+	json.NewEncoder(w).Encode(sprint)
+}
+
+func (h *AggregatorHandler) GetSprint(w http.ResponseWriter, r *http.Request) {
+	sprintID := mux.Vars(r)["id"]
+
+	sprint, err := h.uc.GetSprint(r.Context(), sprintID)
+
+	if errors.Is(err, todo.ErrBoardAccess) {
+		http.Error(w, err.Error(), http.StatusForbidden)
+		return
+	}
+
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusConflict)
+		return
+	}
+
+	json.NewEncoder(w).Encode(sprint)
+}
+
+func (h *AggregatorHandler) GetBoardSprints(w http.ResponseWriter, r *http.Request) {
+	boardID := mux.Vars(r)["id"]
+
+	sprints, err := h.uc.GetBoardSprints(r.Context(), boardID)
+
+	if errors.Is(err, todo.ErrBoardAccess) {
+		http.Error(w, err.Error(), http.StatusForbidden)
+		return
+	}
+
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusConflict)
+		return
+	}
+
+	json.NewEncoder(w).Encode(sprints)
+}
+
+func (h *AggregatorHandler) GetSprintCards(w http.ResponseWriter, r *http.Request) {
+	sprintID := mux.Vars(r)["id"]
+
+	cards, err := h.uc.GetSprintCards(r.Context(), sprintID)
+
+	if errors.Is(err, todo.ErrBoardAccess) {
+		http.Error(w, err.Error(), http.StatusForbidden)
+		return
+	}
+
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusConflict)
+		return
+	}
+
+	json.NewEncoder(w).Encode(cards)
+}
+
+func (h *AggregatorHandler) AddSprintCard(w http.ResponseWriter, r *http.Request) {
+	sprintID := mux.Vars(r)["id"]
+
+	var req dto.AddSprintCardRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, ErrInvalidRequestBody.Error(), http.StatusBadRequest)
+		return
+	}
+
+	card, err := h.uc.AddSprintCard(r.Context(), sprintID, req)
+
+	if errors.Is(err, todo.ErrInvalidSprint) {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if errors.Is(err, todo.ErrBoardAccess) {
+		http.Error(w, err.Error(), http.StatusForbidden)
+		return
+	}
+
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusConflict)
+		return
+	}
+
+	w.WriteHeader(http.StatusCreated)
+
+	json.NewEncoder(w).Encode(card)
+}
+
+func (h *AggregatorHandler) RemoveSprintCard(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+
+	err := h.uc.RemoveSprintCard(r.Context(), vars["id"], vars["card_id"])
+
+	if errors.Is(err, todo.ErrBoardAccess) {
+		http.Error(w, err.Error(), http.StatusForbidden)
+		return
+	}
+
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusConflict)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// CloseSprint closes a sprint and carries its unfinished cards over to the
+// next one. The body is optional.
+func (h *AggregatorHandler) CloseSprint(w http.ResponseWriter, r *http.Request) {
+	sprintID := mux.Vars(r)["id"]
+
+	var req dto.CloseSprintRequest
+	if r.ContentLength != 0 {
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, ErrInvalidRequestBody.Error(), http.StatusBadRequest)
+			return
+		}
+	}
+
+	closed, err := h.uc.CloseSprint(r.Context(), sprintID, req)
+
+	if errors.Is(err, todo.ErrInvalidSprint) {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if errors.Is(err, todo.ErrBoardAccess) {
+		http.Error(w, err.Error(), http.StatusForbidden)
+		return
+	}
+
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusConflict)
+		return
+	}
+
+	json.NewEncoder(w).Encode(closed)
+}
+
+func (h *AggregatorHandler) GetSprintBurndown(w http.ResponseWriter, r *http.Request) {
+	sprintID := mux.Vars(r)["id"]
+
+	points, err := h.uc.GetSprintBurndown(r.Context(), sprintID)
+
+	if errors.Is(err, todo.ErrBoardAccess) {
+		http.Error(w, err.Error(), http.StatusForbidden)
+		return
+	}
+
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusConflict)
+		return
+	}
+
+	json.NewEncoder(w).Encode(points)
+}
+
+func (h *AggregatorHandler) CreateCardTemplate(w http.ResponseWriter, r *http.Request) {
+	var req dto.CardTemplateRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, ErrInvalidRequestBody.Error(), http.StatusBadRequest)
+		return
+	}
+
+	userID, status, err := userIDFromContext(r)
+	if err != nil {
+		http.Error(w, err.Error(), status)
+		return
+	}
+	req.UserID = userID
+
+	template, err := h.uc.CreateCardTemplate(r.Context(), req)
+
+	if err != nil {
+		http.Error(w, err.Error(), templateStatus(err))
+		return
+	}
+
+	w.WriteHeader(http.StatusCreated)
+
+	json.NewEncoder(w).Encode(template)
+}
+
+func (h *AggregatorHandler) GetCardTemplate(w http.ResponseWriter, r *http.Request) {
+	templateID := mux.Vars(r)["id"]
+
+	template, err := h.uc.GetCardTemplate(r.Context(), templateID)
+	if err != nil {
+		http.Error(w, err.Error(), templateStatus(err))
+		return
+	}
+
+	json.NewEncoder(w).Encode(template)
+}
+
+func (h *AggregatorHandler) GetBoardCardTemplates(w http.ResponseWriter, r *http.Request) {
+	boardID := mux.Vars(r)["id"]
+
+	templates, err := h.uc.GetBoardCardTemplates(r.Context(), boardID)
+	if err != nil {
+		http.Error(w, err.Error(), templateStatus(err))
+		return
+	}
+
+	json.NewEncoder(w).Encode(templates)
+}
+
+func (h *AggregatorHandler) UpdateCardTemplate(w http.ResponseWriter, r *http.Request) {
+	templateID := mux.Vars(r)["id"]
+
+	var req dto.CardTemplateRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, ErrInvalidRequestBody.Error(), http.StatusBadRequest)
+		return
+	}
+
+	template, err := h.uc.UpdateCardTemplate(r.Context(), templateID, req)
+
+	if err != nil {
+		http.Error(w, err.Error(), templateStatus(err))
+		return
+	}
+
+	json.NewEncoder(w).Encode(template)
+}
+
+func (h *AggregatorHandler) DeleteCardTemplate(w http.ResponseWriter, r *http.Request) {
+	templateID := mux.Vars(r)["id"]
+
+	err := h.uc.DeleteCardTemplate(r.Context(), templateID)
+
+	if err != nil {
+		http.Error(w, err.Error(), templateStatus(err))
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func templateStatus(err error) int {
+	switch {
+	case errors.Is(err, todo.ErrInvalidCardTemplate):
+		return http.StatusBadRequest
+	case errors.Is(err, todo.ErrCardTemplateNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, todo.ErrQuotaExceeded):
+		return http.StatusUnprocessableEntity
+	}
+
+	return http.StatusConflict
+}
+
+func (h *AggregatorHandler) CreateWorkspace(w http.ResponseWriter, r *http.Request) {
+	var req dto.CreateWorkspaceRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, ErrInvalidRequestBody.Error(), http.StatusBadRequest)
+		return
+	}
+
+	userID, status, err := userIDFromContext(r)
+	if err != nil {
+		http.Error(w, err.Error(), status)
+		return
+	}
+	req.UserID = userID
+
+	workspace, err := h.uc.CreateWorkspace(r.Context(), req)
+
+	if err != nil {
+		http.Error(w, err.Error(), workspaceStatus(err))
+		return
+	}
+
+	w.WriteHeader(http.StatusCreated)
+
+	json.NewEncoder(w).Encode(workspace)
+}
+
+func (h *AggregatorHandler) GetWorkspaces(w http.ResponseWriter, r *http.Request) {
+	userID, ok := middleware.GetUserIDFromContext(r.Context())
+	if !ok {
+		http.Error(w, ErrNoUserID.Error(), http.StatusUnauthorized)
+		return
+	}
+
+	workspaces, err := h.uc.GetWorkspaces(r.Context(), userID)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusConflict)
+		return
+	}
+
+	json.NewEncoder(w).Encode(workspaces)
+}
+
+func (h *AggregatorHandler) GetWorkspaceMembers(w http.ResponseWriter, r *http.Request) {
+	userID, ok := middleware.GetUserIDFromContext(r.Context())
+	if !ok {
+		http.Error(w, ErrNoUserID.Error(), http.StatusUnauthorized)
+		return
+	}
+
+	members, err := h.uc.GetWorkspaceMembers(r.Context(), userID, mux.Vars(r)["id"])
+	if err != nil {
+		http.Error(w, err.Error(), workspaceStatus(err))
+		return
+	}
+
+	json.NewEncoder(w).Encode(members)
+}
+
+func (h *AggregatorHandler) AddWorkspaceMember(w http.ResponseWriter, r *http.Request) {
+	h.changeWorkspaceMember(w, r, h.uc.AddWorkspaceMember)
+}
+
+func (h *AggregatorHandler) UpdateWorkspaceMember(w http.ResponseWriter, r *http.Request) {
+	h.changeWorkspaceMember(w, r, h.uc.UpdateWorkspaceMember)
+}
+
+// changeWorkspaceMember adds a member or changes their role on behalf of
+// the caller, who has to be an admin of the workspace.
+func (h *AggregatorHandler) changeWorkspaceMember(
+	w http.ResponseWriter,
+	r *http.Request,
+	change func(ctx context.Context, workspaceID string, req dto.WorkspaceMemberRequest) error,
+) {
+	var req dto.WorkspaceMemberRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, ErrInvalidRequestBody.Error(), http.StatusBadRequest)
+		return
+	}
+
+	actorID, status, err := userIDFromContext(r)
+	if err != nil {
+		http.Error(w, err.Error(), status)
+		return
+	}
+	req.ActorID = actorID
+
+	err = change(r.Context(), mux.Vars(r)["id"], req)
+
+	if err != nil {
+		http.Error(w, err.Error(), workspaceStatus(err))
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// RemoveWorkspaceMember takes a member out of a workspace. Admins remove
+// anyone; other members only themselves.
+func (h *AggregatorHandler) RemoveWorkspaceMember(w http.ResponseWriter, r *http.Request) {
+	actorID, ok := middleware.GetUserIDFromContext(r.Context())
+	if !ok {
+		http.Error(w, ErrNoUserID.Error(), http.StatusUnauthorized)
+		return
+	}
+
+	vars := mux.Vars(r)
+
+	err := h.uc.RemoveWorkspaceMember(r.Context(), actorID, vars["id"], vars["user_id"])
+	if err != nil {
+		http.Error(w, err.Error(), workspaceStatus(err))
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// GetWorkspaceBoards lists every board of a workspace the caller is an
+// admin of.
+func (h *AggregatorHandler) GetWorkspaceBoards(w http.ResponseWriter, r *http.Request) {
+	userID, ok := middleware.GetUserIDFromContext(r.Context())
+	if !ok {
+		http.Error(w, ErrNoUserID.Error(), http.StatusUnauthorized)
+		return
+	}
+
+	boards, err := h.uc.GetWorkspaceBoards(r.Context(), userID, mux.Vars(r)["id"])
+	if err != nil {
+		http.Error(w, err.Error(), workspaceStatus(err))
+		return
+	}
+
+	json.NewEncoder(w).Encode(boards)
+}
+
+// workspaceStatus is the status of a failed workspace request.
+func workspaceStatus(err error) int {
+	switch {
+	case errors.Is(err, todo.ErrInvalidWorkspace):
+		return http.StatusBadRequest
+	case errors.Is(err, todo.ErrWorkspaceAccess):
+		return http.StatusForbidden
+	case errors.Is(err, todo.ErrWorkspaceNotFound):
+		return http.StatusNotFound
+	}
+
+	return http.StatusConflict
+}
+
+// RegenerateCalendarToken gives the caller a new calendar feed token; the
+// feed at the old one stops working.
+func (h *AggregatorHandler) RegenerateCalendarToken(w http.ResponseWriter, r *http.Request) {
+	userID, ok := middleware.GetUserIDFromContext(r.Context())
+	if !ok {
+		http.Error(w, ErrNoUserID.Error(), http.StatusUnauthorized)
+		return
+	}
+
+	token, err := h.uc.RegenerateCalendarToken(r.Context(), userID)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusConflict)
+		return
+	}
+
+	w.WriteHeader(http.StatusCreated)
+
+	json.NewEncoder(w).Encode(token)
+}
+
+// GetCalendarFeed serves the iCalendar feed of a calendar token, restricted
+// to events or to-dos by kind. It needs no login: the token is the secret.
+func (h *AggregatorHandler) GetCalendarFeed(w http.ResponseWriter, r *http.Request) {
+	token := mux.Vars(r)["token"]
+
+	kind := r.URL.Query().Get("kind")
+	if kind != "" && kind != dto.CalendarKindEvent && kind != dto.CalendarKindTodo {
+		http.Error(w, ErrInvalidKind.Error(), http.StatusBadRequest)
+		return
+	}
+
+	feed, err := h.uc.GetCalendarFeed(r.Context(), token, kind)
+
+	if errors.Is(err, todo.ErrCalendarTokenNotFound) {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusConflict)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/calendar; charset=utf-8")
+	w.Header().Set("Cache-Control", "no-cache")
+
+	w.Write(feed)
+}
+
+// userIDFromContext reads the id of the caller the auth middleware put in
+// the context, with the status to answer if there is none.
+func userIDFromContext(r *http.Request) (uuid.UUID, int, error) {
+	userIDstr, ok := middleware.GetUserIDFromContext(r.Context())
+	if !ok {
+		return uuid.Nil, http.StatusUnauthorized, ErrNoUserID
+	}
+
+	userID, err := uuid.Parse(userIDstr)
+	if err != nil {
+		return uuid.Nil, http.StatusUnauthorized, ErrBadUserID
+	}
+
+	return userID, 0, nil
+}
+
+// WatchBoard relays the event stream of a board from the todo service as it
+// comes, passing Last-Event-ID on for resuming.
+func (h *AggregatorHandler) WatchBoard(w http.ResponseWriter, r *http.Request) {
+	boardID := mux.Vars(r)["id"]
+
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, ErrNoStreaming.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	stream, err := h.uc.WatchBoard(r.Context(), boardID, r.Header.Get("Last-Event-ID"))
+	if err != nil {
+		http.Error(w, err.Error(), watchStatus(err))
+		return
+	}
+	defer stream.Close()
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	buf := make([]byte, 4096)
+	for {
+		n, err := stream.Read(buf)
+		if n > 0 {
+			if _, werr := w.Write(buf[:n]); werr != nil {
+				return
+			}
+			flusher.Flush()
+		}
+		if err != nil {
+			return
+		}
+	}
+}
+
+// renderHTML is whether the request asks for card descriptions rendered as
+// HTML too, by ?render=html.
+func renderHTML(r *http.Request) bool {
+	return r.URL.Query().Get("render") == "html"
+}
+
+// renderDescriptions fills in the HTML of the card descriptions if the
+// request asks for it.
+func renderDescriptions(r *http.Request, cards []dto.Card) {
+	if !renderHTML(r) {
+		return
+	}
+
+	for i := range cards {
+		cards[i].DescriptionHTML = markdown.Render(cards[i].Description)
+	}
+}
+
+// etag renders an entity version as a strong entity tag, the way the todo
+// service does.
+func etag(version int) string {
+	return strconv.Quote(strconv.Itoa(version))
+}
+
+// ifMatch reads the version a write is conditioned on; it is forwarded to
+// the todo service, which refuses the write if the entity has moved on.
+func ifMatch(r *http.Request) (int, int, error) {
+	header := r.Header.Get("If-Match")
+	if header == "" {
+		return 0, http.StatusPreconditionRequired, ErrNoIfMatch
+	}
+
+	tag, err := strconv.Unquote(header)
+	if err != nil {
+		return 0, http.StatusBadRequest, ErrInvalidIfMatch
+	}
+
+	version, err := strconv.Atoi(tag)
+	if err != nil || version <= 0 {
+		return 0, http.StatusBadRequest, ErrInvalidIfMatch
+	}
+
+	return version, 0, nil
+}
+
+// TransferBoard gives a board to another user; only admins may.
+func (h *AggregatorHandler) TransferBoard(w http.ResponseWriter, r *http.Request) {
+	if !requireAdmin(w, r) {
+		return
+	}
+
+	var req struct {
+		UserID uuid.UUID `json:"user_id"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, ErrInvalidRequestBody.Error(), http.StatusBadRequest)
+		return
+	}
+
+	board, err := h.uc.TransferBoard(r.Context(), mux.Vars(r)["id"], req.UserID.String())
+	if err != nil {
+		http.Error(w, err.Error(), transferStatus(err))
+		return
+	}
+
+	json.NewEncoder(w).Encode(board)
+}
+
+// DeleteUser deletes a user with their data; ?transfer_to= names the user
+// their boards go to instead. Only admins may.
+func (h *AggregatorHandler) DeleteUser(w http.ResponseWriter, r *http.Request) {
+	if !requireAdmin(w, r) {
+		return
+	}
+
+	err := h.uc.DeleteUser(r.Context(), mux.Vars(r)["id"], r.URL.Query().Get("transfer_to"))
+	if err != nil {
+		http.Error(w, err.Error(), transferStatus(err))
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// GetUserQuota returns the quota a user is held to; only admins may.
+func (h *AggregatorHandler) GetUserQuota(w http.ResponseWriter, r *http.Request) {
+	if !requireAdmin(w, r) {
+		return
+	}
+
+	quota, err := h.uc.GetUserQuota(r.Context(), mux.Vars(r)["id"])
+	if err != nil {
+		http.Error(w, err.Error(), quotaStatus(err))
+		return
+	}
+
+	json.NewEncoder(w).Encode(quota)
+}
+
+// SetQuotaOverride overrides bounds of the quota of a user; only admins
+// may.
+func (h *AggregatorHandler) SetQuotaOverride(w http.ResponseWriter, r *http.Request) {
+	if !requireAdmin(w, r) {
+		return
+	}
+
+	var req dto.QuotaOverrideRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, ErrInvalidRequestBody.Error(), http.StatusBadRequest)
+		return
+	}
+
+	quota, err := h.uc.SetQuotaOverride(r.Context(), mux.Vars(r)["id"], req)
+	if err != nil {
+		http.Error(w, err.Error(), quotaStatus(err))
+		return
+	}
+
+	json.NewEncoder(w).Encode(quota)
+}
+
+// DeleteQuotaOverride puts a user back on the configured quota; only
+// admins may.
+func (h *AggregatorHandler) DeleteQuotaOverride(w http.ResponseWriter, r *http.Request) {
+	if !requireAdmin(w, r) {
+		return
+	}
+
+	err := h.uc.DeleteQuotaOverride(r.Context(), mux.Vars(r)["id"])
+	if err != nil {
+		http.Error(w, err.Error(), quotaStatus(err))
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// quotaStatus is the status of a failed quota request.
+func quotaStatus(err error) int {
+	switch {
+	case errors.Is(err, todo.ErrInvalidQuota):
+		return http.StatusBadRequest
+	case errors.Is(err, user.ErrUserNotFound), errors.Is(err, todo.ErrQuotaOverrideNotFound):
+		return http.StatusNotFound
+	}
+
+	return http.StatusConflict
+}
+
+// transferStatus is the status of a failed board transfer or user
+// deletion.
+func transferStatus(err error) int {
+	switch {
+	case errors.Is(err, todo.ErrInvalidTransfer):
+		return http.StatusBadRequest
+	case errors.Is(err, todo.ErrBoardAccess):
+		return http.StatusForbidden
+	case errors.Is(err, todo.ErrBoardNotFound), errors.Is(err, user.ErrUserNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, todo.ErrVersionConflict):
+		return http.StatusPreconditionFailed
+	case errors.Is(err, todo.ErrQuotaExceeded):
+		return http.StatusUnprocessableEntity
+	}
+
+	return http.StatusConflict
+}
+
+// requireAdmin answers the request itself unless the caller is an admin.
+//
+// XXX: Role check better should be in another role checking middleware
+func requireAdmin(w http.ResponseWriter, r *http.Request) bool {
+	role, ok := middleware.GetRoleFromContext(r.Context())
+	if !ok {
+		http.Error(w, ErrNoRole.Error(), http.StatusUnauthorized)
+		return false
+	}
+
+	if role != "admin" {
+		http.Error(w, ErrNotAdmin.Error(), http.StatusUnauthorized)
+		return false
+	}
+
+	return true
 }
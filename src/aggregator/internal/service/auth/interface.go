@@ -11,4 +11,6 @@ type AuthService interface {
 	Refresh(ctx context.Context, refreshToken string) (*dto.RefreshResponse, error)
 	ValidateToken(ctx context.Context, token string) (*dto.ValidateTokenResponse, error)
 	Logout(ctx context.Context, refreshToken string) error
+	// RevokeUserTokens drops every refresh token of the user.
+	RevokeUserTokens(ctx context.Context, userID string) error
 }
@@ -3,26 +3,253 @@ package todo
 import (
 	"aggregator/internal/dto"
 	"context"
+	"errors"
+	"io"
 	"time"
 )
 
+// ErrVersionConflict is returned when a conditional write is refused because
+// the todo service holds a newer version of the entity.
+var ErrVersionConflict = errors.New("version conflict")
+
+// ErrInvalidBulk is returned when the todo service rejects a bulk request as
+// a whole, e.g. for having no operations or too many of them.
+var ErrInvalidBulk = errors.New("invalid bulk request")
+
+// ErrLastSwimlane is returned when deleting a swimlane would leave its board
+// without any.
+var ErrLastSwimlane = errors.New("board should keep at least one swimlane")
+
+// ErrCardParent is returned when a card cannot go under the parent asked
+// for: itself, one of its descendants, or too deep a tree.
+var ErrCardParent = errors.New("card cannot go under that parent")
+
+// ErrTimerRunning is returned when starting a timer while the user already
+// has one running.
+var ErrTimerRunning = errors.New("user already has a running timer")
+
+// ErrNoTimerRunning is returned when stopping a timer while the user has
+// none running.
+var ErrNoTimerRunning = errors.New("user has no running timer")
+
+// ErrInvalidTime is returned when the todo service rejects a time entry or
+// a time report query, e.g. for ending before it starts.
+var ErrInvalidTime = errors.New("invalid time entry or report")
+
+// ErrInvalidPeriod is returned when the todo service rejects the period of
+// board analytics, e.g. for ending before it starts or being too long.
+var ErrInvalidPeriod = errors.New("invalid analytics period")
+
+// ErrInvalidStats is returned when the todo service rejects the period or
+// the stale threshold of board stats.
+var ErrInvalidStats = errors.New("invalid board stats period or stale threshold")
+
+// ErrInvalidSprint is returned when the todo service rejects a sprint or a
+// change to one, e.g. for ending before it starts or taking in a card of
+// another board.
+var ErrInvalidSprint = errors.New("invalid sprint")
+
+// ErrSprintClosed is returned when changing a sprint that is closed.
+var ErrSprintClosed = errors.New("sprint is closed")
+
+// ErrCalendarTokenNotFound is returned when no user has the calendar token
+// asked for, e.g. because it was regenerated since.
+var ErrCalendarTokenNotFound = errors.New("calendar token not found")
+
+// ErrInvalidMove is returned when the todo service rejects a column move or
+// a board merge, e.g. for a column already on the board or an unknown
+// strategy.
+var ErrInvalidMove = errors.New("invalid column move or board merge")
+
+// ErrBoardAccess is returned when a column move, a board merge, a star, a
+// view, a watch or board stats involve a board the user has no access to
+// through its workspace.
+var ErrBoardAccess = errors.New("user has no access to the board")
+
+// ErrInvalidWorkspace is returned when the todo service rejects a workspace
+// or a member, e.g. for having no name or an unknown role.
+var ErrInvalidWorkspace = errors.New("invalid workspace or member")
+
+// ErrWorkspaceNotFound is returned when the workspace or the member asked
+// for does not exist.
+var ErrWorkspaceNotFound = errors.New("workspace or member not found")
+
+// ErrWorkspaceAccess is returned when the user lacks the workspace role the
+// request needs, e.g. when a viewer creates a board or a member lists every
+// board of the workspace.
+var ErrWorkspaceAccess = errors.New("user lacks the workspace role needed")
+
+// ErrWorkspaceConflict is returned when a member change is refused: adding
+// an existing member, removing the last admin or sharing a personal
+// workspace.
+var ErrWorkspaceConflict = errors.New("workspace member change refused")
+
+// ErrInvalidTransfer is returned when the todo service rejects a board
+// transfer, e.g. for naming no user or the user who owns the boards.
+var ErrInvalidTransfer = errors.New("invalid board transfer")
+
+// ErrBoardNotFound is returned when the board to transfer, watch or sum up
+// does not exist.
+var ErrBoardNotFound = errors.New("board not found")
+
+// ErrCardNotFound is returned when the card to watch or notify of does not
+// exist.
+var ErrCardNotFound = errors.New("card not found")
+
+// ErrInvalidWatch is returned when the todo service rejects a watch, an
+// event stream or a card notification as malformed.
+var ErrInvalidWatch = errors.New("invalid watch")
+
+// ErrWatchExists is returned when the user already watches the board or
+// card.
+var ErrWatchExists = errors.New("board or card is already watched")
+
+// ErrInvalidCardTemplate is returned when the todo service rejects a card
+// template, e.g. for having no name, or a card made from a template of
+// another board.
+var ErrInvalidCardTemplate = errors.New("invalid card template")
+
+// ErrCardTemplateNotFound is returned when the card template asked for, or
+// the column of a card made from one, does not exist.
+var ErrCardTemplateNotFound = errors.New("card template not found")
+
+// ErrCardTemplateExists is returned when the board already has a card
+// template of the name given.
+var ErrCardTemplateExists = errors.New("board already has a card template of that name")
+
+// ErrQuotaExceeded is returned when a board, column or card is refused for
+// going over the quota of the user, or of the owner of the board.
+var ErrQuotaExceeded = errors.New("quota exceeded")
+
+// ErrInvalidQuota is returned when the todo service rejects a quota
+// override, e.g. for a negative bound.
+var ErrInvalidQuota = errors.New("invalid quota override")
+
+// ErrQuotaOverrideNotFound is returned when deleting the override of a
+// user who has none.
+var ErrQuotaOverrideNotFound = errors.New("quota override not found")
+
 type TodoService interface {
 	GetNewCards(ctx context.Context, from, to time.Time) ([]dto.Card, error)
 
 	GetBoards(ctx context.Context, userID string) ([]dto.Board, error)
+	// GetMarkedBoards lists the boards of the user starred first, then the
+	// ones they viewed lately and then the rest.
+	GetMarkedBoards(ctx context.Context, userID string) ([]dto.MarkedBoard, error)
+	GetRecentBoards(ctx context.Context, userID string) ([]dto.MarkedBoard, error)
 	GetColumns(ctx context.Context, boardID string) ([]dto.Column, error)
-	GetCards(ctx context.Context, columnID string) ([]dto.Card, error)
+	GetSwimlanes(ctx context.Context, boardID string) ([]dto.Swimlane, error)
+	GetCards(ctx context.Context, query dto.CardQuery) ([]dto.Card, error)
+	GetBoard(ctx context.Context, id string) (*dto.Board, error)
+	GetColumn(ctx context.Context, id string) (*dto.Column, error)
+	GetSwimlane(ctx context.Context, id string) (*dto.Swimlane, error)
 	GetCard(ctx context.Context, id string) (*dto.Card, error)
+	GetCardAncestors(ctx context.Context, id string) ([]dto.Card, error)
 
 	CreateBoard(ctx context.Context, board dto.Board) error
 	CreateColumn(ctx context.Context, column dto.Column) error
-	CreateCard(ctx context.Context, card dto.Card) error
+	CreateSwimlane(ctx context.Context, swimlane dto.Swimlane) error
+	// CreateCard creates the card and returns it as the todo service stored
+	// it.
+	CreateCard(ctx context.Context, card dto.Card) (*dto.Card, error)
+	// CreateCardFromTemplate creates the card with the template applied,
+	// {{user}} filled in with username, and returns it.
+	CreateCardFromTemplate(ctx context.Context, templateID string, card dto.Card, username string) (*dto.Card, error)
 
 	UpdateBoard(ctx context.Context, board *dto.Board) error
 	UpdateColumn(ctx context.Context, column *dto.Column) error
+	UpdateSwimlane(ctx context.Context, swimlane *dto.Swimlane) error
 	UpdateCard(ctx context.Context, card *dto.Card) error
+	SetCardParent(ctx context.Context, card *dto.Card) error
+
+	DeleteBoard(ctx context.Context, id string, version int) error
+	DeleteColumn(ctx context.Context, id string, version int) error
+	DeleteSwimlane(ctx context.Context, id string, version int) error
+	DeleteCard(ctx context.Context, id string, version int) error
+
+	BulkCards(ctx context.Context, req dto.BulkCardsRequest) (*dto.BulkCardsResponse, error)
+
+	// MoveColumn moves the column, with its cards, after the last column of
+	// column.BoardID and updates column from the todo service.
+	MoveColumn(ctx context.Context, column *dto.Column) error
+	MergeBoards(ctx context.Context, req dto.MergeBoardsRequest) (*dto.BoardMerge, error)
+
+	StarBoard(ctx context.Context, userID, boardID string) error
+	UnstarBoard(ctx context.Context, userID, boardID string) error
+	RecordBoardView(ctx context.Context, userID, boardID string) error
+
+	AddBoardWatch(ctx context.Context, userID, boardID string) error
+	RemoveBoardWatch(ctx context.Context, userID, boardID string) error
+	AddCardWatch(ctx context.Context, userID, cardID string) error
+	RemoveCardWatch(ctx context.Context, userID, cardID string) error
+	// NotifyCard tells the users mentioned and the watchers of the card what
+	// the actor did on it and returns the notifications made.
+	NotifyCard(ctx context.Context, req dto.NotifyCardRequest) ([]dto.Notification, error)
+	// GetNotifications lists up to limit notifications of the user, the
+	// latest first.
+	GetNotifications(ctx context.Context, userID string, unreadOnly bool, limit int) ([]dto.Notification, error)
+	MarkNotificationsRead(ctx context.Context, req dto.MarkNotificationsReadRequest) error
+
+	StartTimer(ctx context.Context, req dto.StartTimerRequest) (*dto.TimeEntry, error)
+	StopTimer(ctx context.Context, req dto.StopTimerRequest) (*dto.TimeEntry, error)
+	LogTime(ctx context.Context, req dto.LogTimeRequest) (*dto.TimeEntry, error)
+	GetCardTimeEntries(ctx context.Context, cardID string) ([]dto.TimeEntry, error)
+	GetTimeReport(ctx context.Context, query dto.TimeReportQuery) ([]dto.TimeReportRow, error)
+
+	// GetBoardAnalytics reports on the flow of cards across a board from one
+	// DD-MM-YYYY date to another, both included.
+	GetBoardAnalytics(ctx context.Context, boardID, from, to string) (*dto.BoardAnalytics, error)
+
+	// GetBoardStats sums up a board for a member of it from one DD-MM-YYYY
+	// date to another, both included; cards untouched for staleDays days are
+	// stale, by default 14.
+	GetBoardStats(ctx context.Context, userID, boardID, from, to, staleDays string) (*dto.BoardStats, error)
+
+	CreateSprint(ctx context.Context, req dto.CreateSprintRequest) (*dto.Sprint, error)
+	GetSprint(ctx context.Context, id string) (*dto.Sprint, error)
+	GetBoardSprints(ctx context.Context, boardID string) ([]dto.Sprint, error)
+	GetSprintCards(ctx context.Context, sprintID string) ([]dto.SprintCard, error)
+	AddSprintCard(ctx context.Context, sprintID string, req dto.AddSprintCardRequest) (*dto.SprintCard, error)
+	RemoveSprintCard(ctx context.Context, sprintID, cardID string) error
+	CloseSprint(ctx context.Context, sprintID string, req dto.CloseSprintRequest) (*dto.CloseSprintResponse, error)
+	GetSprintBurndown(ctx context.Context, sprintID string) ([]dto.BurndownPoint, error)
+
+	CreateCardTemplate(ctx context.Context, req dto.CardTemplateRequest) (*dto.CardTemplate, error)
+	GetCardTemplate(ctx context.Context, id string) (*dto.CardTemplate, error)
+	GetBoardCardTemplates(ctx context.Context, boardID string) ([]dto.CardTemplate, error)
+	UpdateCardTemplate(ctx context.Context, id string, req dto.CardTemplateRequest) (*dto.CardTemplate, error)
+	DeleteCardTemplate(ctx context.Context, id string) error
+
+	CreateWorkspace(ctx context.Context, req dto.CreateWorkspaceRequest) (*dto.Workspace, error)
+	GetWorkspaces(ctx context.Context, userID string) ([]dto.WorkspaceMembership, error)
+	GetWorkspaceMembers(ctx context.Context, userID, workspaceID string) ([]dto.WorkspaceMember, error)
+	AddWorkspaceMember(ctx context.Context, workspaceID string, req dto.WorkspaceMemberRequest) error
+	UpdateWorkspaceMember(ctx context.Context, workspaceID string, req dto.WorkspaceMemberRequest) error
+	RemoveWorkspaceMember(ctx context.Context, actorID, workspaceID, userID string) error
+	// GetWorkspaceBoards lists every board of the workspace; only its admins
+	// may.
+	GetWorkspaceBoards(ctx context.Context, userID, workspaceID string) ([]dto.Board, error)
+
+	RegenerateCalendarToken(ctx context.Context, userID string) (*dto.CalendarToken, error)
+	GetCalendarCards(ctx context.Context, token string) ([]dto.CalendarCard, error)
+
+	// WatchBoard opens the event stream of a board, resuming after
+	// lastEventID unless it is empty. The caller closes the stream.
+	WatchBoard(ctx context.Context, boardID, lastEventID string) (io.ReadCloser, error)
+
+	// TransferBoard gives the board to the user toUserID.
+	TransferBoard(ctx context.Context, boardID, toUserID string) (*dto.Board, error)
+	// TransferUserBoards gives the boards of fromUserID with the ids, or all
+	// of them without any, to toUserID and returns the boards transferred.
+	TransferUserBoards(ctx context.Context, fromUserID, toUserID string, boardIDs []string) ([]dto.Board, error)
+	// DeleteUserData deletes the boards, workspace memberships, marks,
+	// calendar token, watches and notifications of the user.
+	DeleteUserData(ctx context.Context, userID string) error
 
-	DeleteBoard(ctx context.Context, id string) error
-	DeleteColumn(ctx context.Context, id string) error
-	DeleteCard(ctx context.Context, id string) error
+	// GetUserQuota returns the quota the user is held to.
+	GetUserQuota(ctx context.Context, userID string) (*dto.UserQuota, error)
+	// SetQuotaOverride replaces the override of the user and returns the
+	// quota they are held to with it.
+	SetQuotaOverride(ctx context.Context, userID string, req dto.QuotaOverrideRequest) (*dto.UserQuota, error)
+	DeleteQuotaOverride(ctx context.Context, userID string) error
 }
@@ -3,9 +3,16 @@ package user
 import (
 	"aggregator/internal/dto"
 	"context"
+	"errors"
 	"time"
 )
 
+// ErrUserNotFound is returned when the user asked for does not exist.
+var ErrUserNotFound = errors.New("user not found")
+
 type UserService interface {
 	GetNewUsers(ctx context.Context, from time.Time, to time.Time) ([]dto.User, error)
+	GetUserByID(ctx context.Context, id string) (*dto.User, error)
+	GetUserByUsername(ctx context.Context, username string) (*dto.User, error)
+	DeleteUser(ctx context.Context, id string) error
 }
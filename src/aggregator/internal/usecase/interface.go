@@ -4,7 +4,10 @@ import (
 	"aggregator/internal/dto"
 	"aggregator/internal/entity"
 	"context"
+	"io"
 	"time"
+
+	"github.com/google/uuid"
 )
 
 type AggregatorUseCase interface {
@@ -17,19 +20,112 @@ type AggregatorUseCase interface {
 	Logout(ctx context.Context, refreshToken string) error
 
 	GetBoards(ctx context.Context, userID string) ([]dto.Board, error)
+	GetMarkedBoards(ctx context.Context, userID string) ([]dto.MarkedBoard, error)
+	GetRecentBoards(ctx context.Context, userID string) ([]dto.MarkedBoard, error)
 	GetColumns(ctx context.Context, boardID string) ([]dto.Column, error)
-	GetCards(ctx context.Context, columnID string) ([]dto.Card, error)
+	GetSwimlanes(ctx context.Context, boardID string) ([]dto.Swimlane, error)
+	GetCards(ctx context.Context, query dto.CardQuery) ([]dto.Card, error)
+	GetBoard(ctx context.Context, id string) (*dto.Board, error)
+	// GetBoardSnapshot also records that the user viewed the board.
+	GetBoardSnapshot(ctx context.Context, userID, id string) (*dto.BoardSnapshot, error)
+	GetColumn(ctx context.Context, id string) (*dto.Column, error)
+	GetSwimlane(ctx context.Context, id string) (*dto.Swimlane, error)
 	GetCard(ctx context.Context, id string) (*dto.Card, error)
+	GetCardAncestors(ctx context.Context, id string) ([]dto.Card, error)
 
 	CreateBoard(ctx context.Context, board dto.Board) error
 	CreateColumn(ctx context.Context, column dto.Column) error
-	CreateCard(ctx context.Context, card dto.Card) error
+	CreateSwimlane(ctx context.Context, swimlane dto.Swimlane) error
+	// CreateCard also notifies the users the description mentions and the
+	// watchers of the board.
+	CreateCard(ctx context.Context, card dto.Card) (*dto.Card, error)
+	// CreateCardFromTemplate is CreateCard with a template of the board
+	// applied, {{user}} standing for the username of the creator.
+	CreateCardFromTemplate(ctx context.Context, templateID string, card dto.Card) (*dto.Card, error)
 
 	UpdateBoard(ctx context.Context, board *dto.Board) error
 	UpdateColumn(ctx context.Context, column *dto.Column) error
+	UpdateSwimlane(ctx context.Context, swimlane *dto.Swimlane) error
+	// UpdateCard also notifies the users the edit adds a mention of and the
+	// watchers of the card and its board.
 	UpdateCard(ctx context.Context, card *dto.Card) error
+	SetCardParent(ctx context.Context, card *dto.Card) error
+
+	DeleteBoard(ctx context.Context, id string, version int) error
+	DeleteColumn(ctx context.Context, id string, version int) error
+	DeleteSwimlane(ctx context.Context, id string, version int) error
+	DeleteCard(ctx context.Context, id string, version int) error
+
+	BulkCards(ctx context.Context, req dto.BulkCardsRequest) (*dto.BulkCardsResponse, error)
+
+	MoveColumn(ctx context.Context, column *dto.Column) error
+	MergeBoards(ctx context.Context, req dto.MergeBoardsRequest) (*dto.BoardMerge, error)
+
+	StarBoard(ctx context.Context, userID, boardID string) error
+	UnstarBoard(ctx context.Context, userID, boardID string) error
+
+	AddBoardWatch(ctx context.Context, userID, boardID string) error
+	RemoveBoardWatch(ctx context.Context, userID, boardID string) error
+	AddCardWatch(ctx context.Context, userID, cardID string) error
+	RemoveCardWatch(ctx context.Context, userID, cardID string) error
+	// GetNotifications lists the latest limit notifications of the user,
+	// with the name of who made each.
+	GetNotifications(ctx context.Context, userID string, unreadOnly bool, limit int) ([]dto.Notification, error)
+	// MarkNotificationsRead marks the notifications with the ids read, or
+	// every one of the user without any.
+	MarkNotificationsRead(ctx context.Context, userID string, ids []uuid.UUID) error
+
+	StartTimer(ctx context.Context, req dto.StartTimerRequest) (*dto.TimeEntry, error)
+	StopTimer(ctx context.Context, req dto.StopTimerRequest) (*dto.TimeEntry, error)
+	LogTime(ctx context.Context, req dto.LogTimeRequest) (*dto.TimeEntry, error)
+	GetCardTimeEntries(ctx context.Context, cardID string) ([]dto.TimeEntry, error)
+	GetTimeReport(ctx context.Context, query dto.TimeReportQuery) ([]dto.TimeReportRow, error)
+	GetBoardAnalytics(ctx context.Context, boardID, from, to string) (*dto.BoardAnalytics, error)
+	GetBoardStats(ctx context.Context, userID, boardID, from, to, staleDays string) (*dto.BoardStats, error)
+
+	CreateSprint(ctx context.Context, req dto.CreateSprintRequest) (*dto.Sprint, error)
+	GetSprint(ctx context.Context, id string) (*dto.Sprint, error)
+	GetBoardSprints(ctx context.Context, boardID string) ([]dto.Sprint, error)
+	GetSprintCards(ctx context.Context, sprintID string) ([]dto.SprintCard, error)
+	AddSprintCard(ctx context.Context, sprintID string, req dto.AddSprintCardRequest) (*dto.SprintCard, error)
+	RemoveSprintCard(ctx context.Context, sprintID, cardID string) error
+	CloseSprint(ctx context.Context, sprintID string, req dto.CloseSprintRequest) (*dto.CloseSprintResponse, error)
+	GetSprintBurndown(ctx context.Context, sprintID string) ([]dto.BurndownPoint, error)
+
+	CreateCardTemplate(ctx context.Context, req dto.CardTemplateRequest) (*dto.CardTemplate, error)
+	GetCardTemplate(ctx context.Context, id string) (*dto.CardTemplate, error)
+	GetBoardCardTemplates(ctx context.Context, boardID string) ([]dto.CardTemplate, error)
+	UpdateCardTemplate(ctx context.Context, id string, req dto.CardTemplateRequest) (*dto.CardTemplate, error)
+	DeleteCardTemplate(ctx context.Context, id string) error
+
+	CreateWorkspace(ctx context.Context, req dto.CreateWorkspaceRequest) (*dto.Workspace, error)
+	GetWorkspaces(ctx context.Context, userID string) ([]dto.WorkspaceMembership, error)
+	GetWorkspaceMembers(ctx context.Context, userID, workspaceID string) ([]dto.WorkspaceMember, error)
+	AddWorkspaceMember(ctx context.Context, workspaceID string, req dto.WorkspaceMemberRequest) error
+	UpdateWorkspaceMember(ctx context.Context, workspaceID string, req dto.WorkspaceMemberRequest) error
+	RemoveWorkspaceMember(ctx context.Context, actorID, workspaceID, userID string) error
+	GetWorkspaceBoards(ctx context.Context, userID, workspaceID string) ([]dto.Board, error)
+
+	// RegenerateCalendarToken gives the user a new calendar feed token,
+	// revoking the one they had.
+	RegenerateCalendarToken(ctx context.Context, userID string) (*dto.CalendarToken, error)
+	// GetCalendarFeed renders the cards due of the user with the token as an
+	// iCalendar feed, of kind event or todo, or both if kind is empty.
+	GetCalendarFeed(ctx context.Context, token, kind string) ([]byte, error)
+
+	WatchBoard(ctx context.Context, boardID, lastEventID string) (io.ReadCloser, error)
+
+	// TransferBoard gives the board to the user toUserID, who should exist.
+	TransferBoard(ctx context.Context, boardID, toUserID string) (*dto.Board, error)
+	// DeleteUser deletes the user along with their boards, workspace
+	// memberships and tokens, or hands their boards to transferTo if set.
+	DeleteUser(ctx context.Context, userID, transferTo string) error
 
-	DeleteBoard(ctx context.Context, id string) error
-	DeleteColumn(ctx context.Context, id string) error
-	DeleteCard(ctx context.Context, id string) error
+	// GetUserQuota returns the quota the user, who should exist, is held to.
+	GetUserQuota(ctx context.Context, userID string) (*dto.UserQuota, error)
+	// SetQuotaOverride overrides the bounds of the quota the request has for
+	// the user, who should exist.
+	SetQuotaOverride(ctx context.Context, userID string, req dto.QuotaOverrideRequest) (*dto.UserQuota, error)
+	// DeleteQuotaOverride puts the user back on the configured quota.
+	DeleteQuotaOverride(ctx context.Context, userID string) error
 }
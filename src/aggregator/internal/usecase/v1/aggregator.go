@@ -11,6 +11,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
+	"sort"
 	"time"
 
 	"github.com/google/uuid"
@@ -29,16 +31,73 @@ var (
 	ErrGetBoards        error  = errors.New("failed to get boards")
 	ErrGetColumns       error  = errors.New("failed to get columns")
 	ErrGetCards         error  = errors.New("failed to get cards")
+	ErrGetBoard         error  = errors.New("failed to get board")
+	ErrGetColumn        error  = errors.New("failed to get column")
+	ErrGetSwimlanes     error  = errors.New("failed to get swimlanes")
+	ErrGetSwimlane      error  = errors.New("failed to get swimlane")
+	ErrGetSnapshot      error  = errors.New("failed to get board snapshot")
 	ErrGetCard          error  = errors.New("failed to get card")
+	ErrGetAncestors     error  = errors.New("failed to get card ancestors")
 	ErrCreateBoard      error  = errors.New("failed to create board")
 	ErrCreateColumn     error  = errors.New("failed to create column")
+	ErrCreateSwimlane   error  = errors.New("failed to create swimlane")
 	ErrCreateCard       error  = errors.New("failed to create card")
 	ErrUpdateBoard      error  = errors.New("failed to update board")
 	ErrUpdateColumn     error  = errors.New("failed to update column")
+	ErrUpdateSwimlane   error  = errors.New("failed to update swimlane")
 	ErrUpdateCard       error  = errors.New("failed to update card")
+	ErrSetCardParent    error  = errors.New("failed to set card parent")
 	ErrDeleteBoard      error  = errors.New("failed to delete board")
 	ErrDeleteColumn     error  = errors.New("failed to delete column")
+	ErrDeleteSwimlane   error  = errors.New("failed to delete swimlane")
 	ErrDeleteCard       error  = errors.New("failed to delete card")
+	ErrBulkCards        error  = errors.New("failed to apply card operations")
+	ErrMoveColumn       error  = errors.New("failed to move column")
+	ErrMergeBoards      error  = errors.New("failed to merge boards")
+	ErrGetRecentBoards  error  = errors.New("failed to get recent boards")
+	ErrStarBoard        error  = errors.New("failed to star board")
+	ErrUnstarBoard      error  = errors.New("failed to unstar board")
+	ErrWatchBoard       error  = errors.New("failed to watch board")
+	ErrStartTimer       error  = errors.New("failed to start timer")
+	ErrStopTimer        error  = errors.New("failed to stop timer")
+	ErrLogTime          error  = errors.New("failed to log time")
+	ErrGetTimeEntries   error  = errors.New("failed to get time entries")
+	ErrGetTimeReport    error  = errors.New("failed to get time report")
+	ErrGetAnalytics     error  = errors.New("failed to get board analytics")
+	ErrGetBoardStats    error  = errors.New("failed to get board stats")
+	ErrCreateSprint     error  = errors.New("failed to create sprint")
+	ErrGetSprint        error  = errors.New("failed to get sprint")
+	ErrGetBoardSprints  error  = errors.New("failed to get sprints of board")
+	ErrGetSprintCards   error  = errors.New("failed to get sprint cards")
+	ErrAddSprintCard    error  = errors.New("failed to add card to sprint")
+	ErrRemoveSprintCard error  = errors.New("failed to remove card from sprint")
+	ErrCloseSprint      error  = errors.New("failed to close sprint")
+	ErrGetBurndown      error  = errors.New("failed to get sprint burndown")
+	ErrCalendarToken    error  = errors.New("failed to regenerate calendar token")
+	ErrGetCalendar      error  = errors.New("failed to get calendar feed")
+	ErrCreateWorkspace  error  = errors.New("failed to create workspace")
+	ErrGetWorkspaces    error  = errors.New("failed to get workspaces")
+	ErrGetMembers       error  = errors.New("failed to get workspace members")
+	ErrAddMember        error  = errors.New("failed to add workspace member")
+	ErrUpdateMember     error  = errors.New("failed to update workspace member")
+	ErrRemoveMember     error  = errors.New("failed to remove workspace member")
+	ErrWorkspaceBoards  error  = errors.New("failed to get workspace boards")
+	ErrGetUser          error  = errors.New("failed to get user")
+	ErrTransferBoard    error  = errors.New("failed to transfer board")
+	ErrDeleteUser       error  = errors.New("failed to delete user")
+	ErrRestoreBoards    error  = errors.New("failed to give the transferred boards back")
+	ErrAddWatch         error  = errors.New("failed to add watch")
+	ErrRemoveWatch      error  = errors.New("failed to remove watch")
+	ErrGetInbox         error  = errors.New("failed to get notifications")
+	ErrMarkRead         error  = errors.New("failed to mark notifications read")
+	ErrCreateTemplate   error  = errors.New("failed to create card template")
+	ErrGetTemplate      error  = errors.New("failed to get card template")
+	ErrGetTemplates     error  = errors.New("failed to get card templates of board")
+	ErrUpdateTemplate   error  = errors.New("failed to update card template")
+	ErrDeleteTemplate   error  = errors.New("failed to delete card template")
+	ErrGetQuota         error  = errors.New("failed to get user quota")
+	ErrSetQuota         error  = errors.New("failed to set quota override")
+	ErrDeleteQuota      error  = errors.New("failed to delete quota override")
 )
 
 type AggregatorUseCase struct {
@@ -289,6 +348,212 @@ func (uc *AggregatorUseCase) GetBoards(ctx context.Context, userID string) ([]dt
 	return boards, nil
 }
 
+func (uc *AggregatorUseCase) GetMarkedBoards(ctx context.Context, userID string) ([]dto.MarkedBoard, error) {
+	header := "GetMarkedBoards: "
+
+	uc.log.Info(ctx, header+"Usecase called; Making request to todo service", "userID", userID)
+
+	boards, err := uc.todoSvc.GetMarkedBoards(ctx, userID)
+
+	if err != nil {
+		info := "Failed to get boards"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", ErrGetBoards)
+	}
+
+	uc.log.Info(ctx, header+"Got boards", "boards", boards)
+
+	return boards, nil
+}
+
+func (uc *AggregatorUseCase) GetRecentBoards(ctx context.Context, userID string) ([]dto.MarkedBoard, error) {
+	header := "GetRecentBoards: "
+
+	uc.log.Info(ctx, header+"Usecase called; Making request to todo service", "userID", userID)
+
+	boards, err := uc.todoSvc.GetRecentBoards(ctx, userID)
+
+	if err != nil {
+		info := "Failed to get recent boards"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", ErrGetRecentBoards)
+	}
+
+	uc.log.Info(ctx, header+"Got recent boards", "boards", boards)
+
+	return boards, nil
+}
+
+func (uc *AggregatorUseCase) StarBoard(ctx context.Context, userID, boardID string) error {
+	header := "StarBoard: "
+
+	uc.log.Info(ctx, header+"Usecase called; Making request to todo service", "userID", userID, "boardID", boardID)
+
+	err := uc.todoSvc.StarBoard(ctx, userID, boardID)
+
+	if errors.Is(err, todo.ErrBoardAccess) {
+		info := "Board was not starred"
+		uc.log.Info(ctx, header+info, "boardID", boardID, "err", err.Error())
+		return fmt.Errorf(header+info+": %w", err)
+	}
+
+	if err != nil {
+		info := "Failed to star board"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return fmt.Errorf(header+info+": %w", ErrStarBoard)
+	}
+
+	uc.log.Info(ctx, header+"Successfully starred board", "boardID", boardID)
+
+	return nil
+}
+
+func (uc *AggregatorUseCase) UnstarBoard(ctx context.Context, userID, boardID string) error {
+	header := "UnstarBoard: "
+
+	uc.log.Info(ctx, header+"Usecase called; Making request to todo service", "userID", userID, "boardID", boardID)
+
+	err := uc.todoSvc.UnstarBoard(ctx, userID, boardID)
+
+	if err != nil {
+		info := "Failed to unstar board"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return fmt.Errorf(header+info+": %w", ErrUnstarBoard)
+	}
+
+	uc.log.Info(ctx, header+"Successfully unstarred board", "boardID", boardID)
+
+	return nil
+}
+
+func (uc *AggregatorUseCase) AddBoardWatch(ctx context.Context, userID, boardID string) error {
+	header := "AddBoardWatch: "
+
+	uc.log.Info(ctx, header+"Usecase called; Making request to todo service", "userID", userID, "boardID", boardID)
+
+	err := uc.todoSvc.AddBoardWatch(ctx, userID, boardID)
+
+	return uc.watchResult(ctx, header, err, ErrAddWatch)
+}
+
+func (uc *AggregatorUseCase) RemoveBoardWatch(ctx context.Context, userID, boardID string) error {
+	header := "RemoveBoardWatch: "
+
+	uc.log.Info(ctx, header+"Usecase called; Making request to todo service", "userID", userID, "boardID", boardID)
+
+	err := uc.todoSvc.RemoveBoardWatch(ctx, userID, boardID)
+
+	return uc.watchResult(ctx, header, err, ErrRemoveWatch)
+}
+
+func (uc *AggregatorUseCase) AddCardWatch(ctx context.Context, userID, cardID string) error {
+	header := "AddCardWatch: "
+
+	uc.log.Info(ctx, header+"Usecase called; Making request to todo service", "userID", userID, "cardID", cardID)
+
+	err := uc.todoSvc.AddCardWatch(ctx, userID, cardID)
+
+	return uc.watchResult(ctx, header, err, ErrAddWatch)
+}
+
+func (uc *AggregatorUseCase) RemoveCardWatch(ctx context.Context, userID, cardID string) error {
+	header := "RemoveCardWatch: "
+
+	uc.log.Info(ctx, header+"Usecase called; Making request to todo service", "userID", userID, "cardID", cardID)
+
+	err := uc.todoSvc.RemoveCardWatch(ctx, userID, cardID)
+
+	return uc.watchResult(ctx, header, err, ErrRemoveWatch)
+}
+
+// watchResult wraps the error of a watch change: a refusal of the todo
+// service is kept as is, any other failure becomes failed.
+func (uc *AggregatorUseCase) watchResult(ctx context.Context, header string, err, failed error) error {
+	if errors.Is(err, todo.ErrBoardAccess) || errors.Is(err, todo.ErrBoardNotFound) || errors.Is(err, todo.ErrCardNotFound) ||
+		errors.Is(err, todo.ErrInvalidWatch) || errors.Is(err, todo.ErrWatchExists) {
+		info := "Watch was not changed"
+		uc.log.Info(ctx, header+info, "err", err.Error())
+		return fmt.Errorf(header+info+": %w", err)
+	}
+
+	if err != nil {
+		info := "Failed to change watch"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return fmt.Errorf(header+info+": %w", failed)
+	}
+
+	uc.log.Info(ctx, header+"Successfully changed watch")
+
+	return nil
+}
+
+func (uc *AggregatorUseCase) GetNotifications(ctx context.Context, userID string, unreadOnly bool, limit int) ([]dto.Notification, error) {
+	header := "GetNotifications: "
+
+	uc.log.Info(ctx, header+"Usecase called; Making request to todo service", "userID", userID, "unreadOnly", unreadOnly, "limit", limit)
+
+	notifications, err := uc.todoSvc.GetNotifications(ctx, userID, unreadOnly, limit)
+
+	if err != nil {
+		info := "Failed to get notifications"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", ErrGetInbox)
+	}
+
+	// Names are a nicety of the inbox: a user that cannot be read leaves
+	// the name out rather than the inbox.
+	names := make(map[uuid.UUID]string)
+
+	for i, n := range notifications {
+		name, ok := names[n.ActorID]
+
+		if !ok {
+			actor, err := uc.userSvc.GetUserByID(ctx, n.ActorID.String())
+			if err != nil {
+				uc.log.Warn(ctx, header+"Failed to get actor", "actorID", n.ActorID, "err", err.Error())
+			} else {
+				name = actor.Username
+			}
+
+			names[n.ActorID] = name
+		}
+
+		notifications[i].ActorName = name
+	}
+
+	uc.log.Info(ctx, header+"Got notifications", "count", len(notifications))
+
+	return notifications, nil
+}
+
+func (uc *AggregatorUseCase) MarkNotificationsRead(ctx context.Context, userID string, ids []uuid.UUID) error {
+	header := "MarkNotificationsRead: "
+
+	uc.log.Info(ctx, header+"Usecase called; Parsing user id", "userID", userID, "ids", ids)
+
+	id, err := uuid.Parse(userID)
+
+	if err != nil {
+		info := "Invalid user id"
+		uc.log.Info(ctx, header+info, "userID", userID)
+		return fmt.Errorf(header+info+": %w", ErrMarkRead)
+	}
+
+	uc.log.Info(ctx, header+"Making request to todo service", "userID", userID)
+
+	err = uc.todoSvc.MarkNotificationsRead(ctx, dto.MarkNotificationsReadRequest{UserID: id, IDs: ids})
+
+	if err != nil {
+		info := "Failed to mark notifications read"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return fmt.Errorf(header+info+": %w", ErrMarkRead)
+	}
+
+	uc.log.Info(ctx, header+"Successfully marked notifications read")
+
+	return nil
+}
+
 func (uc *AggregatorUseCase) GetColumns(ctx context.Context, boardID string) ([]dto.Column, error) {
 	header := "GetColumns: "
 
@@ -307,12 +572,30 @@ func (uc *AggregatorUseCase) GetColumns(ctx context.Context, boardID string) ([]
 	return columns, nil
 }
 
-func (uc *AggregatorUseCase) GetCards(ctx context.Context, columnID string) ([]dto.Card, error) {
+func (uc *AggregatorUseCase) GetSwimlanes(ctx context.Context, boardID string) ([]dto.Swimlane, error) {
+	header := "GetSwimlanes: "
+
+	uc.log.Info(ctx, header+"Usecase called; Making request to todo service", "boardID", boardID)
+
+	swimlanes, err := uc.todoSvc.GetSwimlanes(ctx, boardID)
+
+	if err != nil {
+		info := "Failed to get swimlanes"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", ErrGetSwimlanes)
+	}
+
+	uc.log.Info(ctx, header+"Got swimlanes", "swimlanes", swimlanes)
+
+	return swimlanes, nil
+}
+
+func (uc *AggregatorUseCase) GetCards(ctx context.Context, query dto.CardQuery) ([]dto.Card, error) {
 	header := "GetCards: "
 
-	uc.log.Info(ctx, header+"Usecase called; Making request to todo service", "columnID", columnID)
+	uc.log.Info(ctx, header+"Usecase called; Making request to todo service", "query", query)
 
-	cards, err := uc.todoSvc.GetCards(ctx, columnID)
+	cards, err := uc.todoSvc.GetCards(ctx, query)
 
 	if err != nil {
 		info := "Failed to get cards"
@@ -325,6 +608,143 @@ func (uc *AggregatorUseCase) GetCards(ctx context.Context, columnID string) ([]d
 	return cards, nil
 }
 
+func (uc *AggregatorUseCase) GetBoard(ctx context.Context, id string) (*dto.Board, error) {
+	header := "GetBoard: "
+
+	uc.log.Info(ctx, header+"Usecase called; Making request to todo service", "id", id)
+
+	board, err := uc.todoSvc.GetBoard(ctx, id)
+
+	if err != nil {
+		info := "Failed to get board"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", ErrGetBoard)
+	}
+
+	uc.log.Info(ctx, header+"Got board", "board", board)
+
+	return board, nil
+}
+
+func (uc *AggregatorUseCase) GetBoardSnapshot(ctx context.Context, userID, id string) (*dto.BoardSnapshot, error) {
+	header := "GetBoardSnapshot: "
+
+	uc.log.Info(ctx, header+"Usecase called; Making requests to todo service", "userID", userID, "id", id)
+
+	board, err := uc.todoSvc.GetBoard(ctx, id)
+	if err != nil {
+		info := "Failed to get board"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", ErrGetSnapshot)
+	}
+
+	swimlanes, err := uc.todoSvc.GetSwimlanes(ctx, id)
+	if err != nil {
+		info := "Failed to get swimlanes"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", ErrGetSnapshot)
+	}
+
+	columns, err := uc.todoSvc.GetColumns(ctx, id)
+	if err != nil {
+		info := "Failed to get columns"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", ErrGetSnapshot)
+	}
+
+	cards, err := uc.todoSvc.GetCards(ctx, dto.CardQuery{BoardID: id})
+	if err != nil {
+		info := "Failed to get cards"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", ErrGetSnapshot)
+	}
+
+	snapshot := buildSnapshot(*board, swimlanes, columns, cards)
+
+	uc.log.Info(ctx, header+"Got board snapshot", "swimlanes", len(swimlanes), "columns", len(columns), "cards", len(cards))
+
+	// The snapshot is what opening a board fetches. Failing to remember the
+	// view should not keep the user from the board.
+	if err := uc.todoSvc.RecordBoardView(ctx, userID, id); err != nil {
+		uc.log.Warn(ctx, header+"Failed to record board view", "err", err.Error())
+	}
+
+	return snapshot, nil
+}
+
+// buildSnapshot lays cards out on the swimlane by column grid of a board.
+// Cards keep the order they were listed in, which is by position.
+func buildSnapshot(board dto.Board, swimlanes []dto.Swimlane, columns []dto.Column, cards []dto.Card) *dto.BoardSnapshot {
+	sort.SliceStable(swimlanes, func(i, j int) bool { return swimlanes[i].Position < swimlanes[j].Position })
+	sort.SliceStable(columns, func(i, j int) bool { return columns[i].Position < columns[j].Position })
+
+	type cell struct{ swimlaneID, columnID uuid.UUID }
+	cells := make(map[cell][]dto.Card)
+	for _, card := range cards {
+		key := cell{card.SwimlaneID, card.ColumnID}
+		cells[key] = append(cells[key], card)
+	}
+
+	snapshot := &dto.BoardSnapshot{
+		Board:     board,
+		Swimlanes: make([]dto.LaneSnapshot, len(swimlanes)),
+	}
+	for i, swimlane := range swimlanes {
+		lane := dto.LaneSnapshot{
+			Swimlane: swimlane,
+			Columns:  make([]dto.ColumnSnapshot, len(columns)),
+		}
+		for j, column := range columns {
+			lane.Columns[j] = dto.ColumnSnapshot{
+				Column: column,
+				Cards:  cells[cell{swimlane.ID, column.ID}],
+			}
+			if lane.Columns[j].Cards == nil {
+				lane.Columns[j].Cards = []dto.Card{}
+			}
+		}
+		snapshot.Swimlanes[i] = lane
+	}
+
+	return snapshot
+}
+
+func (uc *AggregatorUseCase) GetSwimlane(ctx context.Context, id string) (*dto.Swimlane, error) {
+	header := "GetSwimlane: "
+
+	uc.log.Info(ctx, header+"Usecase called; Making request to todo service", "id", id)
+
+	swimlane, err := uc.todoSvc.GetSwimlane(ctx, id)
+
+	if err != nil {
+		info := "Failed to get swimlane"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", ErrGetSwimlane)
+	}
+
+	uc.log.Info(ctx, header+"Got swimlane", "swimlane", swimlane)
+
+	return swimlane, nil
+}
+
+func (uc *AggregatorUseCase) GetColumn(ctx context.Context, id string) (*dto.Column, error) {
+	header := "GetColumn: "
+
+	uc.log.Info(ctx, header+"Usecase called; Making request to todo service", "id", id)
+
+	column, err := uc.todoSvc.GetColumn(ctx, id)
+
+	if err != nil {
+		info := "Failed to get column"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", ErrGetColumn)
+	}
+
+	uc.log.Info(ctx, header+"Got column", "column", column)
+
+	return column, nil
+}
+
 func (uc *AggregatorUseCase) GetCard(ctx context.Context, id string) (*dto.Card, error) {
 	header := "GetCard: "
 
@@ -343,6 +763,24 @@ func (uc *AggregatorUseCase) GetCard(ctx context.Context, id string) (*dto.Card,
 	return card, nil
 }
 
+func (uc *AggregatorUseCase) GetCardAncestors(ctx context.Context, id string) ([]dto.Card, error) {
+	header := "GetCardAncestors: "
+
+	uc.log.Info(ctx, header+"Usecase called; Making request to todo service", "id", id)
+
+	cards, err := uc.todoSvc.GetCardAncestors(ctx, id)
+
+	if err != nil {
+		info := "Failed to get card ancestors"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", ErrGetAncestors)
+	}
+
+	uc.log.Info(ctx, header+"Got card ancestors", "cards", cards)
+
+	return cards, nil
+}
+
 func (uc *AggregatorUseCase) CreateBoard(ctx context.Context, board dto.Board) error {
 	header := "CreateBoard: "
 
@@ -350,6 +788,18 @@ func (uc *AggregatorUseCase) CreateBoard(ctx context.Context, board dto.Board) e
 
 	err := uc.todoSvc.CreateBoard(ctx, board)
 
+	if errors.Is(err, todo.ErrWorkspaceAccess) {
+		info := "User may not create boards in the workspace"
+		uc.log.Info(ctx, header+info, "userID", board.UserID, "workspaceID", board.WorkspaceID)
+		return fmt.Errorf(header+info+": %w", err)
+	}
+
+	if errors.Is(err, todo.ErrQuotaExceeded) {
+		info := "User is at their board quota"
+		uc.log.Info(ctx, header+info, "userID", board.UserID)
+		return fmt.Errorf(header+info+": %w", err)
+	}
+
 	if err != nil {
 		info := "Failed to create board"
 		uc.log.Error(ctx, header+info, "err", err.Error())
@@ -368,6 +818,12 @@ func (uc *AggregatorUseCase) CreateColumn(ctx context.Context, column dto.Column
 
 	err := uc.todoSvc.CreateColumn(ctx, column)
 
+	if errors.Is(err, todo.ErrQuotaExceeded) {
+		info := "Board is at its column quota"
+		uc.log.Info(ctx, header+info, "boardID", column.BoardID)
+		return fmt.Errorf(header+info+": %w", err)
+	}
+
 	if err != nil {
 		info := "Failed to create column"
 		uc.log.Error(ctx, header+info, "err", err.Error())
@@ -379,22 +835,84 @@ func (uc *AggregatorUseCase) CreateColumn(ctx context.Context, column dto.Column
 	return nil
 }
 
-func (uc *AggregatorUseCase) CreateCard(ctx context.Context, card dto.Card) error {
+func (uc *AggregatorUseCase) CreateSwimlane(ctx context.Context, swimlane dto.Swimlane) error {
+	header := "CreateSwimlane: "
+
+	uc.log.Info(ctx, header+"Usecase called; Making request to todo service", "swimlane", swimlane)
+
+	err := uc.todoSvc.CreateSwimlane(ctx, swimlane)
+
+	if err != nil {
+		info := "Failed to create swimlane"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return fmt.Errorf(header+info+": %w", ErrCreateSwimlane)
+	}
+
+	uc.log.Info(ctx, header+"Successfully created swimlane")
+
+	return nil
+}
+
+func (uc *AggregatorUseCase) CreateCard(ctx context.Context, card dto.Card) (*dto.Card, error) {
 	header := "CreateCard: "
 
 	uc.log.Info(ctx, header+"Usecase called; Making request to todo service", "card", card)
 
-	err := uc.todoSvc.CreateCard(ctx, card)
+	created, err := uc.todoSvc.CreateCard(ctx, card)
+
+	if errors.Is(err, todo.ErrQuotaExceeded) {
+		info := "Card is over the quota of the board"
+		uc.log.Info(ctx, header+info, "columnID", card.ColumnID)
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	if err != nil {
+		info := "Failed to create card"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", ErrCreateCard)
+	}
+
+	uc.log.Info(ctx, header+"Successfully created card", "id", created.ID)
+
+	uc.notifyCard(ctx, header, card.UserID, created.ID, dto.NotificationCardCreated, parseMentions(card.Description))
+
+	return created, nil
+}
+
+func (uc *AggregatorUseCase) CreateCardFromTemplate(ctx context.Context, templateID string, card dto.Card) (*dto.Card, error) {
+	header := "CreateCardFromTemplate: "
+
+	uc.log.Info(ctx, header+"Usecase called; Making request to user service (GetUserByID)", "userID", card.UserID)
+
+	var username string
+	if u, err := uc.userSvc.GetUserByID(ctx, card.UserID.String()); err != nil {
+		uc.log.Warn(ctx, header+"Failed to get user; {{user}} stands for their id", "err", err.Error())
+	} else {
+		username = u.Username
+	}
+
+	uc.log.Info(ctx, header+"Making request to todo service", "templateID", templateID, "card", card)
+
+	created, err := uc.todoSvc.CreateCardFromTemplate(ctx, templateID, card, username)
+
+	if errors.Is(err, todo.ErrInvalidCardTemplate) || errors.Is(err, todo.ErrCardTemplateNotFound) || errors.Is(err, todo.ErrQuotaExceeded) ||
+		errors.Is(err, todo.ErrBoardAccess) {
+		info := "Card was rejected"
+		uc.log.Info(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
 
 	if err != nil {
 		info := "Failed to create card"
 		uc.log.Error(ctx, header+info, "err", err.Error())
-		return fmt.Errorf(header+info+": %w", ErrCreateCard)
+		return nil, fmt.Errorf(header+info+": %w", ErrCreateCard)
 	}
 
-	uc.log.Info(ctx, header+"Successfully created card")
+	uc.log.Info(ctx, header+"Successfully created card", "id", created.ID)
 
-	return nil
+	uc.notifyCard(ctx, header, card.UserID, created.ID, dto.NotificationCardCreated, parseMentions(created.Description))
+
+	return created, nil
 }
 
 func (uc *AggregatorUseCase) UpdateBoard(ctx context.Context, board *dto.Board) error {
@@ -404,6 +922,12 @@ func (uc *AggregatorUseCase) UpdateBoard(ctx context.Context, board *dto.Board)
 
 	err := uc.todoSvc.UpdateBoard(ctx, board)
 
+	if errors.Is(err, todo.ErrVersionConflict) {
+		info := "Board was changed concurrently"
+		uc.log.Info(ctx, header+info, "id", board.ID, "version", board.Version)
+		return fmt.Errorf(header+info+": %w", err)
+	}
+
 	if err != nil {
 		info := "Failed to update board"
 		uc.log.Error(ctx, header+info, "err", err.Error())
@@ -422,6 +946,12 @@ func (uc *AggregatorUseCase) UpdateColumn(ctx context.Context, column *dto.Colum
 
 	err := uc.todoSvc.UpdateColumn(ctx, column)
 
+	if errors.Is(err, todo.ErrVersionConflict) {
+		info := "Column was changed concurrently"
+		uc.log.Info(ctx, header+info, "id", column.ID, "version", column.Version)
+		return fmt.Errorf(header+info+": %w", err)
+	}
+
 	if err != nil {
 		info := "Failed to update column"
 		uc.log.Error(ctx, header+info, "err", err.Error())
@@ -433,30 +963,113 @@ func (uc *AggregatorUseCase) UpdateColumn(ctx context.Context, column *dto.Colum
 	return nil
 }
 
-func (uc *AggregatorUseCase) UpdateCard(ctx context.Context, card *dto.Card) error {
-	header := "UpdateCard: "
+func (uc *AggregatorUseCase) UpdateSwimlane(ctx context.Context, swimlane *dto.Swimlane) error {
+	header := "UpdateSwimlane: "
 
-	uc.log.Info(ctx, header+"Usecase called; Making request to todo service", "card", card)
+	uc.log.Info(ctx, header+"Usecase called; Making request to todo service", "swimlane", swimlane)
 
-	err := uc.todoSvc.UpdateCard(ctx, card)
+	err := uc.todoSvc.UpdateSwimlane(ctx, swimlane)
+
+	if errors.Is(err, todo.ErrVersionConflict) {
+		info := "Swimlane was changed concurrently"
+		uc.log.Info(ctx, header+info, "id", swimlane.ID, "version", swimlane.Version)
+		return fmt.Errorf(header+info+": %w", err)
+	}
 
 	if err != nil {
-		info := "Failed to update card"
+		info := "Failed to update swimlane"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return fmt.Errorf(header+info+": %w", ErrUpdateSwimlane)
+	}
+
+	uc.log.Info(ctx, header+"Successfully updated swimlane")
+
+	return nil
+}
+
+func (uc *AggregatorUseCase) UpdateCard(ctx context.Context, card *dto.Card) error {
+	header := "UpdateCard: "
+
+	uc.log.Info(ctx, header+"Usecase called; Making requests to todo service", "card", card)
+
+	// Only the users the edit adds a mention of are told of it. Without the
+	// card as it was, every user mentioned is.
+	var before string
+	if prev, err := uc.todoSvc.GetCard(ctx, card.ID.String()); err != nil {
+		uc.log.Warn(ctx, header+"Failed to get card before update", "err", err.Error())
+	} else {
+		before = prev.Description
+	}
+
+	err := uc.todoSvc.UpdateCard(ctx, card)
+
+	if errors.Is(err, todo.ErrVersionConflict) {
+		info := "Card was changed concurrently"
+		uc.log.Info(ctx, header+info, "id", card.ID, "version", card.Version)
+		return fmt.Errorf(header+info+": %w", err)
+	}
+
+	if errors.Is(err, todo.ErrQuotaExceeded) {
+		info := "Card is over the quota of the board"
+		uc.log.Info(ctx, header+info, "id", card.ID, "columnID", card.ColumnID)
+		return fmt.Errorf(header+info+": %w", err)
+	}
+
+	if err != nil {
+		info := "Failed to update card"
 		uc.log.Error(ctx, header+info, "err", err.Error())
 		return fmt.Errorf(header+info+": %w", ErrUpdateCard)
 	}
 
 	uc.log.Info(ctx, header+"Successfully updated card")
 
+	uc.notifyCard(ctx, header, card.UserID, card.ID, dto.NotificationCardUpdated, newMentions(before, card.Description))
+
+	return nil
+}
+
+func (uc *AggregatorUseCase) SetCardParent(ctx context.Context, card *dto.Card) error {
+	header := "SetCardParent: "
+
+	uc.log.Info(ctx, header+"Usecase called; Making request to todo service", "card", card)
+
+	err := uc.todoSvc.SetCardParent(ctx, card)
+
+	if errors.Is(err, todo.ErrVersionConflict) {
+		info := "Card was changed concurrently"
+		uc.log.Info(ctx, header+info, "id", card.ID, "version", card.Version)
+		return fmt.Errorf(header+info+": %w", err)
+	}
+
+	if errors.Is(err, todo.ErrCardParent) {
+		info := "Card cannot go under that parent"
+		uc.log.Info(ctx, header+info, "id", card.ID, "parentID", card.ParentID)
+		return fmt.Errorf(header+info+": %w", err)
+	}
+
+	if err != nil {
+		info := "Failed to set card parent"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return fmt.Errorf(header+info+": %w", ErrSetCardParent)
+	}
+
+	uc.log.Info(ctx, header+"Successfully set card parent")
+
 	return nil
 }
 
-func (uc *AggregatorUseCase) DeleteBoard(ctx context.Context, id string) error {
+func (uc *AggregatorUseCase) DeleteBoard(ctx context.Context, id string, version int) error {
 	header := "DeleteBoard: "
 
-	uc.log.Info(ctx, header+"Usecase called; Making request to todo service", "id", id)
+	uc.log.Info(ctx, header+"Usecase called; Making request to todo service", "id", id, "version", version)
+
+	err := uc.todoSvc.DeleteBoard(ctx, id, version)
 
-	err := uc.todoSvc.DeleteBoard(ctx, id)
+	if errors.Is(err, todo.ErrVersionConflict) {
+		info := "Board was changed concurrently"
+		uc.log.Info(ctx, header+info, "id", id, "version", version)
+		return fmt.Errorf(header+info+": %w", err)
+	}
 
 	if err != nil {
 		info := "Failed to delete board"
@@ -469,12 +1082,18 @@ func (uc *AggregatorUseCase) DeleteBoard(ctx context.Context, id string) error {
 	return nil
 }
 
-func (uc *AggregatorUseCase) DeleteColumn(ctx context.Context, id string) error {
+func (uc *AggregatorUseCase) DeleteColumn(ctx context.Context, id string, version int) error {
 	header := "DeleteColumn: "
 
-	uc.log.Info(ctx, header+"Usecase called; Making request to todo service", "id", id)
+	uc.log.Info(ctx, header+"Usecase called; Making request to todo service", "id", id, "version", version)
 
-	err := uc.todoSvc.DeleteColumn(ctx, id)
+	err := uc.todoSvc.DeleteColumn(ctx, id, version)
+
+	if errors.Is(err, todo.ErrVersionConflict) {
+		info := "Column was changed concurrently"
+		uc.log.Info(ctx, header+info, "id", id, "version", version)
+		return fmt.Errorf(header+info+": %w", err)
+	}
 
 	if err != nil {
 		info := "Failed to delete column"
@@ -487,12 +1106,48 @@ func (uc *AggregatorUseCase) DeleteColumn(ctx context.Context, id string) error
 	return nil
 }
 
-func (uc *AggregatorUseCase) DeleteCard(ctx context.Context, id string) error {
+func (uc *AggregatorUseCase) DeleteSwimlane(ctx context.Context, id string, version int) error {
+	header := "DeleteSwimlane: "
+
+	uc.log.Info(ctx, header+"Usecase called; Making request to todo service", "id", id, "version", version)
+
+	err := uc.todoSvc.DeleteSwimlane(ctx, id, version)
+
+	if errors.Is(err, todo.ErrVersionConflict) {
+		info := "Swimlane was changed concurrently"
+		uc.log.Info(ctx, header+info, "id", id, "version", version)
+		return fmt.Errorf(header+info+": %w", err)
+	}
+
+	if errors.Is(err, todo.ErrLastSwimlane) {
+		info := "Swimlane is the last one of its board"
+		uc.log.Info(ctx, header+info, "id", id)
+		return fmt.Errorf(header+info+": %w", err)
+	}
+
+	if err != nil {
+		info := "Failed to delete swimlane"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return fmt.Errorf(header+info+": %w", ErrDeleteSwimlane)
+	}
+
+	uc.log.Info(ctx, header+"Successfully deleted swimlane")
+
+	return nil
+}
+
+func (uc *AggregatorUseCase) DeleteCard(ctx context.Context, id string, version int) error {
 	header := "DeleteCard: "
 
-	uc.log.Info(ctx, header+"Usecase called; Making request to todo service", "id", id)
+	uc.log.Info(ctx, header+"Usecase called; Making request to todo service", "id", id, "version", version)
 
-	err := uc.todoSvc.DeleteCard(ctx, id)
+	err := uc.todoSvc.DeleteCard(ctx, id, version)
+
+	if errors.Is(err, todo.ErrVersionConflict) {
+		info := "Card was changed concurrently"
+		uc.log.Info(ctx, header+info, "id", id, "version", version)
+		return fmt.Errorf(header+info+": %w", err)
+	}
 
 	if err != nil {
 		info := "Failed to delete card"
@@ -504,3 +1159,1058 @@ func (uc *AggregatorUseCase) DeleteCard(ctx context.Context, id string) error {
 
 	return nil
 }
+
+func (uc *AggregatorUseCase) BulkCards(ctx context.Context, req dto.BulkCardsRequest) (*dto.BulkCardsResponse, error) {
+	header := "BulkCards: "
+
+	uc.log.Info(ctx, header+"Usecase called; Making request to todo service", "req", req)
+
+	res, err := uc.todoSvc.BulkCards(ctx, req)
+
+	if errors.Is(err, todo.ErrInvalidBulk) {
+		info := "Bulk request was rejected"
+		uc.log.Info(ctx, header+info, "ops", len(req.Ops))
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	if err != nil {
+		info := "Failed to apply card operations"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", ErrBulkCards)
+	}
+
+	uc.log.Info(ctx, header+"Applied card operations", "results", res.Results)
+
+	return res, nil
+}
+
+func (uc *AggregatorUseCase) MoveColumn(ctx context.Context, column *dto.Column) error {
+	header := "MoveColumn: "
+
+	uc.log.Info(ctx, header+"Usecase called; Making request to todo service", "column", column)
+
+	err := uc.todoSvc.MoveColumn(ctx, column)
+
+	if errors.Is(err, todo.ErrVersionConflict) || errors.Is(err, todo.ErrInvalidMove) || errors.Is(err, todo.ErrBoardAccess) ||
+		errors.Is(err, todo.ErrQuotaExceeded) {
+		info := "Column was not moved"
+		uc.log.Info(ctx, header+info, "id", column.ID, "err", err.Error())
+		return fmt.Errorf(header+info+": %w", err)
+	}
+
+	if err != nil {
+		info := "Failed to move column"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return fmt.Errorf(header+info+": %w", ErrMoveColumn)
+	}
+
+	uc.log.Info(ctx, header+"Successfully moved column", "boardID", column.BoardID)
+
+	return nil
+}
+
+func (uc *AggregatorUseCase) MergeBoards(ctx context.Context, req dto.MergeBoardsRequest) (*dto.BoardMerge, error) {
+	header := "MergeBoards: "
+
+	uc.log.Info(ctx, header+"Usecase called; Making request to todo service", "req", req)
+
+	merge, err := uc.todoSvc.MergeBoards(ctx, req)
+
+	if errors.Is(err, todo.ErrVersionConflict) || errors.Is(err, todo.ErrInvalidMove) || errors.Is(err, todo.ErrBoardAccess) ||
+		errors.Is(err, todo.ErrQuotaExceeded) {
+		info := "Boards were not merged"
+		uc.log.Info(ctx, header+info, "sourceID", req.SourceID, "targetID", req.TargetID, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	if err != nil {
+		info := "Failed to merge boards"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", ErrMergeBoards)
+	}
+
+	uc.log.Info(ctx, header+"Merged boards", "moved", len(merge.Moved), "combined", len(merge.Combined))
+
+	return merge, nil
+}
+
+func (uc *AggregatorUseCase) StartTimer(ctx context.Context, req dto.StartTimerRequest) (*dto.TimeEntry, error) {
+	header := "StartTimer: "
+
+	uc.log.Info(ctx, header+"Usecase called; Making request to todo service", "req", req)
+
+	entry, err := uc.todoSvc.StartTimer(ctx, req)
+
+	if errors.Is(err, todo.ErrTimerRunning) {
+		info := "Timer already running"
+		uc.log.Info(ctx, header+info, "userID", req.UserID)
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	if errors.Is(err, todo.ErrBoardAccess) {
+		info := "Card access was refused"
+		uc.log.Info(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	if err != nil {
+		info := "Failed to start timer"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", ErrStartTimer)
+	}
+
+	uc.log.Info(ctx, header+"Started timer", "entry", entry)
+
+	return entry, nil
+}
+
+func (uc *AggregatorUseCase) StopTimer(ctx context.Context, req dto.StopTimerRequest) (*dto.TimeEntry, error) {
+	header := "StopTimer: "
+
+	uc.log.Info(ctx, header+"Usecase called; Making request to todo service", "req", req)
+
+	entry, err := uc.todoSvc.StopTimer(ctx, req)
+
+	if errors.Is(err, todo.ErrNoTimerRunning) {
+		info := "No timer to stop"
+		uc.log.Info(ctx, header+info, "userID", req.UserID)
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	if err != nil {
+		info := "Failed to stop timer"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", ErrStopTimer)
+	}
+
+	uc.log.Info(ctx, header+"Stopped timer", "entry", entry)
+
+	return entry, nil
+}
+
+func (uc *AggregatorUseCase) LogTime(ctx context.Context, req dto.LogTimeRequest) (*dto.TimeEntry, error) {
+	header := "LogTime: "
+
+	uc.log.Info(ctx, header+"Usecase called; Making request to todo service", "req", req)
+
+	entry, err := uc.todoSvc.LogTime(ctx, req)
+
+	if errors.Is(err, todo.ErrInvalidTime) || errors.Is(err, todo.ErrBoardAccess) {
+		info := "Time entry was rejected"
+		uc.log.Info(ctx, header+info, "startedAt", req.StartedAt, "endedAt", req.EndedAt)
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	if err != nil {
+		info := "Failed to log time"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", ErrLogTime)
+	}
+
+	uc.log.Info(ctx, header+"Logged time", "entry", entry)
+
+	return entry, nil
+}
+
+func (uc *AggregatorUseCase) GetCardTimeEntries(ctx context.Context, cardID string) ([]dto.TimeEntry, error) {
+	header := "GetCardTimeEntries: "
+
+	uc.log.Info(ctx, header+"Usecase called; Making request to todo service", "cardID", cardID)
+
+	entries, err := uc.todoSvc.GetCardTimeEntries(ctx, cardID)
+
+	if errors.Is(err, todo.ErrBoardAccess) {
+		info := "Card access was refused"
+		uc.log.Info(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	if err != nil {
+		info := "Failed to get time entries"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", ErrGetTimeEntries)
+	}
+
+	uc.log.Info(ctx, header+"Got time entries", "count", len(entries))
+
+	return entries, nil
+}
+
+func (uc *AggregatorUseCase) GetTimeReport(ctx context.Context, query dto.TimeReportQuery) ([]dto.TimeReportRow, error) {
+	header := "GetTimeReport: "
+
+	uc.log.Info(ctx, header+"Usecase called; Making request to todo service", "query", query)
+
+	rows, err := uc.todoSvc.GetTimeReport(ctx, query)
+
+	if errors.Is(err, todo.ErrInvalidTime) || errors.Is(err, todo.ErrBoardAccess) {
+		info := "Time report query was rejected"
+		uc.log.Info(ctx, header+info, "query", query)
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	if err != nil {
+		info := "Failed to get time report"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", ErrGetTimeReport)
+	}
+
+	uc.log.Info(ctx, header+"Got time report", "rows", len(rows))
+
+	return rows, nil
+}
+
+func (uc *AggregatorUseCase) GetBoardAnalytics(ctx context.Context, boardID, from, to string) (*dto.BoardAnalytics, error) {
+	header := "GetBoardAnalytics: "
+
+	uc.log.Info(ctx, header+"Usecase called; Making request to todo service", "boardID", boardID, "from", from, "to", to)
+
+	analytics, err := uc.todoSvc.GetBoardAnalytics(ctx, boardID, from, to)
+
+	if errors.Is(err, todo.ErrInvalidPeriod) {
+		info := "Analytics period was rejected"
+		uc.log.Info(ctx, header+info, "from", from, "to", to)
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	if errors.Is(err, todo.ErrBoardAccess) {
+		info := "Board access was refused"
+		uc.log.Info(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	if err != nil {
+		info := "Failed to get board analytics"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", ErrGetAnalytics)
+	}
+
+	uc.log.Info(ctx, header+"Got board analytics", "cards", analytics.LeadTime.Cards)
+
+	return analytics, nil
+}
+
+func (uc *AggregatorUseCase) GetBoardStats(ctx context.Context, userID, boardID, from, to, staleDays string) (*dto.BoardStats, error) {
+	header := "GetBoardStats: "
+
+	uc.log.Info(ctx, header+"Usecase called; Making request to todo service", "userID", userID, "boardID", boardID, "from", from, "to", to, "staleDays", staleDays)
+
+	stats, err := uc.todoSvc.GetBoardStats(ctx, userID, boardID, from, to, staleDays)
+
+	if errors.Is(err, todo.ErrInvalidStats) || errors.Is(err, todo.ErrBoardAccess) || errors.Is(err, todo.ErrBoardNotFound) {
+		info := "Board stats were refused"
+		uc.log.Info(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	if err != nil {
+		info := "Failed to get board stats"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", ErrGetBoardStats)
+	}
+
+	// As in the inbox, a contributor that cannot be read keeps their id and
+	// loses only the name.
+	for i, c := range stats.Contributors {
+		u, err := uc.userSvc.GetUserByID(ctx, c.UserID.String())
+		if err != nil {
+			uc.log.Warn(ctx, header+"Failed to get contributor", "userID", c.UserID, "err", err.Error())
+			continue
+		}
+
+		stats.Contributors[i].Username = u.Username
+	}
+
+	uc.log.Info(ctx, header+"Got board stats", "columns", len(stats.Columns), "contributors", len(stats.Contributors))
+
+	return stats, nil
+}
+
+func (uc *AggregatorUseCase) CreateSprint(ctx context.Context, req dto.CreateSprintRequest) (*dto.Sprint, error) {
+	header := "CreateSprint: "
+
+	uc.log.Info(ctx, header+"Usecase called; Making request to todo service", "req", req)
+
+	sprint, err := uc.todoSvc.CreateSprint(ctx, req)
+
+	if errors.Is(err, todo.ErrInvalidSprint) || errors.Is(err, todo.ErrBoardAccess) {
+		info := "Sprint was rejected"
+		uc.log.Info(ctx, header+info, "req", req)
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	if err != nil {
+		info := "Failed to create sprint"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", ErrCreateSprint)
+	}
+
+	uc.log.Info(ctx, header+"Created sprint", "sprint", sprint)
+
+	return sprint, nil
+}
+
+func (uc *AggregatorUseCase) GetSprint(ctx context.Context, id string) (*dto.Sprint, error) {
+	header := "GetSprint: "
+
+	uc.log.Info(ctx, header+"Usecase called; Making request to todo service", "id", id)
+
+	sprint, err := uc.todoSvc.GetSprint(ctx, id)
+
+	if errors.Is(err, todo.ErrBoardAccess) {
+		info := "Sprint access was refused"
+		uc.log.Info(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	if err != nil {
+		info := "Failed to get sprint"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", ErrGetSprint)
+	}
+
+	uc.log.Info(ctx, header+"Got sprint", "sprint", sprint)
+
+	return sprint, nil
+}
+
+func (uc *AggregatorUseCase) GetBoardSprints(ctx context.Context, boardID string) ([]dto.Sprint, error) {
+	header := "GetBoardSprints: "
+
+	uc.log.Info(ctx, header+"Usecase called; Making request to todo service", "boardID", boardID)
+
+	sprints, err := uc.todoSvc.GetBoardSprints(ctx, boardID)
+
+	if errors.Is(err, todo.ErrBoardAccess) {
+		info := "Sprint access was refused"
+		uc.log.Info(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	if err != nil {
+		info := "Failed to get sprints of board"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", ErrGetBoardSprints)
+	}
+
+	uc.log.Info(ctx, header+"Got sprints", "count", len(sprints))
+
+	return sprints, nil
+}
+
+func (uc *AggregatorUseCase) GetSprintCards(ctx context.Context, sprintID string) ([]dto.SprintCard, error) {
+	header := "GetSprintCards: "
+
+	uc.log.Info(ctx, header+"Usecase called; Making request to todo service", "sprintID", sprintID)
+
+	cards, err := uc.todoSvc.GetSprintCards(ctx, sprintID)
+
+	if errors.Is(err, todo.ErrBoardAccess) {
+		info := "Sprint access was refused"
+		uc.log.Info(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	if err != nil {
+		info := "Failed to get sprint cards"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", ErrGetSprintCards)
+	}
+
+	uc.log.Info(ctx, header+"Got sprint cards", "count", len(cards))
+
+	return cards, nil
+}
+
+func (uc *AggregatorUseCase) AddSprintCard(ctx context.Context, sprintID string, req dto.AddSprintCardRequest) (*dto.SprintCard, error) {
+	header := "AddSprintCard: "
+
+	uc.log.Info(ctx, header+"Usecase called; Making request to todo service", "sprintID", sprintID, "req", req)
+
+	card, err := uc.todoSvc.AddSprintCard(ctx, sprintID, req)
+
+	if errors.Is(err, todo.ErrInvalidSprint) || errors.Is(err, todo.ErrSprintClosed) || errors.Is(err, todo.ErrBoardAccess) {
+		info := "Card was not taken into the sprint"
+		uc.log.Info(ctx, header+info, "sprintID", sprintID, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	if err != nil {
+		info := "Failed to add card to sprint"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", ErrAddSprintCard)
+	}
+
+	uc.log.Info(ctx, header+"Added card to sprint", "card", card)
+
+	return card, nil
+}
+
+func (uc *AggregatorUseCase) RemoveSprintCard(ctx context.Context, sprintID, cardID string) error {
+	header := "RemoveSprintCard: "
+
+	uc.log.Info(ctx, header+"Usecase called; Making request to todo service", "sprintID", sprintID, "cardID", cardID)
+
+	err := uc.todoSvc.RemoveSprintCard(ctx, sprintID, cardID)
+
+	if errors.Is(err, todo.ErrBoardAccess) {
+		info := "Sprint access was refused"
+		uc.log.Info(ctx, header+info, "err", err.Error())
+		return fmt.Errorf(header+info+": %w", err)
+	}
+
+	if err != nil {
+		info := "Failed to remove card from sprint"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return fmt.Errorf(header+info+": %w", ErrRemoveSprintCard)
+	}
+
+	uc.log.Info(ctx, header+"Removed card from sprint")
+
+	return nil
+}
+
+func (uc *AggregatorUseCase) CloseSprint(ctx context.Context, sprintID string, req dto.CloseSprintRequest) (*dto.CloseSprintResponse, error) {
+	header := "CloseSprint: "
+
+	uc.log.Info(ctx, header+"Usecase called; Making request to todo service", "sprintID", sprintID, "req", req)
+
+	closed, err := uc.todoSvc.CloseSprint(ctx, sprintID, req)
+
+	if errors.Is(err, todo.ErrInvalidSprint) || errors.Is(err, todo.ErrSprintClosed) || errors.Is(err, todo.ErrBoardAccess) {
+		info := "Sprint was not closed"
+		uc.log.Info(ctx, header+info, "sprintID", sprintID, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	if err != nil {
+		info := "Failed to close sprint"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", ErrCloseSprint)
+	}
+
+	uc.log.Info(ctx, header+"Closed sprint", "carried", closed.Carried)
+
+	return closed, nil
+}
+
+func (uc *AggregatorUseCase) GetSprintBurndown(ctx context.Context, sprintID string) ([]dto.BurndownPoint, error) {
+	header := "GetSprintBurndown: "
+
+	uc.log.Info(ctx, header+"Usecase called; Making request to todo service", "sprintID", sprintID)
+
+	points, err := uc.todoSvc.GetSprintBurndown(ctx, sprintID)
+
+	if errors.Is(err, todo.ErrBoardAccess) {
+		info := "Sprint access was refused"
+		uc.log.Info(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	if err != nil {
+		info := "Failed to get sprint burndown"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", ErrGetBurndown)
+	}
+
+	uc.log.Info(ctx, header+"Got sprint burndown", "count", len(points))
+
+	return points, nil
+}
+
+func (uc *AggregatorUseCase) CreateCardTemplate(ctx context.Context, req dto.CardTemplateRequest) (*dto.CardTemplate, error) {
+	header := "CreateCardTemplate: "
+
+	uc.log.Info(ctx, header+"Usecase called; Making request to todo service", "req", req)
+
+	template, err := uc.todoSvc.CreateCardTemplate(ctx, req)
+
+	if err != nil {
+		return nil, uc.templateResult(ctx, header, err, ErrCreateTemplate)
+	}
+
+	uc.log.Info(ctx, header+"Created card template", "template", template)
+
+	return template, nil
+}
+
+func (uc *AggregatorUseCase) GetCardTemplate(ctx context.Context, id string) (*dto.CardTemplate, error) {
+	header := "GetCardTemplate: "
+
+	uc.log.Info(ctx, header+"Usecase called; Making request to todo service", "id", id)
+
+	template, err := uc.todoSvc.GetCardTemplate(ctx, id)
+
+	if err != nil {
+		return nil, uc.templateResult(ctx, header, err, ErrGetTemplate)
+	}
+
+	uc.log.Info(ctx, header+"Got card template", "template", template)
+
+	return template, nil
+}
+
+func (uc *AggregatorUseCase) GetBoardCardTemplates(ctx context.Context, boardID string) ([]dto.CardTemplate, error) {
+	header := "GetBoardCardTemplates: "
+
+	uc.log.Info(ctx, header+"Usecase called; Making request to todo service", "boardID", boardID)
+
+	templates, err := uc.todoSvc.GetBoardCardTemplates(ctx, boardID)
+
+	if err != nil {
+		return nil, uc.templateResult(ctx, header, err, ErrGetTemplates)
+	}
+
+	uc.log.Info(ctx, header+"Got card templates", "count", len(templates))
+
+	return templates, nil
+}
+
+func (uc *AggregatorUseCase) UpdateCardTemplate(ctx context.Context, id string, req dto.CardTemplateRequest) (*dto.CardTemplate, error) {
+	header := "UpdateCardTemplate: "
+
+	uc.log.Info(ctx, header+"Usecase called; Making request to todo service", "id", id, "req", req)
+
+	template, err := uc.todoSvc.UpdateCardTemplate(ctx, id, req)
+
+	if err != nil {
+		return nil, uc.templateResult(ctx, header, err, ErrUpdateTemplate)
+	}
+
+	uc.log.Info(ctx, header+"Updated card template", "template", template)
+
+	return template, nil
+}
+
+func (uc *AggregatorUseCase) DeleteCardTemplate(ctx context.Context, id string) error {
+	header := "DeleteCardTemplate: "
+
+	uc.log.Info(ctx, header+"Usecase called; Making request to todo service", "id", id)
+
+	err := uc.todoSvc.DeleteCardTemplate(ctx, id)
+
+	if err != nil {
+		return uc.templateResult(ctx, header, err, ErrDeleteTemplate)
+	}
+
+	uc.log.Info(ctx, header+"Deleted card template", "id", id)
+
+	return nil
+}
+
+// templateResult passes on the card template errors of the todo service
+// the caller can act on and stands failed for the others.
+func (uc *AggregatorUseCase) templateResult(ctx context.Context, header string, err, failed error) error {
+	if errors.Is(err, todo.ErrInvalidCardTemplate) || errors.Is(err, todo.ErrCardTemplateNotFound) ||
+		errors.Is(err, todo.ErrCardTemplateExists) || errors.Is(err, todo.ErrBoardAccess) {
+		info := "Card template request was rejected"
+		uc.log.Info(ctx, header+info, "err", err.Error())
+		return fmt.Errorf(header+info+": %w", err)
+	}
+
+	info := "Card template request failed"
+	uc.log.Error(ctx, header+info, "err", err.Error())
+	return fmt.Errorf(header+info+": %w", failed)
+}
+
+func (uc *AggregatorUseCase) CreateWorkspace(ctx context.Context, req dto.CreateWorkspaceRequest) (*dto.Workspace, error) {
+	header := "CreateWorkspace: "
+
+	uc.log.Info(ctx, header+"Usecase called; Making request to todo service", "req", req)
+
+	workspace, err := uc.todoSvc.CreateWorkspace(ctx, req)
+
+	if errors.Is(err, todo.ErrInvalidWorkspace) {
+		info := "Workspace was rejected"
+		uc.log.Info(ctx, header+info, "req", req)
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	if err != nil {
+		info := "Failed to create workspace"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", ErrCreateWorkspace)
+	}
+
+	uc.log.Info(ctx, header+"Created workspace", "workspace", workspace)
+
+	return workspace, nil
+}
+
+func (uc *AggregatorUseCase) GetWorkspaces(ctx context.Context, userID string) ([]dto.WorkspaceMembership, error) {
+	header := "GetWorkspaces: "
+
+	uc.log.Info(ctx, header+"Usecase called; Making request to todo service", "userID", userID)
+
+	workspaces, err := uc.todoSvc.GetWorkspaces(ctx, userID)
+
+	if err != nil {
+		info := "Failed to get workspaces"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", ErrGetWorkspaces)
+	}
+
+	uc.log.Info(ctx, header+"Got workspaces", "count", len(workspaces))
+
+	return workspaces, nil
+}
+
+func (uc *AggregatorUseCase) GetWorkspaceMembers(ctx context.Context, userID, workspaceID string) ([]dto.WorkspaceMember, error) {
+	header := "GetWorkspaceMembers: "
+
+	uc.log.Info(ctx, header+"Usecase called; Making request to todo service", "userID", userID, "workspaceID", workspaceID)
+
+	members, err := uc.todoSvc.GetWorkspaceMembers(ctx, userID, workspaceID)
+
+	if refused := workspaceRefusal(err); refused != nil {
+		info := "Members were not listed"
+		uc.log.Info(ctx, header+info, "workspaceID", workspaceID, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", refused)
+	}
+
+	if err != nil {
+		info := "Failed to get workspace members"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", ErrGetMembers)
+	}
+
+	uc.log.Info(ctx, header+"Got workspace members", "count", len(members))
+
+	return members, nil
+}
+
+func (uc *AggregatorUseCase) AddWorkspaceMember(ctx context.Context, workspaceID string, req dto.WorkspaceMemberRequest) error {
+	header := "AddWorkspaceMember: "
+
+	uc.log.Info(ctx, header+"Usecase called; Making request to todo service", "workspaceID", workspaceID, "req", req)
+
+	err := uc.todoSvc.AddWorkspaceMember(ctx, workspaceID, req)
+
+	if refused := workspaceRefusal(err); refused != nil {
+		info := "Member was not added"
+		uc.log.Info(ctx, header+info, "workspaceID", workspaceID, "err", err.Error())
+		return fmt.Errorf(header+info+": %w", refused)
+	}
+
+	if err != nil {
+		info := "Failed to add workspace member"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return fmt.Errorf(header+info+": %w", ErrAddMember)
+	}
+
+	uc.log.Info(ctx, header+"Added workspace member", "userID", req.UserID, "role", req.Role)
+
+	return nil
+}
+
+func (uc *AggregatorUseCase) UpdateWorkspaceMember(ctx context.Context, workspaceID string, req dto.WorkspaceMemberRequest) error {
+	header := "UpdateWorkspaceMember: "
+
+	uc.log.Info(ctx, header+"Usecase called; Making request to todo service", "workspaceID", workspaceID, "req", req)
+
+	err := uc.todoSvc.UpdateWorkspaceMember(ctx, workspaceID, req)
+
+	if refused := workspaceRefusal(err); refused != nil {
+		info := "Member role was not changed"
+		uc.log.Info(ctx, header+info, "workspaceID", workspaceID, "err", err.Error())
+		return fmt.Errorf(header+info+": %w", refused)
+	}
+
+	if err != nil {
+		info := "Failed to update workspace member"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return fmt.Errorf(header+info+": %w", ErrUpdateMember)
+	}
+
+	uc.log.Info(ctx, header+"Changed workspace member role", "userID", req.UserID, "role", req.Role)
+
+	return nil
+}
+
+func (uc *AggregatorUseCase) RemoveWorkspaceMember(ctx context.Context, actorID, workspaceID, userID string) error {
+	header := "RemoveWorkspaceMember: "
+
+	uc.log.Info(ctx, header+"Usecase called; Making request to todo service", "actorID", actorID, "workspaceID", workspaceID, "userID", userID)
+
+	err := uc.todoSvc.RemoveWorkspaceMember(ctx, actorID, workspaceID, userID)
+
+	if refused := workspaceRefusal(err); refused != nil {
+		info := "Member was not removed"
+		uc.log.Info(ctx, header+info, "workspaceID", workspaceID, "err", err.Error())
+		return fmt.Errorf(header+info+": %w", refused)
+	}
+
+	if err != nil {
+		info := "Failed to remove workspace member"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return fmt.Errorf(header+info+": %w", ErrRemoveMember)
+	}
+
+	uc.log.Info(ctx, header+"Removed workspace member", "userID", userID)
+
+	return nil
+}
+
+func (uc *AggregatorUseCase) GetWorkspaceBoards(ctx context.Context, userID, workspaceID string) ([]dto.Board, error) {
+	header := "GetWorkspaceBoards: "
+
+	uc.log.Info(ctx, header+"Usecase called; Making request to todo service", "userID", userID, "workspaceID", workspaceID)
+
+	boards, err := uc.todoSvc.GetWorkspaceBoards(ctx, userID, workspaceID)
+
+	if refused := workspaceRefusal(err); refused != nil {
+		info := "Boards were not listed"
+		uc.log.Info(ctx, header+info, "workspaceID", workspaceID, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", refused)
+	}
+
+	if err != nil {
+		info := "Failed to get workspace boards"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", ErrWorkspaceBoards)
+	}
+
+	uc.log.Info(ctx, header+"Got workspace boards", "count", len(boards))
+
+	return boards, nil
+}
+
+// workspaceRefusal is the todo service error err stands for if the todo
+// service refused a workspace request rather than failed it.
+func workspaceRefusal(err error) error {
+	for _, refusal := range []error{
+		todo.ErrInvalidWorkspace,
+		todo.ErrWorkspaceNotFound,
+		todo.ErrWorkspaceAccess,
+		todo.ErrWorkspaceConflict,
+	} {
+		if errors.Is(err, refusal) {
+			return refusal
+		}
+	}
+
+	return nil
+}
+
+func (uc *AggregatorUseCase) RegenerateCalendarToken(ctx context.Context, userID string) (*dto.CalendarToken, error) {
+	header := "RegenerateCalendarToken: "
+
+	uc.log.Info(ctx, header+"Usecase called; Making request to todo service", "userID", userID)
+
+	token, err := uc.todoSvc.RegenerateCalendarToken(ctx, userID)
+
+	if err != nil {
+		info := "Failed to regenerate calendar token"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", ErrCalendarToken)
+	}
+
+	token.FeedPath = fmt.Sprintf(CalendarFeedPath, token.Token)
+
+	uc.log.Info(ctx, header+"Regenerated calendar token", "userID", userID)
+
+	return token, nil
+}
+
+func (uc *AggregatorUseCase) GetCalendarFeed(ctx context.Context, token, kind string) ([]byte, error) {
+	header := "GetCalendarFeed: "
+
+	uc.log.Info(ctx, header+"Usecase called; Making request to todo service", "kind", kind)
+
+	cards, err := uc.todoSvc.GetCalendarCards(ctx, token)
+
+	if errors.Is(err, todo.ErrCalendarTokenNotFound) {
+		info := "Unknown calendar token"
+		uc.log.Info(ctx, header+info)
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	if err != nil {
+		info := "Failed to get calendar cards"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", ErrGetCalendar)
+	}
+
+	uc.log.Info(ctx, header+"Got calendar cards", "count", len(cards))
+
+	return renderCalendar(cards, kind, time.Now()), nil
+}
+
+func (uc *AggregatorUseCase) WatchBoard(ctx context.Context, boardID, lastEventID string) (io.ReadCloser, error) {
+	header := "WatchBoard: "
+
+	uc.log.Info(ctx, header+"Usecase called; Making request to todo service", "boardID", boardID, "lastEventID", lastEventID)
+
+	stream, err := uc.todoSvc.WatchBoard(ctx, boardID, lastEventID)
+
+	if errors.Is(err, todo.ErrInvalidWatch) || errors.Is(err, todo.ErrBoardAccess) || errors.Is(err, todo.ErrBoardNotFound) {
+		info := "Board cannot be watched"
+		uc.log.Info(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	if err != nil {
+		info := "Failed to watch board"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", ErrWatchBoard)
+	}
+
+	uc.log.Info(ctx, header+"Watching board", "boardID", boardID)
+
+	return stream, nil
+}
+
+func (uc *AggregatorUseCase) TransferBoard(ctx context.Context, boardID, toUserID string) (*dto.Board, error) {
+	header := "TransferBoard: "
+
+	uc.log.Info(ctx, header+"Usecase called; Making request to user service (GetUserByID)", "boardID", boardID, "toUserID", toUserID)
+
+	if err := uc.userExists(ctx, header, toUserID); err != nil {
+		return nil, err
+	}
+
+	uc.log.Info(ctx, header+"User exists; Making request to todo service", "toUserID", toUserID)
+
+	board, err := uc.todoSvc.TransferBoard(ctx, boardID, toUserID)
+
+	if refused := transferRefusal(err); refused != nil {
+		info := "Board was not transferred"
+		uc.log.Info(ctx, header+info, "boardID", boardID, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", refused)
+	}
+
+	if err != nil {
+		info := "Failed to transfer board"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", ErrTransferBoard)
+	}
+
+	uc.log.Info(ctx, header+"Transferred board", "boardID", boardID, "toUserID", toUserID)
+
+	return board, nil
+}
+
+// DeleteUser runs the deletion of a user across the services in steps:
+//
+//  1. the boards of the user go to transferTo, if set;
+//  2. the auth service revokes the refresh tokens of the user;
+//  3. the todo service deletes what is left of the user's data;
+//  4. the user service deletes the user.
+//
+// A failure of the first two steps gives the transferred boards back, so
+// the user is left as they were, but for having to log in again. Deleting
+// the data cannot be undone, so a failure of the last step is reported
+// as is; every step is harmless to repeat, so the caller retries.
+func (uc *AggregatorUseCase) DeleteUser(ctx context.Context, userID, transferTo string) error {
+	header := "DeleteUser: "
+
+	uc.log.Info(ctx, header+"Usecase called; Making request to user service (GetUserByID)", "userID", userID, "transferTo", transferTo)
+
+	if err := uc.userExists(ctx, header, userID); err != nil {
+		return err
+	}
+
+	var transferred []dto.Board
+
+	if transferTo != "" {
+		if transferTo == userID {
+			info := "Boards cannot go to the user deleted"
+			uc.log.Info(ctx, header+info, "userID", userID)
+			return fmt.Errorf(header+info+": %w", todo.ErrInvalidTransfer)
+		}
+
+		if err := uc.userExists(ctx, header, transferTo); err != nil {
+			return err
+		}
+
+		uc.log.Info(ctx, header+"Making request to todo service (TransferUserBoards)", "userID", userID, "transferTo", transferTo)
+
+		boards, err := uc.todoSvc.TransferUserBoards(ctx, userID, transferTo, nil)
+
+		if refused := transferRefusal(err); refused != nil {
+			info := "Boards were not transferred"
+			uc.log.Info(ctx, header+info, "userID", userID, "err", err.Error())
+			return fmt.Errorf(header+info+": %w", refused)
+		}
+
+		if err != nil {
+			info := "Failed to transfer boards"
+			uc.log.Error(ctx, header+info, "err", err.Error())
+			return fmt.Errorf(header+info+": %w", ErrDeleteUser)
+		}
+
+		transferred = boards
+
+		uc.log.Info(ctx, header+"Transferred boards", "count", len(transferred))
+	}
+
+	uc.log.Info(ctx, header+"Making request to auth service (RevokeUserTokens)", "userID", userID)
+
+	if err := uc.authSvc.RevokeUserTokens(ctx, userID); err != nil {
+		info := "Failed to revoke tokens"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return uc.restoreBoards(ctx, header, userID, transferTo, transferred, fmt.Errorf(header+info+": %w", ErrDeleteUser))
+	}
+
+	uc.log.Info(ctx, header+"Making request to todo service (DeleteUserData)", "userID", userID)
+
+	if err := uc.todoSvc.DeleteUserData(ctx, userID); err != nil {
+		info := "Failed to delete user data"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return uc.restoreBoards(ctx, header, userID, transferTo, transferred, fmt.Errorf(header+info+": %w", ErrDeleteUser))
+	}
+
+	uc.log.Info(ctx, header+"Making request to user service (DeleteUser)", "userID", userID)
+
+	if err := uc.userSvc.DeleteUser(ctx, userID); err != nil {
+		info := "Failed to delete user; their data is gone, retry to finish"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return fmt.Errorf(header+info+": %w", ErrDeleteUser)
+	}
+
+	uc.log.Info(ctx, header+"Deleted user", "userID", userID, "transferred", len(transferred))
+
+	return nil
+}
+
+func (uc *AggregatorUseCase) GetUserQuota(ctx context.Context, userID string) (*dto.UserQuota, error) {
+	header := "GetUserQuota: "
+
+	uc.log.Info(ctx, header+"Usecase called; Making request to user service (GetUserByID)", "userID", userID)
+
+	if err := uc.userExists(ctx, header, userID); err != nil {
+		return nil, err
+	}
+
+	uc.log.Info(ctx, header+"User exists; Making request to todo service", "userID", userID)
+
+	quota, err := uc.todoSvc.GetUserQuota(ctx, userID)
+
+	if err != nil {
+		info := "Failed to get user quota"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", ErrGetQuota)
+	}
+
+	uc.log.Info(ctx, header+"Successfully got user quota", "userID", userID)
+
+	return quota, nil
+}
+
+func (uc *AggregatorUseCase) SetQuotaOverride(ctx context.Context, userID string, req dto.QuotaOverrideRequest) (*dto.UserQuota, error) {
+	header := "SetQuotaOverride: "
+
+	uc.log.Info(ctx, header+"Usecase called; Making request to user service (GetUserByID)", "userID", userID)
+
+	if err := uc.userExists(ctx, header, userID); err != nil {
+		return nil, err
+	}
+
+	uc.log.Info(ctx, header+"User exists; Making request to todo service", "userID", userID, "req", req)
+
+	quota, err := uc.todoSvc.SetQuotaOverride(ctx, userID, req)
+
+	if errors.Is(err, todo.ErrInvalidQuota) {
+		info := "Quota override was rejected"
+		uc.log.Info(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	if err != nil {
+		info := "Failed to set quota override"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", ErrSetQuota)
+	}
+
+	uc.log.Info(ctx, header+"Successfully set quota override", "userID", userID)
+
+	return quota, nil
+}
+
+func (uc *AggregatorUseCase) DeleteQuotaOverride(ctx context.Context, userID string) error {
+	header := "DeleteQuotaOverride: "
+
+	uc.log.Info(ctx, header+"Usecase called; Making request to todo service", "userID", userID)
+
+	err := uc.todoSvc.DeleteQuotaOverride(ctx, userID)
+
+	if errors.Is(err, todo.ErrQuotaOverrideNotFound) {
+		info := "User has no quota override"
+		uc.log.Info(ctx, header+info, "userID", userID)
+		return fmt.Errorf(header+info+": %w", err)
+	}
+
+	if err != nil {
+		info := "Failed to delete quota override"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return fmt.Errorf(header+info+": %w", ErrDeleteQuota)
+	}
+
+	uc.log.Info(ctx, header+"Successfully deleted quota override", "userID", userID)
+
+	return nil
+}
+
+// restoreBoards gives the boards transferred from userID to toUserID back
+// after the deletion failed with failed, which it returns along with its
+// own error if any.
+func (uc *AggregatorUseCase) restoreBoards(ctx context.Context, header, userID, toUserID string, transferred []dto.Board, failed error) error {
+	if len(transferred) == 0 {
+		return failed
+	}
+
+	ids := make([]string, len(transferred))
+	for i, board := range transferred {
+		ids[i] = board.ID.String()
+	}
+
+	uc.log.Info(ctx, header+"Making request to todo service (TransferUserBoards) to give boards back", "count", len(ids))
+
+	if _, err := uc.todoSvc.TransferUserBoards(ctx, toUserID, userID, ids); err != nil {
+		info := "Failed to give boards back"
+		uc.log.Error(ctx, header+info, "boardIDs", ids, "err", err.Error())
+		return errors.Join(failed, fmt.Errorf(header+info+": %w", ErrRestoreBoards))
+	}
+
+	uc.log.Info(ctx, header+"Gave boards back", "count", len(ids))
+
+	return failed
+}
+
+// userExists checks with the user service that the user with the id
+// exists.
+func (uc *AggregatorUseCase) userExists(ctx context.Context, header, id string) error {
+	_, err := uc.userSvc.GetUserByID(ctx, id)
+
+	if errors.Is(err, user.ErrUserNotFound) {
+		info := "User not found"
+		uc.log.Info(ctx, header+info, "userID", id)
+		return fmt.Errorf(header+info+": %w", err)
+	}
+
+	if err != nil {
+		info := "Failed to get user"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return fmt.Errorf(header+info+": %w", ErrGetUser)
+	}
+
+	return nil
+}
+
+// transferRefusal is the todo service error err stands for if the todo
+// service refused a board transfer rather than failed it.
+func transferRefusal(err error) error {
+	for _, refusal := range []error{
+		todo.ErrInvalidTransfer,
+		todo.ErrBoardNotFound,
+		todo.ErrBoardAccess,
+		todo.ErrVersionConflict,
+		todo.ErrQuotaExceeded,
+	} {
+		if errors.Is(err, refusal) {
+			return refusal
+		}
+	}
+
+	return nil
+}
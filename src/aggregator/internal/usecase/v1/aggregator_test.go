@@ -3,10 +3,14 @@ package v1_test
 import (
 	log "aggregator/internal/adapter/logger"
 	"aggregator/internal/dto"
+	"aggregator/internal/service/todo"
+	"aggregator/internal/service/user"
 	"aggregator/internal/testdata"
 	"aggregator/mocks"
 	"context"
 	"errors"
+	"io"
+	"strings"
 	"testing"
 	"time"
 
@@ -15,6 +19,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/ozontech/allure-go/pkg/framework/provider"
 	"github.com/ozontech/allure-go/pkg/framework/runner"
+	"github.com/stretchr/testify/mock"
 )
 
 func TestGetStats(t *testing.T) {
@@ -628,18 +633,22 @@ func TestGetCards(t *testing.T) {
 
 		tests := []struct {
 			name      string
-			columnID  string
-			mockSetup func(mockTodoSvc *mocks.TodoService, columnID string)
+			query     dto.CardQuery
+			mockSetup func(mockTodoSvc *mocks.TodoService, query dto.CardQuery)
 			wantErr   bool
 			err       error
 		}{
 			{
-				name:     "positive",
-				columnID: mom.GetUUID(0).String(),
-				mockSetup: func(mockTodoSvc *mocks.TodoService, columnID string) {
+				name: "positive",
+				query: dto.CardQuery{
+					ColumnID: mom.GetUUID(0).String(),
+					Priority: []string{"3", "4"},
+					Sort:     "due",
+				},
+				mockSetup: func(mockTodoSvc *mocks.TodoService, query dto.CardQuery) {
 					cardDTOs := make([]dto.Card, 3)
 
-					cid, _ := uuid.Parse(columnID)
+					cid, _ := uuid.Parse(query.ColumnID)
 
 					cardDTOs[0] = dto.Card{
 						ID:       mom.GetUUID(1),
@@ -660,15 +669,17 @@ func TestGetCards(t *testing.T) {
 						Title:    "CardTwo",
 					}
 
-					mockTodoSvc.On("GetCards", context.Background(), columnID).Return(cardDTOs, nil)
+					mockTodoSvc.On("GetCards", context.Background(), query).Return(cardDTOs, nil)
 				},
 				wantErr: false,
 			},
 			{
-				name:     "negative",
-				columnID: mom.GetUUID(0).String(),
-				mockSetup: func(mockTodoSvc *mocks.TodoService, columnID string) {
-					mockTodoSvc.On("GetCards", context.Background(), columnID).Return(nil, errors.New(""))
+				name: "negative",
+				query: dto.CardQuery{
+					BoardID: mom.GetUUID(0).String(),
+				},
+				mockSetup: func(mockTodoSvc *mocks.TodoService, query dto.CardQuery) {
+					mockTodoSvc.On("GetCards", context.Background(), query).Return(nil, errors.New(""))
 				},
 				wantErr: true,
 				err:     v1.ErrGetCards,
@@ -687,10 +698,10 @@ func TestGetCards(t *testing.T) {
 
 					uc := v1.NewAggregatorUseCase(mockUserSvc, mockAuthSvc, mockTodoSvc, logger)
 
-					tt.mockSetup(mockTodoSvc, tt.columnID)
+					tt.mockSetup(mockTodoSvc, tt.query)
 
 					pt.WithNewStep("Call GetCards", func(sCtx provider.StepCtx) {
-						_, err := uc.GetCards(context.Background(), tt.columnID)
+						_, err := uc.GetCards(context.Background(), tt.query)
 
 						if tt.wantErr {
 							sCtx.Assert().Error(err, "Expected error")
@@ -707,8 +718,8 @@ func TestGetCards(t *testing.T) {
 	})
 }
 
-func TestGetCard(t *testing.T) {
-	runner.Run(t, "TestGetCard", func(pt provider.T) {
+func TestGetBoard(t *testing.T) {
+	runner.Run(t, "TestGetBoard", func(pt provider.T) {
 		mom := &testdata.ObjectMother{}
 
 		tests := []struct {
@@ -722,16 +733,16 @@ func TestGetCard(t *testing.T) {
 				name: "positive",
 				id:   mom.GetUUID(0).String(),
 				mockSetup: func(mockTodoSvc *mocks.TodoService, id string) {
-					cid, _ := uuid.Parse(id)
+					bid, _ := uuid.Parse(id)
 
-					cardDTO := dto.Card{
-						ID:       cid,
-						UserID:   mom.GetUUID(1),
-						ColumnID: mom.GetUUID(2),
-						Title:    "Card",
+					boardDTO := dto.Board{
+						ID:      bid,
+						UserID:  mom.GetUUID(1),
+						Title:   "Board",
+						Version: 1,
 					}
 
-					mockTodoSvc.On("GetCard", context.Background(), id).Return(&cardDTO, nil)
+					mockTodoSvc.On("GetBoard", context.Background(), id).Return(&boardDTO, nil)
 				},
 				wantErr: false,
 			},
@@ -739,10 +750,10 @@ func TestGetCard(t *testing.T) {
 				name: "negative",
 				id:   mom.GetUUID(0).String(),
 				mockSetup: func(mockTodoSvc *mocks.TodoService, id string) {
-					mockTodoSvc.On("GetCard", context.Background(), id).Return(nil, errors.New(""))
+					mockTodoSvc.On("GetBoard", context.Background(), id).Return(nil, errors.New(""))
 				},
 				wantErr: true,
-				err:     v1.ErrGetCard,
+				err:     v1.ErrGetBoard,
 			},
 		}
 
@@ -760,8 +771,8 @@ func TestGetCard(t *testing.T) {
 
 					tt.mockSetup(mockTodoSvc, tt.id)
 
-					pt.WithNewStep("Call GetCard", func(sCtx provider.StepCtx) {
-						_, err := uc.GetCard(context.Background(), tt.id)
+					pt.WithNewStep("Call GetBoard", func(sCtx provider.StepCtx) {
+						_, err := uc.GetBoard(context.Background(), tt.id)
 
 						if tt.wantErr {
 							sCtx.Assert().Error(err, "Expected error")
@@ -778,41 +789,66 @@ func TestGetCard(t *testing.T) {
 	})
 }
 
-func TestCreateBoard(t *testing.T) {
-	runner.Run(t, "TestCreateBoard", func(pt provider.T) {
+func TestGetBoardSnapshot(t *testing.T) {
+	runner.Run(t, "TestGetBoardSnapshot", func(pt provider.T) {
 		mom := &testdata.ObjectMother{}
 
+		userID := mom.GetUUID(8).String()
+		board := dto.Board{ID: mom.GetUUID(0), Title: "Board"}
+		swimlanes := []dto.Swimlane{
+			{ID: mom.GetUUID(1), BoardID: board.ID, Title: "Backend", Position: 1},
+			{ID: mom.GetUUID(2), BoardID: board.ID, Title: "Default", Position: 0},
+		}
+		columns := []dto.Column{
+			{ID: mom.GetUUID(3), BoardID: board.ID, Title: "Done", Position: 2},
+			{ID: mom.GetUUID(4), BoardID: board.ID, Title: "To do", Position: 1},
+		}
+		cards := []dto.Card{
+			{ID: mom.GetUUID(5), SwimlaneID: mom.GetUUID(1), ColumnID: mom.GetUUID(3), Position: 1},
+			{ID: mom.GetUUID(6), SwimlaneID: mom.GetUUID(1), ColumnID: mom.GetUUID(3), Position: 2},
+			{ID: mom.GetUUID(7), SwimlaneID: mom.GetUUID(2), ColumnID: mom.GetUUID(4), Position: 1},
+		}
+
 		tests := []struct {
 			name      string
-			board     dto.Board
-			mockSetup func(mockTodoSvc *mocks.TodoService, board dto.Board)
+			id        string
+			mockSetup func(mockTodoSvc *mocks.TodoService, id string)
 			wantErr   bool
 			err       error
 		}{
 			{
 				name: "positive",
-				board: dto.Board{
-					ID:     mom.GetUUID(0),
-					UserID: mom.GetUUID(1),
-					Title:  "PositiveBoard",
+				id:   board.ID.String(),
+				mockSetup: func(mockTodoSvc *mocks.TodoService, id string) {
+					mockTodoSvc.On("GetBoard", context.Background(), id).Return(&board, nil)
+					mockTodoSvc.On("GetSwimlanes", context.Background(), id).Return(append([]dto.Swimlane(nil), swimlanes...), nil)
+					mockTodoSvc.On("GetColumns", context.Background(), id).Return(append([]dto.Column(nil), columns...), nil)
+					mockTodoSvc.On("GetCards", context.Background(), dto.CardQuery{BoardID: id}).Return(cards, nil)
+					mockTodoSvc.On("RecordBoardView", context.Background(), userID, id).Return(nil)
 				},
-				mockSetup: func(mockTodoSvc *mocks.TodoService, board dto.Board) {
-					mockTodoSvc.On("CreateBoard", context.Background(), board).Return(nil)
+				wantErr: false,
+			},
+			{
+				name: "view not recorded",
+				id:   board.ID.String(),
+				mockSetup: func(mockTodoSvc *mocks.TodoService, id string) {
+					mockTodoSvc.On("GetBoard", context.Background(), id).Return(&board, nil)
+					mockTodoSvc.On("GetSwimlanes", context.Background(), id).Return(append([]dto.Swimlane(nil), swimlanes...), nil)
+					mockTodoSvc.On("GetColumns", context.Background(), id).Return(append([]dto.Column(nil), columns...), nil)
+					mockTodoSvc.On("GetCards", context.Background(), dto.CardQuery{BoardID: id}).Return(cards, nil)
+					mockTodoSvc.On("RecordBoardView", context.Background(), userID, id).Return(errors.New(""))
 				},
 				wantErr: false,
 			},
 			{
 				name: "negative",
-				board: dto.Board{
-					ID:     mom.GetUUID(0),
-					UserID: mom.GetUUID(1),
-					Title:  "NegativeBoard",
-				},
-				mockSetup: func(mockTodoSvc *mocks.TodoService, board dto.Board) {
-					mockTodoSvc.On("CreateBoard", context.Background(), board).Return(errors.New(""))
+				id:   board.ID.String(),
+				mockSetup: func(mockTodoSvc *mocks.TodoService, id string) {
+					mockTodoSvc.On("GetBoard", context.Background(), id).Return(&board, nil)
+					mockTodoSvc.On("GetSwimlanes", context.Background(), id).Return(nil, errors.New(""))
 				},
 				wantErr: true,
-				err:     v1.ErrCreateBoard,
+				err:     v1.ErrGetSnapshot,
 			},
 		}
 
@@ -828,16 +864,29 @@ func TestCreateBoard(t *testing.T) {
 
 					uc := v1.NewAggregatorUseCase(mockUserSvc, mockAuthSvc, mockTodoSvc, logger)
 
-					tt.mockSetup(mockTodoSvc, tt.board)
+					tt.mockSetup(mockTodoSvc, tt.id)
 
-					pt.WithNewStep("Call CreateBoard", func(sCtx provider.StepCtx) {
-						err := uc.CreateBoard(context.Background(), tt.board)
+					pt.WithNewStep("Call GetBoardSnapshot", func(sCtx provider.StepCtx) {
+						snapshot, err := uc.GetBoardSnapshot(context.Background(), userID, tt.id)
 
 						if tt.wantErr {
 							sCtx.Assert().Error(err, "Expected error")
 							sCtx.Assert().ErrorIs(err, tt.err)
 						} else {
 							sCtx.Assert().NoError(err, "Expected no error")
+							sCtx.Require().Len(snapshot.Swimlanes, 2)
+
+							lane := snapshot.Swimlanes[0]
+							sCtx.Assert().Equal("Default", lane.Title)
+							sCtx.Require().Len(lane.Columns, 2)
+							sCtx.Assert().Equal("To do", lane.Columns[0].Title)
+							sCtx.Assert().Equal(cards[2:], lane.Columns[0].Cards)
+							sCtx.Assert().Empty(lane.Columns[1].Cards)
+
+							lane = snapshot.Swimlanes[1]
+							sCtx.Assert().Equal("Backend", lane.Title)
+							sCtx.Assert().Empty(lane.Columns[0].Cards)
+							sCtx.Assert().Equal(cards[:2], lane.Columns[1].Cards)
 						}
 
 						mockTodoSvc.AssertExpectations(t)
@@ -848,43 +897,43 @@ func TestCreateBoard(t *testing.T) {
 	})
 }
 
-func TestCreateColumn(t *testing.T) {
-	runner.Run(t, "TestCreateColumn", func(pt provider.T) {
+func TestGetColumn(t *testing.T) {
+	runner.Run(t, "TestGetColumn", func(pt provider.T) {
 		mom := &testdata.ObjectMother{}
 
 		tests := []struct {
 			name      string
-			column    dto.Column
-			mockSetup func(mockTodoSvc *mocks.TodoService, column dto.Column)
+			id        string
+			mockSetup func(mockTodoSvc *mocks.TodoService, id string)
 			wantErr   bool
 			err       error
 		}{
 			{
 				name: "positive",
-				column: dto.Column{
-					ID:      mom.GetUUID(0),
-					UserID:  mom.GetUUID(1),
-					BoardID: mom.GetUUID(2),
-					Title:   "PositiveColumn",
-				},
-				mockSetup: func(mockTodoSvc *mocks.TodoService, column dto.Column) {
-					mockTodoSvc.On("CreateColumn", context.Background(), column).Return(nil)
+				id:   mom.GetUUID(0).String(),
+				mockSetup: func(mockTodoSvc *mocks.TodoService, id string) {
+					cid, _ := uuid.Parse(id)
+
+					columnDTO := dto.Column{
+						ID:      cid,
+						UserID:  mom.GetUUID(1),
+						BoardID: mom.GetUUID(2),
+						Title:   "Column",
+						Version: 1,
+					}
+
+					mockTodoSvc.On("GetColumn", context.Background(), id).Return(&columnDTO, nil)
 				},
 				wantErr: false,
 			},
 			{
 				name: "negative",
-				column: dto.Column{
-					ID:      mom.GetUUID(0),
-					UserID:  mom.GetUUID(1),
-					BoardID: mom.GetUUID(2),
-					Title:   "NegativeColumn",
-				},
-				mockSetup: func(mockTodoSvc *mocks.TodoService, column dto.Column) {
-					mockTodoSvc.On("CreateColumn", context.Background(), column).Return(errors.New(""))
+				id:   mom.GetUUID(0).String(),
+				mockSetup: func(mockTodoSvc *mocks.TodoService, id string) {
+					mockTodoSvc.On("GetColumn", context.Background(), id).Return(nil, errors.New(""))
 				},
 				wantErr: true,
-				err:     v1.ErrCreateColumn,
+				err:     v1.ErrGetColumn,
 			},
 		}
 
@@ -900,10 +949,10 @@ func TestCreateColumn(t *testing.T) {
 
 					uc := v1.NewAggregatorUseCase(mockUserSvc, mockAuthSvc, mockTodoSvc, logger)
 
-					tt.mockSetup(mockTodoSvc, tt.column)
+					tt.mockSetup(mockTodoSvc, tt.id)
 
-					pt.WithNewStep("Call CreateColumn", func(sCtx provider.StepCtx) {
-						err := uc.CreateColumn(context.Background(), tt.column)
+					pt.WithNewStep("Call GetColumn", func(sCtx provider.StepCtx) {
+						_, err := uc.GetColumn(context.Background(), tt.id)
 
 						if tt.wantErr {
 							sCtx.Assert().Error(err, "Expected error")
@@ -920,43 +969,42 @@ func TestCreateColumn(t *testing.T) {
 	})
 }
 
-func TestCreateCard(t *testing.T) {
-	runner.Run(t, "TestCreateCard", func(pt provider.T) {
+func TestGetCard(t *testing.T) {
+	runner.Run(t, "TestGetCard", func(pt provider.T) {
 		mom := &testdata.ObjectMother{}
 
 		tests := []struct {
 			name      string
-			card      dto.Card
-			mockSetup func(mockTodoSvc *mocks.TodoService, card dto.Card)
+			id        string
+			mockSetup func(mockTodoSvc *mocks.TodoService, id string)
 			wantErr   bool
 			err       error
 		}{
 			{
 				name: "positive",
-				card: dto.Card{
-					ID:       mom.GetUUID(0),
-					UserID:   mom.GetUUID(1),
-					ColumnID: mom.GetUUID(2),
-					Title:    "PositiveCard",
-				},
-				mockSetup: func(mockTodoSvc *mocks.TodoService, card dto.Card) {
-					mockTodoSvc.On("CreateCard", context.Background(), card).Return(nil)
+				id:   mom.GetUUID(0).String(),
+				mockSetup: func(mockTodoSvc *mocks.TodoService, id string) {
+					cid, _ := uuid.Parse(id)
+
+					cardDTO := dto.Card{
+						ID:       cid,
+						UserID:   mom.GetUUID(1),
+						ColumnID: mom.GetUUID(2),
+						Title:    "Card",
+					}
+
+					mockTodoSvc.On("GetCard", context.Background(), id).Return(&cardDTO, nil)
 				},
 				wantErr: false,
 			},
 			{
 				name: "negative",
-				card: dto.Card{
-					ID:       mom.GetUUID(0),
-					UserID:   mom.GetUUID(1),
-					ColumnID: mom.GetUUID(2),
-					Title:    "NegativeCard",
-				},
-				mockSetup: func(mockTodoSvc *mocks.TodoService, card dto.Card) {
-					mockTodoSvc.On("CreateCard", context.Background(), card).Return(errors.New(""))
+				id:   mom.GetUUID(0).String(),
+				mockSetup: func(mockTodoSvc *mocks.TodoService, id string) {
+					mockTodoSvc.On("GetCard", context.Background(), id).Return(nil, errors.New(""))
 				},
 				wantErr: true,
-				err:     v1.ErrCreateCard,
+				err:     v1.ErrGetCard,
 			},
 		}
 
@@ -972,10 +1020,10 @@ func TestCreateCard(t *testing.T) {
 
 					uc := v1.NewAggregatorUseCase(mockUserSvc, mockAuthSvc, mockTodoSvc, logger)
 
-					tt.mockSetup(mockTodoSvc, tt.card)
+					tt.mockSetup(mockTodoSvc, tt.id)
 
-					pt.WithNewStep("Call CreateCard", func(sCtx provider.StepCtx) {
-						err := uc.CreateCard(context.Background(), tt.card)
+					pt.WithNewStep("Call GetCard", func(sCtx provider.StepCtx) {
+						_, err := uc.GetCard(context.Background(), tt.id)
 
 						if tt.wantErr {
 							sCtx.Assert().Error(err, "Expected error")
@@ -992,14 +1040,14 @@ func TestCreateCard(t *testing.T) {
 	})
 }
 
-func TestUpdateBoard(t *testing.T) {
-	runner.Run(t, "TestUpdateBoard", func(pt provider.T) {
+func TestCreateBoard(t *testing.T) {
+	runner.Run(t, "TestCreateBoard", func(pt provider.T) {
 		mom := &testdata.ObjectMother{}
 
 		tests := []struct {
 			name      string
 			board     dto.Board
-			mockSetup func(mockTodoSvc *mocks.TodoService, board *dto.Board)
+			mockSetup func(mockTodoSvc *mocks.TodoService, board dto.Board)
 			wantErr   bool
 			err       error
 		}{
@@ -1010,8 +1058,8 @@ func TestUpdateBoard(t *testing.T) {
 					UserID: mom.GetUUID(1),
 					Title:  "PositiveBoard",
 				},
-				mockSetup: func(mockTodoSvc *mocks.TodoService, board *dto.Board) {
-					mockTodoSvc.On("UpdateBoard", context.Background(), board).Return(nil)
+				mockSetup: func(mockTodoSvc *mocks.TodoService, board dto.Board) {
+					mockTodoSvc.On("CreateBoard", context.Background(), board).Return(nil)
 				},
 				wantErr: false,
 			},
@@ -1022,11 +1070,11 @@ func TestUpdateBoard(t *testing.T) {
 					UserID: mom.GetUUID(1),
 					Title:  "NegativeBoard",
 				},
-				mockSetup: func(mockTodoSvc *mocks.TodoService, board *dto.Board) {
-					mockTodoSvc.On("UpdateBoard", context.Background(), board).Return(errors.New(""))
+				mockSetup: func(mockTodoSvc *mocks.TodoService, board dto.Board) {
+					mockTodoSvc.On("CreateBoard", context.Background(), board).Return(errors.New(""))
 				},
 				wantErr: true,
-				err:     v1.ErrUpdateBoard,
+				err:     v1.ErrCreateBoard,
 			},
 		}
 
@@ -1042,10 +1090,10 @@ func TestUpdateBoard(t *testing.T) {
 
 					uc := v1.NewAggregatorUseCase(mockUserSvc, mockAuthSvc, mockTodoSvc, logger)
 
-					tt.mockSetup(mockTodoSvc, &tt.board)
+					tt.mockSetup(mockTodoSvc, tt.board)
 
-					pt.WithNewStep("Call UpdateBoard", func(sCtx provider.StepCtx) {
-						err := uc.UpdateBoard(context.Background(), &tt.board)
+					pt.WithNewStep("Call CreateBoard", func(sCtx provider.StepCtx) {
+						err := uc.CreateBoard(context.Background(), tt.board)
 
 						if tt.wantErr {
 							sCtx.Assert().Error(err, "Expected error")
@@ -1062,14 +1110,14 @@ func TestUpdateBoard(t *testing.T) {
 	})
 }
 
-func TestUpdateColumn(t *testing.T) {
-	runner.Run(t, "TestUpdateColumn", func(pt provider.T) {
+func TestCreateColumn(t *testing.T) {
+	runner.Run(t, "TestCreateColumn", func(pt provider.T) {
 		mom := &testdata.ObjectMother{}
 
 		tests := []struct {
 			name      string
 			column    dto.Column
-			mockSetup func(mockTodoSvc *mocks.TodoService, column *dto.Column)
+			mockSetup func(mockTodoSvc *mocks.TodoService, column dto.Column)
 			wantErr   bool
 			err       error
 		}{
@@ -1081,8 +1129,8 @@ func TestUpdateColumn(t *testing.T) {
 					BoardID: mom.GetUUID(2),
 					Title:   "PositiveColumn",
 				},
-				mockSetup: func(mockTodoSvc *mocks.TodoService, column *dto.Column) {
-					mockTodoSvc.On("UpdateColumn", context.Background(), column).Return(nil)
+				mockSetup: func(mockTodoSvc *mocks.TodoService, column dto.Column) {
+					mockTodoSvc.On("CreateColumn", context.Background(), column).Return(nil)
 				},
 				wantErr: false,
 			},
@@ -1094,11 +1142,11 @@ func TestUpdateColumn(t *testing.T) {
 					BoardID: mom.GetUUID(2),
 					Title:   "NegativeColumn",
 				},
-				mockSetup: func(mockTodoSvc *mocks.TodoService, column *dto.Column) {
-					mockTodoSvc.On("UpdateColumn", context.Background(), column).Return(errors.New(""))
+				mockSetup: func(mockTodoSvc *mocks.TodoService, column dto.Column) {
+					mockTodoSvc.On("CreateColumn", context.Background(), column).Return(errors.New(""))
 				},
 				wantErr: true,
-				err:     v1.ErrUpdateColumn,
+				err:     v1.ErrCreateColumn,
 			},
 		}
 
@@ -1114,10 +1162,10 @@ func TestUpdateColumn(t *testing.T) {
 
 					uc := v1.NewAggregatorUseCase(mockUserSvc, mockAuthSvc, mockTodoSvc, logger)
 
-					tt.mockSetup(mockTodoSvc, &tt.column)
+					tt.mockSetup(mockTodoSvc, tt.column)
 
-					pt.WithNewStep("Call UpdateColumn", func(sCtx provider.StepCtx) {
-						err := uc.UpdateColumn(context.Background(), &tt.column)
+					pt.WithNewStep("Call CreateColumn", func(sCtx provider.StepCtx) {
+						err := uc.CreateColumn(context.Background(), tt.column)
 
 						if tt.wantErr {
 							sCtx.Assert().Error(err, "Expected error")
@@ -1134,14 +1182,14 @@ func TestUpdateColumn(t *testing.T) {
 	})
 }
 
-func TestUpdateCard(t *testing.T) {
-	runner.Run(t, "TestUpdateCard", func(pt provider.T) {
+func TestCreateCard(t *testing.T) {
+	runner.Run(t, "TestCreateCard", func(pt provider.T) {
 		mom := &testdata.ObjectMother{}
 
 		tests := []struct {
 			name      string
 			card      dto.Card
-			mockSetup func(mockTodoSvc *mocks.TodoService, card *dto.Card)
+			mockSetup func(mockUserSvc *mocks.UserService, mockTodoSvc *mocks.TodoService, card dto.Card)
 			wantErr   bool
 			err       error
 		}{
@@ -1153,8 +1201,51 @@ func TestUpdateCard(t *testing.T) {
 					ColumnID: mom.GetUUID(2),
 					Title:    "PositiveCard",
 				},
-				mockSetup: func(mockTodoSvc *mocks.TodoService, card *dto.Card) {
-					mockTodoSvc.On("UpdateCard", context.Background(), card).Return(nil)
+				mockSetup: func(mockUserSvc *mocks.UserService, mockTodoSvc *mocks.TodoService, card dto.Card) {
+					mockTodoSvc.On("CreateCard", context.Background(), card).Return(&card, nil)
+					mockTodoSvc.On("NotifyCard", context.Background(), dto.NotifyCardRequest{
+						ActorID: card.UserID,
+						CardID:  card.ID,
+						Kind:    dto.NotificationCardCreated,
+					}).Return(nil, nil)
+				},
+				wantErr: false,
+			},
+			{
+				name: "positive mentions known users once",
+				card: dto.Card{
+					ID:          mom.GetUUID(0),
+					UserID:      mom.GetUUID(1),
+					ColumnID:    mom.GetUUID(2),
+					Title:       "MentionCard",
+					Description: "@UserZero, see @nobody_here and ask @UserZero; mail user@UserTwo.com",
+				},
+				mockSetup: func(mockUserSvc *mocks.UserService, mockTodoSvc *mocks.TodoService, card dto.Card) {
+					mockTodoSvc.On("CreateCard", context.Background(), card).Return(&card, nil)
+					mockUserSvc.On("GetUserByUsername", context.Background(), "UserZero").
+						Return(&dto.User{ID: mom.GetUUID(3), Username: "UserZero"}, nil).Once()
+					mockUserSvc.On("GetUserByUsername", context.Background(), "nobody_here").
+						Return(nil, user.ErrUserNotFound).Once()
+					mockTodoSvc.On("NotifyCard", context.Background(), dto.NotifyCardRequest{
+						ActorID:   card.UserID,
+						CardID:    card.ID,
+						Kind:      dto.NotificationCardCreated,
+						Mentioned: []uuid.UUID{mom.GetUUID(3)},
+					}).Return([]dto.Notification{{UserID: mom.GetUUID(3), Kind: dto.NotificationMention}}, nil)
+				},
+				wantErr: false,
+			},
+			{
+				name: "positive notify fails",
+				card: dto.Card{
+					ID:       mom.GetUUID(0),
+					UserID:   mom.GetUUID(1),
+					ColumnID: mom.GetUUID(2),
+					Title:    "UnnotifiedCard",
+				},
+				mockSetup: func(mockUserSvc *mocks.UserService, mockTodoSvc *mocks.TodoService, card dto.Card) {
+					mockTodoSvc.On("CreateCard", context.Background(), card).Return(&card, nil)
+					mockTodoSvc.On("NotifyCard", context.Background(), mock.Anything).Return(nil, errors.New(""))
 				},
 				wantErr: false,
 			},
@@ -1166,11 +1257,11 @@ func TestUpdateCard(t *testing.T) {
 					ColumnID: mom.GetUUID(2),
 					Title:    "NegativeCard",
 				},
-				mockSetup: func(mockTodoSvc *mocks.TodoService, card *dto.Card) {
-					mockTodoSvc.On("UpdateCard", context.Background(), card).Return(errors.New(""))
+				mockSetup: func(mockUserSvc *mocks.UserService, mockTodoSvc *mocks.TodoService, card dto.Card) {
+					mockTodoSvc.On("CreateCard", context.Background(), card).Return(nil, errors.New(""))
 				},
 				wantErr: true,
-				err:     v1.ErrUpdateCard,
+				err:     v1.ErrCreateCard,
 			},
 		}
 
@@ -1186,18 +1277,20 @@ func TestUpdateCard(t *testing.T) {
 
 					uc := v1.NewAggregatorUseCase(mockUserSvc, mockAuthSvc, mockTodoSvc, logger)
 
-					tt.mockSetup(mockTodoSvc, &tt.card)
+					tt.mockSetup(mockUserSvc, mockTodoSvc, tt.card)
 
-					pt.WithNewStep("Call UpdateCard", func(sCtx provider.StepCtx) {
-						err := uc.UpdateCard(context.Background(), &tt.card)
+					pt.WithNewStep("Call CreateCard", func(sCtx provider.StepCtx) {
+						card, err := uc.CreateCard(context.Background(), tt.card)
 
 						if tt.wantErr {
 							sCtx.Assert().Error(err, "Expected error")
 							sCtx.Assert().ErrorIs(err, tt.err)
 						} else {
 							sCtx.Assert().NoError(err, "Expected no error")
+							sCtx.Assert().Equal(tt.card.ID, card.ID)
 						}
 
+						mockUserSvc.AssertExpectations(t)
 						mockTodoSvc.AssertExpectations(t)
 					})
 				})
@@ -1206,33 +1299,79 @@ func TestUpdateCard(t *testing.T) {
 	})
 }
 
-func TestDeleteBoard(t *testing.T) {
-	runner.Run(t, "TestDeleteBoard", func(pt provider.T) {
+func TestCreateCardFromTemplate(t *testing.T) {
+	runner.Run(t, "TestCreateCardFromTemplate", func(pt provider.T) {
 		mom := &testdata.ObjectMother{}
 
+		templateID := mom.GetUUID(4).String()
+		card := dto.Card{
+			ID:       mom.GetUUID(0),
+			UserID:   mom.GetUUID(1),
+			ColumnID: mom.GetUUID(2),
+			Title:    "crash on save",
+		}
+
 		tests := []struct {
 			name      string
-			id        string
-			mockSetup func(mockTodoSvc *mocks.TodoService, id string)
+			mockSetup func(mockUserSvc *mocks.UserService, mockTodoSvc *mocks.TodoService)
 			wantErr   bool
 			err       error
 		}{
 			{
 				name: "positive",
-				id:   mom.GetUUID(0).String(),
-				mockSetup: func(mockTodoSvc *mocks.TodoService, id string) {
-					mockTodoSvc.On("DeleteBoard", context.Background(), id).Return(nil)
+				mockSetup: func(mockUserSvc *mocks.UserService, mockTodoSvc *mocks.TodoService) {
+					mockUserSvc.On("GetUserByID", context.Background(), card.UserID.String()).
+						Return(&dto.User{ID: card.UserID, Username: "UserOne"}, nil)
+					mockTodoSvc.On("CreateCardFromTemplate", context.Background(), templateID, card, "UserOne").Return(&card, nil)
+					mockTodoSvc.On("NotifyCard", context.Background(), dto.NotifyCardRequest{
+						ActorID: card.UserID,
+						CardID:  card.ID,
+						Kind:    dto.NotificationCardCreated,
+					}).Return(nil, nil)
+				},
+				wantErr: false,
+			},
+			{
+				name: "positive user unknown",
+				mockSetup: func(mockUserSvc *mocks.UserService, mockTodoSvc *mocks.TodoService) {
+					mockUserSvc.On("GetUserByID", context.Background(), card.UserID.String()).Return(nil, user.ErrUserNotFound)
+					mockTodoSvc.On("CreateCardFromTemplate", context.Background(), templateID, card, "").Return(&card, nil)
+					mockTodoSvc.On("NotifyCard", context.Background(), mock.Anything).Return(nil, nil)
 				},
 				wantErr: false,
 			},
+			{
+				name: "template not found",
+				mockSetup: func(mockUserSvc *mocks.UserService, mockTodoSvc *mocks.TodoService) {
+					mockUserSvc.On("GetUserByID", context.Background(), card.UserID.String()).
+						Return(&dto.User{ID: card.UserID, Username: "UserOne"}, nil)
+					mockTodoSvc.On("CreateCardFromTemplate", context.Background(), templateID, card, "UserOne").
+						Return(nil, todo.ErrCardTemplateNotFound)
+				},
+				wantErr: true,
+				err:     todo.ErrCardTemplateNotFound,
+			},
+			{
+				name: "template of another board",
+				mockSetup: func(mockUserSvc *mocks.UserService, mockTodoSvc *mocks.TodoService) {
+					mockUserSvc.On("GetUserByID", context.Background(), card.UserID.String()).
+						Return(&dto.User{ID: card.UserID, Username: "UserOne"}, nil)
+					mockTodoSvc.On("CreateCardFromTemplate", context.Background(), templateID, card, "UserOne").
+						Return(nil, todo.ErrInvalidCardTemplate)
+				},
+				wantErr: true,
+				err:     todo.ErrInvalidCardTemplate,
+			},
 			{
 				name: "negative",
-				id:   mom.GetUUID(0).String(),
-				mockSetup: func(mockTodoSvc *mocks.TodoService, id string) {
-					mockTodoSvc.On("DeleteBoard", context.Background(), id).Return(errors.New(""))
+				mockSetup: func(mockUserSvc *mocks.UserService, mockTodoSvc *mocks.TodoService) {
+					mockUserSvc.On("GetUserByID", context.Background(), card.UserID.String()).
+						Return(&dto.User{ID: card.UserID, Username: "UserOne"}, nil)
+					mockTodoSvc.On("CreateCardFromTemplate", context.Background(), templateID, card, "UserOne").
+						Return(nil, errors.New(""))
 				},
 				wantErr: true,
-				err:     v1.ErrDeleteBoard,
+				err:     v1.ErrCreateCard,
 			},
 		}
 
@@ -1248,18 +1387,20 @@ func TestDeleteBoard(t *testing.T) {
 
 					uc := v1.NewAggregatorUseCase(mockUserSvc, mockAuthSvc, mockTodoSvc, logger)
 
-					tt.mockSetup(mockTodoSvc, tt.id)
+					tt.mockSetup(mockUserSvc, mockTodoSvc)
 
-					pt.WithNewStep("Call DeleteBoard", func(sCtx provider.StepCtx) {
-						err := uc.DeleteBoard(context.Background(), tt.id)
+					pt.WithNewStep("Call CreateCardFromTemplate", func(sCtx provider.StepCtx) {
+						created, err := uc.CreateCardFromTemplate(context.Background(), templateID, card)
 
 						if tt.wantErr {
 							sCtx.Assert().Error(err, "Expected error")
 							sCtx.Assert().ErrorIs(err, tt.err)
 						} else {
 							sCtx.Assert().NoError(err, "Expected no error")
+							sCtx.Assert().Equal(card.ID, created.ID)
 						}
 
+						mockUserSvc.AssertExpectations(t)
 						mockTodoSvc.AssertExpectations(t)
 					})
 				})
@@ -1268,33 +1409,41 @@ func TestDeleteBoard(t *testing.T) {
 	})
 }
 
-func TestDeleteColumn(t *testing.T) {
-	runner.Run(t, "TestDeleteColumn", func(pt provider.T) {
+func TestUpdateBoard(t *testing.T) {
+	runner.Run(t, "TestUpdateBoard", func(pt provider.T) {
 		mom := &testdata.ObjectMother{}
 
 		tests := []struct {
 			name      string
-			id        string
-			mockSetup func(mockTodoSvc *mocks.TodoService, id string)
+			board     dto.Board
+			mockSetup func(mockTodoSvc *mocks.TodoService, board *dto.Board)
 			wantErr   bool
 			err       error
 		}{
 			{
 				name: "positive",
-				id:   mom.GetUUID(0).String(),
-				mockSetup: func(mockTodoSvc *mocks.TodoService, id string) {
-					mockTodoSvc.On("DeleteColumn", context.Background(), id).Return(nil)
+				board: dto.Board{
+					ID:     mom.GetUUID(0),
+					UserID: mom.GetUUID(1),
+					Title:  "PositiveBoard",
+				},
+				mockSetup: func(mockTodoSvc *mocks.TodoService, board *dto.Board) {
+					mockTodoSvc.On("UpdateBoard", context.Background(), board).Return(nil)
 				},
 				wantErr: false,
 			},
 			{
 				name: "negative",
-				id:   mom.GetUUID(0).String(),
-				mockSetup: func(mockTodoSvc *mocks.TodoService, id string) {
-					mockTodoSvc.On("DeleteColumn", context.Background(), id).Return(errors.New(""))
+				board: dto.Board{
+					ID:     mom.GetUUID(0),
+					UserID: mom.GetUUID(1),
+					Title:  "NegativeBoard",
+				},
+				mockSetup: func(mockTodoSvc *mocks.TodoService, board *dto.Board) {
+					mockTodoSvc.On("UpdateBoard", context.Background(), board).Return(errors.New(""))
 				},
 				wantErr: true,
-				err:     v1.ErrDeleteColumn,
+				err:     v1.ErrUpdateBoard,
 			},
 		}
 
@@ -1310,10 +1459,10 @@ func TestDeleteColumn(t *testing.T) {
 
 					uc := v1.NewAggregatorUseCase(mockUserSvc, mockAuthSvc, mockTodoSvc, logger)
 
-					tt.mockSetup(mockTodoSvc, tt.id)
+					tt.mockSetup(mockTodoSvc, &tt.board)
 
-					pt.WithNewStep("Call DeleteColumn", func(sCtx provider.StepCtx) {
-						err := uc.DeleteColumn(context.Background(), tt.id)
+					pt.WithNewStep("Call UpdateBoard", func(sCtx provider.StepCtx) {
+						err := uc.UpdateBoard(context.Background(), &tt.board)
 
 						if tt.wantErr {
 							sCtx.Assert().Error(err, "Expected error")
@@ -1330,8 +1479,200 @@ func TestDeleteColumn(t *testing.T) {
 	})
 }
 
-func TestDeleteCard(t *testing.T) {
-	runner.Run(t, "TestDeleteCard", func(pt provider.T) {
+func TestUpdateColumn(t *testing.T) {
+	runner.Run(t, "TestUpdateColumn", func(pt provider.T) {
+		mom := &testdata.ObjectMother{}
+
+		tests := []struct {
+			name      string
+			column    dto.Column
+			mockSetup func(mockTodoSvc *mocks.TodoService, column *dto.Column)
+			wantErr   bool
+			err       error
+		}{
+			{
+				name: "positive",
+				column: dto.Column{
+					ID:      mom.GetUUID(0),
+					UserID:  mom.GetUUID(1),
+					BoardID: mom.GetUUID(2),
+					Title:   "PositiveColumn",
+				},
+				mockSetup: func(mockTodoSvc *mocks.TodoService, column *dto.Column) {
+					mockTodoSvc.On("UpdateColumn", context.Background(), column).Return(nil)
+				},
+				wantErr: false,
+			},
+			{
+				name: "negative",
+				column: dto.Column{
+					ID:      mom.GetUUID(0),
+					UserID:  mom.GetUUID(1),
+					BoardID: mom.GetUUID(2),
+					Title:   "NegativeColumn",
+				},
+				mockSetup: func(mockTodoSvc *mocks.TodoService, column *dto.Column) {
+					mockTodoSvc.On("UpdateColumn", context.Background(), column).Return(errors.New(""))
+				},
+				wantErr: true,
+				err:     v1.ErrUpdateColumn,
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				t.Parallel()
+
+				runner.Run(t, tt.name, func(pt provider.T) {
+					mockUserSvc := new(mocks.UserService)
+					mockAuthSvc := new(mocks.AuthService)
+					mockTodoSvc := new(mocks.TodoService)
+					logger := log.NewEmptyLogger()
+
+					uc := v1.NewAggregatorUseCase(mockUserSvc, mockAuthSvc, mockTodoSvc, logger)
+
+					tt.mockSetup(mockTodoSvc, &tt.column)
+
+					pt.WithNewStep("Call UpdateColumn", func(sCtx provider.StepCtx) {
+						err := uc.UpdateColumn(context.Background(), &tt.column)
+
+						if tt.wantErr {
+							sCtx.Assert().Error(err, "Expected error")
+							sCtx.Assert().ErrorIs(err, tt.err)
+						} else {
+							sCtx.Assert().NoError(err, "Expected no error")
+						}
+
+						mockTodoSvc.AssertExpectations(t)
+					})
+				})
+			})
+		}
+	})
+}
+
+func TestUpdateCard(t *testing.T) {
+	runner.Run(t, "TestUpdateCard", func(pt provider.T) {
+		mom := &testdata.ObjectMother{}
+
+		tests := []struct {
+			name      string
+			card      dto.Card
+			mockSetup func(mockUserSvc *mocks.UserService, mockTodoSvc *mocks.TodoService, card *dto.Card)
+			wantErr   bool
+			err       error
+		}{
+			{
+				name: "positive",
+				card: dto.Card{
+					ID:       mom.GetUUID(0),
+					UserID:   mom.GetUUID(1),
+					ColumnID: mom.GetUUID(2),
+					Title:    "PositiveCard",
+				},
+				mockSetup: func(mockUserSvc *mocks.UserService, mockTodoSvc *mocks.TodoService, card *dto.Card) {
+					mockTodoSvc.On("GetCard", context.Background(), card.ID.String()).Return(card, nil)
+					mockTodoSvc.On("UpdateCard", context.Background(), card).Return(nil)
+					mockTodoSvc.On("NotifyCard", context.Background(), dto.NotifyCardRequest{
+						ActorID: card.UserID,
+						CardID:  card.ID,
+						Kind:    dto.NotificationCardUpdated,
+					}).Return(nil, nil)
+				},
+				wantErr: false,
+			},
+			{
+				name: "positive mentions only users added",
+				card: dto.Card{
+					ID:          mom.GetUUID(0),
+					UserID:      mom.GetUUID(1),
+					ColumnID:    mom.GetUUID(2),
+					Title:       "MentionCard",
+					Description: "@UserZero and now @UserThree",
+				},
+				mockSetup: func(mockUserSvc *mocks.UserService, mockTodoSvc *mocks.TodoService, card *dto.Card) {
+					mockTodoSvc.On("GetCard", context.Background(), card.ID.String()).
+						Return(&dto.Card{ID: card.ID, Description: "@UserZero"}, nil)
+					mockTodoSvc.On("UpdateCard", context.Background(), card).Return(nil)
+					mockUserSvc.On("GetUserByUsername", context.Background(), "UserThree").
+						Return(&dto.User{ID: mom.GetUUID(3), Username: "UserThree"}, nil).Once()
+					mockTodoSvc.On("NotifyCard", context.Background(), dto.NotifyCardRequest{
+						ActorID:   card.UserID,
+						CardID:    card.ID,
+						Kind:      dto.NotificationCardUpdated,
+						Mentioned: []uuid.UUID{mom.GetUUID(3)},
+					}).Return(nil, nil)
+				},
+				wantErr: false,
+			},
+			{
+				name: "negative",
+				card: dto.Card{
+					ID:       mom.GetUUID(0),
+					UserID:   mom.GetUUID(1),
+					ColumnID: mom.GetUUID(2),
+					Title:    "NegativeCard",
+				},
+				mockSetup: func(mockUserSvc *mocks.UserService, mockTodoSvc *mocks.TodoService, card *dto.Card) {
+					mockTodoSvc.On("GetCard", context.Background(), card.ID.String()).Return(nil, errors.New(""))
+					mockTodoSvc.On("UpdateCard", context.Background(), card).Return(errors.New(""))
+				},
+				wantErr: true,
+				err:     v1.ErrUpdateCard,
+			},
+			{
+				name: "version conflict",
+				card: dto.Card{
+					ID:       mom.GetUUID(0),
+					UserID:   mom.GetUUID(1),
+					ColumnID: mom.GetUUID(2),
+					Title:    "StaleCard",
+					Version:  1,
+				},
+				mockSetup: func(mockUserSvc *mocks.UserService, mockTodoSvc *mocks.TodoService, card *dto.Card) {
+					mockTodoSvc.On("GetCard", context.Background(), card.ID.String()).Return(card, nil)
+					mockTodoSvc.On("UpdateCard", context.Background(), card).Return(todo.ErrVersionConflict)
+				},
+				wantErr: true,
+				err:     todo.ErrVersionConflict,
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				t.Parallel()
+
+				runner.Run(t, tt.name, func(pt provider.T) {
+					mockUserSvc := new(mocks.UserService)
+					mockAuthSvc := new(mocks.AuthService)
+					mockTodoSvc := new(mocks.TodoService)
+					logger := log.NewEmptyLogger()
+
+					uc := v1.NewAggregatorUseCase(mockUserSvc, mockAuthSvc, mockTodoSvc, logger)
+
+					tt.mockSetup(mockUserSvc, mockTodoSvc, &tt.card)
+
+					pt.WithNewStep("Call UpdateCard", func(sCtx provider.StepCtx) {
+						err := uc.UpdateCard(context.Background(), &tt.card)
+
+						if tt.wantErr {
+							sCtx.Assert().Error(err, "Expected error")
+							sCtx.Assert().ErrorIs(err, tt.err)
+						} else {
+							sCtx.Assert().NoError(err, "Expected no error")
+						}
+
+						mockUserSvc.AssertExpectations(t)
+						mockTodoSvc.AssertExpectations(t)
+					})
+				})
+			})
+		}
+	})
+}
+
+func TestDeleteBoard(t *testing.T) {
+	runner.Run(t, "TestDeleteBoard", func(pt provider.T) {
 		mom := &testdata.ObjectMother{}
 
 		tests := []struct {
@@ -1345,7 +1686,7 @@ func TestDeleteCard(t *testing.T) {
 				name: "positive",
 				id:   mom.GetUUID(0).String(),
 				mockSetup: func(mockTodoSvc *mocks.TodoService, id string) {
-					mockTodoSvc.On("DeleteCard", context.Background(), id).Return(nil)
+					mockTodoSvc.On("DeleteBoard", context.Background(), id, 1).Return(nil)
 				},
 				wantErr: false,
 			},
@@ -1353,10 +1694,19 @@ func TestDeleteCard(t *testing.T) {
 				name: "negative",
 				id:   mom.GetUUID(0).String(),
 				mockSetup: func(mockTodoSvc *mocks.TodoService, id string) {
-					mockTodoSvc.On("DeleteCard", context.Background(), id).Return(errors.New(""))
+					mockTodoSvc.On("DeleteBoard", context.Background(), id, 1).Return(errors.New(""))
 				},
 				wantErr: true,
-				err:     v1.ErrDeleteCard,
+				err:     v1.ErrDeleteBoard,
+			},
+			{
+				name: "version conflict",
+				id:   mom.GetUUID(0).String(),
+				mockSetup: func(mockTodoSvc *mocks.TodoService, id string) {
+					mockTodoSvc.On("DeleteBoard", context.Background(), id, 1).Return(todo.ErrVersionConflict)
+				},
+				wantErr: true,
+				err:     todo.ErrVersionConflict,
 			},
 		}
 
@@ -1374,8 +1724,70 @@ func TestDeleteCard(t *testing.T) {
 
 					tt.mockSetup(mockTodoSvc, tt.id)
 
-					pt.WithNewStep("Call DeleteCard", func(sCtx provider.StepCtx) {
-						err := uc.DeleteCard(context.Background(), tt.id)
+					pt.WithNewStep("Call DeleteBoard", func(sCtx provider.StepCtx) {
+						err := uc.DeleteBoard(context.Background(), tt.id, 1)
+
+						if tt.wantErr {
+							sCtx.Assert().Error(err, "Expected error")
+							sCtx.Assert().ErrorIs(err, tt.err)
+						} else {
+							sCtx.Assert().NoError(err, "Expected no error")
+						}
+
+						mockTodoSvc.AssertExpectations(t)
+					})
+				})
+			})
+		}
+	})
+}
+
+func TestDeleteColumn(t *testing.T) {
+	runner.Run(t, "TestDeleteColumn", func(pt provider.T) {
+		mom := &testdata.ObjectMother{}
+
+		tests := []struct {
+			name      string
+			id        string
+			mockSetup func(mockTodoSvc *mocks.TodoService, id string)
+			wantErr   bool
+			err       error
+		}{
+			{
+				name: "positive",
+				id:   mom.GetUUID(0).String(),
+				mockSetup: func(mockTodoSvc *mocks.TodoService, id string) {
+					mockTodoSvc.On("DeleteColumn", context.Background(), id, 1).Return(nil)
+				},
+				wantErr: false,
+			},
+			{
+				name: "negative",
+				id:   mom.GetUUID(0).String(),
+				mockSetup: func(mockTodoSvc *mocks.TodoService, id string) {
+					mockTodoSvc.On("DeleteColumn", context.Background(), id, 1).Return(errors.New(""))
+				},
+				wantErr: true,
+				err:     v1.ErrDeleteColumn,
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				t.Parallel()
+
+				runner.Run(t, tt.name, func(pt provider.T) {
+					mockUserSvc := new(mocks.UserService)
+					mockAuthSvc := new(mocks.AuthService)
+					mockTodoSvc := new(mocks.TodoService)
+					logger := log.NewEmptyLogger()
+
+					uc := v1.NewAggregatorUseCase(mockUserSvc, mockAuthSvc, mockTodoSvc, logger)
+
+					tt.mockSetup(mockTodoSvc, tt.id)
+
+					pt.WithNewStep("Call DeleteColumn", func(sCtx provider.StepCtx) {
+						err := uc.DeleteColumn(context.Background(), tt.id, 1)
 
 						if tt.wantErr {
 							sCtx.Assert().Error(err, "Expected error")
@@ -1391,3 +1803,1949 @@ func TestDeleteCard(t *testing.T) {
 		}
 	})
 }
+
+func TestDeleteSwimlane(t *testing.T) {
+	runner.Run(t, "TestDeleteSwimlane", func(pt provider.T) {
+		mom := &testdata.ObjectMother{}
+
+		tests := []struct {
+			name      string
+			id        string
+			mockSetup func(mockTodoSvc *mocks.TodoService, id string)
+			wantErr   bool
+			err       error
+		}{
+			{
+				name: "positive",
+				id:   mom.GetUUID(0).String(),
+				mockSetup: func(mockTodoSvc *mocks.TodoService, id string) {
+					mockTodoSvc.On("DeleteSwimlane", context.Background(), id, 1).Return(nil)
+				},
+				wantErr: false,
+			},
+			{
+				name: "last swimlane",
+				id:   mom.GetUUID(0).String(),
+				mockSetup: func(mockTodoSvc *mocks.TodoService, id string) {
+					mockTodoSvc.On("DeleteSwimlane", context.Background(), id, 1).Return(todo.ErrLastSwimlane)
+				},
+				wantErr: true,
+				err:     todo.ErrLastSwimlane,
+			},
+			{
+				name: "negative",
+				id:   mom.GetUUID(0).String(),
+				mockSetup: func(mockTodoSvc *mocks.TodoService, id string) {
+					mockTodoSvc.On("DeleteSwimlane", context.Background(), id, 1).Return(errors.New(""))
+				},
+				wantErr: true,
+				err:     v1.ErrDeleteSwimlane,
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				t.Parallel()
+
+				runner.Run(t, tt.name, func(pt provider.T) {
+					mockUserSvc := new(mocks.UserService)
+					mockAuthSvc := new(mocks.AuthService)
+					mockTodoSvc := new(mocks.TodoService)
+					logger := log.NewEmptyLogger()
+
+					uc := v1.NewAggregatorUseCase(mockUserSvc, mockAuthSvc, mockTodoSvc, logger)
+
+					tt.mockSetup(mockTodoSvc, tt.id)
+
+					pt.WithNewStep("Call DeleteSwimlane", func(sCtx provider.StepCtx) {
+						err := uc.DeleteSwimlane(context.Background(), tt.id, 1)
+
+						if tt.wantErr {
+							sCtx.Assert().Error(err, "Expected error")
+							sCtx.Assert().ErrorIs(err, tt.err)
+						} else {
+							sCtx.Assert().NoError(err, "Expected no error")
+						}
+
+						mockTodoSvc.AssertExpectations(t)
+					})
+				})
+			})
+		}
+	})
+}
+
+func TestSetCardParent(t *testing.T) {
+	runner.Run(t, "TestSetCardParent", func(pt provider.T) {
+		mom := &testdata.ObjectMother{}
+
+		card := dto.Card{ID: mom.GetUUID(0), ParentID: mom.GetUUID(1), Version: 1}
+
+		tests := []struct {
+			name      string
+			mockSetup func(mockTodoSvc *mocks.TodoService)
+			wantErr   bool
+			err       error
+		}{
+			{
+				name: "positive",
+				mockSetup: func(mockTodoSvc *mocks.TodoService) {
+					mockTodoSvc.On("SetCardParent", context.Background(), &card).Return(nil)
+				},
+				wantErr: false,
+			},
+			{
+				name: "cycle",
+				mockSetup: func(mockTodoSvc *mocks.TodoService) {
+					mockTodoSvc.On("SetCardParent", context.Background(), &card).Return(todo.ErrCardParent)
+				},
+				wantErr: true,
+				err:     todo.ErrCardParent,
+			},
+			{
+				name: "version conflict",
+				mockSetup: func(mockTodoSvc *mocks.TodoService) {
+					mockTodoSvc.On("SetCardParent", context.Background(), &card).Return(todo.ErrVersionConflict)
+				},
+				wantErr: true,
+				err:     todo.ErrVersionConflict,
+			},
+			{
+				name: "negative",
+				mockSetup: func(mockTodoSvc *mocks.TodoService) {
+					mockTodoSvc.On("SetCardParent", context.Background(), &card).Return(errors.New(""))
+				},
+				wantErr: true,
+				err:     v1.ErrSetCardParent,
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				t.Parallel()
+
+				runner.Run(t, tt.name, func(pt provider.T) {
+					mockUserSvc := new(mocks.UserService)
+					mockAuthSvc := new(mocks.AuthService)
+					mockTodoSvc := new(mocks.TodoService)
+					logger := log.NewEmptyLogger()
+
+					uc := v1.NewAggregatorUseCase(mockUserSvc, mockAuthSvc, mockTodoSvc, logger)
+
+					tt.mockSetup(mockTodoSvc)
+
+					pt.WithNewStep("Call SetCardParent", func(sCtx provider.StepCtx) {
+						c := card
+						err := uc.SetCardParent(context.Background(), &c)
+
+						if tt.wantErr {
+							sCtx.Assert().Error(err, "Expected error")
+							sCtx.Assert().ErrorIs(err, tt.err)
+						} else {
+							sCtx.Assert().NoError(err, "Expected no error")
+						}
+
+						mockTodoSvc.AssertExpectations(t)
+					})
+				})
+			})
+		}
+	})
+}
+
+func TestDeleteCard(t *testing.T) {
+	runner.Run(t, "TestDeleteCard", func(pt provider.T) {
+		mom := &testdata.ObjectMother{}
+
+		tests := []struct {
+			name      string
+			id        string
+			mockSetup func(mockTodoSvc *mocks.TodoService, id string)
+			wantErr   bool
+			err       error
+		}{
+			{
+				name: "positive",
+				id:   mom.GetUUID(0).String(),
+				mockSetup: func(mockTodoSvc *mocks.TodoService, id string) {
+					mockTodoSvc.On("DeleteCard", context.Background(), id, 1).Return(nil)
+				},
+				wantErr: false,
+			},
+			{
+				name: "negative",
+				id:   mom.GetUUID(0).String(),
+				mockSetup: func(mockTodoSvc *mocks.TodoService, id string) {
+					mockTodoSvc.On("DeleteCard", context.Background(), id, 1).Return(errors.New(""))
+				},
+				wantErr: true,
+				err:     v1.ErrDeleteCard,
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				t.Parallel()
+
+				runner.Run(t, tt.name, func(pt provider.T) {
+					mockUserSvc := new(mocks.UserService)
+					mockAuthSvc := new(mocks.AuthService)
+					mockTodoSvc := new(mocks.TodoService)
+					logger := log.NewEmptyLogger()
+
+					uc := v1.NewAggregatorUseCase(mockUserSvc, mockAuthSvc, mockTodoSvc, logger)
+
+					tt.mockSetup(mockTodoSvc, tt.id)
+
+					pt.WithNewStep("Call DeleteCard", func(sCtx provider.StepCtx) {
+						err := uc.DeleteCard(context.Background(), tt.id, 1)
+
+						if tt.wantErr {
+							sCtx.Assert().Error(err, "Expected error")
+							sCtx.Assert().ErrorIs(err, tt.err)
+						} else {
+							sCtx.Assert().NoError(err, "Expected no error")
+						}
+
+						mockTodoSvc.AssertExpectations(t)
+					})
+				})
+			})
+		}
+	})
+}
+
+func TestBulkCards(t *testing.T) {
+	runner.Run(t, "TestBulkCards", func(pt provider.T) {
+		mom := &testdata.ObjectMother{}
+
+		req := dto.BulkCardsRequest{
+			Ops: []dto.CardOp{{Op: "archive", CardID: mom.GetUUID(0)}},
+		}
+
+		tests := []struct {
+			name      string
+			mockSetup func(mockTodoSvc *mocks.TodoService)
+			wantErr   bool
+			err       error
+		}{
+			{
+				name: "positive",
+				mockSetup: func(mockTodoSvc *mocks.TodoService) {
+					mockTodoSvc.On("BulkCards", context.Background(), req).Return(&dto.BulkCardsResponse{
+						Results: []dto.CardOpResult{{CardID: mom.GetUUID(0), Status: "applied"}},
+					}, nil)
+				},
+				wantErr: false,
+			},
+			{
+				name: "rejected",
+				mockSetup: func(mockTodoSvc *mocks.TodoService) {
+					mockTodoSvc.On("BulkCards", context.Background(), req).Return(nil, todo.ErrInvalidBulk)
+				},
+				wantErr: true,
+				err:     todo.ErrInvalidBulk,
+			},
+			{
+				name: "negative",
+				mockSetup: func(mockTodoSvc *mocks.TodoService) {
+					mockTodoSvc.On("BulkCards", context.Background(), req).Return(nil, errors.New(""))
+				},
+				wantErr: true,
+				err:     v1.ErrBulkCards,
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				t.Parallel()
+
+				runner.Run(t, tt.name, func(pt provider.T) {
+					mockUserSvc := new(mocks.UserService)
+					mockAuthSvc := new(mocks.AuthService)
+					mockTodoSvc := new(mocks.TodoService)
+					logger := log.NewEmptyLogger()
+
+					uc := v1.NewAggregatorUseCase(mockUserSvc, mockAuthSvc, mockTodoSvc, logger)
+
+					tt.mockSetup(mockTodoSvc)
+
+					pt.WithNewStep("Call BulkCards", func(sCtx provider.StepCtx) {
+						res, err := uc.BulkCards(context.Background(), req)
+
+						if tt.wantErr {
+							sCtx.Assert().Error(err, "Expected error")
+							sCtx.Assert().ErrorIs(err, tt.err)
+						} else {
+							sCtx.Assert().NoError(err, "Expected no error")
+							sCtx.Assert().Len(res.Results, 1)
+						}
+
+						mockTodoSvc.AssertExpectations(t)
+					})
+				})
+			})
+		}
+	})
+}
+
+func TestWatchBoard(t *testing.T) {
+	runner.Run(t, "TestWatchBoard", func(pt provider.T) {
+		mom := &testdata.ObjectMother{}
+
+		boardID := mom.GetUUID(0).String()
+
+		tests := []struct {
+			name      string
+			mockSetup func(mockTodoSvc *mocks.TodoService)
+			wantErr   bool
+			err       error
+		}{
+			{
+				name: "positive",
+				mockSetup: func(mockTodoSvc *mocks.TodoService) {
+					mockTodoSvc.On("WatchBoard", context.Background(), boardID, "5").
+						Return(io.NopCloser(strings.NewReader("id: 6\n\n")), nil)
+				},
+				wantErr: false,
+			},
+			{
+				name: "no board",
+				mockSetup: func(mockTodoSvc *mocks.TodoService) {
+					mockTodoSvc.On("WatchBoard", context.Background(), boardID, "5").Return(nil, todo.ErrBoardNotFound)
+				},
+				wantErr: true,
+				err:     todo.ErrBoardNotFound,
+			},
+			{
+				name: "invalid resume id",
+				mockSetup: func(mockTodoSvc *mocks.TodoService) {
+					mockTodoSvc.On("WatchBoard", context.Background(), boardID, "5").Return(nil, todo.ErrInvalidWatch)
+				},
+				wantErr: true,
+				err:     todo.ErrInvalidWatch,
+			},
+			{
+				name: "negative",
+				mockSetup: func(mockTodoSvc *mocks.TodoService) {
+					mockTodoSvc.On("WatchBoard", context.Background(), boardID, "5").Return(nil, errors.New(""))
+				},
+				wantErr: true,
+				err:     v1.ErrWatchBoard,
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				t.Parallel()
+
+				runner.Run(t, tt.name, func(pt provider.T) {
+					mockUserSvc := new(mocks.UserService)
+					mockAuthSvc := new(mocks.AuthService)
+					mockTodoSvc := new(mocks.TodoService)
+					logger := log.NewEmptyLogger()
+
+					uc := v1.NewAggregatorUseCase(mockUserSvc, mockAuthSvc, mockTodoSvc, logger)
+
+					tt.mockSetup(mockTodoSvc)
+
+					pt.WithNewStep("Call WatchBoard", func(sCtx provider.StepCtx) {
+						stream, err := uc.WatchBoard(context.Background(), boardID, "5")
+
+						if tt.wantErr {
+							sCtx.Assert().Error(err, "Expected error")
+							sCtx.Assert().ErrorIs(err, tt.err)
+						} else {
+							sCtx.Assert().NoError(err, "Expected no error")
+							sCtx.Assert().NotNil(stream)
+							stream.Close()
+						}
+
+						mockTodoSvc.AssertExpectations(t)
+					})
+				})
+			})
+		}
+	})
+}
+
+func TestStartTimer(t *testing.T) {
+	runner.Run(t, "TestStartTimer", func(pt provider.T) {
+		mom := &testdata.ObjectMother{}
+
+		req := dto.StartTimerRequest{UserID: mom.GetUUID(0), CardID: mom.GetUUID(1)}
+
+		tests := []struct {
+			name      string
+			mockSetup func(mockTodoSvc *mocks.TodoService)
+			wantErr   bool
+			err       error
+		}{
+			{
+				name: "positive",
+				mockSetup: func(mockTodoSvc *mocks.TodoService) {
+					mockTodoSvc.On("StartTimer", context.Background(), req).Return(&dto.TimeEntry{
+						ID: mom.GetUUID(2), UserID: req.UserID, CardID: req.CardID,
+					}, nil)
+				},
+				wantErr: false,
+			},
+			{
+				name: "already running",
+				mockSetup: func(mockTodoSvc *mocks.TodoService) {
+					mockTodoSvc.On("StartTimer", context.Background(), req).Return(nil, todo.ErrTimerRunning)
+				},
+				wantErr: true,
+				err:     todo.ErrTimerRunning,
+			},
+			{
+				name: "negative",
+				mockSetup: func(mockTodoSvc *mocks.TodoService) {
+					mockTodoSvc.On("StartTimer", context.Background(), req).Return(nil, errors.New(""))
+				},
+				wantErr: true,
+				err:     v1.ErrStartTimer,
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				t.Parallel()
+
+				runner.Run(t, tt.name, func(pt provider.T) {
+					mockUserSvc := new(mocks.UserService)
+					mockAuthSvc := new(mocks.AuthService)
+					mockTodoSvc := new(mocks.TodoService)
+					logger := log.NewEmptyLogger()
+
+					uc := v1.NewAggregatorUseCase(mockUserSvc, mockAuthSvc, mockTodoSvc, logger)
+
+					tt.mockSetup(mockTodoSvc)
+
+					pt.WithNewStep("Call StartTimer", func(sCtx provider.StepCtx) {
+						entry, err := uc.StartTimer(context.Background(), req)
+
+						if tt.wantErr {
+							sCtx.Assert().Error(err, "Expected error")
+							sCtx.Assert().ErrorIs(err, tt.err)
+						} else {
+							sCtx.Assert().NoError(err, "Expected no error")
+							sCtx.Assert().Equal(req.CardID, entry.CardID)
+						}
+
+						mockTodoSvc.AssertExpectations(t)
+					})
+				})
+			})
+		}
+	})
+}
+
+func TestStopTimer(t *testing.T) {
+	runner.Run(t, "TestStopTimer", func(pt provider.T) {
+		mom := &testdata.ObjectMother{}
+
+		req := dto.StopTimerRequest{UserID: mom.GetUUID(0)}
+
+		tests := []struct {
+			name      string
+			mockSetup func(mockTodoSvc *mocks.TodoService)
+			wantErr   bool
+			err       error
+		}{
+			{
+				name: "positive",
+				mockSetup: func(mockTodoSvc *mocks.TodoService) {
+					mockTodoSvc.On("StopTimer", context.Background(), req).Return(&dto.TimeEntry{
+						ID: mom.GetUUID(1), UserID: req.UserID, Duration: 60,
+					}, nil)
+				},
+				wantErr: false,
+			},
+			{
+				name: "not running",
+				mockSetup: func(mockTodoSvc *mocks.TodoService) {
+					mockTodoSvc.On("StopTimer", context.Background(), req).Return(nil, todo.ErrNoTimerRunning)
+				},
+				wantErr: true,
+				err:     todo.ErrNoTimerRunning,
+			},
+			{
+				name: "negative",
+				mockSetup: func(mockTodoSvc *mocks.TodoService) {
+					mockTodoSvc.On("StopTimer", context.Background(), req).Return(nil, errors.New(""))
+				},
+				wantErr: true,
+				err:     v1.ErrStopTimer,
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				t.Parallel()
+
+				runner.Run(t, tt.name, func(pt provider.T) {
+					mockUserSvc := new(mocks.UserService)
+					mockAuthSvc := new(mocks.AuthService)
+					mockTodoSvc := new(mocks.TodoService)
+					logger := log.NewEmptyLogger()
+
+					uc := v1.NewAggregatorUseCase(mockUserSvc, mockAuthSvc, mockTodoSvc, logger)
+
+					tt.mockSetup(mockTodoSvc)
+
+					pt.WithNewStep("Call StopTimer", func(sCtx provider.StepCtx) {
+						entry, err := uc.StopTimer(context.Background(), req)
+
+						if tt.wantErr {
+							sCtx.Assert().Error(err, "Expected error")
+							sCtx.Assert().ErrorIs(err, tt.err)
+						} else {
+							sCtx.Assert().NoError(err, "Expected no error")
+							sCtx.Assert().Equal(int64(60), entry.Duration)
+						}
+
+						mockTodoSvc.AssertExpectations(t)
+					})
+				})
+			})
+		}
+	})
+}
+
+func TestGetBoardAnalytics(t *testing.T) {
+	runner.Run(t, "TestGetBoardAnalytics", func(pt provider.T) {
+		mom := &testdata.ObjectMother{}
+
+		boardID := mom.GetUUID(0)
+		from, to := "01-01-2024", "14-01-2024"
+
+		tests := []struct {
+			name      string
+			mockSetup func(mockTodoSvc *mocks.TodoService)
+			wantErr   bool
+			err       error
+		}{
+			{
+				name: "positive",
+				mockSetup: func(mockTodoSvc *mocks.TodoService) {
+					mockTodoSvc.On("GetBoardAnalytics", context.Background(), boardID.String(), from, to).
+						Return(&dto.BoardAnalytics{BoardID: boardID, LeadTime: dto.FlowTimes{Cards: 3}}, nil)
+				},
+				wantErr: false,
+			},
+			{
+				name: "invalid period",
+				mockSetup: func(mockTodoSvc *mocks.TodoService) {
+					mockTodoSvc.On("GetBoardAnalytics", context.Background(), boardID.String(), from, to).
+						Return(nil, todo.ErrInvalidPeriod)
+				},
+				wantErr: true,
+				err:     todo.ErrInvalidPeriod,
+			},
+			{
+				name: "negative",
+				mockSetup: func(mockTodoSvc *mocks.TodoService) {
+					mockTodoSvc.On("GetBoardAnalytics", context.Background(), boardID.String(), from, to).
+						Return(nil, errors.New(""))
+				},
+				wantErr: true,
+				err:     v1.ErrGetAnalytics,
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				t.Parallel()
+
+				runner.Run(t, tt.name, func(pt provider.T) {
+					mockUserSvc := new(mocks.UserService)
+					mockAuthSvc := new(mocks.AuthService)
+					mockTodoSvc := new(mocks.TodoService)
+					logger := log.NewEmptyLogger()
+
+					uc := v1.NewAggregatorUseCase(mockUserSvc, mockAuthSvc, mockTodoSvc, logger)
+
+					tt.mockSetup(mockTodoSvc)
+
+					pt.WithNewStep("Call GetBoardAnalytics", func(sCtx provider.StepCtx) {
+						analytics, err := uc.GetBoardAnalytics(context.Background(), boardID.String(), from, to)
+
+						if tt.wantErr {
+							sCtx.Assert().Error(err, "Expected error")
+							sCtx.Assert().ErrorIs(err, tt.err)
+						} else {
+							sCtx.Assert().NoError(err, "Expected no error")
+							sCtx.Assert().Equal(3, analytics.LeadTime.Cards)
+						}
+
+						mockTodoSvc.AssertExpectations(t)
+					})
+				})
+			})
+		}
+	})
+}
+
+func TestCreateSprint(t *testing.T) {
+	runner.Run(t, "TestCreateSprint", func(pt provider.T) {
+		mom := &testdata.ObjectMother{}
+
+		start := time.Date(2024, 3, 4, 0, 0, 0, 0, time.UTC)
+		req := dto.CreateSprintRequest{BoardID: mom.GetUUID(0), Name: "Sprint 1", StartDate: start, EndDate: start.AddDate(0, 0, 13)}
+
+		tests := []struct {
+			name      string
+			mockSetup func(mockTodoSvc *mocks.TodoService)
+			wantErr   bool
+			err       error
+		}{
+			{
+				name: "positive",
+				mockSetup: func(mockTodoSvc *mocks.TodoService) {
+					mockTodoSvc.On("CreateSprint", context.Background(), req).Return(&dto.Sprint{
+						ID: mom.GetUUID(1), BoardID: req.BoardID, Name: req.Name, StartDate: req.StartDate, EndDate: req.EndDate,
+					}, nil)
+				},
+				wantErr: false,
+			},
+			{
+				name: "invalid",
+				mockSetup: func(mockTodoSvc *mocks.TodoService) {
+					mockTodoSvc.On("CreateSprint", context.Background(), req).Return(nil, todo.ErrInvalidSprint)
+				},
+				wantErr: true,
+				err:     todo.ErrInvalidSprint,
+			},
+			{
+				name: "negative",
+				mockSetup: func(mockTodoSvc *mocks.TodoService) {
+					mockTodoSvc.On("CreateSprint", context.Background(), req).Return(nil, errors.New(""))
+				},
+				wantErr: true,
+				err:     v1.ErrCreateSprint,
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				t.Parallel()
+
+				runner.Run(t, tt.name, func(pt provider.T) {
+					mockUserSvc := new(mocks.UserService)
+					mockAuthSvc := new(mocks.AuthService)
+					mockTodoSvc := new(mocks.TodoService)
+					logger := log.NewEmptyLogger()
+
+					uc := v1.NewAggregatorUseCase(mockUserSvc, mockAuthSvc, mockTodoSvc, logger)
+
+					tt.mockSetup(mockTodoSvc)
+
+					pt.WithNewStep("Call CreateSprint", func(sCtx provider.StepCtx) {
+						sprint, err := uc.CreateSprint(context.Background(), req)
+
+						if tt.wantErr {
+							sCtx.Assert().Error(err, "Expected error")
+							sCtx.Assert().ErrorIs(err, tt.err)
+						} else {
+							sCtx.Assert().NoError(err, "Expected no error")
+							sCtx.Assert().Equal(mom.GetUUID(1), sprint.ID)
+						}
+
+						mockTodoSvc.AssertExpectations(t)
+					})
+				})
+			})
+		}
+	})
+}
+
+func TestCloseSprint(t *testing.T) {
+	runner.Run(t, "TestCloseSprint", func(pt provider.T) {
+		mom := &testdata.ObjectMother{}
+
+		sprintID := mom.GetUUID(0).String()
+		nextID := mom.GetUUID(1)
+		req := dto.CloseSprintRequest{}
+
+		tests := []struct {
+			name      string
+			mockSetup func(mockTodoSvc *mocks.TodoService)
+			wantErr   bool
+			err       error
+		}{
+			{
+				name: "positive",
+				mockSetup: func(mockTodoSvc *mocks.TodoService) {
+					mockTodoSvc.On("CloseSprint", context.Background(), sprintID, req).Return(&dto.CloseSprintResponse{
+						NextSprint: &dto.Sprint{ID: nextID}, Carried: 2,
+					}, nil)
+				},
+				wantErr: false,
+			},
+			{
+				name: "already closed",
+				mockSetup: func(mockTodoSvc *mocks.TodoService) {
+					mockTodoSvc.On("CloseSprint", context.Background(), sprintID, req).Return(nil, todo.ErrSprintClosed)
+				},
+				wantErr: true,
+				err:     todo.ErrSprintClosed,
+			},
+			{
+				name: "negative",
+				mockSetup: func(mockTodoSvc *mocks.TodoService) {
+					mockTodoSvc.On("CloseSprint", context.Background(), sprintID, req).Return(nil, errors.New(""))
+				},
+				wantErr: true,
+				err:     v1.ErrCloseSprint,
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				t.Parallel()
+
+				runner.Run(t, tt.name, func(pt provider.T) {
+					mockUserSvc := new(mocks.UserService)
+					mockAuthSvc := new(mocks.AuthService)
+					mockTodoSvc := new(mocks.TodoService)
+					logger := log.NewEmptyLogger()
+
+					uc := v1.NewAggregatorUseCase(mockUserSvc, mockAuthSvc, mockTodoSvc, logger)
+
+					tt.mockSetup(mockTodoSvc)
+
+					pt.WithNewStep("Call CloseSprint", func(sCtx provider.StepCtx) {
+						closed, err := uc.CloseSprint(context.Background(), sprintID, req)
+
+						if tt.wantErr {
+							sCtx.Assert().Error(err, "Expected error")
+							sCtx.Assert().ErrorIs(err, tt.err)
+						} else {
+							sCtx.Assert().NoError(err, "Expected no error")
+							sCtx.Assert().Equal(2, closed.Carried)
+							sCtx.Assert().Equal(nextID, closed.NextSprint.ID)
+						}
+
+						mockTodoSvc.AssertExpectations(t)
+					})
+				})
+			})
+		}
+	})
+}
+
+func TestRegenerateCalendarToken(t *testing.T) {
+	runner.Run(t, "TestRegenerateCalendarToken", func(pt provider.T) {
+		mom := &testdata.ObjectMother{}
+
+		userID := mom.GetUUID(0).String()
+
+		tests := []struct {
+			name      string
+			mockSetup func(mockTodoSvc *mocks.TodoService)
+			wantErr   bool
+			err       error
+		}{
+			{
+				name: "positive",
+				mockSetup: func(mockTodoSvc *mocks.TodoService) {
+					mockTodoSvc.On("RegenerateCalendarToken", context.Background(), userID).
+						Return(&dto.CalendarToken{Token: "secret"}, nil)
+				},
+				wantErr: false,
+			},
+			{
+				name: "negative",
+				mockSetup: func(mockTodoSvc *mocks.TodoService) {
+					mockTodoSvc.On("RegenerateCalendarToken", context.Background(), userID).Return(nil, errors.New(""))
+				},
+				wantErr: true,
+				err:     v1.ErrCalendarToken,
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				t.Parallel()
+
+				runner.Run(t, tt.name, func(pt provider.T) {
+					mockUserSvc := new(mocks.UserService)
+					mockAuthSvc := new(mocks.AuthService)
+					mockTodoSvc := new(mocks.TodoService)
+					logger := log.NewEmptyLogger()
+
+					uc := v1.NewAggregatorUseCase(mockUserSvc, mockAuthSvc, mockTodoSvc, logger)
+
+					tt.mockSetup(mockTodoSvc)
+
+					pt.WithNewStep("Call RegenerateCalendarToken", func(sCtx provider.StepCtx) {
+						token, err := uc.RegenerateCalendarToken(context.Background(), userID)
+
+						if tt.wantErr {
+							sCtx.Assert().Error(err, "Expected error")
+							sCtx.Assert().ErrorIs(err, tt.err)
+						} else {
+							sCtx.Assert().NoError(err, "Expected no error")
+							sCtx.Assert().Equal("/api/v1/calendar/secret.ics", token.FeedPath)
+						}
+
+						mockTodoSvc.AssertExpectations(t)
+					})
+				})
+			})
+		}
+	})
+}
+
+func TestGetCalendarFeed(t *testing.T) {
+	runner.Run(t, "TestGetCalendarFeed", func(pt provider.T) {
+		mom := &testdata.ObjectMother{}
+
+		token := "secret"
+		allDayID := mom.GetUUID(0)
+		timedID := mom.GetUUID(1)
+
+		// The todo service sends wall clock times labelled UTC.
+		noon := time.Date(2024, 1, 2, 12, 30, 0, 0, time.UTC)
+		cards := []dto.CalendarCard{
+			{
+				CardID:      allDayID,
+				BoardTitle:  "Work",
+				Title:       "Release, finally; for real",
+				Description: "Line one\nLine two",
+				DueDate:     time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+				Version:     3,
+				CreatedAt:   noon,
+				UpdatedAt:   noon,
+			},
+			{
+				CardID:     timedID,
+				BoardTitle: "Work",
+				Title:      strings.Repeat("Long title ", 10),
+				DueDate:    noon,
+				Done:       true,
+				Version:    1,
+				CreatedAt:  noon,
+				UpdatedAt:  noon,
+			},
+		}
+		dueUTC := time.Date(2024, 1, 2, 12, 30, 0, 0, time.Local).UTC().Format("20060102T150405Z")
+
+		tests := []struct {
+			name      string
+			kind      string
+			mockSetup func(mockTodoSvc *mocks.TodoService)
+			want      []string
+			notWant   []string
+			wantErr   bool
+			err       error
+		}{
+			{
+				name: "positive",
+				mockSetup: func(mockTodoSvc *mocks.TodoService) {
+					mockTodoSvc.On("GetCalendarCards", context.Background(), token).Return(cards, nil)
+				},
+				want: []string{
+					"BEGIN:VCALENDAR\r\nVERSION:2.0\r\n",
+					"BEGIN:VEVENT\r\nUID:card-" + allDayID.String() + "-due@todo\r\n",
+					"BEGIN:VTODO\r\nUID:card-" + allDayID.String() + "@todo\r\n",
+					"SEQUENCE:2\r\n",
+					`SUMMARY:Release\, finally\; for real` + "\r\n",
+					`DESCRIPTION:Line one\nLine two` + "\r\n",
+					"DTSTART;VALUE=DATE:20240101\r\nDTEND;VALUE=DATE:20240102\r\n",
+					"DUE;VALUE=DATE:20240101\r\nSTATUS:NEEDS-ACTION\r\n",
+					"DTSTART:" + dueUTC + "\r\n",
+					"DUE:" + dueUTC + "\r\nSTATUS:COMPLETED\r\n",
+					"LAST-MODIFIED:" + dueUTC + "\r\n",
+					"END:VCALENDAR\r\n",
+				},
+				// DTSTAMP is when the feed was made, not when the card changed.
+				notWant: []string{"DTSTAMP:" + dueUTC + "\r\n"},
+				wantErr: false,
+			},
+			{
+				name: "todos only",
+				kind: dto.CalendarKindTodo,
+				mockSetup: func(mockTodoSvc *mocks.TodoService) {
+					mockTodoSvc.On("GetCalendarCards", context.Background(), token).Return(cards, nil)
+				},
+				want:    []string{"BEGIN:VTODO\r\n"},
+				notWant: []string{"BEGIN:VEVENT\r\n"},
+				wantErr: false,
+			},
+			{
+				name: "unknown token",
+				mockSetup: func(mockTodoSvc *mocks.TodoService) {
+					mockTodoSvc.On("GetCalendarCards", context.Background(), token).Return(nil, todo.ErrCalendarTokenNotFound)
+				},
+				wantErr: true,
+				err:     todo.ErrCalendarTokenNotFound,
+			},
+			{
+				name: "negative",
+				mockSetup: func(mockTodoSvc *mocks.TodoService) {
+					mockTodoSvc.On("GetCalendarCards", context.Background(), token).Return(nil, errors.New(""))
+				},
+				wantErr: true,
+				err:     v1.ErrGetCalendar,
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				t.Parallel()
+
+				runner.Run(t, tt.name, func(pt provider.T) {
+					mockUserSvc := new(mocks.UserService)
+					mockAuthSvc := new(mocks.AuthService)
+					mockTodoSvc := new(mocks.TodoService)
+					logger := log.NewEmptyLogger()
+
+					uc := v1.NewAggregatorUseCase(mockUserSvc, mockAuthSvc, mockTodoSvc, logger)
+
+					tt.mockSetup(mockTodoSvc)
+
+					pt.WithNewStep("Call GetCalendarFeed", func(sCtx provider.StepCtx) {
+						feed, err := uc.GetCalendarFeed(context.Background(), token, tt.kind)
+
+						if tt.wantErr {
+							sCtx.Assert().Error(err, "Expected error")
+							sCtx.Assert().ErrorIs(err, tt.err)
+						} else {
+							sCtx.Assert().NoError(err, "Expected no error")
+
+							// Unfolded, the long title is whole again.
+							unfolded := strings.ReplaceAll(string(feed), "\r\n ", "")
+							for _, want := range tt.want {
+								sCtx.Assert().Contains(unfolded, want)
+							}
+							for _, notWant := range tt.notWant {
+								sCtx.Assert().NotContains(unfolded, notWant)
+							}
+							sCtx.Assert().Contains(unfolded, "SUMMARY:"+strings.Repeat("Long title ", 10)+"\r\n")
+
+							for _, line := range strings.Split(string(feed), "\r\n") {
+								sCtx.Assert().LessOrEqual(len(line), 75)
+							}
+						}
+
+						mockTodoSvc.AssertExpectations(t)
+					})
+				})
+			})
+		}
+	})
+}
+
+func TestMoveColumn(t *testing.T) {
+	runner.Run(t, "TestMoveColumn", func(pt provider.T) {
+		mom := &testdata.ObjectMother{}
+
+		columnID := mom.GetUUID(0)
+		boardID := mom.GetUUID(1)
+
+		tests := []struct {
+			name      string
+			mockSetup func(mockTodoSvc *mocks.TodoService)
+			wantErr   bool
+			err       error
+		}{
+			{
+				name: "positive",
+				mockSetup: func(mockTodoSvc *mocks.TodoService) {
+					mockTodoSvc.On("MoveColumn", context.Background(), mock.Anything).Run(func(args mock.Arguments) {
+						args.Get(1).(*dto.Column).Version = 4
+					}).Return(nil)
+				},
+				wantErr: false,
+			},
+			{
+				name: "board of another user",
+				mockSetup: func(mockTodoSvc *mocks.TodoService) {
+					mockTodoSvc.On("MoveColumn", context.Background(), mock.Anything).Return(todo.ErrBoardAccess)
+				},
+				wantErr: true,
+				err:     todo.ErrBoardAccess,
+			},
+			{
+				name: "negative",
+				mockSetup: func(mockTodoSvc *mocks.TodoService) {
+					mockTodoSvc.On("MoveColumn", context.Background(), mock.Anything).Return(errors.New(""))
+				},
+				wantErr: true,
+				err:     v1.ErrMoveColumn,
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				t.Parallel()
+
+				runner.Run(t, tt.name, func(pt provider.T) {
+					mockUserSvc := new(mocks.UserService)
+					mockAuthSvc := new(mocks.AuthService)
+					mockTodoSvc := new(mocks.TodoService)
+					logger := log.NewEmptyLogger()
+
+					uc := v1.NewAggregatorUseCase(mockUserSvc, mockAuthSvc, mockTodoSvc, logger)
+
+					tt.mockSetup(mockTodoSvc)
+
+					pt.WithNewStep("Call MoveColumn", func(sCtx provider.StepCtx) {
+						column := dto.Column{ID: columnID, BoardID: boardID, Version: 3}
+						err := uc.MoveColumn(context.Background(), &column)
+
+						if tt.wantErr {
+							sCtx.Assert().Error(err, "Expected error")
+							sCtx.Assert().ErrorIs(err, tt.err)
+						} else {
+							sCtx.Assert().NoError(err, "Expected no error")
+							sCtx.Assert().Equal(4, column.Version)
+						}
+
+						mockTodoSvc.AssertExpectations(t)
+					})
+				})
+			})
+		}
+	})
+}
+
+func TestMergeBoards(t *testing.T) {
+	runner.Run(t, "TestMergeBoards", func(pt provider.T) {
+		mom := &testdata.ObjectMother{}
+
+		req := dto.MergeBoardsRequest{
+			UserID:   mom.GetUUID(0),
+			SourceID: mom.GetUUID(1),
+			TargetID: mom.GetUUID(2),
+			Strategy: dto.MergeColumnsCombine,
+		}
+
+		tests := []struct {
+			name      string
+			mockSetup func(mockTodoSvc *mocks.TodoService)
+			wantErr   bool
+			err       error
+		}{
+			{
+				name: "positive",
+				mockSetup: func(mockTodoSvc *mocks.TodoService) {
+					mockTodoSvc.On("MergeBoards", context.Background(), req).Return(&dto.BoardMerge{
+						SourceID: req.SourceID, TargetID: req.TargetID, Strategy: req.Strategy,
+						Moved: []dto.Column{{Title: "To do"}}, Combined: []dto.Column{{Title: "Done"}},
+					}, nil)
+				},
+				wantErr: false,
+			},
+			{
+				name: "rejected",
+				mockSetup: func(mockTodoSvc *mocks.TodoService) {
+					mockTodoSvc.On("MergeBoards", context.Background(), req).Return(nil, todo.ErrInvalidMove)
+				},
+				wantErr: true,
+				err:     todo.ErrInvalidMove,
+			},
+			{
+				name: "negative",
+				mockSetup: func(mockTodoSvc *mocks.TodoService) {
+					mockTodoSvc.On("MergeBoards", context.Background(), req).Return(nil, errors.New(""))
+				},
+				wantErr: true,
+				err:     v1.ErrMergeBoards,
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				t.Parallel()
+
+				runner.Run(t, tt.name, func(pt provider.T) {
+					mockUserSvc := new(mocks.UserService)
+					mockAuthSvc := new(mocks.AuthService)
+					mockTodoSvc := new(mocks.TodoService)
+					logger := log.NewEmptyLogger()
+
+					uc := v1.NewAggregatorUseCase(mockUserSvc, mockAuthSvc, mockTodoSvc, logger)
+
+					tt.mockSetup(mockTodoSvc)
+
+					pt.WithNewStep("Call MergeBoards", func(sCtx provider.StepCtx) {
+						merge, err := uc.MergeBoards(context.Background(), req)
+
+						if tt.wantErr {
+							sCtx.Assert().Error(err, "Expected error")
+							sCtx.Assert().ErrorIs(err, tt.err)
+						} else {
+							sCtx.Assert().NoError(err, "Expected no error")
+							sCtx.Assert().Len(merge.Moved, 1)
+							sCtx.Assert().Len(merge.Combined, 1)
+						}
+
+						mockTodoSvc.AssertExpectations(t)
+					})
+				})
+			})
+		}
+	})
+}
+
+func TestStarBoard(t *testing.T) {
+	runner.Run(t, "TestStarBoard", func(pt provider.T) {
+		mom := &testdata.ObjectMother{}
+
+		userID := mom.GetUUID(0).String()
+		boardID := mom.GetUUID(1).String()
+
+		tests := []struct {
+			name      string
+			mockSetup func(mockTodoSvc *mocks.TodoService)
+			wantErr   bool
+			err       error
+		}{
+			{
+				name: "positive",
+				mockSetup: func(mockTodoSvc *mocks.TodoService) {
+					mockTodoSvc.On("StarBoard", context.Background(), userID, boardID).Return(nil)
+				},
+				wantErr: false,
+			},
+			{
+				name: "board of another user",
+				mockSetup: func(mockTodoSvc *mocks.TodoService) {
+					mockTodoSvc.On("StarBoard", context.Background(), userID, boardID).Return(todo.ErrBoardAccess)
+				},
+				wantErr: true,
+				err:     todo.ErrBoardAccess,
+			},
+			{
+				name: "negative",
+				mockSetup: func(mockTodoSvc *mocks.TodoService) {
+					mockTodoSvc.On("StarBoard", context.Background(), userID, boardID).Return(errors.New(""))
+				},
+				wantErr: true,
+				err:     v1.ErrStarBoard,
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				t.Parallel()
+
+				runner.Run(t, tt.name, func(pt provider.T) {
+					mockUserSvc := new(mocks.UserService)
+					mockAuthSvc := new(mocks.AuthService)
+					mockTodoSvc := new(mocks.TodoService)
+					logger := log.NewEmptyLogger()
+
+					uc := v1.NewAggregatorUseCase(mockUserSvc, mockAuthSvc, mockTodoSvc, logger)
+
+					tt.mockSetup(mockTodoSvc)
+
+					pt.WithNewStep("Call StarBoard", func(sCtx provider.StepCtx) {
+						err := uc.StarBoard(context.Background(), userID, boardID)
+
+						if tt.wantErr {
+							sCtx.Assert().Error(err, "Expected error")
+							sCtx.Assert().ErrorIs(err, tt.err)
+						} else {
+							sCtx.Assert().NoError(err, "Expected no error")
+						}
+
+						mockTodoSvc.AssertExpectations(t)
+					})
+				})
+			})
+		}
+	})
+}
+
+func TestAddCardWatch(t *testing.T) {
+	runner.Run(t, "TestAddCardWatch", func(pt provider.T) {
+		mom := &testdata.ObjectMother{}
+
+		userID := mom.GetUUID(0).String()
+		cardID := mom.GetUUID(1).String()
+
+		tests := []struct {
+			name      string
+			mockSetup func(mockTodoSvc *mocks.TodoService)
+			wantErr   bool
+			err       error
+		}{
+			{
+				name: "positive",
+				mockSetup: func(mockTodoSvc *mocks.TodoService) {
+					mockTodoSvc.On("AddCardWatch", context.Background(), userID, cardID).Return(nil)
+				},
+				wantErr: false,
+			},
+			{
+				name: "card on a board of another workspace",
+				mockSetup: func(mockTodoSvc *mocks.TodoService) {
+					mockTodoSvc.On("AddCardWatch", context.Background(), userID, cardID).Return(todo.ErrBoardAccess)
+				},
+				wantErr: true,
+				err:     todo.ErrBoardAccess,
+			},
+			{
+				name: "card not found",
+				mockSetup: func(mockTodoSvc *mocks.TodoService) {
+					mockTodoSvc.On("AddCardWatch", context.Background(), userID, cardID).Return(todo.ErrCardNotFound)
+				},
+				wantErr: true,
+				err:     todo.ErrCardNotFound,
+			},
+			{
+				name: "already watched",
+				mockSetup: func(mockTodoSvc *mocks.TodoService) {
+					mockTodoSvc.On("AddCardWatch", context.Background(), userID, cardID).Return(todo.ErrWatchExists)
+				},
+				wantErr: true,
+				err:     todo.ErrWatchExists,
+			},
+			{
+				name: "negative",
+				mockSetup: func(mockTodoSvc *mocks.TodoService) {
+					mockTodoSvc.On("AddCardWatch", context.Background(), userID, cardID).Return(errors.New(""))
+				},
+				wantErr: true,
+				err:     v1.ErrAddWatch,
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				t.Parallel()
+
+				runner.Run(t, tt.name, func(pt provider.T) {
+					mockUserSvc := new(mocks.UserService)
+					mockAuthSvc := new(mocks.AuthService)
+					mockTodoSvc := new(mocks.TodoService)
+					logger := log.NewEmptyLogger()
+
+					uc := v1.NewAggregatorUseCase(mockUserSvc, mockAuthSvc, mockTodoSvc, logger)
+
+					tt.mockSetup(mockTodoSvc)
+
+					pt.WithNewStep("Call AddCardWatch", func(sCtx provider.StepCtx) {
+						err := uc.AddCardWatch(context.Background(), userID, cardID)
+
+						if tt.wantErr {
+							sCtx.Assert().Error(err, "Expected error")
+							sCtx.Assert().ErrorIs(err, tt.err)
+						} else {
+							sCtx.Assert().NoError(err, "Expected no error")
+						}
+
+						mockTodoSvc.AssertExpectations(t)
+					})
+				})
+			})
+		}
+	})
+}
+
+func TestGetNotifications(t *testing.T) {
+	runner.Run(t, "TestGetNotifications", func(pt provider.T) {
+		mom := &testdata.ObjectMother{}
+
+		userID := mom.GetUUID(0).String()
+		actorID := mom.GetUUID(1)
+		goneID := mom.GetUUID(2)
+
+		tests := []struct {
+			name      string
+			mockSetup func(mockUserSvc *mocks.UserService, mockTodoSvc *mocks.TodoService)
+			wantNames []string
+			wantErr   bool
+			err       error
+		}{
+			{
+				name: "positive names each actor once",
+				mockSetup: func(mockUserSvc *mocks.UserService, mockTodoSvc *mocks.TodoService) {
+					mockTodoSvc.On("GetNotifications", context.Background(), userID, true, 10).Return([]dto.Notification{
+						{ID: mom.GetUUID(3), ActorID: actorID, Kind: dto.NotificationMention},
+						{ID: mom.GetUUID(4), ActorID: goneID, Kind: dto.NotificationCardUpdated},
+						{ID: mom.GetUUID(5), ActorID: actorID, Kind: dto.NotificationCardCreated},
+					}, nil)
+					mockUserSvc.On("GetUserByID", context.Background(), actorID.String()).
+						Return(&dto.User{ID: actorID, Username: "UserOne"}, nil).Once()
+					mockUserSvc.On("GetUserByID", context.Background(), goneID.String()).
+						Return(nil, user.ErrUserNotFound).Once()
+				},
+				wantNames: []string{"UserOne", "", "UserOne"},
+				wantErr:   false,
+			},
+			{
+				name: "negative",
+				mockSetup: func(mockUserSvc *mocks.UserService, mockTodoSvc *mocks.TodoService) {
+					mockTodoSvc.On("GetNotifications", context.Background(), userID, true, 10).Return(nil, errors.New(""))
+				},
+				wantErr: true,
+				err:     v1.ErrGetInbox,
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				t.Parallel()
+
+				runner.Run(t, tt.name, func(pt provider.T) {
+					mockUserSvc := new(mocks.UserService)
+					mockAuthSvc := new(mocks.AuthService)
+					mockTodoSvc := new(mocks.TodoService)
+					logger := log.NewEmptyLogger()
+
+					uc := v1.NewAggregatorUseCase(mockUserSvc, mockAuthSvc, mockTodoSvc, logger)
+
+					tt.mockSetup(mockUserSvc, mockTodoSvc)
+
+					pt.WithNewStep("Call GetNotifications", func(sCtx provider.StepCtx) {
+						notifications, err := uc.GetNotifications(context.Background(), userID, true, 10)
+
+						if tt.wantErr {
+							sCtx.Assert().Error(err, "Expected error")
+							sCtx.Assert().ErrorIs(err, tt.err)
+						} else {
+							sCtx.Assert().NoError(err, "Expected no error")
+
+							names := make([]string, len(notifications))
+							for i, n := range notifications {
+								names[i] = n.ActorName
+							}
+							sCtx.Assert().Equal(tt.wantNames, names)
+						}
+
+						mockUserSvc.AssertExpectations(t)
+						mockTodoSvc.AssertExpectations(t)
+					})
+				})
+			})
+		}
+	})
+}
+
+func TestGetMarkedBoards(t *testing.T) {
+	runner.Run(t, "TestGetMarkedBoards", func(pt provider.T) {
+		mom := &testdata.ObjectMother{}
+
+		userID := mom.GetUUID(0).String()
+		boards := []dto.MarkedBoard{
+			{Board: dto.Board{ID: mom.GetUUID(1), Title: "Starred"}, Starred: true},
+			{Board: dto.Board{ID: mom.GetUUID(2), Title: "Other"}},
+		}
+
+		tests := []struct {
+			name      string
+			mockSetup func(mockTodoSvc *mocks.TodoService)
+			wantErr   bool
+			err       error
+		}{
+			{
+				name: "positive",
+				mockSetup: func(mockTodoSvc *mocks.TodoService) {
+					mockTodoSvc.On("GetMarkedBoards", context.Background(), userID).Return(boards, nil)
+				},
+				wantErr: false,
+			},
+			{
+				name: "negative",
+				mockSetup: func(mockTodoSvc *mocks.TodoService) {
+					mockTodoSvc.On("GetMarkedBoards", context.Background(), userID).Return(nil, errors.New(""))
+				},
+				wantErr: true,
+				err:     v1.ErrGetBoards,
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				t.Parallel()
+
+				runner.Run(t, tt.name, func(pt provider.T) {
+					mockUserSvc := new(mocks.UserService)
+					mockAuthSvc := new(mocks.AuthService)
+					mockTodoSvc := new(mocks.TodoService)
+					logger := log.NewEmptyLogger()
+
+					uc := v1.NewAggregatorUseCase(mockUserSvc, mockAuthSvc, mockTodoSvc, logger)
+
+					tt.mockSetup(mockTodoSvc)
+
+					pt.WithNewStep("Call GetMarkedBoards", func(sCtx provider.StepCtx) {
+						got, err := uc.GetMarkedBoards(context.Background(), userID)
+
+						if tt.wantErr {
+							sCtx.Assert().Error(err, "Expected error")
+							sCtx.Assert().ErrorIs(err, tt.err)
+						} else {
+							sCtx.Assert().NoError(err, "Expected no error")
+							sCtx.Assert().Equal(boards, got)
+						}
+
+						mockTodoSvc.AssertExpectations(t)
+					})
+				})
+			})
+		}
+	})
+}
+
+func TestCreateBoardInWorkspace(t *testing.T) {
+	runner.Run(t, "TestCreateBoardInWorkspace", func(pt provider.T) {
+		mom := &testdata.ObjectMother{}
+
+		workspaceID := mom.GetUUID(1)
+		board := dto.Board{UserID: mom.GetUUID(0), WorkspaceID: &workspaceID, Title: "Roadmap"}
+
+		tests := []struct {
+			name      string
+			mockSetup func(mockTodoSvc *mocks.TodoService)
+			wantErr   bool
+			err       error
+		}{
+			{
+				name: "positive",
+				mockSetup: func(mockTodoSvc *mocks.TodoService) {
+					mockTodoSvc.On("CreateBoard", context.Background(), board).Return(nil)
+				},
+				wantErr: false,
+			},
+			{
+				name: "viewer",
+				mockSetup: func(mockTodoSvc *mocks.TodoService) {
+					mockTodoSvc.On("CreateBoard", context.Background(), board).Return(todo.ErrWorkspaceAccess)
+				},
+				wantErr: true,
+				err:     todo.ErrWorkspaceAccess,
+			},
+			{
+				name: "over quota",
+				mockSetup: func(mockTodoSvc *mocks.TodoService) {
+					mockTodoSvc.On("CreateBoard", context.Background(), board).Return(todo.ErrQuotaExceeded)
+				},
+				wantErr: true,
+				err:     todo.ErrQuotaExceeded,
+			},
+			{
+				name: "negative",
+				mockSetup: func(mockTodoSvc *mocks.TodoService) {
+					mockTodoSvc.On("CreateBoard", context.Background(), board).Return(errors.New(""))
+				},
+				wantErr: true,
+				err:     v1.ErrCreateBoard,
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				t.Parallel()
+
+				runner.Run(t, tt.name, func(pt provider.T) {
+					mockUserSvc := new(mocks.UserService)
+					mockAuthSvc := new(mocks.AuthService)
+					mockTodoSvc := new(mocks.TodoService)
+					logger := log.NewEmptyLogger()
+
+					uc := v1.NewAggregatorUseCase(mockUserSvc, mockAuthSvc, mockTodoSvc, logger)
+
+					tt.mockSetup(mockTodoSvc)
+
+					pt.WithNewStep("Call CreateBoard", func(sCtx provider.StepCtx) {
+						err := uc.CreateBoard(context.Background(), board)
+
+						if tt.wantErr {
+							sCtx.Assert().Error(err, "Expected error")
+							sCtx.Assert().ErrorIs(err, tt.err)
+						} else {
+							sCtx.Assert().NoError(err, "Expected no error")
+						}
+
+						mockTodoSvc.AssertExpectations(t)
+					})
+				})
+			})
+		}
+	})
+}
+
+func TestAddWorkspaceMember(t *testing.T) {
+	runner.Run(t, "TestAddWorkspaceMember", func(pt provider.T) {
+		mom := &testdata.ObjectMother{}
+
+		workspaceID := mom.GetUUID(0).String()
+		req := dto.WorkspaceMemberRequest{ActorID: mom.GetUUID(1), UserID: mom.GetUUID(2), Role: dto.RoleMember}
+
+		tests := []struct {
+			name      string
+			mockSetup func(mockTodoSvc *mocks.TodoService)
+			wantErr   bool
+			err       error
+		}{
+			{
+				name: "positive",
+				mockSetup: func(mockTodoSvc *mocks.TodoService) {
+					mockTodoSvc.On("AddWorkspaceMember", context.Background(), workspaceID, req).Return(nil)
+				},
+				wantErr: false,
+			},
+			{
+				name: "not admin",
+				mockSetup: func(mockTodoSvc *mocks.TodoService) {
+					mockTodoSvc.On("AddWorkspaceMember", context.Background(), workspaceID, req).Return(todo.ErrWorkspaceAccess)
+				},
+				wantErr: true,
+				err:     todo.ErrWorkspaceAccess,
+			},
+			{
+				name: "already a member",
+				mockSetup: func(mockTodoSvc *mocks.TodoService) {
+					mockTodoSvc.On("AddWorkspaceMember", context.Background(), workspaceID, req).Return(todo.ErrWorkspaceConflict)
+				},
+				wantErr: true,
+				err:     todo.ErrWorkspaceConflict,
+			},
+			{
+				name: "negative",
+				mockSetup: func(mockTodoSvc *mocks.TodoService) {
+					mockTodoSvc.On("AddWorkspaceMember", context.Background(), workspaceID, req).Return(errors.New(""))
+				},
+				wantErr: true,
+				err:     v1.ErrAddMember,
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				t.Parallel()
+
+				runner.Run(t, tt.name, func(pt provider.T) {
+					mockUserSvc := new(mocks.UserService)
+					mockAuthSvc := new(mocks.AuthService)
+					mockTodoSvc := new(mocks.TodoService)
+					logger := log.NewEmptyLogger()
+
+					uc := v1.NewAggregatorUseCase(mockUserSvc, mockAuthSvc, mockTodoSvc, logger)
+
+					tt.mockSetup(mockTodoSvc)
+
+					pt.WithNewStep("Call AddWorkspaceMember", func(sCtx provider.StepCtx) {
+						err := uc.AddWorkspaceMember(context.Background(), workspaceID, req)
+
+						if tt.wantErr {
+							sCtx.Assert().Error(err, "Expected error")
+							sCtx.Assert().ErrorIs(err, tt.err)
+						} else {
+							sCtx.Assert().NoError(err, "Expected no error")
+						}
+
+						mockTodoSvc.AssertExpectations(t)
+					})
+				})
+			})
+		}
+	})
+}
+
+func TestGetWorkspaceBoards(t *testing.T) {
+	runner.Run(t, "TestGetWorkspaceBoards", func(pt provider.T) {
+		mom := &testdata.ObjectMother{}
+
+		userID := mom.GetUUID(0).String()
+		workspaceID := mom.GetUUID(1)
+
+		tests := []struct {
+			name      string
+			mockSetup func(mockTodoSvc *mocks.TodoService)
+			wantErr   bool
+			err       error
+		}{
+			{
+				name: "positive",
+				mockSetup: func(mockTodoSvc *mocks.TodoService) {
+					mockTodoSvc.On("GetWorkspaceBoards", context.Background(), userID, workspaceID.String()).Return([]dto.Board{
+						{ID: mom.GetUUID(2), WorkspaceID: &workspaceID, Title: "Roadmap"},
+						{ID: mom.GetUUID(3), WorkspaceID: &workspaceID, Title: "Bugs"},
+					}, nil)
+				},
+				wantErr: false,
+			},
+			{
+				name: "not admin",
+				mockSetup: func(mockTodoSvc *mocks.TodoService) {
+					mockTodoSvc.On("GetWorkspaceBoards", context.Background(), userID, workspaceID.String()).Return(nil, todo.ErrWorkspaceAccess)
+				},
+				wantErr: true,
+				err:     todo.ErrWorkspaceAccess,
+			},
+			{
+				name: "not found",
+				mockSetup: func(mockTodoSvc *mocks.TodoService) {
+					mockTodoSvc.On("GetWorkspaceBoards", context.Background(), userID, workspaceID.String()).Return(nil, todo.ErrWorkspaceNotFound)
+				},
+				wantErr: true,
+				err:     todo.ErrWorkspaceNotFound,
+			},
+			{
+				name: "negative",
+				mockSetup: func(mockTodoSvc *mocks.TodoService) {
+					mockTodoSvc.On("GetWorkspaceBoards", context.Background(), userID, workspaceID.String()).Return(nil, errors.New(""))
+				},
+				wantErr: true,
+				err:     v1.ErrWorkspaceBoards,
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				t.Parallel()
+
+				runner.Run(t, tt.name, func(pt provider.T) {
+					mockUserSvc := new(mocks.UserService)
+					mockAuthSvc := new(mocks.AuthService)
+					mockTodoSvc := new(mocks.TodoService)
+					logger := log.NewEmptyLogger()
+
+					uc := v1.NewAggregatorUseCase(mockUserSvc, mockAuthSvc, mockTodoSvc, logger)
+
+					tt.mockSetup(mockTodoSvc)
+
+					pt.WithNewStep("Call GetWorkspaceBoards", func(sCtx provider.StepCtx) {
+						boards, err := uc.GetWorkspaceBoards(context.Background(), userID, workspaceID.String())
+
+						if tt.wantErr {
+							sCtx.Assert().Error(err, "Expected error")
+							sCtx.Assert().ErrorIs(err, tt.err)
+						} else {
+							sCtx.Assert().NoError(err, "Expected no error")
+							sCtx.Assert().Len(boards, 2)
+						}
+
+						mockTodoSvc.AssertExpectations(t)
+					})
+				})
+			})
+		}
+	})
+}
+
+func TestDeleteUser(t *testing.T) {
+	runner.Run(t, "TestDeleteUser", func(pt provider.T) {
+		mom := &testdata.ObjectMother{}
+
+		userID := mom.GetUUID(0).String()
+		heirID := mom.GetUUID(1).String()
+		boards := []dto.Board{{ID: mom.GetUUID(2)}, {ID: mom.GetUUID(3)}}
+		boardIDs := []string{boards[0].ID.String(), boards[1].ID.String()}
+
+		tests := []struct {
+			name       string
+			transferTo string
+			mockSetup  func(mockUserSvc *mocks.UserService, mockAuthSvc *mocks.AuthService, mockTodoSvc *mocks.TodoService)
+			wantErr    bool
+			err        error
+		}{
+			{
+				name: "positive",
+				mockSetup: func(mockUserSvc *mocks.UserService, mockAuthSvc *mocks.AuthService, mockTodoSvc *mocks.TodoService) {
+					mockUserSvc.On("GetUserByID", context.Background(), userID).Return(&dto.User{}, nil)
+					mockAuthSvc.On("RevokeUserTokens", context.Background(), userID).Return(nil)
+					mockTodoSvc.On("DeleteUserData", context.Background(), userID).Return(nil)
+					mockUserSvc.On("DeleteUser", context.Background(), userID).Return(nil)
+				},
+				wantErr: false,
+			},
+			{
+				name:       "transfers boards",
+				transferTo: heirID,
+				mockSetup: func(mockUserSvc *mocks.UserService, mockAuthSvc *mocks.AuthService, mockTodoSvc *mocks.TodoService) {
+					mockUserSvc.On("GetUserByID", context.Background(), userID).Return(&dto.User{}, nil)
+					mockUserSvc.On("GetUserByID", context.Background(), heirID).Return(&dto.User{}, nil)
+					mockTodoSvc.On("TransferUserBoards", context.Background(), userID, heirID, []string(nil)).Return(boards, nil)
+					mockAuthSvc.On("RevokeUserTokens", context.Background(), userID).Return(nil)
+					mockTodoSvc.On("DeleteUserData", context.Background(), userID).Return(nil)
+					mockUserSvc.On("DeleteUser", context.Background(), userID).Return(nil)
+				},
+				wantErr: false,
+			},
+			{
+				name: "user not found",
+				mockSetup: func(mockUserSvc *mocks.UserService, mockAuthSvc *mocks.AuthService, mockTodoSvc *mocks.TodoService) {
+					mockUserSvc.On("GetUserByID", context.Background(), userID).Return(nil, user.ErrUserNotFound)
+				},
+				wantErr: true,
+				err:     user.ErrUserNotFound,
+			},
+			{
+				name:       "transfer to self",
+				transferTo: userID,
+				mockSetup: func(mockUserSvc *mocks.UserService, mockAuthSvc *mocks.AuthService, mockTodoSvc *mocks.TodoService) {
+					mockUserSvc.On("GetUserByID", context.Background(), userID).Return(&dto.User{}, nil)
+				},
+				wantErr: true,
+				err:     todo.ErrInvalidTransfer,
+			},
+			{
+				name:       "gives boards back when data deletion fails",
+				transferTo: heirID,
+				mockSetup: func(mockUserSvc *mocks.UserService, mockAuthSvc *mocks.AuthService, mockTodoSvc *mocks.TodoService) {
+					mockUserSvc.On("GetUserByID", context.Background(), userID).Return(&dto.User{}, nil)
+					mockUserSvc.On("GetUserByID", context.Background(), heirID).Return(&dto.User{}, nil)
+					mockTodoSvc.On("TransferUserBoards", context.Background(), userID, heirID, []string(nil)).Return(boards, nil)
+					mockAuthSvc.On("RevokeUserTokens", context.Background(), userID).Return(nil)
+					mockTodoSvc.On("DeleteUserData", context.Background(), userID).Return(errors.New(""))
+					mockTodoSvc.On("TransferUserBoards", context.Background(), heirID, userID, boardIDs).Return(boards, nil)
+				},
+				wantErr: true,
+				err:     v1.ErrDeleteUser,
+			},
+			{
+				name:       "reports boards not given back",
+				transferTo: heirID,
+				mockSetup: func(mockUserSvc *mocks.UserService, mockAuthSvc *mocks.AuthService, mockTodoSvc *mocks.TodoService) {
+					mockUserSvc.On("GetUserByID", context.Background(), userID).Return(&dto.User{}, nil)
+					mockUserSvc.On("GetUserByID", context.Background(), heirID).Return(&dto.User{}, nil)
+					mockTodoSvc.On("TransferUserBoards", context.Background(), userID, heirID, []string(nil)).Return(boards, nil)
+					mockAuthSvc.On("RevokeUserTokens", context.Background(), userID).Return(errors.New(""))
+					mockTodoSvc.On("TransferUserBoards", context.Background(), heirID, userID, boardIDs).Return(nil, errors.New(""))
+				},
+				wantErr: true,
+				err:     v1.ErrRestoreBoards,
+			},
+			{
+				name: "user deletion fails after data deletion",
+				mockSetup: func(mockUserSvc *mocks.UserService, mockAuthSvc *mocks.AuthService, mockTodoSvc *mocks.TodoService) {
+					mockUserSvc.On("GetUserByID", context.Background(), userID).Return(&dto.User{}, nil)
+					mockAuthSvc.On("RevokeUserTokens", context.Background(), userID).Return(nil)
+					mockTodoSvc.On("DeleteUserData", context.Background(), userID).Return(nil)
+					mockUserSvc.On("DeleteUser", context.Background(), userID).Return(errors.New(""))
+				},
+				wantErr: true,
+				err:     v1.ErrDeleteUser,
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				t.Parallel()
+
+				runner.Run(t, tt.name, func(pt provider.T) {
+					mockUserSvc := new(mocks.UserService)
+					mockAuthSvc := new(mocks.AuthService)
+					mockTodoSvc := new(mocks.TodoService)
+					logger := log.NewEmptyLogger()
+
+					uc := v1.NewAggregatorUseCase(mockUserSvc, mockAuthSvc, mockTodoSvc, logger)
+
+					tt.mockSetup(mockUserSvc, mockAuthSvc, mockTodoSvc)
+
+					pt.WithNewStep("Call DeleteUser", func(sCtx provider.StepCtx) {
+						err := uc.DeleteUser(context.Background(), userID, tt.transferTo)
+
+						if tt.wantErr {
+							sCtx.Assert().Error(err, "Expected error")
+							sCtx.Assert().ErrorIs(err, tt.err)
+						} else {
+							sCtx.Assert().NoError(err, "Expected no error")
+						}
+
+						mockUserSvc.AssertExpectations(t)
+						mockAuthSvc.AssertExpectations(t)
+						mockTodoSvc.AssertExpectations(t)
+					})
+				})
+			})
+		}
+	})
+}
+
+func TestSetQuotaOverride(t *testing.T) {
+	runner.Run(t, "TestSetQuotaOverride", func(pt provider.T) {
+		mom := &testdata.ObjectMother{}
+
+		userID := mom.GetUUID(0)
+		cards := 1000
+		req := dto.QuotaOverrideRequest{CardsPerColumn: &cards}
+		quota := &dto.UserQuota{
+			UserID:   userID,
+			Quota:    dto.Quota{BoardsPerUser: 100, ColumnsPerBoard: 50, CardsPerColumn: cards, DescriptionLength: 20000},
+			Override: &dto.QuotaOverride{QuotaOverrideRequest: req},
+		}
+
+		tests := []struct {
+			name      string
+			mockSetup func(mockUserSvc *mocks.UserService, mockTodoSvc *mocks.TodoService)
+			wantErr   bool
+			err       error
+		}{
+			{
+				name: "positive",
+				mockSetup: func(mockUserSvc *mocks.UserService, mockTodoSvc *mocks.TodoService) {
+					mockUserSvc.On("GetUserByID", context.Background(), userID.String()).Return(&dto.User{ID: userID}, nil)
+					mockTodoSvc.On("SetQuotaOverride", context.Background(), userID.String(), req).Return(quota, nil)
+				},
+				wantErr: false,
+			},
+			{
+				name: "user not found",
+				mockSetup: func(mockUserSvc *mocks.UserService, mockTodoSvc *mocks.TodoService) {
+					mockUserSvc.On("GetUserByID", context.Background(), userID.String()).Return(nil, user.ErrUserNotFound)
+				},
+				wantErr: true,
+				err:     user.ErrUserNotFound,
+			},
+			{
+				name: "invalid override",
+				mockSetup: func(mockUserSvc *mocks.UserService, mockTodoSvc *mocks.TodoService) {
+					mockUserSvc.On("GetUserByID", context.Background(), userID.String()).Return(&dto.User{ID: userID}, nil)
+					mockTodoSvc.On("SetQuotaOverride", context.Background(), userID.String(), req).Return(nil, todo.ErrInvalidQuota)
+				},
+				wantErr: true,
+				err:     todo.ErrInvalidQuota,
+			},
+			{
+				name: "negative",
+				mockSetup: func(mockUserSvc *mocks.UserService, mockTodoSvc *mocks.TodoService) {
+					mockUserSvc.On("GetUserByID", context.Background(), userID.String()).Return(&dto.User{ID: userID}, nil)
+					mockTodoSvc.On("SetQuotaOverride", context.Background(), userID.String(), req).Return(nil, errors.New(""))
+				},
+				wantErr: true,
+				err:     v1.ErrSetQuota,
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				t.Parallel()
+
+				runner.Run(t, tt.name, func(pt provider.T) {
+					mockUserSvc := new(mocks.UserService)
+					mockAuthSvc := new(mocks.AuthService)
+					mockTodoSvc := new(mocks.TodoService)
+					logger := log.NewEmptyLogger()
+
+					uc := v1.NewAggregatorUseCase(mockUserSvc, mockAuthSvc, mockTodoSvc, logger)
+
+					tt.mockSetup(mockUserSvc, mockTodoSvc)
+
+					pt.WithNewStep("Call SetQuotaOverride", func(sCtx provider.StepCtx) {
+						got, err := uc.SetQuotaOverride(context.Background(), userID.String(), req)
+
+						if tt.wantErr {
+							sCtx.Assert().Error(err, "Expected error")
+							sCtx.Assert().ErrorIs(err, tt.err)
+						} else {
+							sCtx.Assert().NoError(err, "Expected no error")
+							sCtx.Assert().Equal(quota, got)
+						}
+
+						mockUserSvc.AssertExpectations(t)
+						mockTodoSvc.AssertExpectations(t)
+					})
+				})
+			})
+		}
+	})
+}
+
+func TestGetBoardStats(t *testing.T) {
+	runner.Run(t, "TestGetBoardStats", func(pt provider.T) {
+		mom := &testdata.ObjectMother{}
+
+		userID := mom.GetUUID(0).String()
+		boardID := mom.GetUUID(1).String()
+		activeID := mom.GetUUID(2)
+		goneID := mom.GetUUID(3)
+
+		tests := []struct {
+			name      string
+			mockSetup func(mockUserSvc *mocks.UserService, mockTodoSvc *mocks.TodoService)
+			wantNames []string
+			wantErr   bool
+			err       error
+		}{
+			{
+				name: "positive names the contributors it can",
+				mockSetup: func(mockUserSvc *mocks.UserService, mockTodoSvc *mocks.TodoService) {
+					mockTodoSvc.On("GetBoardStats", context.Background(), userID, boardID, "01-01-2024", "07-01-2024", "").
+						Return(&dto.BoardStats{Contributors: []dto.Contributor{
+							{UserID: activeID, Created: 2},
+							{UserID: goneID, Completed: 1},
+						}}, nil)
+					mockUserSvc.On("GetUserByID", context.Background(), activeID.String()).
+						Return(&dto.User{ID: activeID, Username: "UserOne"}, nil)
+					mockUserSvc.On("GetUserByID", context.Background(), goneID.String()).
+						Return(nil, user.ErrUserNotFound)
+				},
+				wantNames: []string{"UserOne", ""},
+				wantErr:   false,
+			},
+			{
+				name: "not a member",
+				mockSetup: func(mockUserSvc *mocks.UserService, mockTodoSvc *mocks.TodoService) {
+					mockTodoSvc.On("GetBoardStats", context.Background(), userID, boardID, "01-01-2024", "07-01-2024", "").
+						Return(nil, todo.ErrBoardAccess)
+				},
+				wantErr: true,
+				err:     todo.ErrBoardAccess,
+			},
+			{
+				name: "invalid period",
+				mockSetup: func(mockUserSvc *mocks.UserService, mockTodoSvc *mocks.TodoService) {
+					mockTodoSvc.On("GetBoardStats", context.Background(), userID, boardID, "01-01-2024", "07-01-2024", "").
+						Return(nil, todo.ErrInvalidStats)
+				},
+				wantErr: true,
+				err:     todo.ErrInvalidStats,
+			},
+			{
+				name: "negative",
+				mockSetup: func(mockUserSvc *mocks.UserService, mockTodoSvc *mocks.TodoService) {
+					mockTodoSvc.On("GetBoardStats", context.Background(), userID, boardID, "01-01-2024", "07-01-2024", "").
+						Return(nil, errors.New(""))
+				},
+				wantErr: true,
+				err:     v1.ErrGetBoardStats,
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				t.Parallel()
+
+				runner.Run(t, tt.name, func(pt provider.T) {
+					mockUserSvc := new(mocks.UserService)
+					mockAuthSvc := new(mocks.AuthService)
+					mockTodoSvc := new(mocks.TodoService)
+					logger := log.NewEmptyLogger()
+
+					uc := v1.NewAggregatorUseCase(mockUserSvc, mockAuthSvc, mockTodoSvc, logger)
+
+					tt.mockSetup(mockUserSvc, mockTodoSvc)
+
+					pt.WithNewStep("Call GetBoardStats", func(sCtx provider.StepCtx) {
+						stats, err := uc.GetBoardStats(context.Background(), userID, boardID, "01-01-2024", "07-01-2024", "")
+
+						if tt.wantErr {
+							sCtx.Assert().Error(err, "Expected error")
+							sCtx.Assert().ErrorIs(err, tt.err)
+						} else {
+							sCtx.Assert().NoError(err, "Expected no error")
+
+							names := make([]string, len(stats.Contributors))
+							for i, c := range stats.Contributors {
+								names[i] = c.Username
+							}
+							sCtx.Assert().Equal(tt.wantNames, names)
+						}
+
+						mockUserSvc.AssertExpectations(t)
+						mockTodoSvc.AssertExpectations(t)
+					})
+				})
+			})
+		}
+	})
+}
@@ -0,0 +1,145 @@
+package v1
+
+import (
+	"aggregator/internal/dto"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// CalendarFeedPath is where the calendar feed of a token is served.
+const CalendarFeedPath = "/api/v1/calendar/%s.ics"
+
+const (
+	icalDateTime = "20060102T150405Z"
+	icalDate     = "20060102"
+	// icalLineOctets is the longest a content line may be before it is
+	// folded (RFC 5545, 3.1).
+	icalLineOctets = 75
+)
+
+// wallClock reads a time from the todo service. It keeps times without a
+// zone, as wall clock times where the services run, so the clock reading is
+// taken in time.Local whatever zone t came with.
+func wallClock(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), time.Local)
+}
+
+// icalWriter builds an iCalendar object line by line, folding long lines
+// and ending them with CRLF.
+type icalWriter struct {
+	b strings.Builder
+}
+
+func (w *icalWriter) line(name, value string) {
+	line := name + ":" + value
+	limit := icalLineOctets
+
+	for len(line) > limit {
+		cut := limit
+		for cut > 0 && !utf8.RuneStart(line[cut]) {
+			cut--
+		}
+		w.b.WriteString(line[:cut] + "\r\n ")
+		line = line[cut:]
+		// The leading space of a continuation line counts as an octet.
+		limit = icalLineOctets - 1
+	}
+
+	w.b.WriteString(line + "\r\n")
+}
+
+func (w *icalWriter) text(name, value string) {
+	w.line(name, icalEscape(value))
+}
+
+// utc writes a date-time in UTC, which needs no VTIMEZONE.
+func (w *icalWriter) utc(name string, t time.Time) {
+	w.line(name, wallClock(t).UTC().Format(icalDateTime))
+}
+
+// icalEscape escapes a TEXT value (RFC 5545, 3.3.11).
+func icalEscape(s string) string {
+	return strings.NewReplacer(
+		`\`, `\\`,
+		";", `\;`,
+		",", `\,`,
+		"\r\n", `\n`,
+		"\n", `\n`,
+		"\r", "",
+	).Replace(s)
+}
+
+// writeDue writes a due date; one at midnight is a date alone, the way the
+// clients set them, and ends with the day when end is given.
+func (w *icalWriter) writeDue(name, end string, due time.Time) {
+	local := wallClock(due)
+
+	if local.Hour() == 0 && local.Minute() == 0 && local.Second() == 0 {
+		w.line(name+";VALUE=DATE", local.Format(icalDate))
+		if end != "" {
+			w.line(end+";VALUE=DATE", local.AddDate(0, 0, 1).Format(icalDate))
+		}
+		return
+	}
+
+	w.utc(name, due)
+}
+
+// writeCard writes what an event and a to-do of a card have in common.
+// DTSTAMP is when the feed was made; the card's own change time is
+// LAST-MODIFIED.
+func (w *icalWriter) writeCard(card dto.CalendarCard, stamp time.Time) {
+	w.line("DTSTAMP", stamp.UTC().Format(icalDateTime))
+	w.utc("CREATED", card.CreatedAt)
+	w.utc("LAST-MODIFIED", card.UpdatedAt)
+	w.line("SEQUENCE", fmt.Sprint(max(card.Version-1, 0)))
+	w.text("SUMMARY", card.Title)
+	if card.Description != "" {
+		w.text("DESCRIPTION", card.Description)
+	}
+	w.text("CATEGORIES", card.BoardTitle)
+}
+
+// renderCalendar turns cards into an iCalendar (RFC 5545) feed. UIDs are
+// made from card ids, so calendar apps see the same entries update as the
+// cards change. stamp is the time the feed is made at.
+func renderCalendar(cards []dto.CalendarCard, kind string, stamp time.Time) []byte {
+	var w icalWriter
+
+	w.line("BEGIN", "VCALENDAR")
+	w.line("VERSION", "2.0")
+	w.line("PRODID", "-//Service Aggregator//Todo//EN")
+	w.line("CALSCALE", "GREGORIAN")
+	w.line("METHOD", "PUBLISH")
+	w.text("X-WR-CALNAME", "Todo")
+
+	for _, card := range cards {
+		if kind != dto.CalendarKindTodo {
+			w.line("BEGIN", "VEVENT")
+			w.line("UID", fmt.Sprintf("card-%s-due@todo", card.CardID))
+			w.writeCard(card, stamp)
+			w.writeDue("DTSTART", "DTEND", card.DueDate)
+			w.line("TRANSP", "TRANSPARENT")
+			w.line("END", "VEVENT")
+		}
+
+		if kind != dto.CalendarKindEvent {
+			w.line("BEGIN", "VTODO")
+			w.line("UID", fmt.Sprintf("card-%s@todo", card.CardID))
+			w.writeCard(card, stamp)
+			w.writeDue("DUE", "", card.DueDate)
+			if card.Done {
+				w.line("STATUS", "COMPLETED")
+			} else {
+				w.line("STATUS", "NEEDS-ACTION")
+			}
+			w.line("END", "VTODO")
+		}
+	}
+
+	w.line("END", "VCALENDAR")
+
+	return []byte(w.b.String())
+}
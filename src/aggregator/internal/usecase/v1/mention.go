@@ -0,0 +1,98 @@
+package v1
+
+import (
+	"aggregator/internal/dto"
+	"aggregator/internal/service/user"
+	"context"
+	"errors"
+	"regexp"
+
+	"github.com/google/uuid"
+)
+
+// maxMentions caps how many users a single text can mention, so a pasted
+// list of names cannot fan out to the whole user base.
+const maxMentions = 20
+
+// mentionPattern matches @username where usernames follow the rules of the
+// user service. The character before the @ keeps email addresses out.
+var mentionPattern = regexp.MustCompile(`(?:^|[^\w@.])@([a-zA-Z][a-zA-Z0-9_]{4,})`)
+
+// parseMentions returns the usernames mentioned in text, each once, in the
+// order they first appear.
+func parseMentions(text string) []string {
+	var usernames []string
+	seen := make(map[string]bool)
+
+	for _, match := range mentionPattern.FindAllStringSubmatch(text, -1) {
+		if seen[match[1]] {
+			continue
+		}
+
+		seen[match[1]] = true
+		usernames = append(usernames, match[1])
+
+		if len(usernames) == maxMentions {
+			break
+		}
+	}
+
+	return usernames
+}
+
+// newMentions returns the usernames mentioned in after but not in before,
+// so editing a card does not mention the same users again.
+func newMentions(before, after string) []string {
+	old := make(map[string]bool)
+	for _, username := range parseMentions(before) {
+		old[username] = true
+	}
+
+	var usernames []string
+	for _, username := range parseMentions(after) {
+		if !old[username] {
+			usernames = append(usernames, username)
+		}
+	}
+
+	return usernames
+}
+
+// notifyCard resolves the usernames mentioned and has the todo service tell
+// them and the card watchers what the actor did. It is best effort: the
+// card change has been made already, so failures are only logged.
+func (uc *AggregatorUseCase) notifyCard(ctx context.Context, header string, actorID, cardID uuid.UUID, kind string, usernames []string) {
+	var mentioned []uuid.UUID
+
+	for _, username := range usernames {
+		u, err := uc.userSvc.GetUserByUsername(ctx, username)
+
+		if errors.Is(err, user.ErrUserNotFound) {
+			uc.log.Info(ctx, header+"Leaving out mention of unknown user", "username", username)
+			continue
+		}
+
+		if err != nil {
+			uc.log.Warn(ctx, header+"Failed to resolve mention", "username", username, "err", err.Error())
+			continue
+		}
+
+		mentioned = append(mentioned, u.ID)
+	}
+
+	req := dto.NotifyCardRequest{
+		ActorID:   actorID,
+		CardID:    cardID,
+		Kind:      kind,
+		Mentioned: mentioned,
+	}
+
+	notifications, err := uc.todoSvc.NotifyCard(ctx, req)
+
+	if err != nil {
+		uc.log.Warn(ctx, header+"Failed to notify card watchers", "err", err.Error())
+		return
+	}
+
+	uc.log.Info(ctx, header+"Notified card watchers", "count", len(notifications))
+}
@@ -13,6 +13,36 @@ type AggregatorHandler struct {
 	mock.Mock
 }
 
+// AddBoardWatch provides a mock function with given fields: w, r
+func (_m *AggregatorHandler) AddBoardWatch(w http.ResponseWriter, r *http.Request) {
+	_m.Called(w, r)
+}
+
+// AddCardWatch provides a mock function with given fields: w, r
+func (_m *AggregatorHandler) AddCardWatch(w http.ResponseWriter, r *http.Request) {
+	_m.Called(w, r)
+}
+
+// AddSprintCard provides a mock function with given fields: w, r
+func (_m *AggregatorHandler) AddSprintCard(w http.ResponseWriter, r *http.Request) {
+	_m.Called(w, r)
+}
+
+// AddWorkspaceMember provides a mock function with given fields: w, r
+func (_m *AggregatorHandler) AddWorkspaceMember(w http.ResponseWriter, r *http.Request) {
+	_m.Called(w, r)
+}
+
+// BulkCards provides a mock function with given fields: w, r
+func (_m *AggregatorHandler) BulkCards(w http.ResponseWriter, r *http.Request) {
+	_m.Called(w, r)
+}
+
+// CloseSprint provides a mock function with given fields: w, r
+func (_m *AggregatorHandler) CloseSprint(w http.ResponseWriter, r *http.Request) {
+	_m.Called(w, r)
+}
+
 // CreateBoard provides a mock function with given fields: w, r
 func (_m *AggregatorHandler) CreateBoard(w http.ResponseWriter, r *http.Request) {
 	_m.Called(w, r)
@@ -23,11 +53,31 @@ func (_m *AggregatorHandler) CreateCard(w http.ResponseWriter, r *http.Request)
 	_m.Called(w, r)
 }
 
+// CreateCardTemplate provides a mock function with given fields: w, r
+func (_m *AggregatorHandler) CreateCardTemplate(w http.ResponseWriter, r *http.Request) {
+	_m.Called(w, r)
+}
+
 // CreateColumn provides a mock function with given fields: w, r
 func (_m *AggregatorHandler) CreateColumn(w http.ResponseWriter, r *http.Request) {
 	_m.Called(w, r)
 }
 
+// CreateSprint provides a mock function with given fields: w, r
+func (_m *AggregatorHandler) CreateSprint(w http.ResponseWriter, r *http.Request) {
+	_m.Called(w, r)
+}
+
+// CreateSwimlane provides a mock function with given fields: w, r
+func (_m *AggregatorHandler) CreateSwimlane(w http.ResponseWriter, r *http.Request) {
+	_m.Called(w, r)
+}
+
+// CreateWorkspace provides a mock function with given fields: w, r
+func (_m *AggregatorHandler) CreateWorkspace(w http.ResponseWriter, r *http.Request) {
+	_m.Called(w, r)
+}
+
 // DeleteBoard provides a mock function with given fields: w, r
 func (_m *AggregatorHandler) DeleteBoard(w http.ResponseWriter, r *http.Request) {
 	_m.Called(w, r)
@@ -38,36 +88,176 @@ func (_m *AggregatorHandler) DeleteCard(w http.ResponseWriter, r *http.Request)
 	_m.Called(w, r)
 }
 
+// DeleteCardTemplate provides a mock function with given fields: w, r
+func (_m *AggregatorHandler) DeleteCardTemplate(w http.ResponseWriter, r *http.Request) {
+	_m.Called(w, r)
+}
+
 // DeleteColumn provides a mock function with given fields: w, r
 func (_m *AggregatorHandler) DeleteColumn(w http.ResponseWriter, r *http.Request) {
 	_m.Called(w, r)
 }
 
+// DeleteQuotaOverride provides a mock function with given fields: w, r
+func (_m *AggregatorHandler) DeleteQuotaOverride(w http.ResponseWriter, r *http.Request) {
+	_m.Called(w, r)
+}
+
+// DeleteSwimlane provides a mock function with given fields: w, r
+func (_m *AggregatorHandler) DeleteSwimlane(w http.ResponseWriter, r *http.Request) {
+	_m.Called(w, r)
+}
+
+// DeleteUser provides a mock function with given fields: w, r
+func (_m *AggregatorHandler) DeleteUser(w http.ResponseWriter, r *http.Request) {
+	_m.Called(w, r)
+}
+
 // GetBoard provides a mock function with given fields: w, r
 func (_m *AggregatorHandler) GetBoard(w http.ResponseWriter, r *http.Request) {
 	_m.Called(w, r)
 }
 
+// GetBoardAnalytics provides a mock function with given fields: w, r
+func (_m *AggregatorHandler) GetBoardAnalytics(w http.ResponseWriter, r *http.Request) {
+	_m.Called(w, r)
+}
+
+// GetBoardByID provides a mock function with given fields: w, r
+func (_m *AggregatorHandler) GetBoardByID(w http.ResponseWriter, r *http.Request) {
+	_m.Called(w, r)
+}
+
+// GetBoardCardTemplates provides a mock function with given fields: w, r
+func (_m *AggregatorHandler) GetBoardCardTemplates(w http.ResponseWriter, r *http.Request) {
+	_m.Called(w, r)
+}
+
+// GetBoardCards provides a mock function with given fields: w, r
+func (_m *AggregatorHandler) GetBoardCards(w http.ResponseWriter, r *http.Request) {
+	_m.Called(w, r)
+}
+
+// GetBoardSprints provides a mock function with given fields: w, r
+func (_m *AggregatorHandler) GetBoardSprints(w http.ResponseWriter, r *http.Request) {
+	_m.Called(w, r)
+}
+
+// GetBoardStats provides a mock function with given fields: w, r
+func (_m *AggregatorHandler) GetBoardStats(w http.ResponseWriter, r *http.Request) {
+	_m.Called(w, r)
+}
+
 // GetBoards provides a mock function with given fields: w, r
 func (_m *AggregatorHandler) GetBoards(w http.ResponseWriter, r *http.Request) {
 	_m.Called(w, r)
 }
 
+// GetCalendarFeed provides a mock function with given fields: w, r
+func (_m *AggregatorHandler) GetCalendarFeed(w http.ResponseWriter, r *http.Request) {
+	_m.Called(w, r)
+}
+
 // GetCard provides a mock function with given fields: w, r
 func (_m *AggregatorHandler) GetCard(w http.ResponseWriter, r *http.Request) {
 	_m.Called(w, r)
 }
 
+// GetCardAncestors provides a mock function with given fields: w, r
+func (_m *AggregatorHandler) GetCardAncestors(w http.ResponseWriter, r *http.Request) {
+	_m.Called(w, r)
+}
+
+// GetCardChildren provides a mock function with given fields: w, r
+func (_m *AggregatorHandler) GetCardChildren(w http.ResponseWriter, r *http.Request) {
+	_m.Called(w, r)
+}
+
+// GetCardTemplate provides a mock function with given fields: w, r
+func (_m *AggregatorHandler) GetCardTemplate(w http.ResponseWriter, r *http.Request) {
+	_m.Called(w, r)
+}
+
+// GetCardTimeEntries provides a mock function with given fields: w, r
+func (_m *AggregatorHandler) GetCardTimeEntries(w http.ResponseWriter, r *http.Request) {
+	_m.Called(w, r)
+}
+
 // GetColumn provides a mock function with given fields: w, r
 func (_m *AggregatorHandler) GetColumn(w http.ResponseWriter, r *http.Request) {
 	_m.Called(w, r)
 }
 
+// GetColumnByID provides a mock function with given fields: w, r
+func (_m *AggregatorHandler) GetColumnByID(w http.ResponseWriter, r *http.Request) {
+	_m.Called(w, r)
+}
+
+// GetNotifications provides a mock function with given fields: w, r
+func (_m *AggregatorHandler) GetNotifications(w http.ResponseWriter, r *http.Request) {
+	_m.Called(w, r)
+}
+
+// GetRecentBoards provides a mock function with given fields: w, r
+func (_m *AggregatorHandler) GetRecentBoards(w http.ResponseWriter, r *http.Request) {
+	_m.Called(w, r)
+}
+
+// GetSprint provides a mock function with given fields: w, r
+func (_m *AggregatorHandler) GetSprint(w http.ResponseWriter, r *http.Request) {
+	_m.Called(w, r)
+}
+
+// GetSprintBurndown provides a mock function with given fields: w, r
+func (_m *AggregatorHandler) GetSprintBurndown(w http.ResponseWriter, r *http.Request) {
+	_m.Called(w, r)
+}
+
+// GetSprintCards provides a mock function with given fields: w, r
+func (_m *AggregatorHandler) GetSprintCards(w http.ResponseWriter, r *http.Request) {
+	_m.Called(w, r)
+}
+
 // GetStats provides a mock function with given fields: w, r
 func (_m *AggregatorHandler) GetStats(w http.ResponseWriter, r *http.Request) {
 	_m.Called(w, r)
 }
 
+// GetSwimlaneByID provides a mock function with given fields: w, r
+func (_m *AggregatorHandler) GetSwimlaneByID(w http.ResponseWriter, r *http.Request) {
+	_m.Called(w, r)
+}
+
+// GetTimeReport provides a mock function with given fields: w, r
+func (_m *AggregatorHandler) GetTimeReport(w http.ResponseWriter, r *http.Request) {
+	_m.Called(w, r)
+}
+
+// GetUserQuota provides a mock function with given fields: w, r
+func (_m *AggregatorHandler) GetUserQuota(w http.ResponseWriter, r *http.Request) {
+	_m.Called(w, r)
+}
+
+// GetWorkspaceBoards provides a mock function with given fields: w, r
+func (_m *AggregatorHandler) GetWorkspaceBoards(w http.ResponseWriter, r *http.Request) {
+	_m.Called(w, r)
+}
+
+// GetWorkspaceMembers provides a mock function with given fields: w, r
+func (_m *AggregatorHandler) GetWorkspaceMembers(w http.ResponseWriter, r *http.Request) {
+	_m.Called(w, r)
+}
+
+// GetWorkspaces provides a mock function with given fields: w, r
+func (_m *AggregatorHandler) GetWorkspaces(w http.ResponseWriter, r *http.Request) {
+	_m.Called(w, r)
+}
+
+// LogTime provides a mock function with given fields: w, r
+func (_m *AggregatorHandler) LogTime(w http.ResponseWriter, r *http.Request) {
+	_m.Called(w, r)
+}
+
 // Login provides a mock function with given fields: w, r
 func (_m *AggregatorHandler) Login(w http.ResponseWriter, r *http.Request) {
 	_m.Called(w, r)
@@ -78,16 +268,91 @@ func (_m *AggregatorHandler) Logout(w http.ResponseWriter, r *http.Request) {
 	_m.Called(w, r)
 }
 
+// MarkNotificationsRead provides a mock function with given fields: w, r
+func (_m *AggregatorHandler) MarkNotificationsRead(w http.ResponseWriter, r *http.Request) {
+	_m.Called(w, r)
+}
+
+// MergeBoards provides a mock function with given fields: w, r
+func (_m *AggregatorHandler) MergeBoards(w http.ResponseWriter, r *http.Request) {
+	_m.Called(w, r)
+}
+
+// MoveColumn provides a mock function with given fields: w, r
+func (_m *AggregatorHandler) MoveColumn(w http.ResponseWriter, r *http.Request) {
+	_m.Called(w, r)
+}
+
 // Refresh provides a mock function with given fields: w, r
 func (_m *AggregatorHandler) Refresh(w http.ResponseWriter, r *http.Request) {
 	_m.Called(w, r)
 }
 
+// RegenerateCalendarToken provides a mock function with given fields: w, r
+func (_m *AggregatorHandler) RegenerateCalendarToken(w http.ResponseWriter, r *http.Request) {
+	_m.Called(w, r)
+}
+
 // Register provides a mock function with given fields: w, r
 func (_m *AggregatorHandler) Register(w http.ResponseWriter, r *http.Request) {
 	_m.Called(w, r)
 }
 
+// RemoveBoardWatch provides a mock function with given fields: w, r
+func (_m *AggregatorHandler) RemoveBoardWatch(w http.ResponseWriter, r *http.Request) {
+	_m.Called(w, r)
+}
+
+// RemoveCardWatch provides a mock function with given fields: w, r
+func (_m *AggregatorHandler) RemoveCardWatch(w http.ResponseWriter, r *http.Request) {
+	_m.Called(w, r)
+}
+
+// RemoveSprintCard provides a mock function with given fields: w, r
+func (_m *AggregatorHandler) RemoveSprintCard(w http.ResponseWriter, r *http.Request) {
+	_m.Called(w, r)
+}
+
+// RemoveWorkspaceMember provides a mock function with given fields: w, r
+func (_m *AggregatorHandler) RemoveWorkspaceMember(w http.ResponseWriter, r *http.Request) {
+	_m.Called(w, r)
+}
+
+// SetCardParent provides a mock function with given fields: w, r
+func (_m *AggregatorHandler) SetCardParent(w http.ResponseWriter, r *http.Request) {
+	_m.Called(w, r)
+}
+
+// SetQuotaOverride provides a mock function with given fields: w, r
+func (_m *AggregatorHandler) SetQuotaOverride(w http.ResponseWriter, r *http.Request) {
+	_m.Called(w, r)
+}
+
+// StarBoard provides a mock function with given fields: w, r
+func (_m *AggregatorHandler) StarBoard(w http.ResponseWriter, r *http.Request) {
+	_m.Called(w, r)
+}
+
+// StartTimer provides a mock function with given fields: w, r
+func (_m *AggregatorHandler) StartTimer(w http.ResponseWriter, r *http.Request) {
+	_m.Called(w, r)
+}
+
+// StopTimer provides a mock function with given fields: w, r
+func (_m *AggregatorHandler) StopTimer(w http.ResponseWriter, r *http.Request) {
+	_m.Called(w, r)
+}
+
+// TransferBoard provides a mock function with given fields: w, r
+func (_m *AggregatorHandler) TransferBoard(w http.ResponseWriter, r *http.Request) {
+	_m.Called(w, r)
+}
+
+// UnstarBoard provides a mock function with given fields: w, r
+func (_m *AggregatorHandler) UnstarBoard(w http.ResponseWriter, r *http.Request) {
+	_m.Called(w, r)
+}
+
 // UpdateBoard provides a mock function with given fields: w, r
 func (_m *AggregatorHandler) UpdateBoard(w http.ResponseWriter, r *http.Request) {
 	_m.Called(w, r)
@@ -98,16 +363,36 @@ func (_m *AggregatorHandler) UpdateCard(w http.ResponseWriter, r *http.Request)
 	_m.Called(w, r)
 }
 
+// UpdateCardTemplate provides a mock function with given fields: w, r
+func (_m *AggregatorHandler) UpdateCardTemplate(w http.ResponseWriter, r *http.Request) {
+	_m.Called(w, r)
+}
+
 // UpdateColumn provides a mock function with given fields: w, r
 func (_m *AggregatorHandler) UpdateColumn(w http.ResponseWriter, r *http.Request) {
 	_m.Called(w, r)
 }
 
+// UpdateSwimlane provides a mock function with given fields: w, r
+func (_m *AggregatorHandler) UpdateSwimlane(w http.ResponseWriter, r *http.Request) {
+	_m.Called(w, r)
+}
+
+// UpdateWorkspaceMember provides a mock function with given fields: w, r
+func (_m *AggregatorHandler) UpdateWorkspaceMember(w http.ResponseWriter, r *http.Request) {
+	_m.Called(w, r)
+}
+
 // Validate provides a mock function with given fields: w, r
 func (_m *AggregatorHandler) Validate(w http.ResponseWriter, r *http.Request) {
 	_m.Called(w, r)
 }
 
+// WatchBoard provides a mock function with given fields: w, r
+func (_m *AggregatorHandler) WatchBoard(w http.ResponseWriter, r *http.Request) {
+	_m.Called(w, r)
+}
+
 // NewAggregatorHandler creates a new instance of AggregatorHandler. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewAggregatorHandler(t interface {
@@ -7,45 +7,1609 @@ import (
 	entity "aggregator/internal/entity"
 	context "context"
 
+	io "io"
+
 	mock "github.com/stretchr/testify/mock"
 
-	time "time"
-)
+	time "time"
+
+	uuid "github.com/google/uuid"
+)
+
+// AggregatorUseCase is an autogenerated mock type for the AggregatorUseCase type
+type AggregatorUseCase struct {
+	mock.Mock
+}
+
+// AddBoardWatch provides a mock function with given fields: ctx, userID, boardID
+func (_m *AggregatorUseCase) AddBoardWatch(ctx context.Context, userID string, boardID string) error {
+	ret := _m.Called(ctx, userID, boardID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddBoardWatch")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, userID, boardID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AddCardWatch provides a mock function with given fields: ctx, userID, cardID
+func (_m *AggregatorUseCase) AddCardWatch(ctx context.Context, userID string, cardID string) error {
+	ret := _m.Called(ctx, userID, cardID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddCardWatch")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, userID, cardID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AddSprintCard provides a mock function with given fields: ctx, sprintID, req
+func (_m *AggregatorUseCase) AddSprintCard(ctx context.Context, sprintID string, req dto.AddSprintCardRequest) (*dto.SprintCard, error) {
+	ret := _m.Called(ctx, sprintID, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddSprintCard")
+	}
+
+	var r0 *dto.SprintCard
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, dto.AddSprintCardRequest) (*dto.SprintCard, error)); ok {
+		return rf(ctx, sprintID, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, dto.AddSprintCardRequest) *dto.SprintCard); ok {
+		r0 = rf(ctx, sprintID, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.SprintCard)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, dto.AddSprintCardRequest) error); ok {
+		r1 = rf(ctx, sprintID, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AddWorkspaceMember provides a mock function with given fields: ctx, workspaceID, req
+func (_m *AggregatorUseCase) AddWorkspaceMember(ctx context.Context, workspaceID string, req dto.WorkspaceMemberRequest) error {
+	ret := _m.Called(ctx, workspaceID, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddWorkspaceMember")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, dto.WorkspaceMemberRequest) error); ok {
+		r0 = rf(ctx, workspaceID, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// BulkCards provides a mock function with given fields: ctx, req
+func (_m *AggregatorUseCase) BulkCards(ctx context.Context, req dto.BulkCardsRequest) (*dto.BulkCardsResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BulkCards")
+	}
+
+	var r0 *dto.BulkCardsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dto.BulkCardsRequest) (*dto.BulkCardsResponse, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dto.BulkCardsRequest) *dto.BulkCardsResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.BulkCardsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dto.BulkCardsRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CloseSprint provides a mock function with given fields: ctx, sprintID, req
+func (_m *AggregatorUseCase) CloseSprint(ctx context.Context, sprintID string, req dto.CloseSprintRequest) (*dto.CloseSprintResponse, error) {
+	ret := _m.Called(ctx, sprintID, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CloseSprint")
+	}
+
+	var r0 *dto.CloseSprintResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, dto.CloseSprintRequest) (*dto.CloseSprintResponse, error)); ok {
+		return rf(ctx, sprintID, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, dto.CloseSprintRequest) *dto.CloseSprintResponse); ok {
+		r0 = rf(ctx, sprintID, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.CloseSprintResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, dto.CloseSprintRequest) error); ok {
+		r1 = rf(ctx, sprintID, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateBoard provides a mock function with given fields: ctx, board
+func (_m *AggregatorUseCase) CreateBoard(ctx context.Context, board dto.Board) error {
+	ret := _m.Called(ctx, board)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateBoard")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, dto.Board) error); ok {
+		r0 = rf(ctx, board)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CreateCard provides a mock function with given fields: ctx, card
+func (_m *AggregatorUseCase) CreateCard(ctx context.Context, card dto.Card) (*dto.Card, error) {
+	ret := _m.Called(ctx, card)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateCard")
+	}
+
+	var r0 *dto.Card
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dto.Card) (*dto.Card, error)); ok {
+		return rf(ctx, card)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dto.Card) *dto.Card); ok {
+		r0 = rf(ctx, card)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.Card)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dto.Card) error); ok {
+		r1 = rf(ctx, card)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateCardFromTemplate provides a mock function with given fields: ctx, templateID, card
+func (_m *AggregatorUseCase) CreateCardFromTemplate(ctx context.Context, templateID string, card dto.Card) (*dto.Card, error) {
+	ret := _m.Called(ctx, templateID, card)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateCardFromTemplate")
+	}
+
+	var r0 *dto.Card
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, dto.Card) (*dto.Card, error)); ok {
+		return rf(ctx, templateID, card)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, dto.Card) *dto.Card); ok {
+		r0 = rf(ctx, templateID, card)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.Card)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, dto.Card) error); ok {
+		r1 = rf(ctx, templateID, card)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateCardTemplate provides a mock function with given fields: ctx, req
+func (_m *AggregatorUseCase) CreateCardTemplate(ctx context.Context, req dto.CardTemplateRequest) (*dto.CardTemplate, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateCardTemplate")
+	}
+
+	var r0 *dto.CardTemplate
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dto.CardTemplateRequest) (*dto.CardTemplate, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dto.CardTemplateRequest) *dto.CardTemplate); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.CardTemplate)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dto.CardTemplateRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateColumn provides a mock function with given fields: ctx, column
+func (_m *AggregatorUseCase) CreateColumn(ctx context.Context, column dto.Column) error {
+	ret := _m.Called(ctx, column)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateColumn")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, dto.Column) error); ok {
+		r0 = rf(ctx, column)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CreateSprint provides a mock function with given fields: ctx, req
+func (_m *AggregatorUseCase) CreateSprint(ctx context.Context, req dto.CreateSprintRequest) (*dto.Sprint, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateSprint")
+	}
+
+	var r0 *dto.Sprint
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dto.CreateSprintRequest) (*dto.Sprint, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dto.CreateSprintRequest) *dto.Sprint); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.Sprint)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dto.CreateSprintRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateSwimlane provides a mock function with given fields: ctx, swimlane
+func (_m *AggregatorUseCase) CreateSwimlane(ctx context.Context, swimlane dto.Swimlane) error {
+	ret := _m.Called(ctx, swimlane)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateSwimlane")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, dto.Swimlane) error); ok {
+		r0 = rf(ctx, swimlane)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CreateWorkspace provides a mock function with given fields: ctx, req
+func (_m *AggregatorUseCase) CreateWorkspace(ctx context.Context, req dto.CreateWorkspaceRequest) (*dto.Workspace, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateWorkspace")
+	}
+
+	var r0 *dto.Workspace
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dto.CreateWorkspaceRequest) (*dto.Workspace, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dto.CreateWorkspaceRequest) *dto.Workspace); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.Workspace)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dto.CreateWorkspaceRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteBoard provides a mock function with given fields: ctx, id, version
+func (_m *AggregatorUseCase) DeleteBoard(ctx context.Context, id string, version int) error {
+	ret := _m.Called(ctx, id, version)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteBoard")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int) error); ok {
+		r0 = rf(ctx, id, version)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteCard provides a mock function with given fields: ctx, id, version
+func (_m *AggregatorUseCase) DeleteCard(ctx context.Context, id string, version int) error {
+	ret := _m.Called(ctx, id, version)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteCard")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int) error); ok {
+		r0 = rf(ctx, id, version)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteCardTemplate provides a mock function with given fields: ctx, id
+func (_m *AggregatorUseCase) DeleteCardTemplate(ctx context.Context, id string) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteCardTemplate")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteColumn provides a mock function with given fields: ctx, id, version
+func (_m *AggregatorUseCase) DeleteColumn(ctx context.Context, id string, version int) error {
+	ret := _m.Called(ctx, id, version)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteColumn")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int) error); ok {
+		r0 = rf(ctx, id, version)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteQuotaOverride provides a mock function with given fields: ctx, userID
+func (_m *AggregatorUseCase) DeleteQuotaOverride(ctx context.Context, userID string) error {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteQuotaOverride")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteSwimlane provides a mock function with given fields: ctx, id, version
+func (_m *AggregatorUseCase) DeleteSwimlane(ctx context.Context, id string, version int) error {
+	ret := _m.Called(ctx, id, version)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteSwimlane")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int) error); ok {
+		r0 = rf(ctx, id, version)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteUser provides a mock function with given fields: ctx, userID, transferTo
+func (_m *AggregatorUseCase) DeleteUser(ctx context.Context, userID string, transferTo string) error {
+	ret := _m.Called(ctx, userID, transferTo)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteUser")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, userID, transferTo)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetBoard provides a mock function with given fields: ctx, id
+func (_m *AggregatorUseCase) GetBoard(ctx context.Context, id string) (*dto.Board, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBoard")
+	}
+
+	var r0 *dto.Board
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*dto.Board, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *dto.Board); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.Board)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetBoardAnalytics provides a mock function with given fields: ctx, boardID, from, to
+func (_m *AggregatorUseCase) GetBoardAnalytics(ctx context.Context, boardID string, from string, to string) (*dto.BoardAnalytics, error) {
+	ret := _m.Called(ctx, boardID, from, to)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBoardAnalytics")
+	}
+
+	var r0 *dto.BoardAnalytics
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) (*dto.BoardAnalytics, error)); ok {
+		return rf(ctx, boardID, from, to)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) *dto.BoardAnalytics); ok {
+		r0 = rf(ctx, boardID, from, to)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.BoardAnalytics)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string) error); ok {
+		r1 = rf(ctx, boardID, from, to)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetBoardCardTemplates provides a mock function with given fields: ctx, boardID
+func (_m *AggregatorUseCase) GetBoardCardTemplates(ctx context.Context, boardID string) ([]dto.CardTemplate, error) {
+	ret := _m.Called(ctx, boardID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBoardCardTemplates")
+	}
+
+	var r0 []dto.CardTemplate
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]dto.CardTemplate, error)); ok {
+		return rf(ctx, boardID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []dto.CardTemplate); ok {
+		r0 = rf(ctx, boardID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dto.CardTemplate)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, boardID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetBoardSnapshot provides a mock function with given fields: ctx, userID, id
+func (_m *AggregatorUseCase) GetBoardSnapshot(ctx context.Context, userID string, id string) (*dto.BoardSnapshot, error) {
+	ret := _m.Called(ctx, userID, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBoardSnapshot")
+	}
+
+	var r0 *dto.BoardSnapshot
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*dto.BoardSnapshot, error)); ok {
+		return rf(ctx, userID, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *dto.BoardSnapshot); ok {
+		r0 = rf(ctx, userID, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.BoardSnapshot)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, userID, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetBoardSprints provides a mock function with given fields: ctx, boardID
+func (_m *AggregatorUseCase) GetBoardSprints(ctx context.Context, boardID string) ([]dto.Sprint, error) {
+	ret := _m.Called(ctx, boardID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBoardSprints")
+	}
+
+	var r0 []dto.Sprint
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]dto.Sprint, error)); ok {
+		return rf(ctx, boardID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []dto.Sprint); ok {
+		r0 = rf(ctx, boardID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dto.Sprint)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, boardID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetBoardStats provides a mock function with given fields: ctx, userID, boardID, from, to, staleDays
+func (_m *AggregatorUseCase) GetBoardStats(ctx context.Context, userID string, boardID string, from string, to string, staleDays string) (*dto.BoardStats, error) {
+	ret := _m.Called(ctx, userID, boardID, from, to, staleDays)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBoardStats")
+	}
+
+	var r0 *dto.BoardStats
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string, string) (*dto.BoardStats, error)); ok {
+		return rf(ctx, userID, boardID, from, to, staleDays)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string, string) *dto.BoardStats); ok {
+		r0 = rf(ctx, userID, boardID, from, to, staleDays)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.BoardStats)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string, string, string) error); ok {
+		r1 = rf(ctx, userID, boardID, from, to, staleDays)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetBoards provides a mock function with given fields: ctx, userID
+func (_m *AggregatorUseCase) GetBoards(ctx context.Context, userID string) ([]dto.Board, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBoards")
+	}
+
+	var r0 []dto.Board
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]dto.Board, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []dto.Board); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dto.Board)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetCalendarFeed provides a mock function with given fields: ctx, token, kind
+func (_m *AggregatorUseCase) GetCalendarFeed(ctx context.Context, token string, kind string) ([]byte, error) {
+	ret := _m.Called(ctx, token, kind)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCalendarFeed")
+	}
+
+	var r0 []byte
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) ([]byte, error)); ok {
+		return rf(ctx, token, kind)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) []byte); ok {
+		r0 = rf(ctx, token, kind)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, token, kind)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetCard provides a mock function with given fields: ctx, id
+func (_m *AggregatorUseCase) GetCard(ctx context.Context, id string) (*dto.Card, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCard")
+	}
+
+	var r0 *dto.Card
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*dto.Card, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *dto.Card); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.Card)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetCardAncestors provides a mock function with given fields: ctx, id
+func (_m *AggregatorUseCase) GetCardAncestors(ctx context.Context, id string) ([]dto.Card, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCardAncestors")
+	}
+
+	var r0 []dto.Card
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]dto.Card, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []dto.Card); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dto.Card)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetCardTemplate provides a mock function with given fields: ctx, id
+func (_m *AggregatorUseCase) GetCardTemplate(ctx context.Context, id string) (*dto.CardTemplate, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCardTemplate")
+	}
+
+	var r0 *dto.CardTemplate
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*dto.CardTemplate, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *dto.CardTemplate); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.CardTemplate)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetCardTimeEntries provides a mock function with given fields: ctx, cardID
+func (_m *AggregatorUseCase) GetCardTimeEntries(ctx context.Context, cardID string) ([]dto.TimeEntry, error) {
+	ret := _m.Called(ctx, cardID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCardTimeEntries")
+	}
+
+	var r0 []dto.TimeEntry
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]dto.TimeEntry, error)); ok {
+		return rf(ctx, cardID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []dto.TimeEntry); ok {
+		r0 = rf(ctx, cardID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dto.TimeEntry)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, cardID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetCards provides a mock function with given fields: ctx, query
+func (_m *AggregatorUseCase) GetCards(ctx context.Context, query dto.CardQuery) ([]dto.Card, error) {
+	ret := _m.Called(ctx, query)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCards")
+	}
+
+	var r0 []dto.Card
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dto.CardQuery) ([]dto.Card, error)); ok {
+		return rf(ctx, query)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dto.CardQuery) []dto.Card); ok {
+		r0 = rf(ctx, query)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dto.Card)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dto.CardQuery) error); ok {
+		r1 = rf(ctx, query)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetColumn provides a mock function with given fields: ctx, id
+func (_m *AggregatorUseCase) GetColumn(ctx context.Context, id string) (*dto.Column, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetColumn")
+	}
+
+	var r0 *dto.Column
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*dto.Column, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *dto.Column); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.Column)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetColumns provides a mock function with given fields: ctx, boardID
+func (_m *AggregatorUseCase) GetColumns(ctx context.Context, boardID string) ([]dto.Column, error) {
+	ret := _m.Called(ctx, boardID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetColumns")
+	}
+
+	var r0 []dto.Column
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]dto.Column, error)); ok {
+		return rf(ctx, boardID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []dto.Column); ok {
+		r0 = rf(ctx, boardID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dto.Column)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, boardID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetMarkedBoards provides a mock function with given fields: ctx, userID
+func (_m *AggregatorUseCase) GetMarkedBoards(ctx context.Context, userID string) ([]dto.MarkedBoard, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMarkedBoards")
+	}
+
+	var r0 []dto.MarkedBoard
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]dto.MarkedBoard, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []dto.MarkedBoard); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dto.MarkedBoard)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetNotifications provides a mock function with given fields: ctx, userID, unreadOnly, limit
+func (_m *AggregatorUseCase) GetNotifications(ctx context.Context, userID string, unreadOnly bool, limit int) ([]dto.Notification, error) {
+	ret := _m.Called(ctx, userID, unreadOnly, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetNotifications")
+	}
+
+	var r0 []dto.Notification
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, bool, int) ([]dto.Notification, error)); ok {
+		return rf(ctx, userID, unreadOnly, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, bool, int) []dto.Notification); ok {
+		r0 = rf(ctx, userID, unreadOnly, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dto.Notification)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, bool, int) error); ok {
+		r1 = rf(ctx, userID, unreadOnly, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetRecentBoards provides a mock function with given fields: ctx, userID
+func (_m *AggregatorUseCase) GetRecentBoards(ctx context.Context, userID string) ([]dto.MarkedBoard, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRecentBoards")
+	}
+
+	var r0 []dto.MarkedBoard
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]dto.MarkedBoard, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []dto.MarkedBoard); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dto.MarkedBoard)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetSprint provides a mock function with given fields: ctx, id
+func (_m *AggregatorUseCase) GetSprint(ctx context.Context, id string) (*dto.Sprint, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSprint")
+	}
+
+	var r0 *dto.Sprint
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*dto.Sprint, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *dto.Sprint); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.Sprint)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetSprintBurndown provides a mock function with given fields: ctx, sprintID
+func (_m *AggregatorUseCase) GetSprintBurndown(ctx context.Context, sprintID string) ([]dto.BurndownPoint, error) {
+	ret := _m.Called(ctx, sprintID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSprintBurndown")
+	}
+
+	var r0 []dto.BurndownPoint
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]dto.BurndownPoint, error)); ok {
+		return rf(ctx, sprintID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []dto.BurndownPoint); ok {
+		r0 = rf(ctx, sprintID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dto.BurndownPoint)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, sprintID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetSprintCards provides a mock function with given fields: ctx, sprintID
+func (_m *AggregatorUseCase) GetSprintCards(ctx context.Context, sprintID string) ([]dto.SprintCard, error) {
+	ret := _m.Called(ctx, sprintID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSprintCards")
+	}
+
+	var r0 []dto.SprintCard
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]dto.SprintCard, error)); ok {
+		return rf(ctx, sprintID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []dto.SprintCard); ok {
+		r0 = rf(ctx, sprintID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dto.SprintCard)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, sprintID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetStats provides a mock function with given fields: ctx, from, to
+func (_m *AggregatorUseCase) GetStats(ctx context.Context, from time.Time, to time.Time) ([]entity.NewUsersAndCardsStats, error) {
+	ret := _m.Called(ctx, from, to)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetStats")
+	}
+
+	var r0 []entity.NewUsersAndCardsStats
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, time.Time) ([]entity.NewUsersAndCardsStats, error)); ok {
+		return rf(ctx, from, to)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, time.Time) []entity.NewUsersAndCardsStats); ok {
+		r0 = rf(ctx, from, to)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.NewUsersAndCardsStats)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time, time.Time) error); ok {
+		r1 = rf(ctx, from, to)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetSwimlane provides a mock function with given fields: ctx, id
+func (_m *AggregatorUseCase) GetSwimlane(ctx context.Context, id string) (*dto.Swimlane, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSwimlane")
+	}
+
+	var r0 *dto.Swimlane
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*dto.Swimlane, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *dto.Swimlane); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.Swimlane)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetSwimlanes provides a mock function with given fields: ctx, boardID
+func (_m *AggregatorUseCase) GetSwimlanes(ctx context.Context, boardID string) ([]dto.Swimlane, error) {
+	ret := _m.Called(ctx, boardID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSwimlanes")
+	}
+
+	var r0 []dto.Swimlane
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]dto.Swimlane, error)); ok {
+		return rf(ctx, boardID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []dto.Swimlane); ok {
+		r0 = rf(ctx, boardID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dto.Swimlane)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, boardID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetTimeReport provides a mock function with given fields: ctx, query
+func (_m *AggregatorUseCase) GetTimeReport(ctx context.Context, query dto.TimeReportQuery) ([]dto.TimeReportRow, error) {
+	ret := _m.Called(ctx, query)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTimeReport")
+	}
+
+	var r0 []dto.TimeReportRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dto.TimeReportQuery) ([]dto.TimeReportRow, error)); ok {
+		return rf(ctx, query)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dto.TimeReportQuery) []dto.TimeReportRow); ok {
+		r0 = rf(ctx, query)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dto.TimeReportRow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dto.TimeReportQuery) error); ok {
+		r1 = rf(ctx, query)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetUserQuota provides a mock function with given fields: ctx, userID
+func (_m *AggregatorUseCase) GetUserQuota(ctx context.Context, userID string) (*dto.UserQuota, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserQuota")
+	}
+
+	var r0 *dto.UserQuota
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*dto.UserQuota, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *dto.UserQuota); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.UserQuota)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetWorkspaceBoards provides a mock function with given fields: ctx, userID, workspaceID
+func (_m *AggregatorUseCase) GetWorkspaceBoards(ctx context.Context, userID string, workspaceID string) ([]dto.Board, error) {
+	ret := _m.Called(ctx, userID, workspaceID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetWorkspaceBoards")
+	}
+
+	var r0 []dto.Board
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) ([]dto.Board, error)); ok {
+		return rf(ctx, userID, workspaceID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) []dto.Board); ok {
+		r0 = rf(ctx, userID, workspaceID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dto.Board)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, userID, workspaceID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetWorkspaceMembers provides a mock function with given fields: ctx, userID, workspaceID
+func (_m *AggregatorUseCase) GetWorkspaceMembers(ctx context.Context, userID string, workspaceID string) ([]dto.WorkspaceMember, error) {
+	ret := _m.Called(ctx, userID, workspaceID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetWorkspaceMembers")
+	}
+
+	var r0 []dto.WorkspaceMember
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) ([]dto.WorkspaceMember, error)); ok {
+		return rf(ctx, userID, workspaceID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) []dto.WorkspaceMember); ok {
+		r0 = rf(ctx, userID, workspaceID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dto.WorkspaceMember)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, userID, workspaceID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetWorkspaces provides a mock function with given fields: ctx, userID
+func (_m *AggregatorUseCase) GetWorkspaces(ctx context.Context, userID string) ([]dto.WorkspaceMembership, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetWorkspaces")
+	}
+
+	var r0 []dto.WorkspaceMembership
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]dto.WorkspaceMembership, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []dto.WorkspaceMembership); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dto.WorkspaceMembership)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LogTime provides a mock function with given fields: ctx, req
+func (_m *AggregatorUseCase) LogTime(ctx context.Context, req dto.LogTimeRequest) (*dto.TimeEntry, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LogTime")
+	}
+
+	var r0 *dto.TimeEntry
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dto.LogTimeRequest) (*dto.TimeEntry, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dto.LogTimeRequest) *dto.TimeEntry); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.TimeEntry)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dto.LogTimeRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Login provides a mock function with given fields: ctx, email, password
+func (_m *AggregatorUseCase) Login(ctx context.Context, email string, password string) (*dto.Tokens, error) {
+	ret := _m.Called(ctx, email, password)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Login")
+	}
+
+	var r0 *dto.Tokens
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*dto.Tokens, error)); ok {
+		return rf(ctx, email, password)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *dto.Tokens); ok {
+		r0 = rf(ctx, email, password)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.Tokens)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, email, password)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Logout provides a mock function with given fields: ctx, refreshToken
+func (_m *AggregatorUseCase) Logout(ctx context.Context, refreshToken string) error {
+	ret := _m.Called(ctx, refreshToken)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Logout")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, refreshToken)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MarkNotificationsRead provides a mock function with given fields: ctx, userID, ids
+func (_m *AggregatorUseCase) MarkNotificationsRead(ctx context.Context, userID string, ids []uuid.UUID) error {
+	ret := _m.Called(ctx, userID, ids)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkNotificationsRead")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, []uuid.UUID) error); ok {
+		r0 = rf(ctx, userID, ids)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MergeBoards provides a mock function with given fields: ctx, req
+func (_m *AggregatorUseCase) MergeBoards(ctx context.Context, req dto.MergeBoardsRequest) (*dto.BoardMerge, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MergeBoards")
+	}
+
+	var r0 *dto.BoardMerge
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dto.MergeBoardsRequest) (*dto.BoardMerge, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dto.MergeBoardsRequest) *dto.BoardMerge); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.BoardMerge)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dto.MergeBoardsRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MoveColumn provides a mock function with given fields: ctx, column
+func (_m *AggregatorUseCase) MoveColumn(ctx context.Context, column *dto.Column) error {
+	ret := _m.Called(ctx, column)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MoveColumn")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *dto.Column) error); ok {
+		r0 = rf(ctx, column)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Refresh provides a mock function with given fields: ctx, refreshToken
+func (_m *AggregatorUseCase) Refresh(ctx context.Context, refreshToken string) (*dto.RefreshResponse, error) {
+	ret := _m.Called(ctx, refreshToken)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Refresh")
+	}
+
+	var r0 *dto.RefreshResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*dto.RefreshResponse, error)); ok {
+		return rf(ctx, refreshToken)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *dto.RefreshResponse); ok {
+		r0 = rf(ctx, refreshToken)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.RefreshResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, refreshToken)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RegenerateCalendarToken provides a mock function with given fields: ctx, userID
+func (_m *AggregatorUseCase) RegenerateCalendarToken(ctx context.Context, userID string) (*dto.CalendarToken, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RegenerateCalendarToken")
+	}
+
+	var r0 *dto.CalendarToken
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*dto.CalendarToken, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *dto.CalendarToken); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.CalendarToken)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
 
-// AggregatorUseCase is an autogenerated mock type for the AggregatorUseCase type
-type AggregatorUseCase struct {
-	mock.Mock
+	return r0, r1
 }
 
-// CreateBoard provides a mock function with given fields: ctx, board
-func (_m *AggregatorUseCase) CreateBoard(ctx context.Context, board dto.Board) error {
-	ret := _m.Called(ctx, board)
+// Register provides a mock function with given fields: ctx, username, email, password
+func (_m *AggregatorUseCase) Register(ctx context.Context, username string, email string, password string) (*dto.Tokens, error) {
+	ret := _m.Called(ctx, username, email, password)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreateBoard")
+		panic("no return value specified for Register")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, dto.Board) error); ok {
-		r0 = rf(ctx, board)
+	var r0 *dto.Tokens
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) (*dto.Tokens, error)); ok {
+		return rf(ctx, username, email, password)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) *dto.Tokens); ok {
+		r0 = rf(ctx, username, email, password)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.Tokens)
+		}
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string) error); ok {
+		r1 = rf(ctx, username, email, password)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// CreateCard provides a mock function with given fields: ctx, card
-func (_m *AggregatorUseCase) CreateCard(ctx context.Context, card dto.Card) error {
-	ret := _m.Called(ctx, card)
+// RemoveBoardWatch provides a mock function with given fields: ctx, userID, boardID
+func (_m *AggregatorUseCase) RemoveBoardWatch(ctx context.Context, userID string, boardID string) error {
+	ret := _m.Called(ctx, userID, boardID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreateCard")
+		panic("no return value specified for RemoveBoardWatch")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, dto.Card) error); ok {
-		r0 = rf(ctx, card)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, userID, boardID)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -53,17 +1617,17 @@ func (_m *AggregatorUseCase) CreateCard(ctx context.Context, card dto.Card) erro
 	return r0
 }
 
-// CreateColumn provides a mock function with given fields: ctx, column
-func (_m *AggregatorUseCase) CreateColumn(ctx context.Context, column dto.Column) error {
-	ret := _m.Called(ctx, column)
+// RemoveCardWatch provides a mock function with given fields: ctx, userID, cardID
+func (_m *AggregatorUseCase) RemoveCardWatch(ctx context.Context, userID string, cardID string) error {
+	ret := _m.Called(ctx, userID, cardID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreateColumn")
+		panic("no return value specified for RemoveCardWatch")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, dto.Column) error); ok {
-		r0 = rf(ctx, column)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, userID, cardID)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -71,17 +1635,17 @@ func (_m *AggregatorUseCase) CreateColumn(ctx context.Context, column dto.Column
 	return r0
 }
 
-// DeleteBoard provides a mock function with given fields: ctx, id
-func (_m *AggregatorUseCase) DeleteBoard(ctx context.Context, id string) error {
-	ret := _m.Called(ctx, id)
+// RemoveSprintCard provides a mock function with given fields: ctx, sprintID, cardID
+func (_m *AggregatorUseCase) RemoveSprintCard(ctx context.Context, sprintID string, cardID string) error {
+	ret := _m.Called(ctx, sprintID, cardID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for DeleteBoard")
+		panic("no return value specified for RemoveSprintCard")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
-		r0 = rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, sprintID, cardID)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -89,17 +1653,17 @@ func (_m *AggregatorUseCase) DeleteBoard(ctx context.Context, id string) error {
 	return r0
 }
 
-// DeleteCard provides a mock function with given fields: ctx, id
-func (_m *AggregatorUseCase) DeleteCard(ctx context.Context, id string) error {
-	ret := _m.Called(ctx, id)
+// RemoveWorkspaceMember provides a mock function with given fields: ctx, actorID, workspaceID, userID
+func (_m *AggregatorUseCase) RemoveWorkspaceMember(ctx context.Context, actorID string, workspaceID string, userID string) error {
+	ret := _m.Called(ctx, actorID, workspaceID, userID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for DeleteCard")
+		panic("no return value specified for RemoveWorkspaceMember")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
-		r0 = rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) error); ok {
+		r0 = rf(ctx, actorID, workspaceID, userID)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -107,17 +1671,17 @@ func (_m *AggregatorUseCase) DeleteCard(ctx context.Context, id string) error {
 	return r0
 }
 
-// DeleteColumn provides a mock function with given fields: ctx, id
-func (_m *AggregatorUseCase) DeleteColumn(ctx context.Context, id string) error {
-	ret := _m.Called(ctx, id)
+// SetCardParent provides a mock function with given fields: ctx, card
+func (_m *AggregatorUseCase) SetCardParent(ctx context.Context, card *dto.Card) error {
+	ret := _m.Called(ctx, card)
 
 	if len(ret) == 0 {
-		panic("no return value specified for DeleteColumn")
+		panic("no return value specified for SetCardParent")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
-		r0 = rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, *dto.Card) error); ok {
+		r0 = rf(ctx, card)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -125,29 +1689,29 @@ func (_m *AggregatorUseCase) DeleteColumn(ctx context.Context, id string) error
 	return r0
 }
 
-// GetBoards provides a mock function with given fields: ctx, userID
-func (_m *AggregatorUseCase) GetBoards(ctx context.Context, userID string) ([]dto.Board, error) {
-	ret := _m.Called(ctx, userID)
+// SetQuotaOverride provides a mock function with given fields: ctx, userID, req
+func (_m *AggregatorUseCase) SetQuotaOverride(ctx context.Context, userID string, req dto.QuotaOverrideRequest) (*dto.UserQuota, error) {
+	ret := _m.Called(ctx, userID, req)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetBoards")
+		panic("no return value specified for SetQuotaOverride")
 	}
 
-	var r0 []dto.Board
+	var r0 *dto.UserQuota
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) ([]dto.Board, error)); ok {
-		return rf(ctx, userID)
+	if rf, ok := ret.Get(0).(func(context.Context, string, dto.QuotaOverrideRequest) (*dto.UserQuota, error)); ok {
+		return rf(ctx, userID, req)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string) []dto.Board); ok {
-		r0 = rf(ctx, userID)
+	if rf, ok := ret.Get(0).(func(context.Context, string, dto.QuotaOverrideRequest) *dto.UserQuota); ok {
+		r0 = rf(ctx, userID, req)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]dto.Board)
+			r0 = ret.Get(0).(*dto.UserQuota)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(ctx, userID)
+	if rf, ok := ret.Get(1).(func(context.Context, string, dto.QuotaOverrideRequest) error); ok {
+		r1 = rf(ctx, userID, req)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -155,59 +1719,47 @@ func (_m *AggregatorUseCase) GetBoards(ctx context.Context, userID string) ([]dt
 	return r0, r1
 }
 
-// GetCard provides a mock function with given fields: ctx, id
-func (_m *AggregatorUseCase) GetCard(ctx context.Context, id string) (*dto.Card, error) {
-	ret := _m.Called(ctx, id)
+// StarBoard provides a mock function with given fields: ctx, userID, boardID
+func (_m *AggregatorUseCase) StarBoard(ctx context.Context, userID string, boardID string) error {
+	ret := _m.Called(ctx, userID, boardID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetCard")
-	}
-
-	var r0 *dto.Card
-	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) (*dto.Card, error)); ok {
-		return rf(ctx, id)
-	}
-	if rf, ok := ret.Get(0).(func(context.Context, string) *dto.Card); ok {
-		r0 = rf(ctx, id)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*dto.Card)
-		}
+		panic("no return value specified for StarBoard")
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(ctx, id)
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, userID, boardID)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
 
-	return r0, r1
+	return r0
 }
 
-// GetCards provides a mock function with given fields: ctx, columnID
-func (_m *AggregatorUseCase) GetCards(ctx context.Context, columnID string) ([]dto.Card, error) {
-	ret := _m.Called(ctx, columnID)
+// StartTimer provides a mock function with given fields: ctx, req
+func (_m *AggregatorUseCase) StartTimer(ctx context.Context, req dto.StartTimerRequest) (*dto.TimeEntry, error) {
+	ret := _m.Called(ctx, req)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetCards")
+		panic("no return value specified for StartTimer")
 	}
 
-	var r0 []dto.Card
+	var r0 *dto.TimeEntry
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) ([]dto.Card, error)); ok {
-		return rf(ctx, columnID)
+	if rf, ok := ret.Get(0).(func(context.Context, dto.StartTimerRequest) (*dto.TimeEntry, error)); ok {
+		return rf(ctx, req)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string) []dto.Card); ok {
-		r0 = rf(ctx, columnID)
+	if rf, ok := ret.Get(0).(func(context.Context, dto.StartTimerRequest) *dto.TimeEntry); ok {
+		r0 = rf(ctx, req)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]dto.Card)
+			r0 = ret.Get(0).(*dto.TimeEntry)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(ctx, columnID)
+	if rf, ok := ret.Get(1).(func(context.Context, dto.StartTimerRequest) error); ok {
+		r1 = rf(ctx, req)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -215,29 +1767,29 @@ func (_m *AggregatorUseCase) GetCards(ctx context.Context, columnID string) ([]d
 	return r0, r1
 }
 
-// GetColumns provides a mock function with given fields: ctx, boardID
-func (_m *AggregatorUseCase) GetColumns(ctx context.Context, boardID string) ([]dto.Column, error) {
-	ret := _m.Called(ctx, boardID)
+// StopTimer provides a mock function with given fields: ctx, req
+func (_m *AggregatorUseCase) StopTimer(ctx context.Context, req dto.StopTimerRequest) (*dto.TimeEntry, error) {
+	ret := _m.Called(ctx, req)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetColumns")
+		panic("no return value specified for StopTimer")
 	}
 
-	var r0 []dto.Column
+	var r0 *dto.TimeEntry
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) ([]dto.Column, error)); ok {
-		return rf(ctx, boardID)
+	if rf, ok := ret.Get(0).(func(context.Context, dto.StopTimerRequest) (*dto.TimeEntry, error)); ok {
+		return rf(ctx, req)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string) []dto.Column); ok {
-		r0 = rf(ctx, boardID)
+	if rf, ok := ret.Get(0).(func(context.Context, dto.StopTimerRequest) *dto.TimeEntry); ok {
+		r0 = rf(ctx, req)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]dto.Column)
+			r0 = ret.Get(0).(*dto.TimeEntry)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(ctx, boardID)
+	if rf, ok := ret.Get(1).(func(context.Context, dto.StopTimerRequest) error); ok {
+		r1 = rf(ctx, req)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -245,29 +1797,29 @@ func (_m *AggregatorUseCase) GetColumns(ctx context.Context, boardID string) ([]
 	return r0, r1
 }
 
-// GetStats provides a mock function with given fields: ctx, from, to
-func (_m *AggregatorUseCase) GetStats(ctx context.Context, from time.Time, to time.Time) ([]entity.NewUsersAndCardsStats, error) {
-	ret := _m.Called(ctx, from, to)
+// TransferBoard provides a mock function with given fields: ctx, boardID, toUserID
+func (_m *AggregatorUseCase) TransferBoard(ctx context.Context, boardID string, toUserID string) (*dto.Board, error) {
+	ret := _m.Called(ctx, boardID, toUserID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetStats")
+		panic("no return value specified for TransferBoard")
 	}
 
-	var r0 []entity.NewUsersAndCardsStats
+	var r0 *dto.Board
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, time.Time, time.Time) ([]entity.NewUsersAndCardsStats, error)); ok {
-		return rf(ctx, from, to)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*dto.Board, error)); ok {
+		return rf(ctx, boardID, toUserID)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, time.Time, time.Time) []entity.NewUsersAndCardsStats); ok {
-		r0 = rf(ctx, from, to)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *dto.Board); ok {
+		r0 = rf(ctx, boardID, toUserID)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]entity.NewUsersAndCardsStats)
+			r0 = ret.Get(0).(*dto.Board)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, time.Time, time.Time) error); ok {
-		r1 = rf(ctx, from, to)
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, boardID, toUserID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -275,47 +1827,35 @@ func (_m *AggregatorUseCase) GetStats(ctx context.Context, from time.Time, to ti
 	return r0, r1
 }
 
-// Login provides a mock function with given fields: ctx, email, password
-func (_m *AggregatorUseCase) Login(ctx context.Context, email string, password string) (*dto.Tokens, error) {
-	ret := _m.Called(ctx, email, password)
+// UnstarBoard provides a mock function with given fields: ctx, userID, boardID
+func (_m *AggregatorUseCase) UnstarBoard(ctx context.Context, userID string, boardID string) error {
+	ret := _m.Called(ctx, userID, boardID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Login")
-	}
-
-	var r0 *dto.Tokens
-	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*dto.Tokens, error)); ok {
-		return rf(ctx, email, password)
-	}
-	if rf, ok := ret.Get(0).(func(context.Context, string, string) *dto.Tokens); ok {
-		r0 = rf(ctx, email, password)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*dto.Tokens)
-		}
+		panic("no return value specified for UnstarBoard")
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
-		r1 = rf(ctx, email, password)
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, userID, boardID)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
 
-	return r0, r1
+	return r0
 }
 
-// Logout provides a mock function with given fields: ctx, refreshToken
-func (_m *AggregatorUseCase) Logout(ctx context.Context, refreshToken string) error {
-	ret := _m.Called(ctx, refreshToken)
+// UpdateBoard provides a mock function with given fields: ctx, board
+func (_m *AggregatorUseCase) UpdateBoard(ctx context.Context, board *dto.Board) error {
+	ret := _m.Called(ctx, board)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Logout")
+		panic("no return value specified for UpdateBoard")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
-		r0 = rf(ctx, refreshToken)
+	if rf, ok := ret.Get(0).(func(context.Context, *dto.Board) error); ok {
+		r0 = rf(ctx, board)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -323,59 +1863,47 @@ func (_m *AggregatorUseCase) Logout(ctx context.Context, refreshToken string) er
 	return r0
 }
 
-// Refresh provides a mock function with given fields: ctx, refreshToken
-func (_m *AggregatorUseCase) Refresh(ctx context.Context, refreshToken string) (*dto.RefreshResponse, error) {
-	ret := _m.Called(ctx, refreshToken)
+// UpdateCard provides a mock function with given fields: ctx, card
+func (_m *AggregatorUseCase) UpdateCard(ctx context.Context, card *dto.Card) error {
+	ret := _m.Called(ctx, card)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Refresh")
-	}
-
-	var r0 *dto.RefreshResponse
-	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) (*dto.RefreshResponse, error)); ok {
-		return rf(ctx, refreshToken)
-	}
-	if rf, ok := ret.Get(0).(func(context.Context, string) *dto.RefreshResponse); ok {
-		r0 = rf(ctx, refreshToken)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*dto.RefreshResponse)
-		}
+		panic("no return value specified for UpdateCard")
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(ctx, refreshToken)
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *dto.Card) error); ok {
+		r0 = rf(ctx, card)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
 
-	return r0, r1
+	return r0
 }
 
-// Register provides a mock function with given fields: ctx, username, email, password
-func (_m *AggregatorUseCase) Register(ctx context.Context, username string, email string, password string) (*dto.Tokens, error) {
-	ret := _m.Called(ctx, username, email, password)
+// UpdateCardTemplate provides a mock function with given fields: ctx, id, req
+func (_m *AggregatorUseCase) UpdateCardTemplate(ctx context.Context, id string, req dto.CardTemplateRequest) (*dto.CardTemplate, error) {
+	ret := _m.Called(ctx, id, req)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Register")
+		panic("no return value specified for UpdateCardTemplate")
 	}
 
-	var r0 *dto.Tokens
+	var r0 *dto.CardTemplate
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) (*dto.Tokens, error)); ok {
-		return rf(ctx, username, email, password)
+	if rf, ok := ret.Get(0).(func(context.Context, string, dto.CardTemplateRequest) (*dto.CardTemplate, error)); ok {
+		return rf(ctx, id, req)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) *dto.Tokens); ok {
-		r0 = rf(ctx, username, email, password)
+	if rf, ok := ret.Get(0).(func(context.Context, string, dto.CardTemplateRequest) *dto.CardTemplate); ok {
+		r0 = rf(ctx, id, req)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*dto.Tokens)
+			r0 = ret.Get(0).(*dto.CardTemplate)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string, string, string) error); ok {
-		r1 = rf(ctx, username, email, password)
+	if rf, ok := ret.Get(1).(func(context.Context, string, dto.CardTemplateRequest) error); ok {
+		r1 = rf(ctx, id, req)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -383,17 +1911,17 @@ func (_m *AggregatorUseCase) Register(ctx context.Context, username string, emai
 	return r0, r1
 }
 
-// UpdateBoard provides a mock function with given fields: ctx, board
-func (_m *AggregatorUseCase) UpdateBoard(ctx context.Context, board *dto.Board) error {
-	ret := _m.Called(ctx, board)
+// UpdateColumn provides a mock function with given fields: ctx, column
+func (_m *AggregatorUseCase) UpdateColumn(ctx context.Context, column *dto.Column) error {
+	ret := _m.Called(ctx, column)
 
 	if len(ret) == 0 {
-		panic("no return value specified for UpdateBoard")
+		panic("no return value specified for UpdateColumn")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, *dto.Board) error); ok {
-		r0 = rf(ctx, board)
+	if rf, ok := ret.Get(0).(func(context.Context, *dto.Column) error); ok {
+		r0 = rf(ctx, column)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -401,17 +1929,17 @@ func (_m *AggregatorUseCase) UpdateBoard(ctx context.Context, board *dto.Board)
 	return r0
 }
 
-// UpdateCard provides a mock function with given fields: ctx, card
-func (_m *AggregatorUseCase) UpdateCard(ctx context.Context, card *dto.Card) error {
-	ret := _m.Called(ctx, card)
+// UpdateSwimlane provides a mock function with given fields: ctx, swimlane
+func (_m *AggregatorUseCase) UpdateSwimlane(ctx context.Context, swimlane *dto.Swimlane) error {
+	ret := _m.Called(ctx, swimlane)
 
 	if len(ret) == 0 {
-		panic("no return value specified for UpdateCard")
+		panic("no return value specified for UpdateSwimlane")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, *dto.Card) error); ok {
-		r0 = rf(ctx, card)
+	if rf, ok := ret.Get(0).(func(context.Context, *dto.Swimlane) error); ok {
+		r0 = rf(ctx, swimlane)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -419,17 +1947,17 @@ func (_m *AggregatorUseCase) UpdateCard(ctx context.Context, card *dto.Card) err
 	return r0
 }
 
-// UpdateColumn provides a mock function with given fields: ctx, column
-func (_m *AggregatorUseCase) UpdateColumn(ctx context.Context, column *dto.Column) error {
-	ret := _m.Called(ctx, column)
+// UpdateWorkspaceMember provides a mock function with given fields: ctx, workspaceID, req
+func (_m *AggregatorUseCase) UpdateWorkspaceMember(ctx context.Context, workspaceID string, req dto.WorkspaceMemberRequest) error {
+	ret := _m.Called(ctx, workspaceID, req)
 
 	if len(ret) == 0 {
-		panic("no return value specified for UpdateColumn")
+		panic("no return value specified for UpdateWorkspaceMember")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, *dto.Column) error); ok {
-		r0 = rf(ctx, column)
+	if rf, ok := ret.Get(0).(func(context.Context, string, dto.WorkspaceMemberRequest) error); ok {
+		r0 = rf(ctx, workspaceID, req)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -467,6 +1995,36 @@ func (_m *AggregatorUseCase) Validate(ctx context.Context, token string) (*dto.V
 	return r0, r1
 }
 
+// WatchBoard provides a mock function with given fields: ctx, boardID, lastEventID
+func (_m *AggregatorUseCase) WatchBoard(ctx context.Context, boardID string, lastEventID string) (io.ReadCloser, error) {
+	ret := _m.Called(ctx, boardID, lastEventID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WatchBoard")
+	}
+
+	var r0 io.ReadCloser
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (io.ReadCloser, error)); ok {
+		return rf(ctx, boardID, lastEventID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) io.ReadCloser); ok {
+		r0 = rf(ctx, boardID, lastEventID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(io.ReadCloser)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, boardID, lastEventID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // NewAggregatorUseCase creates a new instance of AggregatorUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewAggregatorUseCase(t interface {
@@ -122,6 +122,24 @@ func (_m *AuthService) Register(ctx context.Context, username string, email stri
 	return r0, r1
 }
 
+// RevokeUserTokens provides a mock function with given fields: ctx, userID
+func (_m *AuthService) RevokeUserTokens(ctx context.Context, userID string) error {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeUserTokens")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // ValidateToken provides a mock function with given fields: ctx, token
 func (_m *AuthService) ValidateToken(ctx context.Context, token string) (*dto.ValidateTokenResponse, error) {
 	ret := _m.Called(ctx, token)
@@ -16,6 +16,24 @@ type UserService struct {
 	mock.Mock
 }
 
+// DeleteUser provides a mock function with given fields: ctx, id
+func (_m *UserService) DeleteUser(ctx context.Context, id string) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteUser")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // GetNewUsers provides a mock function with given fields: ctx, from, to
 func (_m *UserService) GetNewUsers(ctx context.Context, from time.Time, to time.Time) ([]dto.User, error) {
 	ret := _m.Called(ctx, from, to)
@@ -46,6 +64,66 @@ func (_m *UserService) GetNewUsers(ctx context.Context, from time.Time, to time.
 	return r0, r1
 }
 
+// GetUserByID provides a mock function with given fields: ctx, id
+func (_m *UserService) GetUserByID(ctx context.Context, id string) (*dto.User, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserByID")
+	}
+
+	var r0 *dto.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*dto.User, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *dto.User); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetUserByUsername provides a mock function with given fields: ctx, username
+func (_m *UserService) GetUserByUsername(ctx context.Context, username string) (*dto.User, error) {
+	ret := _m.Called(ctx, username)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserByUsername")
+	}
+
+	var r0 *dto.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*dto.User, error)); ok {
+		return rf(ctx, username)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *dto.User); ok {
+		r0 = rf(ctx, username)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, username)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // NewUserService creates a new instance of UserService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewUserService(t interface {
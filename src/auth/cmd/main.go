@@ -7,6 +7,7 @@ import (
 	"time"
 	_ "time/tzdata"
 
+	memoryRepo "auth/internal/adapter/repository/memory"
 	sqlxRepo "auth/internal/adapter/repository/sqlx"
 	"auth/internal/adapter/service/tokengen/jwt"
 	user "auth/internal/adapter/service/user/http"
@@ -14,11 +15,13 @@ import (
 	"auth/internal/config"
 	handler "auth/internal/handler/v1"
 	"auth/internal/middleware"
+	"auth/internal/repository"
 	usecase "auth/internal/usecase/v1"
 	"log"
 	"net/http"
 
 	"github.com/gorilla/mux"
+	"github.com/jmoiron/sqlx"
 )
 
 func init() {
@@ -29,6 +32,47 @@ func init() {
 	time.Local = loc
 }
 
+type dbrepo interface {
+	DB() (any, error)
+	Repo(any) any
+}
+
+type postgres struct {
+	cfg *config.Config
+}
+
+// func (p *postgres) DB() (*sqlx.DB, error) {
+func (p *postgres) DB() (any, error) {
+	return database.NewPostgresDB(p.cfg.Auth.Postgres)
+}
+
+// func (p *postgres) Repo(db *sqlx.DB) *sqlxRepo.SQLXTokenRepository {
+func (p *postgres) Repo(db any) any {
+	return sqlxRepo.NewSQLXTokenRepository(db.(*sqlx.DB))
+}
+
+// sqlite runs the SQLX repository of postgres on a database file.
+type sqlite struct {
+	postgres
+}
+
+// func (s *sqlite) DB() (*sqlx.DB, error) {
+func (s *sqlite) DB() (any, error) {
+	return database.NewSQLiteDB(s.cfg.Auth.SQLite)
+}
+
+type memory struct{}
+
+// func (m *memory) DB() (struct{}, error) {
+func (m *memory) DB() (any, error) {
+	return struct{}{}, nil
+}
+
+// func (m *memory) Repo(struct{}) *memoryRepo.MemoryTokenRepository {
+func (m *memory) Repo(any) any {
+	return memoryRepo.NewMemoryTokenRepository()
+}
+
 func main() {
 	config, err := config.LoadConfig("config.toml")
 	if err != nil {
@@ -37,13 +81,24 @@ func main() {
 
 	logger := logger.NewZapLogger(config.Auth.Log)
 
-	db, err := database.NewPostgresDB(config.Auth.Postgres)
+	dbmap := make(map[string]dbrepo)
+	dbmap["postgres"] = &postgres{cfg: config}
+	dbmap["sqlite"] = &sqlite{postgres{cfg: config}}
+	dbmap["memory"] = &memory{}
+
+	dbRepo, ok := dbmap[config.Auth.Database]
+	if !ok {
+		log.Printf("Unknown database %q, exiting", config.Auth.Database)
+		return
+	}
+
+	db, err := dbRepo.DB()
 	if err != nil {
 		log.Println("Couldn't connect to database, exiting")
 		return
 	}
 
-	repo := sqlxRepo.NewSQLXTokenRepository(db)
+	repo := dbRepo.Repo(db).(repository.TokenRepository)
 
 	baseURL := fmt.Sprintf("http://%s:%d/%s", config.User.ContainerName, config.User.LocalPort, config.User.BaseURL)
 
@@ -0,0 +1,60 @@
+package database
+
+import (
+	"errors"
+	"fmt"
+	"log"
+
+	"auth/internal/config"
+	"auth/migrations"
+
+	"github.com/golang-migrate/migrate/v4"
+	"github.com/golang-migrate/migrate/v4/database/sqlite"
+	"github.com/golang-migrate/migrate/v4/source/iofs"
+	"github.com/jmoiron/sqlx"
+	_ "modernc.org/sqlite"
+)
+
+// NewSQLiteDB opens the database file and brings its schema up to date.
+// Times are written in a format that sorts as text.
+func NewSQLiteDB(cfg config.SQLiteConfig) (*sqlx.DB, error) {
+	dsn := fmt.Sprintf("file:%s?_pragma=busy_timeout(5000)&_pragma=journal_mode(WAL)&_time_format=sqlite",
+		cfg.Path)
+
+	db, err := sqlx.Connect("sqlite", dsn)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open SQLite database: %w", err)
+	}
+
+	if err := migrateSQLite(db); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("failed to migrate SQLite database: %w", err)
+	}
+
+	log.Println("Opened SQLite database successfully")
+	return db, nil
+}
+
+func migrateSQLite(db *sqlx.DB) error {
+	source, err := iofs.New(migrations.SQLite, "sqlite")
+	if err != nil {
+		return err
+	}
+
+	driver, err := sqlite.WithInstance(db.DB, &sqlite.Config{})
+	if err != nil {
+		return err
+	}
+
+	m, err := migrate.NewWithInstance("iofs", source, "sqlite", driver)
+	if err != nil {
+		return err
+	}
+
+	// Closing m would close db as well.
+	if err := m.Up(); err != nil && !errors.Is(err, migrate.ErrNoChange) {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,86 @@
+package memory
+
+import (
+	"auth/internal/entity"
+	"auth/internal/repository"
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrTokenNotFound = errors.New("token not found")
+	ErrDuplicateKey  = errors.New("duplicate key")
+)
+
+// MemoryTokenRepository keeps tokens in memory, keyed by id as the tokens
+// table is.
+type MemoryTokenRepository struct {
+	mu     sync.RWMutex
+	tokens map[uuid.UUID]repository.Token
+}
+
+func NewMemoryTokenRepository() *MemoryTokenRepository {
+	return &MemoryTokenRepository{
+		tokens: make(map[uuid.UUID]repository.Token),
+	}
+}
+
+func (r *MemoryTokenRepository) Save(ctx context.Context, token *entity.Token) error {
+	repoToken := repository.RepoToken(*token)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.tokens[repoToken.ID]; ok {
+		return fmt.Errorf("%w: %s", ErrDuplicateKey, repoToken.ID)
+	}
+
+	r.tokens[repoToken.ID] = repoToken
+
+	return nil
+}
+
+func (r *MemoryTokenRepository) Delete(ctx context.Context, tokenID string) error {
+	id, err := uuid.Parse(tokenID)
+	if err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.tokens, id)
+
+	return nil
+}
+
+func (r *MemoryTokenRepository) DeleteByUser(ctx context.Context, userID uuid.UUID) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for id, repoToken := range r.tokens {
+		if repoToken.UserID == userID {
+			delete(r.tokens, id)
+		}
+	}
+
+	return nil
+}
+
+func (r *MemoryTokenRepository) FindByToken(ctx context.Context, tokenValue string) (*entity.Token, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, repoToken := range r.tokens {
+		if repoToken.Token == tokenValue {
+			token := repository.TokenToEntity(repoToken)
+			return &token, nil
+		}
+	}
+
+	return nil, ErrTokenNotFound
+}
@@ -0,0 +1,14 @@
+package memory_test
+
+import (
+	"auth/internal/adapter/repository/memory"
+	"auth/internal/repository"
+	"auth/internal/repository/repotest"
+	"testing"
+)
+
+func TestTokenRepository(t *testing.T) {
+	repotest.TestTokenRepository(t, func(t *testing.T) repository.TokenRepository {
+		return memory.NewMemoryTokenRepository()
+	})
+}
@@ -5,6 +5,7 @@ import (
 	"auth/internal/repository"
 	"context"
 
+	"github.com/google/uuid"
 	"github.com/jmoiron/sqlx"
 )
 
@@ -45,6 +46,17 @@ func (r *SQLXTokenRepository) Delete(ctx context.Context, tokenID string) error
 	return nil
 }
 
+func (r *SQLXTokenRepository) DeleteByUser(ctx context.Context, userID uuid.UUID) error {
+	query := `DELETE FROM tokens WHERE user_id = $1`
+
+	_, err := r.db.ExecContext(ctx, query, userID)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (r *SQLXTokenRepository) FindByToken(ctx context.Context, tokenValue string) (*entity.Token, error) {
 	query := `SELECT id, user_id, token, created_at FROM tokens WHERE token = $1`
 
@@ -0,0 +1,24 @@
+package sqlx_test
+
+import (
+	"auth/internal/adapter/database"
+	"auth/internal/adapter/repository/sqlx"
+	"auth/internal/config"
+	"auth/internal/repository"
+	"auth/internal/repository/repotest"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// The contract runs here on SQLite, which needs no server.
+func TestTokenRepository(t *testing.T) {
+	repotest.TestTokenRepository(t, func(t *testing.T) repository.TokenRepository {
+		db, err := database.NewSQLiteDB(config.SQLiteConfig{Path: filepath.Join(t.TempDir(), "auth.db")})
+		require.NoError(t, err)
+		t.Cleanup(func() { db.Close() })
+
+		return sqlx.NewSQLXTokenRepository(db)
+	})
+}
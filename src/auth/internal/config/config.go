@@ -19,8 +19,7 @@ type LogConfig struct {
 }
 
 type PaginationConfig struct {
-	Limit  int `toml:"limit"`
-	Offset int `toml:"offset"`
+	Limit int `toml:"limit"`
 }
 
 type AggregatorConfig struct {
@@ -47,6 +46,7 @@ type AuthConfig struct {
 	ExposedPort   int            `toml:"exposed_port"`
 	Log           LogConfig      `toml:"log"`
 	Postgres      PostgresConfig `toml:"postgres"`
+	SQLite        SQLiteConfig   `toml:"sqlite"`
 	Token         TokenConfig    `toml:"token"`
 }
 
@@ -65,6 +65,11 @@ type TokenConfig struct {
 	RefreshTTL int    `toml:"refresh_ttl_sec"`
 }
 
+// SQLiteConfig names the database file, made on first start.
+type SQLiteConfig struct {
+	Path string `toml:"path"`
+}
+
 func LoadConfig(configPath string) (*Config, error) {
 	var config Config
 
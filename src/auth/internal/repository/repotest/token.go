@@ -0,0 +1,107 @@
+// Package repotest holds the contract the token repository keeps whatever
+// it stores tokens in. A backend runs the suite from its own tests, so that
+// it cannot drift from the others unnoticed.
+package repotest
+
+import (
+	"auth/internal/entity"
+	"auth/internal/repository"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// NewTokenRepository returns the repository of a backend over an empty
+// store. It is called once for every test of the suite.
+type NewTokenRepository func(t *testing.T) repository.TokenRepository
+
+// createdAt is in whole seconds and in UTC, so that every backend stores it
+// as it is.
+var createdAt = time.Date(2024, time.March, 1, 12, 0, 0, 0, time.UTC)
+
+func newToken(value string) entity.Token {
+	return entity.Token{
+		ID:        uuid.New(),
+		UserID:    uuid.New(),
+		Token:     value,
+		CreatedAt: createdAt,
+	}
+}
+
+// TestTokenRepository runs the token repository contract against the
+// backend newRepo makes.
+func TestTokenRepository(t *testing.T, newRepo NewTokenRepository) {
+	ctx := context.Background()
+
+	t.Run("SaveAndFind", func(t *testing.T) {
+		repo := newRepo(t)
+		token := newToken("refresh")
+		require.NoError(t, repo.Save(ctx, &token))
+		other := newToken("other")
+		require.NoError(t, repo.Save(ctx, &other))
+
+		got, err := repo.FindByToken(ctx, token.Token)
+		require.NoError(t, err)
+		assert.Equal(t, token.ID, got.ID)
+		assert.Equal(t, token.UserID, got.UserID)
+		assert.Equal(t, token.Token, got.Token)
+		assert.True(t, token.CreatedAt.Equal(got.CreatedAt), "CreatedAt: want %v, got %v", token.CreatedAt, got.CreatedAt)
+	})
+
+	t.Run("FindMissing", func(t *testing.T) {
+		repo := newRepo(t)
+
+		_, err := repo.FindByToken(ctx, "missing")
+		assert.Error(t, err)
+	})
+
+	t.Run("SaveKeepsIDsUnique", func(t *testing.T) {
+		repo := newRepo(t)
+		token := newToken("first")
+		require.NoError(t, repo.Save(ctx, &token))
+
+		again := newToken("second")
+		again.ID = token.ID
+		assert.Error(t, repo.Save(ctx, &again))
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		repo := newRepo(t)
+		token, kept := newToken("deleted"), newToken("kept")
+		require.NoError(t, repo.Save(ctx, &token))
+		require.NoError(t, repo.Save(ctx, &kept))
+
+		require.NoError(t, repo.Delete(ctx, token.ID.String()))
+
+		_, err := repo.FindByToken(ctx, token.Token)
+		assert.Error(t, err)
+		_, err = repo.FindByToken(ctx, kept.Token)
+		assert.NoError(t, err)
+
+		assert.NoError(t, repo.Delete(ctx, token.ID.String()), "deleting a token twice is not an error")
+	})
+
+	t.Run("DeleteByUser", func(t *testing.T) {
+		repo := newRepo(t)
+		first, second, kept := newToken("first"), newToken("second"), newToken("kept")
+		second.UserID = first.UserID
+		for _, token := range []*entity.Token{&first, &second, &kept} {
+			require.NoError(t, repo.Save(ctx, token))
+		}
+
+		require.NoError(t, repo.DeleteByUser(ctx, first.UserID))
+
+		_, err := repo.FindByToken(ctx, first.Token)
+		assert.Error(t, err)
+		_, err = repo.FindByToken(ctx, second.Token)
+		assert.Error(t, err)
+		_, err = repo.FindByToken(ctx, kept.Token)
+		assert.NoError(t, err, "the tokens of other users are kept")
+
+		assert.NoError(t, repo.DeleteByUser(ctx, first.UserID), "a user with no tokens is not an error")
+	})
+}
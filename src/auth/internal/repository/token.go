@@ -3,10 +3,14 @@ package repository
 import (
 	"auth/internal/entity"
 	"context"
+
+	"github.com/google/uuid"
 )
 
 type TokenRepository interface {
 	Save(ctx context.Context, token *entity.Token) error
 	Delete(ctx context.Context, tokenID string) error
+	// DeleteByUser deletes every token of the user.
+	DeleteByUser(ctx context.Context, userID uuid.UUID) error
 	FindByToken(ctx context.Context, token string) (*entity.Token, error)
 }
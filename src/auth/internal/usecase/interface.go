@@ -3,6 +3,8 @@ package usecase
 import (
 	"auth/internal/dto"
 	"context"
+
+	"github.com/google/uuid"
 )
 
 type AuthUsecase interface {
@@ -11,4 +13,7 @@ type AuthUsecase interface {
 	Refresh(ctx context.Context, refreshToken string) (*dto.RefreshTokenResponse, error)
 	ValidateToken(ctx context.Context, token string) (string, string, error)
 	Logout(ctx context.Context, refreshToken string) error
+	// RevokeUserTokens deletes the refresh tokens of the user, so that none
+	// of them can be refreshed again.
+	RevokeUserTokens(ctx context.Context, userID uuid.UUID) error
 }
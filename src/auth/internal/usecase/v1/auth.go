@@ -30,6 +30,7 @@ var (
 	ErrValidateToken        error = errors.New("couldn't validate token")
 	ErrLogin                error = errors.New("failed to login")
 	ErrGetUserByEmail       error = errors.New("failed to get user by email")
+	ErrRevokeUserTokens     error = errors.New("couldn't revoke user tokens")
 )
 
 type authUseCase struct {
@@ -171,6 +172,15 @@ func (uc *authUseCase) Refresh(ctx context.Context, refreshToken string) (*dto.R
 
 	uc.log.Info(ctx, header+"Got user data from token", "userID", userID, "role", role)
 
+	uc.log.Info(ctx, header+"Making request to token repo (FindByToken)", "refreshToken", refreshToken)
+
+	_, err = uc.tokenRepo.FindByToken(ctx, refreshToken)
+	if err != nil {
+		info := "Token is not stored; it was logged out or revoked"
+		uc.log.Info(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", ErrInvalidRefreshToken)
+	}
+
 	uc.log.Info(ctx, header+"Making request to token service (GenerateAccessToken)", "userID", userID, "role", role)
 
 	newAccessToken, err := uc.tokenService.GenerateAccessToken(ctx, userID, role)
@@ -236,3 +246,21 @@ func (uc *authUseCase) Logout(ctx context.Context, refreshToken string) error {
 
 	return nil
 }
+
+func (uc *authUseCase) RevokeUserTokens(ctx context.Context, userID uuid.UUID) error {
+	header := "RevokeUserTokens: "
+
+	uc.log.Info(ctx, header+"Usecase called; Making request to token repo (DeleteByUser)", "userID", userID)
+
+	err := uc.tokenRepo.DeleteByUser(ctx, userID)
+
+	if err != nil {
+		info := "Failed to delete tokens"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return fmt.Errorf(header+info+": %w", ErrRevokeUserTokens)
+	}
+
+	uc.log.Info(ctx, header+"Successfully revoked user tokens")
+
+	return nil
+}
@@ -212,10 +212,21 @@ func TestRefresh(t *testing.T) {
 					accessToken := "PositiveAccessToken"
 
 					mockTokenSvc.On("ValidateToken", context.Background(), refreshToken).Return(userID.String(), role, nil)
+					mockTokenRepo.On("FindByToken", context.Background(), refreshToken).Return(&entity.Token{UserID: userID, Token: refreshToken}, nil)
 					mockTokenSvc.On("GenerateAccessToken", context.Background(), userID.String(), role).Return(accessToken, nil)
 				},
 				wantErr: false,
 			},
+			{
+				name:         "revoked",
+				refreshToken: "RevokedRefreshToken",
+				mockSetup: func(mockTokenRepo *mocks.TokenRepository, mockUserSvc *mocks.UserService, mockTokenSvc *mocks.TokenService, refreshToken string) {
+					mockTokenSvc.On("ValidateToken", context.Background(), refreshToken).Return(mom.GetUUID(0).String(), "user", nil)
+					mockTokenRepo.On("FindByToken", context.Background(), refreshToken).Return(nil, errors.New(""))
+				},
+				wantErr: true,
+				err:     v1.ErrInvalidRefreshToken,
+			},
 			{
 				name:         "negative",
 				refreshToken: "NegativeRefreshToken",
@@ -393,6 +404,65 @@ func TestLogout(t *testing.T) {
 	})
 }
 
+func TestRevokeUserTokens(t *testing.T) {
+	runner.Run(t, "TestRevokeUserTokens", func(pt provider.T) {
+		mom := &testdata.ObjectMother{}
+
+		userID := mom.GetUUID(0)
+
+		tests := []struct {
+			name      string
+			mockSetup func(mockTokenRepo *mocks.TokenRepository)
+			wantErr   bool
+			err       error
+		}{
+			{
+				name: "positive",
+				mockSetup: func(mockTokenRepo *mocks.TokenRepository) {
+					mockTokenRepo.On("DeleteByUser", context.Background(), userID).Return(nil)
+				},
+				wantErr: false,
+			},
+			{
+				name: "negative",
+				mockSetup: func(mockTokenRepo *mocks.TokenRepository) {
+					mockTokenRepo.On("DeleteByUser", context.Background(), userID).Return(errors.New(""))
+				},
+				wantErr: true,
+				err:     v1.ErrRevokeUserTokens,
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				t.Parallel()
+
+				runner.Run(t, tt.name, func(pt provider.T) {
+					mockTokenRepo := new(mocks.TokenRepository)
+					logger := log.NewEmptyLogger()
+
+					uc := v1.NewAuthUseCase(mockTokenRepo, new(mocks.UserService), new(mocks.TokenService), logger)
+
+					tt.mockSetup(mockTokenRepo)
+
+					pt.WithNewStep("Call RevokeUserTokens", func(sCtx provider.StepCtx) {
+						err := uc.RevokeUserTokens(context.Background(), userID)
+
+						if tt.wantErr {
+							sCtx.Assert().Error(err, "Expected error")
+							sCtx.Assert().ErrorIs(err, tt.err)
+						} else {
+							sCtx.Assert().NoError(err, "Expected no error")
+						}
+
+						mockTokenRepo.AssertExpectations(t)
+					})
+				})
+			})
+		}
+	})
+}
+
 func hashPassword(password string) (string, error) {
 	bytes, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
 	return string(bytes), err
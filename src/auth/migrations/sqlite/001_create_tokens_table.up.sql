@@ -0,0 +1,6 @@
+CREATE TABLE tokens (
+    id          TEXT PRIMARY KEY,
+    user_id     TEXT NOT NULL,
+    token       TEXT NOT NULL,
+    created_at  TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
@@ -7,6 +7,8 @@ import (
 	context "context"
 
 	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
 )
 
 // AuthUsecase is an autogenerated mock type for the AuthUsecase type
@@ -122,6 +124,24 @@ func (_m *AuthUsecase) Register(ctx context.Context, username string, email stri
 	return r0, r1
 }
 
+// RevokeUserTokens provides a mock function with given fields: ctx, userID
+func (_m *AuthUsecase) RevokeUserTokens(ctx context.Context, userID uuid.UUID) error {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeUserTokens")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // ValidateToken provides a mock function with given fields: ctx, token
 func (_m *AuthUsecase) ValidateToken(ctx context.Context, token string) error {
 	ret := _m.Called(ctx, token)
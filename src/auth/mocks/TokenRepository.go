@@ -7,6 +7,8 @@ import (
 	context "context"
 
 	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
 )
 
 // TokenRepository is an autogenerated mock type for the TokenRepository type
@@ -32,6 +34,24 @@ func (_m *TokenRepository) Delete(ctx context.Context, tokenID string) error {
 	return r0
 }
 
+// DeleteByUser provides a mock function with given fields: ctx, userID
+func (_m *TokenRepository) DeleteByUser(ctx context.Context, userID uuid.UUID) error {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteByUser")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // FindByToken provides a mock function with given fields: ctx, token
 func (_m *TokenRepository) FindByToken(ctx context.Context, token string) (*entity.Token, error) {
 	ret := _m.Called(ctx, token)
@@ -1,6 +1,7 @@
 package integration_test
 
 import (
+	logger "auth/internal/adapter/logger"
 	sqlxRepository "auth/internal/adapter/repository/sqlx"
 	"auth/internal/adapter/service/tokengen/jwt"
 	"auth/internal/dto"
@@ -47,7 +48,7 @@ func sqlxSetup() *testSetup {
 
 	tokenSvc := jwt.NewJWTService("secret", 15*time.Minute, 7*24*time.Hour)
 
-	uc := v1.NewAuthUseCase(repo, userSvc, tokenSvc)
+	uc := v1.NewAuthUseCase(repo, userSvc, tokenSvc, logger.NewEmptyLogger())
 
 	return &testSetup{
 		ctx:      ctx,
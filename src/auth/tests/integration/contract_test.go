@@ -0,0 +1,20 @@
+package integration_test
+
+import (
+	sqlxRepository "auth/internal/adapter/repository/sqlx"
+	"auth/internal/repository"
+	"auth/internal/repository/repotest"
+	"testing"
+)
+
+// TestRepositoryContract runs the token repository contract against
+// Postgres, each test of it on an empty database.
+func TestRepositoryContract(t *testing.T) {
+	repotest.TestTokenRepository(t, func(t *testing.T) repository.TokenRepository {
+		if err := resetDatabase(); err != nil {
+			t.Fatalf("Failed to reset database: %v", err)
+		}
+
+		return sqlxRepository.NewSQLXTokenRepository(db)
+	})
+}
@@ -10,6 +10,7 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"os/signal"
 	"time"
 	_ "time/tzdata"
 
@@ -61,6 +62,26 @@ func saveTokens(tokens *dto.Tokens, filePath string) error {
 	return nil
 }
 
+func addCardQueryFlags(cmd *cobra.Command, q *dto.CardQuery) {
+	flags := cmd.Flags()
+	flags.StringSliceVar(&q.Priority, "priority", nil, "only cards with these priorities (none, low, medium, high, urgent)")
+	flags.StringVar(&q.SwimlaneID, "lane", "", "only cards in this swimlane id")
+	flags.StringVar(&q.UserID, "creator", "", "only cards created by this user id")
+	flags.StringVar(&q.AssigneeID, "assignee", "", "only cards assigned to this user id")
+	flags.StringVar(&q.Label, "label", "", "only cards with this label")
+	flags.StringVar(&q.Text, "text", "", "only cards whose title or description contains this text")
+	flags.StringVar(&q.CreatedFrom, "created-from", "", "created on or after [DD-MM-YYYY]")
+	flags.StringVar(&q.CreatedTo, "created-to", "", "created on or before [DD-MM-YYYY]")
+	flags.StringVar(&q.UpdatedFrom, "updated-from", "", "updated on or after [DD-MM-YYYY]")
+	flags.StringVar(&q.UpdatedTo, "updated-to", "", "updated on or before [DD-MM-YYYY]")
+	flags.StringVar(&q.DueFrom, "due-from", "", "due on or after [DD-MM-YYYY]")
+	flags.StringVar(&q.DueTo, "due-to", "", "due on or before [DD-MM-YYYY]")
+	flags.BoolVar(&q.Archived, "archived", false, "show archived cards instead of active ones")
+	flags.StringVar(&q.Sort, "sort", "", "sort by position, priority, created, updated or due")
+	flags.BoolVar(&q.Descending, "desc", false, "sort in descending order")
+	flags.IntVar(&q.Limit, "limit", 0, "maximum number of cards")
+}
+
 func main() {
 	cfg, err := config.LoadConfig(abspath + "config.toml")
 	if err != nil {
@@ -137,6 +158,7 @@ func main() {
 	}
 
 	// Create board command
+	var boardWorkspace string
 	createBoardCmd := &cobra.Command{
 		Use:   "board [title]",
 		Short: "Create a new board",
@@ -146,9 +168,10 @@ func main() {
 			ctx := context.WithValue(ctx0, "saveFunc", func(tokens *dto.Tokens) {
 				saveTokens(tokens, cfg.Client.TokensPath)
 			})
-			client.CreateBoard(ctx, args[0])
+			client.CreateBoard(ctx, args[0], boardWorkspace)
 		},
 	}
+	createBoardCmd.Flags().StringVar(&boardWorkspace, "workspace", "", "workspace id to create the board in (your personal one by default)")
 	createCmd.AddCommand(createBoardCmd)
 
 	// Create column command
@@ -166,25 +189,55 @@ func main() {
 	}
 	createCmd.AddCommand(createColumnCmd)
 
+	// Create swimlane command
+	createSwimlaneCmd := &cobra.Command{
+		Use:   "swimlane [board_id] [title]",
+		Short: "Create a new swimlane at the bottom of a board",
+		Args:  cobra.ExactArgs(2),
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx0 := context.WithValue(context.Background(), "tokens", &Tokens)
+			ctx := context.WithValue(ctx0, "saveFunc", func(tokens *dto.Tokens) {
+				saveTokens(tokens, cfg.Client.TokensPath)
+			})
+			client.CreateSwimlane(ctx, args[0], args[1])
+		},
+	}
+	createCmd.AddCommand(createSwimlaneCmd)
+
 	// Create card command
+	var cardOpts dto.CardOptions
 	createCardCmd := &cobra.Command{
 		Use:   "card [column_id] [title] [description]",
 		Short: "Create a new card in a column",
-		Args:  cobra.MinimumNArgs(2),
+		Long:  "Create a new card in a column. With --template the title may be left out for the template to give it.",
+		Args: func(cmd *cobra.Command, args []string) error {
+			if cardOpts.TemplateID != "" {
+				return cobra.MinimumNArgs(1)(cmd, args)
+			}
+			return cobra.MinimumNArgs(2)(cmd, args)
+		},
 		Run: func(cmd *cobra.Command, args []string) {
 			ctx0 := context.WithValue(context.Background(), "tokens", &Tokens)
 			ctx := context.WithValue(ctx0, "saveFunc", func(tokens *dto.Tokens) {
 				saveTokens(tokens, cfg.Client.TokensPath)
 			})
-			var description string
+			var title, description string
+			if len(args) > 1 {
+				title = args[1]
+			}
 			if len(args) == 3 {
 				description = args[2]
-			} else {
-				description = ""
 			}
-			client.CreateCard(ctx, args[0], args[1], description)
+			client.CreateCard(ctx, args[0], title, description, cardOpts)
 		},
 	}
+	createCardCmd.Flags().StringVar(&cardOpts.Priority, "priority", "", "card priority (none, low, medium, high, urgent)")
+	createCardCmd.Flags().StringVar(&cardOpts.SwimlaneID, "lane", "", "swimlane id (the first swimlane of the board by default)")
+	createCardCmd.Flags().StringVar(&cardOpts.ParentID, "parent", "", "parent card id, making the new card a sub-card")
+	createCardCmd.Flags().StringVar(&cardOpts.AssigneeID, "assignee", "", "assignee user id")
+	createCardCmd.Flags().StringVar(&cardOpts.DueDate, "due", "", "due date [DD-MM-YYYY]")
+	createCardCmd.Flags().StringSliceVar(&cardOpts.Labels, "label", nil, "card labels")
+	createCardCmd.Flags().StringVar(&cardOpts.TemplateID, "template", "", "card template id of the board, as todo template list shows")
 	createCmd.AddCommand(createCardCmd)
 	rootCmd.AddCommand(createCmd)
 
@@ -195,6 +248,7 @@ func main() {
 	}
 
 	// Show boards command
+	var showStarred bool
 	showBoardsCmd := &cobra.Command{
 		Use:   "boards",
 		Short: "Show all boards",
@@ -203,9 +257,14 @@ func main() {
 			ctx := context.WithValue(ctx0, "saveFunc", func(tokens *dto.Tokens) {
 				saveTokens(tokens, cfg.Client.TokensPath)
 			})
+			if showStarred {
+				client.ShowStarredBoards(ctx)
+				return
+			}
 			client.ShowBoards(ctx)
 		},
 	}
+	showBoardsCmd.Flags().BoolVar(&showStarred, "starred", false, "starred boards first, then the ones viewed lately")
 	showCmd.AddCommand(showBoardsCmd)
 
 	// Show board command
@@ -224,6 +283,7 @@ func main() {
 	showCmd.AddCommand(showBoardCmd)
 
 	// Show column command
+	var columnQuery dto.CardQuery
 	showColumnCmd := &cobra.Command{
 		Use:   "column [column_id]",
 		Short: "Show a column",
@@ -233,11 +293,29 @@ func main() {
 			ctx := context.WithValue(ctx0, "saveFunc", func(tokens *dto.Tokens) {
 				saveTokens(tokens, cfg.Client.TokensPath)
 			})
-			client.ShowColumn(ctx, args[0])
+			client.ShowColumn(ctx, args[0], columnQuery)
 		},
 	}
+	addCardQueryFlags(showColumnCmd, &columnQuery)
 	showCmd.AddCommand(showColumnCmd)
 
+	// Show cards command
+	var boardQuery dto.CardQuery
+	showCardsCmd := &cobra.Command{
+		Use:   "cards [board_id]",
+		Short: "Show cards of a whole board",
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx0 := context.WithValue(context.Background(), "tokens", &Tokens)
+			ctx := context.WithValue(ctx0, "saveFunc", func(tokens *dto.Tokens) {
+				saveTokens(tokens, cfg.Client.TokensPath)
+			})
+			client.ShowBoardCards(ctx, args[0], boardQuery)
+		},
+	}
+	addCardQueryFlags(showCardsCmd, &boardQuery)
+	showCmd.AddCommand(showCardsCmd)
+
 	// Show card command
 	showCardCmd := &cobra.Command{
 		Use:   "card [card_id]",
@@ -302,8 +380,45 @@ func main() {
 		},
 	}
 	updateColumnCmd.AddCommand(updateColumnTitleCmd)
+
+	// Update column done command
+	updateColumnDoneCmd := &cobra.Command{
+		Use:   "done [column_id] [true|false]",
+		Short: "Mark whether a column holds finished cards",
+		Args:  cobra.ExactArgs(2),
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx0 := context.WithValue(context.Background(), "tokens", &Tokens)
+			ctx := context.WithValue(ctx0, "saveFunc", func(tokens *dto.Tokens) {
+				saveTokens(tokens, cfg.Client.TokensPath)
+			})
+			client.UpdateColumnDone(ctx, args[0], args[1])
+		},
+	}
+	updateColumnCmd.AddCommand(updateColumnDoneCmd)
 	updateCmd.AddCommand(updateColumnCmd)
 
+	// Update swimlane command
+	updateSwimlaneCmd := &cobra.Command{
+		Use:   "swimlane",
+		Short: "Update a swimlane",
+	}
+
+	// Update swimlane title command
+	updateSwimlaneTitleCmd := &cobra.Command{
+		Use:   "title [swimlane_id] [new_title]",
+		Short: "Update swimlane title",
+		Args:  cobra.ExactArgs(2),
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx0 := context.WithValue(context.Background(), "tokens", &Tokens)
+			ctx := context.WithValue(ctx0, "saveFunc", func(tokens *dto.Tokens) {
+				saveTokens(tokens, cfg.Client.TokensPath)
+			})
+			client.UpdateSwimlane(ctx, args[0], args[1])
+		},
+	}
+	updateSwimlaneCmd.AddCommand(updateSwimlaneTitleCmd)
+	updateCmd.AddCommand(updateSwimlaneCmd)
+
 	// Update card command
 	updateCardCmd := &cobra.Command{
 		Use:   "card",
@@ -339,6 +454,36 @@ func main() {
 		},
 	}
 	updateCardCmd.AddCommand(updateCardDescriptionCmd)
+
+	// Update card priority command
+	updateCardPriorityCmd := &cobra.Command{
+		Use:   "priority [card_id] [none|low|medium|high|urgent]",
+		Short: "Update card priority",
+		Args:  cobra.ExactArgs(2),
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx0 := context.WithValue(context.Background(), "tokens", &Tokens)
+			ctx := context.WithValue(ctx0, "saveFunc", func(tokens *dto.Tokens) {
+				saveTokens(tokens, cfg.Client.TokensPath)
+			})
+			client.UpdateCardPriority(ctx, args[0], args[1])
+		},
+	}
+	updateCardCmd.AddCommand(updateCardPriorityCmd)
+
+	// Update card parent command
+	updateCardParentCmd := &cobra.Command{
+		Use:   "parent [card_id] [parent_id|none]",
+		Short: "Make a card a sub-card of another one, or a top-level card again",
+		Args:  cobra.ExactArgs(2),
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx0 := context.WithValue(context.Background(), "tokens", &Tokens)
+			ctx := context.WithValue(ctx0, "saveFunc", func(tokens *dto.Tokens) {
+				saveTokens(tokens, cfg.Client.TokensPath)
+			})
+			client.SetCardParent(ctx, args[0], args[1])
+		},
+	}
+	updateCardCmd.AddCommand(updateCardParentCmd)
 	updateCmd.AddCommand(updateCardCmd)
 	rootCmd.AddCommand(updateCmd)
 
@@ -348,21 +493,160 @@ func main() {
 		Short: "Move stuff",
 	}
 
+	var moveLane string
 	moveCardCmd := &cobra.Command{
 		Use:   "card [card_id] [column_id]",
-		Short: "Move card",
-		Args:  cobra.ExactArgs(2),
+		Short: "Move card to another column, swimlane or both",
+		Args:  cobra.RangeArgs(1, 2),
 		Run: func(cmd *cobra.Command, args []string) {
 			ctx0 := context.WithValue(context.Background(), "tokens", &Tokens)
 			ctx := context.WithValue(ctx0, "saveFunc", func(tokens *dto.Tokens) {
 				saveTokens(tokens, cfg.Client.TokensPath)
 			})
-			client.MoveCard(ctx, args[0], args[1])
+			var columnID string
+			if len(args) > 1 {
+				columnID = args[1]
+			}
+			client.MoveCard(ctx, args[0], columnID, moveLane)
 		},
 	}
+	moveCardCmd.Flags().StringVar(&moveLane, "lane", "", "swimlane id to move the card to")
 	moveCmd.AddCommand(moveCardCmd)
+
+	moveColumnCmd := &cobra.Command{
+		Use:   "column [column_id] [board_id]",
+		Short: "Move column with its cards to the end of another board",
+		Args:  cobra.ExactArgs(2),
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx0 := context.WithValue(context.Background(), "tokens", &Tokens)
+			ctx := context.WithValue(ctx0, "saveFunc", func(tokens *dto.Tokens) {
+				saveTokens(tokens, cfg.Client.TokensPath)
+			})
+			client.MoveColumn(ctx, args[0], args[1])
+		},
+	}
+	moveCmd.AddCommand(moveColumnCmd)
 	rootCmd.AddCommand(moveCmd)
 
+	// Merge command
+	var mergeTitles string
+	mergeCmd := &cobra.Command{
+		Use:   "merge [source_board_id] [target_board_id]",
+		Short: "Move every column of a board to the end of another",
+		Args:  cobra.ExactArgs(2),
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx0 := context.WithValue(context.Background(), "tokens", &Tokens)
+			ctx := context.WithValue(ctx0, "saveFunc", func(tokens *dto.Tokens) {
+				saveTokens(tokens, cfg.Client.TokensPath)
+			})
+			client.MergeBoards(ctx, args[0], args[1], mergeTitles)
+		},
+	}
+	mergeCmd.Flags().StringVar(&mergeTitles, "titles", "combine", "columns of the same title: combine their cards, keep both or rename the moved one")
+	rootCmd.AddCommand(mergeCmd)
+
+	// Star command
+	var starOff bool
+	starCmd := &cobra.Command{
+		Use:   "star [board_id]",
+		Short: "Star a board to keep it at the top of your boards",
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx0 := context.WithValue(context.Background(), "tokens", &Tokens)
+			ctx := context.WithValue(ctx0, "saveFunc", func(tokens *dto.Tokens) {
+				saveTokens(tokens, cfg.Client.TokensPath)
+			})
+			client.StarBoard(ctx, args[0], starOff)
+		},
+	}
+	starCmd.Flags().BoolVar(&starOff, "off", false, "take the star off instead")
+	rootCmd.AddCommand(starCmd)
+
+	// Inbox command
+	var inboxUnread bool
+	var inboxLimit int
+	inboxCmd := &cobra.Command{
+		Use:   "inbox",
+		Short: "Show your mentions and the changes of what you watch",
+		Args:  cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx0 := context.WithValue(context.Background(), "tokens", &Tokens)
+			ctx := context.WithValue(ctx0, "saveFunc", func(tokens *dto.Tokens) {
+				saveTokens(tokens, cfg.Client.TokensPath)
+			})
+			client.ShowInbox(ctx, inboxUnread, inboxLimit)
+		},
+	}
+	inboxCmd.Flags().BoolVar(&inboxUnread, "unread", false, "unread notifications only")
+	inboxCmd.Flags().IntVar(&inboxLimit, "limit", 20, "how many of the latest notifications, up to 200")
+
+	inboxReadCmd := &cobra.Command{
+		Use:   "read [notification_id...]",
+		Short: "Mark notifications read, or every one without ids",
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx0 := context.WithValue(context.Background(), "tokens", &Tokens)
+			ctx := context.WithValue(ctx0, "saveFunc", func(tokens *dto.Tokens) {
+				saveTokens(tokens, cfg.Client.TokensPath)
+			})
+			client.MarkRead(ctx, args)
+		},
+	}
+	inboxCmd.AddCommand(inboxReadCmd)
+
+	var inboxWatchOff bool
+	inboxWatchCmd := &cobra.Command{
+		Use:       "watch [board|card] [id]",
+		Short:     "Get the changes of a board or a card in your inbox",
+		Args:      cobra.ExactArgs(2),
+		ValidArgs: []string{"board", "card"},
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx0 := context.WithValue(context.Background(), "tokens", &Tokens)
+			ctx := context.WithValue(ctx0, "saveFunc", func(tokens *dto.Tokens) {
+				saveTokens(tokens, cfg.Client.TokensPath)
+			})
+			client.Watch(ctx, args[0], args[1], inboxWatchOff)
+		},
+	}
+	inboxWatchCmd.Flags().BoolVar(&inboxWatchOff, "off", false, "stop watching instead")
+	inboxCmd.AddCommand(inboxWatchCmd)
+	rootCmd.AddCommand(inboxCmd)
+
+	// Recent command
+	recentCmd := &cobra.Command{
+		Use:   "recent",
+		Short: "Show the boards you viewed lately",
+		Args:  cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx0 := context.WithValue(context.Background(), "tokens", &Tokens)
+			ctx := context.WithValue(ctx0, "saveFunc", func(tokens *dto.Tokens) {
+				saveTokens(tokens, cfg.Client.TokensPath)
+			})
+			client.RecentBoards(ctx)
+		},
+	}
+	rootCmd.AddCommand(recentCmd)
+
+	// Archive command
+	archiveCmd := &cobra.Command{
+		Use:   "archive",
+		Short: "Archive stuff",
+	}
+
+	archiveCardCmd := &cobra.Command{
+		Use:   "card [card_id]",
+		Short: "Archive a card, asking whether to archive its sub-cards too",
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx0 := context.WithValue(context.Background(), "tokens", &Tokens)
+			ctx := context.WithValue(ctx0, "saveFunc", func(tokens *dto.Tokens) {
+				saveTokens(tokens, cfg.Client.TokensPath)
+			})
+			client.ArchiveCard(ctx, args[0], os.Stdin)
+		},
+	}
+	archiveCmd.AddCommand(archiveCardCmd)
+	rootCmd.AddCommand(archiveCmd)
+
 	// Delete command
 	deleteCmd := &cobra.Command{
 		Use:   "delete",
@@ -399,6 +683,21 @@ func main() {
 	}
 	deleteCmd.AddCommand(deleteColumnCmd)
 
+	// Delete swimlane command
+	deleteSwimlaneCmd := &cobra.Command{
+		Use:   "swimlane [swimlane_id]",
+		Short: "Delete a swimlane with its cards",
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx0 := context.WithValue(context.Background(), "tokens", &Tokens)
+			ctx := context.WithValue(ctx0, "saveFunc", func(tokens *dto.Tokens) {
+				saveTokens(tokens, cfg.Client.TokensPath)
+			})
+			client.DeleteSwimlane(ctx, args[0])
+		},
+	}
+	deleteCmd.AddCommand(deleteSwimlaneCmd)
+
 	// Delete card command
 	deleteCardCmd := &cobra.Command{
 		Use:   "card [card_id]",
@@ -415,6 +714,428 @@ func main() {
 	deleteCmd.AddCommand(deleteCardCmd)
 	rootCmd.AddCommand(deleteCmd)
 
+	// Bulk command
+	var allOrNothing bool
+	bulkCmd := &cobra.Command{
+		Use:   "bulk",
+		Short: "Apply card operations read from stdin",
+		Long: `Apply card operations read from stdin, one per line:
+
+  move [card_id] [column_id]
+  archive [card_id] [cascade|keep]   (needed for cards with sub-cards)
+  delete [card_id]
+  label [card_id] [label]
+  assign [card_id] [user_id]   (no user_id unassigns)
+
+Blank lines and lines starting with # are skipped.`,
+		Args: cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx0 := context.WithValue(context.Background(), "tokens", &Tokens)
+			ctx := context.WithValue(ctx0, "saveFunc", func(tokens *dto.Tokens) {
+				saveTokens(tokens, cfg.Client.TokensPath)
+			})
+			client.BulkCards(ctx, os.Stdin, allOrNothing)
+		},
+	}
+	bulkCmd.Flags().BoolVar(&allOrNothing, "all-or-nothing", false, "apply nothing unless every operation succeeds")
+	rootCmd.AddCommand(bulkCmd)
+
+	// Watch command
+	watchCmd := &cobra.Command{
+		Use:   "watch",
+		Short: "Watch for live changes",
+	}
+
+	// Watch board command
+	watchBoardCmd := &cobra.Command{
+		Use:   "board [board_id]",
+		Short: "Print changes of a board as they happen",
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx0, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+			defer stop()
+			ctx1 := context.WithValue(ctx0, "tokens", &Tokens)
+			ctx := context.WithValue(ctx1, "saveFunc", func(tokens *dto.Tokens) {
+				saveTokens(tokens, cfg.Client.TokensPath)
+			})
+			client.WatchBoard(ctx, args[0])
+		},
+	}
+	watchCmd.AddCommand(watchBoardCmd)
+	rootCmd.AddCommand(watchCmd)
+
+	// Timer command
+	timerCmd := &cobra.Command{
+		Use:   "timer",
+		Short: "Track time spent on cards",
+	}
+
+	var timerNote string
+	timerStartCmd := &cobra.Command{
+		Use:   "start [card_id]",
+		Short: "Start a timer on a card; only one can run at a time",
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx0 := context.WithValue(context.Background(), "tokens", &Tokens)
+			ctx := context.WithValue(ctx0, "saveFunc", func(tokens *dto.Tokens) {
+				saveTokens(tokens, cfg.Client.TokensPath)
+			})
+			client.StartTimer(ctx, args[0], timerNote)
+		},
+	}
+	timerStartCmd.Flags().StringVar(&timerNote, "note", "", "what the time is spent on")
+	timerCmd.AddCommand(timerStartCmd)
+
+	timerStopCmd := &cobra.Command{
+		Use:   "stop",
+		Short: "Stop the running timer",
+		Args:  cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx0 := context.WithValue(context.Background(), "tokens", &Tokens)
+			ctx := context.WithValue(ctx0, "saveFunc", func(tokens *dto.Tokens) {
+				saveTokens(tokens, cfg.Client.TokensPath)
+			})
+			client.StopTimer(ctx)
+		},
+	}
+	timerCmd.AddCommand(timerStopCmd)
+	rootCmd.AddCommand(timerCmd)
+
+	// Report command
+	reportCmd := &cobra.Command{
+		Use:   "report",
+		Short: "Show reports",
+	}
+
+	var reportBoard, reportFrom, reportTo string
+	reportTimeCmd := &cobra.Command{
+		Use:   "time",
+		Short: "Show time logged per day, by you or on a board",
+		Args:  cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx0 := context.WithValue(context.Background(), "tokens", &Tokens)
+			ctx := context.WithValue(ctx0, "saveFunc", func(tokens *dto.Tokens) {
+				saveTokens(tokens, cfg.Client.TokensPath)
+			})
+			client.TimeReport(ctx, reportBoard, reportFrom, reportTo)
+		},
+	}
+	reportTimeCmd.Flags().StringVar(&reportBoard, "board", "", "board id, reporting the time of everyone on it")
+	reportTimeCmd.Flags().StringVar(&reportFrom, "from", "", "first day [DD-MM-YYYY] (six days ago by default)")
+	reportTimeCmd.Flags().StringVar(&reportTo, "to", "", "last day [DD-MM-YYYY] (today by default)")
+	reportCmd.AddCommand(reportTimeCmd)
+
+	var flowFrom, flowTo string
+	reportFlowCmd := &cobra.Command{
+		Use:   "flow [board_id]",
+		Short: "Show lead and cycle times, weekly throughput and cumulative flow of a board",
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx0 := context.WithValue(context.Background(), "tokens", &Tokens)
+			ctx := context.WithValue(ctx0, "saveFunc", func(tokens *dto.Tokens) {
+				saveTokens(tokens, cfg.Client.TokensPath)
+			})
+			client.BoardAnalytics(ctx, args[0], flowFrom, flowTo)
+		},
+	}
+	reportFlowCmd.Flags().StringVar(&flowFrom, "from", "", "first day [DD-MM-YYYY] (four weeks ago by default)")
+	reportFlowCmd.Flags().StringVar(&flowTo, "to", "", "last day [DD-MM-YYYY] (today by default)")
+	reportCmd.AddCommand(reportFlowCmd)
+	rootCmd.AddCommand(reportCmd)
+
+	// Sprint command
+	sprintCmd := &cobra.Command{
+		Use:   "sprint",
+		Short: "Plan sprints and follow their burndown",
+	}
+
+	sprintCreateCmd := &cobra.Command{
+		Use:   "create [board_id] [name] [start DD-MM-YYYY] [end DD-MM-YYYY]",
+		Short: "Create a sprint on a board",
+		Args:  cobra.ExactArgs(4),
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx0 := context.WithValue(context.Background(), "tokens", &Tokens)
+			ctx := context.WithValue(ctx0, "saveFunc", func(tokens *dto.Tokens) {
+				saveTokens(tokens, cfg.Client.TokensPath)
+			})
+			client.CreateSprint(ctx, args[0], args[1], args[2], args[3])
+		},
+	}
+	sprintCmd.AddCommand(sprintCreateCmd)
+
+	sprintListCmd := &cobra.Command{
+		Use:   "list [board_id]",
+		Short: "List the sprints of a board",
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx0 := context.WithValue(context.Background(), "tokens", &Tokens)
+			ctx := context.WithValue(ctx0, "saveFunc", func(tokens *dto.Tokens) {
+				saveTokens(tokens, cfg.Client.TokensPath)
+			})
+			client.ShowSprints(ctx, args[0])
+		},
+	}
+	sprintCmd.AddCommand(sprintListCmd)
+
+	sprintShowCmd := &cobra.Command{
+		Use:   "show [sprint_id]",
+		Short: "Show a sprint and its cards",
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx0 := context.WithValue(context.Background(), "tokens", &Tokens)
+			ctx := context.WithValue(ctx0, "saveFunc", func(tokens *dto.Tokens) {
+				saveTokens(tokens, cfg.Client.TokensPath)
+			})
+			client.ShowSprint(ctx, args[0])
+		},
+	}
+	sprintCmd.AddCommand(sprintShowCmd)
+
+	var sprintPoints int
+	sprintAddCmd := &cobra.Command{
+		Use:   "add [sprint_id] [card_id]",
+		Short: "Add a card to a sprint, taking it out of any other open sprint",
+		Args:  cobra.ExactArgs(2),
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx0 := context.WithValue(context.Background(), "tokens", &Tokens)
+			ctx := context.WithValue(ctx0, "saveFunc", func(tokens *dto.Tokens) {
+				saveTokens(tokens, cfg.Client.TokensPath)
+			})
+			client.AddSprintCard(ctx, args[0], args[1], sprintPoints)
+		},
+	}
+	sprintAddCmd.Flags().IntVar(&sprintPoints, "points", 0, "story points of the card")
+	sprintCmd.AddCommand(sprintAddCmd)
+
+	sprintRemoveCmd := &cobra.Command{
+		Use:   "remove [sprint_id] [card_id]",
+		Short: "Remove a card from a sprint",
+		Args:  cobra.ExactArgs(2),
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx0 := context.WithValue(context.Background(), "tokens", &Tokens)
+			ctx := context.WithValue(ctx0, "saveFunc", func(tokens *dto.Tokens) {
+				saveTokens(tokens, cfg.Client.TokensPath)
+			})
+			client.RemoveSprintCard(ctx, args[0], args[1])
+		},
+	}
+	sprintCmd.AddCommand(sprintRemoveCmd)
+
+	var sprintNext string
+	sprintCloseCmd := &cobra.Command{
+		Use:   "close [sprint_id]",
+		Short: "Close a sprint, carrying unfinished cards over to the next one",
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx0 := context.WithValue(context.Background(), "tokens", &Tokens)
+			ctx := context.WithValue(ctx0, "saveFunc", func(tokens *dto.Tokens) {
+				saveTokens(tokens, cfg.Client.TokensPath)
+			})
+			client.CloseSprint(ctx, args[0], sprintNext)
+		},
+	}
+	sprintCloseCmd.Flags().StringVar(&sprintNext, "next", "", "sprint id to carry cards over to (the next open sprint by default)")
+	sprintCmd.AddCommand(sprintCloseCmd)
+
+	sprintBurndownCmd := &cobra.Command{
+		Use:   "burndown [sprint_id]",
+		Short: "Show the burndown of a sprint",
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx0 := context.WithValue(context.Background(), "tokens", &Tokens)
+			ctx := context.WithValue(ctx0, "saveFunc", func(tokens *dto.Tokens) {
+				saveTokens(tokens, cfg.Client.TokensPath)
+			})
+			client.SprintBurndown(ctx, args[0])
+		},
+	}
+	sprintCmd.AddCommand(sprintBurndownCmd)
+	rootCmd.AddCommand(sprintCmd)
+
+	// Template command
+	templateCmd := &cobra.Command{
+		Use:   "template",
+		Short: "Keep card templates of a board",
+	}
+
+	var templateOpts dto.CardTemplateOptions
+	templateCreateCmd := &cobra.Command{
+		Use:   "create [board_id] [name]",
+		Short: "Create a card template on a board",
+		Long: "Create a card template on a board. The title and description may hold {{date}}, {{user}}, {{board}}, " +
+			"{{title}} and {{description}}, filled in when a card is made from it.",
+		Args: cobra.ExactArgs(2),
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx0 := context.WithValue(context.Background(), "tokens", &Tokens)
+			ctx := context.WithValue(ctx0, "saveFunc", func(tokens *dto.Tokens) {
+				saveTokens(tokens, cfg.Client.TokensPath)
+			})
+			client.CreateCardTemplate(ctx, args[0], args[1], templateOpts)
+		},
+	}
+	templateCreateCmd.Flags().StringVar(&templateOpts.TitlePattern, "title", "", "title pattern, e.g. \"[Bug] {{title}}\"")
+	templateCreateCmd.Flags().StringVar(&templateOpts.Description, "description", "", "description body")
+	templateCreateCmd.Flags().StringSliceVar(&templateOpts.Labels, "label", nil, "default labels")
+	templateCreateCmd.Flags().StringArrayVar(&templateOpts.Checklists, "checklist", nil, "checklist as title:item;item, repeatable")
+	templateCmd.AddCommand(templateCreateCmd)
+
+	templateListCmd := &cobra.Command{
+		Use:   "list [board_id]",
+		Short: "List the card templates of a board",
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx0 := context.WithValue(context.Background(), "tokens", &Tokens)
+			ctx := context.WithValue(ctx0, "saveFunc", func(tokens *dto.Tokens) {
+				saveTokens(tokens, cfg.Client.TokensPath)
+			})
+			client.ShowCardTemplates(ctx, args[0])
+		},
+	}
+	templateCmd.AddCommand(templateListCmd)
+
+	templateDeleteCmd := &cobra.Command{
+		Use:   "delete [template_id]",
+		Short: "Delete a card template",
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx0 := context.WithValue(context.Background(), "tokens", &Tokens)
+			ctx := context.WithValue(ctx0, "saveFunc", func(tokens *dto.Tokens) {
+				saveTokens(tokens, cfg.Client.TokensPath)
+			})
+			client.DeleteCardTemplate(ctx, args[0])
+		},
+	}
+	templateCmd.AddCommand(templateDeleteCmd)
+	rootCmd.AddCommand(templateCmd)
+
+	// Workspace command
+	workspaceCmd := &cobra.Command{
+		Use:   "workspace",
+		Short: "Share boards with the members of a workspace",
+	}
+
+	workspaceCreateCmd := &cobra.Command{
+		Use:   "create [name]",
+		Short: "Create a workspace with you as its admin",
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx0 := context.WithValue(context.Background(), "tokens", &Tokens)
+			ctx := context.WithValue(ctx0, "saveFunc", func(tokens *dto.Tokens) {
+				saveTokens(tokens, cfg.Client.TokensPath)
+			})
+			client.CreateWorkspace(ctx, args[0])
+		},
+	}
+	workspaceCmd.AddCommand(workspaceCreateCmd)
+
+	workspaceListCmd := &cobra.Command{
+		Use:   "list",
+		Short: "List your workspaces and your role in each",
+		Args:  cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx0 := context.WithValue(context.Background(), "tokens", &Tokens)
+			ctx := context.WithValue(ctx0, "saveFunc", func(tokens *dto.Tokens) {
+				saveTokens(tokens, cfg.Client.TokensPath)
+			})
+			client.ShowWorkspaces(ctx)
+		},
+	}
+	workspaceCmd.AddCommand(workspaceListCmd)
+
+	workspaceMembersCmd := &cobra.Command{
+		Use:   "members [workspace_id]",
+		Short: "List the members of a workspace",
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx0 := context.WithValue(context.Background(), "tokens", &Tokens)
+			ctx := context.WithValue(ctx0, "saveFunc", func(tokens *dto.Tokens) {
+				saveTokens(tokens, cfg.Client.TokensPath)
+			})
+			client.ShowWorkspaceMembers(ctx, args[0])
+		},
+	}
+	workspaceCmd.AddCommand(workspaceMembersCmd)
+
+	workspaceBoardsCmd := &cobra.Command{
+		Use:   "boards [workspace_id]",
+		Short: "List every board of a workspace you are an admin of",
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx0 := context.WithValue(context.Background(), "tokens", &Tokens)
+			ctx := context.WithValue(ctx0, "saveFunc", func(tokens *dto.Tokens) {
+				saveTokens(tokens, cfg.Client.TokensPath)
+			})
+			client.ShowWorkspaceBoards(ctx, args[0])
+		},
+	}
+	workspaceCmd.AddCommand(workspaceBoardsCmd)
+
+	var memberRole string
+	workspaceAddCmd := &cobra.Command{
+		Use:   "add [workspace_id] [user_id]",
+		Short: "Add a user to a workspace",
+		Args:  cobra.ExactArgs(2),
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx0 := context.WithValue(context.Background(), "tokens", &Tokens)
+			ctx := context.WithValue(ctx0, "saveFunc", func(tokens *dto.Tokens) {
+				saveTokens(tokens, cfg.Client.TokensPath)
+			})
+			client.AddWorkspaceMember(ctx, args[0], args[1], memberRole)
+		},
+	}
+	workspaceAddCmd.Flags().StringVar(&memberRole, "role", "member", "role of the new member: admin, member or viewer")
+	workspaceCmd.AddCommand(workspaceAddCmd)
+
+	workspaceRoleCmd := &cobra.Command{
+		Use:   "role [workspace_id] [user_id] [admin|member|viewer]",
+		Short: "Change the role of a workspace member",
+		Args:  cobra.ExactArgs(3),
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx0 := context.WithValue(context.Background(), "tokens", &Tokens)
+			ctx := context.WithValue(ctx0, "saveFunc", func(tokens *dto.Tokens) {
+				saveTokens(tokens, cfg.Client.TokensPath)
+			})
+			client.UpdateWorkspaceMember(ctx, args[0], args[1], args[2])
+		},
+	}
+	workspaceCmd.AddCommand(workspaceRoleCmd)
+
+	workspaceRemoveCmd := &cobra.Command{
+		Use:   "remove [workspace_id] [user_id]",
+		Short: "Remove a member from a workspace, or leave it with your own id",
+		Args:  cobra.ExactArgs(2),
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx0 := context.WithValue(context.Background(), "tokens", &Tokens)
+			ctx := context.WithValue(ctx0, "saveFunc", func(tokens *dto.Tokens) {
+				saveTokens(tokens, cfg.Client.TokensPath)
+			})
+			client.RemoveWorkspaceMember(ctx, args[0], args[1])
+		},
+	}
+	workspaceCmd.AddCommand(workspaceRemoveCmd)
+	rootCmd.AddCommand(workspaceCmd)
+
+	// Calendar command
+	calendarCmd := &cobra.Command{
+		Use:   "calendar",
+		Short: "Follow your cards due in a calendar app",
+	}
+
+	calendarTokenCmd := &cobra.Command{
+		Use:   "token",
+		Short: "Make a new secret link to your calendar feed; the old one stops working",
+		Args:  cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx0 := context.WithValue(context.Background(), "tokens", &Tokens)
+			ctx := context.WithValue(ctx0, "saveFunc", func(tokens *dto.Tokens) {
+				saveTokens(tokens, cfg.Client.TokensPath)
+			})
+			client.RegenerateCalendarToken(ctx)
+		},
+	}
+	calendarCmd.AddCommand(calendarTokenCmd)
+	rootCmd.AddCommand(calendarCmd)
+
 	// Stats command
 	statsCmd := &cobra.Command{
 		Use:   "stats from [DD-MM-YYYY] to [DD-MM-YYYY]",
@@ -428,6 +1149,25 @@ func main() {
 			client.Stats(ctx, args[0], args[1])
 		},
 	}
+
+	var boardStatsFrom, boardStatsTo string
+	var boardStatsStale int
+	statsBoardCmd := &cobra.Command{
+		Use:   "board [board_id]",
+		Short: "Show cards per column and per day, stale cards and top contributors of a board you are a member of",
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx0 := context.WithValue(context.Background(), "tokens", &Tokens)
+			ctx := context.WithValue(ctx0, "saveFunc", func(tokens *dto.Tokens) {
+				saveTokens(tokens, cfg.Client.TokensPath)
+			})
+			client.BoardStats(ctx, args[0], boardStatsFrom, boardStatsTo, boardStatsStale)
+		},
+	}
+	statsBoardCmd.Flags().StringVar(&boardStatsFrom, "from", "", "first day [DD-MM-YYYY] (four weeks ago by default)")
+	statsBoardCmd.Flags().StringVar(&boardStatsTo, "to", "", "last day [DD-MM-YYYY] (today by default)")
+	statsBoardCmd.Flags().IntVar(&boardStatsStale, "stale-days", 0, "days untouched for a card to be stale (14 by default)")
+	statsCmd.AddCommand(statsBoardCmd)
 	rootCmd.AddCommand(statsCmd)
 
 	if err := rootCmd.Execute(); err != nil {
@@ -1,6 +1,7 @@
 package http
 
 import (
+	"bufio"
 	"bytes"
 	"cli/internal/common/logger"
 	"cli/internal/dto"
@@ -10,7 +11,12 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
 	"time"
+
+	"github.com/google/uuid"
 )
 
 const layout string = "02-01-2006"
@@ -27,24 +33,83 @@ var (
 	ErrLogout       error = errors.New("Failed to log out")
 	ErrGetNewCards  error = errors.New("Failed to get new cards")
 	ErrGetBoards    error = errors.New("Failed to get boards")
+	ErrGetRecent    error = errors.New("Failed to get recent boards")
+	ErrStarBoard    error = errors.New("Failed to star board; it should be in a workspace of yours")
+	ErrUnstarBoard  error = errors.New("Failed to unstar board")
+	ErrAddWatch     error = errors.New("Failed to watch; it should be on a board in a workspace of yours")
+	ErrRemoveWatch  error = errors.New("Failed to stop watching")
+	ErrWatchMissing error = errors.New("Board or card not found")
+	ErrGetInbox     error = errors.New("Failed to get notifications")
+	ErrMarkRead     error = errors.New("Failed to mark notifications read")
 	ErrGetColumns   error = errors.New("Failed to get columns")
 	ErrGetCards     error = errors.New("Failed to get cards")
 	ErrGetCard      error = errors.New("Failed to get card")
+	ErrGetAncestors error = errors.New("Failed to get card ancestors")
+	ErrGetBoard     error = errors.New("Failed to get board")
+	ErrGetColumn    error = errors.New("Failed to get column")
+	ErrGetSwimlane  error = errors.New("Failed to get swimlane")
 	ErrCreateBoard  error = errors.New("Failed to create board")
 	ErrCreateColumn error = errors.New("Failed to create column")
+	ErrCreateLane   error = errors.New("Failed to create swimlane")
 	ErrCreateCard   error = errors.New("Failed to create card")
 	ErrUpdateBoard  error = errors.New("Failed to update board")
 	ErrUpdateColumn error = errors.New("Failed to update column")
+	ErrUpdateLane   error = errors.New("Failed to update swimlane")
 	ErrUpdateCard   error = errors.New("Failed to update card")
+	ErrSetParent    error = errors.New("Failed to set card parent; a card cannot go under itself or its own sub-cards")
 	ErrDeleteBoard  error = errors.New("Failed to delete board")
 	ErrDeleteColumn error = errors.New("Failed to delete column")
+	ErrDeleteLane   error = errors.New("Failed to delete swimlane")
 	ErrDeleteCard   error = errors.New("Failed to delete card")
+	ErrBulkCards    error = errors.New("Failed to apply card operations")
+	ErrMoveColumn   error = errors.New("Failed to move column; it should go to another board of yours")
+	ErrMergeBoards  error = errors.New("Failed to merge boards; they should be two boards of yours and the strategy combine, keep or rename")
+	ErrWatchBoard   error = errors.New("Failed to watch board")
+	ErrStartTimer   error = errors.New("Failed to start timer; only one can run at a time, stop the running one first")
+	ErrStopTimer    error = errors.New("Failed to stop timer")
+	ErrTimeReport   error = errors.New("Failed to get time report")
+	ErrAnalytics    error = errors.New("Failed to get board analytics")
+	ErrPeriod       error = errors.New("Invalid period; dates go DD-MM-YYYY, in order and at most a year apart")
+	ErrBoardStats   error = errors.New("Failed to get board stats")
+	ErrStatsQuery   error = errors.New("Invalid board stats query; dates go DD-MM-YYYY, in order and at most a year apart, and stale days from 1 to 3650")
+	ErrBoardAccess  error = errors.New("You are not a member of the workspace of the board")
+	ErrNoBoard      error = errors.New("Board not found")
+	ErrCreateSprint error = errors.New("Failed to create sprint")
+	ErrGetSprint    error = errors.New("Failed to get sprint")
+	ErrGetSprints   error = errors.New("Failed to get sprints")
+	ErrSprintCards  error = errors.New("Failed to get sprint cards")
+	ErrAddToSprint  error = errors.New("Failed to add card to sprint; the sprint should be open and on the board of the card")
+	ErrSprintRemove error = errors.New("Failed to remove card from sprint")
+	ErrCloseSprint  error = errors.New("Failed to close sprint; it may be closed already")
+	ErrBurndown     error = errors.New("Failed to get sprint burndown")
+	ErrSprint       error = errors.New("Invalid sprint; it needs a name and should not end before it starts")
+	ErrCalendarKey  error = errors.New("Failed to regenerate calendar link")
+	ErrCreateSpace  error = errors.New("Failed to create workspace")
+	ErrGetSpaces    error = errors.New("Failed to get workspaces")
+	ErrGetMembers   error = errors.New("Failed to get workspace members")
+	ErrSpaceBoards  error = errors.New("Failed to get workspace boards")
+	ErrAddMember    error = errors.New("Failed to add workspace member")
+	ErrUpdateMember error = errors.New("Failed to change workspace member role")
+	ErrRemoveMember error = errors.New("Failed to remove workspace member")
+	ErrWorkspace    error = errors.New("Invalid workspace; it needs a name and roles go admin, member or viewer")
+	ErrSpaceAccess  error = errors.New("Your workspace role does not allow that; viewers cannot create boards and only admins manage members or list every board")
+	ErrSpaceMissing error = errors.New("Workspace or member not found")
+	ErrSpaceRefused error = errors.New("Member change refused; the user may be a member already or the last admin, and personal workspaces cannot be shared")
+	ErrAddTemplate  error = errors.New("Failed to create card template")
+	ErrGetTemplates error = errors.New("Failed to get card templates")
+	ErrDelTemplate  error = errors.New("Failed to delete card template")
+	ErrTemplate     error = errors.New("Invalid card template; it needs a name of its own on the board, and labels and checklist items cannot be empty")
+	ErrNoTemplate   error = errors.New("Card template not found")
+	ErrTemplateCard error = errors.New("Failed to create card; the template should belong to the board of the column")
+	ErrQuota        error = errors.New("Quota exceeded; boards, columns, cards or the description are at the limit of the board owner, an admin can raise it")
 )
 
 type AggregatorService struct {
 	baseURL    string
 	httpClient *http.Client
-	log        logger.Logger
+	// streamClient has no timeout: streams last until either side hangs up.
+	streamClient *http.Client
+	log          logger.Logger
 }
 
 func NewAggregatorService(baseURL string, timeout time.Duration, logger logger.Logger) service.AggregatorService {
@@ -53,7 +118,8 @@ func NewAggregatorService(baseURL string, timeout time.Duration, logger logger.L
 		httpClient: &http.Client{
 			Timeout: timeout,
 		},
-		log: logger,
+		streamClient: &http.Client{},
+		log:          logger,
 	}
 }
 
@@ -281,10 +347,17 @@ func (s *AggregatorService) ShowBoards(ctx context.Context) ([]dto.Board, error)
 	return boards, nil
 }
 
-// ShowBoard(ctx context.Context, boardID string) ([]dto.Column, error)
-func (s *AggregatorService) ShowBoard(ctx context.Context, boardID string) ([]dto.Column, error) {
-	url := fmt.Sprintf("%s/board/%s", s.baseURL, boardID)
+// ShowStarredBoards(ctx context.Context) ([]dto.MarkedBoard, error)
+func (s *AggregatorService) ShowStarredBoards(ctx context.Context) ([]dto.MarkedBoard, error) {
+	return s.markedBoards(ctx, fmt.Sprintf("%s/boards?sort=starred", s.baseURL), ErrGetBoards)
+}
+
+// RecentBoards(ctx context.Context) ([]dto.MarkedBoard, error)
+func (s *AggregatorService) RecentBoards(ctx context.Context) ([]dto.MarkedBoard, error) {
+	return s.markedBoards(ctx, fmt.Sprintf("%s/boards/recent", s.baseURL), ErrGetRecent)
+}
 
+func (s *AggregatorService) markedBoards(ctx context.Context, url string, errGet error) ([]dto.MarkedBoard, error) {
 	method := http.MethodGet
 	resp, err := s.makeRequest(ctx, method, url, nil)
 	if err != nil {
@@ -300,58 +373,104 @@ func (s *AggregatorService) ShowBoard(ctx context.Context, boardID string) ([]dt
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		err = ErrGetColumns
+		err = errGet
 		s.log.Error(ctx, err.Error())
 		return nil, err
 	}
 
-	var columns []dto.Column
-	if err := json.NewDecoder(resp.Body).Decode(&columns); err != nil {
+	var boards []dto.MarkedBoard
+	if err := json.NewDecoder(resp.Body).Decode(&boards); err != nil {
 		err = ErrDecodeResponse(err)
 		s.log.Error(ctx, err.Error())
 		return nil, err
 	}
 
-	return columns, nil
+	return boards, nil
+}
+
+// StarBoard(ctx context.Context, boardID string) error
+func (s *AggregatorService) StarBoard(ctx context.Context, boardID string) error {
+	return s.markBoard(ctx, http.MethodPut, boardID, ErrStarBoard)
+}
+
+// UnstarBoard(ctx context.Context, boardID string) error
+func (s *AggregatorService) UnstarBoard(ctx context.Context, boardID string) error {
+	return s.markBoard(ctx, http.MethodDelete, boardID, ErrUnstarBoard)
 }
 
-// ShowColumn(ctx context.Context, columnID string) ([]dto.Card, error)
-func (s *AggregatorService) ShowColumn(ctx context.Context, columnID string) ([]dto.Card, error) {
-	url := fmt.Sprintf("%s/column/%s", s.baseURL, columnID)
+func (s *AggregatorService) markBoard(ctx context.Context, method, boardID string, errMark error) error {
+	url := fmt.Sprintf("%s/board/%s/star", s.baseURL, boardID)
 
-	method := http.MethodGet
 	resp, err := s.makeRequest(ctx, method, url, nil)
 	if err != nil {
 		s.log.Error(ctx, "Error making the request", "method", method, "url", url)
-		return nil, err
+		return err
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode == http.StatusUnauthorized {
 		err = ErrUnauthorized
 		s.log.Error(ctx, err.Error())
-		return nil, err
+		return err
 	}
 
-	if resp.StatusCode != http.StatusOK {
-		err = ErrGetCards
+	if resp.StatusCode != http.StatusNoContent {
+		err = errMark
 		s.log.Error(ctx, err.Error())
-		return nil, err
+		return err
 	}
 
-	var cards []dto.Card
-	if err := json.NewDecoder(resp.Body).Decode(&cards); err != nil {
-		err = ErrDecodeResponse(err)
-		s.log.Error(ctx, err.Error())
-		return nil, err
+	return nil
+}
+
+// Watch(ctx context.Context, target, id string) error
+func (s *AggregatorService) Watch(ctx context.Context, target, id string) error {
+	return s.changeWatch(ctx, http.MethodPut, target, id, ErrAddWatch)
+}
+
+// Unwatch(ctx context.Context, target, id string) error
+func (s *AggregatorService) Unwatch(ctx context.Context, target, id string) error {
+	return s.changeWatch(ctx, http.MethodDelete, target, id, ErrRemoveWatch)
+}
+
+func (s *AggregatorService) changeWatch(ctx context.Context, method, target, id string, errWatch error) error {
+	if target != "board" && target != "card" {
+		return service.ErrWatchTarget
 	}
 
-	return cards, nil
+	url := fmt.Sprintf("%s/%s/%s/watch", s.baseURL, target, id)
+
+	resp, err := s.makeRequest(ctx, method, url, nil)
+	if err != nil {
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url)
+		return err
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusNoContent:
+		return nil
+	case http.StatusUnauthorized:
+		err = ErrUnauthorized
+	case http.StatusNotFound:
+		err = ErrWatchMissing
+	default:
+		err = errWatch
+	}
+
+	s.log.Error(ctx, err.Error())
+	return err
 }
 
-// ShowCard(ctx context.Context, cardID string) (*dto.Card, error)
-func (s *AggregatorService) ShowCard(ctx context.Context, cardID string) (*dto.Card, error) {
-	url := fmt.Sprintf("%s/card/%s", s.baseURL, cardID)
+// Inbox(ctx context.Context, unreadOnly bool, limit int) ([]dto.Notification, error)
+func (s *AggregatorService) Inbox(ctx context.Context, unreadOnly bool, limit int) ([]dto.Notification, error) {
+	values := url.Values{}
+	values.Set("limit", strconv.Itoa(limit))
+	if unreadOnly {
+		values.Set("unread", "true")
+	}
+
+	url := fmt.Sprintf("%s/notifications?%s", s.baseURL, values.Encode())
 
 	method := http.MethodGet
 	resp, err := s.makeRequest(ctx, method, url, nil)
@@ -368,26 +487,26 @@ func (s *AggregatorService) ShowCard(ctx context.Context, cardID string) (*dto.C
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		err = ErrGetCard
+		err = ErrGetInbox
 		s.log.Error(ctx, err.Error())
 		return nil, err
 	}
 
-	var card dto.Card
-	if err := json.NewDecoder(resp.Body).Decode(&card); err != nil {
+	var notifications []dto.Notification
+	if err := json.NewDecoder(resp.Body).Decode(&notifications); err != nil {
 		err = ErrDecodeResponse(err)
 		s.log.Error(ctx, err.Error())
 		return nil, err
 	}
 
-	return &card, nil
+	return notifications, nil
 }
 
-// CreateBoard(ctx context.Context, board dto.Board) error
-func (s *AggregatorService) CreateBoard(ctx context.Context, board dto.Board) error {
-	url := fmt.Sprintf("%s/board", s.baseURL)
+// MarkRead(ctx context.Context, ids []uuid.UUID) error
+func (s *AggregatorService) MarkRead(ctx context.Context, ids []uuid.UUID) error {
+	url := fmt.Sprintf("%s/notifications/read", s.baseURL)
 
-	data := board
+	data := dto.MarkNotificationsReadRequest{IDs: ids}
 
 	method := http.MethodPost
 	resp, err := s.makeRequest(ctx, method, url, data)
@@ -403,8 +522,8 @@ func (s *AggregatorService) CreateBoard(ctx context.Context, board dto.Board) er
 		return err
 	}
 
-	if resp.StatusCode != http.StatusCreated {
-		err = ErrCreateBoard
+	if resp.StatusCode != http.StatusNoContent {
+		err = ErrMarkRead
 		s.log.Error(ctx, err.Error())
 		return err
 	}
@@ -412,235 +531,247 @@ func (s *AggregatorService) CreateBoard(ctx context.Context, board dto.Board) er
 	return nil
 }
 
-// CreateColumn(ctx context.Context, column dto.Column) error
-func (s *AggregatorService) CreateColumn(ctx context.Context, column dto.Column) error {
-	url := fmt.Sprintf("%s/column", s.baseURL)
-
-	data := column
+// ShowBoard(ctx context.Context, boardID string) (*dto.BoardSnapshot, error)
+func (s *AggregatorService) ShowBoard(ctx context.Context, boardID string) (*dto.BoardSnapshot, error) {
+	url := fmt.Sprintf("%s/board/%s", s.baseURL, boardID)
 
-	method := http.MethodPost
-	resp, err := s.makeRequest(ctx, method, url, data)
+	method := http.MethodGet
+	resp, err := s.makeRequest(ctx, method, url, nil)
 	if err != nil {
-		s.log.Error(ctx, "Error making the request", "method", method, "url", url, "data", data)
-		return err
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url)
+		return nil, err
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode == http.StatusUnauthorized {
 		err = ErrUnauthorized
 		s.log.Error(ctx, err.Error())
-		return err
-	}
-
-	if resp.StatusCode != http.StatusCreated {
-		err = ErrCreateBoard
-		s.log.Error(ctx, err.Error())
-		return err
-	}
-
-	return nil
-}
-
-// CreateCard(ctx context.Context, card dto.Card) error
-func (s *AggregatorService) CreateCard(ctx context.Context, card dto.Card) error {
-	url := fmt.Sprintf("%s/card", s.baseURL)
-
-	data := card
-
-	method := http.MethodPost
-	resp, err := s.makeRequest(ctx, method, url, data)
-	if err != nil {
-		s.log.Error(ctx, "Error making the request", "method", method, "url", url, "data", data)
-		return err
+		return nil, err
 	}
-	defer resp.Body.Close()
 
-	if resp.StatusCode == http.StatusUnauthorized {
-		err = ErrUnauthorized
+	if resp.StatusCode != http.StatusOK {
+		err = ErrGetBoard
 		s.log.Error(ctx, err.Error())
-		return err
+		return nil, err
 	}
 
-	if resp.StatusCode != http.StatusCreated {
-		err = ErrCreateBoard
+	var snapshot dto.BoardSnapshot
+	if err := json.NewDecoder(resp.Body).Decode(&snapshot); err != nil {
+		err = ErrDecodeResponse(err)
 		s.log.Error(ctx, err.Error())
-		return err
+		return nil, err
 	}
 
-	return nil
+	return &snapshot, nil
 }
 
-// UpdateBoard(ctx context.Context, board *dto.Board) error
-func (s *AggregatorService) UpdateBoard(ctx context.Context, board *dto.Board) error {
-	url := fmt.Sprintf("%s/board", s.baseURL)
-
-	data := *board
+// ShowColumn(ctx context.Context, columnID string, query dto.CardQuery) ([]dto.Card, error)
+func (s *AggregatorService) ShowColumn(ctx context.Context, columnID string, query dto.CardQuery) ([]dto.Card, error) {
+	url := fmt.Sprintf("%s/column/%s?%s", s.baseURL, columnID, query.Values().Encode())
 
-	method := http.MethodPut
-	resp, err := s.makeRequest(ctx, method, url, data)
+	method := http.MethodGet
+	resp, err := s.makeRequest(ctx, method, url, nil)
 	if err != nil {
-		s.log.Error(ctx, "Error making the request", "method", method, "url", url, "data", data)
-		return err
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url)
+		return nil, err
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode == http.StatusUnauthorized {
 		err = ErrUnauthorized
 		s.log.Error(ctx, err.Error())
-		return err
+		return nil, err
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		err = ErrUpdateBoard
+		err = ErrGetCards
 		s.log.Error(ctx, err.Error())
-		return err
+		return nil, err
 	}
 
-	return nil
-}
+	var cards []dto.Card
+	if err := json.NewDecoder(resp.Body).Decode(&cards); err != nil {
+		err = ErrDecodeResponse(err)
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
 
-// UpdateColumn(ctx context.Context, column *dto.Column) error
-func (s *AggregatorService) UpdateColumn(ctx context.Context, column *dto.Column) error {
-	url := fmt.Sprintf("%s/column", s.baseURL)
+	return cards, nil
+}
 
-	data := *column
+// ShowBoardCards(ctx context.Context, boardID string, query dto.CardQuery) ([]dto.Card, error)
+func (s *AggregatorService) ShowBoardCards(ctx context.Context, boardID string, query dto.CardQuery) ([]dto.Card, error) {
+	url := fmt.Sprintf("%s/board/%s/cards?%s", s.baseURL, boardID, query.Values().Encode())
 
-	method := http.MethodPut
-	resp, err := s.makeRequest(ctx, method, url, data)
+	method := http.MethodGet
+	resp, err := s.makeRequest(ctx, method, url, nil)
 	if err != nil {
-		s.log.Error(ctx, "Error making the request", "method", method, "url", url, "data", data)
-		return err
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url)
+		return nil, err
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode == http.StatusUnauthorized {
 		err = ErrUnauthorized
 		s.log.Error(ctx, err.Error())
-		return err
+		return nil, err
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		err = ErrUpdateColumn
+		err = ErrGetCards
 		s.log.Error(ctx, err.Error())
-		return err
+		return nil, err
 	}
 
-	return nil
-}
+	var cards []dto.Card
+	if err := json.NewDecoder(resp.Body).Decode(&cards); err != nil {
+		err = ErrDecodeResponse(err)
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
 
-// UpdateCard(ctx context.Context, card *dto.Card) error
-func (s *AggregatorService) UpdateCard(ctx context.Context, card *dto.Card) error {
-	url := fmt.Sprintf("%s/card", s.baseURL)
+	return cards, nil
+}
 
-	data := *card
+// ShowCard(ctx context.Context, cardID string) (*dto.Card, error)
+func (s *AggregatorService) ShowCard(ctx context.Context, cardID string) (*dto.Card, error) {
+	url := fmt.Sprintf("%s/card/%s", s.baseURL, cardID)
 
-	method := http.MethodPut
-	resp, err := s.makeRequest(ctx, method, url, data)
+	method := http.MethodGet
+	resp, err := s.makeRequest(ctx, method, url, nil)
 	if err != nil {
-		s.log.Error(ctx, "Error making the request", "method", method, "url", url, "data", data)
-		return err
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url)
+		return nil, err
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode == http.StatusUnauthorized {
 		err = ErrUnauthorized
 		s.log.Error(ctx, err.Error())
-		return err
+		return nil, err
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		err = ErrUpdateCard
+		err = ErrGetCard
 		s.log.Error(ctx, err.Error())
-		return err
+		return nil, err
 	}
 
-	return nil
+	var card dto.Card
+	if err := json.NewDecoder(resp.Body).Decode(&card); err != nil {
+		err = ErrDecodeResponse(err)
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	return &card, nil
 }
 
-// DeleteBoard(ctx context.Context, id string) error
-func (s *AggregatorService) DeleteBoard(ctx context.Context, id string) error {
-	url := fmt.Sprintf("%s/board/%s", s.baseURL, id)
+// ShowCardChildren(ctx context.Context, cardID string) ([]dto.Card, error)
+func (s *AggregatorService) ShowCardChildren(ctx context.Context, cardID string) ([]dto.Card, error) {
+	url := fmt.Sprintf("%s/card/%s/children", s.baseURL, cardID)
 
-	method := http.MethodDelete
+	method := http.MethodGet
 	resp, err := s.makeRequest(ctx, method, url, nil)
 	if err != nil {
 		s.log.Error(ctx, "Error making the request", "method", method, "url", url)
-		return err
+		return nil, err
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode == http.StatusUnauthorized {
 		err = ErrUnauthorized
 		s.log.Error(ctx, err.Error())
-		return err
+		return nil, err
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		err = ErrDeleteBoard
+		err = ErrGetCards
 		s.log.Error(ctx, err.Error())
-		return err
+		return nil, err
 	}
 
-	return nil
+	var cards []dto.Card
+	if err := json.NewDecoder(resp.Body).Decode(&cards); err != nil {
+		err = ErrDecodeResponse(err)
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	return cards, nil
 }
 
-// DeleteColumn(ctx context.Context, id string) error
-func (s *AggregatorService) DeleteColumn(ctx context.Context, id string) error {
-	url := fmt.Sprintf("%s/column/%s", s.baseURL, id)
+// ShowCardAncestors(ctx context.Context, cardID string) ([]dto.Card, error)
+func (s *AggregatorService) ShowCardAncestors(ctx context.Context, cardID string) ([]dto.Card, error) {
+	url := fmt.Sprintf("%s/card/%s/ancestors", s.baseURL, cardID)
 
-	method := http.MethodDelete
+	method := http.MethodGet
 	resp, err := s.makeRequest(ctx, method, url, nil)
 	if err != nil {
 		s.log.Error(ctx, "Error making the request", "method", method, "url", url)
-		return err
+		return nil, err
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode == http.StatusUnauthorized {
 		err = ErrUnauthorized
 		s.log.Error(ctx, err.Error())
-		return err
+		return nil, err
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		err = ErrDeleteColumn
+		err = ErrGetAncestors
 		s.log.Error(ctx, err.Error())
-		return err
+		return nil, err
 	}
 
-	return nil
+	var cards []dto.Card
+	if err := json.NewDecoder(resp.Body).Decode(&cards); err != nil {
+		err = ErrDecodeResponse(err)
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	return cards, nil
 }
 
-// DeleteCard(ctx context.Context, id string) error
-func (s *AggregatorService) DeleteCard(ctx context.Context, id string) error {
-	url := fmt.Sprintf("%s/card/%s", s.baseURL, id)
+// GetBoard(ctx context.Context, boardID string) (*dto.Board, error)
+func (s *AggregatorService) GetBoard(ctx context.Context, boardID string) (*dto.Board, error) {
+	url := fmt.Sprintf("%s/boards/%s", s.baseURL, boardID)
 
-	method := http.MethodDelete
+	method := http.MethodGet
 	resp, err := s.makeRequest(ctx, method, url, nil)
 	if err != nil {
 		s.log.Error(ctx, "Error making the request", "method", method, "url", url)
-		return err
+		return nil, err
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode == http.StatusUnauthorized {
 		err = ErrUnauthorized
 		s.log.Error(ctx, err.Error())
-		return err
+		return nil, err
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		err = ErrDeleteCard
+		err = ErrGetBoard
 		s.log.Error(ctx, err.Error())
-		return err
+		return nil, err
 	}
 
-	return nil
+	var board dto.Board
+	if err := json.NewDecoder(resp.Body).Decode(&board); err != nil {
+		err = ErrDecodeResponse(err)
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	return &board, nil
 }
 
-// Stats(ctx context.Context, from, to string) ([]dto.NewUsersAndCardsStats, error)
-func (s *AggregatorService) Stats(ctx context.Context, from, to string) ([]dto.NewUsersAndCardsStats, error) {
-	url := fmt.Sprintf("%s/stats/%s/%s", s.baseURL, from, to)
+// GetColumn(ctx context.Context, columnID string) (*dto.Column, error)
+func (s *AggregatorService) GetColumn(ctx context.Context, columnID string) (*dto.Column, error) {
+	url := fmt.Sprintf("%s/columns/%s", s.baseURL, columnID)
 
 	method := http.MethodGet
 	resp, err := s.makeRequest(ctx, method, url, nil)
@@ -657,12 +788,1601 @@ func (s *AggregatorService) Stats(ctx context.Context, from, to string) ([]dto.N
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		err = ErrGetNewCards
+		err = ErrGetColumn
 		s.log.Error(ctx, err.Error())
 		return nil, err
 	}
 
-	var stats []dto.NewUsersAndCardsStats
+	var column dto.Column
+	if err := json.NewDecoder(resp.Body).Decode(&column); err != nil {
+		err = ErrDecodeResponse(err)
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	return &column, nil
+}
+
+// GetSwimlane(ctx context.Context, swimlaneID string) (*dto.Swimlane, error)
+func (s *AggregatorService) GetSwimlane(ctx context.Context, swimlaneID string) (*dto.Swimlane, error) {
+	url := fmt.Sprintf("%s/swimlanes/%s", s.baseURL, swimlaneID)
+
+	method := http.MethodGet
+	resp, err := s.makeRequest(ctx, method, url, nil)
+	if err != nil {
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url)
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusUnauthorized {
+		err = ErrUnauthorized
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		err = ErrGetSwimlane
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	var swimlane dto.Swimlane
+	if err := json.NewDecoder(resp.Body).Decode(&swimlane); err != nil {
+		err = ErrDecodeResponse(err)
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	return &swimlane, nil
+}
+
+// CreateBoard(ctx context.Context, board dto.Board) error
+func (s *AggregatorService) CreateBoard(ctx context.Context, board dto.Board) error {
+	url := fmt.Sprintf("%s/board", s.baseURL)
+
+	data := board
+
+	method := http.MethodPost
+	resp, err := s.makeRequest(ctx, method, url, data)
+	if err != nil {
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url, "data", data)
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusUnauthorized {
+		err = ErrUnauthorized
+		s.log.Error(ctx, err.Error())
+		return err
+	}
+
+	if resp.StatusCode == http.StatusForbidden {
+		err = ErrSpaceAccess
+		s.log.Error(ctx, err.Error())
+		return err
+	}
+
+	if resp.StatusCode == http.StatusUnprocessableEntity {
+		err = ErrQuota
+		s.log.Error(ctx, err.Error())
+		return err
+	}
+
+	if resp.StatusCode != http.StatusCreated {
+		err = ErrCreateBoard
+		s.log.Error(ctx, err.Error())
+		return err
+	}
+
+	return nil
+}
+
+// CreateColumn(ctx context.Context, column dto.Column) error
+func (s *AggregatorService) CreateColumn(ctx context.Context, column dto.Column) error {
+	url := fmt.Sprintf("%s/column", s.baseURL)
+
+	data := column
+
+	method := http.MethodPost
+	resp, err := s.makeRequest(ctx, method, url, data)
+	if err != nil {
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url, "data", data)
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusUnauthorized {
+		err = ErrUnauthorized
+		s.log.Error(ctx, err.Error())
+		return err
+	}
+
+	if resp.StatusCode == http.StatusUnprocessableEntity {
+		err = ErrQuota
+		s.log.Error(ctx, err.Error())
+		return err
+	}
+
+	if resp.StatusCode != http.StatusCreated {
+		err = ErrCreateBoard
+		s.log.Error(ctx, err.Error())
+		return err
+	}
+
+	return nil
+}
+
+// CreateSwimlane(ctx context.Context, swimlane dto.Swimlane) error
+func (s *AggregatorService) CreateSwimlane(ctx context.Context, swimlane dto.Swimlane) error {
+	url := fmt.Sprintf("%s/swimlane", s.baseURL)
+
+	data := swimlane
+
+	method := http.MethodPost
+	resp, err := s.makeRequest(ctx, method, url, data)
+	if err != nil {
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url, "data", data)
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusUnauthorized {
+		err = ErrUnauthorized
+		s.log.Error(ctx, err.Error())
+		return err
+	}
+
+	if resp.StatusCode != http.StatusCreated {
+		err = ErrCreateLane
+		s.log.Error(ctx, err.Error())
+		return err
+	}
+
+	return nil
+}
+
+// CreateCard(ctx context.Context, card dto.Card) error
+func (s *AggregatorService) CreateCard(ctx context.Context, card dto.Card) error {
+	url := fmt.Sprintf("%s/card", s.baseURL)
+
+	data := card
+
+	method := http.MethodPost
+	resp, err := s.makeRequest(ctx, method, url, data)
+	if err != nil {
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url, "data", data)
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusUnauthorized {
+		err = ErrUnauthorized
+		s.log.Error(ctx, err.Error())
+		return err
+	}
+
+	if resp.StatusCode == http.StatusUnprocessableEntity {
+		err = ErrQuota
+		s.log.Error(ctx, err.Error())
+		return err
+	}
+
+	if resp.StatusCode != http.StatusCreated {
+		err = ErrCreateBoard
+		s.log.Error(ctx, err.Error())
+		return err
+	}
+
+	return nil
+}
+
+// UpdateBoard(ctx context.Context, board *dto.Board) error
+func (s *AggregatorService) UpdateBoard(ctx context.Context, board *dto.Board) error {
+	url := fmt.Sprintf("%s/board", s.baseURL)
+
+	data := *board
+
+	method := http.MethodPut
+	resp, err := s.makeConditionalRequest(ctx, method, url, data, board.Version)
+	if err != nil {
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url, "data", data)
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusUnauthorized {
+		err = ErrUnauthorized
+		s.log.Error(ctx, err.Error())
+		return err
+	}
+
+	if resp.StatusCode == http.StatusPreconditionFailed {
+		err = service.ErrConflict
+		s.log.Error(ctx, err.Error())
+		return err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		err = ErrUpdateBoard
+		s.log.Error(ctx, err.Error())
+		return err
+	}
+
+	return nil
+}
+
+// UpdateColumn(ctx context.Context, column *dto.Column) error
+func (s *AggregatorService) UpdateColumn(ctx context.Context, column *dto.Column) error {
+	url := fmt.Sprintf("%s/column", s.baseURL)
+
+	data := *column
+
+	method := http.MethodPut
+	resp, err := s.makeConditionalRequest(ctx, method, url, data, column.Version)
+	if err != nil {
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url, "data", data)
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusUnauthorized {
+		err = ErrUnauthorized
+		s.log.Error(ctx, err.Error())
+		return err
+	}
+
+	if resp.StatusCode == http.StatusPreconditionFailed {
+		err = service.ErrConflict
+		s.log.Error(ctx, err.Error())
+		return err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		err = ErrUpdateColumn
+		s.log.Error(ctx, err.Error())
+		return err
+	}
+
+	return nil
+}
+
+// MoveColumn(ctx context.Context, column *dto.Column) error
+func (s *AggregatorService) MoveColumn(ctx context.Context, column *dto.Column) error {
+	url := fmt.Sprintf("%s/column/board", s.baseURL)
+
+	data := dto.MoveColumnRequest{ID: column.ID, BoardID: column.BoardID}
+
+	method := http.MethodPut
+	resp, err := s.makeConditionalRequest(ctx, method, url, data, column.Version)
+	if err != nil {
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url, "data", data)
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusUnauthorized {
+		err = ErrUnauthorized
+		s.log.Error(ctx, err.Error())
+		return err
+	}
+
+	if resp.StatusCode == http.StatusPreconditionFailed {
+		err = service.ErrConflict
+		s.log.Error(ctx, err.Error())
+		return err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		err = ErrMoveColumn
+		s.log.Error(ctx, err.Error())
+		return err
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(column); err != nil {
+		err = ErrDecodeResponse(err)
+		s.log.Error(ctx, err.Error())
+		return err
+	}
+
+	return nil
+}
+
+// MergeBoards(ctx context.Context, req dto.MergeBoardsRequest) (*dto.BoardMerge, error)
+func (s *AggregatorService) MergeBoards(ctx context.Context, req dto.MergeBoardsRequest) (*dto.BoardMerge, error) {
+	url := fmt.Sprintf("%s/boards/merge", s.baseURL)
+
+	data := req
+
+	method := http.MethodPost
+	resp, err := s.makeRequest(ctx, method, url, data)
+	if err != nil {
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url, "data", data)
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusUnauthorized {
+		err = ErrUnauthorized
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	if resp.StatusCode == http.StatusPreconditionFailed {
+		err = service.ErrConflict
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		err = ErrMergeBoards
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	var merge dto.BoardMerge
+	if err := json.NewDecoder(resp.Body).Decode(&merge); err != nil {
+		err = ErrDecodeResponse(err)
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	return &merge, nil
+}
+
+// UpdateSwimlane(ctx context.Context, swimlane *dto.Swimlane) error
+func (s *AggregatorService) UpdateSwimlane(ctx context.Context, swimlane *dto.Swimlane) error {
+	url := fmt.Sprintf("%s/swimlane", s.baseURL)
+
+	data := *swimlane
+
+	method := http.MethodPut
+	resp, err := s.makeConditionalRequest(ctx, method, url, data, swimlane.Version)
+	if err != nil {
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url, "data", data)
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusUnauthorized {
+		err = ErrUnauthorized
+		s.log.Error(ctx, err.Error())
+		return err
+	}
+
+	if resp.StatusCode == http.StatusPreconditionFailed {
+		err = service.ErrConflict
+		s.log.Error(ctx, err.Error())
+		return err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		err = ErrUpdateLane
+		s.log.Error(ctx, err.Error())
+		return err
+	}
+
+	return nil
+}
+
+// UpdateCard(ctx context.Context, card *dto.Card) error
+func (s *AggregatorService) UpdateCard(ctx context.Context, card *dto.Card) error {
+	url := fmt.Sprintf("%s/card", s.baseURL)
+
+	data := *card
+
+	method := http.MethodPut
+	resp, err := s.makeConditionalRequest(ctx, method, url, data, card.Version)
+	if err != nil {
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url, "data", data)
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusUnauthorized {
+		err = ErrUnauthorized
+		s.log.Error(ctx, err.Error())
+		return err
+	}
+
+	if resp.StatusCode == http.StatusPreconditionFailed {
+		err = service.ErrConflict
+		s.log.Error(ctx, err.Error())
+		return err
+	}
+
+	if resp.StatusCode == http.StatusUnprocessableEntity {
+		err = ErrQuota
+		s.log.Error(ctx, err.Error())
+		return err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		err = ErrUpdateCard
+		s.log.Error(ctx, err.Error())
+		return err
+	}
+
+	return nil
+}
+
+// SetCardParent(ctx context.Context, card *dto.Card) error
+func (s *AggregatorService) SetCardParent(ctx context.Context, card *dto.Card) error {
+	url := fmt.Sprintf("%s/card/parent", s.baseURL)
+
+	data := dto.SetCardParentRequest{ID: card.ID, ParentID: card.ParentID}
+
+	method := http.MethodPut
+	resp, err := s.makeConditionalRequest(ctx, method, url, data, card.Version)
+	if err != nil {
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url, "data", data)
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusUnauthorized {
+		err = ErrUnauthorized
+		s.log.Error(ctx, err.Error())
+		return err
+	}
+
+	if resp.StatusCode == http.StatusPreconditionFailed {
+		err = service.ErrConflict
+		s.log.Error(ctx, err.Error())
+		return err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		err = ErrSetParent
+		s.log.Error(ctx, err.Error())
+		return err
+	}
+
+	return nil
+}
+
+// DeleteBoard(ctx context.Context, id string, version int) error
+func (s *AggregatorService) DeleteBoard(ctx context.Context, id string, version int) error {
+	url := fmt.Sprintf("%s/board/%s", s.baseURL, id)
+
+	method := http.MethodDelete
+	resp, err := s.makeConditionalRequest(ctx, method, url, nil, version)
+	if err != nil {
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url)
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusUnauthorized {
+		err = ErrUnauthorized
+		s.log.Error(ctx, err.Error())
+		return err
+	}
+
+	if resp.StatusCode == http.StatusPreconditionFailed {
+		err = service.ErrConflict
+		s.log.Error(ctx, err.Error())
+		return err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		err = ErrDeleteBoard
+		s.log.Error(ctx, err.Error())
+		return err
+	}
+
+	return nil
+}
+
+// DeleteColumn(ctx context.Context, id string, version int) error
+func (s *AggregatorService) DeleteColumn(ctx context.Context, id string, version int) error {
+	url := fmt.Sprintf("%s/column/%s", s.baseURL, id)
+
+	method := http.MethodDelete
+	resp, err := s.makeConditionalRequest(ctx, method, url, nil, version)
+	if err != nil {
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url)
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusUnauthorized {
+		err = ErrUnauthorized
+		s.log.Error(ctx, err.Error())
+		return err
+	}
+
+	if resp.StatusCode == http.StatusPreconditionFailed {
+		err = service.ErrConflict
+		s.log.Error(ctx, err.Error())
+		return err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		err = ErrDeleteColumn
+		s.log.Error(ctx, err.Error())
+		return err
+	}
+
+	return nil
+}
+
+// DeleteSwimlane(ctx context.Context, id string, version int) error
+func (s *AggregatorService) DeleteSwimlane(ctx context.Context, id string, version int) error {
+	url := fmt.Sprintf("%s/swimlane/%s", s.baseURL, id)
+
+	method := http.MethodDelete
+	resp, err := s.makeConditionalRequest(ctx, method, url, nil, version)
+	if err != nil {
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url)
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusUnauthorized {
+		err = ErrUnauthorized
+		s.log.Error(ctx, err.Error())
+		return err
+	}
+
+	if resp.StatusCode == http.StatusPreconditionFailed {
+		err = service.ErrConflict
+		s.log.Error(ctx, err.Error())
+		return err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		err = ErrDeleteLane
+		s.log.Error(ctx, err.Error())
+		return err
+	}
+
+	return nil
+}
+
+// DeleteCard(ctx context.Context, id string, version int) error
+func (s *AggregatorService) DeleteCard(ctx context.Context, id string, version int) error {
+	url := fmt.Sprintf("%s/card/%s", s.baseURL, id)
+
+	method := http.MethodDelete
+	resp, err := s.makeConditionalRequest(ctx, method, url, nil, version)
+	if err != nil {
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url)
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusUnauthorized {
+		err = ErrUnauthorized
+		s.log.Error(ctx, err.Error())
+		return err
+	}
+
+	if resp.StatusCode == http.StatusPreconditionFailed {
+		err = service.ErrConflict
+		s.log.Error(ctx, err.Error())
+		return err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		err = ErrDeleteCard
+		s.log.Error(ctx, err.Error())
+		return err
+	}
+
+	return nil
+}
+
+// BulkCards(ctx context.Context, req dto.BulkCardsRequest) (*dto.BulkCardsResponse, error)
+func (s *AggregatorService) BulkCards(ctx context.Context, req dto.BulkCardsRequest) (*dto.BulkCardsResponse, error) {
+	url := fmt.Sprintf("%s/cards/bulk", s.baseURL)
+
+	data := req
+
+	method := http.MethodPost
+	resp, err := s.makeRequest(ctx, method, url, data)
+	if err != nil {
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url, "data", data)
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusUnauthorized {
+		err = ErrUnauthorized
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		err = ErrBulkCards
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	var res dto.BulkCardsResponse
+	if err := json.NewDecoder(resp.Body).Decode(&res); err != nil {
+		err = ErrDecodeResponse(err)
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// StartTimer(ctx context.Context, req dto.StartTimerRequest) (*dto.TimeEntry, error)
+func (s *AggregatorService) StartTimer(ctx context.Context, req dto.StartTimerRequest) (*dto.TimeEntry, error) {
+	url := fmt.Sprintf("%s/timer/start", s.baseURL)
+
+	data := req
+
+	method := http.MethodPost
+	resp, err := s.makeRequest(ctx, method, url, data)
+	if err != nil {
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url, "data", data)
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusUnauthorized {
+		err = ErrUnauthorized
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusCreated {
+		err = ErrStartTimer
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	var entry dto.TimeEntry
+	if err := json.NewDecoder(resp.Body).Decode(&entry); err != nil {
+		err = ErrDecodeResponse(err)
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	return &entry, nil
+}
+
+// StopTimer(ctx context.Context) (*dto.TimeEntry, error)
+func (s *AggregatorService) StopTimer(ctx context.Context) (*dto.TimeEntry, error) {
+	url := fmt.Sprintf("%s/timer/stop", s.baseURL)
+
+	method := http.MethodPost
+	resp, err := s.makeRequest(ctx, method, url, nil)
+	if err != nil {
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url)
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusUnauthorized {
+		err = ErrUnauthorized
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		err = service.ErrNoTimerRunning
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		err = ErrStopTimer
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	var entry dto.TimeEntry
+	if err := json.NewDecoder(resp.Body).Decode(&entry); err != nil {
+		err = ErrDecodeResponse(err)
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	return &entry, nil
+}
+
+// TimeReport(ctx context.Context, boardID, from, to string) ([]dto.TimeReportRow, error)
+func (s *AggregatorService) TimeReport(ctx context.Context, boardID, from, to string) ([]dto.TimeReportRow, error) {
+	values := url.Values{}
+	if boardID != "" {
+		values.Set("board_id", boardID)
+	}
+	values.Set("from", from)
+	values.Set("to", to)
+
+	url := fmt.Sprintf("%s/report/time?%s", s.baseURL, values.Encode())
+
+	method := http.MethodGet
+	resp, err := s.makeRequest(ctx, method, url, nil)
+	if err != nil {
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url)
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusUnauthorized {
+		err = ErrUnauthorized
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		err = ErrTimeReport
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	var rows []dto.TimeReportRow
+	if err := json.NewDecoder(resp.Body).Decode(&rows); err != nil {
+		err = ErrDecodeResponse(err)
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	return rows, nil
+}
+
+// BoardAnalytics(ctx context.Context, boardID, from, to string) (*dto.BoardAnalytics, error)
+func (s *AggregatorService) BoardAnalytics(ctx context.Context, boardID, from, to string) (*dto.BoardAnalytics, error) {
+	values := url.Values{}
+	values.Set("from", from)
+	values.Set("to", to)
+
+	url := fmt.Sprintf("%s/board/%s/analytics?%s", s.baseURL, boardID, values.Encode())
+
+	method := http.MethodGet
+	resp, err := s.makeRequest(ctx, method, url, nil)
+	if err != nil {
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url)
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusUnauthorized {
+		err = ErrUnauthorized
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	if resp.StatusCode == http.StatusBadRequest {
+		err = ErrPeriod
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		err = ErrAnalytics
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	var analytics dto.BoardAnalytics
+	if err := json.NewDecoder(resp.Body).Decode(&analytics); err != nil {
+		err = ErrDecodeResponse(err)
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	return &analytics, nil
+}
+
+// BoardStats(ctx context.Context, boardID, from, to, staleDays string) (*dto.BoardStats, error)
+func (s *AggregatorService) BoardStats(ctx context.Context, boardID, from, to, staleDays string) (*dto.BoardStats, error) {
+	values := url.Values{}
+	values.Set("from", from)
+	values.Set("to", to)
+	if staleDays != "" {
+		values.Set("stale_days", staleDays)
+	}
+
+	url := fmt.Sprintf("%s/board/%s/stats?%s", s.baseURL, boardID, values.Encode())
+
+	method := http.MethodGet
+	resp, err := s.makeRequest(ctx, method, url, nil)
+	if err != nil {
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url)
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusUnauthorized:
+		err = ErrUnauthorized
+	case http.StatusBadRequest:
+		err = ErrStatsQuery
+	case http.StatusForbidden:
+		err = ErrBoardAccess
+	case http.StatusNotFound:
+		err = ErrNoBoard
+	default:
+		err = ErrBoardStats
+	}
+
+	if err != nil {
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	var stats dto.BoardStats
+	if err := json.NewDecoder(resp.Body).Decode(&stats); err != nil {
+		err = ErrDecodeResponse(err)
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	return &stats, nil
+}
+
+// CreateSprint(ctx context.Context, req dto.CreateSprintRequest) (*dto.Sprint, error)
+func (s *AggregatorService) CreateSprint(ctx context.Context, req dto.CreateSprintRequest) (*dto.Sprint, error) {
+	url := fmt.Sprintf("%s/sprint", s.baseURL)
+
+	data := req
+
+	method := http.MethodPost
+	resp, err := s.makeRequest(ctx, method, url, data)
+	if err != nil {
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url, "data", data)
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusUnauthorized {
+		err = ErrUnauthorized
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	if resp.StatusCode == http.StatusBadRequest {
+		err = ErrSprint
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusCreated {
+		err = ErrCreateSprint
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	var sprint dto.Sprint
+	if err := json.NewDecoder(resp.Body).Decode(&sprint); err != nil {
+		err = ErrDecodeResponse(err)
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	return &sprint, nil
+}
+
+// GetSprint(ctx context.Context, id string) (*dto.Sprint, error)
+func (s *AggregatorService) GetSprint(ctx context.Context, id string) (*dto.Sprint, error) {
+	url := fmt.Sprintf("%s/sprint/%s", s.baseURL, id)
+
+	method := http.MethodGet
+	resp, err := s.makeRequest(ctx, method, url, nil)
+	if err != nil {
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url)
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusUnauthorized {
+		err = ErrUnauthorized
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		err = ErrGetSprint
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	var sprint dto.Sprint
+	if err := json.NewDecoder(resp.Body).Decode(&sprint); err != nil {
+		err = ErrDecodeResponse(err)
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	return &sprint, nil
+}
+
+// GetBoardSprints(ctx context.Context, boardID string) ([]dto.Sprint, error)
+func (s *AggregatorService) GetBoardSprints(ctx context.Context, boardID string) ([]dto.Sprint, error) {
+	url := fmt.Sprintf("%s/board/%s/sprints", s.baseURL, boardID)
+
+	method := http.MethodGet
+	resp, err := s.makeRequest(ctx, method, url, nil)
+	if err != nil {
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url)
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusUnauthorized {
+		err = ErrUnauthorized
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		err = ErrGetSprints
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	var sprints []dto.Sprint
+	if err := json.NewDecoder(resp.Body).Decode(&sprints); err != nil {
+		err = ErrDecodeResponse(err)
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	return sprints, nil
+}
+
+// GetSprintCards(ctx context.Context, sprintID string) ([]dto.SprintCard, error)
+func (s *AggregatorService) GetSprintCards(ctx context.Context, sprintID string) ([]dto.SprintCard, error) {
+	url := fmt.Sprintf("%s/sprint/%s/cards", s.baseURL, sprintID)
+
+	method := http.MethodGet
+	resp, err := s.makeRequest(ctx, method, url, nil)
+	if err != nil {
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url)
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusUnauthorized {
+		err = ErrUnauthorized
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		err = ErrSprintCards
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	var cards []dto.SprintCard
+	if err := json.NewDecoder(resp.Body).Decode(&cards); err != nil {
+		err = ErrDecodeResponse(err)
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	return cards, nil
+}
+
+// AddSprintCard(ctx context.Context, sprintID string, req dto.AddSprintCardRequest) (*dto.SprintCard, error)
+func (s *AggregatorService) AddSprintCard(ctx context.Context, sprintID string, req dto.AddSprintCardRequest) (*dto.SprintCard, error) {
+	url := fmt.Sprintf("%s/sprint/%s/cards", s.baseURL, sprintID)
+
+	data := req
+
+	method := http.MethodPost
+	resp, err := s.makeRequest(ctx, method, url, data)
+	if err != nil {
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url, "data", data)
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusUnauthorized {
+		err = ErrUnauthorized
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusCreated {
+		err = ErrAddToSprint
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	var card dto.SprintCard
+	if err := json.NewDecoder(resp.Body).Decode(&card); err != nil {
+		err = ErrDecodeResponse(err)
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	return &card, nil
+}
+
+// RemoveSprintCard(ctx context.Context, sprintID, cardID string) error
+func (s *AggregatorService) RemoveSprintCard(ctx context.Context, sprintID, cardID string) error {
+	url := fmt.Sprintf("%s/sprint/%s/cards/%s", s.baseURL, sprintID, cardID)
+
+	method := http.MethodDelete
+	resp, err := s.makeRequest(ctx, method, url, nil)
+	if err != nil {
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url)
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusUnauthorized {
+		err = ErrUnauthorized
+		s.log.Error(ctx, err.Error())
+		return err
+	}
+
+	if resp.StatusCode != http.StatusNoContent {
+		err = ErrSprintRemove
+		s.log.Error(ctx, err.Error())
+		return err
+	}
+
+	return nil
+}
+
+// CloseSprint(ctx context.Context, sprintID string, req dto.CloseSprintRequest) (*dto.CloseSprintResponse, error)
+func (s *AggregatorService) CloseSprint(ctx context.Context, sprintID string, req dto.CloseSprintRequest) (*dto.CloseSprintResponse, error) {
+	url := fmt.Sprintf("%s/sprint/%s/close", s.baseURL, sprintID)
+
+	data := req
+
+	method := http.MethodPost
+	resp, err := s.makeRequest(ctx, method, url, data)
+	if err != nil {
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url, "data", data)
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusUnauthorized {
+		err = ErrUnauthorized
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		err = ErrCloseSprint
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	var closed dto.CloseSprintResponse
+	if err := json.NewDecoder(resp.Body).Decode(&closed); err != nil {
+		err = ErrDecodeResponse(err)
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	return &closed, nil
+}
+
+// SprintBurndown(ctx context.Context, sprintID string) ([]dto.BurndownPoint, error)
+func (s *AggregatorService) SprintBurndown(ctx context.Context, sprintID string) ([]dto.BurndownPoint, error) {
+	url := fmt.Sprintf("%s/sprint/%s/burndown", s.baseURL, sprintID)
+
+	method := http.MethodGet
+	resp, err := s.makeRequest(ctx, method, url, nil)
+	if err != nil {
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url)
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusUnauthorized {
+		err = ErrUnauthorized
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		err = ErrBurndown
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	var points []dto.BurndownPoint
+	if err := json.NewDecoder(resp.Body).Decode(&points); err != nil {
+		err = ErrDecodeResponse(err)
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	return points, nil
+}
+
+// CreateCardFromTemplate(ctx context.Context, templateID string, card dto.Card) error
+func (s *AggregatorService) CreateCardFromTemplate(ctx context.Context, templateID string, card dto.Card) error {
+	url := fmt.Sprintf("%s/card", s.baseURL)
+
+	id, err := uuid.Parse(templateID)
+	if err != nil {
+		s.log.Error(ctx, ErrNoTemplate.Error(), "templateID", templateID)
+		return ErrNoTemplate
+	}
+
+	data := struct {
+		dto.Card
+		TemplateID uuid.UUID `json:"template_id"`
+	}{card, id}
+
+	method := http.MethodPost
+	resp, err := s.makeRequest(ctx, method, url, data)
+	if err != nil {
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url, "data", data)
+		return err
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusCreated:
+		return nil
+	case http.StatusUnauthorized:
+		err = ErrUnauthorized
+	case http.StatusBadRequest:
+		err = ErrTemplateCard
+	case http.StatusNotFound:
+		err = ErrNoTemplate
+	case http.StatusUnprocessableEntity:
+		err = ErrQuota
+	default:
+		err = ErrCreateCard
+	}
+
+	s.log.Error(ctx, err.Error())
+	return err
+}
+
+// CreateCardTemplate(ctx context.Context, req dto.CardTemplateRequest) (*dto.CardTemplate, error)
+func (s *AggregatorService) CreateCardTemplate(ctx context.Context, req dto.CardTemplateRequest) (*dto.CardTemplate, error) {
+	url := fmt.Sprintf("%s/template", s.baseURL)
+
+	data := req
+
+	method := http.MethodPost
+	resp, err := s.makeRequest(ctx, method, url, data)
+	if err != nil {
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url, "data", data)
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusUnauthorized {
+		err = ErrUnauthorized
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	if resp.StatusCode == http.StatusBadRequest || resp.StatusCode == http.StatusConflict {
+		err = ErrTemplate
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusCreated {
+		err = ErrAddTemplate
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	var template dto.CardTemplate
+	if err := json.NewDecoder(resp.Body).Decode(&template); err != nil {
+		err = ErrDecodeResponse(err)
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	return &template, nil
+}
+
+// GetBoardCardTemplates(ctx context.Context, boardID string) ([]dto.CardTemplate, error)
+func (s *AggregatorService) GetBoardCardTemplates(ctx context.Context, boardID string) ([]dto.CardTemplate, error) {
+	url := fmt.Sprintf("%s/board/%s/templates", s.baseURL, boardID)
+
+	method := http.MethodGet
+	resp, err := s.makeRequest(ctx, method, url, nil)
+	if err != nil {
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url)
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusUnauthorized {
+		err = ErrUnauthorized
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		err = ErrGetTemplates
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	var templates []dto.CardTemplate
+	if err := json.NewDecoder(resp.Body).Decode(&templates); err != nil {
+		err = ErrDecodeResponse(err)
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	return templates, nil
+}
+
+// DeleteCardTemplate(ctx context.Context, id string) error
+func (s *AggregatorService) DeleteCardTemplate(ctx context.Context, id string) error {
+	url := fmt.Sprintf("%s/template/%s", s.baseURL, id)
+
+	method := http.MethodDelete
+	resp, err := s.makeRequest(ctx, method, url, nil)
+	if err != nil {
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url)
+		return err
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusNoContent:
+		return nil
+	case http.StatusUnauthorized:
+		err = ErrUnauthorized
+	case http.StatusNotFound:
+		err = ErrNoTemplate
+	default:
+		err = ErrDelTemplate
+	}
+
+	s.log.Error(ctx, err.Error())
+	return err
+}
+
+// CreateWorkspace(ctx context.Context, name string) (*dto.Workspace, error)
+func (s *AggregatorService) CreateWorkspace(ctx context.Context, name string) (*dto.Workspace, error) {
+	url := fmt.Sprintf("%s/workspace", s.baseURL)
+
+	data := dto.CreateWorkspaceRequest{Name: name}
+
+	method := http.MethodPost
+	resp, err := s.makeRequest(ctx, method, url, data)
+	if err != nil {
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url, "data", data)
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if err := workspaceError(resp, http.StatusCreated, ErrCreateSpace); err != nil {
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	var workspace dto.Workspace
+	if err := json.NewDecoder(resp.Body).Decode(&workspace); err != nil {
+		err = ErrDecodeResponse(err)
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	workspace.Role = dto.RoleAdmin
+
+	return &workspace, nil
+}
+
+// GetWorkspaces(ctx context.Context) ([]dto.Workspace, error)
+func (s *AggregatorService) GetWorkspaces(ctx context.Context) ([]dto.Workspace, error) {
+	url := fmt.Sprintf("%s/workspaces", s.baseURL)
+
+	method := http.MethodGet
+	resp, err := s.makeRequest(ctx, method, url, nil)
+	if err != nil {
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url)
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if err := workspaceError(resp, http.StatusOK, ErrGetSpaces); err != nil {
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	var workspaces []dto.Workspace
+	if err := json.NewDecoder(resp.Body).Decode(&workspaces); err != nil {
+		err = ErrDecodeResponse(err)
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	return workspaces, nil
+}
+
+// GetWorkspaceMembers(ctx context.Context, workspaceID string) ([]dto.WorkspaceMember, error)
+func (s *AggregatorService) GetWorkspaceMembers(ctx context.Context, workspaceID string) ([]dto.WorkspaceMember, error) {
+	url := fmt.Sprintf("%s/workspace/%s/members", s.baseURL, workspaceID)
+
+	method := http.MethodGet
+	resp, err := s.makeRequest(ctx, method, url, nil)
+	if err != nil {
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url)
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if err := workspaceError(resp, http.StatusOK, ErrGetMembers); err != nil {
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	var members []dto.WorkspaceMember
+	if err := json.NewDecoder(resp.Body).Decode(&members); err != nil {
+		err = ErrDecodeResponse(err)
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	return members, nil
+}
+
+// GetWorkspaceBoards(ctx context.Context, workspaceID string) ([]dto.Board, error)
+func (s *AggregatorService) GetWorkspaceBoards(ctx context.Context, workspaceID string) ([]dto.Board, error) {
+	url := fmt.Sprintf("%s/workspace/%s/boards", s.baseURL, workspaceID)
+
+	method := http.MethodGet
+	resp, err := s.makeRequest(ctx, method, url, nil)
+	if err != nil {
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url)
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if err := workspaceError(resp, http.StatusOK, ErrSpaceBoards); err != nil {
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	var boards []dto.Board
+	if err := json.NewDecoder(resp.Body).Decode(&boards); err != nil {
+		err = ErrDecodeResponse(err)
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	return boards, nil
+}
+
+// AddWorkspaceMember(ctx context.Context, workspaceID string, req dto.WorkspaceMemberRequest) error
+func (s *AggregatorService) AddWorkspaceMember(ctx context.Context, workspaceID string, req dto.WorkspaceMemberRequest) error {
+	return s.changeWorkspaceMember(ctx, http.MethodPost, workspaceID, req, ErrAddMember)
+}
+
+// UpdateWorkspaceMember(ctx context.Context, workspaceID string, req dto.WorkspaceMemberRequest) error
+func (s *AggregatorService) UpdateWorkspaceMember(ctx context.Context, workspaceID string, req dto.WorkspaceMemberRequest) error {
+	return s.changeWorkspaceMember(ctx, http.MethodPut, workspaceID, req, ErrUpdateMember)
+}
+
+func (s *AggregatorService) changeWorkspaceMember(ctx context.Context, method, workspaceID string, req dto.WorkspaceMemberRequest, errChange error) error {
+	url := fmt.Sprintf("%s/workspace/%s/members", s.baseURL, workspaceID)
+
+	data := req
+
+	resp, err := s.makeRequest(ctx, method, url, data)
+	if err != nil {
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url, "data", data)
+		return err
+	}
+	defer resp.Body.Close()
+
+	if err := workspaceError(resp, http.StatusNoContent, errChange); err != nil {
+		s.log.Error(ctx, err.Error())
+		return err
+	}
+
+	return nil
+}
+
+// RemoveWorkspaceMember(ctx context.Context, workspaceID, userID string) error
+func (s *AggregatorService) RemoveWorkspaceMember(ctx context.Context, workspaceID, userID string) error {
+	url := fmt.Sprintf("%s/workspace/%s/members/%s", s.baseURL, workspaceID, userID)
+
+	method := http.MethodDelete
+	resp, err := s.makeRequest(ctx, method, url, nil)
+	if err != nil {
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url)
+		return err
+	}
+	defer resp.Body.Close()
+
+	if err := workspaceError(resp, http.StatusNoContent, ErrRemoveMember); err != nil {
+		s.log.Error(ctx, err.Error())
+		return err
+	}
+
+	return nil
+}
+
+// workspaceError maps the status of a workspace request expecting ok to an
+// error; failed stands for the unexpected statuses.
+func workspaceError(resp *http.Response, ok int, failed error) error {
+	switch resp.StatusCode {
+	case ok:
+		return nil
+	case http.StatusUnauthorized:
+		return ErrUnauthorized
+	case http.StatusBadRequest:
+		return ErrWorkspace
+	case http.StatusForbidden:
+		return ErrSpaceAccess
+	case http.StatusNotFound:
+		return ErrSpaceMissing
+	case http.StatusConflict:
+		return ErrSpaceRefused
+	}
+
+	return failed
+}
+
+// WatchBoard(ctx context.Context, boardID, lastEventID string, handle func(dto.Activity)) error
+func (s *AggregatorService) RegenerateCalendarToken(ctx context.Context) (*dto.CalendarToken, error) {
+	url := fmt.Sprintf("%s/calendar/token", s.baseURL)
+
+	method := http.MethodPost
+	resp, err := s.makeRequest(ctx, method, url, nil)
+	if err != nil {
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url)
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusUnauthorized {
+		err = ErrUnauthorized
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusCreated {
+		err = ErrCalendarKey
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	var token dto.CalendarToken
+	if err := json.NewDecoder(resp.Body).Decode(&token); err != nil {
+		err = ErrDecodeResponse(err)
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	token.FeedURL = feedURL(s.baseURL, token.FeedPath)
+
+	return &token, nil
+}
+
+// feedURL resolves the path of a feed against the host of the aggregator.
+func feedURL(baseURL, path string) string {
+	base, err := url.Parse(baseURL)
+	if err != nil {
+		return path
+	}
+
+	return base.ResolveReference(&url.URL{Path: path}).String()
+}
+
+func (s *AggregatorService) WatchBoard(ctx context.Context, boardID, lastEventID string, handle func(dto.Activity)) error {
+	url := fmt.Sprintf("%s/board/%s/events", s.baseURL, boardID)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		err = fmt.Errorf("error creating request: %w", err)
+		s.log.Error(ctx, err.Error())
+		return err
+	}
+
+	tokens, ok := ctx.Value("tokens").(*dto.Tokens)
+	if ok {
+		req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", tokens.AccessToken))
+	}
+
+	req.Header.Set("Accept", "text/event-stream")
+	if lastEventID != "" {
+		req.Header.Set("Last-Event-ID", lastEventID)
+	}
+
+	resp, err := s.streamClient.Do(req)
+	if err != nil {
+		err = fmt.Errorf("error sending request: %w", err)
+		s.log.Error(ctx, err.Error())
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusUnauthorized {
+		err = ErrUnauthorized
+		s.log.Error(ctx, err.Error())
+		return err
+	}
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusForbidden:
+		err = ErrBoardAccess
+	case http.StatusNotFound:
+		err = ErrNoBoard
+	default:
+		err = ErrWatchBoard
+	}
+
+	if err != nil {
+		s.log.Error(ctx, err.Error())
+		return err
+	}
+
+	// Events are blocks of "field: value" lines ended by a blank line. Only
+	// data matters here, since it carries the id and kind as well; comments
+	// (keep-alives) start with a colon.
+	var data strings.Builder
+	scanner := bufio.NewScanner(resp.Body)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := scanner.Text()
+
+		if line == "" {
+			if data.Len() > 0 {
+				var activity dto.Activity
+				if err := json.Unmarshal([]byte(data.String()), &activity); err != nil {
+					err = ErrDecodeResponse(err)
+					s.log.Error(ctx, err.Error())
+					return err
+				}
+				handle(activity)
+				data.Reset()
+			}
+			continue
+		}
+
+		if value, ok := strings.CutPrefix(line, "data:"); ok {
+			if data.Len() > 0 {
+				data.WriteByte('\n')
+			}
+			data.WriteString(strings.TrimPrefix(value, " "))
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		err = fmt.Errorf("error reading stream: %w", err)
+		s.log.Error(ctx, err.Error())
+		return err
+	}
+
+	return nil
+}
+
+// Stats(ctx context.Context, from, to string) ([]dto.NewUsersAndCardsStats, error)
+func (s *AggregatorService) Stats(ctx context.Context, from, to string) ([]dto.NewUsersAndCardsStats, error) {
+	url := fmt.Sprintf("%s/stats/%s/%s", s.baseURL, from, to)
+
+	method := http.MethodGet
+	resp, err := s.makeRequest(ctx, method, url, nil)
+	if err != nil {
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url)
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusUnauthorized {
+		err = ErrUnauthorized
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		err = ErrGetNewCards
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	var stats []dto.NewUsersAndCardsStats
 	if err := json.NewDecoder(resp.Body).Decode(&stats); err != nil {
 		err = ErrDecodeResponse(err)
 		s.log.Error(ctx, err.Error())
@@ -673,6 +2393,12 @@ func (s *AggregatorService) Stats(ctx context.Context, from, to string) ([]dto.N
 }
 
 func (s *AggregatorService) makeRequest(ctx context.Context, method, url string, data any) (*http.Response, error) {
+	return s.makeConditionalRequest(ctx, method, url, data, 0)
+}
+
+// makeConditionalRequest sends the version the caller has read as If-Match,
+// unless it is zero.
+func (s *AggregatorService) makeConditionalRequest(ctx context.Context, method, url string, data any, version int) (*http.Response, error) {
 	jsonBody, err := json.Marshal(data)
 	if err != nil {
 		err = fmt.Errorf("error marshaling user data: %w", err)
@@ -693,6 +2419,9 @@ func (s *AggregatorService) makeRequest(ctx context.Context, method, url string,
 	}
 
 	req.Header.Set("Content-Type", "application/json")
+	if version != 0 {
+		req.Header.Set("If-Match", strconv.Quote(strconv.Itoa(version)))
+	}
 
 	resp, err := s.httpClient.Do(req)
 	if err != nil {
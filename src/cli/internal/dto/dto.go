@@ -1,6 +1,12 @@
 package dto
 
 import (
+	"bufio"
+	"fmt"
+	"io"
+	"net/url"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -14,19 +20,146 @@ type User struct {
 }
 
 type Card struct {
-	ID          uuid.UUID `json:"id"`
-	UserID      uuid.UUID `json:"user_id"`
-	ColumnID    uuid.UUID `json:"column_id"`
-	Title       string    `json:"title"`
-	Description string    `json:"description,omitempty"`
-	Position    float64   `json:"position"`
-	CreatedAt   time.Time `json:"created_at"`
+	ID          uuid.UUID  `json:"id"`
+	UserID      uuid.UUID  `json:"user_id"`
+	ColumnID    uuid.UUID  `json:"column_id"`
+	SwimlaneID  uuid.UUID  `json:"swimlane_id"`
+	ParentID    uuid.UUID  `json:"parent_id,omitempty"`
+	Title       string     `json:"title"`
+	Description string     `json:"description,omitempty"`
+	Position    float64    `json:"position"`
+	Priority    int        `json:"priority"`
+	AssigneeID  uuid.UUID  `json:"assignee_id,omitempty"`
+	DueDate     *time.Time `json:"due_date,omitempty"`
+	Labels      []string   `json:"labels,omitempty"`
+	ArchivedAt  *time.Time `json:"archived_at,omitempty"`
+	Version     int        `json:"version"`
+	CreatedAt   time.Time  `json:"created_at"`
+	UpdatedAt   time.Time  `json:"updated_at"`
+
+	ChildCount     int `json:"child_count"`
+	DoneChildCount int `json:"done_child_count"`
+}
+
+// PriorityNames are indexed by the card priority they name.
+var PriorityNames = []string{"none", "low", "medium", "high", "urgent"}
+
+func PriorityName(priority int) string {
+	if priority < 0 || priority >= len(PriorityNames) {
+		return strconv.Itoa(priority)
+	}
+	return PriorityNames[priority]
+}
+
+// ParsePriority accepts either a priority name or its number.
+func ParsePriority(s string) (int, error) {
+	for i, name := range PriorityNames {
+		if strings.EqualFold(s, name) {
+			return i, nil
+		}
+	}
+
+	priority, err := strconv.Atoi(s)
+	if err != nil || priority < 0 || priority >= len(PriorityNames) {
+		return 0, fmt.Errorf("unknown priority %q, expected one of %s", s, strings.Join(PriorityNames, ", "))
+	}
+
+	return priority, nil
+}
+
+// CardOptions are the optional card fields settable on creation.
+type CardOptions struct {
+	SwimlaneID string
+	ParentID   string
+	Priority   string
+	AssigneeID string
+	DueDate    string // DD-MM-YYYY
+	Labels     []string
+	// TemplateID makes the card from a template of its board; the title
+	// may then be left empty for the template to give it.
+	TemplateID string
+}
+
+// CardTemplateOptions are the optional card template fields. Checklists go
+// "title:item;item".
+type CardTemplateOptions struct {
+	TitlePattern string
+	Description  string
+	Labels       []string
+	Checklists   []string
+}
+
+// CardQuery holds the card listing filters sent to the aggregator.
+type CardQuery struct {
+	SwimlaneID  string
+	Priority    []string
+	UserID      string
+	AssigneeID  string
+	Label       string
+	Text        string
+	CreatedFrom string
+	CreatedTo   string
+	UpdatedFrom string
+	UpdatedTo   string
+	DueFrom     string
+	DueTo       string
+	Archived    bool
+	Sort        string
+	Descending  bool
+	Limit       int
+}
+
+func (q CardQuery) Values() url.Values {
+	values := url.Values{}
+	params := map[string]string{
+		"swimlane_id":  q.SwimlaneID,
+		"user_id":      q.UserID,
+		"assignee_id":  q.AssigneeID,
+		"label":        q.Label,
+		"q":            q.Text,
+		"created_from": q.CreatedFrom,
+		"created_to":   q.CreatedTo,
+		"updated_from": q.UpdatedFrom,
+		"updated_to":   q.UpdatedTo,
+		"due_from":     q.DueFrom,
+		"due_to":       q.DueTo,
+		"sort":         q.Sort,
+	}
+	for name, value := range params {
+		if value != "" {
+			values.Set(name, value)
+		}
+	}
+	for _, p := range q.Priority {
+		values.Add("priority", p)
+	}
+	if q.Archived {
+		values.Set("archived", "true")
+	}
+	if q.Descending {
+		values.Set("order", "desc")
+	}
+	if q.Limit > 0 {
+		values.Set("limit", strconv.Itoa(q.Limit))
+	}
+	return values
 }
 
 type Board struct {
-	ID     uuid.UUID `json:"id"`
-	UserID uuid.UUID `json:"user_id"`
-	Title  string    `json:"title"`
+	ID          uuid.UUID  `json:"id"`
+	UserID      uuid.UUID  `json:"user_id"`
+	WorkspaceID *uuid.UUID `json:"workspace_id,omitempty"`
+	Title       string     `json:"title"`
+	Version     int        `json:"version"`
+}
+
+// MarkedBoard is a board with the user's star on it and the last time they
+// opened it, if lately.
+type MarkedBoard struct {
+	Board
+	Starred   bool       `json:"starred"`
+	StarredAt *time.Time `json:"starred_at,omitempty"`
+	ViewedAt  *time.Time `json:"viewed_at,omitempty"`
 }
 
 type Column struct {
@@ -35,6 +168,47 @@ type Column struct {
 	BoardID  uuid.UUID `json:"board_id"`
 	Title    string    `json:"title"`
 	Position float64   `json:"position"`
+	Done     bool      `json:"done"`
+	Version  int       `json:"version"`
+}
+
+type Swimlane struct {
+	ID       uuid.UUID `json:"id"`
+	UserID   uuid.UUID `json:"user_id"`
+	BoardID  uuid.UUID `json:"board_id"`
+	Title    string    `json:"title"`
+	Position float64   `json:"position"`
+	Version  int       `json:"version"`
+}
+
+// BoardSnapshot is a whole board at once: its cards grouped by swimlane, then
+// by column.
+type BoardSnapshot struct {
+	Board     Board          `json:"board"`
+	Swimlanes []LaneSnapshot `json:"swimlanes"`
+}
+
+type LaneSnapshot struct {
+	Swimlane
+	Columns []ColumnSnapshot `json:"columns"`
+}
+
+type ColumnSnapshot struct {
+	Column
+	Cards []Card `json:"cards"`
+}
+
+// Activity is a change of a board, as sent by its event stream. Only the
+// part of Board, Column, Swimlane and Card that matches the kind is set.
+type Activity struct {
+	ID        int64     `json:"id"`
+	BoardID   uuid.UUID `json:"board_id"`
+	Kind      string    `json:"kind"`
+	Board     *Board    `json:"board,omitempty"`
+	Column    *Column   `json:"column,omitempty"`
+	Swimlane  *Swimlane `json:"swimlane,omitempty"`
+	Card      *Card     `json:"card,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
 }
 
 type RegisterRequest struct {
@@ -101,6 +275,35 @@ type UpdateCardRequest struct {
 	CreateCardRequest
 }
 
+// MoveColumnRequest moves a column with its cards to the end of another
+// board.
+type MoveColumnRequest struct {
+	ID      uuid.UUID `json:"id"`
+	BoardID uuid.UUID `json:"board_id"`
+}
+
+// MergeBoardsRequest moves every column of the source board to the end of
+// the target one. Strategy says what becomes of a source column titled like
+// a target one: combine puts its cards in that column, keep moves it as it
+// is and rename moves it titled after its board.
+type MergeBoardsRequest struct {
+	SourceID uuid.UUID `json:"source_id"`
+	TargetID uuid.UUID `json:"target_id"`
+	Strategy string    `json:"strategy"`
+}
+
+type BoardMerge struct {
+	Moved    []Column `json:"moved"`
+	Combined []Column `json:"combined"`
+}
+
+// SetCardParentRequest puts a card under another one; an empty parent_id
+// moves it back to the top level.
+type SetCardParentRequest struct {
+	ID       uuid.UUID `json:"id"`
+	ParentID uuid.UUID `json:"parent_id,omitempty"`
+}
+
 type UserBase struct {
 	ID       uuid.UUID
 	Username string
@@ -120,3 +323,356 @@ type NewUsersAndCardsStats struct {
 	Cards              []CardBase `json:"cards"`
 	NumCardsByNewUsers int        `json:"num_cards_by_new_users"`
 }
+
+type CardOp struct {
+	Op         string    `json:"op"`
+	CardID     uuid.UUID `json:"card_id"`
+	Version    int       `json:"version"`
+	ColumnID   uuid.UUID `json:"column_id,omitempty"`
+	Label      string    `json:"label,omitempty"`
+	AssigneeID uuid.UUID `json:"assignee_id,omitempty"`
+	Cascade    *bool     `json:"cascade,omitempty"`
+}
+
+type BulkCardsRequest struct {
+	AllOrNothing bool     `json:"all_or_nothing"`
+	Ops          []CardOp `json:"ops"`
+}
+
+type CardOpResult struct {
+	Index  int       `json:"index"`
+	CardID uuid.UUID `json:"card_id"`
+	Status string    `json:"status"`
+	Error  string    `json:"error,omitempty"`
+}
+
+type BulkCardsResponse struct {
+	Results []CardOpResult `json:"results"`
+}
+
+// TimeEntry is time a user spent on a card, Duration in seconds. A running
+// timer has no EndedAt.
+type TimeEntry struct {
+	ID        uuid.UUID  `json:"id"`
+	CardID    uuid.UUID  `json:"card_id"`
+	UserID    uuid.UUID  `json:"user_id"`
+	StartedAt time.Time  `json:"started_at"`
+	EndedAt   *time.Time `json:"ended_at,omitempty"`
+	Duration  int64      `json:"duration"`
+	Note      string     `json:"note,omitempty"`
+}
+
+type StartTimerRequest struct {
+	CardID uuid.UUID `json:"card_id"`
+	Note   string    `json:"note,omitempty"`
+}
+
+// TimeReportRow totals the time a user logged on a card over a day,
+// Duration in seconds.
+type TimeReportRow struct {
+	Day       time.Time `json:"day"`
+	UserID    uuid.UUID `json:"user_id"`
+	CardID    uuid.UUID `json:"card_id"`
+	CardTitle string    `json:"card_title"`
+	Duration  int64     `json:"duration"`
+}
+
+// Checklist is rendered as a Markdown task list at the end of the
+// description of the cards made from its template.
+type Checklist struct {
+	Title string   `json:"title"`
+	Items []string `json:"items"`
+}
+
+type CardTemplateRequest struct {
+	BoardID      uuid.UUID   `json:"board_id"`
+	Name         string      `json:"name"`
+	TitlePattern string      `json:"title_pattern,omitempty"`
+	Description  string      `json:"description,omitempty"`
+	Labels       []string    `json:"labels,omitempty"`
+	Checklists   []Checklist `json:"checklists,omitempty"`
+}
+
+// CardTemplate fills in new cards of its board. Its title pattern and
+// description may hold {{date}}, {{user}}, {{board}}, {{title}} and
+// {{description}}.
+type CardTemplate struct {
+	ID           uuid.UUID   `json:"id"`
+	BoardID      uuid.UUID   `json:"board_id"`
+	Name         string      `json:"name"`
+	TitlePattern string      `json:"title_pattern"`
+	Description  string      `json:"description"`
+	Labels       []string    `json:"labels"`
+	Checklists   []Checklist `json:"checklists"`
+	CreatedBy    uuid.UUID   `json:"created_by"`
+}
+
+type CreateSprintRequest struct {
+	BoardID   uuid.UUID `json:"board_id"`
+	Name      string    `json:"name"`
+	StartDate time.Time `json:"start_date"`
+	EndDate   time.Time `json:"end_date"`
+}
+
+// Sprint runs from StartDate to EndDate, both days included.
+type Sprint struct {
+	ID        uuid.UUID  `json:"id"`
+	BoardID   uuid.UUID  `json:"board_id"`
+	Name      string     `json:"name"`
+	StartDate time.Time  `json:"start_date"`
+	EndDate   time.Time  `json:"end_date"`
+	ClosedAt  *time.Time `json:"closed_at,omitempty"`
+}
+
+type AddSprintCardRequest struct {
+	CardID uuid.UUID `json:"card_id"`
+	Points int       `json:"points"`
+}
+
+type SprintCard struct {
+	CardID    uuid.UUID `json:"card_id"`
+	CardTitle string    `json:"card_title,omitempty"`
+	Points    int       `json:"points"`
+	Done      bool      `json:"done"`
+}
+
+type CloseSprintRequest struct {
+	NextSprintID *uuid.UUID `json:"next_sprint_id,omitempty"`
+}
+
+type CloseSprintResponse struct {
+	NextSprint *Sprint `json:"next_sprint,omitempty"`
+	Carried    int     `json:"carried"`
+}
+
+// BurndownPoint is the work left in a sprint at the end of a day.
+type BurndownPoint struct {
+	Day         time.Time `json:"day"`
+	Cards       int       `json:"cards"`
+	Points      int       `json:"points"`
+	IdealPoints float64   `json:"ideal_points"`
+}
+
+// CalendarToken opens the calendar feed at FeedPath on the aggregator
+// without logging in; FeedURL is the full link to it.
+type CalendarToken struct {
+	Token     string    `json:"token"`
+	FeedPath  string    `json:"feed_path"`
+	FeedURL   string    `json:"-"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// Notification kinds: a mention in a card description, or a change of a
+// card or board the user watches.
+const (
+	NotificationMention     = "mention"
+	NotificationCardCreated = "card.created"
+	NotificationCardUpdated = "card.updated"
+	NotificationCardMoved   = "card.moved"
+	NotificationCardArchive = "card.archived"
+)
+
+// Notification is an entry of the inbox of the caller.
+type Notification struct {
+	ID        uuid.UUID  `json:"id"`
+	ActorID   uuid.UUID  `json:"actor_id"`
+	ActorName string     `json:"actor_name,omitempty"`
+	Kind      string     `json:"kind"`
+	BoardID   uuid.UUID  `json:"board_id"`
+	CardID    uuid.UUID  `json:"card_id"`
+	CardTitle string     `json:"card_title"`
+	Read      bool       `json:"read"`
+	ReadAt    *time.Time `json:"read_at,omitempty"`
+	CreatedAt time.Time  `json:"created_at"`
+}
+
+type MarkNotificationsReadRequest struct {
+	IDs []uuid.UUID `json:"ids,omitempty"`
+}
+
+// Workspace member roles: admins manage the members and see every board,
+// members read and write the boards and viewers only read them.
+const (
+	RoleAdmin  = "admin"
+	RoleMember = "member"
+	RoleViewer = "viewer"
+)
+
+type CreateWorkspaceRequest struct {
+	Name string `json:"name"`
+}
+
+// Workspace is a workspace of the caller with the role they have in it.
+type Workspace struct {
+	ID        uuid.UUID `json:"id"`
+	Name      string    `json:"name"`
+	Personal  bool      `json:"personal"`
+	CreatedBy uuid.UUID `json:"created_by"`
+	CreatedAt time.Time `json:"created_at"`
+	Role      string    `json:"role"`
+}
+
+type WorkspaceMemberRequest struct {
+	UserID uuid.UUID `json:"user_id"`
+	Role   string    `json:"role"`
+}
+
+type WorkspaceMember struct {
+	UserID    uuid.UUID `json:"user_id"`
+	Role      string    `json:"role"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// FlowTimes sums up how long the cards done over a period took, in seconds.
+type FlowTimes struct {
+	Cards   int   `json:"cards"`
+	Average int64 `json:"average"`
+	P50     int64 `json:"p50"`
+	P85     int64 `json:"p85"`
+	P95     int64 `json:"p95"`
+}
+
+type WeekThroughput struct {
+	WeekStart time.Time `json:"week_start"`
+	Cards     int       `json:"cards"`
+}
+
+// FlowSeries has the number of cards in a column at the end of each day.
+type FlowSeries struct {
+	ColumnID    uuid.UUID `json:"column_id"`
+	ColumnTitle string    `json:"column_title"`
+	Counts      []int     `json:"counts"`
+}
+
+// BoardAnalytics describes how cards flowed across a board; each Flow
+// series has a count per day of Days.
+type BoardAnalytics struct {
+	BoardID    uuid.UUID        `json:"board_id"`
+	LeadTime   FlowTimes        `json:"lead_time"`
+	CycleTime  FlowTimes        `json:"cycle_time"`
+	Throughput []WeekThroughput `json:"throughput"`
+	Days       []time.Time      `json:"days"`
+	Flow       []FlowSeries     `json:"flow"`
+}
+
+type ColumnCards struct {
+	ColumnID    uuid.UUID `json:"column_id"`
+	ColumnTitle string    `json:"column_title"`
+	Done        bool      `json:"done"`
+	Cards       int       `json:"cards"`
+}
+
+type DayCards struct {
+	Day       time.Time `json:"day"`
+	Created   int       `json:"created"`
+	Completed int       `json:"completed"`
+}
+
+type StaleCard struct {
+	CardID      uuid.UUID  `json:"card_id"`
+	ColumnID    uuid.UUID  `json:"column_id"`
+	ColumnTitle string     `json:"column_title"`
+	Title       string     `json:"title"`
+	AssigneeID  *uuid.UUID `json:"assignee_id,omitempty"`
+	UpdatedAt   time.Time  `json:"updated_at"`
+}
+
+type Contributor struct {
+	UserID    uuid.UUID `json:"user_id"`
+	Username  string    `json:"username,omitempty"`
+	Created   int       `json:"created"`
+	Completed int       `json:"completed"`
+}
+
+// BoardStats sums up a board; Days has a count per day of the period and
+// Columns and Stale are as of now.
+type BoardStats struct {
+	BoardID      uuid.UUID     `json:"board_id"`
+	Columns      []ColumnCards `json:"columns"`
+	Days         []DayCards    `json:"days"`
+	StaleDays    int           `json:"stale_days"`
+	Stale        []StaleCard   `json:"stale"`
+	Contributors []Contributor `json:"contributors"`
+}
+
+// ParseCardOps reads one card operation per line:
+//
+//	move [card_id] [column_id]
+//	archive [card_id] [cascade|keep]   (needed for cards with sub-cards)
+//	delete [card_id]
+//	label [card_id] [label]
+//	assign [card_id] [user_id]   (no user_id unassigns)
+//
+// Blank lines and lines starting with # are skipped.
+func ParseCardOps(r io.Reader) ([]CardOp, error) {
+	var ops []CardOp
+
+	scanner := bufio.NewScanner(r)
+	for line := 1; scanner.Scan(); line++ {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 || strings.HasPrefix(fields[0], "#") {
+			continue
+		}
+
+		op, err := parseCardOp(fields)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		ops = append(ops, op)
+	}
+
+	return ops, scanner.Err()
+}
+
+func parseCardOp(fields []string) (CardOp, error) {
+	var op CardOp
+
+	args := map[string]int{"move": 3, "archive": 2, "delete": 2, "label": 3, "assign": 3}
+	want, ok := args[fields[0]]
+	if !ok {
+		return op, fmt.Errorf("unknown operation %q", fields[0])
+	}
+	// assign may leave out the user to unassign the card, and archive may
+	// say what becomes of the sub-cards
+	optional := (fields[0] == "assign" && len(fields) == 2) || (fields[0] == "archive" && len(fields) == 3)
+	if len(fields) != want && !optional {
+		return op, fmt.Errorf("%s takes %d arguments", fields[0], want-1)
+	}
+
+	cardID, err := uuid.Parse(fields[1])
+	if err != nil {
+		return op, fmt.Errorf("invalid card id %q", fields[1])
+	}
+	op.CardID = cardID
+
+	switch fields[0] {
+	case "move":
+		op.Op = "move"
+		if op.ColumnID, err = uuid.Parse(fields[2]); err != nil {
+			return op, fmt.Errorf("invalid column id %q", fields[2])
+		}
+	case "archive":
+		op.Op = "archive"
+		if len(fields) == 3 {
+			if fields[2] != "cascade" && fields[2] != "keep" {
+				return op, fmt.Errorf("unknown archive mode %q, expected cascade or keep", fields[2])
+			}
+			cascade := fields[2] == "cascade"
+			op.Cascade = &cascade
+		}
+	case "delete":
+		op.Op = "delete"
+	case "label":
+		op.Op = "set_label"
+		op.Label = fields[2]
+	case "assign":
+		op.Op = "set_assignee"
+		if len(fields) == 3 {
+			if op.AssigneeID, err = uuid.Parse(fields[2]); err != nil {
+				return op, fmt.Errorf("invalid user id %q", fields[2])
+			}
+		}
+	}
+
+	return op, nil
+}
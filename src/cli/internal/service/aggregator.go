@@ -3,8 +3,22 @@ package service
 import (
 	"cli/internal/dto"
 	"context"
+	"errors"
+
+	"github.com/google/uuid"
 )
 
+// ErrConflict is returned when a write is refused because someone else has
+// changed the entity since it was read.
+var ErrConflict = errors.New("Changed by someone else since it was read")
+
+// ErrWatchTarget is returned when watching something other than a board or
+// a card.
+var ErrWatchTarget = errors.New("Only a board or a card can be watched")
+
+// ErrNoTimerRunning is returned when stopping a timer while none is running.
+var ErrNoTimerRunning = errors.New("No timer is running")
+
 type AggregatorService interface {
 	Register(ctx context.Context, username, email, password string) (*dto.Tokens, error)
 	Login(ctx context.Context, email, password string) (*dto.Tokens, error)
@@ -13,21 +27,100 @@ type AggregatorService interface {
 	Logout(ctx context.Context, refreshToken string) error
 
 	ShowBoards(ctx context.Context) ([]dto.Board, error)
-	ShowBoard(ctx context.Context, boardID string) ([]dto.Column, error)
-	ShowColumn(ctx context.Context, columnID string) ([]dto.Card, error)
+	// ShowStarredBoards lists the boards of the caller starred first, then
+	// the ones they opened lately and then the rest.
+	ShowStarredBoards(ctx context.Context) ([]dto.MarkedBoard, error)
+	RecentBoards(ctx context.Context) ([]dto.MarkedBoard, error)
+	ShowBoard(ctx context.Context, boardID string) (*dto.BoardSnapshot, error)
+	ShowColumn(ctx context.Context, columnID string, query dto.CardQuery) ([]dto.Card, error)
+	ShowBoardCards(ctx context.Context, boardID string, query dto.CardQuery) ([]dto.Card, error)
 	ShowCard(ctx context.Context, cardID string) (*dto.Card, error)
+	ShowCardChildren(ctx context.Context, cardID string) ([]dto.Card, error)
+	ShowCardAncestors(ctx context.Context, cardID string) ([]dto.Card, error)
+	GetBoard(ctx context.Context, boardID string) (*dto.Board, error)
+	GetColumn(ctx context.Context, columnID string) (*dto.Column, error)
+	GetSwimlane(ctx context.Context, swimlaneID string) (*dto.Swimlane, error)
 
 	CreateBoard(ctx context.Context, board dto.Board) error
 	CreateColumn(ctx context.Context, column dto.Column) error
+	CreateSwimlane(ctx context.Context, swimlane dto.Swimlane) error
 	CreateCard(ctx context.Context, card dto.Card) error
 
 	UpdateBoard(ctx context.Context, board *dto.Board) error
 	UpdateColumn(ctx context.Context, column *dto.Column) error
+	UpdateSwimlane(ctx context.Context, swimlane *dto.Swimlane) error
 	UpdateCard(ctx context.Context, card *dto.Card) error
+	SetCardParent(ctx context.Context, card *dto.Card) error
+
+	DeleteBoard(ctx context.Context, id string, version int) error
+	DeleteColumn(ctx context.Context, id string, version int) error
+	DeleteSwimlane(ctx context.Context, id string, version int) error
+	DeleteCard(ctx context.Context, id string, version int) error
+
+	BulkCards(ctx context.Context, req dto.BulkCardsRequest) (*dto.BulkCardsResponse, error)
+
+	// MoveColumn moves the column with its cards to the end of
+	// column.BoardID and updates column from the answer.
+	MoveColumn(ctx context.Context, column *dto.Column) error
+	MergeBoards(ctx context.Context, req dto.MergeBoardsRequest) (*dto.BoardMerge, error)
+
+	StarBoard(ctx context.Context, boardID string) error
+	UnstarBoard(ctx context.Context, boardID string) error
+
+	// Watch has the caller notified of the changes of a board or a card, by
+	// target; Unwatch stops it.
+	Watch(ctx context.Context, target, id string) error
+	Unwatch(ctx context.Context, target, id string) error
+	Inbox(ctx context.Context, unreadOnly bool, limit int) ([]dto.Notification, error)
+	// MarkRead marks the notifications with the ids read, or every one
+	// without any.
+	MarkRead(ctx context.Context, ids []uuid.UUID) error
+
+	StartTimer(ctx context.Context, req dto.StartTimerRequest) (*dto.TimeEntry, error)
+	StopTimer(ctx context.Context) (*dto.TimeEntry, error)
+	// TimeReport reports the time logged on a board, or by the caller when
+	// boardID is empty, from one DD-MM-YYYY date to another, both included.
+	TimeReport(ctx context.Context, boardID, from, to string) ([]dto.TimeReportRow, error)
+	// BoardAnalytics reports on the flow of cards across a board from one
+	// DD-MM-YYYY date to another, both included.
+	BoardAnalytics(ctx context.Context, boardID, from, to string) (*dto.BoardAnalytics, error)
+	// BoardStats sums up a board the caller is a member of from one
+	// DD-MM-YYYY date to another, both included; staleDays may be empty.
+	BoardStats(ctx context.Context, boardID, from, to, staleDays string) (*dto.BoardStats, error)
+
+	CreateSprint(ctx context.Context, req dto.CreateSprintRequest) (*dto.Sprint, error)
+	GetSprint(ctx context.Context, id string) (*dto.Sprint, error)
+	GetBoardSprints(ctx context.Context, boardID string) ([]dto.Sprint, error)
+	GetSprintCards(ctx context.Context, sprintID string) ([]dto.SprintCard, error)
+	AddSprintCard(ctx context.Context, sprintID string, req dto.AddSprintCardRequest) (*dto.SprintCard, error)
+	RemoveSprintCard(ctx context.Context, sprintID, cardID string) error
+	CloseSprint(ctx context.Context, sprintID string, req dto.CloseSprintRequest) (*dto.CloseSprintResponse, error)
+	SprintBurndown(ctx context.Context, sprintID string) ([]dto.BurndownPoint, error)
+
+	// CreateCardFromTemplate creates card through the template, which gives
+	// it its title pattern, description, labels and checklists.
+	CreateCardFromTemplate(ctx context.Context, templateID string, card dto.Card) error
+	CreateCardTemplate(ctx context.Context, req dto.CardTemplateRequest) (*dto.CardTemplate, error)
+	GetBoardCardTemplates(ctx context.Context, boardID string) ([]dto.CardTemplate, error)
+	DeleteCardTemplate(ctx context.Context, id string) error
+
+	CreateWorkspace(ctx context.Context, name string) (*dto.Workspace, error)
+	GetWorkspaces(ctx context.Context) ([]dto.Workspace, error)
+	GetWorkspaceMembers(ctx context.Context, workspaceID string) ([]dto.WorkspaceMember, error)
+	// GetWorkspaceBoards lists every board of a workspace; only its admins
+	// may.
+	GetWorkspaceBoards(ctx context.Context, workspaceID string) ([]dto.Board, error)
+	AddWorkspaceMember(ctx context.Context, workspaceID string, req dto.WorkspaceMemberRequest) error
+	UpdateWorkspaceMember(ctx context.Context, workspaceID string, req dto.WorkspaceMemberRequest) error
+	RemoveWorkspaceMember(ctx context.Context, workspaceID, userID string) error
+
+	// RegenerateCalendarToken gives the caller a new calendar feed link; the
+	// old one stops working.
+	RegenerateCalendarToken(ctx context.Context) (*dto.CalendarToken, error)
 
-	DeleteBoard(ctx context.Context, id string) error
-	DeleteColumn(ctx context.Context, id string) error
-	DeleteCard(ctx context.Context, id string) error
+	// WatchBoard calls handle for every change of a board after lastEventID,
+	// or from now on when it is empty, until the stream ends or ctx is done.
+	WatchBoard(ctx context.Context, boardID, lastEventID string, handle func(dto.Activity)) error
 
 	Stats(ctx context.Context, from, to string) ([]dto.NewUsersAndCardsStats, error)
 }
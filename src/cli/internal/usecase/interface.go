@@ -3,6 +3,7 @@ package usecase
 import (
 	"cli/internal/dto"
 	"context"
+	"io"
 )
 
 type Client interface {
@@ -14,23 +15,101 @@ type Client interface {
 
 	// context with value tokens
 	ShowBoards(ctx context.Context)
+	// ShowStarredBoards lists the boards starred first, then the ones opened
+	// lately.
+	ShowStarredBoards(ctx context.Context)
+	RecentBoards(ctx context.Context)
+	// StarBoard stars a board, or takes the star off when off is set.
+	StarBoard(ctx context.Context, boardIDstr string, off bool)
+	// Watch sends the changes of a board or a card, by target, to the inbox
+	// of the user, or stops it when off is set.
+	Watch(ctx context.Context, target, idStr string, off bool)
+	// ShowInbox lists the latest limit notifications of the user.
+	ShowInbox(ctx context.Context, unreadOnly bool, limit int)
+	// MarkRead marks the notifications with the ids read, or every one
+	// without any.
+	MarkRead(ctx context.Context, idStrs []string)
 	ShowBoard(ctx context.Context, boardID string)
-	ShowColumn(ctx context.Context, columnID string)
+	ShowColumn(ctx context.Context, columnID string, query dto.CardQuery)
+	ShowBoardCards(ctx context.Context, boardID string, query dto.CardQuery)
 	ShowCard(ctx context.Context, cardID string)
 
-	CreateBoard(ctx context.Context, title string)
+	// CreateBoard creates a board in the workspace, or in the personal one
+	// of the user when workspaceIDstr is empty.
+	CreateBoard(ctx context.Context, title, workspaceIDstr string)
 	CreateColumn(ctx context.Context, boardID, title string)
-	CreateCard(ctx context.Context, columnID, title, description string)
+	CreateSwimlane(ctx context.Context, boardID, title string)
+	CreateCard(ctx context.Context, columnID, title, description string, opts dto.CardOptions)
 
 	UpdateBoard(ctx context.Context, boardID, title string)
 	UpdateColumn(ctx context.Context, columnID, title string)
+	UpdateColumnDone(ctx context.Context, columnID, done string)
+	UpdateSwimlane(ctx context.Context, swimlaneID, title string)
 	UpdateCardTitle(ctx context.Context, cardID, title string)
 	UpdateCardDescription(ctx context.Context, cardID, description string)
-	MoveCard(ctx context.Context, cardIDstr, columnIDstr string)
+	UpdateCardPriority(ctx context.Context, cardID, priority string)
+	MoveCard(ctx context.Context, cardIDstr, columnIDstr, swimlaneIDstr string)
+	// MoveColumn moves a column with its cards to the end of another board.
+	MoveColumn(ctx context.Context, columnIDstr, boardIDstr string)
+	// MergeBoards moves every column of one board to the end of another,
+	// dealing with columns of the same title by the strategy: combine, keep
+	// or rename.
+	MergeBoards(ctx context.Context, sourceIDstr, targetIDstr, strategy string)
+	SetCardParent(ctx context.Context, cardIDstr, parentIDstr string)
+	// ArchiveCard asks on confirm whether to archive the sub-cards too, if
+	// the card has any.
+	ArchiveCard(ctx context.Context, cardIDstr string, confirm io.Reader)
 
 	DeleteBoard(ctx context.Context, id string)
 	DeleteColumn(ctx context.Context, id string)
+	DeleteSwimlane(ctx context.Context, id string)
 	DeleteCard(ctx context.Context, id string)
 
+	BulkCards(ctx context.Context, input io.Reader, allOrNothing bool)
+
+	WatchBoard(ctx context.Context, boardID string)
+
+	StartTimer(ctx context.Context, cardIDstr, note string)
+	StopTimer(ctx context.Context)
+	// TimeReport prints the time logged on a board, or by the user when
+	// boardID is empty, per day. Dates default to the last seven days.
+	TimeReport(ctx context.Context, boardID, from, to string)
+	// BoardAnalytics prints the lead and cycle times of a board and charts
+	// its weekly throughput and cumulative flow. Dates default to the last
+	// four weeks.
+	BoardAnalytics(ctx context.Context, boardID, from, to string)
+	// BoardStats prints the cards per column of a board, charts the cards
+	// created and completed per day, and lists its stale cards and most
+	// active contributors. Dates default to the last four weeks.
+	BoardStats(ctx context.Context, boardID, from, to string, staleDays int)
+
+	CreateSprint(ctx context.Context, boardIDstr, name, start, end string)
+	ShowSprints(ctx context.Context, boardID string)
+	ShowSprint(ctx context.Context, sprintID string)
+	AddSprintCard(ctx context.Context, sprintID, cardIDstr string, points int)
+	RemoveSprintCard(ctx context.Context, sprintID, cardID string)
+	// CloseSprint closes a sprint and carries its unfinished cards over to
+	// nextIDstr, or to the next open sprint of the board when it is empty.
+	CloseSprint(ctx context.Context, sprintID, nextIDstr string)
+	// SprintBurndown charts the points left in a sprint each day against
+	// the ideal.
+	SprintBurndown(ctx context.Context, sprintID string)
+
+	CreateCardTemplate(ctx context.Context, boardIDstr, name string, opts dto.CardTemplateOptions)
+	ShowCardTemplates(ctx context.Context, boardID string)
+	DeleteCardTemplate(ctx context.Context, id string)
+
+	CreateWorkspace(ctx context.Context, name string)
+	ShowWorkspaces(ctx context.Context)
+	ShowWorkspaceMembers(ctx context.Context, workspaceID string)
+	ShowWorkspaceBoards(ctx context.Context, workspaceID string)
+	AddWorkspaceMember(ctx context.Context, workspaceID, userIDstr, role string)
+	UpdateWorkspaceMember(ctx context.Context, workspaceID, userIDstr, role string)
+	RemoveWorkspaceMember(ctx context.Context, workspaceID, userID string)
+
+	// RegenerateCalendarToken prints a new link to the calendar feed of the
+	// user's cards due; the old link stops working.
+	RegenerateCalendarToken(ctx context.Context)
+
 	Stats(ctx context.Context, from, to string)
 }
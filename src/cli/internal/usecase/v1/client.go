@@ -5,11 +5,18 @@ import (
 	"cli/internal/service"
 	"cli/internal/usecase"
 	"context"
+	"errors"
 	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/google/uuid"
 )
 
+const layout string = "02-01-2006"
+
 type ClientUseCase struct {
 	svc service.AggregatorService
 }
@@ -84,10 +91,11 @@ func (uc *ClientUseCase) ShowBoards(ctx context.Context) {
 	}
 }
 
-func (uc *ClientUseCase) ShowBoard(ctx context.Context, boardID string) {
+func (uc *ClientUseCase) ShowStarredBoards(ctx context.Context) {
 	tokens, ok := ctx.Value("tokens").(*dto.Tokens)
 	if !ok {
 		fmt.Println("failed to get tokens from context")
+		return
 	}
 
 	_, err := uc.svc.Validate(ctx, tokens.AccessToken)
@@ -110,19 +118,17 @@ func (uc *ClientUseCase) ShowBoard(ctx context.Context, boardID string) {
 		fn(tokens)
 	}
 
-	columns, err := uc.svc.ShowBoard(ctx, boardID)
+	boards, err := uc.svc.ShowStarredBoards(ctx)
 
 	if err != nil {
 		fmt.Printf("Error: %s\n", err)
 		return
 	}
 
-	for i, column := range columns {
-		fmt.Printf("%d. %s\nTitle: %s\n", i+1, column.ID, column.Title)
-	}
+	printMarkedBoards(boards)
 }
 
-func (uc *ClientUseCase) ShowColumn(ctx context.Context, columnID string) {
+func (uc *ClientUseCase) RecentBoards(ctx context.Context) {
 	tokens, ok := ctx.Value("tokens").(*dto.Tokens)
 	if !ok {
 		fmt.Println("failed to get tokens from context")
@@ -132,13 +138,13 @@ func (uc *ClientUseCase) ShowColumn(ctx context.Context, columnID string) {
 	_, err := uc.svc.Validate(ctx, tokens.AccessToken)
 	if err != nil {
 		// fmt.Println("Access token expired. Refreshing.")
-		refreshResp, err := uc.svc.Refresh(ctx, tokens.RefreshToken)
+		resp, err := uc.svc.Refresh(ctx, tokens.RefreshToken)
 		if err != nil {
 			fmt.Println("Please log in again.")
 			return
 		}
 
-		tokens.AccessToken = refreshResp.AccessToken
+		tokens.AccessToken = resp.AccessToken
 
 		fn, ok := ctx.Value("saveFunc").(func(*dto.Tokens))
 		if !ok {
@@ -149,19 +155,39 @@ func (uc *ClientUseCase) ShowColumn(ctx context.Context, columnID string) {
 		fn(tokens)
 	}
 
-	cards, err := uc.svc.ShowColumn(ctx, columnID)
+	boards, err := uc.svc.RecentBoards(ctx)
 
 	if err != nil {
 		fmt.Printf("Error: %s\n", err)
 		return
 	}
 
-	for i, card := range cards {
-		fmt.Printf("%d. %s\nTitle: %s\n", i+1, card.ID, card.Title)
+	if len(boards) == 0 {
+		fmt.Println("No boards viewed lately.")
+		return
 	}
+
+	printMarkedBoards(boards)
 }
 
-func (uc *ClientUseCase) ShowCard(ctx context.Context, cardID string) {
+// printMarkedBoards lists boards like ShowBoards does, with a star on the
+// starred ones and when they were last viewed.
+func printMarkedBoards(boards []dto.MarkedBoard) {
+	for i, board := range boards {
+		star := ""
+		if board.Starred {
+			star = " *"
+		}
+
+		fmt.Printf("%d. %s%s\nTitle: %s\n", i+1, board.ID, star, board.Title)
+
+		if board.ViewedAt != nil {
+			fmt.Printf("Viewed: %s\n", board.ViewedAt.Format("02-01-2006 15:04"))
+		}
+	}
+}
+
+func (uc *ClientUseCase) StarBoard(ctx context.Context, boardIDstr string, off bool) {
 	tokens, ok := ctx.Value("tokens").(*dto.Tokens)
 	if !ok {
 		fmt.Println("failed to get tokens from context")
@@ -171,13 +197,13 @@ func (uc *ClientUseCase) ShowCard(ctx context.Context, cardID string) {
 	_, err := uc.svc.Validate(ctx, tokens.AccessToken)
 	if err != nil {
 		// fmt.Println("Access token expired. Refreshing.")
-		refreshResp, err := uc.svc.Refresh(ctx, tokens.RefreshToken)
+		resp, err := uc.svc.Refresh(ctx, tokens.RefreshToken)
 		if err != nil {
 			fmt.Println("Please log in again.")
 			return
 		}
 
-		tokens.AccessToken = refreshResp.AccessToken
+		tokens.AccessToken = resp.AccessToken
 
 		fn, ok := ctx.Value("saveFunc").(func(*dto.Tokens))
 		if !ok {
@@ -188,134 +214,84 @@ func (uc *ClientUseCase) ShowCard(ctx context.Context, cardID string) {
 		fn(tokens)
 	}
 
-	card, err := uc.svc.ShowCard(ctx, cardID)
-
+	boardID, err := uuid.Parse(boardIDstr)
 	if err != nil {
-		fmt.Printf("Error: %s\n", err)
-		return
-	}
-
-	fmt.Printf("Title: %s\nDescription: %s\n", card.Title, card.Description)
-}
-
-func (uc *ClientUseCase) CreateBoard(ctx context.Context, title string) {
-	tokens, ok := ctx.Value("tokens").(*dto.Tokens)
-	if !ok {
-		fmt.Println("failed to get tokens from context")
+		fmt.Println("failed parsing board uuid")
 		return
 	}
 
-	resp, err := uc.svc.Validate(ctx, tokens.AccessToken)
-	if err != nil {
-		fmt.Println("access token expired")
-		return
+	if off {
+		err = uc.svc.UnstarBoard(ctx, boardID.String())
+	} else {
+		err = uc.svc.StarBoard(ctx, boardID.String())
 	}
 
-	userID, err := uuid.Parse(resp.UserID)
 	if err != nil {
-		fmt.Println("failed parsing user uuid")
+		fmt.Printf("Error: %s\n", err)
 		return
 	}
 
-	board := dto.Board{
-		UserID: userID,
-		Title:  title,
-	}
-
-	err = uc.svc.CreateBoard(ctx, board)
-
-	if err != nil {
-		fmt.Printf("Error: %s\n", err)
+	if off {
+		fmt.Println("Board unstarred")
 		return
 	}
 
-	fmt.Println("Board successfully created.")
+	fmt.Println("Board starred")
 }
 
-func (uc *ClientUseCase) CreateColumn(ctx context.Context, boardIDstr, title string) {
+func (uc *ClientUseCase) Watch(ctx context.Context, target, idStr string, off bool) {
 	tokens, ok := ctx.Value("tokens").(*dto.Tokens)
 	if !ok {
 		fmt.Println("failed to get tokens from context")
 		return
 	}
 
-	resp, err := uc.svc.Validate(ctx, tokens.AccessToken)
-	if err != nil {
-		fmt.Println("access token expired")
-		return
-	}
-
-	userID, err := uuid.Parse(resp.UserID)
-	if err != nil {
-		fmt.Println("failed parsing user uuid")
-		return
-	}
-
-	boardID, err := uuid.Parse(boardIDstr)
+	_, err := uc.svc.Validate(ctx, tokens.AccessToken)
 	if err != nil {
-		fmt.Println("failed parsing board uuid")
-		return
-	}
-
-	column := dto.Column{
-		UserID:  userID,
-		BoardID: boardID,
-		Title:   title,
-	}
-
-	err = uc.svc.CreateColumn(ctx, column)
+		// fmt.Println("Access token expired. Refreshing.")
+		resp, err := uc.svc.Refresh(ctx, tokens.RefreshToken)
+		if err != nil {
+			fmt.Println("Please log in again.")
+			return
+		}
 
-	if err != nil {
-		fmt.Printf("Error: %s\n", err)
-		return
-	}
+		tokens.AccessToken = resp.AccessToken
 
-	fmt.Println("Column successfully created.")
-}
+		fn, ok := ctx.Value("saveFunc").(func(*dto.Tokens))
+		if !ok {
+			fmt.Println("failed to get saveFunc from context")
+			return
+		}
 
-func (uc *ClientUseCase) CreateCard(ctx context.Context, columnIDstr, title, description string) {
-	tokens, ok := ctx.Value("tokens").(*dto.Tokens)
-	if !ok {
-		fmt.Println("failed to get tokens from context")
-		return
+		fn(tokens)
 	}
 
-	resp, err := uc.svc.Validate(ctx, tokens.AccessToken)
+	id, err := uuid.Parse(idStr)
 	if err != nil {
-		fmt.Println("access token expired")
+		fmt.Printf("failed parsing %s uuid\n", target)
 		return
 	}
 
-	userID, err := uuid.Parse(resp.UserID)
-	if err != nil {
-		fmt.Println("failed parsing user uuid")
-		return
+	if off {
+		err = uc.svc.Unwatch(ctx, target, id.String())
+	} else {
+		err = uc.svc.Watch(ctx, target, id.String())
 	}
 
-	columnID, err := uuid.Parse(columnIDstr)
 	if err != nil {
-		fmt.Println("failed parsing column uuid")
+		fmt.Printf("Error: %s\n", err)
 		return
 	}
 
-	card := dto.Card{
-		UserID:      userID,
-		ColumnID:    columnID,
-		Title:       title,
-		Description: description,
-	}
-
-	err = uc.svc.CreateCard(ctx, card)
-
-	if err != nil {
-		fmt.Printf("Error: %s\n", err)
+	if off {
+		fmt.Printf("Stopped watching %s\n", target)
 		return
 	}
 
-	fmt.Println("Card successfully created.")
+	fmt.Printf("Watching %s; its changes go to your inbox\n", target)
 }
 
-func (uc *ClientUseCase) UpdateBoard(ctx context.Context, boardIDstr, title string) {
+func (uc *ClientUseCase) ShowInbox(ctx context.Context, unreadOnly bool, limit int) {
 	tokens, ok := ctx.Value("tokens").(*dto.Tokens)
 	if !ok {
 		fmt.Println("failed to get tokens from context")
@@ -325,13 +301,13 @@ func (uc *ClientUseCase) UpdateBoard(ctx context.Context, boardIDstr, title stri
 	_, err := uc.svc.Validate(ctx, tokens.AccessToken)
 	if err != nil {
 		// fmt.Println("Access token expired. Refreshing.")
-		refreshResp, err := uc.svc.Refresh(ctx, tokens.RefreshToken)
+		resp, err := uc.svc.Refresh(ctx, tokens.RefreshToken)
 		if err != nil {
 			fmt.Println("Please log in again.")
 			return
 		}
 
-		tokens.AccessToken = refreshResp.AccessToken
+		tokens.AccessToken = resp.AccessToken
 
 		fn, ok := ctx.Value("saveFunc").(func(*dto.Tokens))
 		if !ok {
@@ -342,28 +318,54 @@ func (uc *ClientUseCase) UpdateBoard(ctx context.Context, boardIDstr, title stri
 		fn(tokens)
 	}
 
-	boardID, err := uuid.Parse(boardIDstr)
+	notifications, err := uc.svc.Inbox(ctx, unreadOnly, limit)
+
 	if err != nil {
-		fmt.Println("failed parsing board uuid")
+		fmt.Printf("Error: %s\n", err)
 		return
 	}
 
-	board := dto.Board{
-		ID:    boardID,
-		Title: title,
+	if len(notifications) == 0 {
+		fmt.Println("Inbox is empty.")
+		return
 	}
 
-	err = uc.svc.UpdateBoard(ctx, &board)
+	for i, n := range notifications {
+		unread := ""
+		if !n.Read {
+			unread = " (unread)"
+		}
+
+		actor := n.ActorName
+		if actor == "" {
+			actor = n.ActorID.String()
+		}
 
-	if err != nil {
-		fmt.Printf("Error: %s\n", err)
-		return
+		fmt.Printf("%d. %s%s\n", i+1, n.ID, unread)
+		fmt.Printf("@%s %s %q\n", actor, notificationVerb(n.Kind), n.CardTitle)
+		fmt.Printf("Card: %s, %s\n", n.CardID, n.CreatedAt.Format("02-01-2006 15:04"))
 	}
+}
 
-	fmt.Println("Board successfully updated.")
+// notificationVerb says what the actor of a notification of the kind did.
+func notificationVerb(kind string) string {
+	switch kind {
+	case dto.NotificationMention:
+		return "mentioned you on"
+	case dto.NotificationCardCreated:
+		return "created"
+	case dto.NotificationCardUpdated:
+		return "updated"
+	case dto.NotificationCardMoved:
+		return "moved"
+	case dto.NotificationCardArchive:
+		return "archived"
+	}
+
+	return kind
 }
 
-func (uc *ClientUseCase) UpdateColumn(ctx context.Context, columnIDstr, title string) {
+func (uc *ClientUseCase) MarkRead(ctx context.Context, idStrs []string) {
 	tokens, ok := ctx.Value("tokens").(*dto.Tokens)
 	if !ok {
 		fmt.Println("failed to get tokens from context")
@@ -373,13 +375,13 @@ func (uc *ClientUseCase) UpdateColumn(ctx context.Context, columnIDstr, title st
 	_, err := uc.svc.Validate(ctx, tokens.AccessToken)
 	if err != nil {
 		// fmt.Println("Access token expired. Refreshing.")
-		refreshResp, err := uc.svc.Refresh(ctx, tokens.RefreshToken)
+		resp, err := uc.svc.Refresh(ctx, tokens.RefreshToken)
 		if err != nil {
 			fmt.Println("Please log in again.")
 			return
 		}
 
-		tokens.AccessToken = refreshResp.AccessToken
+		tokens.AccessToken = resp.AccessToken
 
 		fn, ok := ctx.Value("saveFunc").(func(*dto.Tokens))
 		if !ok {
@@ -390,44 +392,46 @@ func (uc *ClientUseCase) UpdateColumn(ctx context.Context, columnIDstr, title st
 		fn(tokens)
 	}
 
-	columnID, err := uuid.Parse(columnIDstr)
-	if err != nil {
-		fmt.Println("failed parsing column uuid")
-		return
-	}
-
-	column := dto.Column{
-		ID:    columnID,
-		Title: title,
+	ids := make([]uuid.UUID, len(idStrs))
+	for i, idStr := range idStrs {
+		ids[i], err = uuid.Parse(idStr)
+		if err != nil {
+			fmt.Println("failed parsing notification uuid")
+			return
+		}
 	}
 
-	err = uc.svc.UpdateColumn(ctx, &column)
+	err = uc.svc.MarkRead(ctx, ids)
 
 	if err != nil {
 		fmt.Printf("Error: %s\n", err)
 		return
 	}
 
-	fmt.Println("Column successfully updated.")
+	if len(ids) == 0 {
+		fmt.Println("Every notification marked read")
+		return
+	}
+
+	fmt.Printf("%d notification(s) marked read\n", len(ids))
 }
 
-func (uc *ClientUseCase) UpdateCardTitle(ctx context.Context, cardIDstr, title string) {
+func (uc *ClientUseCase) ShowBoard(ctx context.Context, boardID string) {
 	tokens, ok := ctx.Value("tokens").(*dto.Tokens)
 	if !ok {
 		fmt.Println("failed to get tokens from context")
-		return
 	}
 
 	_, err := uc.svc.Validate(ctx, tokens.AccessToken)
 	if err != nil {
 		// fmt.Println("Access token expired. Refreshing.")
-		refreshResp, err := uc.svc.Refresh(ctx, tokens.RefreshToken)
+		resp, err := uc.svc.Refresh(ctx, tokens.RefreshToken)
 		if err != nil {
 			fmt.Println("Please log in again.")
 			return
 		}
 
-		tokens.AccessToken = refreshResp.AccessToken
+		tokens.AccessToken = resp.AccessToken
 
 		fn, ok := ctx.Value("saveFunc").(func(*dto.Tokens))
 		if !ok {
@@ -438,28 +442,43 @@ func (uc *ClientUseCase) UpdateCardTitle(ctx context.Context, cardIDstr, title s
 		fn(tokens)
 	}
 
-	cardID, err := uuid.Parse(cardIDstr)
-	if err != nil {
-		fmt.Println("failed parsing card uuid")
-		return
-	}
-
-	card := dto.Card{
-		ID:    cardID,
-		Title: title,
-	}
-
-	err = uc.svc.UpdateCard(ctx, &card)
+	snapshot, err := uc.svc.ShowBoard(ctx, boardID)
 
 	if err != nil {
 		fmt.Printf("Error: %s\n", err)
 		return
 	}
 
-	fmt.Println("Card title successfully updated.")
+	printSnapshot(snapshot)
 }
 
-func (uc *ClientUseCase) UpdateCardDescription(ctx context.Context, cardIDstr, description string) {
+// printSnapshot prints a board lane by lane, with the cards of every column
+// indented under it.
+func printSnapshot(snapshot *dto.BoardSnapshot) {
+	fmt.Printf("Board: %s\n", snapshot.Board.Title)
+	for _, lane := range snapshot.Swimlanes {
+		fmt.Printf("\n== %s (%s)\n", lane.Title, lane.ID)
+		for _, column := range lane.Columns {
+			fmt.Printf("  %s (%s)", column.Title, column.ID)
+			if column.Done {
+				fmt.Print(" [done]")
+			}
+			fmt.Println()
+			for _, card := range column.Cards {
+				fmt.Printf("    - %s (%s)", card.Title, card.ID)
+				if card.Priority != 0 {
+					fmt.Printf(" [%s]", dto.PriorityName(card.Priority))
+				}
+				if card.ChildCount > 0 {
+					fmt.Printf(" [%d/%d]", card.DoneChildCount, card.ChildCount)
+				}
+				fmt.Println()
+			}
+		}
+	}
+}
+
+func (uc *ClientUseCase) ShowColumn(ctx context.Context, columnID string, query dto.CardQuery) {
 	tokens, ok := ctx.Value("tokens").(*dto.Tokens)
 	if !ok {
 		fmt.Println("failed to get tokens from context")
@@ -486,28 +505,22 @@ func (uc *ClientUseCase) UpdateCardDescription(ctx context.Context, cardIDstr, d
 		fn(tokens)
 	}
 
-	cardID, err := uuid.Parse(cardIDstr)
-	if err != nil {
-		fmt.Println("failed parsing card uuid")
+	if err := normalizePriorities(&query); err != nil {
+		fmt.Printf("Error: %s\n", err)
 		return
 	}
 
-	card := dto.Card{
-		ID:          cardID,
-		Description: description,
-	}
-
-	err = uc.svc.UpdateCard(ctx, &card)
+	cards, err := uc.svc.ShowColumn(ctx, columnID, query)
 
 	if err != nil {
 		fmt.Printf("Error: %s\n", err)
 		return
 	}
 
-	fmt.Println("Card description successfully updated.")
+	printCards(cards)
 }
 
-func (uc *ClientUseCase) MoveCard(ctx context.Context, cardIDstr, columnIDstr string) {
+func (uc *ClientUseCase) ShowBoardCards(ctx context.Context, boardID string, query dto.CardQuery) {
 	tokens, ok := ctx.Value("tokens").(*dto.Tokens)
 	if !ok {
 		fmt.Println("failed to get tokens from context")
@@ -534,34 +547,49 @@ func (uc *ClientUseCase) MoveCard(ctx context.Context, cardIDstr, columnIDstr st
 		fn(tokens)
 	}
 
-	cardID, err := uuid.Parse(cardIDstr)
-	if err != nil {
-		fmt.Println("failed parsing card uuid")
+	if err := normalizePriorities(&query); err != nil {
+		fmt.Printf("Error: %s\n", err)
 		return
 	}
 
-	columnID, err := uuid.Parse(columnIDstr)
+	cards, err := uc.svc.ShowBoardCards(ctx, boardID, query)
+
 	if err != nil {
-		fmt.Println("failed parsing column uuid")
+		fmt.Printf("Error: %s\n", err)
 		return
 	}
 
-	card := dto.Card{
-		ID:       cardID,
-		ColumnID: columnID,
+	printCards(cards)
+}
+
+func normalizePriorities(query *dto.CardQuery) error {
+	for i, p := range query.Priority {
+		priority, err := dto.ParsePriority(p)
+		if err != nil {
+			return err
+		}
+		query.Priority[i] = strconv.Itoa(priority)
 	}
 
-	err = uc.svc.UpdateCard(ctx, &card)
+	return nil
+}
 
-	if err != nil {
-		fmt.Printf("Error: %s\n", err)
-		return
+func printCards(cards []dto.Card) {
+	for i, card := range cards {
+		fmt.Printf("%d. %s\nTitle: %s\n", i+1, card.ID, card.Title)
+		if card.Priority != 0 {
+			fmt.Printf("Priority: %s\n", dto.PriorityName(card.Priority))
+		}
+		if card.DueDate != nil {
+			fmt.Printf("Due: %s\n", card.DueDate.Format(layout))
+		}
+		if len(card.Labels) > 0 {
+			fmt.Printf("Labels: %s\n", strings.Join(card.Labels, ", "))
+		}
 	}
-
-	fmt.Println("Card successfully moved.")
 }
 
-func (uc *ClientUseCase) DeleteBoard(ctx context.Context, id string) {
+func (uc *ClientUseCase) ShowCard(ctx context.Context, cardID string) {
 	tokens, ok := ctx.Value("tokens").(*dto.Tokens)
 	if !ok {
 		fmt.Println("failed to get tokens from context")
@@ -588,55 +616,2320 @@ func (uc *ClientUseCase) DeleteBoard(ctx context.Context, id string) {
 		fn(tokens)
 	}
 
-	err = uc.svc.DeleteBoard(ctx, id)
+	card, err := uc.svc.ShowCard(ctx, cardID)
 
 	if err != nil {
 		fmt.Printf("Error: %s\n", err)
 		return
 	}
 
-	fmt.Println("Board successfully deleted.")
-}
-
-func (uc *ClientUseCase) DeleteColumn(ctx context.Context, id string) {
-	tokens, ok := ctx.Value("tokens").(*dto.Tokens)
-	if !ok {
-		fmt.Println("failed to get tokens from context")
-		return
-	}
-
-	_, err := uc.svc.Validate(ctx, tokens.AccessToken)
-	if err != nil {
-		// fmt.Println("Access token expired. Refreshing.")
-		refreshResp, err := uc.svc.Refresh(ctx, tokens.RefreshToken)
+	if card.ParentID != uuid.Nil {
+		ancestors, err := uc.svc.ShowCardAncestors(ctx, cardID)
 		if err != nil {
-			fmt.Println("Please log in again.")
+			fmt.Printf("Error: %s\n", err)
 			return
 		}
 
-		tokens.AccessToken = refreshResp.AccessToken
-
-		fn, ok := ctx.Value("saveFunc").(func(*dto.Tokens))
-		if !ok {
-			fmt.Println("failed to get saveFunc from context")
-			return
+		// Ancestors come parent first; the breadcrumb starts at the root.
+		titles := make([]string, len(ancestors))
+		for i, ancestor := range ancestors {
+			titles[len(ancestors)-1-i] = ancestor.Title
 		}
-
-		fn(tokens)
+		fmt.Printf("Path: %s\n", strings.Join(titles, " > "))
 	}
 
-	err = uc.svc.DeleteColumn(ctx, id)
+	printCard(card)
+
+	if card.ChildCount > 0 {
+		fmt.Println("Sub-cards:")
+		uc.printCardTree(ctx, card.ID.String(), 1)
+	}
+}
 
+// printCardTree lists the sub-cards of a card and theirs in turn, indented
+// by depth.
+func (uc *ClientUseCase) printCardTree(ctx context.Context, cardID string, depth int) {
+	children, err := uc.svc.ShowCardChildren(ctx, cardID)
 	if err != nil {
 		fmt.Printf("Error: %s\n", err)
 		return
 	}
 
-	fmt.Println("Column successfully deleted.")
+	for _, child := range children {
+		fmt.Printf("%s- %s (%s)", strings.Repeat("  ", depth), child.Title, child.ID)
+		if child.ChildCount > 0 {
+			fmt.Printf(" [%d/%d]", child.DoneChildCount, child.ChildCount)
+		}
+		fmt.Println()
+
+		if child.ChildCount > 0 {
+			uc.printCardTree(ctx, child.ID.String(), depth+1)
+		}
+	}
 }
 
-func (uc *ClientUseCase) DeleteCard(ctx context.Context, id string) {
-	tokens, ok := ctx.Value("tokens").(*dto.Tokens)
+func printCard(card *dto.Card) {
+	fmt.Printf("Title: %s\n", card.Title)
+	if card.Description != "" {
+		fmt.Println("Description:")
+		for _, line := range strings.Split(renderMarkdown(card.Description, colorTerminal()), "\n") {
+			fmt.Printf("  %s\n", line)
+		}
+	}
+	fmt.Printf("Priority: %s\n", dto.PriorityName(card.Priority))
+	if card.AssigneeID != uuid.Nil {
+		fmt.Printf("Assignee: %s\n", card.AssigneeID)
+	}
+	if card.DueDate != nil {
+		fmt.Printf("Due: %s\n", card.DueDate.Format(layout))
+	}
+	if len(card.Labels) > 0 {
+		fmt.Printf("Labels: %s\n", strings.Join(card.Labels, ", "))
+	}
+	if card.ArchivedAt != nil {
+		fmt.Printf("Archived: %s\n", card.ArchivedAt.Format(layout))
+	}
+	if card.ChildCount > 0 {
+		fmt.Printf("Sub-cards done: %d/%d\n", card.DoneChildCount, card.ChildCount)
+	}
+}
+
+// The report*Conflict functions explain a refused write and show the entity
+// as the server holds it now, so that the change can be redone on top of it.
+
+func (uc *ClientUseCase) reportBoardConflict(ctx context.Context, boardID string) {
+	fmt.Println("Conflict: the board was changed by someone else, your change was not saved.")
+
+	board, err := uc.svc.GetBoard(ctx, boardID)
+	if err != nil {
+		fmt.Printf("Error: %s\n", err)
+		return
+	}
+
+	fmt.Printf("Current state:\nTitle: %s\n", board.Title)
+}
+
+func (uc *ClientUseCase) reportColumnConflict(ctx context.Context, columnID string) {
+	fmt.Println("Conflict: the column was changed by someone else, your change was not saved.")
+
+	column, err := uc.svc.GetColumn(ctx, columnID)
+	if err != nil {
+		fmt.Printf("Error: %s\n", err)
+		return
+	}
+
+	fmt.Printf("Current state:\nTitle: %s\n", column.Title)
+}
+
+func (uc *ClientUseCase) reportSwimlaneConflict(ctx context.Context, swimlaneID string) {
+	fmt.Println("Conflict: the swimlane was changed by someone else, your change was not saved.")
+
+	swimlane, err := uc.svc.GetSwimlane(ctx, swimlaneID)
+	if err != nil {
+		fmt.Printf("Error: %s\n", err)
+		return
+	}
+
+	fmt.Printf("Current state:\nTitle: %s\n", swimlane.Title)
+}
+
+func (uc *ClientUseCase) reportCardConflict(ctx context.Context, cardID string) {
+	fmt.Println("Conflict: the card was changed by someone else, your change was not saved.")
+
+	card, err := uc.svc.ShowCard(ctx, cardID)
+	if err != nil {
+		fmt.Printf("Error: %s\n", err)
+		return
+	}
+
+	fmt.Printf("Current state:\nColumn: %s\nSwimlane: %s\n", card.ColumnID, card.SwimlaneID)
+	printCard(card)
+}
+
+func (uc *ClientUseCase) CreateBoard(ctx context.Context, title, workspaceIDstr string) {
+	tokens, ok := ctx.Value("tokens").(*dto.Tokens)
+	if !ok {
+		fmt.Println("failed to get tokens from context")
+		return
+	}
+
+	resp, err := uc.svc.Validate(ctx, tokens.AccessToken)
+	if err != nil {
+		fmt.Println("access token expired")
+		return
+	}
+
+	userID, err := uuid.Parse(resp.UserID)
+	if err != nil {
+		fmt.Println("failed parsing user uuid")
+		return
+	}
+
+	board := dto.Board{
+		UserID: userID,
+		Title:  title,
+	}
+
+	if workspaceIDstr != "" {
+		workspaceID, err := uuid.Parse(workspaceIDstr)
+		if err != nil {
+			fmt.Println("failed parsing workspace uuid")
+			return
+		}
+		board.WorkspaceID = &workspaceID
+	}
+
+	err = uc.svc.CreateBoard(ctx, board)
+
+	if err != nil {
+		fmt.Printf("Error: %s\n", err)
+		return
+	}
+
+	fmt.Println("Board successfully created.")
+}
+
+func (uc *ClientUseCase) CreateColumn(ctx context.Context, boardIDstr, title string) {
+	tokens, ok := ctx.Value("tokens").(*dto.Tokens)
+	if !ok {
+		fmt.Println("failed to get tokens from context")
+		return
+	}
+
+	resp, err := uc.svc.Validate(ctx, tokens.AccessToken)
+	if err != nil {
+		fmt.Println("access token expired")
+		return
+	}
+
+	userID, err := uuid.Parse(resp.UserID)
+	if err != nil {
+		fmt.Println("failed parsing user uuid")
+		return
+	}
+
+	boardID, err := uuid.Parse(boardIDstr)
+	if err != nil {
+		fmt.Println("failed parsing board uuid")
+		return
+	}
+
+	column := dto.Column{
+		UserID:  userID,
+		BoardID: boardID,
+		Title:   title,
+	}
+
+	err = uc.svc.CreateColumn(ctx, column)
+
+	if err != nil {
+		fmt.Printf("Error: %s\n", err)
+		return
+	}
+
+	fmt.Println("Column successfully created.")
+}
+
+func (uc *ClientUseCase) CreateSwimlane(ctx context.Context, boardIDstr, title string) {
+	tokens, ok := ctx.Value("tokens").(*dto.Tokens)
+	if !ok {
+		fmt.Println("failed to get tokens from context")
+		return
+	}
+
+	resp, err := uc.svc.Validate(ctx, tokens.AccessToken)
+	if err != nil {
+		fmt.Println("access token expired")
+		return
+	}
+
+	userID, err := uuid.Parse(resp.UserID)
+	if err != nil {
+		fmt.Println("failed parsing user uuid")
+		return
+	}
+
+	boardID, err := uuid.Parse(boardIDstr)
+	if err != nil {
+		fmt.Println("failed parsing board uuid")
+		return
+	}
+
+	snapshot, err := uc.svc.ShowBoard(ctx, boardID.String())
+	if err != nil {
+		fmt.Printf("Error: %s\n", err)
+		return
+	}
+
+	// New lanes go below the existing ones.
+	swimlane := dto.Swimlane{
+		UserID:  userID,
+		BoardID: boardID,
+		Title:   title,
+	}
+	for _, lane := range snapshot.Swimlanes {
+		if lane.Position >= swimlane.Position {
+			swimlane.Position = lane.Position + 1
+		}
+	}
+
+	err = uc.svc.CreateSwimlane(ctx, swimlane)
+
+	if err != nil {
+		fmt.Printf("Error: %s\n", err)
+		return
+	}
+
+	fmt.Println("Swimlane successfully created.")
+}
+
+func (uc *ClientUseCase) CreateCard(ctx context.Context, columnIDstr, title, description string, opts dto.CardOptions) {
+	tokens, ok := ctx.Value("tokens").(*dto.Tokens)
+	if !ok {
+		fmt.Println("failed to get tokens from context")
+		return
+	}
+
+	resp, err := uc.svc.Validate(ctx, tokens.AccessToken)
+	if err != nil {
+		fmt.Println("access token expired")
+		return
+	}
+
+	userID, err := uuid.Parse(resp.UserID)
+	if err != nil {
+		fmt.Println("failed parsing user uuid")
+		return
+	}
+
+	columnID, err := uuid.Parse(columnIDstr)
+	if err != nil {
+		fmt.Println("failed parsing column uuid")
+		return
+	}
+
+	card := dto.Card{
+		UserID:      userID,
+		ColumnID:    columnID,
+		Title:       title,
+		Description: description,
+		Labels:      opts.Labels,
+	}
+
+	if opts.SwimlaneID != "" {
+		card.SwimlaneID, err = uuid.Parse(opts.SwimlaneID)
+		if err != nil {
+			fmt.Println("failed parsing swimlane uuid")
+			return
+		}
+	}
+
+	if opts.ParentID != "" {
+		card.ParentID, err = uuid.Parse(opts.ParentID)
+		if err != nil {
+			fmt.Println("failed parsing parent card uuid")
+			return
+		}
+	}
+
+	if opts.Priority != "" {
+		card.Priority, err = dto.ParsePriority(opts.Priority)
+		if err != nil {
+			fmt.Printf("Error: %s\n", err)
+			return
+		}
+	}
+
+	if opts.AssigneeID != "" {
+		card.AssigneeID, err = uuid.Parse(opts.AssigneeID)
+		if err != nil {
+			fmt.Println("failed parsing assignee uuid")
+			return
+		}
+	}
+
+	if opts.DueDate != "" {
+		due, err := time.ParseInLocation(layout, opts.DueDate, time.Local)
+		if err != nil {
+			fmt.Println("failed parsing due date, expected DD-MM-YYYY")
+			return
+		}
+		card.DueDate = &due
+	}
+
+	if opts.TemplateID != "" {
+		err = uc.svc.CreateCardFromTemplate(ctx, opts.TemplateID, card)
+	} else {
+		err = uc.svc.CreateCard(ctx, card)
+	}
+
+	if err != nil {
+		fmt.Printf("Error: %s\n", err)
+		return
+	}
+
+	fmt.Println("Card successfully created.")
+}
+
+func (uc *ClientUseCase) UpdateBoard(ctx context.Context, boardIDstr, title string) {
+	tokens, ok := ctx.Value("tokens").(*dto.Tokens)
+	if !ok {
+		fmt.Println("failed to get tokens from context")
+		return
+	}
+
+	_, err := uc.svc.Validate(ctx, tokens.AccessToken)
+	if err != nil {
+		// fmt.Println("Access token expired. Refreshing.")
+		refreshResp, err := uc.svc.Refresh(ctx, tokens.RefreshToken)
+		if err != nil {
+			fmt.Println("Please log in again.")
+			return
+		}
+
+		tokens.AccessToken = refreshResp.AccessToken
+
+		fn, ok := ctx.Value("saveFunc").(func(*dto.Tokens))
+		if !ok {
+			fmt.Println("failed to get saveFunc from context")
+			return
+		}
+
+		fn(tokens)
+	}
+
+	boardID, err := uuid.Parse(boardIDstr)
+	if err != nil {
+		fmt.Println("failed parsing board uuid")
+		return
+	}
+
+	board, err := uc.svc.GetBoard(ctx, boardID.String())
+	if err != nil {
+		fmt.Printf("Error: %s\n", err)
+		return
+	}
+
+	board.Title = title
+
+	err = uc.svc.UpdateBoard(ctx, board)
+
+	if errors.Is(err, service.ErrConflict) {
+		uc.reportBoardConflict(ctx, boardID.String())
+		return
+	}
+
+	if err != nil {
+		fmt.Printf("Error: %s\n", err)
+		return
+	}
+
+	fmt.Println("Board successfully updated.")
+}
+
+func (uc *ClientUseCase) UpdateColumn(ctx context.Context, columnIDstr, title string) {
+	tokens, ok := ctx.Value("tokens").(*dto.Tokens)
+	if !ok {
+		fmt.Println("failed to get tokens from context")
+		return
+	}
+
+	_, err := uc.svc.Validate(ctx, tokens.AccessToken)
+	if err != nil {
+		// fmt.Println("Access token expired. Refreshing.")
+		refreshResp, err := uc.svc.Refresh(ctx, tokens.RefreshToken)
+		if err != nil {
+			fmt.Println("Please log in again.")
+			return
+		}
+
+		tokens.AccessToken = refreshResp.AccessToken
+
+		fn, ok := ctx.Value("saveFunc").(func(*dto.Tokens))
+		if !ok {
+			fmt.Println("failed to get saveFunc from context")
+			return
+		}
+
+		fn(tokens)
+	}
+
+	columnID, err := uuid.Parse(columnIDstr)
+	if err != nil {
+		fmt.Println("failed parsing column uuid")
+		return
+	}
+
+	column, err := uc.svc.GetColumn(ctx, columnID.String())
+	if err != nil {
+		fmt.Printf("Error: %s\n", err)
+		return
+	}
+
+	column.Title = title
+
+	err = uc.svc.UpdateColumn(ctx, column)
+
+	if errors.Is(err, service.ErrConflict) {
+		uc.reportColumnConflict(ctx, columnID.String())
+		return
+	}
+
+	if err != nil {
+		fmt.Printf("Error: %s\n", err)
+		return
+	}
+
+	fmt.Println("Column successfully updated.")
+}
+
+// UpdateColumnDone marks a column as holding finished cards, or not; cards
+// in done columns count as done sub-cards of their parents.
+func (uc *ClientUseCase) UpdateColumnDone(ctx context.Context, columnIDstr, doneStr string) {
+	tokens, ok := ctx.Value("tokens").(*dto.Tokens)
+	if !ok {
+		fmt.Println("failed to get tokens from context")
+		return
+	}
+
+	_, err := uc.svc.Validate(ctx, tokens.AccessToken)
+	if err != nil {
+		// fmt.Println("Access token expired. Refreshing.")
+		refreshResp, err := uc.svc.Refresh(ctx, tokens.RefreshToken)
+		if err != nil {
+			fmt.Println("Please log in again.")
+			return
+		}
+
+		tokens.AccessToken = refreshResp.AccessToken
+
+		fn, ok := ctx.Value("saveFunc").(func(*dto.Tokens))
+		if !ok {
+			fmt.Println("failed to get saveFunc from context")
+			return
+		}
+
+		fn(tokens)
+	}
+
+	columnID, err := uuid.Parse(columnIDstr)
+	if err != nil {
+		fmt.Println("failed parsing column uuid")
+		return
+	}
+
+	done, err := strconv.ParseBool(doneStr)
+	if err != nil {
+		fmt.Println("failed parsing done flag, expected true or false")
+		return
+	}
+
+	column, err := uc.svc.GetColumn(ctx, columnID.String())
+	if err != nil {
+		fmt.Printf("Error: %s\n", err)
+		return
+	}
+
+	column.Done = done
+
+	err = uc.svc.UpdateColumn(ctx, column)
+
+	if errors.Is(err, service.ErrConflict) {
+		uc.reportColumnConflict(ctx, columnID.String())
+		return
+	}
+
+	if err != nil {
+		fmt.Printf("Error: %s\n", err)
+		return
+	}
+
+	fmt.Println("Column successfully updated.")
+}
+
+func (uc *ClientUseCase) UpdateSwimlane(ctx context.Context, swimlaneIDstr, title string) {
+	tokens, ok := ctx.Value("tokens").(*dto.Tokens)
+	if !ok {
+		fmt.Println("failed to get tokens from context")
+		return
+	}
+
+	_, err := uc.svc.Validate(ctx, tokens.AccessToken)
+	if err != nil {
+		// fmt.Println("Access token expired. Refreshing.")
+		refreshResp, err := uc.svc.Refresh(ctx, tokens.RefreshToken)
+		if err != nil {
+			fmt.Println("Please log in again.")
+			return
+		}
+
+		tokens.AccessToken = refreshResp.AccessToken
+
+		fn, ok := ctx.Value("saveFunc").(func(*dto.Tokens))
+		if !ok {
+			fmt.Println("failed to get saveFunc from context")
+			return
+		}
+
+		fn(tokens)
+	}
+
+	swimlaneID, err := uuid.Parse(swimlaneIDstr)
+	if err != nil {
+		fmt.Println("failed parsing swimlane uuid")
+		return
+	}
+
+	swimlane, err := uc.svc.GetSwimlane(ctx, swimlaneID.String())
+	if err != nil {
+		fmt.Printf("Error: %s\n", err)
+		return
+	}
+
+	swimlane.Title = title
+
+	err = uc.svc.UpdateSwimlane(ctx, swimlane)
+
+	if errors.Is(err, service.ErrConflict) {
+		uc.reportSwimlaneConflict(ctx, swimlaneID.String())
+		return
+	}
+
+	if err != nil {
+		fmt.Printf("Error: %s\n", err)
+		return
+	}
+
+	fmt.Println("Swimlane successfully updated.")
+}
+
+func (uc *ClientUseCase) UpdateCardTitle(ctx context.Context, cardIDstr, title string) {
+	tokens, ok := ctx.Value("tokens").(*dto.Tokens)
+	if !ok {
+		fmt.Println("failed to get tokens from context")
+		return
+	}
+
+	_, err := uc.svc.Validate(ctx, tokens.AccessToken)
+	if err != nil {
+		// fmt.Println("Access token expired. Refreshing.")
+		refreshResp, err := uc.svc.Refresh(ctx, tokens.RefreshToken)
+		if err != nil {
+			fmt.Println("Please log in again.")
+			return
+		}
+
+		tokens.AccessToken = refreshResp.AccessToken
+
+		fn, ok := ctx.Value("saveFunc").(func(*dto.Tokens))
+		if !ok {
+			fmt.Println("failed to get saveFunc from context")
+			return
+		}
+
+		fn(tokens)
+	}
+
+	cardID, err := uuid.Parse(cardIDstr)
+	if err != nil {
+		fmt.Println("failed parsing card uuid")
+		return
+	}
+
+	card, err := uc.svc.ShowCard(ctx, cardID.String())
+	if err != nil {
+		fmt.Printf("Error: %s\n", err)
+		return
+	}
+
+	card.Title = title
+	card.ColumnID = uuid.Nil
+	card.SwimlaneID = uuid.Nil
+
+	err = uc.svc.UpdateCard(ctx, card)
+
+	if errors.Is(err, service.ErrConflict) {
+		uc.reportCardConflict(ctx, cardID.String())
+		return
+	}
+
+	if err != nil {
+		fmt.Printf("Error: %s\n", err)
+		return
+	}
+
+	fmt.Println("Card title successfully updated.")
+}
+
+func (uc *ClientUseCase) UpdateCardDescription(ctx context.Context, cardIDstr, description string) {
+	tokens, ok := ctx.Value("tokens").(*dto.Tokens)
+	if !ok {
+		fmt.Println("failed to get tokens from context")
+		return
+	}
+
+	_, err := uc.svc.Validate(ctx, tokens.AccessToken)
+	if err != nil {
+		// fmt.Println("Access token expired. Refreshing.")
+		refreshResp, err := uc.svc.Refresh(ctx, tokens.RefreshToken)
+		if err != nil {
+			fmt.Println("Please log in again.")
+			return
+		}
+
+		tokens.AccessToken = refreshResp.AccessToken
+
+		fn, ok := ctx.Value("saveFunc").(func(*dto.Tokens))
+		if !ok {
+			fmt.Println("failed to get saveFunc from context")
+			return
+		}
+
+		fn(tokens)
+	}
+
+	cardID, err := uuid.Parse(cardIDstr)
+	if err != nil {
+		fmt.Println("failed parsing card uuid")
+		return
+	}
+
+	card, err := uc.svc.ShowCard(ctx, cardID.String())
+	if err != nil {
+		fmt.Printf("Error: %s\n", err)
+		return
+	}
+
+	card.Description = description
+	card.ColumnID = uuid.Nil
+	card.SwimlaneID = uuid.Nil
+
+	err = uc.svc.UpdateCard(ctx, card)
+
+	if errors.Is(err, service.ErrConflict) {
+		uc.reportCardConflict(ctx, cardID.String())
+		return
+	}
+
+	if err != nil {
+		fmt.Printf("Error: %s\n", err)
+		return
+	}
+
+	fmt.Println("Card description successfully updated.")
+}
+
+func (uc *ClientUseCase) UpdateCardPriority(ctx context.Context, cardIDstr, priorityStr string) {
+	tokens, ok := ctx.Value("tokens").(*dto.Tokens)
+	if !ok {
+		fmt.Println("failed to get tokens from context")
+		return
+	}
+
+	_, err := uc.svc.Validate(ctx, tokens.AccessToken)
+	if err != nil {
+		// fmt.Println("Access token expired. Refreshing.")
+		refreshResp, err := uc.svc.Refresh(ctx, tokens.RefreshToken)
+		if err != nil {
+			fmt.Println("Please log in again.")
+			return
+		}
+
+		tokens.AccessToken = refreshResp.AccessToken
+
+		fn, ok := ctx.Value("saveFunc").(func(*dto.Tokens))
+		if !ok {
+			fmt.Println("failed to get saveFunc from context")
+			return
+		}
+
+		fn(tokens)
+	}
+
+	cardID, err := uuid.Parse(cardIDstr)
+	if err != nil {
+		fmt.Println("failed parsing card uuid")
+		return
+	}
+
+	priority, err := dto.ParsePriority(priorityStr)
+	if err != nil {
+		fmt.Printf("Error: %s\n", err)
+		return
+	}
+
+	card, err := uc.svc.ShowCard(ctx, cardID.String())
+	if err != nil {
+		fmt.Printf("Error: %s\n", err)
+		return
+	}
+
+	card.Priority = priority
+	card.ColumnID = uuid.Nil
+	card.SwimlaneID = uuid.Nil
+
+	err = uc.svc.UpdateCard(ctx, card)
+
+	if errors.Is(err, service.ErrConflict) {
+		uc.reportCardConflict(ctx, cardID.String())
+		return
+	}
+
+	if err != nil {
+		fmt.Printf("Error: %s\n", err)
+		return
+	}
+
+	fmt.Println("Card priority successfully updated.")
+}
+
+// MoveCard moves a card to another column, another swimlane or both; an empty
+// id keeps the card where it is on that axis.
+func (uc *ClientUseCase) MoveCard(ctx context.Context, cardIDstr, columnIDstr, swimlaneIDstr string) {
+	tokens, ok := ctx.Value("tokens").(*dto.Tokens)
+	if !ok {
+		fmt.Println("failed to get tokens from context")
+		return
+	}
+
+	_, err := uc.svc.Validate(ctx, tokens.AccessToken)
+	if err != nil {
+		// fmt.Println("Access token expired. Refreshing.")
+		refreshResp, err := uc.svc.Refresh(ctx, tokens.RefreshToken)
+		if err != nil {
+			fmt.Println("Please log in again.")
+			return
+		}
+
+		tokens.AccessToken = refreshResp.AccessToken
+
+		fn, ok := ctx.Value("saveFunc").(func(*dto.Tokens))
+		if !ok {
+			fmt.Println("failed to get saveFunc from context")
+			return
+		}
+
+		fn(tokens)
+	}
+
+	cardID, err := uuid.Parse(cardIDstr)
+	if err != nil {
+		fmt.Println("failed parsing card uuid")
+		return
+	}
+
+	if columnIDstr == "" && swimlaneIDstr == "" {
+		fmt.Println("either a column or a swimlane to move to is required")
+		return
+	}
+
+	card := dto.Card{ID: cardID}
+
+	if columnIDstr != "" {
+		card.ColumnID, err = uuid.Parse(columnIDstr)
+		if err != nil {
+			fmt.Println("failed parsing column uuid")
+			return
+		}
+	}
+
+	if swimlaneIDstr != "" {
+		card.SwimlaneID, err = uuid.Parse(swimlaneIDstr)
+		if err != nil {
+			fmt.Println("failed parsing swimlane uuid")
+			return
+		}
+	}
+
+	current, err := uc.svc.ShowCard(ctx, cardID.String())
+	if err != nil {
+		fmt.Printf("Error: %s\n", err)
+		return
+	}
+
+	card.Version = current.Version
+
+	err = uc.svc.UpdateCard(ctx, &card)
+
+	if errors.Is(err, service.ErrConflict) {
+		uc.reportCardConflict(ctx, cardID.String())
+		return
+	}
+
+	if err != nil {
+		fmt.Printf("Error: %s\n", err)
+		return
+	}
+
+	fmt.Println("Card successfully moved.")
+}
+
+func (uc *ClientUseCase) MoveColumn(ctx context.Context, columnIDstr, boardIDstr string) {
+	tokens, ok := ctx.Value("tokens").(*dto.Tokens)
+	if !ok {
+		fmt.Println("failed to get tokens from context")
+		return
+	}
+
+	_, err := uc.svc.Validate(ctx, tokens.AccessToken)
+	if err != nil {
+		// fmt.Println("Access token expired. Refreshing.")
+		refreshResp, err := uc.svc.Refresh(ctx, tokens.RefreshToken)
+		if err != nil {
+			fmt.Println("Please log in again.")
+			return
+		}
+
+		tokens.AccessToken = refreshResp.AccessToken
+
+		fn, ok := ctx.Value("saveFunc").(func(*dto.Tokens))
+		if !ok {
+			fmt.Println("failed to get saveFunc from context")
+			return
+		}
+
+		fn(tokens)
+	}
+
+	columnID, err := uuid.Parse(columnIDstr)
+	if err != nil {
+		fmt.Println("failed parsing column uuid")
+		return
+	}
+
+	boardID, err := uuid.Parse(boardIDstr)
+	if err != nil {
+		fmt.Println("failed parsing board uuid")
+		return
+	}
+
+	column, err := uc.svc.GetColumn(ctx, columnID.String())
+	if err != nil {
+		fmt.Printf("Error: %s\n", err)
+		return
+	}
+
+	column.BoardID = boardID
+
+	err = uc.svc.MoveColumn(ctx, column)
+
+	if errors.Is(err, service.ErrConflict) {
+		uc.reportColumnConflict(ctx, columnID.String())
+		return
+	}
+
+	if err != nil {
+		fmt.Printf("Error: %s\n", err)
+		return
+	}
+
+	fmt.Println("Column successfully moved with its cards.")
+}
+
+func (uc *ClientUseCase) MergeBoards(ctx context.Context, sourceIDstr, targetIDstr, strategy string) {
+	tokens, ok := ctx.Value("tokens").(*dto.Tokens)
+	if !ok {
+		fmt.Println("failed to get tokens from context")
+		return
+	}
+
+	_, err := uc.svc.Validate(ctx, tokens.AccessToken)
+	if err != nil {
+		// fmt.Println("Access token expired. Refreshing.")
+		refreshResp, err := uc.svc.Refresh(ctx, tokens.RefreshToken)
+		if err != nil {
+			fmt.Println("Please log in again.")
+			return
+		}
+
+		tokens.AccessToken = refreshResp.AccessToken
+
+		fn, ok := ctx.Value("saveFunc").(func(*dto.Tokens))
+		if !ok {
+			fmt.Println("failed to get saveFunc from context")
+			return
+		}
+
+		fn(tokens)
+	}
+
+	sourceID, err := uuid.Parse(sourceIDstr)
+	if err != nil {
+		fmt.Println("failed parsing source board uuid")
+		return
+	}
+
+	targetID, err := uuid.Parse(targetIDstr)
+	if err != nil {
+		fmt.Println("failed parsing target board uuid")
+		return
+	}
+
+	merge, err := uc.svc.MergeBoards(ctx, dto.MergeBoardsRequest{SourceID: sourceID, TargetID: targetID, Strategy: strategy})
+
+	if errors.Is(err, service.ErrConflict) {
+		fmt.Println("Conflict: a column was changed by someone else during the merge, nothing was merged. Try again.")
+		return
+	}
+
+	if err != nil {
+		fmt.Printf("Error: %s\n", err)
+		return
+	}
+
+	fmt.Printf("Boards merged: %d column(s) moved, %d combined with a column of the same title.\n", len(merge.Moved), len(merge.Combined))
+}
+
+// SetCardParent puts a card under another one, or back to the top level
+// when parentIDstr is "none".
+func (uc *ClientUseCase) SetCardParent(ctx context.Context, cardIDstr, parentIDstr string) {
+	tokens, ok := ctx.Value("tokens").(*dto.Tokens)
+	if !ok {
+		fmt.Println("failed to get tokens from context")
+		return
+	}
+
+	_, err := uc.svc.Validate(ctx, tokens.AccessToken)
+	if err != nil {
+		// fmt.Println("Access token expired. Refreshing.")
+		refreshResp, err := uc.svc.Refresh(ctx, tokens.RefreshToken)
+		if err != nil {
+			fmt.Println("Please log in again.")
+			return
+		}
+
+		tokens.AccessToken = refreshResp.AccessToken
+
+		fn, ok := ctx.Value("saveFunc").(func(*dto.Tokens))
+		if !ok {
+			fmt.Println("failed to get saveFunc from context")
+			return
+		}
+
+		fn(tokens)
+	}
+
+	cardID, err := uuid.Parse(cardIDstr)
+	if err != nil {
+		fmt.Println("failed parsing card uuid")
+		return
+	}
+
+	card := dto.Card{ID: cardID}
+	if parentIDstr != "none" {
+		card.ParentID, err = uuid.Parse(parentIDstr)
+		if err != nil {
+			fmt.Println("failed parsing parent card uuid")
+			return
+		}
+	}
+
+	current, err := uc.svc.ShowCard(ctx, cardID.String())
+	if err != nil {
+		fmt.Printf("Error: %s\n", err)
+		return
+	}
+
+	card.Version = current.Version
+
+	err = uc.svc.SetCardParent(ctx, &card)
+
+	if errors.Is(err, service.ErrConflict) {
+		uc.reportCardConflict(ctx, cardID.String())
+		return
+	}
+
+	if err != nil {
+		fmt.Printf("Error: %s\n", err)
+		return
+	}
+
+	fmt.Println("Card parent successfully set.")
+}
+
+func (uc *ClientUseCase) ArchiveCard(ctx context.Context, cardIDstr string, confirm io.Reader) {
+	tokens, ok := ctx.Value("tokens").(*dto.Tokens)
+	if !ok {
+		fmt.Println("failed to get tokens from context")
+		return
+	}
+
+	_, err := uc.svc.Validate(ctx, tokens.AccessToken)
+	if err != nil {
+		// fmt.Println("Access token expired. Refreshing.")
+		refreshResp, err := uc.svc.Refresh(ctx, tokens.RefreshToken)
+		if err != nil {
+			fmt.Println("Please log in again.")
+			return
+		}
+
+		tokens.AccessToken = refreshResp.AccessToken
+
+		fn, ok := ctx.Value("saveFunc").(func(*dto.Tokens))
+		if !ok {
+			fmt.Println("failed to get saveFunc from context")
+			return
+		}
+
+		fn(tokens)
+	}
+
+	cardID, err := uuid.Parse(cardIDstr)
+	if err != nil {
+		fmt.Println("failed parsing card uuid")
+		return
+	}
+
+	card, err := uc.svc.ShowCard(ctx, cardID.String())
+	if err != nil {
+		fmt.Printf("Error: %s\n", err)
+		return
+	}
+
+	op := dto.CardOp{Op: "archive", CardID: cardID, Version: card.Version}
+
+	if card.ChildCount > 0 {
+		fmt.Printf("The card has %d active sub-cards. Archive them too? [y/N] ", card.ChildCount)
+
+		var answer string
+		fmt.Fscanln(confirm, &answer)
+
+		cascade := strings.EqualFold(answer, "y") || strings.EqualFold(answer, "yes")
+		op.Cascade = &cascade
+	}
+
+	res, err := uc.svc.BulkCards(ctx, dto.BulkCardsRequest{Ops: []dto.CardOp{op}})
+	if err != nil {
+		fmt.Printf("Error: %s\n", err)
+		return
+	}
+
+	if len(res.Results) != 1 {
+		fmt.Println("Error: unexpected bulk response")
+		return
+	}
+
+	if result := res.Results[0]; result.Status != "applied" {
+		fmt.Printf("Error: %s\n", result.Error)
+		return
+	}
+
+	fmt.Println("Card successfully archived.")
+}
+
+func (uc *ClientUseCase) DeleteBoard(ctx context.Context, id string) {
+	tokens, ok := ctx.Value("tokens").(*dto.Tokens)
+	if !ok {
+		fmt.Println("failed to get tokens from context")
+		return
+	}
+
+	_, err := uc.svc.Validate(ctx, tokens.AccessToken)
+	if err != nil {
+		// fmt.Println("Access token expired. Refreshing.")
+		refreshResp, err := uc.svc.Refresh(ctx, tokens.RefreshToken)
+		if err != nil {
+			fmt.Println("Please log in again.")
+			return
+		}
+
+		tokens.AccessToken = refreshResp.AccessToken
+
+		fn, ok := ctx.Value("saveFunc").(func(*dto.Tokens))
+		if !ok {
+			fmt.Println("failed to get saveFunc from context")
+			return
+		}
+
+		fn(tokens)
+	}
+
+	board, err := uc.svc.GetBoard(ctx, id)
+	if err != nil {
+		fmt.Printf("Error: %s\n", err)
+		return
+	}
+
+	err = uc.svc.DeleteBoard(ctx, id, board.Version)
+
+	if errors.Is(err, service.ErrConflict) {
+		uc.reportBoardConflict(ctx, id)
+		return
+	}
+
+	if err != nil {
+		fmt.Printf("Error: %s\n", err)
+		return
+	}
+
+	fmt.Println("Board successfully deleted.")
+}
+
+func (uc *ClientUseCase) DeleteColumn(ctx context.Context, id string) {
+	tokens, ok := ctx.Value("tokens").(*dto.Tokens)
+	if !ok {
+		fmt.Println("failed to get tokens from context")
+		return
+	}
+
+	_, err := uc.svc.Validate(ctx, tokens.AccessToken)
+	if err != nil {
+		// fmt.Println("Access token expired. Refreshing.")
+		refreshResp, err := uc.svc.Refresh(ctx, tokens.RefreshToken)
+		if err != nil {
+			fmt.Println("Please log in again.")
+			return
+		}
+
+		tokens.AccessToken = refreshResp.AccessToken
+
+		fn, ok := ctx.Value("saveFunc").(func(*dto.Tokens))
+		if !ok {
+			fmt.Println("failed to get saveFunc from context")
+			return
+		}
+
+		fn(tokens)
+	}
+
+	column, err := uc.svc.GetColumn(ctx, id)
+	if err != nil {
+		fmt.Printf("Error: %s\n", err)
+		return
+	}
+
+	err = uc.svc.DeleteColumn(ctx, id, column.Version)
+
+	if errors.Is(err, service.ErrConflict) {
+		uc.reportColumnConflict(ctx, id)
+		return
+	}
+
+	if err != nil {
+		fmt.Printf("Error: %s\n", err)
+		return
+	}
+
+	fmt.Println("Column successfully deleted.")
+}
+
+func (uc *ClientUseCase) DeleteSwimlane(ctx context.Context, id string) {
+	tokens, ok := ctx.Value("tokens").(*dto.Tokens)
+	if !ok {
+		fmt.Println("failed to get tokens from context")
+		return
+	}
+
+	_, err := uc.svc.Validate(ctx, tokens.AccessToken)
+	if err != nil {
+		// fmt.Println("Access token expired. Refreshing.")
+		refreshResp, err := uc.svc.Refresh(ctx, tokens.RefreshToken)
+		if err != nil {
+			fmt.Println("Please log in again.")
+			return
+		}
+
+		tokens.AccessToken = refreshResp.AccessToken
+
+		fn, ok := ctx.Value("saveFunc").(func(*dto.Tokens))
+		if !ok {
+			fmt.Println("failed to get saveFunc from context")
+			return
+		}
+
+		fn(tokens)
+	}
+
+	swimlane, err := uc.svc.GetSwimlane(ctx, id)
+	if err != nil {
+		fmt.Printf("Error: %s\n", err)
+		return
+	}
+
+	err = uc.svc.DeleteSwimlane(ctx, id, swimlane.Version)
+
+	if errors.Is(err, service.ErrConflict) {
+		uc.reportSwimlaneConflict(ctx, id)
+		return
+	}
+
+	if err != nil {
+		fmt.Printf("Error: %s\n", err)
+		return
+	}
+
+	fmt.Println("Swimlane successfully deleted.")
+}
+
+func (uc *ClientUseCase) DeleteCard(ctx context.Context, id string) {
+	tokens, ok := ctx.Value("tokens").(*dto.Tokens)
+	if !ok {
+		fmt.Println("failed to get tokens from context")
+		return
+	}
+
+	_, err := uc.svc.Validate(ctx, tokens.AccessToken)
+	if err != nil {
+		// fmt.Println("Access token expired. Refreshing.")
+		refreshResp, err := uc.svc.Refresh(ctx, tokens.RefreshToken)
+		if err != nil {
+			fmt.Println("Please log in again.")
+			return
+		}
+
+		tokens.AccessToken = refreshResp.AccessToken
+
+		fn, ok := ctx.Value("saveFunc").(func(*dto.Tokens))
+		if !ok {
+			fmt.Println("failed to get saveFunc from context")
+			return
+		}
+
+		fn(tokens)
+	}
+
+	card, err := uc.svc.ShowCard(ctx, id)
+	if err != nil {
+		fmt.Printf("Error: %s\n", err)
+		return
+	}
+
+	err = uc.svc.DeleteCard(ctx, id, card.Version)
+
+	if errors.Is(err, service.ErrConflict) {
+		uc.reportCardConflict(ctx, id)
+		return
+	}
+
+	if err != nil {
+		fmt.Printf("Error: %s\n", err)
+		return
+	}
+
+	fmt.Println("Card successfully deleted.")
+}
+
+func (uc *ClientUseCase) BulkCards(ctx context.Context, input io.Reader, allOrNothing bool) {
+	tokens, ok := ctx.Value("tokens").(*dto.Tokens)
+	if !ok {
+		fmt.Println("failed to get tokens from context")
+		return
+	}
+
+	_, err := uc.svc.Validate(ctx, tokens.AccessToken)
+	if err != nil {
+		// fmt.Println("Access token expired. Refreshing.")
+		refreshResp, err := uc.svc.Refresh(ctx, tokens.RefreshToken)
+		if err != nil {
+			fmt.Println("Please log in again.")
+			return
+		}
+
+		tokens.AccessToken = refreshResp.AccessToken
+
+		fn, ok := ctx.Value("saveFunc").(func(*dto.Tokens))
+		if !ok {
+			fmt.Println("failed to get saveFunc from context")
+			return
+		}
+
+		fn(tokens)
+	}
+
+	ops, err := dto.ParseCardOps(input)
+	if err != nil {
+		fmt.Printf("Error: %s\n", err)
+		return
+	}
+
+	if len(ops) == 0 {
+		fmt.Println("No operations given.")
+		return
+	}
+
+	// Operations are made against the cards as they are now, so that changes
+	// made meanwhile are not overwritten.
+	for i := range ops {
+		card, err := uc.svc.ShowCard(ctx, ops[i].CardID.String())
+		if err != nil {
+			fmt.Printf("Error: card %s: %s\n", ops[i].CardID, err)
+			return
+		}
+		ops[i].Version = card.Version
+	}
+
+	res, err := uc.svc.BulkCards(ctx, dto.BulkCardsRequest{AllOrNothing: allOrNothing, Ops: ops})
+	if err != nil {
+		fmt.Printf("Error: %s\n", err)
+		return
+	}
+
+	applied := 0
+	for _, result := range res.Results {
+		line := fmt.Sprintf("%d. %s %s: %s", result.Index+1, ops[result.Index].Op, result.CardID, result.Status)
+		if result.Error != "" {
+			line += " (" + result.Error + ")"
+		}
+		fmt.Println(line)
+
+		if result.Status == "applied" {
+			applied++
+		}
+	}
+
+	fmt.Printf("%d of %d operations applied.\n", applied, len(ops))
+}
+
+func (uc *ClientUseCase) StartTimer(ctx context.Context, cardIDstr, note string) {
+	tokens, ok := ctx.Value("tokens").(*dto.Tokens)
+	if !ok {
+		fmt.Println("failed to get tokens from context")
+		return
+	}
+
+	_, err := uc.svc.Validate(ctx, tokens.AccessToken)
+	if err != nil {
+		// fmt.Println("Access token expired. Refreshing.")
+		refreshResp, err := uc.svc.Refresh(ctx, tokens.RefreshToken)
+		if err != nil {
+			fmt.Println("Please log in again.")
+			return
+		}
+
+		tokens.AccessToken = refreshResp.AccessToken
+
+		fn, ok := ctx.Value("saveFunc").(func(*dto.Tokens))
+		if !ok {
+			fmt.Println("failed to get saveFunc from context")
+			return
+		}
+
+		fn(tokens)
+	}
+
+	cardID, err := uuid.Parse(cardIDstr)
+	if err != nil {
+		fmt.Println("failed parsing card uuid")
+		return
+	}
+
+	entry, err := uc.svc.StartTimer(ctx, dto.StartTimerRequest{CardID: cardID, Note: note})
+	if err != nil {
+		fmt.Printf("Error: %s\n", err)
+		return
+	}
+
+	fmt.Printf("Timer started on card %s at %s.\n", entry.CardID, entry.StartedAt.Format("15:04"))
+}
+
+func (uc *ClientUseCase) StopTimer(ctx context.Context) {
+	tokens, ok := ctx.Value("tokens").(*dto.Tokens)
+	if !ok {
+		fmt.Println("failed to get tokens from context")
+		return
+	}
+
+	_, err := uc.svc.Validate(ctx, tokens.AccessToken)
+	if err != nil {
+		// fmt.Println("Access token expired. Refreshing.")
+		refreshResp, err := uc.svc.Refresh(ctx, tokens.RefreshToken)
+		if err != nil {
+			fmt.Println("Please log in again.")
+			return
+		}
+
+		tokens.AccessToken = refreshResp.AccessToken
+
+		fn, ok := ctx.Value("saveFunc").(func(*dto.Tokens))
+		if !ok {
+			fmt.Println("failed to get saveFunc from context")
+			return
+		}
+
+		fn(tokens)
+	}
+
+	entry, err := uc.svc.StopTimer(ctx)
+	if err != nil {
+		fmt.Printf("Error: %s\n", err)
+		return
+	}
+
+	fmt.Printf("Timer stopped on card %s after %s.\n", entry.CardID, formatDuration(entry.Duration))
+}
+
+func (uc *ClientUseCase) TimeReport(ctx context.Context, boardID, from, to string) {
+	tokens, ok := ctx.Value("tokens").(*dto.Tokens)
+	if !ok {
+		fmt.Println("failed to get tokens from context")
+		return
+	}
+
+	_, err := uc.svc.Validate(ctx, tokens.AccessToken)
+	if err != nil {
+		// fmt.Println("Access token expired. Refreshing.")
+		refreshResp, err := uc.svc.Refresh(ctx, tokens.RefreshToken)
+		if err != nil {
+			fmt.Println("Please log in again.")
+			return
+		}
+
+		tokens.AccessToken = refreshResp.AccessToken
+
+		fn, ok := ctx.Value("saveFunc").(func(*dto.Tokens))
+		if !ok {
+			fmt.Println("failed to get saveFunc from context")
+			return
+		}
+
+		fn(tokens)
+	}
+
+	today := time.Now()
+	if from == "" {
+		from = today.AddDate(0, 0, -6).Format(layout)
+	}
+	if to == "" {
+		to = today.Format(layout)
+	}
+
+	rows, err := uc.svc.TimeReport(ctx, boardID, from, to)
+	if err != nil {
+		fmt.Printf("Error: %s\n", err)
+		return
+	}
+
+	if len(rows) == 0 {
+		fmt.Println("No time logged.")
+		return
+	}
+
+	var total, dayTotal int64
+	for i, row := range rows {
+		if i == 0 || !row.Day.Equal(rows[i-1].Day) {
+			fmt.Println(row.Day.Format(layout))
+		}
+
+		line := fmt.Sprintf("    %s  %s", formatDuration(row.Duration), row.CardTitle)
+		if boardID != "" {
+			line += fmt.Sprintf(" (user %s)", row.UserID)
+		}
+		fmt.Println(line)
+
+		dayTotal += row.Duration
+		if i == len(rows)-1 || !rows[i+1].Day.Equal(row.Day) {
+			fmt.Printf("    %s  total\n", formatDuration(dayTotal))
+			total += dayTotal
+			dayTotal = 0
+		}
+	}
+
+	fmt.Printf("Total: %s\n", formatDuration(total))
+}
+
+func (uc *ClientUseCase) BoardAnalytics(ctx context.Context, boardID, from, to string) {
+	tokens, ok := ctx.Value("tokens").(*dto.Tokens)
+	if !ok {
+		fmt.Println("failed to get tokens from context")
+		return
+	}
+
+	_, err := uc.svc.Validate(ctx, tokens.AccessToken)
+	if err != nil {
+		// fmt.Println("Access token expired. Refreshing.")
+		refreshResp, err := uc.svc.Refresh(ctx, tokens.RefreshToken)
+		if err != nil {
+			fmt.Println("Please log in again.")
+			return
+		}
+
+		tokens.AccessToken = refreshResp.AccessToken
+
+		fn, ok := ctx.Value("saveFunc").(func(*dto.Tokens))
+		if !ok {
+			fmt.Println("failed to get saveFunc from context")
+			return
+		}
+
+		fn(tokens)
+	}
+
+	today := time.Now()
+	if from == "" {
+		from = today.AddDate(0, 0, -27).Format(layout)
+	}
+	if to == "" {
+		to = today.Format(layout)
+	}
+
+	analytics, err := uc.svc.BoardAnalytics(ctx, boardID, from, to)
+	if err != nil {
+		fmt.Printf("Error: %s\n", err)
+		return
+	}
+
+	printFlowTimes("Lead time", analytics.LeadTime)
+	printFlowTimes("Cycle time", analytics.CycleTime)
+
+	fmt.Println()
+	fmt.Println("Throughput (cards done per week)")
+	maxCards := 0
+	for _, week := range analytics.Throughput {
+		maxCards = max(maxCards, week.Cards)
+	}
+	for _, week := range analytics.Throughput {
+		fmt.Printf("  %s |%s %d\n", week.WeekStart.Format(layout), strings.Repeat("#", scaleBar(week.Cards, maxCards)), week.Cards)
+	}
+
+	if len(analytics.Flow) == 0 {
+		return
+	}
+
+	// The cumulative flow stacks the columns last to first, so that done
+	// cards make up the steady left end of every bar.
+	fmt.Println()
+	fmt.Println("Cumulative flow (cards per column at the end of each day)")
+	fmt.Print("  ")
+	for i := len(analytics.Flow) - 1; i >= 0; i-- {
+		fmt.Printf(" %c %s", flowMarks[i%len(flowMarks)], analytics.Flow[i].ColumnTitle)
+	}
+	fmt.Println()
+
+	totals := make([]int, len(analytics.Days))
+	maxTotal := 0
+	for d := range analytics.Days {
+		for _, series := range analytics.Flow {
+			totals[d] += series.Counts[d]
+		}
+		maxTotal = max(maxTotal, totals[d])
+	}
+
+	for d, day := range analytics.Days {
+		var bar strings.Builder
+		cumulative := 0
+		for i := len(analytics.Flow) - 1; i >= 0; i-- {
+			start := scaleBar(cumulative, maxTotal)
+			cumulative += analytics.Flow[i].Counts[d]
+			bar.WriteString(strings.Repeat(string(flowMarks[i%len(flowMarks)]), scaleBar(cumulative, maxTotal)-start))
+		}
+		fmt.Printf("  %s |%s %d\n", day.Format(layout), bar.String(), totals[d])
+	}
+}
+
+func (uc *ClientUseCase) BoardStats(ctx context.Context, boardID, from, to string, staleDays int) {
+	tokens, ok := ctx.Value("tokens").(*dto.Tokens)
+	if !ok {
+		fmt.Println("failed to get tokens from context")
+		return
+	}
+
+	_, err := uc.svc.Validate(ctx, tokens.AccessToken)
+	if err != nil {
+		// fmt.Println("Access token expired. Refreshing.")
+		refreshResp, err := uc.svc.Refresh(ctx, tokens.RefreshToken)
+		if err != nil {
+			fmt.Println("Please log in again.")
+			return
+		}
+
+		tokens.AccessToken = refreshResp.AccessToken
+
+		fn, ok := ctx.Value("saveFunc").(func(*dto.Tokens))
+		if !ok {
+			fmt.Println("failed to get saveFunc from context")
+			return
+		}
+
+		fn(tokens)
+	}
+
+	today := time.Now()
+	if from == "" {
+		from = today.AddDate(0, 0, -27).Format(layout)
+	}
+	if to == "" {
+		to = today.Format(layout)
+	}
+
+	// Without a threshold the todo service picks its own.
+	var stale string
+	if staleDays != 0 {
+		stale = strconv.Itoa(staleDays)
+	}
+
+	stats, err := uc.svc.BoardStats(ctx, boardID, from, to, stale)
+	if err != nil {
+		fmt.Printf("Error: %s\n", err)
+		return
+	}
+
+	fmt.Println("Cards per column")
+	for _, column := range stats.Columns {
+		done := ""
+		if column.Done {
+			done = " (done)"
+		}
+		fmt.Printf("  %s%s: %d\n", column.ColumnTitle, done, column.Cards)
+	}
+
+	fmt.Println()
+	fmt.Println("Cards created (+) and completed (#) per day")
+	maxCards := 0
+	for _, day := range stats.Days {
+		maxCards = max(maxCards, day.Created, day.Completed)
+	}
+	for _, day := range stats.Days {
+		fmt.Printf("  %s |%s %d\n", day.Day.Format(layout), strings.Repeat("+", scaleBar(day.Created, maxCards)), day.Created)
+		fmt.Printf("  %s |%s %d\n", strings.Repeat(" ", len(layout)), strings.Repeat("#", scaleBar(day.Completed, maxCards)), day.Completed)
+	}
+
+	fmt.Println()
+	if len(stats.Stale) == 0 {
+		fmt.Printf("No cards untouched for %d days\n", stats.StaleDays)
+	} else {
+		fmt.Printf("Untouched for %d days, oldest first\n", stats.StaleDays)
+		for _, card := range stats.Stale {
+			fmt.Printf("  %s [%s] in %s, last changed %s\n", card.Title, card.CardID, card.ColumnTitle, card.UpdatedAt.Format(layout))
+		}
+	}
+
+	fmt.Println()
+	if len(stats.Contributors) == 0 {
+		fmt.Println("No contributors over the period")
+		return
+	}
+
+	fmt.Println("Most active contributors")
+	for _, c := range stats.Contributors {
+		name := c.Username
+		if name == "" {
+			name = c.UserID.String()
+		}
+		fmt.Printf("  %s: %d created, %d completed\n", name, c.Created, c.Completed)
+	}
+}
+
+func (uc *ClientUseCase) CreateSprint(ctx context.Context, boardIDstr, name, start, end string) {
+	tokens, ok := ctx.Value("tokens").(*dto.Tokens)
+	if !ok {
+		fmt.Println("failed to get tokens from context")
+		return
+	}
+
+	_, err := uc.svc.Validate(ctx, tokens.AccessToken)
+	if err != nil {
+		// fmt.Println("Access token expired. Refreshing.")
+		refreshResp, err := uc.svc.Refresh(ctx, tokens.RefreshToken)
+		if err != nil {
+			fmt.Println("Please log in again.")
+			return
+		}
+
+		tokens.AccessToken = refreshResp.AccessToken
+
+		fn, ok := ctx.Value("saveFunc").(func(*dto.Tokens))
+		if !ok {
+			fmt.Println("failed to get saveFunc from context")
+			return
+		}
+
+		fn(tokens)
+	}
+
+	boardID, err := uuid.Parse(boardIDstr)
+	if err != nil {
+		fmt.Println("failed parsing board uuid")
+		return
+	}
+
+	startDate, err := time.Parse(layout, start)
+	if err != nil {
+		fmt.Println("invalid start date, expected DD-MM-YYYY")
+		return
+	}
+
+	endDate, err := time.Parse(layout, end)
+	if err != nil {
+		fmt.Println("invalid end date, expected DD-MM-YYYY")
+		return
+	}
+
+	sprint, err := uc.svc.CreateSprint(ctx, dto.CreateSprintRequest{
+		BoardID:   boardID,
+		Name:      name,
+		StartDate: startDate,
+		EndDate:   endDate,
+	})
+	if err != nil {
+		fmt.Printf("Error: %s\n", err)
+		return
+	}
+
+	fmt.Printf("Sprint created with id: %s\n", sprint.ID)
+}
+
+func (uc *ClientUseCase) ShowSprints(ctx context.Context, boardID string) {
+	tokens, ok := ctx.Value("tokens").(*dto.Tokens)
+	if !ok {
+		fmt.Println("failed to get tokens from context")
+		return
+	}
+
+	_, err := uc.svc.Validate(ctx, tokens.AccessToken)
+	if err != nil {
+		// fmt.Println("Access token expired. Refreshing.")
+		refreshResp, err := uc.svc.Refresh(ctx, tokens.RefreshToken)
+		if err != nil {
+			fmt.Println("Please log in again.")
+			return
+		}
+
+		tokens.AccessToken = refreshResp.AccessToken
+
+		fn, ok := ctx.Value("saveFunc").(func(*dto.Tokens))
+		if !ok {
+			fmt.Println("failed to get saveFunc from context")
+			return
+		}
+
+		fn(tokens)
+	}
+
+	sprints, err := uc.svc.GetBoardSprints(ctx, boardID)
+	if err != nil {
+		fmt.Printf("Error: %s\n", err)
+		return
+	}
+
+	if len(sprints) == 0 {
+		fmt.Println("No sprints.")
+		return
+	}
+
+	for i, sprint := range sprints {
+		fmt.Printf("%d. %s\n", i+1, formatSprint(sprint))
+	}
+}
+
+func (uc *ClientUseCase) ShowSprint(ctx context.Context, sprintID string) {
+	tokens, ok := ctx.Value("tokens").(*dto.Tokens)
+	if !ok {
+		fmt.Println("failed to get tokens from context")
+		return
+	}
+
+	_, err := uc.svc.Validate(ctx, tokens.AccessToken)
+	if err != nil {
+		// fmt.Println("Access token expired. Refreshing.")
+		refreshResp, err := uc.svc.Refresh(ctx, tokens.RefreshToken)
+		if err != nil {
+			fmt.Println("Please log in again.")
+			return
+		}
+
+		tokens.AccessToken = refreshResp.AccessToken
+
+		fn, ok := ctx.Value("saveFunc").(func(*dto.Tokens))
+		if !ok {
+			fmt.Println("failed to get saveFunc from context")
+			return
+		}
+
+		fn(tokens)
+	}
+
+	sprint, err := uc.svc.GetSprint(ctx, sprintID)
+	if err != nil {
+		fmt.Printf("Error: %s\n", err)
+		return
+	}
+
+	cards, err := uc.svc.GetSprintCards(ctx, sprintID)
+	if err != nil {
+		fmt.Printf("Error: %s\n", err)
+		return
+	}
+
+	fmt.Println(formatSprint(*sprint))
+
+	var points, donePoints int
+	for i, card := range cards {
+		mark := " "
+		if card.Done {
+			mark = "x"
+			donePoints += card.Points
+		}
+		points += card.Points
+		fmt.Printf("    %d. [%s] %s (%d pts, %s)\n", i+1, mark, card.CardTitle, card.Points, card.CardID)
+	}
+
+	fmt.Printf("Done: %d of %d points\n", donePoints, points)
+}
+
+// formatSprint renders a sprint on one line, e.g.
+// Sprint 1 (01-03-2024 - 14-03-2024, closed) [id].
+func formatSprint(sprint dto.Sprint) string {
+	state := "open"
+	if sprint.ClosedAt != nil {
+		state = "closed"
+	}
+	return fmt.Sprintf("%s (%s - %s, %s) [%s]", sprint.Name,
+		sprint.StartDate.UTC().Format(layout), sprint.EndDate.UTC().Format(layout), state, sprint.ID)
+}
+
+func (uc *ClientUseCase) AddSprintCard(ctx context.Context, sprintID, cardIDstr string, points int) {
+	tokens, ok := ctx.Value("tokens").(*dto.Tokens)
+	if !ok {
+		fmt.Println("failed to get tokens from context")
+		return
+	}
+
+	_, err := uc.svc.Validate(ctx, tokens.AccessToken)
+	if err != nil {
+		// fmt.Println("Access token expired. Refreshing.")
+		refreshResp, err := uc.svc.Refresh(ctx, tokens.RefreshToken)
+		if err != nil {
+			fmt.Println("Please log in again.")
+			return
+		}
+
+		tokens.AccessToken = refreshResp.AccessToken
+
+		fn, ok := ctx.Value("saveFunc").(func(*dto.Tokens))
+		if !ok {
+			fmt.Println("failed to get saveFunc from context")
+			return
+		}
+
+		fn(tokens)
+	}
+
+	cardID, err := uuid.Parse(cardIDstr)
+	if err != nil {
+		fmt.Println("failed parsing card uuid")
+		return
+	}
+
+	_, err = uc.svc.AddSprintCard(ctx, sprintID, dto.AddSprintCardRequest{CardID: cardID, Points: points})
+	if err != nil {
+		fmt.Printf("Error: %s\n", err)
+		return
+	}
+
+	fmt.Println("Card added to sprint.")
+}
+
+func (uc *ClientUseCase) RemoveSprintCard(ctx context.Context, sprintID, cardID string) {
+	tokens, ok := ctx.Value("tokens").(*dto.Tokens)
+	if !ok {
+		fmt.Println("failed to get tokens from context")
+		return
+	}
+
+	_, err := uc.svc.Validate(ctx, tokens.AccessToken)
+	if err != nil {
+		// fmt.Println("Access token expired. Refreshing.")
+		refreshResp, err := uc.svc.Refresh(ctx, tokens.RefreshToken)
+		if err != nil {
+			fmt.Println("Please log in again.")
+			return
+		}
+
+		tokens.AccessToken = refreshResp.AccessToken
+
+		fn, ok := ctx.Value("saveFunc").(func(*dto.Tokens))
+		if !ok {
+			fmt.Println("failed to get saveFunc from context")
+			return
+		}
+
+		fn(tokens)
+	}
+
+	err = uc.svc.RemoveSprintCard(ctx, sprintID, cardID)
+	if err != nil {
+		fmt.Printf("Error: %s\n", err)
+		return
+	}
+
+	fmt.Println("Card removed from sprint.")
+}
+
+func (uc *ClientUseCase) CloseSprint(ctx context.Context, sprintID, nextIDstr string) {
+	tokens, ok := ctx.Value("tokens").(*dto.Tokens)
+	if !ok {
+		fmt.Println("failed to get tokens from context")
+		return
+	}
+
+	_, err := uc.svc.Validate(ctx, tokens.AccessToken)
+	if err != nil {
+		// fmt.Println("Access token expired. Refreshing.")
+		refreshResp, err := uc.svc.Refresh(ctx, tokens.RefreshToken)
+		if err != nil {
+			fmt.Println("Please log in again.")
+			return
+		}
+
+		tokens.AccessToken = refreshResp.AccessToken
+
+		fn, ok := ctx.Value("saveFunc").(func(*dto.Tokens))
+		if !ok {
+			fmt.Println("failed to get saveFunc from context")
+			return
+		}
+
+		fn(tokens)
+	}
+
+	var req dto.CloseSprintRequest
+	if nextIDstr != "" {
+		nextID, err := uuid.Parse(nextIDstr)
+		if err != nil {
+			fmt.Println("failed parsing next sprint uuid")
+			return
+		}
+		req.NextSprintID = &nextID
+	}
+
+	closed, err := uc.svc.CloseSprint(ctx, sprintID, req)
+	if err != nil {
+		fmt.Printf("Error: %s\n", err)
+		return
+	}
+
+	if closed.NextSprint == nil {
+		fmt.Println("Sprint closed. There is no next sprint, so unfinished cards stay where they are.")
+		return
+	}
+
+	fmt.Printf("Sprint closed. %d unfinished card(s) carried over to %s.\n", closed.Carried, closed.NextSprint.Name)
+}
+
+func (uc *ClientUseCase) SprintBurndown(ctx context.Context, sprintID string) {
+	tokens, ok := ctx.Value("tokens").(*dto.Tokens)
+	if !ok {
+		fmt.Println("failed to get tokens from context")
+		return
+	}
+
+	_, err := uc.svc.Validate(ctx, tokens.AccessToken)
+	if err != nil {
+		// fmt.Println("Access token expired. Refreshing.")
+		refreshResp, err := uc.svc.Refresh(ctx, tokens.RefreshToken)
+		if err != nil {
+			fmt.Println("Please log in again.")
+			return
+		}
+
+		tokens.AccessToken = refreshResp.AccessToken
+
+		fn, ok := ctx.Value("saveFunc").(func(*dto.Tokens))
+		if !ok {
+			fmt.Println("failed to get saveFunc from context")
+			return
+		}
+
+		fn(tokens)
+	}
+
+	points, err := uc.svc.SprintBurndown(ctx, sprintID)
+	if err != nil {
+		fmt.Printf("Error: %s\n", err)
+		return
+	}
+
+	if len(points) == 0 {
+		fmt.Println("The sprint has not started yet.")
+		return
+	}
+
+	top := 0
+	for _, p := range points {
+		top = max(top, p.Points, int(p.IdealPoints+0.5))
+	}
+
+	// Each bar is the points left; the | marks where the ideal line is.
+	fmt.Println("Burndown (points left at the end of each day, | is ideal)")
+	for _, p := range points {
+		bar := []byte(strings.Repeat("#", scaleBar(p.Points, top)) + strings.Repeat(" ", chartWidth))
+		bar[scaleBar(int(p.IdealPoints+0.5), top)] = '|'
+		fmt.Printf("  %s %s %d pts, %d cards\n", p.Day.UTC().Format(layout), bar, p.Points, p.Cards)
+	}
+}
+
+// flowMarks tell the columns of a board apart in the cumulative flow.
+const flowMarks = "#=+*o%@x"
+
+// chartWidth is the length of the longest bar of a chart.
+const chartWidth = 50
+
+// scaleBar is the length of the bar of n when the longest is of top.
+func scaleBar(n, top int) int {
+	if top == 0 {
+		return 0
+	}
+	return (n*chartWidth + top/2) / top
+}
+
+func printFlowTimes(name string, times dto.FlowTimes) {
+	if times.Cards == 0 {
+		fmt.Printf("%s: no cards done\n", name)
+		return
+	}
+
+	fmt.Printf("%s over %d cards: average %s, 50%% %s, 85%% %s, 95%% %s\n", name, times.Cards,
+		formatDuration(times.Average), formatDuration(times.P50), formatDuration(times.P85), formatDuration(times.P95))
+}
+
+// formatDuration renders seconds as hours and minutes, e.g. 1h05m.
+func formatDuration(seconds int64) string {
+	minutes := seconds / 60
+	return fmt.Sprintf("%dh%02dm", minutes/60, minutes%60)
+}
+
+// reconnectDelay is how long WatchBoard waits before picking a lost stream
+// up again.
+const reconnectDelay = 3 * time.Second
+
+// seenWindow is how far below the latest change WatchBoard keeps the ids of
+// the changes it printed. It covers the changes the todo service sends again
+// on resuming.
+const seenWindow = 1000
+
+func (uc *ClientUseCase) CreateCardTemplate(ctx context.Context, boardIDstr, name string, opts dto.CardTemplateOptions) {
+	tokens, ok := ctx.Value("tokens").(*dto.Tokens)
+	if !ok {
+		fmt.Println("failed to get tokens from context")
+		return
+	}
+
+	_, err := uc.svc.Validate(ctx, tokens.AccessToken)
+	if err != nil {
+		// fmt.Println("Access token expired. Refreshing.")
+		refreshResp, err := uc.svc.Refresh(ctx, tokens.RefreshToken)
+		if err != nil {
+			fmt.Println("Please log in again.")
+			return
+		}
+
+		tokens.AccessToken = refreshResp.AccessToken
+
+		fn, ok := ctx.Value("saveFunc").(func(*dto.Tokens))
+		if !ok {
+			fmt.Println("failed to get saveFunc from context")
+			return
+		}
+
+		fn(tokens)
+	}
+
+	boardID, err := uuid.Parse(boardIDstr)
+	if err != nil {
+		fmt.Println("failed parsing board uuid")
+		return
+	}
+
+	req := dto.CardTemplateRequest{
+		BoardID:      boardID,
+		Name:         name,
+		TitlePattern: opts.TitlePattern,
+		Description:  opts.Description,
+		Labels:       opts.Labels,
+	}
+
+	for _, raw := range opts.Checklists {
+		title, items, ok := strings.Cut(raw, ":")
+		if !ok {
+			fmt.Println("invalid checklist, expected title:item;item")
+			return
+		}
+
+		checklist := dto.Checklist{Title: strings.TrimSpace(title)}
+		for _, item := range strings.Split(items, ";") {
+			checklist.Items = append(checklist.Items, strings.TrimSpace(item))
+		}
+		req.Checklists = append(req.Checklists, checklist)
+	}
+
+	template, err := uc.svc.CreateCardTemplate(ctx, req)
+	if err != nil {
+		fmt.Printf("Error: %s\n", err)
+		return
+	}
+
+	fmt.Printf("Card template created with id: %s\n", template.ID)
+}
+
+func (uc *ClientUseCase) ShowCardTemplates(ctx context.Context, boardID string) {
+	tokens, ok := ctx.Value("tokens").(*dto.Tokens)
+	if !ok {
+		fmt.Println("failed to get tokens from context")
+		return
+	}
+
+	_, err := uc.svc.Validate(ctx, tokens.AccessToken)
+	if err != nil {
+		// fmt.Println("Access token expired. Refreshing.")
+		refreshResp, err := uc.svc.Refresh(ctx, tokens.RefreshToken)
+		if err != nil {
+			fmt.Println("Please log in again.")
+			return
+		}
+
+		tokens.AccessToken = refreshResp.AccessToken
+
+		fn, ok := ctx.Value("saveFunc").(func(*dto.Tokens))
+		if !ok {
+			fmt.Println("failed to get saveFunc from context")
+			return
+		}
+
+		fn(tokens)
+	}
+
+	templates, err := uc.svc.GetBoardCardTemplates(ctx, boardID)
+	if err != nil {
+		fmt.Printf("Error: %s\n", err)
+		return
+	}
+
+	if len(templates) == 0 {
+		fmt.Println("No card templates.")
+		return
+	}
+
+	for i, template := range templates {
+		fmt.Printf("%d. %s [%s]\n", i+1, template.Name, template.ID)
+		if template.TitlePattern != "" {
+			fmt.Printf("   Title: %s\n", template.TitlePattern)
+		}
+		if len(template.Labels) > 0 {
+			fmt.Printf("   Labels: %s\n", strings.Join(template.Labels, ", "))
+		}
+		for _, checklist := range template.Checklists {
+			fmt.Printf("   Checklist: %s (%d items)\n", checklist.Title, len(checklist.Items))
+		}
+	}
+}
+
+func (uc *ClientUseCase) DeleteCardTemplate(ctx context.Context, id string) {
+	tokens, ok := ctx.Value("tokens").(*dto.Tokens)
+	if !ok {
+		fmt.Println("failed to get tokens from context")
+		return
+	}
+
+	_, err := uc.svc.Validate(ctx, tokens.AccessToken)
+	if err != nil {
+		// fmt.Println("Access token expired. Refreshing.")
+		refreshResp, err := uc.svc.Refresh(ctx, tokens.RefreshToken)
+		if err != nil {
+			fmt.Println("Please log in again.")
+			return
+		}
+
+		tokens.AccessToken = refreshResp.AccessToken
+
+		fn, ok := ctx.Value("saveFunc").(func(*dto.Tokens))
+		if !ok {
+			fmt.Println("failed to get saveFunc from context")
+			return
+		}
+
+		fn(tokens)
+	}
+
+	err = uc.svc.DeleteCardTemplate(ctx, id)
+	if err != nil {
+		fmt.Printf("Error: %s\n", err)
+		return
+	}
+
+	fmt.Println("Card template successfully deleted.")
+}
+
+func (uc *ClientUseCase) CreateWorkspace(ctx context.Context, name string) {
+	tokens, ok := ctx.Value("tokens").(*dto.Tokens)
+	if !ok {
+		fmt.Println("failed to get tokens from context")
+		return
+	}
+
+	_, err := uc.svc.Validate(ctx, tokens.AccessToken)
+	if err != nil {
+		// fmt.Println("Access token expired. Refreshing.")
+		refreshResp, err := uc.svc.Refresh(ctx, tokens.RefreshToken)
+		if err != nil {
+			fmt.Println("Please log in again.")
+			return
+		}
+
+		tokens.AccessToken = refreshResp.AccessToken
+
+		fn, ok := ctx.Value("saveFunc").(func(*dto.Tokens))
+		if !ok {
+			fmt.Println("failed to get saveFunc from context")
+			return
+		}
+
+		fn(tokens)
+	}
+
+	workspace, err := uc.svc.CreateWorkspace(ctx, name)
+	if err != nil {
+		fmt.Printf("Error: %s\n", err)
+		return
+	}
+
+	fmt.Printf("Workspace created with id: %s\n", workspace.ID)
+}
+
+func (uc *ClientUseCase) ShowWorkspaces(ctx context.Context) {
+	tokens, ok := ctx.Value("tokens").(*dto.Tokens)
+	if !ok {
+		fmt.Println("failed to get tokens from context")
+		return
+	}
+
+	_, err := uc.svc.Validate(ctx, tokens.AccessToken)
+	if err != nil {
+		// fmt.Println("Access token expired. Refreshing.")
+		refreshResp, err := uc.svc.Refresh(ctx, tokens.RefreshToken)
+		if err != nil {
+			fmt.Println("Please log in again.")
+			return
+		}
+
+		tokens.AccessToken = refreshResp.AccessToken
+
+		fn, ok := ctx.Value("saveFunc").(func(*dto.Tokens))
+		if !ok {
+			fmt.Println("failed to get saveFunc from context")
+			return
+		}
+
+		fn(tokens)
+	}
+
+	workspaces, err := uc.svc.GetWorkspaces(ctx)
+	if err != nil {
+		fmt.Printf("Error: %s\n", err)
+		return
+	}
+
+	for i, workspace := range workspaces {
+		personal := ""
+		if workspace.Personal {
+			personal = " (personal)"
+		}
+
+		fmt.Printf("%d. %s%s\nName: %s\nRole: %s\n", i+1, workspace.ID, personal, workspace.Name, workspace.Role)
+	}
+}
+
+func (uc *ClientUseCase) ShowWorkspaceMembers(ctx context.Context, workspaceID string) {
+	tokens, ok := ctx.Value("tokens").(*dto.Tokens)
 	if !ok {
 		fmt.Println("failed to get tokens from context")
 		return
@@ -662,14 +2955,324 @@ func (uc *ClientUseCase) DeleteCard(ctx context.Context, id string) {
 		fn(tokens)
 	}
 
-	err = uc.svc.DeleteCard(ctx, id)
+	members, err := uc.svc.GetWorkspaceMembers(ctx, workspaceID)
+	if err != nil {
+		fmt.Printf("Error: %s\n", err)
+		return
+	}
+
+	for i, member := range members {
+		fmt.Printf("%d. %s %s, since %s\n", i+1, member.UserID, member.Role, member.CreatedAt.Format(layout))
+	}
+}
+
+func (uc *ClientUseCase) ShowWorkspaceBoards(ctx context.Context, workspaceID string) {
+	tokens, ok := ctx.Value("tokens").(*dto.Tokens)
+	if !ok {
+		fmt.Println("failed to get tokens from context")
+		return
+	}
+
+	_, err := uc.svc.Validate(ctx, tokens.AccessToken)
+	if err != nil {
+		// fmt.Println("Access token expired. Refreshing.")
+		refreshResp, err := uc.svc.Refresh(ctx, tokens.RefreshToken)
+		if err != nil {
+			fmt.Println("Please log in again.")
+			return
+		}
+
+		tokens.AccessToken = refreshResp.AccessToken
+
+		fn, ok := ctx.Value("saveFunc").(func(*dto.Tokens))
+		if !ok {
+			fmt.Println("failed to get saveFunc from context")
+			return
+		}
+
+		fn(tokens)
+	}
 
+	boards, err := uc.svc.GetWorkspaceBoards(ctx, workspaceID)
 	if err != nil {
 		fmt.Printf("Error: %s\n", err)
 		return
 	}
 
-	fmt.Println("Card successfully deleted.")
+	if len(boards) == 0 {
+		fmt.Println("No boards.")
+		return
+	}
+
+	for i, board := range boards {
+		fmt.Printf("%d. %s\nTitle: %s\nOwner: %s\n", i+1, board.ID, board.Title, board.UserID)
+	}
+}
+
+func (uc *ClientUseCase) AddWorkspaceMember(ctx context.Context, workspaceID, userIDstr, role string) {
+	tokens, ok := ctx.Value("tokens").(*dto.Tokens)
+	if !ok {
+		fmt.Println("failed to get tokens from context")
+		return
+	}
+
+	_, err := uc.svc.Validate(ctx, tokens.AccessToken)
+	if err != nil {
+		// fmt.Println("Access token expired. Refreshing.")
+		refreshResp, err := uc.svc.Refresh(ctx, tokens.RefreshToken)
+		if err != nil {
+			fmt.Println("Please log in again.")
+			return
+		}
+
+		tokens.AccessToken = refreshResp.AccessToken
+
+		fn, ok := ctx.Value("saveFunc").(func(*dto.Tokens))
+		if !ok {
+			fmt.Println("failed to get saveFunc from context")
+			return
+		}
+
+		fn(tokens)
+	}
+
+	userID, err := uuid.Parse(userIDstr)
+	if err != nil {
+		fmt.Println("failed parsing user uuid")
+		return
+	}
+
+	err = uc.svc.AddWorkspaceMember(ctx, workspaceID, dto.WorkspaceMemberRequest{UserID: userID, Role: role})
+	if err != nil {
+		fmt.Printf("Error: %s\n", err)
+		return
+	}
+
+	fmt.Printf("Member added as %s.\n", role)
+}
+
+func (uc *ClientUseCase) UpdateWorkspaceMember(ctx context.Context, workspaceID, userIDstr, role string) {
+	tokens, ok := ctx.Value("tokens").(*dto.Tokens)
+	if !ok {
+		fmt.Println("failed to get tokens from context")
+		return
+	}
+
+	_, err := uc.svc.Validate(ctx, tokens.AccessToken)
+	if err != nil {
+		// fmt.Println("Access token expired. Refreshing.")
+		refreshResp, err := uc.svc.Refresh(ctx, tokens.RefreshToken)
+		if err != nil {
+			fmt.Println("Please log in again.")
+			return
+		}
+
+		tokens.AccessToken = refreshResp.AccessToken
+
+		fn, ok := ctx.Value("saveFunc").(func(*dto.Tokens))
+		if !ok {
+			fmt.Println("failed to get saveFunc from context")
+			return
+		}
+
+		fn(tokens)
+	}
+
+	userID, err := uuid.Parse(userIDstr)
+	if err != nil {
+		fmt.Println("failed parsing user uuid")
+		return
+	}
+
+	err = uc.svc.UpdateWorkspaceMember(ctx, workspaceID, dto.WorkspaceMemberRequest{UserID: userID, Role: role})
+	if err != nil {
+		fmt.Printf("Error: %s\n", err)
+		return
+	}
+
+	fmt.Printf("Member is now %s.\n", role)
+}
+
+func (uc *ClientUseCase) RemoveWorkspaceMember(ctx context.Context, workspaceID, userID string) {
+	tokens, ok := ctx.Value("tokens").(*dto.Tokens)
+	if !ok {
+		fmt.Println("failed to get tokens from context")
+		return
+	}
+
+	_, err := uc.svc.Validate(ctx, tokens.AccessToken)
+	if err != nil {
+		// fmt.Println("Access token expired. Refreshing.")
+		refreshResp, err := uc.svc.Refresh(ctx, tokens.RefreshToken)
+		if err != nil {
+			fmt.Println("Please log in again.")
+			return
+		}
+
+		tokens.AccessToken = refreshResp.AccessToken
+
+		fn, ok := ctx.Value("saveFunc").(func(*dto.Tokens))
+		if !ok {
+			fmt.Println("failed to get saveFunc from context")
+			return
+		}
+
+		fn(tokens)
+	}
+
+	err = uc.svc.RemoveWorkspaceMember(ctx, workspaceID, userID)
+	if err != nil {
+		fmt.Printf("Error: %s\n", err)
+		return
+	}
+
+	fmt.Println("Member removed from workspace.")
+}
+
+func (uc *ClientUseCase) RegenerateCalendarToken(ctx context.Context) {
+	tokens, ok := ctx.Value("tokens").(*dto.Tokens)
+	if !ok {
+		fmt.Println("failed to get tokens from context")
+		return
+	}
+
+	_, err := uc.svc.Validate(ctx, tokens.AccessToken)
+	if err != nil {
+		// fmt.Println("Access token expired. Refreshing.")
+		refreshResp, err := uc.svc.Refresh(ctx, tokens.RefreshToken)
+		if err != nil {
+			fmt.Println("Please log in again.")
+			return
+		}
+
+		tokens.AccessToken = refreshResp.AccessToken
+
+		fn, ok := ctx.Value("saveFunc").(func(*dto.Tokens))
+		if !ok {
+			fmt.Println("failed to get saveFunc from context")
+			return
+		}
+
+		fn(tokens)
+	}
+
+	token, err := uc.svc.RegenerateCalendarToken(ctx)
+	if err != nil {
+		fmt.Printf("Error: %s\n", err)
+		return
+	}
+
+	fmt.Println("Subscribe to your cards due in a calendar app with this link:")
+	fmt.Printf("  %s\n", token.FeedURL)
+	fmt.Println("Add ?kind=event or ?kind=todo to get only events or only to-dos.")
+	fmt.Println("Keep the link secret; any link made before stops working.")
+}
+
+func (uc *ClientUseCase) WatchBoard(ctx context.Context, boardID string) {
+	tokens, ok := ctx.Value("tokens").(*dto.Tokens)
+	if !ok {
+		fmt.Println("failed to get tokens from context")
+		return
+	}
+
+	saveFunc, ok := ctx.Value("saveFunc").(func(*dto.Tokens))
+	if !ok {
+		fmt.Println("failed to get saveFunc from context")
+		return
+	}
+
+	refresh := func() bool {
+		refreshResp, err := uc.svc.Refresh(ctx, tokens.RefreshToken)
+		if err != nil {
+			fmt.Println("Please log in again.")
+			return false
+		}
+
+		tokens.AccessToken = refreshResp.AccessToken
+		saveFunc(tokens)
+		return true
+	}
+
+	_, err := uc.svc.Validate(ctx, tokens.AccessToken)
+	if err != nil {
+		// fmt.Println("Access token expired. Refreshing.")
+		if !refresh() {
+			return
+		}
+	}
+
+	board, err := uc.svc.GetBoard(ctx, boardID)
+	if err != nil {
+		fmt.Printf("Error: %s\n", err)
+		return
+	}
+
+	fmt.Printf("Watching board %s (%s). Press Ctrl+C to stop.\n", board.Title, board.ID)
+
+	// The stream is resumed from the latest change seen, so none is missed
+	// while reconnecting. A resumed stream starts a little before it, as
+	// changes do not commit in id order, so the ones printed are skipped.
+	lastEventID := ""
+	var lastID int64
+	seen := make(map[int64]bool)
+	for {
+		err := uc.svc.WatchBoard(ctx, boardID, lastEventID, func(activity dto.Activity) {
+			if seen[activity.ID] {
+				return
+			}
+			seen[activity.ID] = true
+
+			if activity.ID > lastID {
+				lastID = activity.ID
+				lastEventID = strconv.FormatInt(lastID, 10)
+			}
+			printActivity(activity)
+		})
+
+		if ctx.Err() != nil {
+			return
+		}
+
+		if err != nil {
+			fmt.Printf("Error: %s\n", err)
+		}
+		fmt.Println("Connection lost. Reconnecting...")
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(reconnectDelay):
+		}
+
+		// The access token may have expired while watching.
+		if _, err := uc.svc.Validate(ctx, tokens.AccessToken); err != nil {
+			if ctx.Err() != nil || !refresh() {
+				return
+			}
+		}
+
+		for id := range seen {
+			if id < lastID-seenWindow {
+				delete(seen, id)
+			}
+		}
+	}
+}
+
+func printActivity(activity dto.Activity) {
+	var subject string
+	switch {
+	case activity.Card != nil:
+		subject = fmt.Sprintf("%s (%s)", activity.Card.Title, activity.Card.ID)
+	case activity.Column != nil:
+		subject = fmt.Sprintf("%s (%s)", activity.Column.Title, activity.Column.ID)
+	case activity.Swimlane != nil:
+		subject = fmt.Sprintf("%s (%s)", activity.Swimlane.Title, activity.Swimlane.ID)
+	case activity.Board != nil:
+		subject = fmt.Sprintf("%s (%s)", activity.Board.Title, activity.Board.ID)
+	}
+
+	fmt.Printf("[%s] %s: %s\n", activity.CreatedAt.Local().Format("15:04:05"), activity.Kind, subject)
 }
 
 func (uc *ClientUseCase) Stats(ctx context.Context, from, to string) {
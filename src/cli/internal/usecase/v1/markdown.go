@@ -0,0 +1,196 @@
+package v1
+
+import (
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// ANSI escapes of the styles descriptions are printed with on a terminal.
+const (
+	ansiBold      = "\033[1m"
+	ansiItalic    = "\033[3m"
+	ansiUnderline = "\033[4m"
+	ansiDim       = "\033[2m"
+	ansiReset     = "\033[0m"
+)
+
+var (
+	mdHeading     = regexp.MustCompile(`^(#{1,6})\s+(.*?)\s*#*\s*$`)
+	mdTask        = regexp.MustCompile(`^(\s*)[-*+]\s+\[([ xX])\]\s+(.*)$`)
+	mdBullet      = regexp.MustCompile(`^(\s*)[-*+]\s+(.*)$`)
+	mdOrdered     = regexp.MustCompile(`^(\s*)(\d+)[.)]\s+(.*)$`)
+	mdQuote       = regexp.MustCompile(`^\s*>\s?(.*)$`)
+	mdRule        = regexp.MustCompile(`^\s*([-*_])(\s*[-*_]){2,}\s*$`)
+	mdFence       = regexp.MustCompile("^\\s*(```|~~~)")
+	mdCodeSpan    = regexp.MustCompile("`([^`]+)`")
+	mdLink        = regexp.MustCompile(`\[([^\]]+)\]\(([^)\s]+)\)`)
+	mdAngleLink   = regexp.MustCompile(`<((?:https?|mailto):[^>\s]+)>`)
+	mdBareURL     = regexp.MustCompile(`https?://[^\s<>()]+[^\s<>().,;:!?'"]`)
+	mdStrong      = regexp.MustCompile(`(\*\*|__)([^*_]+?)(\*\*|__)`)
+	mdEmphasis    = regexp.MustCompile(`(^|[^\w*])[*_]([^*_\s][^*_]*?)[*_]($|[^\w*])`)
+	mdStrike      = regexp.MustCompile(`~~([^~]+)~~`)
+	mdAsideMarker = regexp.MustCompile("\x00(\\d+)\x00")
+)
+
+// colorTerminal is whether stdout is a terminal that takes ANSI styles; it
+// is not when piped or when NO_COLOR is set.
+func colorTerminal() bool {
+	if os.Getenv("NO_COLOR") != "" {
+		return false
+	}
+
+	info, err := os.Stdout.Stat()
+	if err != nil {
+		return false
+	}
+
+	return info.Mode()&os.ModeCharDevice != 0
+}
+
+// renderMarkdown lays out a Markdown text for a terminal: headings, lists,
+// task checkboxes, quotes, code and links. Styles use ANSI escapes if ansi
+// is set and are dropped, markers and all, otherwise. Control characters of
+// the text itself are dropped first, so that a description cannot send the
+// terminal escapes of its own.
+func renderMarkdown(src string, ansi bool) string {
+	var out []string
+	inCode := false
+
+	src = stripControl(strings.ReplaceAll(src, "\r\n", "\n"))
+
+	for _, line := range strings.Split(src, "\n") {
+		if mdFence.MatchString(line) {
+			inCode = !inCode
+			continue
+		}
+
+		if inCode {
+			out = append(out, "    "+ansiStyle(ansi, ansiDim, line))
+			continue
+		}
+
+		if m := mdHeading.FindStringSubmatch(line); m != nil {
+			text := renderInline(m[2], ansi)
+			switch len(m[1]) {
+			case 1:
+				out = append(out, ansiStyle(ansi, ansiBold+ansiUnderline, text))
+				if !ansi {
+					out = append(out, strings.Repeat("=", utf8.RuneCountInString(text)))
+				}
+			case 2:
+				out = append(out, ansiStyle(ansi, ansiBold, text))
+				if !ansi {
+					out = append(out, strings.Repeat("-", utf8.RuneCountInString(text)))
+				}
+			default:
+				out = append(out, ansiStyle(ansi, ansiBold, text))
+			}
+			continue
+		}
+
+		if mdRule.MatchString(line) {
+			out = append(out, strings.Repeat("─", 40))
+			continue
+		}
+
+		if m := mdTask.FindStringSubmatch(line); m != nil {
+			box := "☐"
+			if m[2] != " " {
+				box = "☑"
+			}
+			out = append(out, m[1]+"  "+box+" "+renderInline(m[3], ansi))
+			continue
+		}
+
+		if m := mdBullet.FindStringSubmatch(line); m != nil {
+			out = append(out, m[1]+"  • "+renderInline(m[2], ansi))
+			continue
+		}
+
+		if m := mdOrdered.FindStringSubmatch(line); m != nil {
+			out = append(out, m[1]+"  "+m[2]+". "+renderInline(m[3], ansi))
+			continue
+		}
+
+		if m := mdQuote.FindStringSubmatch(line); m != nil {
+			out = append(out, "│ "+ansiStyle(ansi, ansiItalic, renderInline(m[1], ansi)))
+			continue
+		}
+
+		out = append(out, renderInline(line, ansi))
+	}
+
+	return strings.TrimRight(strings.Join(out, "\n"), "\n")
+}
+
+// stripControl drops the C0 and C1 control characters of s but for line
+// feeds and tabs.
+func stripControl(s string) string {
+	return strings.Map(func(r rune) rune {
+		if r != '\n' && r != '\t' && unicode.IsControl(r) {
+			return -1
+		}
+		return r
+	}, s)
+}
+
+// renderInline styles the emphasis, code and links of a line. Code spans
+// and links are set aside first, so that nothing inside them is taken for
+// emphasis.
+func renderInline(line string, ansi bool) string {
+	line = strings.ReplaceAll(line, "\x00", "")
+
+	var aside []string
+	setAside := func(rendered string) string {
+		aside = append(aside, rendered)
+		return "\x00" + strconv.Itoa(len(aside)-1) + "\x00"
+	}
+
+	line = mdCodeSpan.ReplaceAllStringFunc(line, func(s string) string {
+		return setAside(ansiStyle(ansi, ansiDim, mdCodeSpan.FindStringSubmatch(s)[1]))
+	})
+	line = mdLink.ReplaceAllStringFunc(line, func(s string) string {
+		m := mdLink.FindStringSubmatch(s)
+		if m[1] == m[2] {
+			return setAside(ansiStyle(ansi, ansiUnderline, m[2]))
+		}
+		return setAside(m[1] + " (" + ansiStyle(ansi, ansiUnderline, m[2]) + ")")
+	})
+	line = mdAngleLink.ReplaceAllStringFunc(line, func(s string) string {
+		return setAside(ansiStyle(ansi, ansiUnderline, mdAngleLink.FindStringSubmatch(s)[1]))
+	})
+	line = mdBareURL.ReplaceAllStringFunc(line, func(s string) string {
+		return setAside(ansiStyle(ansi, ansiUnderline, s))
+	})
+
+	line = mdStrong.ReplaceAllStringFunc(line, func(s string) string {
+		m := mdStrong.FindStringSubmatch(s)
+		if m[1] != m[3] {
+			return s
+		}
+		return ansiStyle(ansi, ansiBold, m[2])
+	})
+	line = mdEmphasis.ReplaceAllStringFunc(line, func(s string) string {
+		m := mdEmphasis.FindStringSubmatch(s)
+		return m[1] + ansiStyle(ansi, ansiItalic, m[2]) + m[3]
+	})
+	line = mdStrike.ReplaceAllString(line, "$1")
+
+	return mdAsideMarker.ReplaceAllStringFunc(line, func(s string) string {
+		i, _ := strconv.Atoi(mdAsideMarker.FindStringSubmatch(s)[1])
+		return aside[i]
+	})
+}
+
+// ansiStyle wraps text in the ANSI escape code if ansi is set.
+func ansiStyle(ansi bool, code, text string) string {
+	if !ansi {
+		return text
+	}
+
+	return code + text + ansiReset
+}
@@ -0,0 +1,106 @@
+package v1
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestRenderMarkdown(t *testing.T) {
+	tests := []struct {
+		name string
+		src  string
+		ansi bool
+		want string
+	}{
+		{
+			name: "heading",
+			src:  "# Title",
+			want: "Title\n=====",
+		},
+		{
+			name: "heading styled",
+			src:  "## Title",
+			ansi: true,
+			want: ansiBold + "Title" + ansiReset,
+		},
+		{
+			name: "lists",
+			src:  "- one\n1. two\n- [x] three\n- [ ] four",
+			want: "  • one\n  1. two\n  ☑ three\n  ☐ four",
+		},
+		{
+			name: "quote and rule",
+			src:  "> said\n---",
+			want: "│ said\n" + strings.Repeat("─", 40),
+		},
+		{
+			name: "code block",
+			src:  "```\n**not bold**\n```",
+			want: "    **not bold**",
+		},
+		{
+			name: "inline",
+			src:  "**bold** and *it* with `**code**` and ~~gone~~",
+			want: "bold and it with **code** and gone",
+		},
+		{
+			name: "inline styled",
+			src:  "**bold**",
+			ansi: true,
+			want: ansiBold + "bold" + ansiReset,
+		},
+		{
+			name: "links",
+			src:  "[docs](https://example.com) and <https://example.org>",
+			want: "docs (https://example.com) and https://example.org",
+		},
+		{
+			name: "crlf",
+			src:  "one\r\ntwo",
+			want: "one\ntwo",
+		},
+		{
+			name: "escape sequences",
+			src:  "\x1b]0;pwned\x07plain \x1b[31mred\x1b[0m",
+			want: "]0;pwnedplain [31mred[0m",
+		},
+		{
+			name: "c0 and c1 controls",
+			src:  "a\x00b\x08c\rd\u009be\u0085f",
+			want: "abcdef",
+		},
+		{
+			name: "tabs and newlines kept",
+			src:  "a\tb\nc",
+			want: "a\tb\nc",
+		},
+		{
+			name: "controls in code block",
+			src:  "```\n\x1b[2Jclear\n```",
+			want: "    [2Jclear",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := renderMarkdown(tt.src, tt.ansi); got != tt.want {
+				t.Errorf("renderMarkdown(%q, %v) = %q, want %q", tt.src, tt.ansi, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRenderMarkdownNoRawEscapes(t *testing.T) {
+	got := renderMarkdown("# \x1b[1mHi\u009b\n- *a*\x1b", true)
+
+	// The only escapes left are the styles the renderer adds itself.
+	for _, style := range []string{ansiBold, ansiItalic, ansiUnderline, ansiDim, ansiReset} {
+		got = strings.ReplaceAll(got, style, "")
+	}
+
+	if strings.ContainsAny(got, "\x1b\u009b") {
+		t.Errorf("rendered text keeps raw escapes: %q", got)
+	}
+}
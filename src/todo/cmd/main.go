@@ -5,18 +5,28 @@ import (
 	"time"
 	_ "time/tzdata"
 	"todo/internal/adapter/database"
+	"todo/internal/adapter/feed"
 	"todo/internal/adapter/logger"
 
+	"context"
 	"log"
 	"net/http"
+	"strings"
+	memoryRepo "todo/internal/adapter/repository/memory"
+	mongoRepo "todo/internal/adapter/repository/mongo"
 	sqlxRepo "todo/internal/adapter/repository/sqlx"
+	"todo/internal/adapter/repository/unsupported"
 	api "todo/internal/api/v1"
 	"todo/internal/config"
+	"todo/internal/entity"
 	handler "todo/internal/handler/v1"
 	"todo/internal/middleware"
+	"todo/internal/repository"
 	usecase "todo/internal/usecase/v1"
 
 	"github.com/gorilla/mux"
+	"github.com/jmoiron/sqlx"
+	"go.mongodb.org/mongo-driver/mongo"
 )
 
 func init() {
@@ -27,13 +37,182 @@ func init() {
 	time.Local = loc
 }
 
+type repos struct {
+	board     repository.BoardRepository
+	column    repository.ColumnRepository
+	swimlane  repository.SwimlaneRepository
+	card      repository.CardRepository
+	activity  repository.ActivityRepository
+	timeEntry repository.TimeEntryRepository
+	cardFlow  repository.CardFlowRepository
+	stats     repository.BoardStatsRepository
+	sprint    repository.SprintRepository
+	calendar  repository.CalendarRepository
+	boardMark repository.BoardMarkRepository
+	notify    repository.NotificationRepository
+	template  repository.CardTemplateRepository
+	quota     repository.QuotaRepository
+	workspace repository.WorkspaceRepository
+	tx        repository.TxManager
+	// leftOut names the features the backend has no repositories for.
+	leftOut []string
+}
+
+type dbrepo interface {
+	DB() (any, error)
+	Repos(any) repos
+}
+
+type postgres struct {
+	cfg *config.Config
+}
+
+// func (p *postgres) DB(cfg config.PostgresConfig) (*sqlx.DB, error) {
+func (p *postgres) DB() (any, error) {
+	return database.NewPostgresDB(p.cfg.Todo.Postgres)
+}
+
+// func (p *postgres) Repos(db *sqlx.DB) repos {
+func (p *postgres) Repos(db any) repos {
+	sqlxDB := db.(*sqlx.DB)
+
+	return repos{
+		board:     sqlxRepo.NewSQLXBoardRepository(sqlxDB),
+		column:    sqlxRepo.NewSQLXColumnRepository(sqlxDB),
+		swimlane:  sqlxRepo.NewSQLXSwimlaneRepository(sqlxDB),
+		card:      sqlxRepo.NewSQLXCardRepository(sqlxDB),
+		activity:  sqlxRepo.NewSQLXActivityRepository(sqlxDB),
+		timeEntry: sqlxRepo.NewSQLXTimeEntryRepository(sqlxDB),
+		cardFlow:  sqlxRepo.NewSQLXCardFlowRepository(sqlxDB),
+		stats:     sqlxRepo.NewSQLXBoardStatsRepository(sqlxDB),
+		sprint:    sqlxRepo.NewSQLXSprintRepository(sqlxDB),
+		calendar:  sqlxRepo.NewSQLXCalendarRepository(sqlxDB),
+		boardMark: sqlxRepo.NewSQLXBoardMarkRepository(sqlxDB),
+		notify:    sqlxRepo.NewSQLXNotificationRepository(sqlxDB),
+		template:  sqlxRepo.NewSQLXCardTemplateRepository(sqlxDB),
+		quota:     sqlxRepo.NewSQLXQuotaRepository(sqlxDB),
+		workspace: sqlxRepo.NewSQLXWorkspaceRepository(sqlxDB),
+		tx:        sqlxRepo.NewSQLXTxManager(sqlxDB),
+	}
+}
+
+// sqlite runs the SQLX repositories of postgres on a database file.
+type sqlite struct {
+	postgres
+}
+
+// func (s *sqlite) DB(cfg config.SQLiteConfig) (*sqlx.DB, error) {
+func (s *sqlite) DB() (any, error) {
+	return database.NewSQLiteDB(s.cfg.Todo.SQLite)
+}
+
+// postgresOnly lists the features only the SQLX repositories have.
+var postgresOnly = []string{
+	api.FeatureTimeTracking,
+	api.FeatureAnalytics,
+	api.FeatureBoardStats,
+	api.FeatureSprints,
+	api.FeatureCalendar,
+	api.FeatureBoardMarks,
+	api.FeatureNotifications,
+	api.FeatureCardTemplates,
+}
+
+type mongodb struct {
+	cfg *config.Config
+}
+
+// func (m *mongodb) DB(cfg config.MongoConfig) (*mongo.Database, error) {
+func (m *mongodb) DB() (any, error) {
+	db, err := database.NewMongoDB(m.cfg.Todo.Mongo)
+	if err != nil {
+		return nil, err
+	}
+
+	return db, mongoRepo.CreateIndexes(context.TODO(), db)
+}
+
+// Time tracking, analytics, board stats, sprints, calendar feeds, board
+// marks, notifications and card templates are left to Postgres.
+// func (m *mongodb) Repos(db *mongo.Database) repos {
+func (m *mongodb) Repos(db any) repos {
+	mongoDB := db.(*mongo.Database)
+	none := unsupported.NewRepository("mongo")
+
+	return repos{
+		board:     mongoRepo.NewMongoBoardRepository(mongoDB),
+		column:    mongoRepo.NewMongoColumnRepository(mongoDB),
+		swimlane:  mongoRepo.NewMongoSwimlaneRepository(mongoDB),
+		card:      mongoRepo.NewMongoCardRepository(mongoDB),
+		activity:  mongoRepo.NewMongoActivityRepository(mongoDB),
+		timeEntry: none,
+		cardFlow:  none,
+		stats:     none,
+		sprint:    none,
+		calendar:  none,
+		boardMark: none,
+		notify:    none,
+		template:  none,
+		quota:     mongoRepo.NewMongoQuotaRepository(mongoDB),
+		workspace: mongoRepo.NewMongoWorkspaceRepository(mongoDB),
+		tx:        mongoRepo.NewMongoTxManager(mongoDB),
+		leftOut:   postgresOnly,
+	}
+}
+
+type memory struct{}
+
+// func (m *memory) DB() (*memoryRepo.Store, error) {
+func (m *memory) DB() (any, error) {
+	return memoryRepo.NewStore(), nil
+}
+
+// The in-memory store has what Mongo has.
+// func (m *memory) Repos(store *memoryRepo.Store) repos {
+func (m *memory) Repos(db any) repos {
+	store := db.(*memoryRepo.Store)
+	none := unsupported.NewRepository("memory")
+
+	return repos{
+		board:     memoryRepo.NewMemoryBoardRepository(store),
+		column:    memoryRepo.NewMemoryColumnRepository(store),
+		swimlane:  memoryRepo.NewMemorySwimlaneRepository(store),
+		card:      memoryRepo.NewMemoryCardRepository(store),
+		activity:  memoryRepo.NewMemoryActivityRepository(store),
+		timeEntry: none,
+		cardFlow:  none,
+		stats:     none,
+		sprint:    none,
+		calendar:  none,
+		boardMark: none,
+		notify:    none,
+		template:  none,
+		quota:     memoryRepo.NewMemoryQuotaRepository(store),
+		workspace: memoryRepo.NewMemoryWorkspaceRepository(store),
+		tx:        memoryRepo.NewStoreTxManager(store),
+		leftOut:   postgresOnly,
+	}
+}
+
 func main() {
 	config, err := config.LoadConfig("config.toml")
 	if err != nil {
 		log.Println("Error reading config (config.toml)")
 	}
 
-	db, err := database.NewPostgresDB(config.Todo.Postgres)
+	dbmap := make(map[string]dbrepo)
+	dbmap["postgres"] = &postgres{cfg: config}
+	dbmap["sqlite"] = &sqlite{postgres{cfg: config}}
+	dbmap["mongo"] = &mongodb{cfg: config}
+	dbmap["memory"] = &memory{}
+
+	dbRepo, ok := dbmap[config.Todo.Database]
+	if !ok {
+		log.Printf("Unknown database %q, exiting", config.Todo.Database)
+		return
+	}
+
+	db, err := dbRepo.DB()
 	if err != nil {
 		log.Println("Couldn't connect to database, exiting")
 		return
@@ -41,17 +220,63 @@ func main() {
 
 	logger := logger.NewZapLogger(config.Todo.Log)
 
-	boardRepo := sqlxRepo.NewSQLXBoardRepository(db)
-	columnRepo := sqlxRepo.NewSQLXColumnRepository(db)
-	cardRepo := sqlxRepo.NewSQLXCardRepository(db)
+	r := dbRepo.Repos(db)
+	if len(r.leftOut) > 0 {
+		log.Printf("Database %q leaves out %s, their routes answer 501", config.Todo.Database, strings.Join(r.leftOut, ", "))
+	}
+
+	boardRepo := r.board
+	columnRepo := r.column
+	swimlaneRepo := r.swimlane
+	cardRepo := r.card
+	activityRepo := r.activity
+	timeEntryRepo := r.timeEntry
+	cardFlowRepo := r.cardFlow
+	statsRepo := r.stats
+	sprintRepo := r.sprint
+	calendarRepo := r.calendar
+	boardMarkRepo := r.boardMark
+	notificationRepo := r.notify
+	templateRepo := r.template
+	quotaRepo := r.quota
+	workspaceRepo := r.workspace
+	txManager := r.tx
+	hub := feed.NewHub()
 
-	uc := usecase.NewTodoUseCase(boardRepo, columnRepo, cardRepo, logger)
+	uc := usecase.NewTodoUseCase(boardRepo, columnRepo, swimlaneRepo, cardRepo, workspaceRepo, txManager, logger)
+	quotaUC := usecase.NewQuotaUseCase(uc, quotaRepo, boardRepo, columnRepo, cardRepo, txManager, entity.Quota(config.Todo.Quota), logger)
+	feedUC := usecase.NewFeedUseCase(quotaUC, activityRepo, boardRepo, columnRepo, swimlaneRepo, cardRepo, txManager, hub, logger)
+	accessUC := usecase.NewAccessUseCase(feedUC, boardRepo, columnRepo, swimlaneRepo, cardRepo, workspaceRepo, logger)
+	timeUC := usecase.NewTimeUseCase(timeEntryRepo, cardRepo, columnRepo, boardRepo, workspaceRepo, txManager, logger)
+	analyticsUC := usecase.NewAnalyticsUseCase(cardFlowRepo, boardRepo, workspaceRepo, logger)
+	statsUC := usecase.NewBoardStatsUseCase(statsRepo, boardRepo, workspaceRepo, logger)
+	sprintUC := usecase.NewSprintUseCase(sprintRepo, boardRepo, columnRepo, cardRepo, workspaceRepo, txManager, logger)
+	calendarUC := usecase.NewCalendarUseCase(calendarRepo, logger)
+	boardMarkUC := usecase.NewBoardMarkUseCase(boardMarkRepo, boardRepo, workspaceRepo, logger)
+	notificationUC := usecase.NewNotificationUseCase(notificationRepo, boardRepo, columnRepo, cardRepo, workspaceRepo, logger)
+	templateUC := usecase.NewCardTemplateUseCase(templateRepo, boardRepo, columnRepo, workspaceRepo, accessUC, logger)
+	workspaceUC := usecase.NewWorkspaceUseCase(workspaceRepo, txManager, logger)
+	userDataUC := usecase.NewUserDataUseCase(boardRepo, workspaceRepo, boardMarkRepo, calendarRepo, notificationRepo, quotaRepo, txManager, entity.Quota(config.Todo.Quota), logger)
 
-	userHandler := handler.NewTodoHandler(uc, config.Pagination)
+	userHandler := handler.NewTodoHandler(accessUC, templateUC, config.Pagination)
+	feedHandler := handler.NewFeedHandler(accessUC)
+	timeHandler := handler.NewTimeHandler(timeUC)
+	analyticsHandler := handler.NewAnalyticsHandler(analyticsUC)
+	statsHandler := handler.NewBoardStatsHandler(statsUC)
+	sprintHandler := handler.NewSprintHandler(sprintUC)
+	calendarHandler := handler.NewCalendarHandler(calendarUC)
+	boardMarkHandler := handler.NewBoardMarkHandler(boardMarkUC, config.Pagination)
+	notificationHandler := handler.NewNotificationHandler(notificationUC, config.Pagination)
+	workspaceHandler := handler.NewWorkspaceHandler(workspaceUC, config.Pagination)
+	userDataHandler := handler.NewUserDataHandler(userDataUC)
+	templateHandler := handler.NewCardTemplateHandler(templateUC)
+	quotaHandler := handler.NewQuotaHandler(quotaUC)
 	router := mux.NewRouter()
 	loggingMiddleware := middleware.NewLoggingMiddleware(logger)
 	router.Use(loggingMiddleware.Middleware)
-	api.InitializeV1Routes(router, userHandler)
+	callerMiddleware := middleware.NewCallerMiddleware()
+	router.Use(callerMiddleware.Middleware)
+	api.InitializeV1Routes(router, r.leftOut, userHandler, feedHandler, timeHandler, analyticsHandler, statsHandler, sprintHandler, calendarHandler, boardMarkHandler, notificationHandler, workspaceHandler, userDataHandler, templateHandler, quotaHandler)
 
 	localPort := fmt.Sprintf("%d", config.Todo.LocalPort)
 	exposedPort := fmt.Sprintf("%d", config.Todo.ExposedPort)
@@ -0,0 +1,31 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"todo/internal/config"
+
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func NewMongoDB(cfg config.MongoConfig) (*mongo.Database, error) {
+	URI := fmt.Sprintf("mongodb://%s:%d/?replicaSet=%s",
+		cfg.Host, cfg.Port, cfg.ReplicaSet)
+
+	opts := options.Client().ApplyURI(URI)
+	if cfg.User != "" {
+		opts.SetAuth(options.Credential{Username: cfg.User, Password: cfg.Password})
+	}
+
+	client, err := mongo.Connect(context.TODO(), opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to MongoDB: %w", err)
+	}
+
+	db := client.Database(cfg.DBName)
+
+	log.Println("Connected to MongoDB successfully")
+	return db, nil
+}
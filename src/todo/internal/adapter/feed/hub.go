@@ -0,0 +1,73 @@
+package feed
+
+import (
+	"sync"
+	"todo/internal/entity"
+
+	"github.com/google/uuid"
+)
+
+// watcherBuffer is how many activities a watcher may fall behind by before
+// it is dropped.
+const watcherBuffer = 64
+
+// Hub fans activities out to the watchers of their board within this
+// process. A watcher that falls behind has its channel closed; it is expected
+// to reconnect and catch up from the activity table.
+type Hub struct {
+	mu       sync.Mutex
+	watchers map[uuid.UUID]map[chan entity.Activity]struct{}
+}
+
+func NewHub() *Hub {
+	return &Hub{
+		watchers: make(map[uuid.UUID]map[chan entity.Activity]struct{}),
+	}
+}
+
+func (h *Hub) Subscribe(boardID uuid.UUID) (<-chan entity.Activity, func()) {
+	ch := make(chan entity.Activity, watcherBuffer)
+
+	h.mu.Lock()
+	if h.watchers[boardID] == nil {
+		h.watchers[boardID] = make(map[chan entity.Activity]struct{})
+	}
+	h.watchers[boardID][ch] = struct{}{}
+	h.mu.Unlock()
+
+	cancel := func() {
+		h.mu.Lock()
+		defer h.mu.Unlock()
+		h.drop(boardID, ch)
+	}
+
+	return ch, cancel
+}
+
+func (h *Hub) Publish(activity entity.Activity) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for ch := range h.watchers[activity.BoardID] {
+		select {
+		case ch <- activity:
+		default:
+			h.drop(activity.BoardID, ch)
+		}
+	}
+}
+
+// drop closes and forgets a watcher channel, once; h.mu must be held.
+func (h *Hub) drop(boardID uuid.UUID, ch chan entity.Activity) {
+	watchers := h.watchers[boardID]
+	if _, ok := watchers[ch]; !ok {
+		return
+	}
+
+	delete(watchers, ch)
+	close(ch)
+
+	if len(watchers) == 0 {
+		delete(h.watchers, boardID)
+	}
+}
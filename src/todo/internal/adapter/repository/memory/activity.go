@@ -0,0 +1,61 @@
+package memory
+
+import (
+	"context"
+	"todo/internal/entity"
+	"todo/internal/repository"
+
+	"github.com/google/uuid"
+)
+
+type MemoryActivityRepository struct {
+	store *Store
+}
+
+func NewMemoryActivityRepository(store *Store) *MemoryActivityRepository {
+	return &MemoryActivityRepository{store: store}
+}
+
+// AddActivity numbers activities in the order they are added, as the SQL
+// schema does with a sequence. They are stored as the rows the SQLX
+// repository writes, so the caller keeps the entities it passes.
+func (r *MemoryActivityRepository) AddActivity(ctx context.Context, activity *entity.Activity) error {
+	repoActivity, err := repository.RepoActivity(*activity)
+	if err != nil {
+		return err
+	}
+
+	return r.store.run(ctx, func(d *data) error {
+		d.lastActivityID++
+		repoActivity.ID = d.lastActivityID
+		d.activities = append(d.activities, repoActivity)
+
+		activity.ID = repoActivity.ID
+
+		return nil
+	})
+}
+
+func (r *MemoryActivityRepository) GetActivities(ctx context.Context, boardID uuid.UUID, afterID int64, limit int) ([]entity.Activity, error) {
+	var repoActivities []repository.Activity
+
+	err := r.store.run(ctx, func(d *data) error {
+		repoActivities = pageOf(d.activities, limit, func(a repository.Activity) bool {
+			return a.BoardID == boardID && a.ID > afterID
+		})
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	activities := make([]entity.Activity, len(repoActivities))
+	for i, a := range repoActivities {
+		if activities[i], err = repository.ActivityToEntity(a); err != nil {
+			return nil, err
+		}
+	}
+
+	return activities, nil
+}
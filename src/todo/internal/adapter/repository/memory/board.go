@@ -0,0 +1,146 @@
+package memory
+
+import (
+	"context"
+	"todo/internal/entity"
+	"todo/internal/repository"
+
+	"github.com/google/uuid"
+)
+
+type MemoryBoardRepository struct {
+	store *Store
+}
+
+func NewMemoryBoardRepository(store *Store) *MemoryBoardRepository {
+	return &MemoryBoardRepository{store: store}
+}
+
+func (r *MemoryBoardRepository) CreateBoard(ctx context.Context, board *entity.Board) error {
+	lane := entity.Swimlane{
+		ID:        uuid.New(),
+		UserID:    board.UserID,
+		BoardID:   board.ID,
+		Title:     repository.DefaultSwimlaneTitle,
+		Version:   1,
+		CreatedAt: board.CreatedAt,
+		UpdatedAt: board.CreatedAt,
+	}
+
+	return r.store.run(ctx, func(d *data) error {
+		if _, ok := d.workspaces[board.WorkspaceID]; !ok {
+			return repository.ErrWorkspaceNotFound
+		}
+
+		if err := insert(d.boards, board.ID, *board); err != nil {
+			return err
+		}
+
+		d.swimlanes[lane.ID] = lane
+
+		return nil
+	})
+}
+
+func (r *MemoryBoardRepository) GetBoardByID(ctx context.Context, id uuid.UUID) (*entity.Board, error) {
+	var board entity.Board
+
+	err := r.store.run(ctx, func(d *data) error {
+		var ok bool
+		if board, ok = d.boards[id]; !ok {
+			return repository.ErrBoardNotFound
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &board, nil
+}
+
+func (r *MemoryBoardRepository) GetBoardsByUser(ctx context.Context, userID uuid.UUID, page repository.Page) ([]entity.Board, error) {
+	var boards []entity.Board
+
+	err := r.store.run(ctx, func(d *data) (err error) {
+		boards, err = d.findBoards(func(b entity.Board) bool {
+			return b.UserID == userID
+		}, page)
+
+		return err
+	})
+
+	return boards, err
+}
+
+// findBoards lists a page of the boards match selects in the order they were
+// made.
+func (d *data) findBoards(match func(b entity.Board) bool, page repository.Page) ([]entity.Board, error) {
+	return pageByTime(values(d.boards, match), page, func(b entity.Board) timeKey {
+		return timeKey{at: b.CreatedAt, id: b.ID}
+	})
+}
+
+func (r *MemoryBoardRepository) UpdateBoard(ctx context.Context, board *entity.Board) error {
+	err := r.store.run(ctx, func(d *data) error {
+		stored, ok := d.boards[board.ID]
+		if !ok || stored.Version != board.Version {
+			return repository.ErrVersionMismatch
+		}
+
+		stored.Title = board.Title
+		stored.Version++
+		stored.UpdatedAt = board.UpdatedAt
+		d.boards[board.ID] = stored
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	board.Version++
+
+	return nil
+}
+
+func (r *MemoryBoardRepository) TransferBoard(ctx context.Context, board *entity.Board) error {
+	err := r.store.run(ctx, func(d *data) error {
+		stored, ok := d.boards[board.ID]
+		if !ok || stored.Version != board.Version {
+			return repository.ErrVersionMismatch
+		}
+
+		if _, ok := d.workspaces[board.WorkspaceID]; !ok {
+			return repository.ErrWorkspaceNotFound
+		}
+
+		stored.UserID = board.UserID
+		stored.WorkspaceID = board.WorkspaceID
+		stored.Version++
+		stored.UpdatedAt = board.UpdatedAt
+		d.boards[board.ID] = stored
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	board.Version++
+
+	return nil
+}
+
+func (r *MemoryBoardRepository) DeleteBoard(ctx context.Context, id uuid.UUID, version int) error {
+	return r.store.run(ctx, func(d *data) error {
+		if b, ok := d.boards[id]; !ok || b.Version != version {
+			return repository.ErrVersionMismatch
+		}
+
+		d.deleteBoard(id)
+
+		return nil
+	})
+}
@@ -0,0 +1,284 @@
+package memory
+
+import (
+	"context"
+	"slices"
+	"sort"
+	"time"
+	"todo/internal/entity"
+	"todo/internal/repository"
+
+	"github.com/google/uuid"
+)
+
+type MemoryCardRepository struct {
+	store *Store
+}
+
+func NewMemoryCardRepository(store *Store) *MemoryCardRepository {
+	return &MemoryCardRepository{store: store}
+}
+
+func (r *MemoryCardRepository) CreateCard(ctx context.Context, card *entity.Card) error {
+	return r.store.run(ctx, func(d *data) error {
+		column, ok := d.columns[card.ColumnID]
+		if !ok {
+			return repository.ErrColumnNotFound
+		}
+
+		laneID := card.SwimlaneID
+		if laneID == uuid.Nil {
+			laneID = d.firstSwimlane(column.BoardID)
+		} else if _, ok := d.swimlanes[laneID]; !ok {
+			return errSwimlaneNotFound
+		}
+
+		if _, ok := d.cards[card.ParentID]; card.ParentID != uuid.Nil && !ok {
+			return repository.ErrCardNotFound
+		}
+
+		stored := *card
+		stored.SwimlaneID = laneID
+		stored.Labels = labelSet(card.Labels)
+		stored.ArchivedAt = nil
+		stored.ChildCount, stored.DoneChildCount = 0, 0
+
+		if err := insert(d.cards, card.ID, stored); err != nil {
+			return err
+		}
+
+		card.SwimlaneID = laneID
+
+		return nil
+	})
+}
+
+func (r *MemoryCardRepository) GetCardByID(ctx context.Context, id uuid.UUID) (*entity.Card, error) {
+	var card entity.Card
+
+	err := r.store.run(ctx, func(d *data) error {
+		stored, ok := d.cards[id]
+		if !ok {
+			return repository.ErrCardNotFound
+		}
+
+		card = d.withRollups([]entity.Card{stored})[0]
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &card, nil
+}
+
+func (r *MemoryCardRepository) GetCardAncestors(ctx context.Context, id uuid.UUID) ([]entity.Card, error) {
+	var ancestors []entity.Card
+
+	err := r.store.run(ctx, func(d *data) error {
+		card, ok := d.cards[id]
+		for ok && card.ParentID != uuid.Nil && len(ancestors) < repository.MaxCardDepth {
+			if card, ok = d.cards[card.ParentID]; ok {
+				ancestors = append(ancestors, card)
+			}
+		}
+
+		ancestors = d.withRollups(ancestors)
+
+		return nil
+	})
+
+	return ancestors, err
+}
+
+func (r *MemoryCardRepository) SetCardParent(ctx context.Context, card *entity.Card) error {
+	err := r.store.run(ctx, func(d *data) error {
+		stored, ok := d.cards[card.ID]
+		if !ok || stored.Version != card.Version {
+			return repository.ErrVersionMismatch
+		}
+
+		if _, ok := d.cards[card.ParentID]; card.ParentID != uuid.Nil && !ok {
+			return repository.ErrCardNotFound
+		}
+
+		stored.ParentID = card.ParentID
+		stored.Version++
+		stored.UpdatedAt = card.UpdatedAt
+		d.cards[card.ID] = stored
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	card.Version++
+
+	return nil
+}
+
+func (r *MemoryCardRepository) GetCardsByColumn(ctx context.Context, columnID uuid.UUID, page repository.Page) ([]entity.Card, error) {
+	return r.findCards(ctx, func(c entity.Card) bool {
+		return c.ColumnID == columnID && c.ArchivedAt == nil
+	}, page)
+}
+
+func (r *MemoryCardRepository) UpdateCard(ctx context.Context, card *entity.Card) error {
+	err := r.store.run(ctx, func(d *data) error {
+		stored, ok := d.cards[card.ID]
+		if !ok || stored.Version != card.Version {
+			return repository.ErrVersionMismatch
+		}
+
+		stored.Title = card.Title
+		stored.Description = card.Description
+		stored.Position = card.Position
+		stored.Priority = card.Priority
+		stored.AssigneeID = card.AssigneeID
+		stored.DueDate = card.DueDate
+		stored.Labels = labelSet(card.Labels)
+		stored.Version++
+		stored.UpdatedAt = card.UpdatedAt
+		d.cards[card.ID] = stored
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	card.Version++
+
+	return nil
+}
+
+// MoveCard moves the card to its column, its swimlane or both; the one left
+// unset is kept where possible.
+func (r *MemoryCardRepository) MoveCard(ctx context.Context, card *entity.Card) error {
+	err := r.store.run(ctx, func(d *data) error {
+		stored, ok := d.cards[card.ID]
+		if !ok || stored.Version != card.Version {
+			return repository.ErrVersionMismatch
+		}
+
+		columnID := stored.ColumnID
+		if card.ColumnID != uuid.Nil {
+			columnID = card.ColumnID
+		}
+
+		laneID := card.SwimlaneID
+		if laneID == uuid.Nil {
+			var err error
+			if laneID, err = d.movedSwimlane(columnID, stored.SwimlaneID); err != nil {
+				return err
+			}
+		} else if _, ok := d.swimlanes[laneID]; !ok {
+			return errSwimlaneNotFound
+		}
+
+		if _, ok := d.columns[columnID]; !ok {
+			return repository.ErrColumnNotFound
+		}
+
+		stored.ColumnID = columnID
+		stored.SwimlaneID = laneID
+		stored.Version++
+		stored.UpdatedAt = card.UpdatedAt
+		d.cards[card.ID] = stored
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	card.Version++
+
+	return nil
+}
+
+func (r *MemoryCardRepository) DeleteCard(ctx context.Context, id uuid.UUID, version int) error {
+	return r.store.run(ctx, func(d *data) error {
+		if c, ok := d.cards[id]; !ok || c.Version != version {
+			return repository.ErrVersionMismatch
+		}
+
+		d.deleteCards(func(c entity.Card) bool {
+			return c.ID == id
+		})
+
+		return nil
+	})
+}
+
+func (r *MemoryCardRepository) GetNewCards(ctx context.Context, from, to time.Time, page repository.Page) ([]entity.Card, error) {
+	return r.findCards(ctx, func(c entity.Card) bool {
+		return !c.CreatedAt.Before(from) && !c.CreatedAt.After(to)
+	}, page)
+}
+
+// findCards lists a page of the cards match selects in the order they were
+// made.
+func (r *MemoryCardRepository) findCards(ctx context.Context, match func(c entity.Card) bool, page repository.Page) ([]entity.Card, error) {
+	var cards []entity.Card
+
+	err := r.store.run(ctx, func(d *data) (err error) {
+		cards, err = pageByTime(values(d.cards, match), page, func(c entity.Card) timeKey {
+			return timeKey{at: c.CreatedAt, id: c.ID}
+		})
+		if err != nil {
+			return err
+		}
+
+		cards = d.withRollups(cards)
+
+		return nil
+	})
+
+	return cards, err
+}
+
+// withRollups counts the active children of the given cards, and those of
+// them in done columns. It gives each card a labels slice of its own.
+func (d *data) withRollups(cards []entity.Card) []entity.Card {
+	rolled := make([]entity.Card, len(cards))
+	if len(cards) == 0 {
+		return rolled
+	}
+
+	childCount := make(map[uuid.UUID]int)
+	doneChildCount := make(map[uuid.UUID]int)
+	for _, c := range d.cards {
+		if c.ParentID == uuid.Nil || c.ArchivedAt != nil {
+			continue
+		}
+		childCount[c.ParentID]++
+		if d.columns[c.ColumnID].Done {
+			doneChildCount[c.ParentID]++
+		}
+	}
+
+	for i, c := range cards {
+		c.Labels = slices.Clone(c.Labels)
+		c.ChildCount = childCount[c.ID]
+		c.DoneChildCount = doneChildCount[c.ID]
+		rolled[i] = c
+	}
+
+	return rolled
+}
+
+// labelSet stores labels the way the card_labels table does: once each, in
+// order, and nil when there are none.
+func labelSet(labels []string) []string {
+	if len(labels) == 0 {
+		return nil
+	}
+
+	set := slices.Clone(labels)
+	sort.Strings(set)
+
+	return slices.Compact(set)
+}
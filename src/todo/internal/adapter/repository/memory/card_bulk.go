@@ -0,0 +1,165 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"time"
+	"todo/internal/entity"
+	"todo/internal/repository"
+
+	"github.com/google/uuid"
+)
+
+// ApplyCardBatch changes only cards, so a copy of them taken before an
+// operation, or before the batch, serves as its savepoint.
+func (r *MemoryCardRepository) ApplyCardBatch(ctx context.Context, batch repository.CardBatch) ([]repository.CardOpResult, error) {
+	results := make([]repository.CardOpResult, len(batch.Ops))
+
+	err := r.store.run(ctx, func(d *data) error {
+		batchSaved := maps.Clone(d.cards)
+
+		for i, op := range batch.Ops {
+			saved := maps.Clone(d.cards)
+
+			opErr := d.applyCardOp(op, batch.At)
+
+			if opErr == nil {
+				results[i].Status = repository.CardOpApplied
+				continue
+			}
+
+			results[i] = repository.CardOpResult{Status: repository.CardOpFailed, Err: opErr}
+			d.cards = saved
+
+			if batch.AllOrNothing {
+				for j := range results {
+					if j != i {
+						results[j].Status = repository.CardOpRolledBack
+					}
+				}
+				d.cards = batchSaved
+				return nil
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+func (d *data) applyCardOp(op repository.CardOp, at time.Time) error {
+	switch op.Kind {
+	case repository.CardOpMove:
+		return d.touchCard(op, func(c *entity.Card) error {
+			laneID, err := d.movedSwimlane(op.ColumnID, c.SwimlaneID)
+			if err != nil {
+				return err
+			}
+			c.ColumnID, c.SwimlaneID, c.UpdatedAt = op.ColumnID, laneID, at
+			return nil
+		})
+	case repository.CardOpArchive:
+		if err := d.archiveChildren(op, at); err != nil {
+			return err
+		}
+		return d.touchCard(op, func(c *entity.Card) error {
+			c.ArchivedAt, c.UpdatedAt = &at, at
+			return nil
+		})
+	case repository.CardOpSetAssignee:
+		return d.touchCard(op, func(c *entity.Card) error {
+			c.AssigneeID, c.UpdatedAt = op.AssigneeID, at
+			return nil
+		})
+	case repository.CardOpSetLabel:
+		return d.touchCard(op, func(c *entity.Card) error {
+			c.Labels, c.UpdatedAt = labelSet(append(c.Labels[:len(c.Labels):len(c.Labels)], op.Label)), at
+			return nil
+		})
+	case repository.CardOpDelete:
+		if _, err := d.opCard(op); err != nil {
+			return err
+		}
+		d.deleteCards(func(c entity.Card) bool {
+			return c.ID == op.CardID
+		})
+		return nil
+	default:
+		return fmt.Errorf("unknown card operation %q", op.Kind)
+	}
+}
+
+// archiveChildren archives the active descendants of a card being archived
+// if the operation cascades, and refuses to leave the question open when the
+// card has any.
+func (d *data) archiveChildren(op repository.CardOp, at time.Time) error {
+	if op.Cascade == nil {
+		for _, c := range d.cards {
+			if c.ParentID == op.CardID && c.ArchivedAt == nil {
+				return repository.ErrCardHasChildren
+			}
+		}
+		return nil
+	}
+
+	if !*op.Cascade {
+		return nil
+	}
+
+	level := map[uuid.UUID]bool{op.CardID: true}
+	for depth := 0; depth < repository.MaxCardDepth && len(level) > 0; depth++ {
+		next := make(map[uuid.UUID]bool)
+		for id, c := range d.cards {
+			if !level[c.ParentID] {
+				continue
+			}
+			next[id] = true
+			if c.ArchivedAt == nil {
+				c.ArchivedAt, c.UpdatedAt = &at, at
+				c.Version++
+				d.cards[id] = c
+			}
+		}
+		level = next
+	}
+
+	return nil
+}
+
+// opCard returns the card of the operation, at its version. It tells a
+// missing card from a stale version.
+func (d *data) opCard(op repository.CardOp) (entity.Card, error) {
+	c, ok := d.cards[op.CardID]
+
+	switch {
+	case !ok:
+		return c, repository.ErrCardNotFound
+	case c.Version != op.Version:
+		return c, repository.ErrVersionMismatch
+	}
+
+	return c, nil
+}
+
+// touchCard changes the card of the operation with change and bumps its
+// version.
+func (d *data) touchCard(op repository.CardOp, change func(c *entity.Card) error) error {
+	c, err := d.opCard(op)
+	if err != nil {
+		return err
+	}
+
+	if err := change(&c); err != nil {
+		return err
+	}
+
+	c.Version++
+	d.cards[c.ID] = c
+
+	return nil
+}
@@ -0,0 +1,190 @@
+package memory
+
+import (
+	"bytes"
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+	"todo/internal/entity"
+	"todo/internal/repository"
+
+	"github.com/google/uuid"
+)
+
+func (r *MemoryCardRepository) GetCards(ctx context.Context, q repository.CardQuery) ([]entity.Card, error) {
+	sortBy := q.SortBy
+	if sortBy == "" {
+		sortBy = repository.SortByPosition
+	}
+
+	switch sortBy {
+	case repository.SortByPosition, repository.SortByPriority, repository.SortByCreated,
+		repository.SortByUpdated, repository.SortByDue:
+	default:
+		return nil, fmt.Errorf("unknown card sort field %q", sortBy)
+	}
+
+	var after *cardKey
+	if q.Page.After != nil {
+		key, err := cursorKey(q.Page.After, sortBy)
+		if err != nil {
+			return nil, err
+		}
+		after = &key
+	}
+
+	var cards []entity.Card
+
+	err := r.store.run(ctx, func(d *data) error {
+		matched := values(d.cards, func(c entity.Card) bool {
+			return d.matchCard(c, q)
+		})
+
+		sort.Slice(matched, func(i, j int) bool {
+			return compareCards(sortKey(matched[i], sortBy), sortKey(matched[j], sortBy), sortBy, q.Descending) < 0
+		})
+
+		limit := q.Page.Limit
+		if limit <= 0 {
+			limit = -1
+		}
+
+		cards = d.withRollups(pageOf(matched, limit, func(c entity.Card) bool {
+			return after == nil || compareCards(sortKey(c, sortBy), *after, sortBy, q.Descending) > 0
+		}))
+
+		return nil
+	})
+
+	return cards, err
+}
+
+// matchCard reports whether the card passes the filters of q. Text is
+// matched as a literal, case-insensitive substring of the title or the
+// description.
+func (d *data) matchCard(c entity.Card, q repository.CardQuery) bool {
+	switch {
+	case q.BoardID != nil && d.columns[c.ColumnID].BoardID != *q.BoardID:
+		return false
+	case q.ColumnID != nil && c.ColumnID != *q.ColumnID:
+		return false
+	case q.SwimlaneID != nil && c.SwimlaneID != *q.SwimlaneID:
+		return false
+	case q.ParentID != nil && c.ParentID != *q.ParentID:
+		return false
+	case len(q.Priorities) > 0 && !slices.Contains(q.Priorities, c.Priority):
+		return false
+	case q.UserID != nil && c.UserID != *q.UserID:
+		return false
+	case q.AssigneeID != nil && c.AssigneeID != *q.AssigneeID:
+		return false
+	case q.Label != nil && !slices.Contains(c.Labels, *q.Label):
+		return false
+	case q.Archived != (c.ArchivedAt != nil):
+		return false
+	}
+
+	if q.Text != nil {
+		text := strings.ToLower(*q.Text)
+		if !strings.Contains(strings.ToLower(c.Title), text) && !strings.Contains(strings.ToLower(c.Description), text) {
+			return false
+		}
+	}
+
+	return inRange(&c.CreatedAt, q.CreatedFrom, q.CreatedTo) &&
+		inRange(&c.UpdatedAt, q.UpdatedFrom, q.UpdatedTo) &&
+		inRange(c.DueDate, q.DueFrom, q.DueTo)
+}
+
+// inRange reports whether t is in the half-open range [from, to). A nil t
+// is in no range but the unbounded one, as NULL is in SQL.
+func inRange(t, from, to *time.Time) bool {
+	if from == nil && to == nil {
+		return true
+	}
+
+	return t != nil && (from == nil || !t.Before(*from)) && (to == nil || t.Before(*to))
+}
+
+// cardKey is the position of a card in a card listing: the sort field, as
+// a number or a time, and the id.
+type cardKey struct {
+	number float64
+	time   *time.Time
+	id     uuid.UUID
+}
+
+func sortKey(c entity.Card, sortBy repository.CardSortField) cardKey {
+	key := cardKey{id: c.ID}
+
+	switch sortBy {
+	case repository.SortByPosition:
+		key.number = c.Position
+	case repository.SortByPriority:
+		key.number = float64(c.Priority)
+	case repository.SortByCreated:
+		key.time = &c.CreatedAt
+	case repository.SortByUpdated:
+		key.time = &c.UpdatedAt
+	case repository.SortByDue:
+		key.time = c.DueDate
+	}
+
+	return key
+}
+
+// cursorKey reads the key of a card listing cursor, which only due dates
+// may leave without a value.
+func cursorKey(after *repository.Cursor, sortBy repository.CardSortField) (cardKey, error) {
+	key := cardKey{time: after.Time, id: after.ID}
+
+	switch sortBy {
+	case repository.SortByPosition, repository.SortByPriority:
+		if after.Number == nil {
+			return cardKey{}, repository.ErrInvalidCursor
+		}
+		key.number = *after.Number
+	case repository.SortByCreated, repository.SortByUpdated:
+		if after.Time == nil {
+			return cardKey{}, repository.ErrInvalidCursor
+		}
+	}
+
+	return key, nil
+}
+
+// compareCards orders card keys by the sort field and then the id, in the
+// given direction. Cards without a due date go last in both directions, as
+// they do in the SQL listing.
+func compareCards(a, b cardKey, sortBy repository.CardSortField, desc bool) int {
+	if (a.time == nil) != (b.time == nil) {
+		if a.time == nil {
+			return 1
+		}
+		return -1
+	}
+
+	c := 0
+	switch sortBy {
+	case repository.SortByPosition, repository.SortByPriority:
+		c = cmp.Compare(a.number, b.number)
+	default:
+		if a.time != nil {
+			c = a.time.Compare(*b.time)
+		}
+	}
+
+	if c == 0 {
+		c = bytes.Compare(a.id[:], b.id[:])
+	}
+
+	if desc {
+		return -c
+	}
+
+	return c
+}
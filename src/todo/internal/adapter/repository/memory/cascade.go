@@ -0,0 +1,83 @@
+package memory
+
+import (
+	"todo/internal/entity"
+
+	"github.com/google/uuid"
+)
+
+// The deletes below do what the foreign keys of the SQL schema do: deleting
+// a workspace deletes its members and boards, deleting a board deletes its
+// columns and swimlanes, deleting either of those deletes its cards, and
+// deleting a card takes its children to the top level.
+
+func (d *data) deleteWorkspace(id uuid.UUID) {
+	delete(d.workspaces, id)
+
+	for key := range d.members {
+		if key.workspaceID == id {
+			delete(d.members, key)
+		}
+	}
+
+	for _, b := range d.boards {
+		if b.WorkspaceID == id {
+			d.deleteBoard(b.ID)
+		}
+	}
+}
+
+func (d *data) deleteBoard(id uuid.UUID) {
+	delete(d.boards, id)
+
+	for _, c := range d.columns {
+		if c.BoardID == id {
+			d.deleteColumn(c.ID)
+		}
+	}
+
+	for _, s := range d.swimlanes {
+		if s.BoardID == id {
+			d.deleteSwimlane(s.ID)
+		}
+	}
+}
+
+func (d *data) deleteColumn(id uuid.UUID) {
+	delete(d.columns, id)
+
+	d.deleteCards(func(c entity.Card) bool {
+		return c.ColumnID == id
+	})
+}
+
+func (d *data) deleteSwimlane(id uuid.UUID) {
+	delete(d.swimlanes, id)
+
+	d.deleteCards(func(c entity.Card) bool {
+		return c.SwimlaneID == id
+	})
+}
+
+// deleteCards deletes the cards match selects.
+func (d *data) deleteCards(match func(c entity.Card) bool) {
+	deleted := make(map[uuid.UUID]bool)
+
+	for id, c := range d.cards {
+		if match(c) {
+			delete(d.cards, id)
+			deleted[id] = true
+		}
+	}
+
+	if len(deleted) == 0 {
+		return
+	}
+
+	for id, c := range d.cards {
+		if deleted[c.ParentID] {
+			c.ParentID = uuid.Nil
+			d.cards[id] = c
+		}
+	}
+}
@@ -0,0 +1,192 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"time"
+	"todo/internal/entity"
+	"todo/internal/repository"
+
+	"github.com/google/uuid"
+)
+
+type MemoryColumnRepository struct {
+	store *Store
+}
+
+func NewMemoryColumnRepository(store *Store) *MemoryColumnRepository {
+	return &MemoryColumnRepository{store: store}
+}
+
+func (r *MemoryColumnRepository) CreateColumn(ctx context.Context, column *entity.Column) error {
+	return r.store.run(ctx, func(d *data) error {
+		if _, ok := d.boards[column.BoardID]; !ok {
+			return repository.ErrBoardNotFound
+		}
+
+		return insert(d.columns, column.ID, *column)
+	})
+}
+
+func (r *MemoryColumnRepository) GetColumnByID(ctx context.Context, id uuid.UUID) (*entity.Column, error) {
+	var column entity.Column
+
+	err := r.store.run(ctx, func(d *data) error {
+		var ok bool
+		if column, ok = d.columns[id]; !ok {
+			return repository.ErrColumnNotFound
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &column, nil
+}
+
+func (r *MemoryColumnRepository) GetColumnsByBoard(ctx context.Context, boardID uuid.UUID, page repository.Page) ([]entity.Column, error) {
+	var columns []entity.Column
+
+	err := r.store.run(ctx, func(d *data) (err error) {
+		match := func(c entity.Column) bool {
+			return c.BoardID == boardID
+		}
+
+		columns, err = pageByTime(values(d.columns, match), page, func(c entity.Column) timeKey {
+			return timeKey{at: c.CreatedAt, id: c.ID}
+		})
+
+		return err
+	})
+
+	return columns, err
+}
+
+func (r *MemoryColumnRepository) UpdateColumn(ctx context.Context, column *entity.Column) error {
+	err := r.store.run(ctx, func(d *data) error {
+		stored, ok := d.columns[column.ID]
+		if !ok || stored.Version != column.Version {
+			return repository.ErrVersionMismatch
+		}
+
+		stored.Title = column.Title
+		stored.Position = column.Position
+		stored.Done = column.Done
+		stored.Version++
+		stored.UpdatedAt = column.UpdatedAt
+		d.columns[column.ID] = stored
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	column.Version++
+
+	return nil
+}
+
+func (r *MemoryColumnRepository) MoveColumn(ctx context.Context, column *entity.Column) error {
+	err := r.store.run(ctx, func(d *data) error {
+		stored, ok := d.columns[column.ID]
+		if !ok || stored.Version != column.Version {
+			return repository.ErrVersionMismatch
+		}
+
+		if _, ok := d.boards[column.BoardID]; !ok {
+			return repository.ErrBoardNotFound
+		}
+
+		stored.BoardID = column.BoardID
+		stored.Title = column.Title
+		stored.Position = column.Position
+		stored.Version++
+		stored.UpdatedAt = column.UpdatedAt
+		d.columns[column.ID] = stored
+
+		laneID := d.firstSwimlane(column.BoardID)
+
+		for id, c := range d.cards {
+			if c.ColumnID == column.ID {
+				c.SwimlaneID = laneID
+				c.Version++
+				c.UpdatedAt = column.UpdatedAt
+				d.cards[id] = c
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	column.Version++
+
+	return nil
+}
+
+func (r *MemoryColumnRepository) MergeColumn(ctx context.Context, source *entity.Column, targetID uuid.UUID) error {
+	now := time.Now()
+
+	return r.store.run(ctx, func(d *data) error {
+		target, ok := d.columns[targetID]
+		if !ok {
+			return repository.ErrColumnNotFound
+		}
+
+		if c, ok := d.columns[source.ID]; !ok || c.Version != source.Version {
+			return repository.ErrVersionMismatch
+		}
+
+		laneID := d.firstSwimlane(target.BoardID)
+
+		// The moved cards go after the last card of the target, or from
+		// position 0 if it has none.
+		var moved []entity.Card
+		position, last := 0.0, false
+		for _, c := range d.cards {
+			switch {
+			case c.ColumnID == source.ID:
+				moved = append(moved, c)
+			case c.ColumnID == targetID && (!last || c.Position+1 > position):
+				position, last = c.Position+1, true
+			}
+		}
+
+		sort.Slice(moved, func(i, j int) bool {
+			if moved[i].Position != moved[j].Position {
+				return moved[i].Position < moved[j].Position
+			}
+			return uuidLess(moved[i].ID, moved[j].ID)
+		})
+
+		for i, c := range moved {
+			c.ColumnID = targetID
+			c.SwimlaneID = laneID
+			c.Position = position + float64(i)
+			c.Version++
+			c.UpdatedAt = now
+			d.cards[c.ID] = c
+		}
+
+		d.deleteColumn(source.ID)
+
+		return nil
+	})
+}
+
+func (r *MemoryColumnRepository) DeleteColumn(ctx context.Context, id uuid.UUID, version int) error {
+	return r.store.run(ctx, func(d *data) error {
+		if c, ok := d.columns[id]; !ok || c.Version != version {
+			return repository.ErrVersionMismatch
+		}
+
+		d.deleteColumn(id)
+
+		return nil
+	})
+}
@@ -0,0 +1,36 @@
+package memory_test
+
+import (
+	"testing"
+	"todo/internal/adapter/repository/memory"
+	"todo/internal/repository/repotest"
+)
+
+func newRepos(t *testing.T) repotest.Repos {
+	store := memory.NewStore()
+
+	return repotest.Repos{
+		Board:     memory.NewMemoryBoardRepository(store),
+		Column:    memory.NewMemoryColumnRepository(store),
+		Swimlane:  memory.NewMemorySwimlaneRepository(store),
+		Card:      memory.NewMemoryCardRepository(store),
+		Workspace: memory.NewMemoryWorkspaceRepository(store),
+		Quota:     memory.NewMemoryQuotaRepository(store),
+	}
+}
+
+func TestBoardRepository(t *testing.T) {
+	repotest.TestBoardRepository(t, newRepos)
+}
+
+func TestColumnRepository(t *testing.T) {
+	repotest.TestColumnRepository(t, newRepos)
+}
+
+func TestCardRepository(t *testing.T) {
+	repotest.TestCardRepository(t, newRepos)
+}
+
+func TestQuotaRepository(t *testing.T) {
+	repotest.TestQuotaRepository(t, newRepos)
+}
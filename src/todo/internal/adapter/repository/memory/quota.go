@@ -0,0 +1,76 @@
+package memory
+
+import (
+	"context"
+	"todo/internal/entity"
+	"todo/internal/repository"
+
+	"github.com/google/uuid"
+)
+
+type MemoryQuotaRepository struct {
+	store *Store
+}
+
+func NewMemoryQuotaRepository(store *Store) *MemoryQuotaRepository {
+	return &MemoryQuotaRepository{store: store}
+}
+
+func (r *MemoryQuotaRepository) GetQuotaOverride(ctx context.Context, userID uuid.UUID) (*entity.QuotaOverride, error) {
+	var override entity.QuotaOverride
+
+	err := r.store.run(ctx, func(d *data) error {
+		var ok bool
+		if override, ok = d.overrides[userID]; !ok {
+			return repository.ErrQuotaOverrideNotFound
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	override = copyOverride(override)
+
+	return &override, nil
+}
+
+func (r *MemoryQuotaRepository) SetQuotaOverride(ctx context.Context, override *entity.QuotaOverride) error {
+	return r.store.run(ctx, func(d *data) error {
+		d.overrides[override.UserID] = copyOverride(*override)
+
+		return nil
+	})
+}
+
+func (r *MemoryQuotaRepository) DeleteQuotaOverride(ctx context.Context, userID uuid.UUID) error {
+	return r.store.run(ctx, func(d *data) error {
+		if _, ok := d.overrides[userID]; !ok {
+			return repository.ErrQuotaOverrideNotFound
+		}
+
+		delete(d.overrides, userID)
+
+		return nil
+	})
+}
+
+// LockQuota has nothing to take: the StoreTxManager runs one unit of work
+// at a time already.
+func (r *MemoryQuotaRepository) LockQuota(ctx context.Context, scope uuid.UUID) error {
+	return nil
+}
+
+// copyOverride copies the bounds of the override, so that the store and its
+// callers never share them.
+func copyOverride(o entity.QuotaOverride) entity.QuotaOverride {
+	for _, b := range []**int{&o.BoardsPerUser, &o.ColumnsPerBoard, &o.CardsPerColumn, &o.DescriptionLength} {
+		if *b != nil {
+			n := **b
+			*b = &n
+		}
+	}
+
+	return o
+}
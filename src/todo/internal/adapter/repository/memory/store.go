@@ -0,0 +1,211 @@
+package memory
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"sort"
+	"sync"
+	"time"
+	"todo/internal/entity"
+	"todo/internal/repository"
+
+	"github.com/google/uuid"
+)
+
+var (
+	errDuplicateKey     = errors.New("duplicate key")
+	errSwimlaneNotFound = errors.New("swimlane not found")
+)
+
+// Store holds the data of the in-memory repositories, which share it the way
+// the SQLX repositories share a database. Every call takes the store for
+// itself; a unit of work run by the StoreTxManager takes it for its whole
+// length.
+type Store struct {
+	mu   sync.Mutex
+	data *data
+}
+
+func NewStore() *Store {
+	return &Store{data: newData()}
+}
+
+type memberKey struct {
+	workspaceID uuid.UUID
+	userID      uuid.UUID
+}
+
+type data struct {
+	boards     map[uuid.UUID]entity.Board
+	columns    map[uuid.UUID]entity.Column
+	swimlanes  map[uuid.UUID]entity.Swimlane
+	cards      map[uuid.UUID]entity.Card
+	workspaces map[uuid.UUID]entity.Workspace
+	members    map[memberKey]entity.WorkspaceMember
+	overrides  map[uuid.UUID]entity.QuotaOverride
+
+	// Activities are kept as stored rows, in the order of their IDs.
+	activities     []repository.Activity
+	lastActivityID int64
+}
+
+func newData() *data {
+	return &data{
+		boards:     make(map[uuid.UUID]entity.Board),
+		columns:    make(map[uuid.UUID]entity.Column),
+		swimlanes:  make(map[uuid.UUID]entity.Swimlane),
+		cards:      make(map[uuid.UUID]entity.Card),
+		workspaces: make(map[uuid.UUID]entity.Workspace),
+		members:    make(map[memberKey]entity.WorkspaceMember),
+		overrides:  make(map[uuid.UUID]entity.QuotaOverride),
+	}
+}
+
+// clone copies the data for a unit of work to go back to. Rows are values
+// whose slices and bounds are never changed in place, so copying the maps is
+// enough.
+func (d *data) clone() *data {
+	return &data{
+		boards:         maps.Clone(d.boards),
+		columns:        maps.Clone(d.columns),
+		swimlanes:      maps.Clone(d.swimlanes),
+		cards:          maps.Clone(d.cards),
+		workspaces:     maps.Clone(d.workspaces),
+		members:        maps.Clone(d.members),
+		overrides:      maps.Clone(d.overrides),
+		activities:     append([]repository.Activity(nil), d.activities...),
+		lastActivityID: d.lastActivityID,
+	}
+}
+
+type storeKey struct{}
+
+// run calls fn with the data of the store, taking the store unless ctx
+// belongs to a unit of work that has it already. The repositories check all
+// a call needs before they change anything, so a call that fails leaves the
+// data as it was.
+func (s *Store) run(ctx context.Context, fn func(d *data) error) error {
+	if ctx.Value(storeKey{}) == s {
+		return fn(s.data)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return fn(s.data)
+}
+
+// StoreTxManager runs units of work against a Store one at a time, and
+// undoes those that fail.
+type StoreTxManager struct {
+	store *Store
+}
+
+func NewStoreTxManager(store *Store) *StoreTxManager {
+	return &StoreTxManager{store: store}
+}
+
+func (m *StoreTxManager) WithinTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	s := m.store
+
+	if ctx.Value(storeKey{}) == s {
+		return fn(ctx)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	saved := s.data.clone()
+
+	if err := fn(context.WithValue(ctx, storeKey{}, s)); err != nil {
+		s.data = saved
+		return err
+	}
+
+	return nil
+}
+
+// The helpers below list rows the way the SQL listings do: by a key and
+// then by id, a page at a time after a keyset cursor.
+
+func uuidLess(a, b uuid.UUID) bool {
+	return bytes.Compare(a[:], b[:]) < 0
+}
+
+// timeKey is the key of a row listed by a time and then its id.
+type timeKey struct {
+	at time.Time
+	id uuid.UUID
+}
+
+func (k timeKey) less(o timeKey) bool {
+	if !k.at.Equal(o.at) {
+		return k.at.Before(o.at)
+	}
+
+	return uuidLess(k.id, o.id)
+}
+
+// pageByTime sorts the rows by their time keys and returns the page of them.
+func pageByTime[T any](rows []T, page repository.Page, key func(T) timeKey) ([]T, error) {
+	var after *timeKey
+	if page.After != nil {
+		if page.After.Time == nil {
+			return nil, repository.ErrInvalidCursor
+		}
+		after = &timeKey{at: *page.After.Time, id: page.After.ID}
+	}
+
+	sort.Slice(rows, func(i, j int) bool {
+		return key(rows[i]).less(key(rows[j]))
+	})
+
+	return pageOf(rows, page.Limit, func(row T) bool {
+		return after == nil || after.less(key(row))
+	}), nil
+}
+
+// pageOf returns up to limit of the sorted rows, from the first one follows
+// accepts on. A negative limit returns them all.
+func pageOf[T any](rows []T, limit int, follows func(T) bool) []T {
+	page := []T{}
+
+	for _, row := range rows {
+		if len(page) == limit {
+			break
+		}
+		if follows(row) {
+			page = append(page, row)
+		}
+	}
+
+	return page
+}
+
+// values lists the rows of a map that keep returns true for.
+func values[K comparable, V any](rows map[K]V, keep func(V) bool) []V {
+	var list []V
+
+	for _, row := range rows {
+		if keep(row) {
+			list = append(list, row)
+		}
+	}
+
+	return list
+}
+
+// insert adds the row under id unless there is one already, as a primary key
+// would.
+func insert[V any](rows map[uuid.UUID]V, id uuid.UUID, row V) error {
+	if _, ok := rows[id]; ok {
+		return fmt.Errorf("%w: %s", errDuplicateKey, id)
+	}
+
+	rows[id] = row
+
+	return nil
+}
@@ -0,0 +1,147 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"todo/internal/entity"
+	"todo/internal/repository"
+
+	"github.com/google/uuid"
+)
+
+type MemorySwimlaneRepository struct {
+	store *Store
+}
+
+func NewMemorySwimlaneRepository(store *Store) *MemorySwimlaneRepository {
+	return &MemorySwimlaneRepository{store: store}
+}
+
+func (r *MemorySwimlaneRepository) CreateSwimlane(ctx context.Context, swimlane *entity.Swimlane) error {
+	return r.store.run(ctx, func(d *data) error {
+		if _, ok := d.boards[swimlane.BoardID]; !ok {
+			return repository.ErrBoardNotFound
+		}
+
+		return insert(d.swimlanes, swimlane.ID, *swimlane)
+	})
+}
+
+func (r *MemorySwimlaneRepository) GetSwimlaneByID(ctx context.Context, id uuid.UUID) (*entity.Swimlane, error) {
+	var swimlane entity.Swimlane
+
+	err := r.store.run(ctx, func(d *data) error {
+		var ok bool
+		if swimlane, ok = d.swimlanes[id]; !ok {
+			return errSwimlaneNotFound
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &swimlane, nil
+}
+
+func (r *MemorySwimlaneRepository) GetSwimlanesByBoard(ctx context.Context, boardID uuid.UUID, page repository.Page) ([]entity.Swimlane, error) {
+	if page.After != nil && page.After.Number == nil {
+		return nil, repository.ErrInvalidCursor
+	}
+
+	var swimlanes []entity.Swimlane
+
+	err := r.store.run(ctx, func(d *data) error {
+		lanes := d.boardSwimlanes(boardID)
+
+		swimlanes = pageOf(lanes, page.Limit, func(s entity.Swimlane) bool {
+			after := page.After
+			return after == nil || s.Position > *after.Number ||
+				(s.Position == *after.Number && uuidLess(after.ID, s.ID))
+		})
+
+		return nil
+	})
+
+	return swimlanes, err
+}
+
+func (r *MemorySwimlaneRepository) UpdateSwimlane(ctx context.Context, swimlane *entity.Swimlane) error {
+	err := r.store.run(ctx, func(d *data) error {
+		stored, ok := d.swimlanes[swimlane.ID]
+		if !ok || stored.Version != swimlane.Version {
+			return repository.ErrVersionMismatch
+		}
+
+		stored.Title = swimlane.Title
+		stored.Position = swimlane.Position
+		stored.Version++
+		stored.UpdatedAt = swimlane.UpdatedAt
+		d.swimlanes[swimlane.ID] = stored
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	swimlane.Version++
+
+	return nil
+}
+
+func (r *MemorySwimlaneRepository) DeleteSwimlane(ctx context.Context, id uuid.UUID, version int) error {
+	return r.store.run(ctx, func(d *data) error {
+		if s, ok := d.swimlanes[id]; !ok || s.Version != version {
+			return repository.ErrVersionMismatch
+		}
+
+		d.deleteSwimlane(id)
+
+		return nil
+	})
+}
+
+// boardSwimlanes lists the swimlanes of the board top to bottom.
+func (d *data) boardSwimlanes(boardID uuid.UUID) []entity.Swimlane {
+	lanes := values(d.swimlanes, func(s entity.Swimlane) bool {
+		return s.BoardID == boardID
+	})
+
+	sort.Slice(lanes, func(i, j int) bool {
+		if lanes[i].Position != lanes[j].Position {
+			return lanes[i].Position < lanes[j].Position
+		}
+		return uuidLess(lanes[i].ID, lanes[j].ID)
+	})
+
+	return lanes
+}
+
+// firstSwimlane returns the id of the first swimlane of the board, or
+// uuid.Nil if it has none.
+func (d *data) firstSwimlane(boardID uuid.UUID) uuid.UUID {
+	lanes := d.boardSwimlanes(boardID)
+	if len(lanes) == 0 {
+		return uuid.Nil
+	}
+
+	return lanes[0].ID
+}
+
+// movedSwimlane returns the swimlane of a card moved to the column from the
+// swimlane laneID: that one if it is on the board of the column, or else the
+// first swimlane there.
+func (d *data) movedSwimlane(columnID, laneID uuid.UUID) (uuid.UUID, error) {
+	column, ok := d.columns[columnID]
+	if !ok {
+		return uuid.Nil, repository.ErrColumnNotFound
+	}
+
+	if lane, ok := d.swimlanes[laneID]; ok && lane.BoardID == column.BoardID {
+		return laneID, nil
+	}
+
+	return d.firstSwimlane(column.BoardID), nil
+}
@@ -0,0 +1,53 @@
+package memory
+
+import (
+	"context"
+	"sync"
+)
+
+type txKey struct{}
+
+// TxManager runs units of work without a database, for tests. It records how
+// the outermost units of work ended.
+type TxManager struct {
+	mu         sync.Mutex
+	committed  int
+	rolledBack int
+}
+
+func NewTxManager() *TxManager {
+	return &TxManager{}
+}
+
+func (m *TxManager) WithinTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	if ctx.Value(txKey{}) != nil {
+		return fn(ctx)
+	}
+
+	err := fn(context.WithValue(ctx, txKey{}, true))
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if err != nil {
+		m.rolledBack++
+	} else {
+		m.committed++
+	}
+
+	return err
+}
+
+func (m *TxManager) Committed() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.committed
+}
+
+func (m *TxManager) RolledBack() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.rolledBack
+}
@@ -0,0 +1,256 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+	"todo/internal/entity"
+	"todo/internal/repository"
+
+	"github.com/google/uuid"
+)
+
+type MemoryWorkspaceRepository struct {
+	store *Store
+}
+
+func NewMemoryWorkspaceRepository(store *Store) *MemoryWorkspaceRepository {
+	return &MemoryWorkspaceRepository{store: store}
+}
+
+func (r *MemoryWorkspaceRepository) CreateWorkspace(ctx context.Context, workspace *entity.Workspace, admin *entity.WorkspaceMember) error {
+	return r.store.run(ctx, func(d *data) error {
+		if workspace.Personal && d.personalWorkspace(workspace.CreatedBy) != nil {
+			return fmt.Errorf("%w: personal workspace of %s", errDuplicateKey, workspace.CreatedBy)
+		}
+
+		if _, ok := d.workspaces[admin.WorkspaceID]; !ok && admin.WorkspaceID != workspace.ID {
+			return repository.ErrWorkspaceNotFound
+		}
+
+		key := memberKey{admin.WorkspaceID, admin.UserID}
+		if _, ok := d.members[key]; ok {
+			return repository.ErrWorkspaceMemberExists
+		}
+
+		if err := insert(d.workspaces, workspace.ID, *workspace); err != nil {
+			return err
+		}
+
+		d.members[key] = *admin
+
+		return nil
+	})
+}
+
+func (r *MemoryWorkspaceRepository) GetWorkspaceByID(ctx context.Context, id uuid.UUID) (*entity.Workspace, error) {
+	var workspace entity.Workspace
+
+	err := r.store.run(ctx, func(d *data) error {
+		var ok bool
+		if workspace, ok = d.workspaces[id]; !ok {
+			return repository.ErrWorkspaceNotFound
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &workspace, nil
+}
+
+func (r *MemoryWorkspaceRepository) DeleteWorkspace(ctx context.Context, id uuid.UUID) error {
+	return r.store.run(ctx, func(d *data) error {
+		if _, ok := d.workspaces[id]; !ok {
+			return repository.ErrWorkspaceNotFound
+		}
+
+		d.deleteWorkspace(id)
+
+		return nil
+	})
+}
+
+func (r *MemoryWorkspaceRepository) EnsurePersonalWorkspace(ctx context.Context, userID uuid.UUID, at time.Time) (*entity.Workspace, error) {
+	var workspace entity.Workspace
+
+	err := r.store.run(ctx, func(d *data) error {
+		if w := d.personalWorkspace(userID); w != nil {
+			workspace = *w
+		} else {
+			workspace = entity.Workspace{
+				ID:        uuid.New(),
+				Name:      repository.PersonalWorkspaceName,
+				Personal:  true,
+				CreatedBy: userID,
+				CreatedAt: at,
+			}
+			d.workspaces[workspace.ID] = workspace
+		}
+
+		key := memberKey{workspace.ID, userID}
+		if _, ok := d.members[key]; !ok {
+			d.members[key] = entity.WorkspaceMember{
+				WorkspaceID: workspace.ID,
+				UserID:      userID,
+				Role:        entity.RoleAdmin,
+				CreatedAt:   workspace.CreatedAt,
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &workspace, nil
+}
+
+// personalWorkspace returns the personal workspace of the user, or nil if
+// they have none.
+func (d *data) personalWorkspace(userID uuid.UUID) *entity.Workspace {
+	for _, w := range d.workspaces {
+		if w.Personal && w.CreatedBy == userID {
+			return &w
+		}
+	}
+
+	return nil
+}
+
+func (r *MemoryWorkspaceRepository) GetWorkspacesByUser(ctx context.Context, userID uuid.UUID) ([]entity.WorkspaceMembership, error) {
+	workspaces := []entity.WorkspaceMembership{}
+
+	err := r.store.run(ctx, func(d *data) error {
+		for _, m := range d.members {
+			if m.UserID == userID {
+				workspaces = append(workspaces, entity.WorkspaceMembership{Workspace: d.workspaces[m.WorkspaceID], Role: m.Role})
+			}
+		}
+
+		return nil
+	})
+
+	sort.Slice(workspaces, func(i, j int) bool {
+		a, b := workspaces[i], workspaces[j]
+		return timeKey{at: a.CreatedAt, id: a.ID}.less(timeKey{at: b.CreatedAt, id: b.ID})
+	})
+
+	return workspaces, err
+}
+
+func (r *MemoryWorkspaceRepository) AddWorkspaceMember(ctx context.Context, member *entity.WorkspaceMember) error {
+	return r.store.run(ctx, func(d *data) error {
+		if _, ok := d.workspaces[member.WorkspaceID]; !ok {
+			return repository.ErrWorkspaceNotFound
+		}
+
+		key := memberKey{member.WorkspaceID, member.UserID}
+		if _, ok := d.members[key]; ok {
+			return repository.ErrWorkspaceMemberExists
+		}
+
+		d.members[key] = *member
+
+		return nil
+	})
+}
+
+func (r *MemoryWorkspaceRepository) UpdateWorkspaceMember(ctx context.Context, member *entity.WorkspaceMember) error {
+	return r.store.run(ctx, func(d *data) error {
+		key := memberKey{member.WorkspaceID, member.UserID}
+
+		stored, ok := d.members[key]
+		if !ok {
+			return repository.ErrWorkspaceMemberNotFound
+		}
+
+		stored.Role = member.Role
+		d.members[key] = stored
+
+		return nil
+	})
+}
+
+func (r *MemoryWorkspaceRepository) RemoveWorkspaceMember(ctx context.Context, workspaceID, userID uuid.UUID) error {
+	return r.store.run(ctx, func(d *data) error {
+		key := memberKey{workspaceID, userID}
+		if _, ok := d.members[key]; !ok {
+			return repository.ErrWorkspaceMemberNotFound
+		}
+
+		delete(d.members, key)
+
+		return nil
+	})
+}
+
+func (r *MemoryWorkspaceRepository) GetWorkspaceMember(ctx context.Context, workspaceID, userID uuid.UUID) (*entity.WorkspaceMember, error) {
+	var member entity.WorkspaceMember
+
+	err := r.store.run(ctx, func(d *data) error {
+		var ok bool
+		if member, ok = d.members[memberKey{workspaceID, userID}]; !ok {
+			return repository.ErrWorkspaceMemberNotFound
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &member, nil
+}
+
+func (r *MemoryWorkspaceRepository) GetWorkspaceMembers(ctx context.Context, workspaceID uuid.UUID) ([]entity.WorkspaceMember, error) {
+	members := []entity.WorkspaceMember{}
+
+	err := r.store.run(ctx, func(d *data) error {
+		members = append(members, values(d.members, func(m entity.WorkspaceMember) bool {
+			return m.WorkspaceID == workspaceID
+		})...)
+
+		return nil
+	})
+
+	sort.Slice(members, func(i, j int) bool {
+		a, b := members[i], members[j]
+		return timeKey{at: a.CreatedAt, id: a.UserID}.less(timeKey{at: b.CreatedAt, id: b.UserID})
+	})
+
+	return members, err
+}
+
+func (r *MemoryWorkspaceRepository) GetMemberBoards(ctx context.Context, userID uuid.UUID, page repository.Page) ([]entity.Board, error) {
+	var boards []entity.Board
+
+	err := r.store.run(ctx, func(d *data) (err error) {
+		boards, err = d.findBoards(func(b entity.Board) bool {
+			_, ok := d.members[memberKey{b.WorkspaceID, userID}]
+			return ok
+		}, page)
+
+		return err
+	})
+
+	return boards, err
+}
+
+func (r *MemoryWorkspaceRepository) GetWorkspaceBoards(ctx context.Context, workspaceID uuid.UUID, page repository.Page) ([]entity.Board, error) {
+	var boards []entity.Board
+
+	err := r.store.run(ctx, func(d *data) (err error) {
+		boards, err = d.findBoards(func(b entity.Board) bool {
+			return b.WorkspaceID == workspaceID
+		}, page)
+
+		return err
+	})
+
+	return boards, err
+}
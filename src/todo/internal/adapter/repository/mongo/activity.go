@@ -0,0 +1,73 @@
+package repository
+
+import (
+	"context"
+	"todo/internal/entity"
+	"todo/internal/repository"
+
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type MongoActivityRepository struct {
+	collections
+}
+
+func NewMongoActivityRepository(db *mongo.Database) *MongoActivityRepository {
+	return &MongoActivityRepository{collections: newCollections(db)}
+}
+
+// AddActivity takes the ID from a counter, as the SQL schema does from a
+// sequence, for feeds to be read on from the last ID seen.
+func (r *MongoActivityRepository) AddActivity(ctx context.Context, activity *entity.Activity) error {
+	repoActivity, err := repository.RepoActivity(*activity)
+	if err != nil {
+		return err
+	}
+
+	var counter struct {
+		Seq int64 `bson:"seq"`
+	}
+
+	err = r.counters.FindOneAndUpdate(ctx,
+		bson.M{"_id": "activities"},
+		bson.M{"$inc": bson.M{"seq": 1}},
+		options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.After),
+	).Decode(&counter)
+	if err != nil {
+		return err
+	}
+
+	repoActivity.ID = counter.Seq
+
+	if _, err := r.activities.InsertOne(ctx, repoActivity); err != nil {
+		return err
+	}
+
+	activity.ID = repoActivity.ID
+
+	return nil
+}
+
+func (r *MongoActivityRepository) GetActivities(ctx context.Context, boardID uuid.UUID, afterID int64, limit int) ([]entity.Activity, error) {
+	filter := bson.M{"board_id": boardID, "_id": bson.M{"$gt": afterID}}
+
+	var repoActivities []repository.Activity
+	err := findAll(ctx, r.activities, filter, &repoActivities,
+		options.Find().SetSort(bson.D{{Key: "_id", Value: 1}}).SetLimit(int64(limit)))
+
+	if err != nil {
+		return nil, err
+	}
+
+	activities := make([]entity.Activity, len(repoActivities))
+	for i, a := range repoActivities {
+		if activities[i], err = repository.ActivityToEntity(a); err != nil {
+			return nil, err
+		}
+	}
+
+	return activities, nil
+}
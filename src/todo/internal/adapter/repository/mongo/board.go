@@ -0,0 +1,144 @@
+package repository
+
+import (
+	"context"
+	"todo/internal/entity"
+	"todo/internal/repository"
+
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type MongoBoardRepository struct {
+	collections
+}
+
+func NewMongoBoardRepository(db *mongo.Database) *MongoBoardRepository {
+	return &MongoBoardRepository{collections: newCollections(db)}
+}
+
+func (r *MongoBoardRepository) CreateBoard(ctx context.Context, board *entity.Board) error {
+	repoBoard := repository.RepoBoard(*board)
+
+	lane := repository.Swimlane{
+		ID:        uuid.New(),
+		UserID:    board.UserID,
+		BoardID:   board.ID,
+		Title:     repository.DefaultSwimlaneTitle,
+		Version:   1,
+		CreatedAt: board.CreatedAt,
+		UpdatedAt: board.CreatedAt,
+	}
+
+	return inTx(ctx, r.db, func(ctx context.Context) error {
+		if err := reference(ctx, r.workspaces, board.WorkspaceID); err != nil {
+			return err
+		}
+
+		if _, err := r.boards.InsertOne(ctx, repoBoard); err != nil {
+			return err
+		}
+
+		_, err := r.swimlanes.InsertOne(ctx, lane)
+
+		return err
+	})
+}
+
+func (r *MongoBoardRepository) GetBoardByID(ctx context.Context, id uuid.UUID) (*entity.Board, error) {
+	var repoBoard repository.Board
+
+	err := r.boards.FindOne(ctx, bson.M{"_id": id}).Decode(&repoBoard)
+	if err != nil {
+		return nil, err
+	}
+
+	board := repository.BoardToEntity(repoBoard)
+
+	return &board, nil
+}
+
+func (r *MongoBoardRepository) GetBoardsByUser(ctx context.Context, userID uuid.UUID, page repository.Page) ([]entity.Board, error) {
+	return r.findBoards(ctx, bson.M{"user_id": userID}, page)
+}
+
+// findBoards lists a page of the boards the filter selects in the order they
+// were made.
+func (c collections) findBoards(ctx context.Context, filter bson.M, page repository.Page) ([]entity.Board, error) {
+	if page.After != nil {
+		if page.After.Time == nil {
+			return nil, repository.ErrInvalidCursor
+		}
+
+		filter = bson.M{"$and": bson.A{filter, keyset("created_at", *page.After.Time, page.After.ID, false)}}
+	}
+
+	var repoBoards []repository.Board
+	err := findAll(ctx, c.boards, filter, &repoBoards,
+		options.Find().SetSort(ascending("created_at")).SetLimit(int64(page.Limit)))
+
+	if err != nil {
+		return nil, err
+	}
+
+	boards := make([]entity.Board, len(repoBoards))
+	for i, b := range repoBoards {
+		boards[i] = repository.BoardToEntity(b)
+	}
+
+	return boards, nil
+}
+
+func (r *MongoBoardRepository) UpdateBoard(ctx context.Context, board *entity.Board) error {
+	update := bson.M{
+		"$set": bson.M{"title": board.Title, "updated_at": board.UpdatedAt},
+		"$inc": bson.M{"version": 1},
+	}
+
+	err := updated(r.boards.UpdateOne(ctx, bson.M{"_id": board.ID, "version": board.Version}, update))
+	if err != nil {
+		return err
+	}
+
+	board.Version++
+
+	return nil
+}
+
+func (r *MongoBoardRepository) TransferBoard(ctx context.Context, board *entity.Board) error {
+	update := bson.M{
+		"$set": bson.M{"user_id": board.UserID, "workspace_id": board.WorkspaceID, "updated_at": board.UpdatedAt},
+		"$inc": bson.M{"version": 1},
+	}
+
+	err := inTx(ctx, r.db, func(ctx context.Context) error {
+		if err := reference(ctx, r.workspaces, board.WorkspaceID); err != nil {
+			return err
+		}
+
+		return updated(r.boards.UpdateOne(ctx, bson.M{"_id": board.ID, "version": board.Version}, update))
+	})
+	if err != nil {
+		return err
+	}
+
+	board.Version++
+
+	return nil
+}
+
+func (r *MongoBoardRepository) DeleteBoard(ctx context.Context, id uuid.UUID, version int) error {
+	return inTx(ctx, r.db, func(ctx context.Context) error {
+		if err := deleted(r.boards.DeleteOne(ctx, bson.M{"_id": id, "version": version})); err != nil {
+			return err
+		}
+
+		if err := r.deleteColumns(ctx, bson.M{"board_id": id}); err != nil {
+			return err
+		}
+
+		return r.deleteSwimlanes(ctx, bson.M{"board_id": id})
+	})
+}
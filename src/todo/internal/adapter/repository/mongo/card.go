@@ -0,0 +1,292 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"time"
+	"todo/internal/entity"
+	"todo/internal/repository"
+
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// MongoCardRepository keeps the labels of a card in the card document.
+type MongoCardRepository struct {
+	collections
+}
+
+func NewMongoCardRepository(db *mongo.Database) *MongoCardRepository {
+	return &MongoCardRepository{collections: newCollections(db)}
+}
+
+func (r *MongoCardRepository) CreateCard(ctx context.Context, card *entity.Card) error {
+	return inTx(ctx, r.db, func(ctx context.Context) error {
+		var column repository.Column
+		if err := r.columns.FindOne(ctx, bson.M{"_id": card.ColumnID}).Decode(&column); err != nil {
+			return err
+		}
+
+		if card.SwimlaneID == uuid.Nil {
+			laneID, err := r.firstSwimlane(ctx, column.BoardID)
+			if err != nil {
+				return err
+			}
+			card.SwimlaneID = laneID
+		} else if err := reference(ctx, r.swimlanes, card.SwimlaneID); err != nil {
+			return err
+		}
+
+		if card.ParentID != uuid.Nil {
+			if err := reference(ctx, r.cards, card.ParentID); err != nil {
+				return err
+			}
+		}
+
+		_, err := r.cards.InsertOne(ctx, cardDocument(*card))
+
+		return err
+	})
+}
+
+func (r *MongoCardRepository) GetCardByID(ctx context.Context, id uuid.UUID) (*entity.Card, error) {
+	var repoCard repository.Card
+
+	err := r.cards.FindOne(ctx, bson.M{"_id": id}).Decode(&repoCard)
+	if err != nil {
+		return nil, err
+	}
+
+	cards, err := r.withRollups(ctx, []repository.Card{repoCard})
+	if err != nil {
+		return nil, err
+	}
+
+	return &cards[0], nil
+}
+
+func (r *MongoCardRepository) GetCardAncestors(ctx context.Context, id uuid.UUID) ([]entity.Card, error) {
+	var card repository.Card
+
+	err := r.cards.FindOne(ctx, bson.M{"_id": id}).Decode(&card)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return []entity.Card{}, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	var ancestors []repository.Card
+	for card.ParentID.Valid && len(ancestors) < repository.MaxCardDepth {
+		if err := r.cards.FindOne(ctx, bson.M{"_id": card.ParentID.UUID}).Decode(&card); err != nil {
+			return nil, err
+		}
+		ancestors = append(ancestors, card)
+	}
+
+	return r.withRollups(ctx, ancestors)
+}
+
+func (r *MongoCardRepository) SetCardParent(ctx context.Context, card *entity.Card) error {
+	update := bson.M{
+		"$set": bson.M{
+			"parent_id":  uuid.NullUUID{UUID: card.ParentID, Valid: card.ParentID != uuid.Nil},
+			"updated_at": card.UpdatedAt,
+		},
+		"$inc": bson.M{"version": 1},
+	}
+
+	err := updated(r.cards.UpdateOne(ctx, bson.M{"_id": card.ID, "version": card.Version}, update))
+	if err != nil {
+		return err
+	}
+
+	card.Version++
+
+	return nil
+}
+
+func (r *MongoCardRepository) GetCardsByColumn(ctx context.Context, columnID uuid.UUID, page repository.Page) ([]entity.Card, error) {
+	return r.findCards(ctx, bson.M{"column_id": columnID, "archived_at": nil}, page)
+}
+
+func (r *MongoCardRepository) UpdateCard(ctx context.Context, card *entity.Card) error {
+	repoCard := cardDocument(*card)
+
+	update := bson.M{
+		"$set": bson.M{
+			"title":       repoCard.Title,
+			"description": repoCard.Description,
+			"position":    repoCard.Position,
+			"priority":    repoCard.Priority,
+			"assignee_id": repoCard.AssigneeID,
+			"due_date":    repoCard.DueDate,
+			"labels":      repoCard.Labels,
+			"updated_at":  repoCard.UpdatedAt,
+		},
+		"$inc": bson.M{"version": 1},
+	}
+
+	err := updated(r.cards.UpdateOne(ctx, bson.M{"_id": card.ID, "version": card.Version}, update))
+	if err != nil {
+		return err
+	}
+
+	card.Version++
+
+	return nil
+}
+
+// cardDocument keeps the labels of a card as an array even when there are
+// none, for labels to be added to it.
+func cardDocument(card entity.Card) repository.Card {
+	repoCard := repository.RepoCard(card)
+	if repoCard.Labels == nil {
+		repoCard.Labels = []string{}
+	}
+
+	return repoCard
+}
+
+// MoveCard moves the card to its column, its swimlane or both; the one left
+// unset is kept where possible.
+func (r *MongoCardRepository) MoveCard(ctx context.Context, card *entity.Card) error {
+	filter := bson.M{"_id": card.ID, "version": card.Version}
+
+	err := inTx(ctx, r.db, func(ctx context.Context) error {
+		var current repository.Card
+
+		err := r.cards.FindOne(ctx, filter).Decode(&current)
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return repository.ErrVersionMismatch
+		}
+
+		if err != nil {
+			return err
+		}
+
+		columnID := current.ColumnID
+		if card.ColumnID != uuid.Nil {
+			columnID = card.ColumnID
+		}
+
+		laneID := card.SwimlaneID
+		if laneID == uuid.Nil {
+			if laneID, err = r.movedSwimlane(ctx, columnID, current.SwimlaneID.UUID); err != nil {
+				return err
+			}
+		}
+
+		return updated(r.cards.UpdateOne(ctx, filter, bson.M{
+			"$set": bson.M{"column_id": columnID, "swimlane_id": laneID, "updated_at": card.UpdatedAt},
+			"$inc": bson.M{"version": 1},
+		}))
+	})
+	if err != nil {
+		return err
+	}
+
+	card.Version++
+
+	return nil
+}
+
+func (r *MongoCardRepository) DeleteCard(ctx context.Context, id uuid.UUID, version int) error {
+	return inTx(ctx, r.db, func(ctx context.Context) error {
+		if err := deleted(r.cards.DeleteOne(ctx, bson.M{"_id": id, "version": version})); err != nil {
+			return err
+		}
+
+		return r.orphanCards(ctx, id)
+	})
+}
+
+func (r *MongoCardRepository) GetNewCards(ctx context.Context, from, to time.Time, page repository.Page) ([]entity.Card, error) {
+	return r.findCards(ctx, bson.M{"created_at": bson.M{"$gte": from, "$lte": to}}, page)
+}
+
+// findCards lists a page of the cards the filter selects in the order they
+// were made.
+func (r *MongoCardRepository) findCards(ctx context.Context, filter bson.M, page repository.Page) ([]entity.Card, error) {
+	if page.After != nil {
+		if page.After.Time == nil {
+			return nil, repository.ErrInvalidCursor
+		}
+
+		filter = bson.M{"$and": bson.A{filter, keyset("created_at", *page.After.Time, page.After.ID, false)}}
+	}
+
+	var repoCards []repository.Card
+	err := findAll(ctx, r.cards, filter, &repoCards,
+		options.Find().SetSort(ascending("created_at")).SetLimit(int64(page.Limit)))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return r.withRollups(ctx, repoCards)
+}
+
+// withRollups counts the active children of the given cards, and those of
+// them in done columns, and converts the cards to entities.
+func (r *MongoCardRepository) withRollups(ctx context.Context, repoCards []repository.Card) ([]entity.Card, error) {
+	cards := make([]entity.Card, len(repoCards))
+	if len(repoCards) == 0 {
+		return cards, nil
+	}
+
+	ids := make([]uuid.UUID, len(repoCards))
+	for i, c := range repoCards {
+		ids[i] = c.ID
+	}
+
+	var children []repository.Card
+	err := findAll(ctx, r.cards, bson.M{"parent_id": bson.M{"$in": ids}, "archived_at": nil}, &children,
+		options.Find().SetProjection(bson.M{"parent_id": 1, "column_id": 1}))
+	if err != nil {
+		return nil, err
+	}
+
+	columnIDs := make([]uuid.UUID, len(children))
+	for i, c := range children {
+		columnIDs[i] = c.ColumnID
+	}
+
+	doneColumns, err := findIDs(ctx, r.columns, bson.M{"_id": bson.M{"$in": columnIDs}, "done": true})
+	if err != nil {
+		return nil, err
+	}
+
+	done := make(map[uuid.UUID]bool, len(doneColumns))
+	for _, id := range doneColumns {
+		done[id] = true
+	}
+
+	childCount := make(map[uuid.UUID]int)
+	doneChildCount := make(map[uuid.UUID]int)
+	for _, c := range children {
+		childCount[c.ParentID.UUID]++
+		if done[c.ColumnID] {
+			doneChildCount[c.ParentID.UUID]++
+		}
+	}
+
+	for i, c := range repoCards {
+		// Labels come back in order and as nil when there are none, as
+		// the SQLX repository returns them.
+		if len(c.Labels) == 0 {
+			c.Labels = nil
+		}
+		sort.Strings(c.Labels)
+
+		c.ChildCount = childCount[c.ID]
+		c.DoneChildCount = doneChildCount[c.ID]
+		cards[i] = repository.CardToEntity(c)
+	}
+
+	return cards, nil
+}
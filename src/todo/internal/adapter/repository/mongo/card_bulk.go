@@ -0,0 +1,249 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+	"todo/internal/repository"
+
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func (r *MongoCardRepository) ApplyCardBatch(ctx context.Context, batch repository.CardBatch) ([]repository.CardOpResult, error) {
+	results := make([]repository.CardOpResult, len(batch.Ops))
+
+	err := inTx(ctx, r.db, func(ctx context.Context) error {
+		// Mongo has no savepoints, so the cards are put back by hand: every
+		// operation saves the cards it changes first. The batch may be part
+		// of a larger unit of work, so an all-or-nothing batch undoes only
+		// its own changes.
+		batchUndo := newCardUndo(r.cards)
+
+		for i, op := range batch.Ops {
+			undo := newCardUndo(r.cards)
+
+			opErr := r.applyCardOp(ctx, undo, op, batch.At)
+
+			if opErr == nil {
+				results[i].Status = repository.CardOpApplied
+				batchUndo.merge(undo)
+				continue
+			}
+
+			results[i] = repository.CardOpResult{Status: repository.CardOpFailed, Err: opErr}
+
+			if err := undo.restore(ctx); err != nil {
+				return err
+			}
+
+			if batch.AllOrNothing {
+				for j := range results {
+					if j != i {
+						results[j].Status = repository.CardOpRolledBack
+					}
+				}
+				return batchUndo.restore(ctx)
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+func (r *MongoCardRepository) applyCardOp(ctx context.Context, undo *cardUndo, op repository.CardOp, at time.Time) error {
+	switch op.Kind {
+	case repository.CardOpMove:
+		card, err := r.opCard(ctx, op)
+		if err != nil {
+			return err
+		}
+		laneID, err := r.movedSwimlane(ctx, op.ColumnID, card.SwimlaneID.UUID)
+		if err != nil {
+			return err
+		}
+		return r.touchCard(ctx, undo, op, bson.M{"column_id": op.ColumnID, "swimlane_id": laneID, "updated_at": at})
+	case repository.CardOpArchive:
+		if err := r.archiveChildren(ctx, undo, op, at); err != nil {
+			return err
+		}
+		return r.touchCard(ctx, undo, op, bson.M{"archived_at": at, "updated_at": at})
+	case repository.CardOpSetAssignee:
+		assignee := uuid.NullUUID{UUID: op.AssigneeID, Valid: op.AssigneeID != uuid.Nil}
+		return r.touchCard(ctx, undo, op, bson.M{"assignee_id": assignee, "updated_at": at})
+	case repository.CardOpSetLabel:
+		if err := r.touchCard(ctx, undo, op, bson.M{"updated_at": at}); err != nil {
+			return err
+		}
+		_, err := r.cards.UpdateOne(ctx, bson.M{"_id": op.CardID}, bson.M{"$addToSet": bson.M{"labels": op.Label}})
+		return err
+	case repository.CardOpDelete:
+		if _, err := r.opCard(ctx, op); err != nil {
+			return err
+		}
+		if err := undo.save(ctx, bson.M{"$or": bson.A{bson.M{"_id": op.CardID}, bson.M{"parent_id": op.CardID}}}); err != nil {
+			return err
+		}
+		if _, err := r.cards.DeleteOne(ctx, opFilter(op)); err != nil {
+			return err
+		}
+		return r.orphanCards(ctx, op.CardID)
+	default:
+		return fmt.Errorf("unknown card operation %q", op.Kind)
+	}
+}
+
+// archiveChildren archives the active descendants of a card being archived
+// if the operation cascades, and refuses to leave the question open when the
+// card has any.
+func (r *MongoCardRepository) archiveChildren(ctx context.Context, undo *cardUndo, op repository.CardOp, at time.Time) error {
+	if op.Cascade == nil {
+		n, err := r.cards.CountDocuments(ctx, bson.M{"parent_id": op.CardID, "archived_at": nil}, options.Count().SetLimit(1))
+		if err != nil {
+			return err
+		}
+		if n > 0 {
+			return repository.ErrCardHasChildren
+		}
+		return nil
+	}
+
+	if !*op.Cascade {
+		return nil
+	}
+
+	if _, err := r.opCard(ctx, op); err != nil {
+		return err
+	}
+
+	var descendants []uuid.UUID
+	level := []uuid.UUID{op.CardID}
+	for depth := 0; depth < repository.MaxCardDepth && len(level) > 0; depth++ {
+		ids, err := findIDs(ctx, r.cards, bson.M{"parent_id": bson.M{"$in": level}})
+		if err != nil {
+			return err
+		}
+		descendants = append(descendants, ids...)
+		level = ids
+	}
+
+	filter := bson.M{"_id": bson.M{"$in": descendants}, "archived_at": nil}
+	if err := undo.save(ctx, filter); err != nil {
+		return err
+	}
+
+	_, err := r.cards.UpdateMany(ctx, filter, bson.M{
+		"$set": bson.M{"archived_at": at, "updated_at": at},
+		"$inc": bson.M{"version": 1},
+	})
+
+	return err
+}
+
+// opFilter selects the card of the operation at its version.
+func opFilter(op repository.CardOp) bson.M {
+	return bson.M{"_id": op.CardID, "version": op.Version}
+}
+
+// opCard reads the card of the operation. It tells a missing card from a
+// stale version when there is none to read.
+func (r *MongoCardRepository) opCard(ctx context.Context, op repository.CardOp) (*repository.Card, error) {
+	var card repository.Card
+
+	err := r.cards.FindOne(ctx, opFilter(op)).Decode(&card)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		n, err := r.cards.CountDocuments(ctx, bson.M{"_id": op.CardID})
+		if err != nil {
+			return nil, err
+		}
+		if n == 0 {
+			return nil, repository.ErrCardNotFound
+		}
+		return nil, repository.ErrVersionMismatch
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &card, nil
+}
+
+// touchCard sets the given fields of the card of the operation and bumps its
+// version.
+func (r *MongoCardRepository) touchCard(ctx context.Context, undo *cardUndo, op repository.CardOp, set bson.M) error {
+	if _, err := r.opCard(ctx, op); err != nil {
+		return err
+	}
+
+	if err := undo.save(ctx, bson.M{"_id": op.CardID}); err != nil {
+		return err
+	}
+
+	_, err := r.cards.UpdateOne(ctx, opFilter(op), bson.M{"$set": set, "$inc": bson.M{"version": 1}})
+
+	return err
+}
+
+// cardUndo keeps the cards as they were before the first change to them, to
+// put them back with restore.
+type cardUndo struct {
+	cards *mongo.Collection
+	saved map[uuid.UUID]bson.Raw
+}
+
+func newCardUndo(cards *mongo.Collection) *cardUndo {
+	return &cardUndo{cards: cards, saved: make(map[uuid.UUID]bson.Raw)}
+}
+
+// save keeps the cards the filter selects, unless they are kept already.
+func (u *cardUndo) save(ctx context.Context, filter interface{}) error {
+	cursor, err := u.cards.Find(ctx, filter)
+	if err != nil {
+		return err
+	}
+	defer cursor.Close(ctx)
+
+	for cursor.Next(ctx) {
+		var doc struct {
+			ID uuid.UUID `bson:"_id"`
+		}
+		if err := cursor.Decode(&doc); err != nil {
+			return err
+		}
+		if _, ok := u.saved[doc.ID]; !ok {
+			u.saved[doc.ID] = append(bson.Raw(nil), cursor.Current...)
+		}
+	}
+
+	return cursor.Err()
+}
+
+// merge takes over the cards other keeps that u does not, as they were
+// before either changed them.
+func (u *cardUndo) merge(other *cardUndo) {
+	for id, doc := range other.saved {
+		if _, ok := u.saved[id]; !ok {
+			u.saved[id] = doc
+		}
+	}
+}
+
+func (u *cardUndo) restore(ctx context.Context) error {
+	for id, doc := range u.saved {
+		_, err := u.cards.ReplaceOne(ctx, bson.M{"_id": id}, doc, options.Replace().SetUpsert(true))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
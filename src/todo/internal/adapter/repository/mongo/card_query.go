@@ -0,0 +1,177 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+	"todo/internal/entity"
+	"todo/internal/repository"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// cardSortFields whitelists the fields a card listing may be ordered by.
+var cardSortFields = map[repository.CardSortField]string{
+	repository.SortByPosition: "position",
+	repository.SortByPriority: "priority",
+	repository.SortByCreated:  "created_at",
+	repository.SortByUpdated:  "updated_at",
+	repository.SortByDue:      "due_date",
+}
+
+func (r *MongoCardRepository) GetCards(ctx context.Context, q repository.CardQuery) ([]entity.Card, error) {
+	pipeline, err := r.cardPipeline(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+
+	cursor, err := r.cards.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, err
+	}
+
+	var repoCards []repository.Card
+	if err := cursor.All(ctx, &repoCards); err != nil {
+		return nil, err
+	}
+
+	return r.withRollups(ctx, repoCards)
+}
+
+// cardPipeline renders q into an aggregation pipeline. Text is matched as a
+// literal, case-insensitive substring of the title or the description.
+func (r *MongoCardRepository) cardPipeline(ctx context.Context, q repository.CardQuery) (mongo.Pipeline, error) {
+	var conds bson.A
+
+	if q.BoardID != nil {
+		columnIDs, err := findIDs(ctx, r.columns, bson.M{"board_id": *q.BoardID})
+		if err != nil {
+			return nil, err
+		}
+		conds = append(conds, bson.M{"column_id": bson.M{"$in": columnIDs}})
+	}
+	if q.ColumnID != nil {
+		conds = append(conds, bson.M{"column_id": *q.ColumnID})
+	}
+	if q.SwimlaneID != nil {
+		conds = append(conds, bson.M{"swimlane_id": *q.SwimlaneID})
+	}
+	if q.ParentID != nil {
+		conds = append(conds, bson.M{"parent_id": *q.ParentID})
+	}
+	if len(q.Priorities) > 0 {
+		conds = append(conds, bson.M{"priority": bson.M{"$in": q.Priorities}})
+	}
+	if q.UserID != nil {
+		conds = append(conds, bson.M{"user_id": *q.UserID})
+	}
+	if q.AssigneeID != nil {
+		conds = append(conds, bson.M{"assignee_id": *q.AssigneeID})
+	}
+	if q.Label != nil {
+		conds = append(conds, bson.M{"labels": *q.Label})
+	}
+	if q.Text != nil {
+		pattern := primitive.Regex{Pattern: regexp.QuoteMeta(*q.Text), Options: "i"}
+		conds = append(conds, bson.M{"$or": bson.A{
+			bson.M{"title": pattern},
+			bson.M{"description": pattern},
+		}})
+	}
+	conds = appendRange(conds, "created_at", q.CreatedFrom, q.CreatedTo)
+	conds = appendRange(conds, "updated_at", q.UpdatedFrom, q.UpdatedTo)
+	conds = appendRange(conds, "due_date", q.DueFrom, q.DueTo)
+	if q.Archived {
+		conds = append(conds, bson.M{"archived_at": bson.M{"$ne": nil}})
+	} else {
+		conds = append(conds, bson.M{"archived_at": nil})
+	}
+
+	sortBy := q.SortBy
+	if sortBy == "" {
+		sortBy = repository.SortByPosition
+	}
+	field, ok := cardSortFields[sortBy]
+	if !ok {
+		return nil, fmt.Errorf("unknown card sort field %q", sortBy)
+	}
+
+	direction := 1
+	if q.Descending {
+		direction = -1
+	}
+
+	if q.Page.After != nil {
+		cond, err := cardKeyset(q.Page.After, sortBy, field, q.Descending)
+		if err != nil {
+			return nil, err
+		}
+		conds = append(conds, cond)
+	}
+
+	pipeline := mongo.Pipeline{{{Key: "$match", Value: bson.M{"$and": conds}}}}
+
+	// Cards without a due date go last in both directions; the id keeps the
+	// order stable between equal keys.
+	order := bson.D{{Key: field, Value: direction}, {Key: "_id", Value: direction}}
+	if sortBy == repository.SortByDue {
+		pipeline = append(pipeline, bson.D{{Key: "$addFields", Value: bson.M{
+			"no_due_date": bson.M{"$eq": bson.A{"$due_date", nil}},
+		}}})
+		order = append(bson.D{{Key: "no_due_date", Value: 1}}, order...)
+	}
+
+	pipeline = append(pipeline, bson.D{{Key: "$sort", Value: order}})
+	if q.Page.Limit > 0 {
+		pipeline = append(pipeline, bson.D{{Key: "$limit", Value: q.Page.Limit}})
+	}
+
+	return pipeline, nil
+}
+
+// appendRange adds the half-open range [from, to) of the field to conds.
+func appendRange(conds bson.A, field string, from, to *time.Time) bson.A {
+	if from != nil {
+		conds = append(conds, bson.M{field: bson.M{"$gte": *from}})
+	}
+	if to != nil {
+		conds = append(conds, bson.M{field: bson.M{"$lt": *to}})
+	}
+
+	return conds
+}
+
+// cardKeyset renders the condition selecting the cards that follow the
+// cursor in the given order. It mirrors the sort of cardPipeline, including
+// due dates sorting nulls last in both directions.
+func cardKeyset(after *repository.Cursor, sortBy repository.CardSortField, field string, desc bool) (bson.M, error) {
+	op := "$gt"
+	if desc {
+		op = "$lt"
+	}
+
+	switch sortBy {
+	case repository.SortByPosition, repository.SortByPriority:
+		if after.Number == nil {
+			return nil, repository.ErrInvalidCursor
+		}
+		return keyset(field, *after.Number, after.ID, desc), nil
+	case repository.SortByDue:
+		if after.Time == nil {
+			return bson.M{field: nil, "_id": bson.M{op: after.ID}}, nil
+		}
+		return bson.M{"$or": bson.A{
+			bson.M{field: bson.M{op: *after.Time}},
+			bson.M{field: *after.Time, "_id": bson.M{op: after.ID}},
+			bson.M{field: nil},
+		}}, nil
+	default:
+		if after.Time == nil {
+			return nil, repository.ErrInvalidCursor
+		}
+		return keyset(field, *after.Time, after.ID, desc), nil
+	}
+}
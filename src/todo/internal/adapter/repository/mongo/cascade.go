@@ -0,0 +1,86 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// The deletes below do by hand what the foreign keys of the SQL schema do:
+// deleting a workspace deletes its members and boards, deleting a board
+// deletes its columns and swimlanes, deleting either of those deletes its
+// cards, and deleting a card takes its children to the top level. Callers
+// run them in a transaction.
+
+func (c collections) deleteBoards(ctx context.Context, filter interface{}) error {
+	ids, err := findIDs(ctx, c.boards, filter)
+	if err != nil || len(ids) == 0 {
+		return err
+	}
+
+	if err := c.deleteColumns(ctx, bson.M{"board_id": bson.M{"$in": ids}}); err != nil {
+		return err
+	}
+
+	if err := c.deleteSwimlanes(ctx, bson.M{"board_id": bson.M{"$in": ids}}); err != nil {
+		return err
+	}
+
+	_, err = c.boards.DeleteMany(ctx, bson.M{"_id": bson.M{"$in": ids}})
+
+	return err
+}
+
+func (c collections) deleteColumns(ctx context.Context, filter interface{}) error {
+	ids, err := findIDs(ctx, c.columns, filter)
+	if err != nil || len(ids) == 0 {
+		return err
+	}
+
+	if err := c.deleteCards(ctx, bson.M{"column_id": bson.M{"$in": ids}}); err != nil {
+		return err
+	}
+
+	_, err = c.columns.DeleteMany(ctx, bson.M{"_id": bson.M{"$in": ids}})
+
+	return err
+}
+
+func (c collections) deleteSwimlanes(ctx context.Context, filter interface{}) error {
+	ids, err := findIDs(ctx, c.swimlanes, filter)
+	if err != nil || len(ids) == 0 {
+		return err
+	}
+
+	if err := c.deleteCards(ctx, bson.M{"swimlane_id": bson.M{"$in": ids}}); err != nil {
+		return err
+	}
+
+	_, err = c.swimlanes.DeleteMany(ctx, bson.M{"_id": bson.M{"$in": ids}})
+
+	return err
+}
+
+func (c collections) deleteCards(ctx context.Context, filter interface{}) error {
+	ids, err := findIDs(ctx, c.cards, filter)
+	if err != nil || len(ids) == 0 {
+		return err
+	}
+
+	if _, err := c.cards.DeleteMany(ctx, bson.M{"_id": bson.M{"$in": ids}}); err != nil {
+		return err
+	}
+
+	return c.orphanCards(ctx, ids...)
+}
+
+// orphanCards takes the children of the deleted cards to the top level.
+func (c collections) orphanCards(ctx context.Context, ids ...uuid.UUID) error {
+	_, err := c.cards.UpdateMany(ctx,
+		bson.M{"parent_id": bson.M{"$in": ids}},
+		bson.M{"$set": bson.M{"parent_id": nil}},
+	)
+
+	return err
+}
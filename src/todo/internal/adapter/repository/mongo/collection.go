@@ -0,0 +1,215 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/bsoncodec"
+	"go.mongodb.org/mongo-driver/bson/bsonoptions"
+	"go.mongodb.org/mongo-driver/bson/bsonrw"
+	"go.mongodb.org/mongo-driver/bson/bsontype"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+var errMissingReference = errors.New("referenced document does not exist")
+
+// registry stores a uuid.NullUUID as the UUID or null, and an
+// sql.NullInt64 as the number or null, the way the SQL schema does, rather
+// than as documents, and reads times back in the local time zone.
+var registry = func() *bsoncodec.Registry {
+	tNullUUID := reflect.TypeOf(uuid.NullUUID{})
+	tNullInt64 := reflect.TypeOf(sql.NullInt64{})
+
+	rb := bson.NewRegistryBuilder()
+	rb.RegisterTypeEncoder(tNullUUID, bsoncodec.ValueEncoderFunc(encodeNullUUID))
+	rb.RegisterTypeDecoder(tNullUUID, bsoncodec.ValueDecoderFunc(decodeNullUUID))
+	rb.RegisterTypeEncoder(tNullInt64, bsoncodec.ValueEncoderFunc(encodeNullInt64))
+	rb.RegisterTypeDecoder(tNullInt64, bsoncodec.ValueDecoderFunc(decodeNullInt64))
+	rb.RegisterTypeDecoder(reflect.TypeOf(time.Time{}), bsoncodec.NewTimeCodec(bsonoptions.TimeCodec().SetUseLocalTimeZone(true)))
+
+	return rb.Build()
+}()
+
+func encodeNullUUID(_ bsoncodec.EncodeContext, vw bsonrw.ValueWriter, val reflect.Value) error {
+	id := val.Interface().(uuid.NullUUID)
+	if !id.Valid {
+		return vw.WriteNull()
+	}
+
+	return vw.WriteBinary(id.UUID[:])
+}
+
+func decodeNullUUID(_ bsoncodec.DecodeContext, vr bsonrw.ValueReader, val reflect.Value) error {
+	var id uuid.NullUUID
+
+	switch vr.Type() {
+	case bsontype.Null:
+		if err := vr.ReadNull(); err != nil {
+			return err
+		}
+	case bsontype.Binary:
+		data, _, err := vr.ReadBinary()
+		if err != nil {
+			return err
+		}
+		if id.UUID, err = uuid.FromBytes(data); err != nil {
+			return err
+		}
+		id.Valid = true
+	default:
+		return fmt.Errorf("cannot decode %v into a uuid.NullUUID", vr.Type())
+	}
+
+	val.Set(reflect.ValueOf(id))
+
+	return nil
+}
+
+func encodeNullInt64(_ bsoncodec.EncodeContext, vw bsonrw.ValueWriter, val reflect.Value) error {
+	n := val.Interface().(sql.NullInt64)
+	if !n.Valid {
+		return vw.WriteNull()
+	}
+
+	return vw.WriteInt64(n.Int64)
+}
+
+func decodeNullInt64(_ bsoncodec.DecodeContext, vr bsonrw.ValueReader, val reflect.Value) error {
+	var n sql.NullInt64
+
+	switch vr.Type() {
+	case bsontype.Null:
+		if err := vr.ReadNull(); err != nil {
+			return err
+		}
+	case bsontype.Int32:
+		i, err := vr.ReadInt32()
+		if err != nil {
+			return err
+		}
+		n = sql.NullInt64{Int64: int64(i), Valid: true}
+	case bsontype.Int64:
+		i, err := vr.ReadInt64()
+		if err != nil {
+			return err
+		}
+		n = sql.NullInt64{Int64: i, Valid: true}
+	default:
+		return fmt.Errorf("cannot decode %v into an sql.NullInt64", vr.Type())
+	}
+
+	val.Set(reflect.ValueOf(n))
+
+	return nil
+}
+
+// collections are the collections of the service. Every repository holds
+// them all, since deletes cascade from one collection to the next.
+type collections struct {
+	db               *mongo.Database
+	boards           *mongo.Collection
+	columns          *mongo.Collection
+	swimlanes        *mongo.Collection
+	cards            *mongo.Collection
+	activities       *mongo.Collection
+	counters         *mongo.Collection
+	workspaces       *mongo.Collection
+	workspaceMembers *mongo.Collection
+	quotaOverrides   *mongo.Collection
+	quotaLocks       *mongo.Collection
+}
+
+func newCollections(db *mongo.Database) collections {
+	opts := options.Collection().SetRegistry(registry)
+
+	return collections{
+		db:               db,
+		boards:           db.Collection("boards", opts),
+		columns:          db.Collection("columns", opts),
+		swimlanes:        db.Collection("swimlanes", opts),
+		cards:            db.Collection("cards", opts),
+		activities:       db.Collection("activities", opts),
+		counters:         db.Collection("counters", opts),
+		workspaces:       db.Collection("workspaces", opts),
+		workspaceMembers: db.Collection("workspace_members", opts),
+		quotaOverrides:   db.Collection("quota_overrides", opts),
+		quotaLocks:       db.Collection("quota_locks", opts),
+	}
+}
+
+// findAll decodes all the documents the filter selects into results, a
+// pointer to a slice.
+func findAll(ctx context.Context, coll *mongo.Collection, filter interface{}, results interface{}, opts ...*options.FindOptions) error {
+	cursor, err := coll.Find(ctx, filter, opts...)
+	if err != nil {
+		return err
+	}
+
+	return cursor.All(ctx, results)
+}
+
+// findIDs lists the ids of the documents the filter selects, in the given
+// order if any.
+func findIDs(ctx context.Context, coll *mongo.Collection, filter interface{}, sort ...bson.D) ([]uuid.UUID, error) {
+	var docs []struct {
+		ID uuid.UUID `bson:"_id"`
+	}
+
+	opts := options.Find().SetProjection(bson.M{"_id": 1})
+	if len(sort) > 0 {
+		opts.SetSort(sort[0])
+	}
+
+	err := findAll(ctx, coll, filter, &docs, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]uuid.UUID, len(docs))
+	for i, d := range docs {
+		ids[i] = d.ID
+	}
+
+	return ids, nil
+}
+
+// reference checks that the collection has a document with the id, as a
+// foreign key of the SQL schema would.
+func reference(ctx context.Context, coll *mongo.Collection, id uuid.UUID) error {
+	n, err := coll.CountDocuments(ctx, bson.M{"_id": id}, options.Count().SetLimit(1))
+	if err != nil {
+		return err
+	}
+
+	if n == 0 {
+		return fmt.Errorf("%w: %s %s", errMissingReference, coll.Name(), id)
+	}
+
+	return nil
+}
+
+// keyset selects the documents following the one with the given sort key
+// and id, in ascending order of both, or descending if desc.
+func keyset(key string, value interface{}, id uuid.UUID, desc bool) bson.M {
+	op := "$gt"
+	if desc {
+		op = "$lt"
+	}
+
+	return bson.M{"$or": bson.A{
+		bson.M{key: bson.M{op: value}},
+		bson.M{key: value, "_id": bson.M{op: id}},
+	}}
+}
+
+// ascending sorts by key and then by id.
+func ascending(key string) bson.D {
+	return bson.D{{Key: key, Value: 1}, {Key: "_id", Value: 1}}
+}
@@ -0,0 +1,193 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+	"todo/internal/entity"
+	"todo/internal/repository"
+
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type MongoColumnRepository struct {
+	collections
+}
+
+func NewMongoColumnRepository(db *mongo.Database) *MongoColumnRepository {
+	return &MongoColumnRepository{collections: newCollections(db)}
+}
+
+func (r *MongoColumnRepository) CreateColumn(ctx context.Context, column *entity.Column) error {
+	return inTx(ctx, r.db, func(ctx context.Context) error {
+		if err := reference(ctx, r.boards, column.BoardID); err != nil {
+			return err
+		}
+
+		_, err := r.columns.InsertOne(ctx, repository.RepoColumn(*column))
+
+		return err
+	})
+}
+
+func (r *MongoColumnRepository) GetColumnByID(ctx context.Context, id uuid.UUID) (*entity.Column, error) {
+	var repoColumn repository.Column
+
+	err := r.columns.FindOne(ctx, bson.M{"_id": id}).Decode(&repoColumn)
+	if err != nil {
+		return nil, err
+	}
+
+	column := repository.ColumnToEntity(repoColumn)
+
+	return &column, nil
+}
+
+func (r *MongoColumnRepository) GetColumnsByBoard(ctx context.Context, boardID uuid.UUID, page repository.Page) ([]entity.Column, error) {
+	filter := bson.M{"board_id": boardID}
+
+	if page.After != nil {
+		if page.After.Time == nil {
+			return nil, repository.ErrInvalidCursor
+		}
+
+		filter = bson.M{"$and": bson.A{filter, keyset("created_at", *page.After.Time, page.After.ID, false)}}
+	}
+
+	var repoColumns []repository.Column
+	err := findAll(ctx, r.columns, filter, &repoColumns,
+		options.Find().SetSort(ascending("created_at")).SetLimit(int64(page.Limit)))
+
+	if err != nil {
+		return nil, err
+	}
+
+	columns := make([]entity.Column, len(repoColumns))
+	for i, c := range repoColumns {
+		columns[i] = repository.ColumnToEntity(c)
+	}
+
+	return columns, nil
+}
+
+func (r *MongoColumnRepository) UpdateColumn(ctx context.Context, column *entity.Column) error {
+	update := bson.M{
+		"$set": bson.M{
+			"title":      column.Title,
+			"position":   column.Position,
+			"done":       column.Done,
+			"updated_at": column.UpdatedAt,
+		},
+		"$inc": bson.M{"version": 1},
+	}
+
+	err := updated(r.columns.UpdateOne(ctx, bson.M{"_id": column.ID, "version": column.Version}, update))
+	if err != nil {
+		return err
+	}
+
+	column.Version++
+
+	return nil
+}
+
+func (r *MongoColumnRepository) MoveColumn(ctx context.Context, column *entity.Column) error {
+	update := bson.M{
+		"$set": bson.M{
+			"board_id":   column.BoardID,
+			"title":      column.Title,
+			"position":   column.Position,
+			"updated_at": column.UpdatedAt,
+		},
+		"$inc": bson.M{"version": 1},
+	}
+
+	err := inTx(ctx, r.db, func(ctx context.Context) error {
+		err := updated(r.columns.UpdateOne(ctx, bson.M{"_id": column.ID, "version": column.Version}, update))
+		if err != nil {
+			return err
+		}
+
+		laneID, err := r.firstSwimlane(ctx, column.BoardID)
+		if err != nil {
+			return err
+		}
+
+		_, err = r.cards.UpdateMany(ctx, bson.M{"column_id": column.ID}, bson.M{
+			"$set": bson.M{"swimlane_id": laneID, "updated_at": column.UpdatedAt},
+			"$inc": bson.M{"version": 1},
+		})
+
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	column.Version++
+
+	return nil
+}
+
+func (r *MongoColumnRepository) MergeColumn(ctx context.Context, source *entity.Column, targetID uuid.UUID) error {
+	now := time.Now()
+
+	return inTx(ctx, r.db, func(ctx context.Context) error {
+		var target repository.Column
+		if err := r.columns.FindOne(ctx, bson.M{"_id": targetID}).Decode(&target); err != nil {
+			return err
+		}
+
+		laneID, err := r.firstSwimlane(ctx, target.BoardID)
+		if err != nil {
+			return err
+		}
+
+		var last repository.Card
+		position := 0.0
+
+		err = r.cards.FindOne(ctx, bson.M{"column_id": targetID},
+			options.FindOne().SetSort(bson.D{{Key: "position", Value: -1}})).Decode(&last)
+		switch {
+		case err == nil:
+			position = last.Position + 1
+		case !errors.Is(err, mongo.ErrNoDocuments):
+			return err
+		}
+
+		ids, err := findIDs(ctx, r.cards, bson.M{"column_id": source.ID}, ascending("position"))
+		if err != nil {
+			return err
+		}
+
+		for i, id := range ids {
+			_, err := r.cards.UpdateOne(ctx, bson.M{"_id": id}, bson.M{
+				"$set": bson.M{
+					"column_id":   targetID,
+					"swimlane_id": laneID,
+					"position":    position + float64(i),
+					"updated_at":  now,
+				},
+				"$inc": bson.M{"version": 1},
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		return deleted(r.columns.DeleteOne(ctx, bson.M{"_id": source.ID, "version": source.Version}))
+	})
+}
+
+func (r *MongoColumnRepository) DeleteColumn(ctx context.Context, id uuid.UUID, version int) error {
+	return inTx(ctx, r.db, func(ctx context.Context) error {
+		if err := deleted(r.columns.DeleteOne(ctx, bson.M{"_id": id, "version": version})); err != nil {
+			return err
+		}
+
+		return r.deleteCards(ctx, bson.M{"column_id": id})
+	})
+}
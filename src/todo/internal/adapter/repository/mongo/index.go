@@ -0,0 +1,57 @@
+package repository
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// CreateIndexes makes the indexes of the SQL schema, with unique ones in
+// place of its primary keys and unique constraints. Indexes that exist
+// already are left as they are, so it runs on every start.
+func CreateIndexes(ctx context.Context, db *mongo.Database) error {
+	indexes := map[string][]mongo.IndexModel{
+		"boards": {
+			{Keys: bson.D{{Key: "user_id", Value: 1}, {Key: "created_at", Value: 1}, {Key: "_id", Value: 1}}},
+			{Keys: bson.D{{Key: "workspace_id", Value: 1}, {Key: "created_at", Value: 1}, {Key: "_id", Value: 1}}},
+		},
+		"columns": {
+			{Keys: bson.D{{Key: "board_id", Value: 1}, {Key: "created_at", Value: 1}, {Key: "_id", Value: 1}}},
+		},
+		"swimlanes": {
+			{Keys: bson.D{{Key: "board_id", Value: 1}, {Key: "position", Value: 1}, {Key: "_id", Value: 1}}},
+		},
+		"cards": {
+			{Keys: bson.D{{Key: "column_id", Value: 1}, {Key: "position", Value: 1}}},
+			{Keys: bson.D{{Key: "swimlane_id", Value: 1}}},
+			{Keys: bson.D{{Key: "parent_id", Value: 1}}},
+			{Keys: bson.D{{Key: "priority", Value: 1}}},
+			{Keys: bson.D{{Key: "assignee_id", Value: 1}}},
+			{Keys: bson.D{{Key: "labels", Value: 1}}},
+			{Keys: bson.D{{Key: "created_at", Value: 1}, {Key: "_id", Value: 1}}},
+		},
+		"activities": {
+			{Keys: bson.D{{Key: "board_id", Value: 1}, {Key: "_id", Value: 1}}},
+		},
+		"workspaces": {
+			{
+				Keys:    bson.D{{Key: "created_by", Value: 1}},
+				Options: options.Index().SetUnique(true).SetPartialFilterExpression(bson.M{"personal": true}),
+			},
+		},
+		"workspace_members": {
+			{Keys: bson.D{{Key: "workspace_id", Value: 1}, {Key: "user_id", Value: 1}}, Options: options.Index().SetUnique(true)},
+			{Keys: bson.D{{Key: "user_id", Value: 1}}},
+		},
+	}
+
+	for name, models := range indexes {
+		if _, err := db.Collection(name).Indexes().CreateMany(ctx, models); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,74 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"todo/internal/entity"
+	"todo/internal/repository"
+
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type MongoQuotaRepository struct {
+	collections
+}
+
+func NewMongoQuotaRepository(db *mongo.Database) *MongoQuotaRepository {
+	return &MongoQuotaRepository{collections: newCollections(db)}
+}
+
+func (r *MongoQuotaRepository) GetQuotaOverride(ctx context.Context, userID uuid.UUID) (*entity.QuotaOverride, error) {
+	var repoOverride repository.QuotaOverride
+
+	err := r.quotaOverrides.FindOne(ctx, bson.M{"_id": userID}).Decode(&repoOverride)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, repository.ErrQuotaOverrideNotFound
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	override := repository.QuotaOverrideToEntity(repoOverride)
+
+	return &override, nil
+}
+
+func (r *MongoQuotaRepository) SetQuotaOverride(ctx context.Context, override *entity.QuotaOverride) error {
+	_, err := r.quotaOverrides.ReplaceOne(ctx,
+		bson.M{"_id": override.UserID},
+		repository.RepoQuotaOverride(*override),
+		options.Replace().SetUpsert(true),
+	)
+
+	return err
+}
+
+func (r *MongoQuotaRepository) DeleteQuotaOverride(ctx context.Context, userID uuid.UUID) error {
+	res, err := r.quotaOverrides.DeleteOne(ctx, bson.M{"_id": userID})
+	if err != nil {
+		return err
+	}
+
+	if res.DeletedCount == 0 {
+		return repository.ErrQuotaOverrideNotFound
+	}
+
+	return nil
+}
+
+// LockQuota writes the lock document of the scope. Mongo has no locks to
+// wait on: of two transactions that write it, the later one fails with a
+// write conflict instead, so that they never count the same things.
+func (r *MongoQuotaRepository) LockQuota(ctx context.Context, scope uuid.UUID) error {
+	_, err := r.quotaLocks.UpdateOne(ctx,
+		bson.M{"_id": scope},
+		bson.M{"$inc": bson.M{"writes": 1}},
+		options.Update().SetUpsert(true),
+	)
+
+	return err
+}
@@ -0,0 +1,129 @@
+package repository
+
+import (
+	"context"
+	"todo/internal/entity"
+	"todo/internal/repository"
+
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type MongoSwimlaneRepository struct {
+	collections
+}
+
+func NewMongoSwimlaneRepository(db *mongo.Database) *MongoSwimlaneRepository {
+	return &MongoSwimlaneRepository{collections: newCollections(db)}
+}
+
+func (r *MongoSwimlaneRepository) CreateSwimlane(ctx context.Context, swimlane *entity.Swimlane) error {
+	return inTx(ctx, r.db, func(ctx context.Context) error {
+		if err := reference(ctx, r.boards, swimlane.BoardID); err != nil {
+			return err
+		}
+
+		_, err := r.swimlanes.InsertOne(ctx, repository.RepoSwimlane(*swimlane))
+
+		return err
+	})
+}
+
+func (r *MongoSwimlaneRepository) GetSwimlaneByID(ctx context.Context, id uuid.UUID) (*entity.Swimlane, error) {
+	var repoSwimlane repository.Swimlane
+
+	err := r.swimlanes.FindOne(ctx, bson.M{"_id": id}).Decode(&repoSwimlane)
+	if err != nil {
+		return nil, err
+	}
+
+	swimlane := repository.SwimlaneToEntity(repoSwimlane)
+
+	return &swimlane, nil
+}
+
+func (r *MongoSwimlaneRepository) GetSwimlanesByBoard(ctx context.Context, boardID uuid.UUID, page repository.Page) ([]entity.Swimlane, error) {
+	filter := bson.M{"board_id": boardID}
+
+	if page.After != nil {
+		if page.After.Number == nil {
+			return nil, repository.ErrInvalidCursor
+		}
+
+		filter = bson.M{"$and": bson.A{filter, keyset("position", *page.After.Number, page.After.ID, false)}}
+	}
+
+	var repoSwimlanes []repository.Swimlane
+	err := findAll(ctx, r.swimlanes, filter, &repoSwimlanes,
+		options.Find().SetSort(ascending("position")).SetLimit(int64(page.Limit)))
+
+	if err != nil {
+		return nil, err
+	}
+
+	swimlanes := make([]entity.Swimlane, len(repoSwimlanes))
+	for i, s := range repoSwimlanes {
+		swimlanes[i] = repository.SwimlaneToEntity(s)
+	}
+
+	return swimlanes, nil
+}
+
+func (r *MongoSwimlaneRepository) UpdateSwimlane(ctx context.Context, swimlane *entity.Swimlane) error {
+	update := bson.M{
+		"$set": bson.M{"title": swimlane.Title, "position": swimlane.Position, "updated_at": swimlane.UpdatedAt},
+		"$inc": bson.M{"version": 1},
+	}
+
+	err := updated(r.swimlanes.UpdateOne(ctx, bson.M{"_id": swimlane.ID, "version": swimlane.Version}, update))
+	if err != nil {
+		return err
+	}
+
+	swimlane.Version++
+
+	return nil
+}
+
+func (r *MongoSwimlaneRepository) DeleteSwimlane(ctx context.Context, id uuid.UUID, version int) error {
+	return inTx(ctx, r.db, func(ctx context.Context) error {
+		if err := deleted(r.swimlanes.DeleteOne(ctx, bson.M{"_id": id, "version": version})); err != nil {
+			return err
+		}
+
+		return r.deleteCards(ctx, bson.M{"swimlane_id": id})
+	})
+}
+
+// firstSwimlane returns the id of the first swimlane of the board.
+func (c collections) firstSwimlane(ctx context.Context, boardID uuid.UUID) (uuid.UUID, error) {
+	var lane repository.Swimlane
+
+	err := c.swimlanes.FindOne(ctx, bson.M{"board_id": boardID},
+		options.FindOne().SetSort(ascending("position"))).Decode(&lane)
+
+	return lane.ID, err
+}
+
+// movedSwimlane returns the swimlane of a card moved to the column from the
+// swimlane laneID: that one if it is on the board of the column, or else
+// the first swimlane there.
+func (c collections) movedSwimlane(ctx context.Context, columnID, laneID uuid.UUID) (uuid.UUID, error) {
+	var column repository.Column
+	if err := c.columns.FindOne(ctx, bson.M{"_id": columnID}).Decode(&column); err != nil {
+		return uuid.Nil, err
+	}
+
+	n, err := c.swimlanes.CountDocuments(ctx, bson.M{"_id": laneID, "board_id": column.BoardID})
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	if n > 0 {
+		return laneID, nil
+	}
+
+	return c.firstSwimlane(ctx, column.BoardID)
+}
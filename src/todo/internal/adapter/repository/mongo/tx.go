@@ -0,0 +1,51 @@
+package repository
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// MongoTxManager runs units of work in a Mongo transaction, whose session the
+// context carries; the Mongo repositories pick it up from there. Transactions
+// need the server to be a member of a replica set.
+type MongoTxManager struct {
+	client *mongo.Client
+}
+
+func NewMongoTxManager(db *mongo.Database) *MongoTxManager {
+	return &MongoTxManager{client: db.Client()}
+}
+
+func (m *MongoTxManager) WithinTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	if mongo.SessionFromContext(ctx) != nil {
+		return fn(ctx)
+	}
+
+	session, err := m.client.StartSession()
+	if err != nil {
+		return err
+	}
+	defer session.EndSession(ctx)
+
+	// Unlike session.WithTransaction, a conflict is not retried: fn may
+	// have changed the entities it was given, as in the SQLX repositories.
+	return mongo.WithSession(ctx, session, func(ctx mongo.SessionContext) error {
+		if err := ctx.StartTransaction(); err != nil {
+			return err
+		}
+
+		if err := fn(ctx); err != nil {
+			ctx.AbortTransaction(ctx)
+			return err
+		}
+
+		return ctx.CommitTransaction(ctx)
+	})
+}
+
+// inTx runs fn in the transaction of the unit of work ctx belongs to, or in
+// a transaction of its own outside of one.
+func inTx(ctx context.Context, db *mongo.Database, fn func(ctx context.Context) error) error {
+	return NewMongoTxManager(db).WithinTx(ctx, fn)
+}
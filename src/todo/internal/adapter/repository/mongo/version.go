@@ -0,0 +1,35 @@
+package repository
+
+import (
+	"todo/internal/repository"
+
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// updated checks the result of an update conditioned on the document
+// version: an update that matched no document lost the race to another
+// writer.
+func updated(res *mongo.UpdateResult, err error) error {
+	if err != nil {
+		return err
+	}
+
+	if res.MatchedCount == 0 {
+		return repository.ErrVersionMismatch
+	}
+
+	return nil
+}
+
+// deleted does the same for a delete.
+func deleted(res *mongo.DeleteResult, err error) error {
+	if err != nil {
+		return err
+	}
+
+	if res.DeletedCount == 0 {
+		return repository.ErrVersionMismatch
+	}
+
+	return nil
+}
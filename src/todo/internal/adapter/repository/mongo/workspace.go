@@ -0,0 +1,221 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+	"todo/internal/entity"
+	"todo/internal/repository"
+
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type MongoWorkspaceRepository struct {
+	collections
+}
+
+func NewMongoWorkspaceRepository(db *mongo.Database) *MongoWorkspaceRepository {
+	return &MongoWorkspaceRepository{collections: newCollections(db)}
+}
+
+func (r *MongoWorkspaceRepository) CreateWorkspace(ctx context.Context, workspace *entity.Workspace, admin *entity.WorkspaceMember) error {
+	return inTx(ctx, r.db, func(ctx context.Context) error {
+		if _, err := r.workspaces.InsertOne(ctx, repository.RepoWorkspace(*workspace)); err != nil {
+			return err
+		}
+
+		_, err := r.workspaceMembers.InsertOne(ctx, repository.RepoWorkspaceMember(*admin))
+
+		return err
+	})
+}
+
+func (r *MongoWorkspaceRepository) GetWorkspaceByID(ctx context.Context, id uuid.UUID) (*entity.Workspace, error) {
+	var repoWorkspace repository.Workspace
+
+	err := r.workspaces.FindOne(ctx, bson.M{"_id": id}).Decode(&repoWorkspace)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, repository.ErrWorkspaceNotFound
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	workspace := repository.WorkspaceToEntity(repoWorkspace)
+
+	return &workspace, nil
+}
+
+func (r *MongoWorkspaceRepository) DeleteWorkspace(ctx context.Context, id uuid.UUID) error {
+	return inTx(ctx, r.db, func(ctx context.Context) error {
+		res, err := r.workspaces.DeleteOne(ctx, bson.M{"_id": id})
+		if err != nil {
+			return err
+		}
+
+		if res.DeletedCount == 0 {
+			return repository.ErrWorkspaceNotFound
+		}
+
+		if _, err := r.workspaceMembers.DeleteMany(ctx, bson.M{"workspace_id": id}); err != nil {
+			return err
+		}
+
+		return r.deleteBoards(ctx, bson.M{"workspace_id": id})
+	})
+}
+
+func (r *MongoWorkspaceRepository) EnsurePersonalWorkspace(ctx context.Context, userID uuid.UUID, at time.Time) (*entity.Workspace, error) {
+	var repoWorkspace repository.Workspace
+
+	err := inTx(ctx, r.db, func(ctx context.Context) error {
+		err := r.workspaces.FindOneAndUpdate(ctx,
+			bson.M{"created_by": userID, "personal": true},
+			bson.M{"$setOnInsert": bson.M{"_id": uuid.New(), "name": repository.PersonalWorkspaceName, "created_at": at}},
+			options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.After),
+		).Decode(&repoWorkspace)
+		if err != nil {
+			return err
+		}
+
+		_, err = r.workspaceMembers.UpdateOne(ctx,
+			bson.M{"workspace_id": repoWorkspace.ID, "user_id": userID},
+			bson.M{"$setOnInsert": bson.M{"role": entity.RoleAdmin, "created_at": repoWorkspace.CreatedAt}},
+			options.Update().SetUpsert(true),
+		)
+
+		return err
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	workspace := repository.WorkspaceToEntity(repoWorkspace)
+
+	return &workspace, nil
+}
+
+func (r *MongoWorkspaceRepository) GetWorkspacesByUser(ctx context.Context, userID uuid.UUID) ([]entity.WorkspaceMembership, error) {
+	var repoMembers []repository.WorkspaceMember
+	if err := findAll(ctx, r.workspaceMembers, bson.M{"user_id": userID}, &repoMembers); err != nil {
+		return nil, err
+	}
+
+	roles := make(map[uuid.UUID]string, len(repoMembers))
+	ids := make([]uuid.UUID, len(repoMembers))
+	for i, m := range repoMembers {
+		roles[m.WorkspaceID] = m.Role
+		ids[i] = m.WorkspaceID
+	}
+
+	var repoWorkspaces []repository.Workspace
+	err := findAll(ctx, r.workspaces, bson.M{"_id": bson.M{"$in": ids}}, &repoWorkspaces,
+		options.Find().SetSort(ascending("created_at")))
+
+	if err != nil {
+		return nil, err
+	}
+
+	workspaces := make([]entity.WorkspaceMembership, len(repoWorkspaces))
+	for i, w := range repoWorkspaces {
+		workspaces[i] = repository.WorkspaceMembershipToEntity(repository.WorkspaceMembership{Workspace: w, Role: roles[w.ID]})
+	}
+
+	return workspaces, nil
+}
+
+func (r *MongoWorkspaceRepository) AddWorkspaceMember(ctx context.Context, member *entity.WorkspaceMember) error {
+	_, err := r.workspaceMembers.InsertOne(ctx, repository.RepoWorkspaceMember(*member))
+
+	if mongo.IsDuplicateKeyError(err) {
+		return repository.ErrWorkspaceMemberExists
+	}
+
+	return err
+}
+
+func (r *MongoWorkspaceRepository) UpdateWorkspaceMember(ctx context.Context, member *entity.WorkspaceMember) error {
+	res, err := r.workspaceMembers.UpdateOne(ctx,
+		bson.M{"workspace_id": member.WorkspaceID, "user_id": member.UserID},
+		bson.M{"$set": bson.M{"role": member.Role}},
+	)
+	if err != nil {
+		return err
+	}
+
+	if res.MatchedCount == 0 {
+		return repository.ErrWorkspaceMemberNotFound
+	}
+
+	return nil
+}
+
+func (r *MongoWorkspaceRepository) RemoveWorkspaceMember(ctx context.Context, workspaceID, userID uuid.UUID) error {
+	res, err := r.workspaceMembers.DeleteOne(ctx, bson.M{"workspace_id": workspaceID, "user_id": userID})
+	if err != nil {
+		return err
+	}
+
+	if res.DeletedCount == 0 {
+		return repository.ErrWorkspaceMemberNotFound
+	}
+
+	return nil
+}
+
+func (r *MongoWorkspaceRepository) GetWorkspaceMember(ctx context.Context, workspaceID, userID uuid.UUID) (*entity.WorkspaceMember, error) {
+	var repoMember repository.WorkspaceMember
+
+	err := r.workspaceMembers.FindOne(ctx, bson.M{"workspace_id": workspaceID, "user_id": userID}).Decode(&repoMember)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, repository.ErrWorkspaceMemberNotFound
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	member := repository.WorkspaceMemberToEntity(repoMember)
+
+	return &member, nil
+}
+
+func (r *MongoWorkspaceRepository) GetWorkspaceMembers(ctx context.Context, workspaceID uuid.UUID) ([]entity.WorkspaceMember, error) {
+	var repoMembers []repository.WorkspaceMember
+	err := findAll(ctx, r.workspaceMembers, bson.M{"workspace_id": workspaceID}, &repoMembers,
+		options.Find().SetSort(bson.D{{Key: "created_at", Value: 1}, {Key: "user_id", Value: 1}}))
+
+	if err != nil {
+		return nil, err
+	}
+
+	members := make([]entity.WorkspaceMember, len(repoMembers))
+	for i, m := range repoMembers {
+		members[i] = repository.WorkspaceMemberToEntity(m)
+	}
+
+	return members, nil
+}
+
+func (r *MongoWorkspaceRepository) GetWorkspaceBoards(ctx context.Context, workspaceID uuid.UUID, page repository.Page) ([]entity.Board, error) {
+	return r.findBoards(ctx, bson.M{"workspace_id": workspaceID}, page)
+}
+
+func (r *MongoWorkspaceRepository) GetMemberBoards(ctx context.Context, userID uuid.UUID, page repository.Page) ([]entity.Board, error) {
+	var repoMembers []repository.WorkspaceMember
+	if err := findAll(ctx, r.workspaceMembers, bson.M{"user_id": userID}, &repoMembers); err != nil {
+		return nil, err
+	}
+
+	ids := make([]uuid.UUID, len(repoMembers))
+	for i, m := range repoMembers {
+		ids[i] = m.WorkspaceID
+	}
+
+	return r.findBoards(ctx, bson.M{"workspace_id": bson.M{"$in": ids}}, page)
+}
@@ -0,0 +1,58 @@
+package repository
+
+import (
+	"context"
+	"todo/internal/entity"
+	"todo/internal/repository"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+)
+
+type SQLXActivityRepository struct {
+	db *sqlx.DB
+}
+
+func NewSQLXActivityRepository(db *sqlx.DB) *SQLXActivityRepository {
+	return &SQLXActivityRepository{db: db}
+}
+
+func (r *SQLXActivityRepository) AddActivity(ctx context.Context, activity *entity.Activity) error {
+	query := `
+	INSERT INTO activities (board_id, kind, payload, created_at)
+	VALUES ($1, $2, $3, $4)
+	RETURNING id
+	`
+
+	repoActivity, err := repository.RepoActivity(*activity)
+	if err != nil {
+		return err
+	}
+
+	return conn(ctx, r.db).GetContext(ctx, &activity.ID, query,
+		repoActivity.BoardID, repoActivity.Kind, repoActivity.Payload, repoActivity.CreatedAt)
+}
+
+func (r *SQLXActivityRepository) GetActivities(ctx context.Context, boardID uuid.UUID, afterID int64, limit int) ([]entity.Activity, error) {
+	query := `
+	SELECT * FROM activities WHERE board_id = $1 AND id > $2
+	ORDER BY id ASC
+	LIMIT $3
+	`
+
+	var repoActivities []repository.Activity
+	err := conn(ctx, r.db).SelectContext(ctx, &repoActivities, query, boardID, afterID, limit)
+
+	if err != nil {
+		return nil, err
+	}
+
+	activities := make([]entity.Activity, len(repoActivities))
+	for i, a := range repoActivities {
+		if activities[i], err = repository.ActivityToEntity(a); err != nil {
+			return nil, err
+		}
+	}
+
+	return activities, nil
+}
@@ -21,13 +21,34 @@ func (r *SQLXBoardRepository) CreateBoard(ctx context.Context, board *entity.Boa
 	repoBoard := repository.RepoBoard(*board)
 
 	query := `
-    INSERT INTO boards (id, user_id, title, created_at, updated_at)
-	VALUES (:id, :user_id, :title, :created_at, :updated_at)
+    INSERT INTO boards (id, user_id, workspace_id, title, version, created_at, updated_at)
+	VALUES (:id, :user_id, :workspace_id, :title, :version, :created_at, :updated_at)
     `
 
-	_, err := r.db.NamedExecContext(ctx, query, repoBoard)
+	lane := repository.Swimlane{
+		ID:        uuid.New(),
+		UserID:    board.UserID,
+		BoardID:   board.ID,
+		Title:     repository.DefaultSwimlaneTitle,
+		Version:   1,
+		CreatedAt: board.CreatedAt,
+		UpdatedAt: board.CreatedAt,
+	}
+
+	laneQuery := `
+	INSERT INTO swimlanes (id, board_id, user_id, title, position, version, created_at, updated_at)
+	VALUES (:id, :board_id, :user_id, :title, :position, :version, :created_at, :updated_at)
+	`
 
-	return err
+	return inTx(ctx, r.db, func(tx *sqlx.Tx) error {
+		if _, err := tx.NamedExecContext(ctx, query, repoBoard); err != nil {
+			return err
+		}
+
+		_, err := tx.NamedExecContext(ctx, laneQuery, lane)
+
+		return err
+	})
 }
 
 func (r *SQLXBoardRepository) GetBoardByID(ctx context.Context, id uuid.UUID) (*entity.Board, error) {
@@ -36,7 +57,7 @@ func (r *SQLXBoardRepository) GetBoardByID(ctx context.Context, id uuid.UUID) (*
 	`
 
 	var repoBoard repository.Board
-	err := r.db.GetContext(ctx, &repoBoard, query, id)
+	err := conn(ctx, r.db).GetContext(ctx, &repoBoard, query, id)
 
 	if err != nil {
 		return nil, err
@@ -47,16 +68,30 @@ func (r *SQLXBoardRepository) GetBoardByID(ctx context.Context, id uuid.UUID) (*
 	return &board, err
 }
 
-func (r *SQLXBoardRepository) GetBoardsByUser(ctx context.Context, userID uuid.UUID, limit, offset int) ([]entity.Board, error) {
+func (r *SQLXBoardRepository) GetBoardsByUser(ctx context.Context, userID uuid.UUID, page repository.Page) ([]entity.Board, error) {
 	query := `
 	SELECT * FROM boards WHERE user_id = $1
-	ORDER BY created_at ASC
+	ORDER BY created_at ASC, id ASC
 	LIMIT $2
-	OFFSET $3
 	`
+	args := []interface{}{userID, page.Limit}
+
+	if page.After != nil {
+		if page.After.Time == nil {
+			return nil, repository.ErrInvalidCursor
+		}
+
+		query = `
+		SELECT * FROM boards WHERE user_id = $1
+		AND (created_at > $3 OR (created_at = $3 AND id > $4))
+		ORDER BY created_at ASC, id ASC
+		LIMIT $2
+		`
+		args = append(args, *page.After.Time, page.After.ID)
+	}
 
 	var repoBoards []repository.Board
-	err := r.db.SelectContext(ctx, &repoBoards, query, userID, limit, offset)
+	err := conn(ctx, r.db).SelectContext(ctx, &repoBoards, query, args...)
 
 	if err != nil {
 		return nil, err
@@ -76,21 +111,47 @@ func (r *SQLXBoardRepository) UpdateBoard(ctx context.Context, board *entity.Boa
 	query := `
     UPDATE boards SET
 	title = :title,
+	version = version + 1,
 	updated_at = :updated_at
-    WHERE id = :id
+    WHERE id = :id AND version = :version
     `
 
-	_, err := r.db.NamedExecContext(ctx, query, repoBoard)
+	err := versioned(conn(ctx, r.db).NamedExecContext(ctx, query, repoBoard))
+	if err != nil {
+		return err
+	}
+
+	board.Version++
 
-	return err
+	return nil
 }
 
-func (r *SQLXBoardRepository) DeleteBoard(ctx context.Context, id uuid.UUID) error {
+func (r *SQLXBoardRepository) TransferBoard(ctx context.Context, board *entity.Board) error {
+	repoBoard := repository.RepoBoard(*board)
+
 	query := `
-	DELETE FROM boards WHERE id = $1
-	`
+    UPDATE boards SET
+	user_id = :user_id,
+	workspace_id = :workspace_id,
+	version = version + 1,
+	updated_at = :updated_at
+    WHERE id = :id AND version = :version
+    `
+
+	err := versioned(conn(ctx, r.db).NamedExecContext(ctx, query, repoBoard))
+	if err != nil {
+		return err
+	}
 
-	_, err := r.db.ExecContext(ctx, query, id)
+	board.Version++
+
+	return nil
+}
+
+func (r *SQLXBoardRepository) DeleteBoard(ctx context.Context, id uuid.UUID, version int) error {
+	query := `
+	DELETE FROM boards WHERE id = $1 AND version = $2
+	`
 
-	return err
+	return versioned(conn(ctx, r.db).ExecContext(ctx, query, id, version))
 }
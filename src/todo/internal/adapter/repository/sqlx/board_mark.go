@@ -0,0 +1,174 @@
+package repository
+
+import (
+	"context"
+	"todo/internal/entity"
+	"todo/internal/repository"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+)
+
+type SQLXBoardMarkRepository struct {
+	db *sqlx.DB
+}
+
+func NewSQLXBoardMarkRepository(db *sqlx.DB) *SQLXBoardMarkRepository {
+	return &SQLXBoardMarkRepository{db: db}
+}
+
+// markedBoards ranks the boards of every workspace the user $1 is a member
+// of, the boards GetMemberBoards lists, as repository.MarkedBoardRank does.
+const markedBoards = `
+	WITH marked AS (
+		SELECT b.*, s.created_at AS starred_at, v.viewed_at,
+			CASE
+				WHEN s.board_id IS NOT NULL THEN 0
+				WHEN v.board_id IS NOT NULL THEN 1
+				ELSE 2
+			END AS rank_group,
+			CASE
+				WHEN s.board_id IS NOT NULL THEN COALESCE(v.viewed_at, s.created_at)
+				WHEN v.board_id IS NOT NULL THEN v.viewed_at
+				ELSE b.created_at
+			END AS rank_at
+		FROM boards b
+		JOIN workspace_members m ON m.workspace_id = b.workspace_id AND m.user_id = $1
+		LEFT JOIN board_stars s ON s.board_id = b.id AND s.user_id = $1
+		LEFT JOIN board_views v ON v.board_id = b.id AND v.user_id = $1
+	)
+	SELECT * FROM marked
+	`
+
+// markedBoardsOrder puts starred and recent boards latest viewed first and
+// the rest oldest first.
+const markedBoardsOrder = `
+	ORDER BY rank_group, CASE WHEN rank_group < 2 THEN rank_at END DESC, rank_at, id
+	LIMIT $2
+	`
+
+// markedBoardRow has the rank of the board too. Its time is computed, which
+// SQLite hands back as text.
+type markedBoardRow struct {
+	repository.MarkedBoard
+	RankGroup int       `db:"rank_group"`
+	RankAt    timeValue `db:"rank_at"`
+}
+
+func (r *SQLXBoardMarkRepository) StarBoard(ctx context.Context, star *entity.BoardStar) error {
+	query := `
+	INSERT INTO board_stars (user_id, board_id, created_at)
+	VALUES (:user_id, :board_id, :created_at)
+	ON CONFLICT (user_id, board_id) DO NOTHING
+	`
+
+	_, err := conn(ctx, r.db).NamedExecContext(ctx, query, repository.RepoBoardStar(*star))
+
+	return err
+}
+
+func (r *SQLXBoardMarkRepository) UnstarBoard(ctx context.Context, userID, boardID uuid.UUID) error {
+	query := `DELETE FROM board_stars WHERE user_id = $1 AND board_id = $2`
+
+	_, err := conn(ctx, r.db).ExecContext(ctx, query, userID, boardID)
+
+	return err
+}
+
+func (r *SQLXBoardMarkRepository) RecordBoardView(ctx context.Context, view *entity.BoardView) error {
+	query := `
+	INSERT INTO board_views (user_id, board_id, viewed_at)
+	VALUES (:user_id, :board_id, :viewed_at)
+	ON CONFLICT (user_id, board_id) DO UPDATE SET viewed_at = EXCLUDED.viewed_at
+	`
+
+	pruneQuery := `
+	DELETE FROM board_views WHERE user_id = $1 AND board_id NOT IN (
+		SELECT board_id FROM board_views WHERE user_id = $1
+		ORDER BY viewed_at DESC, board_id
+		LIMIT $2
+	)
+	`
+
+	return inTx(ctx, r.db, func(tx *sqlx.Tx) error {
+		if _, err := tx.NamedExecContext(ctx, query, repository.RepoBoardView(*view)); err != nil {
+			return err
+		}
+
+		_, err := tx.ExecContext(ctx, pruneQuery, view.UserID, repository.RecentBoardsLimit)
+
+		return err
+	})
+}
+
+func (r *SQLXBoardMarkRepository) GetRecentBoards(ctx context.Context, userID uuid.UUID) ([]entity.MarkedBoard, error) {
+	query := `
+	SELECT b.*, s.created_at AS starred_at, v.viewed_at
+	FROM board_views v
+	JOIN boards b ON b.id = v.board_id
+	JOIN workspace_members m ON m.workspace_id = b.workspace_id AND m.user_id = v.user_id
+	LEFT JOIN board_stars s ON s.board_id = b.id AND s.user_id = v.user_id
+	WHERE v.user_id = $1
+	ORDER BY v.viewed_at DESC, b.id
+	LIMIT $2
+	`
+
+	var repoBoards []repository.MarkedBoard
+	err := conn(ctx, r.db).SelectContext(ctx, &repoBoards, query, userID, repository.RecentBoardsLimit)
+
+	if err != nil {
+		return nil, err
+	}
+
+	boards := make([]entity.MarkedBoard, len(repoBoards))
+	for i, b := range repoBoards {
+		boards[i] = repository.MarkedBoardToEntity(b)
+	}
+
+	return boards, nil
+}
+
+func (r *SQLXBoardMarkRepository) GetMarkedBoards(ctx context.Context, userID uuid.UUID, page repository.Page) ([]entity.MarkedBoard, error) {
+	query := markedBoards + markedBoardsOrder
+	args := []interface{}{userID, page.Limit}
+
+	if page.After != nil {
+		if page.After.Time == nil || page.After.Number == nil {
+			return nil, repository.ErrInvalidCursor
+		}
+
+		query = markedBoards + `
+		WHERE rank_group > $3 OR (rank_group = $3 AND (
+			(rank_group < 2 AND (rank_at < $4 OR (rank_at = $4 AND id > $5)))
+			OR (rank_group = 2 AND (rank_at > $4 OR (rank_at = $4 AND id > $5)))
+		))
+		` + markedBoardsOrder
+		args = append(args, int(*page.After.Number), *page.After.Time, page.After.ID)
+	}
+
+	var rows []markedBoardRow
+	err := conn(ctx, r.db).SelectContext(ctx, &rows, query, args...)
+
+	if err != nil {
+		return nil, err
+	}
+
+	boards := make([]entity.MarkedBoard, len(rows))
+	for i, row := range rows {
+		boards[i] = repository.MarkedBoardToEntity(row.MarkedBoard)
+	}
+
+	return boards, nil
+}
+
+func (r *SQLXBoardMarkRepository) DeleteUserMarks(ctx context.Context, userID uuid.UUID) error {
+	return inTx(ctx, r.db, func(tx *sqlx.Tx) error {
+		if _, err := tx.ExecContext(ctx, `DELETE FROM board_stars WHERE user_id = $1`, userID); err != nil {
+			return err
+		}
+
+		_, err := tx.ExecContext(ctx, `DELETE FROM board_views WHERE user_id = $1`, userID)
+
+		return err
+	})
+}
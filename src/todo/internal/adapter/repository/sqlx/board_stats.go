@@ -0,0 +1,137 @@
+package repository
+
+import (
+	"context"
+	"time"
+	"todo/internal/entity"
+	"todo/internal/repository"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+)
+
+type SQLXBoardStatsRepository struct {
+	db *sqlx.DB
+}
+
+func NewSQLXBoardStatsRepository(db *sqlx.DB) *SQLXBoardStatsRepository {
+	return &SQLXBoardStatsRepository{db: db}
+}
+
+func (r *SQLXBoardStatsRepository) GetColumnCards(ctx context.Context, boardID uuid.UUID) ([]entity.ColumnCards, error) {
+	query := `
+	SELECT col.id AS column_id, col.title AS column_title, col.done, COUNT(c.id) AS cards
+	FROM columns col
+	LEFT JOIN cards c ON c.column_id = col.id AND c.archived_at IS NULL
+	WHERE col.board_id = $1
+	GROUP BY col.id, col.title, col.done, col.position
+	ORDER BY col.position, col.id
+	`
+
+	var repoColumns []repository.ColumnCards
+	err := conn(ctx, r.db).SelectContext(ctx, &repoColumns, query, boardID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	columns := make([]entity.ColumnCards, len(repoColumns))
+	for i, c := range repoColumns {
+		columns[i] = repository.ColumnCardsToEntity(c)
+	}
+
+	return columns, nil
+}
+
+// GetCreatedCards takes a card to be created on the board when it first
+// entered a column of it, so that deleted cards still count.
+func (r *SQLXBoardStatsRepository) GetCreatedCards(ctx context.Context, boardID uuid.UUID, from, to time.Time) ([]entity.CreatedCard, error) {
+	query := `
+	WITH created AS (
+		SELECT s.card_id, MIN(s.entered_at) AS created_at
+		FROM card_column_stays s
+		WHERE s.board_id = $1
+		GROUP BY s.card_id
+	)
+	SELECT cr.card_id, c.user_id, cr.created_at
+	FROM created cr LEFT JOIN cards c ON c.id = cr.card_id
+	WHERE cr.created_at >= $2 AND cr.created_at < $3
+	ORDER BY cr.created_at, cr.card_id
+	`
+
+	// The times are computed, which SQLite hands back as text.
+	var repoCards []struct {
+		repository.CreatedCard
+		CreatedAt timeValue `db:"created_at"`
+	}
+	err := conn(ctx, r.db).SelectContext(ctx, &repoCards, query, boardID, from, to)
+
+	if err != nil {
+		return nil, err
+	}
+
+	cards := make([]entity.CreatedCard, len(repoCards))
+	for i, c := range repoCards {
+		c.CreatedCard.CreatedAt = c.CreatedAt.Time
+		cards[i] = repository.CreatedCardToEntity(c.CreatedCard)
+	}
+
+	return cards, nil
+}
+
+func (r *SQLXBoardStatsRepository) GetCompletedCards(ctx context.Context, boardID uuid.UUID, from, to time.Time) ([]entity.CompletedCard, error) {
+	query := `
+	WITH done AS (
+		SELECT s.card_id, MIN(s.entered_at) AS done_at
+		FROM card_column_stays s JOIN columns col ON col.id = s.column_id
+		WHERE s.board_id = $1 AND col.done
+		GROUP BY s.card_id
+	)
+	SELECT d.card_id, c.assignee_id, d.done_at
+	FROM done d LEFT JOIN cards c ON c.id = d.card_id
+	WHERE d.done_at >= $2 AND d.done_at < $3
+	ORDER BY d.done_at, d.card_id
+	`
+
+	var repoCards []struct {
+		repository.CompletedCard
+		DoneAt timeValue `db:"done_at"`
+	}
+	err := conn(ctx, r.db).SelectContext(ctx, &repoCards, query, boardID, from, to)
+
+	if err != nil {
+		return nil, err
+	}
+
+	cards := make([]entity.CompletedCard, len(repoCards))
+	for i, c := range repoCards {
+		c.CompletedCard.DoneAt = c.DoneAt.Time
+		cards[i] = repository.CompletedCardToEntity(c.CompletedCard)
+	}
+
+	return cards, nil
+}
+
+func (r *SQLXBoardStatsRepository) GetStaleCards(ctx context.Context, boardID uuid.UUID, before time.Time, limit int) ([]entity.StaleCard, error) {
+	query := `
+	SELECT c.id AS card_id, col.id AS column_id, col.title AS column_title, c.title, c.assignee_id, c.updated_at
+	FROM cards c JOIN columns col ON col.id = c.column_id
+	WHERE col.board_id = $1 AND NOT col.done AND c.archived_at IS NULL AND c.updated_at < $2
+	ORDER BY c.updated_at, c.id
+	LIMIT $3
+	`
+
+	var repoCards []repository.StaleCard
+	err := conn(ctx, r.db).SelectContext(ctx, &repoCards, query, boardID, before, limit)
+
+	if err != nil {
+		return nil, err
+	}
+
+	cards := make([]entity.StaleCard, len(repoCards))
+	for i, c := range repoCards {
+		cards[i] = repository.StaleCardToEntity(c)
+	}
+
+	return cards, nil
+}
@@ -0,0 +1,87 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"todo/internal/entity"
+	"todo/internal/repository"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+)
+
+type SQLXCalendarRepository struct {
+	db *sqlx.DB
+}
+
+func NewSQLXCalendarRepository(db *sqlx.DB) *SQLXCalendarRepository {
+	return &SQLXCalendarRepository{db: db}
+}
+
+func (r *SQLXCalendarRepository) SetCalendarToken(ctx context.Context, token *entity.CalendarToken) error {
+	query := `
+	INSERT INTO calendar_tokens (user_id, token_hash, created_at)
+	VALUES (:user_id, :token_hash, :created_at)
+	ON CONFLICT (user_id) DO UPDATE SET token_hash = EXCLUDED.token_hash, created_at = EXCLUDED.created_at
+	`
+
+	_, err := conn(ctx, r.db).NamedExecContext(ctx, query, repository.RepoCalendarToken(*token))
+
+	return err
+}
+
+func (r *SQLXCalendarRepository) GetCalendarTokenByHash(ctx context.Context, tokenHash string) (*entity.CalendarToken, error) {
+	query := `SELECT * FROM calendar_tokens WHERE token_hash = $1`
+
+	var repoToken repository.CalendarToken
+	err := conn(ctx, r.db).GetContext(ctx, &repoToken, query, tokenHash)
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, repository.ErrCalendarTokenNotFound
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	token := repository.CalendarTokenToEntity(repoToken)
+
+	return &token, nil
+}
+
+func (r *SQLXCalendarRepository) DeleteCalendarToken(ctx context.Context, userID uuid.UUID) error {
+	query := `DELETE FROM calendar_tokens WHERE user_id = $1`
+
+	_, err := conn(ctx, r.db).ExecContext(ctx, query, userID)
+
+	return err
+}
+
+func (r *SQLXCalendarRepository) GetCalendarCards(ctx context.Context, userID uuid.UUID) ([]entity.CalendarCard, error) {
+	query := `
+	SELECT c.id AS card_id, b.id AS board_id, b.title AS board_title, c.title,
+		COALESCE(c.description, '') AS description, c.due_date, col.done,
+		c.version, c.created_at, c.updated_at
+	FROM cards c
+	JOIN columns col ON col.id = c.column_id
+	JOIN boards b ON b.id = col.board_id
+	JOIN workspace_members m ON m.workspace_id = b.workspace_id AND m.user_id = $1
+	WHERE c.due_date IS NOT NULL AND c.archived_at IS NULL
+	ORDER BY c.due_date, c.id
+	`
+
+	var repoCards []repository.CalendarCard
+	err := conn(ctx, r.db).SelectContext(ctx, &repoCards, query, userID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	cards := make([]entity.CalendarCard, len(repoCards))
+	for i, c := range repoCards {
+		cards[i] = repository.CalendarCardToEntity(c)
+	}
+
+	return cards, nil
+}
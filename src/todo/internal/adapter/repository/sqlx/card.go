@@ -20,55 +20,145 @@ func NewSQLXCardRepository(db *sqlx.DB) *SQLXCardRepository {
 
 func (r *SQLXCardRepository) CreateCard(ctx context.Context, card *entity.Card) error {
 	query := `
-	INSERT INTO cards (id, column_id, user_id, title, description, position, created_at, updated_at)
-	VALUES (:id, :column_id, :user_id, :title, :description, :position, :created_at, :updated_at)
+	INSERT INTO cards (id, column_id, swimlane_id, parent_id, user_id, title, description, position, priority, assignee_id, due_date, version, created_at, updated_at)
+	VALUES (:id, :column_id, COALESCE(:swimlane_id, (` + firstSwimlaneOfColumn + `)), :parent_id, :user_id, :title, :description, :position, :priority, :assignee_id, :due_date, :version, :created_at, :updated_at)
 	`
 
 	repoCard := repository.RepoCard(*card)
 
-	_, err := r.db.NamedExecContext(ctx, query, repoCard)
+	return inTx(ctx, r.db, func(tx *sqlx.Tx) error {
+		if _, err := tx.NamedExecContext(ctx, query, repoCard); err != nil {
+			return err
+		}
 
-	return err
+		if card.SwimlaneID == uuid.Nil {
+			err := tx.GetContext(ctx, &card.SwimlaneID, `SELECT swimlane_id FROM cards WHERE id = $1`, card.ID)
+			if err != nil {
+				return err
+			}
+		}
+
+		if err := syncColumnStays(ctx, tx, card.CreatedAt, card.ID); err != nil {
+			return err
+		}
+
+		return replaceCardLabels(ctx, tx, card.ID, card.Labels)
+	})
 }
 
+// firstSwimlaneOfColumn selects the first swimlane of the board of the
+// column named :column_id.
+const firstSwimlaneOfColumn = `
+	SELECT s.id FROM swimlanes s JOIN columns col ON col.board_id = s.board_id
+	WHERE col.id = :column_id
+	ORDER BY s.position, s.id
+	LIMIT 1`
+
+// movedSwimlane is the swimlane of a card being moved to the column $1,
+// unless $2 names one: its current swimlane if that is on the board of the
+// column, or else the first swimlane there.
+const movedSwimlane = `COALESCE($2, (
+	SELECT s.id FROM swimlanes s JOIN columns col ON col.board_id = s.board_id
+	WHERE col.id = COALESCE($1, cards.column_id) AND s.id = cards.swimlane_id
+	), (
+	SELECT s.id FROM swimlanes s JOIN columns col ON col.board_id = s.board_id
+	WHERE col.id = COALESCE($1, cards.column_id)
+	ORDER BY s.position, s.id
+	LIMIT 1))`
+
 func (r *SQLXCardRepository) GetCardByID(ctx context.Context, id uuid.UUID) (*entity.Card, error) {
 	query := `
 	SELECT * FROM cards WHERE id = $1
 	`
 
 	var repoCard repository.Card
-	err := r.db.GetContext(ctx, &repoCard, query, id)
+	err := conn(ctx, r.db).GetContext(ctx, &repoCard, query, id)
 
 	if err != nil {
 		return nil, err
 	}
 
-	card := repository.CardToEntity(repoCard)
+	cards, err := r.withLabels(ctx, []repository.Card{repoCard})
+	if err != nil {
+		return nil, err
+	}
 
-	return &card, nil
+	return &cards[0], nil
 }
 
-func (r *SQLXCardRepository) GetCardsByColumn(ctx context.Context, columnID uuid.UUID, limit, offset int) ([]entity.Card, error) {
+func (r *SQLXCardRepository) GetCardAncestors(ctx context.Context, id uuid.UUID) ([]entity.Card, error) {
 	query := `
-	SELECT * FROM cards WHERE column_id = $1
-	ORDER BY created_at ASC
-	LIMIT $2
-	OFFSET $3
+	WITH RECURSIVE ancestors AS (
+		SELECT parent_id AS id, 1 AS depth FROM cards
+		WHERE id = $1 AND parent_id IS NOT NULL
+		UNION ALL
+		SELECT c.parent_id, a.depth + 1 FROM ancestors a JOIN cards c ON c.id = a.id
+		WHERE c.parent_id IS NOT NULL AND a.depth < $2
+	)
+	SELECT c.* FROM ancestors a JOIN cards c ON c.id = a.id ORDER BY a.depth
 	`
 
 	var repoCards []repository.Card
-	err := r.db.SelectContext(ctx, &repoCards, query, columnID, limit, offset)
+	err := conn(ctx, r.db).SelectContext(ctx, &repoCards, query, id, repository.MaxCardDepth)
 
 	if err != nil {
 		return nil, err
 	}
 
-	cards := make([]entity.Card, len(repoCards))
-	for i, c := range repoCards {
-		cards[i] = repository.CardToEntity(c)
+	return r.withLabels(ctx, repoCards)
+}
+
+func (r *SQLXCardRepository) SetCardParent(ctx context.Context, card *entity.Card) error {
+	query := `
+    UPDATE cards SET
+	parent_id = $1,
+	version = version + 1,
+	updated_at = $2
+    WHERE id = $3 AND version = $4
+    `
+
+	parent := uuid.NullUUID{UUID: card.ParentID, Valid: card.ParentID != uuid.Nil}
+
+	err := versioned(conn(ctx, r.db).ExecContext(ctx, query, parent, card.UpdatedAt, card.ID, card.Version))
+	if err != nil {
+		return err
 	}
 
-	return cards, nil
+	card.Version++
+
+	return nil
+}
+
+func (r *SQLXCardRepository) GetCardsByColumn(ctx context.Context, columnID uuid.UUID, page repository.Page) ([]entity.Card, error) {
+	query := `
+	SELECT * FROM cards WHERE column_id = $1 AND archived_at IS NULL
+	ORDER BY created_at ASC, id ASC
+	LIMIT $2
+	`
+	args := []interface{}{columnID, page.Limit}
+
+	if page.After != nil {
+		if page.After.Time == nil {
+			return nil, repository.ErrInvalidCursor
+		}
+
+		query = `
+		SELECT * FROM cards WHERE column_id = $1 AND archived_at IS NULL
+		AND (created_at > $3 OR (created_at = $3 AND id > $4))
+		ORDER BY created_at ASC, id ASC
+		LIMIT $2
+		`
+		args = append(args, *page.After.Time, page.After.ID)
+	}
+
+	var repoCards []repository.Card
+	err := conn(ctx, r.db).SelectContext(ctx, &repoCards, query, args...)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return r.withLabels(ctx, repoCards)
 }
 
 func (r *SQLXCardRepository) UpdateCard(ctx context.Context, card *entity.Card) error {
@@ -77,59 +167,221 @@ func (r *SQLXCardRepository) UpdateCard(ctx context.Context, card *entity.Card)
 	title = :title,
 	description = :description,
 	position = :position,
+	priority = :priority,
+	assignee_id = :assignee_id,
+	due_date = :due_date,
+	version = version + 1,
 	updated_at = :updated_at
-    WHERE id = :id
+    WHERE id = :id AND version = :version
     `
 
 	repoCard := repository.RepoCard(*card)
 
-	_, err := r.db.NamedExecContext(ctx, query, repoCard)
+	err := inTx(ctx, r.db, func(tx *sqlx.Tx) error {
+		if err := versioned(tx.NamedExecContext(ctx, query, repoCard)); err != nil {
+			return err
+		}
+
+		return replaceCardLabels(ctx, tx, card.ID, card.Labels)
+	})
+	if err != nil {
+		return err
+	}
+
+	card.Version++
 
-	return err
+	return nil
 }
 
+// MoveCard moves the card to its column, its swimlane or both; the one left
+// unset is kept where possible.
 func (r *SQLXCardRepository) MoveCard(ctx context.Context, card *entity.Card) error {
 	query := `
     UPDATE cards SET
-	column_id = :column_id,
-	updated_at = :updated_at
-    WHERE id = :id
+	swimlane_id = ` + movedSwimlane + `,
+	column_id = COALESCE($1, column_id),
+	version = version + 1,
+	updated_at = $3
+    WHERE id = $4 AND version = $5
     `
 
-	repoCard := repository.RepoCard(*card)
+	column := uuid.NullUUID{UUID: card.ColumnID, Valid: card.ColumnID != uuid.Nil}
+	swimlane := uuid.NullUUID{UUID: card.SwimlaneID, Valid: card.SwimlaneID != uuid.Nil}
+
+	err := inTx(ctx, r.db, func(tx *sqlx.Tx) error {
+		if err := versioned(tx.ExecContext(ctx, query, column, swimlane, card.UpdatedAt, card.ID, card.Version)); err != nil {
+			return err
+		}
+
+		return syncColumnStays(ctx, tx, card.UpdatedAt, card.ID)
+	})
+	if err != nil {
+		return err
+	}
 
-	_, err := r.db.NamedExecContext(ctx, query, repoCard)
+	card.Version++
 
-	return err
+	return nil
 }
 
-func (r *SQLXCardRepository) DeleteCard(ctx context.Context, id uuid.UUID) error {
+func (r *SQLXCardRepository) DeleteCard(ctx context.Context, id uuid.UUID, version int) error {
 	query := `
-	DELETE FROM cards WHERE id = $1
+	DELETE FROM cards WHERE id = $1 AND version = $2
 	`
 
-	_, err := r.db.ExecContext(ctx, query, id)
+	return inTx(ctx, r.db, func(tx *sqlx.Tx) error {
+		if err := versioned(tx.ExecContext(ctx, query, id, version)); err != nil {
+			return err
+		}
 
-	return err
+		return syncColumnStays(ctx, tx, time.Now(), id)
+	})
 }
 
-func (r *SQLXCardRepository) GetNewCards(ctx context.Context, from, to time.Time) ([]entity.Card, error) {
+func (r *SQLXCardRepository) GetNewCards(ctx context.Context, from, to time.Time, page repository.Page) ([]entity.Card, error) {
 	query := `
 	SELECT * FROM cards
 	WHERE $1 <= created_at AND created_at <= $2
+	ORDER BY created_at ASC, id ASC
+	LIMIT $3
 	`
+	args := []interface{}{from, to, page.Limit}
+
+	if page.After != nil {
+		if page.After.Time == nil {
+			return nil, repository.ErrInvalidCursor
+		}
+
+		query = `
+		SELECT * FROM cards
+		WHERE $1 <= created_at AND created_at <= $2
+		AND (created_at > $4 OR (created_at = $4 AND id > $5))
+		ORDER BY created_at ASC, id ASC
+		LIMIT $3
+		`
+		args = append(args, *page.After.Time, page.After.ID)
+	}
 
 	var repoCards []repository.Card
-	err := r.db.SelectContext(ctx, &repoCards, query, from, to)
+	err := conn(ctx, r.db).SelectContext(ctx, &repoCards, query, args...)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return r.withLabels(ctx, repoCards)
+}
 
+func (r *SQLXCardRepository) GetCards(ctx context.Context, q repository.CardQuery) ([]entity.Card, error) {
+	query, args, err := buildCardQuery(q)
 	if err != nil {
 		return nil, err
 	}
 
+	var repoCards []repository.Card
+	err = conn(ctx, r.db).SelectContext(ctx, &repoCards, r.db.Rebind(query), args...)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return r.withLabels(ctx, repoCards)
+}
+
+// withLabels loads the labels and the rollups of all given cards with a
+// query each and converts them to entities.
+func (r *SQLXCardRepository) withLabels(ctx context.Context, repoCards []repository.Card) ([]entity.Card, error) {
 	cards := make([]entity.Card, len(repoCards))
+	if len(repoCards) == 0 {
+		return cards, nil
+	}
+
+	ids := make([]uuid.UUID, len(repoCards))
 	for i, c := range repoCards {
+		ids[i] = c.ID
+	}
+
+	query, args, err := sqlx.In(`
+	SELECT card_id, label FROM card_labels WHERE card_id IN (?) ORDER BY label
+	`, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	var rows []struct {
+		CardID uuid.UUID `db:"card_id"`
+		Label  string    `db:"label"`
+	}
+	err = conn(ctx, r.db).SelectContext(ctx, &rows, r.db.Rebind(query), args...)
+	if err != nil {
+		return nil, err
+	}
+
+	labels := make(map[uuid.UUID][]string)
+	for _, row := range rows {
+		labels[row.CardID] = append(labels[row.CardID], row.Label)
+	}
+
+	rollups, err := r.getRollups(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, c := range repoCards {
+		c.Labels = labels[c.ID]
+		c.ChildCount = rollups[c.ID].Children
+		c.DoneChildCount = rollups[c.ID].DoneChildren
 		cards[i] = repository.CardToEntity(c)
 	}
 
 	return cards, nil
 }
+
+type cardRollup struct {
+	ParentID     uuid.UUID `db:"parent_id"`
+	Children     int       `db:"children"`
+	DoneChildren int       `db:"done_children"`
+}
+
+// getRollups counts the active children of the given cards, and those of
+// them in done columns.
+func (r *SQLXCardRepository) getRollups(ctx context.Context, ids []uuid.UUID) (map[uuid.UUID]cardRollup, error) {
+	query, args, err := sqlx.In(`
+	SELECT c.parent_id, COUNT(*) AS children, COUNT(*) FILTER (WHERE col.done) AS done_children
+	FROM cards c JOIN columns col ON col.id = c.column_id
+	WHERE c.parent_id IN (?) AND c.archived_at IS NULL
+	GROUP BY c.parent_id
+	`, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	var rows []cardRollup
+	err = conn(ctx, r.db).SelectContext(ctx, &rows, r.db.Rebind(query), args...)
+	if err != nil {
+		return nil, err
+	}
+
+	rollups := make(map[uuid.UUID]cardRollup, len(rows))
+	for _, row := range rows {
+		rollups[row.ParentID] = row
+	}
+
+	return rollups, nil
+}
+
+func replaceCardLabels(ctx context.Context, tx *sqlx.Tx, cardID uuid.UUID, labels []string) error {
+	_, err := tx.ExecContext(ctx, `DELETE FROM card_labels WHERE card_id = $1`, cardID)
+	if err != nil {
+		return err
+	}
+
+	for _, label := range labels {
+		_, err = tx.ExecContext(ctx, `INSERT INTO card_labels (card_id, label) VALUES ($1, $2)`, cardID, label)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
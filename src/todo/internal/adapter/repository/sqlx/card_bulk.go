@@ -0,0 +1,189 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+	"todo/internal/repository"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+)
+
+func (r *SQLXCardRepository) ApplyCardBatch(ctx context.Context, batch repository.CardBatch) ([]repository.CardOpResult, error) {
+	results := make([]repository.CardOpResult, len(batch.Ops))
+
+	err := inTx(ctx, r.db, func(tx *sqlx.Tx) error {
+		// The batch may be part of a larger unit of work, so an all-or-nothing
+		// batch undoes only its own changes.
+		if _, err := tx.ExecContext(ctx, `SAVEPOINT card_batch`); err != nil {
+			return err
+		}
+
+		for i, op := range batch.Ops {
+			// A failed statement aborts the whole transaction in Postgres, so
+			// every operation runs under a savepoint it can be rolled back to.
+			if _, err := tx.ExecContext(ctx, `SAVEPOINT card_op`); err != nil {
+				return err
+			}
+
+			opErr := applyCardOp(ctx, tx, op, batch.At)
+
+			if opErr == nil {
+				results[i].Status = repository.CardOpApplied
+				if _, err := tx.ExecContext(ctx, `RELEASE SAVEPOINT card_op`); err != nil {
+					return err
+				}
+				continue
+			}
+
+			results[i] = repository.CardOpResult{Status: repository.CardOpFailed, Err: opErr}
+
+			if batch.AllOrNothing {
+				for j := range results {
+					if j != i {
+						results[j].Status = repository.CardOpRolledBack
+					}
+				}
+				_, err := tx.ExecContext(ctx, `ROLLBACK TO SAVEPOINT card_batch`)
+				return err
+			}
+
+			if _, err := tx.ExecContext(ctx, `ROLLBACK TO SAVEPOINT card_op`); err != nil {
+				return err
+			}
+		}
+
+		_, err := tx.ExecContext(ctx, `RELEASE SAVEPOINT card_batch`)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+func applyCardOp(ctx context.Context, tx *sqlx.Tx, op repository.CardOp, at time.Time) error {
+	switch op.Kind {
+	case repository.CardOpMove:
+		if err := touchCard(ctx, tx, op, `swimlane_id = `+bulkMovedSwimlane+`, column_id = $3, updated_at = $4`, op.ColumnID, at); err != nil {
+			return err
+		}
+		return syncColumnStays(ctx, tx, at, op.CardID)
+	case repository.CardOpArchive:
+		children, err := archiveChildren(ctx, tx, op, at)
+		if err != nil {
+			return err
+		}
+		if err := touchCard(ctx, tx, op, `archived_at = $3, updated_at = $3`, at); err != nil {
+			return err
+		}
+		return syncColumnStays(ctx, tx, at, append(children, op.CardID)...)
+	case repository.CardOpSetAssignee:
+		assignee := uuid.NullUUID{UUID: op.AssigneeID, Valid: op.AssigneeID != uuid.Nil}
+		return touchCard(ctx, tx, op, `assignee_id = $3, updated_at = $4`, assignee, at)
+	case repository.CardOpSetLabel:
+		if err := touchCard(ctx, tx, op, `updated_at = $3`, at); err != nil {
+			return err
+		}
+		_, err := tx.ExecContext(ctx, `
+		INSERT INTO card_labels (card_id, label) VALUES ($1, $2)
+		ON CONFLICT DO NOTHING
+		`, op.CardID, op.Label)
+		return err
+	case repository.CardOpDelete:
+		res, err := tx.ExecContext(ctx, `
+		DELETE FROM cards WHERE id = $1 AND version = $2
+		`, op.CardID, op.Version)
+		if err := cardOpAffected(ctx, tx, res, err, op); err != nil {
+			return err
+		}
+		return syncColumnStays(ctx, tx, at, op.CardID)
+	default:
+		return fmt.Errorf("unknown card operation %q", op.Kind)
+	}
+}
+
+// archiveChildren archives the active descendants of a card being archived
+// if the operation cascades, and refuses to leave the question open when the
+// card has any. It returns the ids of the cards it archived.
+func archiveChildren(ctx context.Context, tx *sqlx.Tx, op repository.CardOp, at time.Time) ([]uuid.UUID, error) {
+	if op.Cascade == nil {
+		var hasChildren bool
+		err := tx.GetContext(ctx, &hasChildren, `
+		SELECT EXISTS (SELECT 1 FROM cards WHERE parent_id = $1 AND archived_at IS NULL)
+		`, op.CardID)
+		if err != nil {
+			return nil, err
+		}
+		if hasChildren {
+			return nil, repository.ErrCardHasChildren
+		}
+		return nil, nil
+	}
+
+	if !*op.Cascade {
+		return nil, nil
+	}
+
+	var archived []uuid.UUID
+	err := tx.SelectContext(ctx, &archived, `
+	WITH RECURSIVE descendants AS (
+		SELECT id, 1 AS depth FROM cards WHERE parent_id = $1
+		UNION ALL
+		SELECT c.id, d.depth + 1 FROM descendants d JOIN cards c ON c.parent_id = d.id
+		WHERE d.depth < $3
+	)
+	UPDATE cards SET archived_at = $2, updated_at = $2, version = version + 1
+	WHERE id IN (SELECT id FROM descendants) AND archived_at IS NULL
+	RETURNING id
+	`, op.CardID, at, repository.MaxCardDepth)
+
+	return archived, err
+}
+
+// touchCard updates a card with the given SET clause, whose bindvars start
+// at $3, and bumps its version.
+func touchCard(ctx context.Context, tx *sqlx.Tx, op repository.CardOp, set string, args ...interface{}) error {
+	query := `
+	UPDATE cards SET ` + set + `, version = version + 1
+	WHERE id = $1 AND version = $2
+	`
+
+	res, err := tx.ExecContext(ctx, query, append([]interface{}{op.CardID, op.Version}, args...)...)
+
+	return cardOpAffected(ctx, tx, res, err, op)
+}
+
+// cardOpAffected tells a missing card from a stale version when an operation
+// touched no rows.
+func cardOpAffected(ctx context.Context, tx *sqlx.Tx, res sql.Result, err error, op repository.CardOp) error {
+	err = versioned(res, err)
+	if !errors.Is(err, repository.ErrVersionMismatch) {
+		return err
+	}
+
+	var exists bool
+	if err := tx.GetContext(ctx, &exists, `SELECT EXISTS (SELECT 1 FROM cards WHERE id = $1)`, op.CardID); err != nil {
+		return err
+	}
+	if !exists {
+		return repository.ErrCardNotFound
+	}
+
+	return repository.ErrVersionMismatch
+}
+
+// bulkMovedSwimlane keeps a card moved to the column $3 in its swimlane if
+// that is on the board of the column, or else puts it in the first one there.
+const bulkMovedSwimlane = `COALESCE((
+	SELECT s.id FROM swimlanes s JOIN columns col ON col.board_id = s.board_id
+	WHERE col.id = $3 AND s.id = cards.swimlane_id
+	), (
+	SELECT s.id FROM swimlanes s JOIN columns col ON col.board_id = s.board_id
+	WHERE col.id = $3
+	ORDER BY s.position, s.id
+	LIMIT 1))`
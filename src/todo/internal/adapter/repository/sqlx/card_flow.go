@@ -0,0 +1,152 @@
+package repository
+
+import (
+	"context"
+	"time"
+	"todo/internal/entity"
+	"todo/internal/repository"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+)
+
+type SQLXCardFlowRepository struct {
+	db *sqlx.DB
+}
+
+func NewSQLXCardFlowRepository(db *sqlx.DB) *SQLXCardFlowRepository {
+	return &SQLXCardFlowRepository{db: db}
+}
+
+// syncColumnStays brings the column history of the given cards in step with
+// them at the time at: the open stay of a card that has left its column, was
+// archived or deleted is closed, and an active card without an open stay
+// gets one in its column. Card writes call it in their transaction.
+func syncColumnStays(ctx context.Context, tx *sqlx.Tx, at time.Time, cardIDs ...uuid.UUID) error {
+	if len(cardIDs) == 0 {
+		return nil
+	}
+
+	query, args, err := sqlx.In(`
+	UPDATE card_column_stays AS s SET left_at = ?
+	WHERE s.card_id IN (?) AND s.left_at IS NULL AND NOT EXISTS (
+		SELECT 1 FROM cards c
+		WHERE c.id = s.card_id AND c.column_id = s.column_id AND c.archived_at IS NULL
+	)
+	`, at, cardIDs)
+	if err != nil {
+		return err
+	}
+
+	if _, err := tx.ExecContext(ctx, tx.Rebind(query), args...); err != nil {
+		return err
+	}
+
+	query, args, err = sqlx.In(`
+	INSERT INTO card_column_stays (card_id, board_id, column_id, entered_at)
+	SELECT c.id, col.board_id, c.column_id, ?
+	FROM cards c JOIN columns col ON col.id = c.column_id
+	WHERE c.id IN (?) AND c.archived_at IS NULL AND NOT EXISTS (
+		SELECT 1 FROM card_column_stays s WHERE s.card_id = c.id AND s.left_at IS NULL
+	)
+	`, at, cardIDs)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, tx.Rebind(query), args...)
+
+	return err
+}
+
+func (r *SQLXCardFlowRepository) GetCardFlows(ctx context.Context, boardID uuid.UUID, from, to time.Time) ([]entity.CardFlow, error) {
+	query := `
+	WITH done AS (
+		SELECT s.card_id, MIN(s.entered_at) AS done_at
+		FROM card_column_stays s JOIN columns col ON col.id = s.column_id
+		WHERE s.board_id = $1 AND col.done
+		GROUP BY s.card_id
+	)
+	SELECT d.card_id, d.done_at,
+		(SELECT MIN(s.entered_at) FROM card_column_stays s
+		WHERE s.card_id = d.card_id) AS created_at,
+		(SELECT s.entered_at FROM card_column_stays s
+		WHERE s.card_id = d.card_id ORDER BY s.entered_at, s.id LIMIT 1 OFFSET 1) AS started_at
+	FROM done d
+	WHERE d.done_at >= $2 AND d.done_at < $3
+	ORDER BY d.done_at, d.card_id
+	`
+
+	// The times are computed, which SQLite hands back as text.
+	var repoFlows []struct {
+		repository.CardFlow
+		CreatedAt timeValue  `db:"created_at"`
+		StartedAt *timeValue `db:"started_at"`
+		DoneAt    timeValue  `db:"done_at"`
+	}
+	err := conn(ctx, r.db).SelectContext(ctx, &repoFlows, query, boardID, from, to)
+
+	if err != nil {
+		return nil, err
+	}
+
+	flows := make([]entity.CardFlow, len(repoFlows))
+	for i, f := range repoFlows {
+		f.CardFlow.CreatedAt, f.CardFlow.DoneAt = f.CreatedAt.Time, f.DoneAt.Time
+		if f.StartedAt != nil {
+			f.CardFlow.StartedAt = &f.StartedAt.Time
+		}
+		flows[i] = repository.CardFlowToEntity(f.CardFlow)
+	}
+
+	return flows, nil
+}
+
+func (r *SQLXCardFlowRepository) GetColumnCounts(ctx context.Context, boardID uuid.UUID, from, to time.Time) ([]entity.ColumnCount, error) {
+	query := `
+	SELECT d.day, col.id AS column_id, col.title AS column_title, COUNT(s.id) AS count
+	FROM generate_series($2::timestamp, $3::timestamp - interval '1 day', interval '1 day') AS d(day)
+	CROSS JOIN columns col
+	LEFT JOIN card_column_stays s ON s.column_id = col.id
+		AND s.entered_at < d.day + interval '1 day'
+		AND (s.left_at IS NULL OR s.left_at >= d.day + interval '1 day')
+	WHERE col.board_id = $1
+	GROUP BY d.day, col.id, col.title, col.position
+	ORDER BY d.day, col.position, col.id
+	`
+	args := []interface{}{boardID, from, to}
+
+	if isSQLite(r.db) {
+		days, dayArgs := sqliteDays(from, to)
+		query = `
+		WITH d (day, next_day) AS (` + days + `)
+		SELECT d.day, col.id AS column_id, col.title AS column_title, COUNT(s.id) AS count
+		FROM d CROSS JOIN columns col
+		LEFT JOIN card_column_stays s ON s.column_id = col.id
+			AND s.entered_at < d.next_day
+			AND (s.left_at IS NULL OR s.left_at >= d.next_day)
+		WHERE col.board_id = ?
+		GROUP BY d.day, col.id, col.title, col.position
+		ORDER BY d.day, col.position, col.id
+		`
+		args = append(dayArgs, boardID)
+	}
+
+	var repoCounts []struct {
+		repository.ColumnCount
+		Day timeValue `db:"day"`
+	}
+	err := conn(ctx, r.db).SelectContext(ctx, &repoCounts, query, args...)
+
+	if err != nil {
+		return nil, err
+	}
+
+	counts := make([]entity.ColumnCount, len(repoCounts))
+	for i, c := range repoCounts {
+		c.ColumnCount.Day = c.Day.Time
+		counts[i] = repository.ColumnCountToEntity(c.ColumnCount)
+	}
+
+	return counts, nil
+}
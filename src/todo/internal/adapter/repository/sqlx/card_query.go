@@ -0,0 +1,172 @@
+package repository
+
+import (
+	"fmt"
+	"strings"
+	"todo/internal/repository"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// cardSortColumns whitelists the columns a card listing may be ordered by,
+// so that no caller-provided text ever reaches the ORDER BY clause.
+var cardSortColumns = map[repository.CardSortField]string{
+	repository.SortByPosition: "c.position",
+	repository.SortByPriority: "c.priority",
+	repository.SortByCreated:  "c.created_at",
+	repository.SortByUpdated:  "c.updated_at",
+	repository.SortByDue:      "c.due_date",
+}
+
+// cardFilter collects WHERE conditions. Conditions are constant SQL
+// fragments with ? bindvars; values always travel separately as args.
+type cardFilter struct {
+	conds []string
+	args  []interface{}
+}
+
+func (f *cardFilter) add(cond string, args ...interface{}) {
+	f.conds = append(f.conds, cond)
+	f.args = append(f.args, args...)
+}
+
+// buildCardQuery renders q into a query with ? bindvars, to be rebound for
+// the driver in use.
+func buildCardQuery(q repository.CardQuery) (string, []interface{}, error) {
+	f := &cardFilter{}
+
+	if q.BoardID != nil {
+		f.add("c.column_id IN (SELECT id FROM columns WHERE board_id = ?)", *q.BoardID)
+	}
+	if q.ColumnID != nil {
+		f.add("c.column_id = ?", *q.ColumnID)
+	}
+	if q.SwimlaneID != nil {
+		f.add("c.swimlane_id = ?", *q.SwimlaneID)
+	}
+	if q.ParentID != nil {
+		f.add("c.parent_id = ?", *q.ParentID)
+	}
+	if len(q.Priorities) > 0 {
+		f.add("c.priority IN (?)", q.Priorities)
+	}
+	if q.UserID != nil {
+		f.add("c.user_id = ?", *q.UserID)
+	}
+	if q.AssigneeID != nil {
+		f.add("c.assignee_id = ?", *q.AssigneeID)
+	}
+	if q.Label != nil {
+		f.add("EXISTS (SELECT 1 FROM card_labels l WHERE l.card_id = c.id AND l.label = ?)", *q.Label)
+	}
+	if q.Text != nil {
+		pattern := "%" + escapeLike(strings.ToLower(*q.Text)) + "%"
+		f.add(`(LOWER(c.title) LIKE ? ESCAPE '\' OR LOWER(COALESCE(c.description, '')) LIKE ? ESCAPE '\')`, pattern, pattern)
+	}
+	if q.CreatedFrom != nil {
+		f.add("c.created_at >= ?", *q.CreatedFrom)
+	}
+	if q.CreatedTo != nil {
+		f.add("c.created_at < ?", *q.CreatedTo)
+	}
+	if q.UpdatedFrom != nil {
+		f.add("c.updated_at >= ?", *q.UpdatedFrom)
+	}
+	if q.UpdatedTo != nil {
+		f.add("c.updated_at < ?", *q.UpdatedTo)
+	}
+	if q.DueFrom != nil {
+		f.add("c.due_date >= ?", *q.DueFrom)
+	}
+	if q.DueTo != nil {
+		f.add("c.due_date < ?", *q.DueTo)
+	}
+	if q.Archived {
+		f.add("c.archived_at IS NOT NULL")
+	} else {
+		f.add("c.archived_at IS NULL")
+	}
+
+	sortBy := q.SortBy
+	if sortBy == "" {
+		sortBy = repository.SortByPosition
+	}
+	column, ok := cardSortColumns[sortBy]
+	if !ok {
+		return "", nil, fmt.Errorf("unknown card sort field %q", sortBy)
+	}
+
+	direction := "ASC"
+	if q.Descending {
+		direction = "DESC"
+	}
+
+	if q.Page.After != nil {
+		cond, args, err := cardKeyset(q.Page.After, sortBy, column, q.Descending)
+		if err != nil {
+			return "", nil, err
+		}
+		f.add(cond, args...)
+	}
+
+	// Cards without a due date go last in both directions; the id keeps the
+	// order stable between equal keys.
+	order := []string{column + " " + direction, "c.id " + direction}
+	if sortBy == repository.SortByDue {
+		order = append([]string{"(c.due_date IS NULL)"}, order...)
+	}
+
+	var sb strings.Builder
+	sb.WriteString("SELECT c.* FROM cards c")
+	if len(f.conds) > 0 {
+		sb.WriteString(" WHERE ")
+		sb.WriteString(strings.Join(f.conds, " AND "))
+	}
+	sb.WriteString(" ORDER BY ")
+	sb.WriteString(strings.Join(order, ", "))
+
+	args := f.args
+	if q.Page.Limit > 0 {
+		sb.WriteString(" LIMIT ?")
+		args = append(args, q.Page.Limit)
+	}
+
+	return sqlx.In(sb.String(), args...)
+}
+
+// cardKeyset renders the condition selecting the cards that follow the
+// cursor in the given order. It mirrors the ORDER BY of buildCardQuery,
+// including due dates sorting NULLs last in both directions.
+func cardKeyset(after *repository.Cursor, sortBy repository.CardSortField, column string, desc bool) (string, []interface{}, error) {
+	op := ">"
+	if desc {
+		op = "<"
+	}
+
+	var key interface{}
+	switch sortBy {
+	case repository.SortByPosition, repository.SortByPriority:
+		if after.Number == nil {
+			return "", nil, repository.ErrInvalidCursor
+		}
+		key = *after.Number
+	case repository.SortByDue:
+		if after.Time == nil {
+			return fmt.Sprintf("(%[1]s IS NULL AND c.id %[2]s ?)", column, op), []interface{}{after.ID}, nil
+		}
+		cond := fmt.Sprintf("(%[1]s %[2]s ? OR (%[1]s = ? AND c.id %[2]s ?) OR %[1]s IS NULL)", column, op)
+		return cond, []interface{}{*after.Time, *after.Time, after.ID}, nil
+	default:
+		if after.Time == nil {
+			return "", nil, repository.ErrInvalidCursor
+		}
+		key = *after.Time
+	}
+
+	cond := fmt.Sprintf("(%[1]s %[2]s ? OR (%[1]s = ? AND c.id %[2]s ?))", column, op)
+	return cond, []interface{}{key, key, after.ID}, nil
+}
+
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(s)
+}
@@ -0,0 +1,134 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"todo/internal/entity"
+	"todo/internal/repository"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+)
+
+type SQLXCardTemplateRepository struct {
+	db *sqlx.DB
+}
+
+func NewSQLXCardTemplateRepository(db *sqlx.DB) *SQLXCardTemplateRepository {
+	return &SQLXCardTemplateRepository{db: db}
+}
+
+func (r *SQLXCardTemplateRepository) CreateCardTemplate(ctx context.Context, template *entity.CardTemplate) error {
+	query := `
+	INSERT INTO card_templates (id, board_id, name, title_pattern, description, labels, checklists, created_by, created_at, updated_at)
+	VALUES (:id, :board_id, :name, :title_pattern, :description, :labels, :checklists, :created_by, :created_at, :updated_at)
+	`
+
+	repoTemplate, err := repository.RepoCardTemplate(*template)
+	if err != nil {
+		return err
+	}
+
+	_, err = conn(ctx, r.db).NamedExecContext(ctx, query, repoTemplate)
+
+	if uniqueViolation(err, "") {
+		return repository.ErrCardTemplateExists
+	}
+
+	return err
+}
+
+func (r *SQLXCardTemplateRepository) GetCardTemplateByID(ctx context.Context, id uuid.UUID) (*entity.CardTemplate, error) {
+	query := `SELECT * FROM card_templates WHERE id = $1`
+
+	var repoTemplate repository.CardTemplate
+	err := conn(ctx, r.db).GetContext(ctx, &repoTemplate, query, id)
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, repository.ErrCardTemplateNotFound
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	template, err := repository.CardTemplateToEntity(repoTemplate)
+	if err != nil {
+		return nil, err
+	}
+
+	return &template, nil
+}
+
+func (r *SQLXCardTemplateRepository) GetCardTemplatesByBoard(ctx context.Context, boardID uuid.UUID) ([]entity.CardTemplate, error) {
+	query := `SELECT * FROM card_templates WHERE board_id = $1 ORDER BY name, id`
+
+	var repoTemplates []repository.CardTemplate
+	err := conn(ctx, r.db).SelectContext(ctx, &repoTemplates, query, boardID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	templates := make([]entity.CardTemplate, len(repoTemplates))
+	for i, t := range repoTemplates {
+		templates[i], err = repository.CardTemplateToEntity(t)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return templates, nil
+}
+
+func (r *SQLXCardTemplateRepository) UpdateCardTemplate(ctx context.Context, template *entity.CardTemplate) error {
+	query := `
+	UPDATE card_templates
+	SET name = :name, title_pattern = :title_pattern, description = :description,
+		labels = :labels, checklists = :checklists, updated_at = :updated_at
+	WHERE id = :id
+	`
+
+	repoTemplate, err := repository.RepoCardTemplate(*template)
+	if err != nil {
+		return err
+	}
+
+	res, err := conn(ctx, r.db).NamedExecContext(ctx, query, repoTemplate)
+
+	if uniqueViolation(err, "") {
+		return repository.ErrCardTemplateExists
+	}
+
+	if err != nil {
+		return err
+	}
+
+	return templateAffected(res)
+}
+
+func (r *SQLXCardTemplateRepository) DeleteCardTemplate(ctx context.Context, id uuid.UUID) error {
+	query := `DELETE FROM card_templates WHERE id = $1`
+
+	res, err := conn(ctx, r.db).ExecContext(ctx, query, id)
+	if err != nil {
+		return err
+	}
+
+	return templateAffected(res)
+}
+
+// templateAffected tells a write that found no template from one that did.
+func templateAffected(res sql.Result) error {
+	n, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if n == 0 {
+		return repository.ErrCardTemplateNotFound
+	}
+
+	return nil
+}
@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"time"
 	"todo/internal/entity"
 	"todo/internal/repository"
 
@@ -21,11 +22,11 @@ func (r *SQLXColumnRepository) CreateColumn(ctx context.Context, column *entity.
 	repoColumn := repository.RepoColumn(*column)
 
 	query := `
-	INSERT INTO columns (id, board_id, user_id, title, position, created_at, updated_at)
-	VALUES (:id, :board_id, :user_id, :title, :position, :created_at, :updated_at)
+	INSERT INTO columns (id, board_id, user_id, title, position, done, version, created_at, updated_at)
+	VALUES (:id, :board_id, :user_id, :title, :position, :done, :version, :created_at, :updated_at)
 	`
 
-	_, err := r.db.NamedExecContext(ctx, query, repoColumn)
+	_, err := conn(ctx, r.db).NamedExecContext(ctx, query, repoColumn)
 
 	return err
 }
@@ -36,7 +37,7 @@ func (r *SQLXColumnRepository) GetColumnByID(ctx context.Context, id uuid.UUID)
 	`
 
 	var repoColumn repository.Column
-	err := r.db.GetContext(ctx, &repoColumn, query, id)
+	err := conn(ctx, r.db).GetContext(ctx, &repoColumn, query, id)
 
 	if err != nil {
 		return nil, err
@@ -47,16 +48,30 @@ func (r *SQLXColumnRepository) GetColumnByID(ctx context.Context, id uuid.UUID)
 	return &column, nil
 }
 
-func (r *SQLXColumnRepository) GetColumnsByBoard(ctx context.Context, boardID uuid.UUID, limit, offset int) ([]entity.Column, error) {
+func (r *SQLXColumnRepository) GetColumnsByBoard(ctx context.Context, boardID uuid.UUID, page repository.Page) ([]entity.Column, error) {
 	query := `
 	SELECT * FROM columns WHERE board_id = $1
-	ORDER BY created_at ASC
+	ORDER BY created_at ASC, id ASC
 	LIMIT $2
-	OFFSET $3
 	`
+	args := []interface{}{boardID, page.Limit}
+
+	if page.After != nil {
+		if page.After.Time == nil {
+			return nil, repository.ErrInvalidCursor
+		}
+
+		query = `
+		SELECT * FROM columns WHERE board_id = $1
+		AND (created_at > $3 OR (created_at = $3 AND id > $4))
+		ORDER BY created_at ASC, id ASC
+		LIMIT $2
+		`
+		args = append(args, *page.After.Time, page.After.ID)
+	}
 
 	var repoColumns []repository.Column
-	err := r.db.SelectContext(ctx, &repoColumns, query, boardID, limit, offset)
+	err := conn(ctx, r.db).SelectContext(ctx, &repoColumns, query, args...)
 
 	if err != nil {
 		return nil, err
@@ -75,23 +90,177 @@ func (r *SQLXColumnRepository) UpdateColumn(ctx context.Context, column *entity.
     UPDATE columns SET
 	title = :title,
 	position = :position,
+	done = :done,
+	version = version + 1,
 	updated_at = :updated_at
-    WHERE id = :id
+    WHERE id = :id AND version = :version
     `
 
 	repoColumn := repository.RepoColumn(*column)
 
-	_, err := r.db.NamedExecContext(ctx, query, repoColumn)
+	err := versioned(conn(ctx, r.db).NamedExecContext(ctx, query, repoColumn))
+	if err != nil {
+		return err
+	}
 
-	return err
+	column.Version++
+
+	return nil
 }
 
-func (r *SQLXColumnRepository) DeleteColumn(ctx context.Context, id uuid.UUID) error {
+func (r *SQLXColumnRepository) MoveColumn(ctx context.Context, column *entity.Column) error {
 	query := `
-	DELETE FROM columns WHERE id = $1
+    UPDATE columns SET
+	board_id = $1,
+	title = $2,
+	position = $3,
+	version = version + 1,
+	updated_at = $4
+    WHERE id = $5 AND version = $6
+    `
+
+	cardsQuery := `
+	UPDATE cards SET
+	swimlane_id = (` + firstSwimlaneOfBoard + `),
+	version = version + 1,
+	updated_at = $2
+	WHERE column_id = $3
+	RETURNING id
 	`
 
-	_, err := r.db.ExecContext(ctx, query, id)
+	// The cards stay in their column, so their stays there are closed by
+	// hand for syncColumnStays to open new ones on the new board.
+	staysQuery := `
+	UPDATE card_column_stays SET left_at = ?
+	WHERE card_id IN (?) AND left_at IS NULL AND board_id <> ?
+	`
+
+	err := inTx(ctx, r.db, func(tx *sqlx.Tx) error {
+		err := versioned(tx.ExecContext(ctx, query, column.BoardID, column.Title, column.Position, column.UpdatedAt, column.ID, column.Version))
+		if err != nil {
+			return err
+		}
+
+		var cardIDs []uuid.UUID
+		if err := tx.SelectContext(ctx, &cardIDs, cardsQuery, column.BoardID, column.UpdatedAt, column.ID); err != nil {
+			return err
+		}
+
+		if len(cardIDs) > 0 {
+			query, args, err := sqlx.In(staysQuery, column.UpdatedAt, cardIDs, column.BoardID)
+			if err != nil {
+				return err
+			}
+
+			if _, err := tx.ExecContext(ctx, tx.Rebind(query), args...); err != nil {
+				return err
+			}
+		}
+
+		if err := syncColumnStays(ctx, tx, column.UpdatedAt, cardIDs...); err != nil {
+			return err
+		}
+
+		return leaveOpenSprints(ctx, tx, column.BoardID, cardIDs)
+	})
+	if err != nil {
+		return err
+	}
+
+	column.Version++
+
+	return nil
+}
+
+func (r *SQLXColumnRepository) MergeColumn(ctx context.Context, source *entity.Column, targetID uuid.UUID) error {
+	// The cards go after those of the target. Where they start is worked
+	// out first: a subquery of the update would see, in SQLite, the cards it
+	// has already moved.
+	startQuery := `
+	SELECT COALESCE(MAX(position) + 1, 0) FROM cards WHERE column_id = $1
+	`
+
+	cardsQuery := `
+	UPDATE cards SET
+	column_id = $4,
+	swimlane_id = (` + firstSwimlaneOfBoard + `),
+	position = CAST($5 AS REAL) + o.rank,
+	version = version + 1,
+	updated_at = $2
+	FROM (
+		SELECT id, ROW_NUMBER() OVER (ORDER BY position, id) - 1 AS rank
+		FROM cards WHERE column_id = $3
+	) AS o
+	WHERE cards.id = o.id
+	RETURNING cards.id
+	`
+
+	query := `
+	DELETE FROM columns WHERE id = $1 AND version = $2
+	`
+
+	now := time.Now()
+
+	return inTx(ctx, r.db, func(tx *sqlx.Tx) error {
+		var boardID uuid.UUID
+		if err := tx.GetContext(ctx, &boardID, `SELECT board_id FROM columns WHERE id = $1`, targetID); err != nil {
+			return err
+		}
+
+		var start float64
+		if err := tx.GetContext(ctx, &start, startQuery, targetID); err != nil {
+			return err
+		}
+
+		var cardIDs []uuid.UUID
+		if err := tx.SelectContext(ctx, &cardIDs, cardsQuery, boardID, now, source.ID, targetID, start); err != nil {
+			return err
+		}
+
+		if err := versioned(tx.ExecContext(ctx, query, source.ID, source.Version)); err != nil {
+			return err
+		}
+
+		if err := syncColumnStays(ctx, tx, now, cardIDs...); err != nil {
+			return err
+		}
+
+		return leaveOpenSprints(ctx, tx, boardID, cardIDs)
+	})
+}
+
+// firstSwimlaneOfBoard selects the first swimlane of the board $1.
+const firstSwimlaneOfBoard = `
+	SELECT s.id FROM swimlanes s WHERE s.board_id = $1
+	ORDER BY s.position, s.id
+	LIMIT 1`
+
+// leaveOpenSprints takes the cards, which have moved to the board boardID,
+// out of the open sprints of other boards. Closed sprints keep them for
+// their burndown.
+func leaveOpenSprints(ctx context.Context, tx *sqlx.Tx, boardID uuid.UUID, cardIDs []uuid.UUID) error {
+	if len(cardIDs) == 0 {
+		return nil
+	}
+
+	query, args, err := sqlx.In(`
+	DELETE FROM sprint_cards
+	WHERE sprint_id IN (SELECT id FROM sprints WHERE closed_at IS NULL AND board_id <> ?)
+	AND card_id IN (?)
+	`, boardID, cardIDs)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, tx.Rebind(query), args...)
 
 	return err
 }
+
+func (r *SQLXColumnRepository) DeleteColumn(ctx context.Context, id uuid.UUID, version int) error {
+	query := `
+	DELETE FROM columns WHERE id = $1 AND version = $2
+	`
+
+	return versioned(conn(ctx, r.db).ExecContext(ctx, query, id, version))
+}
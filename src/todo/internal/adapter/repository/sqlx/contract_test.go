@@ -0,0 +1,45 @@
+package repository_test
+
+import (
+	"path/filepath"
+	"testing"
+	"todo/internal/adapter/database"
+	repository "todo/internal/adapter/repository/sqlx"
+	"todo/internal/config"
+	"todo/internal/repository/repotest"
+
+	"github.com/stretchr/testify/require"
+)
+
+// The contract runs here on SQLite, which needs no server; the integration
+// tests run it on Postgres.
+func newRepos(t *testing.T) repotest.Repos {
+	db, err := database.NewSQLiteDB(config.SQLiteConfig{Path: filepath.Join(t.TempDir(), "todo.db")})
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+
+	return repotest.Repos{
+		Board:     repository.NewSQLXBoardRepository(db),
+		Column:    repository.NewSQLXColumnRepository(db),
+		Swimlane:  repository.NewSQLXSwimlaneRepository(db),
+		Card:      repository.NewSQLXCardRepository(db),
+		Workspace: repository.NewSQLXWorkspaceRepository(db),
+		Quota:     repository.NewSQLXQuotaRepository(db),
+	}
+}
+
+func TestBoardRepository(t *testing.T) {
+	repotest.TestBoardRepository(t, newRepos)
+}
+
+func TestColumnRepository(t *testing.T) {
+	repotest.TestColumnRepository(t, newRepos)
+}
+
+func TestCardRepository(t *testing.T) {
+	repotest.TestCardRepository(t, newRepos)
+}
+
+func TestQuotaRepository(t *testing.T) {
+	repotest.TestQuotaRepository(t, newRepos)
+}
@@ -0,0 +1,86 @@
+package repository
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"modernc.org/sqlite"
+	sqlite3 "modernc.org/sqlite/lib"
+)
+
+// The repositories run on Postgres and on SQLite alike. Most queries are
+// written in the SQL both understand; the few that need date arithmetic
+// SQLite lacks check which of the two they run on.
+const sqliteDriver = "sqlite"
+
+func isSQLite(db *sqlx.DB) bool {
+	return db.DriverName() == sqliteDriver
+}
+
+// uniqueViolation reports whether err is a write breaking the unique
+// constraint or index named constraint, or any of them if it is empty.
+// SQLite reports the columns and not the name, so any will do there.
+func uniqueViolation(err error, constraint string) bool {
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) {
+		return pqErr.Code == "23505" && (constraint == "" || pqErr.Constraint == constraint)
+	}
+
+	var sqliteErr *sqlite.Error
+	if errors.As(err, &sqliteErr) {
+		return sqliteErr.Code() == sqlite3.SQLITE_CONSTRAINT_UNIQUE || sqliteErr.Code() == sqlite3.SQLITE_CONSTRAINT_PRIMARYKEY
+	}
+
+	return false
+}
+
+// sqliteTimeFormats are the layouts of the times SQLite keeps: the one the
+// driver writes, and the one of CURRENT_TIMESTAMP.
+var sqliteTimeFormats = []string{"2006-01-02 15:04:05.999999999-07:00", "2006-01-02 15:04:05"}
+
+// timeValue scans a time the driver may hand back as text. SQLite does so
+// for columns a query computes, which have no declared type to tell it.
+type timeValue struct {
+	time.Time
+}
+
+func (t *timeValue) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case time.Time:
+		t.Time = v
+		return nil
+	case string:
+		for _, layout := range sqliteTimeFormats {
+			if parsed, err := time.Parse(layout, v); err == nil {
+				t.Time = parsed
+				return nil
+			}
+		}
+	}
+
+	return fmt.Errorf("cannot scan %T %v into a time", src, src)
+}
+
+// sqliteDays renders the days in [from, to) as a VALUES list of their
+// starts and ends, for SQLite to join on as Postgres does generate_series.
+// It returns the list with ? bindvars and its args.
+func sqliteDays(from, to time.Time) (string, []interface{}) {
+	var rows []string
+	var args []interface{}
+
+	for day := from; day.Before(to); day = day.AddDate(0, 0, 1) {
+		rows = append(rows, "(?, ?)")
+		args = append(args, day, day.AddDate(0, 0, 1))
+	}
+
+	if len(rows) == 0 {
+		// A VALUES list cannot be empty.
+		return "SELECT NULL, NULL WHERE FALSE", nil
+	}
+
+	return "VALUES " + strings.Join(rows, ", "), args
+}
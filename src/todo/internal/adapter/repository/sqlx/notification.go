@@ -0,0 +1,174 @@
+package repository
+
+import (
+	"context"
+	"time"
+	"todo/internal/entity"
+	"todo/internal/repository"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+)
+
+type SQLXNotificationRepository struct {
+	db *sqlx.DB
+}
+
+func NewSQLXNotificationRepository(db *sqlx.DB) *SQLXNotificationRepository {
+	return &SQLXNotificationRepository{db: db}
+}
+
+func (r *SQLXNotificationRepository) AddWatch(ctx context.Context, watch *entity.Watch) error {
+	if watch.CardID != uuid.Nil {
+		query := `
+		INSERT INTO card_watches (user_id, card_id, created_at)
+		VALUES (:user_id, :card_id, :created_at)
+		ON CONFLICT (user_id, card_id) DO NOTHING
+		`
+
+		_, err := conn(ctx, r.db).NamedExecContext(ctx, query, repository.RepoCardWatch(*watch))
+
+		return err
+	}
+
+	query := `
+	INSERT INTO board_watches (user_id, board_id, created_at)
+	VALUES (:user_id, :board_id, :created_at)
+	ON CONFLICT (user_id, board_id) DO NOTHING
+	`
+
+	_, err := conn(ctx, r.db).NamedExecContext(ctx, query, repository.RepoBoardWatch(*watch))
+
+	return err
+}
+
+func (r *SQLXNotificationRepository) RemoveWatch(ctx context.Context, watch *entity.Watch) error {
+	if watch.CardID != uuid.Nil {
+		query := `DELETE FROM card_watches WHERE user_id = $1 AND card_id = $2`
+
+		_, err := conn(ctx, r.db).ExecContext(ctx, query, watch.UserID, watch.CardID)
+
+		return err
+	}
+
+	query := `DELETE FROM board_watches WHERE user_id = $1 AND board_id = $2`
+
+	_, err := conn(ctx, r.db).ExecContext(ctx, query, watch.UserID, watch.BoardID)
+
+	return err
+}
+
+func (r *SQLXNotificationRepository) GetWatchers(ctx context.Context, boardID, cardID uuid.UUID) ([]uuid.UUID, error) {
+	query := `
+	SELECT user_id FROM card_watches WHERE card_id = $1
+	UNION
+	SELECT user_id FROM board_watches WHERE board_id = $2
+	ORDER BY user_id
+	`
+
+	var userIDs []uuid.UUID
+	err := conn(ctx, r.db).SelectContext(ctx, &userIDs, query, cardID, boardID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return userIDs, nil
+}
+
+func (r *SQLXNotificationRepository) AddNotifications(ctx context.Context, notifications []entity.Notification) error {
+	if len(notifications) == 0 {
+		return nil
+	}
+
+	query := `
+	INSERT INTO notifications
+	(id, user_id, actor_id, kind, board_id, card_id, card_title, read_at, created_at)
+	VALUES
+	(:id, :user_id, :actor_id, :kind, :board_id, :card_id, :card_title, :read_at, :created_at)
+	`
+
+	return inTx(ctx, r.db, func(tx *sqlx.Tx) error {
+		for _, n := range notifications {
+			if _, err := tx.NamedExecContext(ctx, query, repository.RepoNotification(n)); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+func (r *SQLXNotificationRepository) GetNotifications(ctx context.Context, userID uuid.UUID, unreadOnly bool, page repository.Page) ([]entity.Notification, error) {
+	where := `WHERE user_id = $1`
+	order := `
+	ORDER BY created_at DESC, id DESC
+	LIMIT $2
+	`
+	args := []interface{}{userID, page.Limit}
+
+	if unreadOnly {
+		where += ` AND read_at IS NULL`
+	}
+
+	if page.After != nil {
+		if page.After.Time == nil {
+			return nil, repository.ErrInvalidCursor
+		}
+
+		where += ` AND (created_at < $3 OR (created_at = $3 AND id < $4))`
+		args = append(args, *page.After.Time, page.After.ID)
+	}
+
+	var repoNotifications []repository.Notification
+	err := conn(ctx, r.db).SelectContext(ctx, &repoNotifications, `SELECT * FROM notifications `+where+order, args...)
+
+	if err != nil {
+		return nil, err
+	}
+
+	notifications := make([]entity.Notification, len(repoNotifications))
+	for i, n := range repoNotifications {
+		notifications[i] = repository.NotificationToEntity(n)
+	}
+
+	return notifications, nil
+}
+
+func (r *SQLXNotificationRepository) MarkNotificationsRead(ctx context.Context, userID uuid.UUID, ids []uuid.UUID, at time.Time) error {
+	if len(ids) == 0 {
+		query := `UPDATE notifications SET read_at = $1 WHERE user_id = $2 AND read_at IS NULL`
+
+		_, err := conn(ctx, r.db).ExecContext(ctx, query, at, userID)
+
+		return err
+	}
+
+	query, args, err := sqlx.In(`
+	UPDATE notifications SET read_at = ?
+	WHERE user_id = ? AND read_at IS NULL AND id IN (?)
+	`, at, userID, ids)
+	if err != nil {
+		return err
+	}
+
+	_, err = conn(ctx, r.db).ExecContext(ctx, r.db.Rebind(query), args...)
+
+	return err
+}
+
+func (r *SQLXNotificationRepository) DeleteUserNotifications(ctx context.Context, userID uuid.UUID) error {
+	return inTx(ctx, r.db, func(tx *sqlx.Tx) error {
+		for _, query := range []string{
+			`DELETE FROM notifications WHERE user_id = $1`,
+			`DELETE FROM card_watches WHERE user_id = $1`,
+			`DELETE FROM board_watches WHERE user_id = $1`,
+		} {
+			if _, err := tx.ExecContext(ctx, query, userID); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,90 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/binary"
+	"errors"
+	"todo/internal/entity"
+	"todo/internal/repository"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+)
+
+type SQLXQuotaRepository struct {
+	db *sqlx.DB
+}
+
+func NewSQLXQuotaRepository(db *sqlx.DB) *SQLXQuotaRepository {
+	return &SQLXQuotaRepository{db: db}
+}
+
+func (r *SQLXQuotaRepository) GetQuotaOverride(ctx context.Context, userID uuid.UUID) (*entity.QuotaOverride, error) {
+	query := `SELECT * FROM quota_overrides WHERE user_id = $1`
+
+	var repoOverride repository.QuotaOverride
+	err := conn(ctx, r.db).GetContext(ctx, &repoOverride, query, userID)
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, repository.ErrQuotaOverrideNotFound
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	override := repository.QuotaOverrideToEntity(repoOverride)
+
+	return &override, nil
+}
+
+func (r *SQLXQuotaRepository) SetQuotaOverride(ctx context.Context, override *entity.QuotaOverride) error {
+	query := `
+	INSERT INTO quota_overrides (user_id, boards_per_user, columns_per_board, cards_per_column, description_length, updated_at)
+	VALUES (:user_id, :boards_per_user, :columns_per_board, :cards_per_column, :description_length, :updated_at)
+	ON CONFLICT (user_id) DO UPDATE SET
+		boards_per_user = EXCLUDED.boards_per_user, columns_per_board = EXCLUDED.columns_per_board,
+		cards_per_column = EXCLUDED.cards_per_column, description_length = EXCLUDED.description_length,
+		updated_at = EXCLUDED.updated_at
+	`
+
+	_, err := conn(ctx, r.db).NamedExecContext(ctx, query, repository.RepoQuotaOverride(*override))
+
+	return err
+}
+
+func (r *SQLXQuotaRepository) DeleteQuotaOverride(ctx context.Context, userID uuid.UUID) error {
+	query := `DELETE FROM quota_overrides WHERE user_id = $1`
+
+	res, err := conn(ctx, r.db).ExecContext(ctx, query, userID)
+	if err != nil {
+		return err
+	}
+
+	n, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if n == 0 {
+		return repository.ErrQuotaOverrideNotFound
+	}
+
+	return nil
+}
+
+// LockQuota takes a transaction level advisory lock keyed by the scope on
+// Postgres. SQLite transactions begin immediate, taking the write lock of
+// the whole database, so there is nothing more to take there.
+func (r *SQLXQuotaRepository) LockQuota(ctx context.Context, scope uuid.UUID) error {
+	if isSQLite(r.db) {
+		return nil
+	}
+
+	query := `SELECT pg_advisory_xact_lock($1)`
+
+	_, err := conn(ctx, r.db).ExecContext(ctx, query, int64(binary.BigEndian.Uint64(scope[:8])))
+
+	return err
+}
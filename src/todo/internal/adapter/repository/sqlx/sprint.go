@@ -0,0 +1,234 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+	"todo/internal/entity"
+	"todo/internal/repository"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+)
+
+type SQLXSprintRepository struct {
+	db *sqlx.DB
+}
+
+func NewSQLXSprintRepository(db *sqlx.DB) *SQLXSprintRepository {
+	return &SQLXSprintRepository{db: db}
+}
+
+func (r *SQLXSprintRepository) CreateSprint(ctx context.Context, sprint *entity.Sprint) error {
+	query := `
+	INSERT INTO sprints (id, board_id, name, start_date, end_date, closed_at, created_at)
+	VALUES (:id, :board_id, :name, :start_date, :end_date, :closed_at, :created_at)
+	`
+
+	_, err := conn(ctx, r.db).NamedExecContext(ctx, query, repository.RepoSprint(*sprint))
+
+	return err
+}
+
+func (r *SQLXSprintRepository) GetSprintByID(ctx context.Context, id uuid.UUID) (*entity.Sprint, error) {
+	query := `SELECT * FROM sprints WHERE id = $1`
+
+	var repoSprint repository.Sprint
+	err := conn(ctx, r.db).GetContext(ctx, &repoSprint, query, id)
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, repository.ErrSprintNotFound
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	sprint := repository.SprintToEntity(repoSprint)
+
+	return &sprint, nil
+}
+
+func (r *SQLXSprintRepository) GetSprintsByBoard(ctx context.Context, boardID uuid.UUID) ([]entity.Sprint, error) {
+	query := `SELECT * FROM sprints WHERE board_id = $1 ORDER BY start_date, created_at, id`
+
+	var repoSprints []repository.Sprint
+	err := conn(ctx, r.db).SelectContext(ctx, &repoSprints, query, boardID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	sprints := make([]entity.Sprint, len(repoSprints))
+	for i, s := range repoSprints {
+		sprints[i] = repository.SprintToEntity(s)
+	}
+
+	return sprints, nil
+}
+
+func (r *SQLXSprintRepository) AddSprintCard(ctx context.Context, card *entity.SprintCard) error {
+	return inTx(ctx, r.db, func(tx *sqlx.Tx) error {
+		_, err := tx.ExecContext(ctx, `
+		DELETE FROM sprint_cards
+		WHERE card_id = $1 AND sprint_id <> $2
+		AND sprint_id IN (SELECT id FROM sprints WHERE closed_at IS NULL)
+		`, card.CardID, card.SprintID)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.NamedExecContext(ctx, `
+		INSERT INTO sprint_cards (sprint_id, card_id, points, added_at)
+		VALUES (:sprint_id, :card_id, :points, :added_at)
+		ON CONFLICT (sprint_id, card_id) DO UPDATE SET points = EXCLUDED.points
+		`, repository.RepoSprintCard(*card))
+
+		return err
+	})
+}
+
+func (r *SQLXSprintRepository) RemoveSprintCard(ctx context.Context, sprintID, cardID uuid.UUID) error {
+	query := `DELETE FROM sprint_cards WHERE sprint_id = $1 AND card_id = $2`
+
+	res, err := conn(ctx, r.db).ExecContext(ctx, query, sprintID, cardID)
+	if err != nil {
+		return err
+	}
+
+	n, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if n == 0 {
+		return repository.ErrSprintCardNotFound
+	}
+
+	return nil
+}
+
+func (r *SQLXSprintRepository) GetSprintCards(ctx context.Context, sprintID uuid.UUID) ([]entity.SprintCard, error) {
+	query := `
+	SELECT sc.*, c.title AS card_title, col.done
+	FROM sprint_cards sc
+	JOIN cards c ON c.id = sc.card_id
+	JOIN columns col ON col.id = c.column_id
+	WHERE sc.sprint_id = $1
+	ORDER BY sc.added_at, sc.card_id
+	`
+
+	var repoCards []repository.SprintCard
+	err := conn(ctx, r.db).SelectContext(ctx, &repoCards, query, sprintID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	cards := make([]entity.SprintCard, len(repoCards))
+	for i, c := range repoCards {
+		cards[i] = repository.SprintCardToEntity(c)
+	}
+
+	return cards, nil
+}
+
+func (r *SQLXSprintRepository) CloseSprint(ctx context.Context, id uuid.UUID, nextID *uuid.UUID, at time.Time) (int, error) {
+	var carried int64
+
+	err := inTx(ctx, r.db, func(tx *sqlx.Tx) error {
+		res, err := tx.ExecContext(ctx, `
+		UPDATE sprints SET closed_at = $2 WHERE id = $1 AND closed_at IS NULL
+		`, id, at)
+		if err != nil {
+			return err
+		}
+
+		n, err := res.RowsAffected()
+		if err != nil {
+			return err
+		}
+		if n == 0 {
+			return repository.ErrSprintClosed
+		}
+
+		if nextID == nil {
+			return nil
+		}
+
+		res, err = tx.ExecContext(ctx, `
+		INSERT INTO sprint_cards (sprint_id, card_id, points, added_at)
+		SELECT $2, sc.card_id, sc.points, $3
+		FROM sprint_cards sc
+		JOIN cards c ON c.id = sc.card_id
+		JOIN columns col ON col.id = c.column_id
+		WHERE sc.sprint_id = $1 AND NOT col.done AND c.archived_at IS NULL
+		ON CONFLICT (sprint_id, card_id) DO NOTHING
+		`, id, *nextID, at)
+		if err != nil {
+			return err
+		}
+
+		carried, err = res.RowsAffected()
+		return err
+	})
+
+	return int(carried), err
+}
+
+func (r *SQLXSprintRepository) GetSprintBurndown(ctx context.Context, id uuid.UUID, from, to time.Time) ([]entity.BurndownPoint, error) {
+	query := `
+	SELECT d.day, COUNT(sc.card_id) AS cards, COALESCE(SUM(sc.points), 0) AS points
+	FROM generate_series($2::timestamp, $3::timestamp - interval '1 day', interval '1 day') AS d(day)
+	LEFT JOIN sprint_cards sc ON sc.sprint_id = $1
+		AND sc.added_at < d.day + interval '1 day'
+		AND NOT EXISTS (
+			SELECT 1 FROM card_column_stays s JOIN columns col ON col.id = s.column_id
+			WHERE s.card_id = sc.card_id AND col.done
+				AND s.entered_at < d.day + interval '1 day'
+				AND (s.left_at IS NULL OR s.left_at >= d.day + interval '1 day')
+		)
+	GROUP BY d.day
+	ORDER BY d.day
+	`
+	args := []interface{}{id, from, to}
+
+	if isSQLite(r.db) {
+		days, dayArgs := sqliteDays(from, to)
+		query = `
+		WITH d (day, next_day) AS (` + days + `)
+		SELECT d.day, COUNT(sc.card_id) AS cards, COALESCE(SUM(sc.points), 0) AS points
+		FROM d
+		LEFT JOIN sprint_cards sc ON sc.sprint_id = ?
+			AND sc.added_at < d.next_day
+			AND NOT EXISTS (
+				SELECT 1 FROM card_column_stays s JOIN columns col ON col.id = s.column_id
+				WHERE s.card_id = sc.card_id AND col.done
+					AND s.entered_at < d.next_day
+					AND (s.left_at IS NULL OR s.left_at >= d.next_day)
+			)
+		GROUP BY d.day
+		ORDER BY d.day
+		`
+		args = append(dayArgs, id)
+	}
+
+	var repoPoints []struct {
+		repository.BurndownPoint
+		Day timeValue `db:"day"`
+	}
+	err := conn(ctx, r.db).SelectContext(ctx, &repoPoints, query, args...)
+
+	if err != nil {
+		return nil, err
+	}
+
+	points := make([]entity.BurndownPoint, len(repoPoints))
+	for i, p := range repoPoints {
+		p.BurndownPoint.Day = p.Day.Time
+		points[i] = repository.BurndownPointToEntity(p.BurndownPoint)
+	}
+
+	return points, nil
+}
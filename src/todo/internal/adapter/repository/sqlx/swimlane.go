@@ -0,0 +1,115 @@
+package repository
+
+import (
+	"context"
+	"todo/internal/entity"
+	"todo/internal/repository"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+)
+
+type SQLXSwimlaneRepository struct {
+	db *sqlx.DB
+}
+
+func NewSQLXSwimlaneRepository(db *sqlx.DB) *SQLXSwimlaneRepository {
+	return &SQLXSwimlaneRepository{db: db}
+}
+
+func (r *SQLXSwimlaneRepository) CreateSwimlane(ctx context.Context, swimlane *entity.Swimlane) error {
+	repoSwimlane := repository.RepoSwimlane(*swimlane)
+
+	query := `
+	INSERT INTO swimlanes (id, board_id, user_id, title, position, version, created_at, updated_at)
+	VALUES (:id, :board_id, :user_id, :title, :position, :version, :created_at, :updated_at)
+	`
+
+	_, err := conn(ctx, r.db).NamedExecContext(ctx, query, repoSwimlane)
+
+	return err
+}
+
+func (r *SQLXSwimlaneRepository) GetSwimlaneByID(ctx context.Context, id uuid.UUID) (*entity.Swimlane, error) {
+	query := `
+	SELECT * FROM swimlanes WHERE id = $1
+	`
+
+	var repoSwimlane repository.Swimlane
+	err := conn(ctx, r.db).GetContext(ctx, &repoSwimlane, query, id)
+
+	if err != nil {
+		return nil, err
+	}
+
+	swimlane := repository.SwimlaneToEntity(repoSwimlane)
+
+	return &swimlane, nil
+}
+
+func (r *SQLXSwimlaneRepository) GetSwimlanesByBoard(ctx context.Context, boardID uuid.UUID, page repository.Page) ([]entity.Swimlane, error) {
+	query := `
+	SELECT * FROM swimlanes WHERE board_id = $1
+	ORDER BY position ASC, id ASC
+	LIMIT $2
+	`
+	args := []interface{}{boardID, page.Limit}
+
+	if page.After != nil {
+		if page.After.Number == nil {
+			return nil, repository.ErrInvalidCursor
+		}
+
+		query = `
+		SELECT * FROM swimlanes WHERE board_id = $1
+		AND (position > $3 OR (position = $3 AND id > $4))
+		ORDER BY position ASC, id ASC
+		LIMIT $2
+		`
+		args = append(args, *page.After.Number, page.After.ID)
+	}
+
+	var repoSwimlanes []repository.Swimlane
+	err := conn(ctx, r.db).SelectContext(ctx, &repoSwimlanes, query, args...)
+
+	if err != nil {
+		return nil, err
+	}
+
+	swimlanes := make([]entity.Swimlane, len(repoSwimlanes))
+	for i, s := range repoSwimlanes {
+		swimlanes[i] = repository.SwimlaneToEntity(s)
+	}
+
+	return swimlanes, nil
+}
+
+func (r *SQLXSwimlaneRepository) UpdateSwimlane(ctx context.Context, swimlane *entity.Swimlane) error {
+	query := `
+    UPDATE swimlanes SET
+	title = :title,
+	position = :position,
+	version = version + 1,
+	updated_at = :updated_at
+    WHERE id = :id AND version = :version
+    `
+
+	repoSwimlane := repository.RepoSwimlane(*swimlane)
+
+	err := versioned(conn(ctx, r.db).NamedExecContext(ctx, query, repoSwimlane))
+	if err != nil {
+		return err
+	}
+
+	swimlane.Version++
+
+	return nil
+}
+
+func (r *SQLXSwimlaneRepository) DeleteSwimlane(ctx context.Context, id uuid.UUID, version int) error {
+	query := `
+	DELETE FROM swimlanes WHERE id = $1 AND version = $2
+	`
+
+	return versioned(conn(ctx, r.db).ExecContext(ctx, query, id, version))
+}
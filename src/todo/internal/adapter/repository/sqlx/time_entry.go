@@ -0,0 +1,149 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+	"time"
+	"todo/internal/entity"
+	"todo/internal/repository"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+)
+
+// runningTimerIndex backs the one running timer per user rule when two
+// timers are started at once.
+const runningTimerIndex = "time_entries_running_idx"
+
+type SQLXTimeEntryRepository struct {
+	db *sqlx.DB
+}
+
+func NewSQLXTimeEntryRepository(db *sqlx.DB) *SQLXTimeEntryRepository {
+	return &SQLXTimeEntryRepository{db: db}
+}
+
+func (r *SQLXTimeEntryRepository) AddTimeEntry(ctx context.Context, entry *entity.TimeEntry) error {
+	query := `
+	INSERT INTO time_entries (id, card_id, user_id, started_at, ended_at, note, created_at)
+	VALUES (:id, :card_id, :user_id, :started_at, :ended_at, :note, :created_at)
+	`
+
+	_, err := conn(ctx, r.db).NamedExecContext(ctx, query, repository.RepoTimeEntry(*entry))
+
+	if uniqueViolation(err, runningTimerIndex) {
+		return repository.ErrTimerRunning
+	}
+
+	return err
+}
+
+func (r *SQLXTimeEntryRepository) GetRunningTimeEntry(ctx context.Context, userID uuid.UUID) (*entity.TimeEntry, error) {
+	query := `SELECT * FROM time_entries WHERE user_id = $1 AND ended_at IS NULL`
+
+	var repoEntry repository.TimeEntry
+	err := conn(ctx, r.db).GetContext(ctx, &repoEntry, query, userID)
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, repository.ErrNoTimerRunning
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	entry := repository.TimeEntryToEntity(repoEntry)
+
+	return &entry, nil
+}
+
+func (r *SQLXTimeEntryRepository) StopTimeEntry(ctx context.Context, userID uuid.UUID, endedAt time.Time) (*entity.TimeEntry, error) {
+	query := `
+	UPDATE time_entries SET ended_at = $2
+	WHERE user_id = $1 AND ended_at IS NULL
+	RETURNING *
+	`
+
+	var repoEntry repository.TimeEntry
+	err := conn(ctx, r.db).GetContext(ctx, &repoEntry, query, userID, endedAt)
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, repository.ErrNoTimerRunning
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	entry := repository.TimeEntryToEntity(repoEntry)
+
+	return &entry, nil
+}
+
+func (r *SQLXTimeEntryRepository) GetTimeEntriesByCard(ctx context.Context, cardID uuid.UUID) ([]entity.TimeEntry, error) {
+	query := `SELECT * FROM time_entries WHERE card_id = $1 ORDER BY started_at, id`
+
+	var repoEntries []repository.TimeEntry
+	err := conn(ctx, r.db).SelectContext(ctx, &repoEntries, query, cardID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]entity.TimeEntry, len(repoEntries))
+	for i, e := range repoEntries {
+		entries[i] = repository.TimeEntryToEntity(e)
+	}
+
+	return entries, nil
+}
+
+func (r *SQLXTimeEntryRepository) GetTimeReport(ctx context.Context, q repository.TimeReportQuery) ([]entity.TimeReportRow, error) {
+	conds := []string{"t.ended_at IS NOT NULL", "t.started_at >= ?", "t.started_at < ?"}
+	args := []interface{}{q.From, q.To}
+
+	if q.BoardID != nil {
+		conds = append(conds, "c.column_id IN (SELECT id FROM columns WHERE board_id = ?)")
+		args = append(args, *q.BoardID)
+	}
+	if q.UserID != nil {
+		conds = append(conds, "t.user_id = ?")
+		args = append(args, *q.UserID)
+	}
+
+	day, seconds := `date_trunc('day', t.started_at)`, `SUM(EXTRACT(EPOCH FROM t.ended_at - t.started_at))::BIGINT`
+	if isSQLite(r.db) {
+		// SQLite keeps times as text ending in their offset, and subtracts
+		// them as Julian days.
+		day = `substr(t.started_at, 1, 10) || ' 00:00:00' || substr(t.started_at, -6)`
+		seconds = `CAST(ROUND(SUM(julianday(t.ended_at) - julianday(t.started_at)) * 86400) AS INTEGER)`
+	}
+
+	query := `
+	SELECT ` + day + ` AS day, t.user_id, t.card_id, c.title AS card_title, ` + seconds + ` AS seconds
+	FROM time_entries t JOIN cards c ON c.id = t.card_id
+	WHERE ` + strings.Join(conds, " AND ") + `
+	GROUP BY day, t.user_id, t.card_id, c.title
+	ORDER BY day, t.user_id, c.title, t.card_id
+	`
+
+	var repoRows []struct {
+		repository.TimeReportRow
+		Day timeValue `db:"day"`
+	}
+	err := conn(ctx, r.db).SelectContext(ctx, &repoRows, r.db.Rebind(query), args...)
+
+	if err != nil {
+		return nil, err
+	}
+
+	rows := make([]entity.TimeReportRow, len(repoRows))
+	for i, row := range repoRows {
+		row.TimeReportRow.Day = row.Day.Time
+		rows[i] = repository.TimeReportRowToEntity(row.TimeReportRow)
+	}
+
+	return rows, nil
+}
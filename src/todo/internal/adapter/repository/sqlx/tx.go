@@ -0,0 +1,64 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/jmoiron/sqlx"
+)
+
+type txKey struct{}
+
+// SQLXTxManager runs units of work in a database transaction carried by the
+// context; the SQLX repositories pick it up from there.
+type SQLXTxManager struct {
+	db *sqlx.DB
+}
+
+func NewSQLXTxManager(db *sqlx.DB) *SQLXTxManager {
+	return &SQLXTxManager{db: db}
+}
+
+func (m *SQLXTxManager) WithinTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	if _, ok := ctx.Value(txKey{}).(*sqlx.Tx); ok {
+		return fn(ctx)
+	}
+
+	tx, err := m.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if err := fn(context.WithValue(ctx, txKey{}, tx)); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// querier is what the repositories need from *sqlx.DB and *sqlx.Tx alike.
+type querier interface {
+	sqlx.ExtContext
+	GetContext(ctx context.Context, dest interface{}, query string, args ...interface{}) error
+	SelectContext(ctx context.Context, dest interface{}, query string, args ...interface{}) error
+	NamedExecContext(ctx context.Context, query string, arg interface{}) (sql.Result, error)
+}
+
+// conn returns the transaction of the unit of work ctx belongs to, or db
+// outside of one.
+func conn(ctx context.Context, db *sqlx.DB) querier {
+	if tx, ok := ctx.Value(txKey{}).(*sqlx.Tx); ok {
+		return tx
+	}
+
+	return db
+}
+
+// inTx runs fn in the transaction of the unit of work ctx belongs to, or in
+// a transaction of its own outside of one.
+func inTx(ctx context.Context, db *sqlx.DB, fn func(tx *sqlx.Tx) error) error {
+	return NewSQLXTxManager(db).WithinTx(ctx, func(ctx context.Context) error {
+		return fn(ctx.Value(txKey{}).(*sqlx.Tx))
+	})
+}
@@ -0,0 +1,25 @@
+package repository
+
+import (
+	"database/sql"
+	"todo/internal/repository"
+)
+
+// versioned checks the result of a write conditioned on the row version: a
+// write that touched no rows lost the race to another writer.
+func versioned(res sql.Result, err error) error {
+	if err != nil {
+		return err
+	}
+
+	n, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if n == 0 {
+		return repository.ErrVersionMismatch
+	}
+
+	return nil
+}
@@ -0,0 +1,306 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+	"todo/internal/entity"
+	"todo/internal/repository"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+)
+
+type SQLXWorkspaceRepository struct {
+	db *sqlx.DB
+}
+
+func NewSQLXWorkspaceRepository(db *sqlx.DB) *SQLXWorkspaceRepository {
+	return &SQLXWorkspaceRepository{db: db}
+}
+
+const insertWorkspaceMember = `
+	INSERT INTO workspace_members (workspace_id, user_id, role, created_at)
+	VALUES (:workspace_id, :user_id, :role, :created_at)
+	`
+
+func (r *SQLXWorkspaceRepository) CreateWorkspace(ctx context.Context, workspace *entity.Workspace, admin *entity.WorkspaceMember) error {
+	query := `
+	INSERT INTO workspaces (id, name, personal, created_by, created_at)
+	VALUES (:id, :name, :personal, :created_by, :created_at)
+	`
+
+	return inTx(ctx, r.db, func(tx *sqlx.Tx) error {
+		if _, err := tx.NamedExecContext(ctx, query, repository.RepoWorkspace(*workspace)); err != nil {
+			return err
+		}
+
+		_, err := tx.NamedExecContext(ctx, insertWorkspaceMember, repository.RepoWorkspaceMember(*admin))
+
+		return err
+	})
+}
+
+func (r *SQLXWorkspaceRepository) GetWorkspaceByID(ctx context.Context, id uuid.UUID) (*entity.Workspace, error) {
+	query := `SELECT * FROM workspaces WHERE id = $1`
+
+	var repoWorkspace repository.Workspace
+	err := conn(ctx, r.db).GetContext(ctx, &repoWorkspace, query, id)
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, repository.ErrWorkspaceNotFound
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	workspace := repository.WorkspaceToEntity(repoWorkspace)
+
+	return &workspace, nil
+}
+
+func (r *SQLXWorkspaceRepository) DeleteWorkspace(ctx context.Context, id uuid.UUID) error {
+	query := `DELETE FROM workspaces WHERE id = $1`
+
+	res, err := conn(ctx, r.db).ExecContext(ctx, query, id)
+	if err != nil {
+		return err
+	}
+
+	n, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if n == 0 {
+		return repository.ErrWorkspaceNotFound
+	}
+
+	return nil
+}
+
+func (r *SQLXWorkspaceRepository) EnsurePersonalWorkspace(ctx context.Context, userID uuid.UUID, at time.Time) (*entity.Workspace, error) {
+	query := `
+	INSERT INTO workspaces (id, name, personal, created_by, created_at)
+	VALUES ($1, $2, TRUE, $3, $4)
+	ON CONFLICT (created_by) WHERE personal DO NOTHING
+	`
+
+	selectQuery := `SELECT * FROM workspaces WHERE created_by = $1 AND personal`
+
+	memberQuery := `
+	INSERT INTO workspace_members (workspace_id, user_id, role, created_at)
+	VALUES ($1, $2, $3, $4)
+	ON CONFLICT (workspace_id, user_id) DO NOTHING
+	`
+
+	var repoWorkspace repository.Workspace
+
+	err := inTx(ctx, r.db, func(tx *sqlx.Tx) error {
+		if _, err := tx.ExecContext(ctx, query, uuid.New(), repository.PersonalWorkspaceName, userID, at); err != nil {
+			return err
+		}
+
+		if err := tx.GetContext(ctx, &repoWorkspace, selectQuery, userID); err != nil {
+			return err
+		}
+
+		_, err := tx.ExecContext(ctx, memberQuery, repoWorkspace.ID, userID, entity.RoleAdmin, repoWorkspace.CreatedAt)
+
+		return err
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	workspace := repository.WorkspaceToEntity(repoWorkspace)
+
+	return &workspace, nil
+}
+
+func (r *SQLXWorkspaceRepository) GetWorkspacesByUser(ctx context.Context, userID uuid.UUID) ([]entity.WorkspaceMembership, error) {
+	query := `
+	SELECT w.*, m.role
+	FROM workspace_members m
+	JOIN workspaces w ON w.id = m.workspace_id
+	WHERE m.user_id = $1
+	ORDER BY w.created_at, w.id
+	`
+
+	var repoWorkspaces []repository.WorkspaceMembership
+	err := conn(ctx, r.db).SelectContext(ctx, &repoWorkspaces, query, userID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	workspaces := make([]entity.WorkspaceMembership, len(repoWorkspaces))
+	for i, w := range repoWorkspaces {
+		workspaces[i] = repository.WorkspaceMembershipToEntity(w)
+	}
+
+	return workspaces, nil
+}
+
+func (r *SQLXWorkspaceRepository) AddWorkspaceMember(ctx context.Context, member *entity.WorkspaceMember) error {
+	_, err := conn(ctx, r.db).NamedExecContext(ctx, insertWorkspaceMember, repository.RepoWorkspaceMember(*member))
+
+	if uniqueViolation(err, "") {
+		return repository.ErrWorkspaceMemberExists
+	}
+
+	return err
+}
+
+func (r *SQLXWorkspaceRepository) UpdateWorkspaceMember(ctx context.Context, member *entity.WorkspaceMember) error {
+	query := `
+	UPDATE workspace_members SET role = :role
+	WHERE workspace_id = :workspace_id AND user_id = :user_id
+	`
+
+	return memberChanged(conn(ctx, r.db).NamedExecContext(ctx, query, repository.RepoWorkspaceMember(*member)))
+}
+
+func (r *SQLXWorkspaceRepository) RemoveWorkspaceMember(ctx context.Context, workspaceID, userID uuid.UUID) error {
+	query := `DELETE FROM workspace_members WHERE workspace_id = $1 AND user_id = $2`
+
+	return memberChanged(conn(ctx, r.db).ExecContext(ctx, query, workspaceID, userID))
+}
+
+func (r *SQLXWorkspaceRepository) GetWorkspaceMember(ctx context.Context, workspaceID, userID uuid.UUID) (*entity.WorkspaceMember, error) {
+	query := `SELECT * FROM workspace_members WHERE workspace_id = $1 AND user_id = $2`
+
+	var repoMember repository.WorkspaceMember
+	err := conn(ctx, r.db).GetContext(ctx, &repoMember, query, workspaceID, userID)
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, repository.ErrWorkspaceMemberNotFound
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	member := repository.WorkspaceMemberToEntity(repoMember)
+
+	return &member, nil
+}
+
+func (r *SQLXWorkspaceRepository) GetWorkspaceMembers(ctx context.Context, workspaceID uuid.UUID) ([]entity.WorkspaceMember, error) {
+	query := `
+	SELECT * FROM workspace_members WHERE workspace_id = $1
+	ORDER BY created_at, user_id
+	`
+
+	var repoMembers []repository.WorkspaceMember
+	err := conn(ctx, r.db).SelectContext(ctx, &repoMembers, query, workspaceID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	members := make([]entity.WorkspaceMember, len(repoMembers))
+	for i, m := range repoMembers {
+		members[i] = repository.WorkspaceMemberToEntity(m)
+	}
+
+	return members, nil
+}
+
+func (r *SQLXWorkspaceRepository) GetWorkspaceBoards(ctx context.Context, workspaceID uuid.UUID, page repository.Page) ([]entity.Board, error) {
+	query := `
+	SELECT * FROM boards WHERE workspace_id = $1
+	ORDER BY created_at ASC, id ASC
+	LIMIT $2
+	`
+	args := []interface{}{workspaceID, page.Limit}
+
+	if page.After != nil {
+		if page.After.Time == nil {
+			return nil, repository.ErrInvalidCursor
+		}
+
+		query = `
+		SELECT * FROM boards WHERE workspace_id = $1
+		AND (created_at > $3 OR (created_at = $3 AND id > $4))
+		ORDER BY created_at ASC, id ASC
+		LIMIT $2
+		`
+		args = append(args, *page.After.Time, page.After.ID)
+	}
+
+	var repoBoards []repository.Board
+	err := conn(ctx, r.db).SelectContext(ctx, &repoBoards, query, args...)
+
+	if err != nil {
+		return nil, err
+	}
+
+	boards := make([]entity.Board, len(repoBoards))
+	for i, b := range repoBoards {
+		boards[i] = repository.BoardToEntity(b)
+	}
+
+	return boards, nil
+}
+
+func (r *SQLXWorkspaceRepository) GetMemberBoards(ctx context.Context, userID uuid.UUID, page repository.Page) ([]entity.Board, error) {
+	query := `
+	SELECT b.* FROM boards b
+	JOIN workspace_members m ON m.workspace_id = b.workspace_id AND m.user_id = $1
+	ORDER BY b.created_at ASC, b.id ASC
+	LIMIT $2
+	`
+	args := []interface{}{userID, page.Limit}
+
+	if page.After != nil {
+		if page.After.Time == nil {
+			return nil, repository.ErrInvalidCursor
+		}
+
+		query = `
+		SELECT b.* FROM boards b
+		JOIN workspace_members m ON m.workspace_id = b.workspace_id AND m.user_id = $1
+		WHERE b.created_at > $3 OR (b.created_at = $3 AND b.id > $4)
+		ORDER BY b.created_at ASC, b.id ASC
+		LIMIT $2
+		`
+		args = append(args, *page.After.Time, page.After.ID)
+	}
+
+	var repoBoards []repository.Board
+	err := conn(ctx, r.db).SelectContext(ctx, &repoBoards, query, args...)
+
+	if err != nil {
+		return nil, err
+	}
+
+	boards := make([]entity.Board, len(repoBoards))
+	for i, b := range repoBoards {
+		boards[i] = repository.BoardToEntity(b)
+	}
+
+	return boards, nil
+}
+
+// memberChanged turns an update or delete of no member into
+// ErrWorkspaceMemberNotFound.
+func memberChanged(res sql.Result, err error) error {
+	if err != nil {
+		return err
+	}
+
+	n, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if n == 0 {
+		return repository.ErrWorkspaceMemberNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,217 @@
+package unsupported
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+	"todo/internal/entity"
+	"todo/internal/repository"
+
+	"github.com/google/uuid"
+)
+
+var ErrUnsupported = errors.New("not supported by the storage backend")
+
+// Repository stands in for the time entry, card flow, board stats, sprint,
+// calendar, board mark, notification, card template and quota repositories
+// on a storage backend that has none. Every call fails with ErrUnsupported,
+// so the rest of the service still runs.
+type Repository struct {
+	backend string
+}
+
+func NewRepository(backend string) *Repository {
+	return &Repository{backend: backend}
+}
+
+func (r *Repository) err() error {
+	return fmt.Errorf("%w: %s", ErrUnsupported, r.backend)
+}
+
+func (r *Repository) AddTimeEntry(ctx context.Context, entry *entity.TimeEntry) error {
+	return r.err()
+}
+
+func (r *Repository) GetRunningTimeEntry(ctx context.Context, userID uuid.UUID) (*entity.TimeEntry, error) {
+	return nil, r.err()
+}
+
+func (r *Repository) StopTimeEntry(ctx context.Context, userID uuid.UUID, endedAt time.Time) (*entity.TimeEntry, error) {
+	return nil, r.err()
+}
+
+func (r *Repository) GetTimeEntriesByCard(ctx context.Context, cardID uuid.UUID) ([]entity.TimeEntry, error) {
+	return nil, r.err()
+}
+
+func (r *Repository) GetTimeReport(ctx context.Context, query repository.TimeReportQuery) ([]entity.TimeReportRow, error) {
+	return nil, r.err()
+}
+
+func (r *Repository) GetCardFlows(ctx context.Context, boardID uuid.UUID, from, to time.Time) ([]entity.CardFlow, error) {
+	return nil, r.err()
+}
+
+func (r *Repository) GetColumnCounts(ctx context.Context, boardID uuid.UUID, from, to time.Time) ([]entity.ColumnCount, error) {
+	return nil, r.err()
+}
+
+func (r *Repository) GetColumnCards(ctx context.Context, boardID uuid.UUID) ([]entity.ColumnCards, error) {
+	return nil, r.err()
+}
+
+func (r *Repository) GetCreatedCards(ctx context.Context, boardID uuid.UUID, from, to time.Time) ([]entity.CreatedCard, error) {
+	return nil, r.err()
+}
+
+func (r *Repository) GetCompletedCards(ctx context.Context, boardID uuid.UUID, from, to time.Time) ([]entity.CompletedCard, error) {
+	return nil, r.err()
+}
+
+func (r *Repository) GetStaleCards(ctx context.Context, boardID uuid.UUID, before time.Time, limit int) ([]entity.StaleCard, error) {
+	return nil, r.err()
+}
+
+func (r *Repository) CreateSprint(ctx context.Context, sprint *entity.Sprint) error {
+	return r.err()
+}
+
+func (r *Repository) GetSprintByID(ctx context.Context, id uuid.UUID) (*entity.Sprint, error) {
+	return nil, r.err()
+}
+
+func (r *Repository) GetSprintsByBoard(ctx context.Context, boardID uuid.UUID) ([]entity.Sprint, error) {
+	return nil, r.err()
+}
+
+func (r *Repository) AddSprintCard(ctx context.Context, card *entity.SprintCard) error {
+	return r.err()
+}
+
+func (r *Repository) RemoveSprintCard(ctx context.Context, sprintID, cardID uuid.UUID) error {
+	return r.err()
+}
+
+func (r *Repository) GetSprintCards(ctx context.Context, sprintID uuid.UUID) ([]entity.SprintCard, error) {
+	return nil, r.err()
+}
+
+func (r *Repository) CloseSprint(ctx context.Context, id uuid.UUID, nextID *uuid.UUID, at time.Time) (int, error) {
+	return 0, r.err()
+}
+
+func (r *Repository) GetSprintBurndown(ctx context.Context, id uuid.UUID, from, to time.Time) ([]entity.BurndownPoint, error) {
+	return nil, r.err()
+}
+
+func (r *Repository) SetCalendarToken(ctx context.Context, token *entity.CalendarToken) error {
+	return r.err()
+}
+
+func (r *Repository) GetCalendarTokenByHash(ctx context.Context, tokenHash string) (*entity.CalendarToken, error) {
+	return nil, r.err()
+}
+
+func (r *Repository) GetCalendarCards(ctx context.Context, userID uuid.UUID) ([]entity.CalendarCard, error) {
+	return nil, r.err()
+}
+
+// DeleteCalendarToken has nothing to delete, as no token can be set.
+func (r *Repository) DeleteCalendarToken(ctx context.Context, userID uuid.UUID) error {
+	return nil
+}
+
+func (r *Repository) StarBoard(ctx context.Context, star *entity.BoardStar) error {
+	return r.err()
+}
+
+func (r *Repository) UnstarBoard(ctx context.Context, userID, boardID uuid.UUID) error {
+	return r.err()
+}
+
+func (r *Repository) RecordBoardView(ctx context.Context, view *entity.BoardView) error {
+	return r.err()
+}
+
+func (r *Repository) GetRecentBoards(ctx context.Context, userID uuid.UUID) ([]entity.MarkedBoard, error) {
+	return nil, r.err()
+}
+
+func (r *Repository) GetMarkedBoards(ctx context.Context, userID uuid.UUID, page repository.Page) ([]entity.MarkedBoard, error) {
+	return nil, r.err()
+}
+
+// DeleteUserMarks has nothing to delete, as no board can be marked.
+func (r *Repository) DeleteUserMarks(ctx context.Context, userID uuid.UUID) error {
+	return nil
+}
+
+func (r *Repository) AddWatch(ctx context.Context, watch *entity.Watch) error {
+	return r.err()
+}
+
+func (r *Repository) RemoveWatch(ctx context.Context, watch *entity.Watch) error {
+	return r.err()
+}
+
+func (r *Repository) GetWatchers(ctx context.Context, boardID, cardID uuid.UUID) ([]uuid.UUID, error) {
+	return nil, r.err()
+}
+
+func (r *Repository) AddNotifications(ctx context.Context, notifications []entity.Notification) error {
+	return r.err()
+}
+
+func (r *Repository) GetNotifications(ctx context.Context, userID uuid.UUID, unreadOnly bool, page repository.Page) ([]entity.Notification, error) {
+	return nil, r.err()
+}
+
+func (r *Repository) MarkNotificationsRead(ctx context.Context, userID uuid.UUID, ids []uuid.UUID, at time.Time) error {
+	return r.err()
+}
+
+// DeleteUserNotifications has nothing to delete, as no watch or
+// notification can be added.
+func (r *Repository) DeleteUserNotifications(ctx context.Context, userID uuid.UUID) error {
+	return nil
+}
+
+func (r *Repository) CreateCardTemplate(ctx context.Context, template *entity.CardTemplate) error {
+	return r.err()
+}
+
+func (r *Repository) GetCardTemplateByID(ctx context.Context, id uuid.UUID) (*entity.CardTemplate, error) {
+	return nil, r.err()
+}
+
+func (r *Repository) GetCardTemplatesByBoard(ctx context.Context, boardID uuid.UUID) ([]entity.CardTemplate, error) {
+	return nil, r.err()
+}
+
+func (r *Repository) UpdateCardTemplate(ctx context.Context, template *entity.CardTemplate) error {
+	return r.err()
+}
+
+func (r *Repository) DeleteCardTemplate(ctx context.Context, id uuid.UUID) error {
+	return r.err()
+}
+
+// GetQuotaOverride finds no override, as the backend keeps none: users are
+// held to the configured quota.
+func (r *Repository) GetQuotaOverride(ctx context.Context, userID uuid.UUID) (*entity.QuotaOverride, error) {
+	return nil, repository.ErrQuotaOverrideNotFound
+}
+
+func (r *Repository) SetQuotaOverride(ctx context.Context, override *entity.QuotaOverride) error {
+	return r.err()
+}
+
+func (r *Repository) DeleteQuotaOverride(ctx context.Context, userID uuid.UUID) error {
+	return r.err()
+}
+
+// LockQuota locks nothing: the backend leaves counting to run unlocked.
+func (r *Repository) LockQuota(ctx context.Context, scope uuid.UUID) error {
+	return nil
+}
@@ -1,28 +1,145 @@
 package v1
 
 import (
+	"fmt"
+	"net/http"
 	v1 "todo/internal/handler/v1"
 
 	"github.com/gorilla/mux"
 )
 
-func InitializeV1Routes(router *mux.Router, todoHandler *v1.TodoHandler) {
+// The parts of the API a storage backend may leave out.
+const (
+	FeatureTimeTracking  = "time tracking"
+	FeatureAnalytics     = "analytics"
+	FeatureBoardStats    = "board stats"
+	FeatureSprints       = "sprints"
+	FeatureCalendar      = "calendar feeds"
+	FeatureBoardMarks    = "board marks"
+	FeatureNotifications = "notifications"
+	FeatureCardTemplates = "card templates"
+)
+
+// InitializeV1Routes registers the routes of the API. Those of the features
+// in leftOut, which the storage backend does not have, answer 501 Not
+// Implemented without reaching their handlers.
+func InitializeV1Routes(
+	router *mux.Router,
+	leftOut []string,
+	todoHandler *v1.TodoHandler,
+	feedHandler *v1.FeedHandler,
+	timeHandler *v1.TimeHandler,
+	analyticsHandler *v1.AnalyticsHandler,
+	statsHandler *v1.BoardStatsHandler,
+	sprintHandler *v1.SprintHandler,
+	calendarHandler *v1.CalendarHandler,
+	boardMarkHandler *v1.BoardMarkHandler,
+	notificationHandler *v1.NotificationHandler,
+	workspaceHandler *v1.WorkspaceHandler,
+	userDataHandler *v1.UserDataHandler,
+	templateHandler *v1.CardTemplateHandler,
+	quotaHandler *v1.QuotaHandler,
+) {
+	on := func(feature string, handler http.HandlerFunc) http.HandlerFunc {
+		for _, f := range leftOut {
+			if f == feature {
+				return notImplemented(feature)
+			}
+		}
+
+		return handler
+	}
+
 	router.HandleFunc("/api/v1/boards", todoHandler.CreateBoard).Methods("POST")
+	router.HandleFunc("/api/v1/boards/recent", on(FeatureBoardMarks, boardMarkHandler.GetRecentBoards)).Methods("GET")
 	router.HandleFunc("/api/v1/boards/{id}", todoHandler.GetBoardByID).Methods("GET")
+	router.HandleFunc("/api/v1/boards/{id}/star", on(FeatureBoardMarks, boardMarkHandler.StarBoard)).Methods("PUT")
+	router.HandleFunc("/api/v1/boards/{id}/star", on(FeatureBoardMarks, boardMarkHandler.UnstarBoard)).Methods("DELETE")
+	router.HandleFunc("/api/v1/boards/{id}/views", on(FeatureBoardMarks, boardMarkHandler.RecordBoardView)).Methods("POST")
+	router.HandleFunc("/api/v1/boards/{id}/watchers", on(FeatureNotifications, notificationHandler.AddBoardWatch)).Methods("PUT")
+	router.HandleFunc("/api/v1/boards/{id}/watchers", on(FeatureNotifications, notificationHandler.RemoveBoardWatch)).Methods("DELETE")
+	router.HandleFunc("/api/v1/boards/{id}/events", feedHandler.WatchBoard).Methods("GET")
+	router.HandleFunc("/api/v1/boards/{id}/analytics", on(FeatureAnalytics, analyticsHandler.GetBoardAnalytics)).Methods("GET")
+	router.HandleFunc("/api/v1/boards/{id}/stats", on(FeatureBoardStats, statsHandler.GetBoardStats)).Methods("GET")
+	router.HandleFunc("/api/v1/boards/{id}/owner", userDataHandler.TransferBoard).Methods("PUT")
+	router.HandleFunc("/api/v1/boards", on(FeatureBoardMarks, boardMarkHandler.GetMarkedBoards)).Methods("GET").Queries("sort", "starred")
 	router.HandleFunc("/api/v1/boards", todoHandler.GetBoardsByUser).Methods("GET")
+	router.HandleFunc("/api/v1/boards/merge", todoHandler.MergeBoards).Methods("POST")
 	router.HandleFunc("/api/v1/boards", todoHandler.UpdateBoard).Methods("PUT")
 	router.HandleFunc("/api/v1/boards", todoHandler.DeleteBoard).Methods("DELETE")
 
+	router.HandleFunc("/api/v1/workspaces", workspaceHandler.CreateWorkspace).Methods("POST")
+	router.HandleFunc("/api/v1/workspaces", workspaceHandler.GetWorkspacesByUser).Methods("GET")
+	router.HandleFunc("/api/v1/workspaces/{id}/members", workspaceHandler.GetWorkspaceMembers).Methods("GET")
+	router.HandleFunc("/api/v1/workspaces/{id}/members", workspaceHandler.AddWorkspaceMember).Methods("POST")
+	router.HandleFunc("/api/v1/workspaces/{id}/members", workspaceHandler.UpdateWorkspaceMember).Methods("PUT")
+	router.HandleFunc("/api/v1/workspaces/{id}/members", workspaceHandler.RemoveWorkspaceMember).Methods("DELETE")
+	router.HandleFunc("/api/v1/workspaces/{id}/boards", workspaceHandler.GetWorkspaceBoards).Methods("GET")
+
+	router.HandleFunc("/api/v1/users/{id}/boards/transfer", userDataHandler.TransferUserBoards).Methods("POST")
+	router.HandleFunc("/api/v1/users/{id}", userDataHandler.DeleteUserData).Methods("DELETE")
+	router.HandleFunc("/api/v1/users/{id}/quota", quotaHandler.GetUserQuota).Methods("GET")
+	router.HandleFunc("/api/v1/users/{id}/quota", quotaHandler.SetQuotaOverride).Methods("PUT")
+	router.HandleFunc("/api/v1/users/{id}/quota", quotaHandler.DeleteQuotaOverride).Methods("DELETE")
+
 	router.HandleFunc("/api/v1/columns", todoHandler.CreateColumn).Methods("POST")
 	router.HandleFunc("/api/v1/columns/{id}", todoHandler.GetColumnByID).Methods("GET")
 	router.HandleFunc("/api/v1/columns", todoHandler.GetColumnsByBoard).Methods("GET")
 	router.HandleFunc("/api/v1/columns", todoHandler.UpdateColumn).Methods("PUT")
+	router.HandleFunc("/api/v1/columns/board", todoHandler.MoveColumn).Methods("PUT")
 	router.HandleFunc("/api/v1/columns", todoHandler.DeleteColumn).Methods("DELETE")
 
+	router.HandleFunc("/api/v1/swimlanes", todoHandler.CreateSwimlane).Methods("POST")
+	router.HandleFunc("/api/v1/swimlanes/{id}", todoHandler.GetSwimlaneByID).Methods("GET")
+	router.HandleFunc("/api/v1/swimlanes", todoHandler.GetSwimlanesByBoard).Methods("GET")
+	router.HandleFunc("/api/v1/swimlanes", todoHandler.UpdateSwimlane).Methods("PUT")
+	router.HandleFunc("/api/v1/swimlanes", todoHandler.DeleteSwimlane).Methods("DELETE")
+
 	router.HandleFunc("/api/v1/cards", todoHandler.CreateCard).Methods("POST")
+	router.HandleFunc("/api/v1/cards/bulk", todoHandler.BulkCards).Methods("POST")
 	router.HandleFunc("/api/v1/cards/new", todoHandler.GetNewCards).Methods("GET")
+	router.HandleFunc("/api/v1/cards/parent", todoHandler.SetCardParent).Methods("PUT")
 	router.HandleFunc("/api/v1/cards/{id}", todoHandler.GetCardByID).Methods("GET")
-	router.HandleFunc("/api/v1/cards", todoHandler.GetCardsByColumn).Methods("GET")
+	router.HandleFunc("/api/v1/cards/{id}/ancestors", todoHandler.GetCardAncestors).Methods("GET")
+	router.HandleFunc("/api/v1/cards/{id}/time-entries", on(FeatureTimeTracking, timeHandler.GetCardTimeEntries)).Methods("GET")
+	router.HandleFunc("/api/v1/cards/{id}/watchers", on(FeatureNotifications, notificationHandler.AddCardWatch)).Methods("PUT")
+	router.HandleFunc("/api/v1/cards/{id}/watchers", on(FeatureNotifications, notificationHandler.RemoveCardWatch)).Methods("DELETE")
+	router.HandleFunc("/api/v1/cards", todoHandler.GetCards).Methods("GET")
 	router.HandleFunc("/api/v1/cards", todoHandler.UpdateCard).Methods("PUT")
 	router.HandleFunc("/api/v1/cards", todoHandler.DeleteCard).Methods("DELETE")
+
+	router.HandleFunc("/api/v1/timer/start", on(FeatureTimeTracking, timeHandler.StartTimer)).Methods("POST")
+	router.HandleFunc("/api/v1/timer/stop", on(FeatureTimeTracking, timeHandler.StopTimer)).Methods("POST")
+	router.HandleFunc("/api/v1/time-entries", on(FeatureTimeTracking, timeHandler.LogTime)).Methods("POST")
+	router.HandleFunc("/api/v1/reports/time", on(FeatureTimeTracking, timeHandler.GetTimeReport)).Methods("GET")
+
+	router.HandleFunc("/api/v1/sprints", on(FeatureSprints, sprintHandler.CreateSprint)).Methods("POST")
+	router.HandleFunc("/api/v1/sprints/{id}", on(FeatureSprints, sprintHandler.GetSprintByID)).Methods("GET")
+	router.HandleFunc("/api/v1/sprints", on(FeatureSprints, sprintHandler.GetSprintsByBoard)).Methods("GET")
+	router.HandleFunc("/api/v1/sprints/{id}/cards", on(FeatureSprints, sprintHandler.AddSprintCard)).Methods("POST")
+	router.HandleFunc("/api/v1/sprints/{id}/cards", on(FeatureSprints, sprintHandler.GetSprintCards)).Methods("GET")
+	router.HandleFunc("/api/v1/sprints/{id}/cards/{card_id}", on(FeatureSprints, sprintHandler.RemoveSprintCard)).Methods("DELETE")
+	router.HandleFunc("/api/v1/sprints/{id}/close", on(FeatureSprints, sprintHandler.CloseSprint)).Methods("POST")
+	router.HandleFunc("/api/v1/sprints/{id}/burndown", on(FeatureSprints, sprintHandler.GetSprintBurndown)).Methods("GET")
+
+	router.HandleFunc("/api/v1/templates", on(FeatureCardTemplates, templateHandler.CreateCardTemplate)).Methods("POST")
+	router.HandleFunc("/api/v1/templates/{id}", on(FeatureCardTemplates, templateHandler.GetCardTemplateByID)).Methods("GET")
+	router.HandleFunc("/api/v1/templates", on(FeatureCardTemplates, templateHandler.GetCardTemplatesByBoard)).Methods("GET")
+	router.HandleFunc("/api/v1/templates/{id}", on(FeatureCardTemplates, templateHandler.UpdateCardTemplate)).Methods("PUT")
+	router.HandleFunc("/api/v1/templates/{id}", on(FeatureCardTemplates, templateHandler.DeleteCardTemplate)).Methods("DELETE")
+
+	router.HandleFunc("/api/v1/notifications", on(FeatureNotifications, notificationHandler.NotifyCard)).Methods("POST")
+	router.HandleFunc("/api/v1/notifications", on(FeatureNotifications, notificationHandler.GetNotifications)).Methods("GET")
+	router.HandleFunc("/api/v1/notifications/read", on(FeatureNotifications, notificationHandler.MarkNotificationsRead)).Methods("POST")
+
+	router.HandleFunc("/api/v1/calendar/token", on(FeatureCalendar, calendarHandler.RegenerateCalendarToken)).Methods("POST")
+	router.HandleFunc("/api/v1/calendar/{token}/cards", on(FeatureCalendar, calendarHandler.GetCalendarCards)).Methods("GET")
+}
+
+func notImplemented(feature string) http.HandlerFunc {
+	msg := fmt.Sprintf("%s not supported by the storage backend", feature)
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, msg, http.StatusNotImplemented)
+	}
 }
@@ -0,0 +1,38 @@
+package v1_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	api "todo/internal/api/v1"
+
+	"github.com/gorilla/mux"
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/runner"
+)
+
+func TestLeftOutRoutes(t *testing.T) {
+	runner.Run(t, "TestLeftOutRoutes", func(pt provider.T) {
+		router := mux.NewRouter()
+		api.InitializeV1Routes(router, []string{api.FeatureSprints, api.FeatureTimeTracking},
+			nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+		tests := []struct {
+			name   string
+			method string
+			target string
+		}{
+			{name: "sprints", method: http.MethodGet, target: "/api/v1/sprints"},
+			{name: "timer", method: http.MethodPost, target: "/api/v1/timer/start"},
+		}
+
+		for _, tt := range tests {
+			pt.WithNewStep("Call "+tt.name, func(sCtx provider.StepCtx) {
+				w := httptest.NewRecorder()
+				router.ServeHTTP(w, httptest.NewRequest(tt.method, tt.target, nil))
+
+				sCtx.Assert().Equal(http.StatusNotImplemented, w.Code)
+			})
+		}
+	})
+}
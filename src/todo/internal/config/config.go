@@ -18,8 +18,7 @@ type LogConfig struct {
 }
 
 type PaginationConfig struct {
-	Limit  int `toml:"limit"`
-	Offset int `toml:"offset"`
+	Limit int `toml:"limit"`
 }
 
 type TodoConfig struct {
@@ -31,6 +30,9 @@ type TodoConfig struct {
 	ExposedPort   int            `toml:"exposed_port"`
 	Log           LogConfig      `toml:"log"`
 	Postgres      PostgresConfig `toml:"postgres"`
+	Mongo         MongoConfig    `toml:"mongo"`
+	SQLite        SQLiteConfig   `toml:"sqlite"`
+	Quota         QuotaConfig    `toml:"quota"`
 }
 
 type PostgresConfig struct {
@@ -42,6 +44,32 @@ type PostgresConfig struct {
 	SSLMode  string `toml:"sslmode"`
 }
 
+// MongoConfig names the replica set to join, which Mongo needs for the
+// transactions units of work run in. The user and password may be left out
+// for a server without authentication.
+type MongoConfig struct {
+	Host       string `toml:"host"`
+	Port       int    `toml:"port"`
+	User       string `toml:"user"`
+	Password   string `toml:"password"`
+	DBName     string `toml:"dbname"`
+	ReplicaSet string `toml:"replica_set"`
+}
+
+// SQLiteConfig names the database file, made on first start.
+type SQLiteConfig struct {
+	Path string `toml:"path"`
+}
+
+// QuotaConfig bounds what every user may keep unless an admin overrides it
+// for them. A bound of zero, or one left out, is no bound.
+type QuotaConfig struct {
+	BoardsPerUser     int `toml:"boards_per_user"`
+	ColumnsPerBoard   int `toml:"columns_per_board"`
+	CardsPerColumn    int `toml:"cards_per_column"`
+	DescriptionLength int `toml:"description_length"`
+}
+
 func LoadConfig(configPath string) (*Config, error) {
 	var config Config
 
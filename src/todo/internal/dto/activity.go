@@ -0,0 +1,47 @@
+package dto
+
+import (
+	"time"
+	"todo/internal/entity"
+
+	"github.com/google/uuid"
+)
+
+// Activity is sent as the data of a board event; the event id and type are
+// its ID and Kind.
+type Activity struct {
+	ID        int64     `json:"id"`
+	BoardID   uuid.UUID `json:"board_id"`
+	Kind      string    `json:"kind"`
+	Board     *Board    `json:"board,omitempty"`
+	Column    *Column   `json:"column,omitempty"`
+	Swimlane  *Swimlane `json:"swimlane,omitempty"`
+	Card      *Card     `json:"card,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+func ToActivityDTO(activity *entity.Activity) Activity {
+	activityDTO := Activity{
+		ID:        activity.ID,
+		BoardID:   activity.BoardID,
+		Kind:      activity.Kind,
+		CreatedAt: activity.CreatedAt,
+	}
+	if activity.Board != nil {
+		board := ToBoardDTO(activity.Board)
+		activityDTO.Board = &board
+	}
+	if activity.Column != nil {
+		column := ToColumnDTO(activity.Column)
+		activityDTO.Column = &column
+	}
+	if activity.Swimlane != nil {
+		swimlane := ToSwimlaneDTO(activity.Swimlane)
+		activityDTO.Swimlane = &swimlane
+	}
+	if activity.Card != nil {
+		card := ToCardDTO(activity.Card)
+		activityDTO.Card = &card
+	}
+	return activityDTO
+}
@@ -0,0 +1,79 @@
+package dto
+
+import (
+	"time"
+	"todo/internal/entity"
+
+	"github.com/google/uuid"
+)
+
+// FlowTimes has its durations in seconds.
+type FlowTimes struct {
+	Cards   int   `json:"cards"`
+	Average int64 `json:"average"`
+	P50     int64 `json:"p50"`
+	P85     int64 `json:"p85"`
+	P95     int64 `json:"p95"`
+}
+
+type WeekThroughput struct {
+	WeekStart time.Time `json:"week_start"`
+	Cards     int       `json:"cards"`
+}
+
+type FlowSeries struct {
+	ColumnID    uuid.UUID `json:"column_id"`
+	ColumnTitle string    `json:"column_title"`
+	Counts      []int     `json:"counts"`
+}
+
+// BoardAnalytics covers [From, To); each Flow series has a count per day of
+// Days.
+type BoardAnalytics struct {
+	BoardID    uuid.UUID        `json:"board_id"`
+	From       time.Time        `json:"from"`
+	To         time.Time        `json:"to"`
+	LeadTime   FlowTimes        `json:"lead_time"`
+	CycleTime  FlowTimes        `json:"cycle_time"`
+	Throughput []WeekThroughput `json:"throughput"`
+	Days       []time.Time      `json:"days"`
+	Flow       []FlowSeries     `json:"flow"`
+}
+
+func toFlowTimesDTO(times entity.FlowTimes) FlowTimes {
+	return FlowTimes{
+		Cards:   times.Cards,
+		Average: int64(times.Average.Seconds()),
+		P50:     int64(times.P50.Seconds()),
+		P85:     int64(times.P85.Seconds()),
+		P95:     int64(times.P95.Seconds()),
+	}
+}
+
+func ToBoardAnalyticsDTO(analytics *entity.BoardAnalytics) BoardAnalytics {
+	throughput := make([]WeekThroughput, len(analytics.Throughput))
+	for i, w := range analytics.Throughput {
+		throughput[i] = WeekThroughput{WeekStart: w.WeekStart, Cards: w.Cards}
+	}
+
+	flow := make([]FlowSeries, len(analytics.Flow))
+	for i, s := range analytics.Flow {
+		flow[i] = FlowSeries{ColumnID: s.ColumnID, ColumnTitle: s.ColumnTitle, Counts: s.Counts}
+	}
+
+	days := analytics.Days
+	if days == nil {
+		days = []time.Time{}
+	}
+
+	return BoardAnalytics{
+		BoardID:    analytics.BoardID,
+		From:       analytics.From,
+		To:         analytics.To,
+		LeadTime:   toFlowTimesDTO(analytics.LeadTime),
+		CycleTime:  toFlowTimesDTO(analytics.CycleTime),
+		Throughput: throughput,
+		Days:       days,
+		Flow:       flow,
+	}
+}
@@ -6,14 +6,19 @@ import (
 	"github.com/google/uuid"
 )
 
+// CreateBoardRequest puts the board in the personal workspace of the user
+// when it has no workspace ID.
 type CreateBoardRequest struct {
-	UserID uuid.UUID `json:"user_id"`
-	Title  string    `json:"title"`
+	UserID      uuid.UUID  `json:"user_id"`
+	WorkspaceID *uuid.UUID `json:"workspace_id,omitempty"`
+	Title       string     `json:"title"`
 }
 
 type Board struct {
-	ID    uuid.UUID `json:"id"`
-	Title string    `json:"title"`
+	ID          uuid.UUID `json:"id"`
+	WorkspaceID uuid.UUID `json:"workspace_id"`
+	Title       string    `json:"title"`
+	Version     int       `json:"version"`
 }
 
 type UpdateBoardRequest struct {
@@ -22,8 +27,10 @@ type UpdateBoardRequest struct {
 
 func ToBoardDTO(board *entity.Board) Board {
 	return Board{
-		ID:    board.ID,
-		Title: board.Title,
+		ID:          board.ID,
+		WorkspaceID: board.WorkspaceID,
+		Title:       board.Title,
+		Version:     board.Version,
 	}
 }
 
@@ -34,3 +41,28 @@ func ToBoardDTOs(boards []entity.Board) []Board {
 	}
 	return boardDTOs
 }
+
+type MergeBoardsRequest struct {
+	UserID   uuid.UUID `json:"user_id"`
+	SourceID uuid.UUID `json:"source_id"`
+	TargetID uuid.UUID `json:"target_id"`
+	Strategy string    `json:"strategy"`
+}
+
+type BoardMerge struct {
+	SourceID uuid.UUID `json:"source_id"`
+	TargetID uuid.UUID `json:"target_id"`
+	Strategy string    `json:"strategy"`
+	Moved    []Column  `json:"moved"`
+	Combined []Column  `json:"combined"`
+}
+
+func ToBoardMergeDTO(merge *entity.BoardMerge) BoardMerge {
+	return BoardMerge{
+		SourceID: merge.SourceID,
+		TargetID: merge.TargetID,
+		Strategy: merge.Strategy,
+		Moved:    ToColumnDTOs(merge.Moved),
+		Combined: ToColumnDTOs(merge.Combined),
+	}
+}
@@ -0,0 +1,37 @@
+package dto
+
+import (
+	"time"
+	"todo/internal/entity"
+
+	"github.com/google/uuid"
+)
+
+type BoardMarkRequest struct {
+	UserID uuid.UUID `json:"user_id"`
+}
+
+// MarkedBoard is a board with the marks the user asking left on it.
+type MarkedBoard struct {
+	Board
+	Starred   bool       `json:"starred"`
+	StarredAt *time.Time `json:"starred_at,omitempty"`
+	ViewedAt  *time.Time `json:"viewed_at,omitempty"`
+}
+
+func ToMarkedBoardDTO(board *entity.MarkedBoard) MarkedBoard {
+	return MarkedBoard{
+		Board:     ToBoardDTO(&board.Board),
+		Starred:   board.StarredAt != nil,
+		StarredAt: board.StarredAt,
+		ViewedAt:  board.ViewedAt,
+	}
+}
+
+func ToMarkedBoardDTOs(boards []entity.MarkedBoard) []MarkedBoard {
+	boardDTOs := make([]MarkedBoard, len(boards))
+	for i, board := range boards {
+		boardDTOs[i] = ToMarkedBoardDTO(&board)
+	}
+	return boardDTOs
+}
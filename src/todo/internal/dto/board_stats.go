@@ -0,0 +1,91 @@
+package dto
+
+import (
+	"time"
+	"todo/internal/entity"
+
+	"github.com/google/uuid"
+)
+
+type ColumnCards struct {
+	ColumnID    uuid.UUID `json:"column_id"`
+	ColumnTitle string    `json:"column_title"`
+	Done        bool      `json:"done"`
+	Cards       int       `json:"cards"`
+}
+
+type DayCards struct {
+	Day       time.Time `json:"day"`
+	Created   int       `json:"created"`
+	Completed int       `json:"completed"`
+}
+
+type StaleCard struct {
+	CardID      uuid.UUID  `json:"card_id"`
+	ColumnID    uuid.UUID  `json:"column_id"`
+	ColumnTitle string     `json:"column_title"`
+	Title       string     `json:"title"`
+	AssigneeID  *uuid.UUID `json:"assignee_id,omitempty"`
+	UpdatedAt   time.Time  `json:"updated_at"`
+}
+
+// Contributor counts the cards a user created and the cards assigned to
+// them completed.
+type Contributor struct {
+	UserID    uuid.UUID `json:"user_id"`
+	Created   int       `json:"created"`
+	Completed int       `json:"completed"`
+}
+
+// BoardStats covers [From, To) with a count per day in Days; Columns and
+// Stale are as of now.
+type BoardStats struct {
+	BoardID      uuid.UUID     `json:"board_id"`
+	From         time.Time     `json:"from"`
+	To           time.Time     `json:"to"`
+	Columns      []ColumnCards `json:"columns"`
+	Days         []DayCards    `json:"days"`
+	StaleDays    int           `json:"stale_days"`
+	Stale        []StaleCard   `json:"stale"`
+	Contributors []Contributor `json:"contributors"`
+}
+
+func ToBoardStatsDTO(stats *entity.BoardStats) BoardStats {
+	columns := make([]ColumnCards, len(stats.Columns))
+	for i, c := range stats.Columns {
+		columns[i] = ColumnCards{ColumnID: c.ColumnID, ColumnTitle: c.ColumnTitle, Done: c.Done, Cards: c.Cards}
+	}
+
+	days := make([]DayCards, len(stats.Days))
+	for i, d := range stats.Days {
+		days[i] = DayCards{Day: d.Day, Created: d.Created, Completed: d.Completed}
+	}
+
+	stale := make([]StaleCard, len(stats.Stale))
+	for i, c := range stats.Stale {
+		stale[i] = StaleCard{
+			CardID:      c.CardID,
+			ColumnID:    c.ColumnID,
+			ColumnTitle: c.ColumnTitle,
+			Title:       c.Title,
+			AssigneeID:  c.AssigneeID,
+			UpdatedAt:   c.UpdatedAt,
+		}
+	}
+
+	contributors := make([]Contributor, len(stats.Contributors))
+	for i, c := range stats.Contributors {
+		contributors[i] = Contributor{UserID: c.UserID, Created: c.Created, Completed: c.Completed}
+	}
+
+	return BoardStats{
+		BoardID:      stats.BoardID,
+		From:         stats.From,
+		To:           stats.To,
+		Columns:      columns,
+		Days:         days,
+		StaleDays:    stats.StaleDays,
+		Stale:        stale,
+		Contributors: contributors,
+	}
+}
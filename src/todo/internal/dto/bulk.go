@@ -0,0 +1,71 @@
+package dto
+
+import (
+	"todo/internal/repository"
+
+	"github.com/google/uuid"
+)
+
+// CardOp is one item of a bulk request. Op is one of move, archive, delete,
+// set_label and set_assignee; an empty assignee_id unassigns the card.
+// Archiving a card with active sub-cards needs cascade, true to archive them
+// too and false to leave them be.
+type CardOp struct {
+	Op         string    `json:"op"`
+	CardID     uuid.UUID `json:"card_id"`
+	Version    int       `json:"version,omitempty"`
+	ColumnID   uuid.UUID `json:"column_id,omitempty"`
+	Label      string    `json:"label,omitempty"`
+	AssigneeID uuid.UUID `json:"assignee_id,omitempty"`
+	Cascade    *bool     `json:"cascade,omitempty"`
+}
+
+type BulkCardsRequest struct {
+	AllOrNothing bool     `json:"all_or_nothing"`
+	Ops          []CardOp `json:"ops"`
+}
+
+// CardOpResult reports one item of a bulk request. Status is applied, failed
+// or rolled_back; the latter marks items undone or skipped because another
+// item of an all-or-nothing request failed.
+type CardOpResult struct {
+	Index  int       `json:"index"`
+	CardID uuid.UUID `json:"card_id"`
+	Status string    `json:"status"`
+	Error  string    `json:"error,omitempty"`
+}
+
+type BulkCardsResponse struct {
+	Results []CardOpResult `json:"results"`
+}
+
+func ToCardOps(opDTOs []CardOp) []repository.CardOp {
+	ops := make([]repository.CardOp, len(opDTOs))
+	for i, op := range opDTOs {
+		ops[i] = repository.CardOp{
+			Kind:       repository.CardOpKind(op.Op),
+			CardID:     op.CardID,
+			Version:    op.Version,
+			ColumnID:   op.ColumnID,
+			Label:      op.Label,
+			AssigneeID: op.AssigneeID,
+			Cascade:    op.Cascade,
+		}
+	}
+	return ops
+}
+
+func ToBulkCardsResponse(opDTOs []CardOp, results []repository.CardOpResult) BulkCardsResponse {
+	resultDTOs := make([]CardOpResult, len(results))
+	for i, result := range results {
+		resultDTOs[i] = CardOpResult{
+			Index:  i,
+			CardID: opDTOs[i].CardID,
+			Status: string(result.Status),
+		}
+		if result.Err != nil {
+			resultDTOs[i].Error = result.Err.Error()
+		}
+	}
+	return BulkCardsResponse{Results: resultDTOs}
+}
@@ -0,0 +1,60 @@
+package dto
+
+import (
+	"time"
+	"todo/internal/entity"
+
+	"github.com/google/uuid"
+)
+
+type RegenerateCalendarTokenRequest struct {
+	UserID uuid.UUID `json:"user_id"`
+}
+
+// CalendarToken carries the token itself, which is not kept anywhere: it is
+// only ever seen in the response regenerating it.
+type CalendarToken struct {
+	UserID    uuid.UUID `json:"user_id"`
+	Token     string    `json:"token"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type CalendarCard struct {
+	CardID      uuid.UUID `json:"card_id"`
+	BoardID     uuid.UUID `json:"board_id"`
+	BoardTitle  string    `json:"board_title"`
+	Title       string    `json:"title"`
+	Description string    `json:"description,omitempty"`
+	DueDate     time.Time `json:"due_date"`
+	Done        bool      `json:"done"`
+	Version     int       `json:"version"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+func ToCalendarTokenDTO(token string, calendarToken *entity.CalendarToken) CalendarToken {
+	return CalendarToken{
+		UserID:    calendarToken.UserID,
+		Token:     token,
+		CreatedAt: calendarToken.CreatedAt,
+	}
+}
+
+func ToCalendarCardDTOs(cards []entity.CalendarCard) []CalendarCard {
+	cardDTOs := make([]CalendarCard, len(cards))
+	for i, c := range cards {
+		cardDTOs[i] = CalendarCard{
+			CardID:      c.CardID,
+			BoardID:     c.BoardID,
+			BoardTitle:  c.BoardTitle,
+			Title:       c.Title,
+			Description: c.Description,
+			DueDate:     c.DueDate,
+			Done:        c.Done,
+			Version:     c.Version,
+			CreatedAt:   c.CreatedAt,
+			UpdatedAt:   c.UpdatedAt,
+		}
+	}
+	return cardDTOs
+}
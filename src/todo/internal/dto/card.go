@@ -8,29 +8,64 @@ import (
 )
 
 type CreateCardRequest struct {
-	UserID      uuid.UUID `json:"user_id"`
-	ColumnID    uuid.UUID `json:"column_id"`
-	Title       string    `json:"title"`
-	Description string    `json:"description,omitempty"`
-	Position    float64   `json:"position"`
+	UserID      uuid.UUID  `json:"user_id"`
+	ColumnID    uuid.UUID  `json:"column_id"`
+	SwimlaneID  uuid.UUID  `json:"swimlane_id,omitempty"`
+	ParentID    uuid.UUID  `json:"parent_id,omitempty"`
+	Title       string     `json:"title"`
+	Description string     `json:"description,omitempty"`
+	Position    float64    `json:"position"`
+	Priority    int        `json:"priority,omitempty"`
+	AssigneeID  uuid.UUID  `json:"assignee_id,omitempty"`
+	DueDate     *time.Time `json:"due_date,omitempty"`
+	Labels      []string   `json:"labels,omitempty"`
+
+	// TemplateID makes the card from a template of its board, {{user}}
+	// filled in with Username.
+	TemplateID *uuid.UUID `json:"template_id,omitempty"`
+	Username   string     `json:"username,omitempty"`
 }
 
 type Card struct {
-	ID          uuid.UUID `json:"id"`
-	UserID      uuid.UUID `json:"user_id"`
-	ColumnID    uuid.UUID `json:"column_id"`
-	Title       string    `json:"title"`
-	Description string    `json:"description,omitempty"`
-	Position    float64   `json:"position"`
-	CreatedAt   time.Time `json:"created_at"`
+	ID          uuid.UUID  `json:"id"`
+	UserID      uuid.UUID  `json:"user_id"`
+	ColumnID    uuid.UUID  `json:"column_id"`
+	SwimlaneID  uuid.UUID  `json:"swimlane_id"`
+	ParentID    uuid.UUID  `json:"parent_id,omitempty"`
+	Title       string     `json:"title"`
+	Description string     `json:"description,omitempty"`
+	Position    float64    `json:"position"`
+	Priority    int        `json:"priority"`
+	AssigneeID  uuid.UUID  `json:"assignee_id,omitempty"`
+	DueDate     *time.Time `json:"due_date,omitempty"`
+	Labels      []string   `json:"labels,omitempty"`
+	ArchivedAt  *time.Time `json:"archived_at,omitempty"`
+	Version     int        `json:"version"`
+	CreatedAt   time.Time  `json:"created_at"`
+	UpdatedAt   time.Time  `json:"updated_at"`
+
+	ChildCount     int `json:"child_count"`
+	DoneChildCount int `json:"done_child_count"`
 }
 
 type UpdateCardRequest struct {
-	ID          uuid.UUID `json:"id"`
-	ColumnID    uuid.UUID `json:"column_id,omitempty"`
-	Title       string    `json:"title,omitempty"`
-	Description string    `json:"description,omitempty"`
-	Position    float64   `json:"position,omitempty"`
+	ID          uuid.UUID  `json:"id"`
+	ColumnID    uuid.UUID  `json:"column_id,omitempty"`
+	SwimlaneID  uuid.UUID  `json:"swimlane_id,omitempty"`
+	Title       string     `json:"title,omitempty"`
+	Description string     `json:"description,omitempty"`
+	Position    float64    `json:"position,omitempty"`
+	Priority    int        `json:"priority,omitempty"`
+	AssigneeID  uuid.UUID  `json:"assignee_id,omitempty"`
+	DueDate     *time.Time `json:"due_date,omitempty"`
+	Labels      []string   `json:"labels,omitempty"`
+}
+
+// SetCardParentRequest puts a card under another one; an empty parent_id
+// moves it back to the top level.
+type SetCardParentRequest struct {
+	ID       uuid.UUID `json:"id"`
+	ParentID uuid.UUID `json:"parent_id,omitempty"`
 }
 
 func ToCardDTO(card *entity.Card) Card {
@@ -38,10 +73,22 @@ func ToCardDTO(card *entity.Card) Card {
 		ID:          card.ID,
 		UserID:      card.UserID,
 		ColumnID:    card.ColumnID,
+		SwimlaneID:  card.SwimlaneID,
+		ParentID:    card.ParentID,
 		Title:       card.Title,
 		Description: card.Description,
 		Position:    card.Position,
+		Priority:    card.Priority,
+		AssigneeID:  card.AssigneeID,
+		DueDate:     card.DueDate,
+		Labels:      card.Labels,
+		ArchivedAt:  card.ArchivedAt,
+		Version:     card.Version,
 		CreatedAt:   card.CreatedAt,
+		UpdatedAt:   card.UpdatedAt,
+
+		ChildCount:     card.ChildCount,
+		DoneChildCount: card.DoneChildCount,
 	}
 }
 
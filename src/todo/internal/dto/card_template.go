@@ -0,0 +1,83 @@
+package dto
+
+import (
+	"time"
+	"todo/internal/entity"
+
+	"github.com/google/uuid"
+)
+
+type Checklist struct {
+	Title string   `json:"title"`
+	Items []string `json:"items"`
+}
+
+// CardTemplateRequest creates a template of the board or, on update, sets
+// everything but the board of one.
+type CardTemplateRequest struct {
+	BoardID      uuid.UUID   `json:"board_id,omitempty"`
+	UserID       uuid.UUID   `json:"user_id,omitempty"`
+	Name         string      `json:"name"`
+	TitlePattern string      `json:"title_pattern,omitempty"`
+	Description  string      `json:"description,omitempty"`
+	Labels       []string    `json:"labels,omitempty"`
+	Checklists   []Checklist `json:"checklists,omitempty"`
+}
+
+type CardTemplate struct {
+	ID           uuid.UUID   `json:"id"`
+	BoardID      uuid.UUID   `json:"board_id"`
+	Name         string      `json:"name"`
+	TitlePattern string      `json:"title_pattern,omitempty"`
+	Description  string      `json:"description,omitempty"`
+	Labels       []string    `json:"labels,omitempty"`
+	Checklists   []Checklist `json:"checklists,omitempty"`
+	CreatedBy    uuid.UUID   `json:"created_by"`
+	CreatedAt    time.Time   `json:"created_at"`
+	UpdatedAt    time.Time   `json:"updated_at"`
+}
+
+func ToCardTemplateEntity(req CardTemplateRequest) *entity.CardTemplate {
+	template := &entity.CardTemplate{
+		BoardID:      req.BoardID,
+		Name:         req.Name,
+		TitlePattern: req.TitlePattern,
+		Description:  req.Description,
+		Labels:       req.Labels,
+		CreatedBy:    req.UserID,
+	}
+
+	for _, c := range req.Checklists {
+		template.Checklists = append(template.Checklists, entity.Checklist{Title: c.Title, Items: c.Items})
+	}
+
+	return template
+}
+
+func ToCardTemplateDTO(template *entity.CardTemplate) CardTemplate {
+	templateDTO := CardTemplate{
+		ID:           template.ID,
+		BoardID:      template.BoardID,
+		Name:         template.Name,
+		TitlePattern: template.TitlePattern,
+		Description:  template.Description,
+		Labels:       template.Labels,
+		CreatedBy:    template.CreatedBy,
+		CreatedAt:    template.CreatedAt,
+		UpdatedAt:    template.UpdatedAt,
+	}
+
+	for _, c := range template.Checklists {
+		templateDTO.Checklists = append(templateDTO.Checklists, Checklist{Title: c.Title, Items: c.Items})
+	}
+
+	return templateDTO
+}
+
+func ToCardTemplateDTOs(templates []entity.CardTemplate) []CardTemplate {
+	templateDTOs := make([]CardTemplate, len(templates))
+	for i, template := range templates {
+		templateDTOs[i] = ToCardTemplateDTO(&template)
+	}
+	return templateDTOs
+}
@@ -11,6 +11,7 @@ type CreateColumnRequest struct {
 	BoardID  uuid.UUID `json:"board_id"`
 	Title    string    `json:"title"`
 	Position float64   `json:"position"`
+	Done     bool      `json:"done,omitempty"`
 }
 
 type Column struct {
@@ -18,12 +19,23 @@ type Column struct {
 	BoardID  uuid.UUID `json:"board_id"`
 	Title    string    `json:"title"`
 	Position float64   `json:"position"`
+	Done     bool      `json:"done"`
+	Version  int       `json:"version"`
 }
 
 type UpdateColumnRequest struct {
 	ID       uuid.UUID `json:"id"`
 	Title    string    `json:"title,omitempty"`
 	Position float64   `json:"position,omitempty"`
+	Done     bool      `json:"done,omitempty"`
+}
+
+// MoveColumnRequest moves a column to the end of another board; the column
+// version goes in the If-Match header.
+type MoveColumnRequest struct {
+	ID      uuid.UUID `json:"id"`
+	UserID  uuid.UUID `json:"user_id"`
+	BoardID uuid.UUID `json:"board_id"`
 }
 
 func ToColumnDTO(column *entity.Column) Column {
@@ -32,6 +44,8 @@ func ToColumnDTO(column *entity.Column) Column {
 		BoardID:  column.BoardID,
 		Title:    column.Title,
 		Position: column.Position,
+		Done:     column.Done,
+		Version:  column.Version,
 	}
 }
 
@@ -0,0 +1,64 @@
+package dto
+
+import (
+	"time"
+	"todo/internal/entity"
+
+	"github.com/google/uuid"
+)
+
+type WatchRequest struct {
+	UserID uuid.UUID `json:"user_id"`
+}
+
+// NotifyCardRequest asks for the users mentioned and the watchers of a card
+// to be told of what the actor did on it.
+type NotifyCardRequest struct {
+	ActorID   uuid.UUID   `json:"actor_id"`
+	CardID    uuid.UUID   `json:"card_id"`
+	Kind      string      `json:"kind"`
+	Mentioned []uuid.UUID `json:"mentioned,omitempty"`
+}
+
+// MarkNotificationsReadRequest marks the notifications with the ids, or all
+// of them without any, read.
+type MarkNotificationsReadRequest struct {
+	UserID uuid.UUID   `json:"user_id"`
+	IDs    []uuid.UUID `json:"ids,omitempty"`
+}
+
+type Notification struct {
+	ID        uuid.UUID  `json:"id"`
+	UserID    uuid.UUID  `json:"user_id"`
+	ActorID   uuid.UUID  `json:"actor_id"`
+	Kind      string     `json:"kind"`
+	BoardID   uuid.UUID  `json:"board_id"`
+	CardID    uuid.UUID  `json:"card_id"`
+	CardTitle string     `json:"card_title"`
+	Read      bool       `json:"read"`
+	ReadAt    *time.Time `json:"read_at,omitempty"`
+	CreatedAt time.Time  `json:"created_at"`
+}
+
+func ToNotificationDTO(notification *entity.Notification) Notification {
+	return Notification{
+		ID:        notification.ID,
+		UserID:    notification.UserID,
+		ActorID:   notification.ActorID,
+		Kind:      notification.Kind,
+		BoardID:   notification.BoardID,
+		CardID:    notification.CardID,
+		CardTitle: notification.CardTitle,
+		Read:      notification.ReadAt != nil,
+		ReadAt:    notification.ReadAt,
+		CreatedAt: notification.CreatedAt,
+	}
+}
+
+func ToNotificationDTOs(notifications []entity.Notification) []Notification {
+	notificationDTOs := make([]Notification, len(notifications))
+	for i, notification := range notifications {
+		notificationDTOs[i] = ToNotificationDTO(&notification)
+	}
+	return notificationDTOs
+}
@@ -0,0 +1,74 @@
+package dto
+
+import (
+	"time"
+	"todo/internal/entity"
+
+	"github.com/google/uuid"
+)
+
+// Quota bounds what a user may keep; zero is no bound.
+type Quota struct {
+	BoardsPerUser     int `json:"boards_per_user"`
+	ColumnsPerBoard   int `json:"columns_per_board"`
+	CardsPerColumn    int `json:"cards_per_column"`
+	DescriptionLength int `json:"description_length"`
+}
+
+// QuotaOverrideRequest sets the bounds it has for the user; the ones it
+// leaves out stay as configured.
+type QuotaOverrideRequest struct {
+	BoardsPerUser     *int `json:"boards_per_user,omitempty"`
+	ColumnsPerBoard   *int `json:"columns_per_board,omitempty"`
+	CardsPerColumn    *int `json:"cards_per_column,omitempty"`
+	DescriptionLength *int `json:"description_length,omitempty"`
+}
+
+type QuotaOverride struct {
+	QuotaOverrideRequest
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// UserQuota is the quota the user is held to and the override it comes
+// from, if any.
+type UserQuota struct {
+	UserID   uuid.UUID      `json:"user_id"`
+	Quota    Quota          `json:"quota"`
+	Override *QuotaOverride `json:"override,omitempty"`
+}
+
+func ToQuotaOverrideEntity(userID uuid.UUID, req QuotaOverrideRequest) *entity.QuotaOverride {
+	return &entity.QuotaOverride{
+		UserID:            userID,
+		BoardsPerUser:     req.BoardsPerUser,
+		ColumnsPerBoard:   req.ColumnsPerBoard,
+		CardsPerColumn:    req.CardsPerColumn,
+		DescriptionLength: req.DescriptionLength,
+	}
+}
+
+func ToUserQuotaDTO(quota *entity.UserQuota) UserQuota {
+	quotaDTO := UserQuota{
+		UserID: quota.UserID,
+		Quota: Quota{
+			BoardsPerUser:     quota.Quota.BoardsPerUser,
+			ColumnsPerBoard:   quota.Quota.ColumnsPerBoard,
+			CardsPerColumn:    quota.Quota.CardsPerColumn,
+			DescriptionLength: quota.Quota.DescriptionLength,
+		},
+	}
+
+	if o := quota.Override; o != nil {
+		quotaDTO.Override = &QuotaOverride{
+			QuotaOverrideRequest: QuotaOverrideRequest{
+				BoardsPerUser:     o.BoardsPerUser,
+				ColumnsPerBoard:   o.ColumnsPerBoard,
+				CardsPerColumn:    o.CardsPerColumn,
+				DescriptionLength: o.DescriptionLength,
+			},
+			UpdatedAt: o.UpdatedAt,
+		}
+	}
+
+	return quotaDTO
+}
@@ -0,0 +1,109 @@
+package dto
+
+import (
+	"time"
+	"todo/internal/entity"
+
+	"github.com/google/uuid"
+)
+
+type CreateSprintRequest struct {
+	BoardID   uuid.UUID `json:"board_id"`
+	Name      string    `json:"name"`
+	StartDate time.Time `json:"start_date"`
+	EndDate   time.Time `json:"end_date"`
+}
+
+type Sprint struct {
+	ID        uuid.UUID  `json:"id"`
+	BoardID   uuid.UUID  `json:"board_id"`
+	Name      string     `json:"name"`
+	StartDate time.Time  `json:"start_date"`
+	EndDate   time.Time  `json:"end_date"`
+	ClosedAt  *time.Time `json:"closed_at,omitempty"`
+	CreatedAt time.Time  `json:"created_at"`
+}
+
+type AddSprintCardRequest struct {
+	CardID uuid.UUID `json:"card_id"`
+	Points int       `json:"points"`
+}
+
+type SprintCard struct {
+	SprintID  uuid.UUID `json:"sprint_id"`
+	CardID    uuid.UUID `json:"card_id"`
+	CardTitle string    `json:"card_title,omitempty"`
+	Points    int       `json:"points"`
+	Done      bool      `json:"done"`
+	AddedAt   time.Time `json:"added_at"`
+}
+
+// CloseSprintRequest names the sprint to carry unfinished cards over to; by
+// default it is the next open sprint of the board.
+type CloseSprintRequest struct {
+	NextSprintID *uuid.UUID `json:"next_sprint_id,omitempty"`
+}
+
+type CloseSprintResponse struct {
+	NextSprint *Sprint `json:"next_sprint,omitempty"`
+	Carried    int     `json:"carried"`
+}
+
+type BurndownPoint struct {
+	Day         time.Time `json:"day"`
+	Cards       int       `json:"cards"`
+	Points      int       `json:"points"`
+	IdealPoints float64   `json:"ideal_points"`
+}
+
+func ToSprintDTO(sprint *entity.Sprint) Sprint {
+	return Sprint{
+		ID:        sprint.ID,
+		BoardID:   sprint.BoardID,
+		Name:      sprint.Name,
+		StartDate: sprint.StartDate,
+		EndDate:   sprint.EndDate,
+		ClosedAt:  sprint.ClosedAt,
+		CreatedAt: sprint.CreatedAt,
+	}
+}
+
+func ToSprintDTOs(sprints []entity.Sprint) []Sprint {
+	sprintDTOs := make([]Sprint, len(sprints))
+	for i, sprint := range sprints {
+		sprintDTOs[i] = ToSprintDTO(&sprint)
+	}
+	return sprintDTOs
+}
+
+func ToSprintCardDTO(card *entity.SprintCard) SprintCard {
+	return SprintCard{
+		SprintID:  card.SprintID,
+		CardID:    card.CardID,
+		CardTitle: card.CardTitle,
+		Points:    card.Points,
+		Done:      card.Done,
+		AddedAt:   card.AddedAt,
+	}
+}
+
+func ToSprintCardDTOs(cards []entity.SprintCard) []SprintCard {
+	cardDTOs := make([]SprintCard, len(cards))
+	for i, card := range cards {
+		cardDTOs[i] = ToSprintCardDTO(&card)
+	}
+	return cardDTOs
+}
+
+func ToBurndownDTOs(points []entity.BurndownPoint) []BurndownPoint {
+	pointDTOs := make([]BurndownPoint, len(points))
+	for i, p := range points {
+		pointDTOs[i] = BurndownPoint{
+			Day:         p.Day,
+			Cards:       p.Cards,
+			Points:      p.Points,
+			IdealPoints: p.IdealPoints,
+		}
+	}
+	return pointDTOs
+}
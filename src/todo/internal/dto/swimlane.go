@@ -0,0 +1,46 @@
+package dto
+
+import (
+	"todo/internal/entity"
+
+	"github.com/google/uuid"
+)
+
+type CreateSwimlaneRequest struct {
+	UserID   uuid.UUID `json:"user_id"`
+	BoardID  uuid.UUID `json:"board_id"`
+	Title    string    `json:"title"`
+	Position float64   `json:"position"`
+}
+
+type Swimlane struct {
+	ID       uuid.UUID `json:"id"`
+	BoardID  uuid.UUID `json:"board_id"`
+	Title    string    `json:"title"`
+	Position float64   `json:"position"`
+	Version  int       `json:"version"`
+}
+
+type UpdateSwimlaneRequest struct {
+	ID       uuid.UUID `json:"id"`
+	Title    string    `json:"title,omitempty"`
+	Position float64   `json:"position,omitempty"`
+}
+
+func ToSwimlaneDTO(swimlane *entity.Swimlane) Swimlane {
+	return Swimlane{
+		ID:       swimlane.ID,
+		BoardID:  swimlane.BoardID,
+		Title:    swimlane.Title,
+		Position: swimlane.Position,
+		Version:  swimlane.Version,
+	}
+}
+
+func ToSwimlaneDTOs(swimlanes []entity.Swimlane) []Swimlane {
+	swimlaneDTOs := make([]Swimlane, len(swimlanes))
+	for i, swimlane := range swimlanes {
+		swimlaneDTOs[i] = ToSwimlaneDTO(&swimlane)
+	}
+	return swimlaneDTOs
+}
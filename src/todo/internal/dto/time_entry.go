@@ -0,0 +1,88 @@
+package dto
+
+import (
+	"time"
+	"todo/internal/entity"
+
+	"github.com/google/uuid"
+)
+
+type StartTimerRequest struct {
+	UserID uuid.UUID `json:"user_id"`
+	CardID uuid.UUID `json:"card_id"`
+	Note   string    `json:"note,omitempty"`
+}
+
+type StopTimerRequest struct {
+	UserID uuid.UUID `json:"user_id"`
+}
+
+type LogTimeRequest struct {
+	UserID    uuid.UUID `json:"user_id"`
+	CardID    uuid.UUID `json:"card_id"`
+	StartedAt time.Time `json:"started_at"`
+	EndedAt   time.Time `json:"ended_at"`
+	Note      string    `json:"note,omitempty"`
+}
+
+// TimeEntry has its Duration in seconds; a running entry has no EndedAt
+// and counts up to now.
+type TimeEntry struct {
+	ID        uuid.UUID  `json:"id"`
+	CardID    uuid.UUID  `json:"card_id"`
+	UserID    uuid.UUID  `json:"user_id"`
+	StartedAt time.Time  `json:"started_at"`
+	EndedAt   *time.Time `json:"ended_at,omitempty"`
+	Duration  int64      `json:"duration"`
+	Note      string     `json:"note,omitempty"`
+	CreatedAt time.Time  `json:"created_at"`
+}
+
+// TimeReportRow has its Duration in seconds.
+type TimeReportRow struct {
+	Day       time.Time `json:"day"`
+	UserID    uuid.UUID `json:"user_id"`
+	CardID    uuid.UUID `json:"card_id"`
+	CardTitle string    `json:"card_title"`
+	Duration  int64     `json:"duration"`
+}
+
+func ToTimeEntryDTO(entry *entity.TimeEntry) TimeEntry {
+	end := time.Now()
+	if entry.EndedAt != nil {
+		end = *entry.EndedAt
+	}
+
+	return TimeEntry{
+		ID:        entry.ID,
+		CardID:    entry.CardID,
+		UserID:    entry.UserID,
+		StartedAt: entry.StartedAt,
+		EndedAt:   entry.EndedAt,
+		Duration:  int64(end.Sub(entry.StartedAt).Seconds()),
+		Note:      entry.Note,
+		CreatedAt: entry.CreatedAt,
+	}
+}
+
+func ToTimeEntryDTOs(entries []entity.TimeEntry) []TimeEntry {
+	entryDTOs := make([]TimeEntry, len(entries))
+	for i, entry := range entries {
+		entryDTOs[i] = ToTimeEntryDTO(&entry)
+	}
+	return entryDTOs
+}
+
+func ToTimeReportDTOs(rows []entity.TimeReportRow) []TimeReportRow {
+	rowDTOs := make([]TimeReportRow, len(rows))
+	for i, row := range rows {
+		rowDTOs[i] = TimeReportRow{
+			Day:       row.Day,
+			UserID:    row.UserID,
+			CardID:    row.CardID,
+			CardTitle: row.CardTitle,
+			Duration:  int64(row.Duration.Seconds()),
+		}
+	}
+	return rowDTOs
+}
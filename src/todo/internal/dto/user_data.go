@@ -0,0 +1,15 @@
+package dto
+
+import "github.com/google/uuid"
+
+// TransferBoardRequest names the user a board goes to.
+type TransferBoardRequest struct {
+	UserID uuid.UUID `json:"user_id"`
+}
+
+// TransferUserBoardsRequest hands the boards with the ids, or all of them
+// without any, to the user UserID.
+type TransferUserBoardsRequest struct {
+	UserID   uuid.UUID   `json:"user_id"`
+	BoardIDs []uuid.UUID `json:"board_ids,omitempty"`
+}
@@ -0,0 +1,75 @@
+package dto
+
+import (
+	"time"
+	"todo/internal/entity"
+
+	"github.com/google/uuid"
+)
+
+type CreateWorkspaceRequest struct {
+	UserID uuid.UUID `json:"user_id"`
+	Name   string    `json:"name"`
+}
+
+type Workspace struct {
+	ID        uuid.UUID `json:"id"`
+	Name      string    `json:"name"`
+	Personal  bool      `json:"personal"`
+	CreatedBy uuid.UUID `json:"created_by"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// WorkspaceMembership is a workspace with the role the user asking has in
+// it.
+type WorkspaceMembership struct {
+	Workspace
+	Role string `json:"role"`
+}
+
+// WorkspaceMemberRequest adds a member or changes their role on behalf of
+// the admin ActorID.
+type WorkspaceMemberRequest struct {
+	ActorID uuid.UUID `json:"actor_id"`
+	UserID  uuid.UUID `json:"user_id"`
+	Role    string    `json:"role"`
+}
+
+type WorkspaceMember struct {
+	UserID    uuid.UUID `json:"user_id"`
+	Role      string    `json:"role"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+func ToWorkspaceDTO(workspace *entity.Workspace) Workspace {
+	return Workspace{
+		ID:        workspace.ID,
+		Name:      workspace.Name,
+		Personal:  workspace.Personal,
+		CreatedBy: workspace.CreatedBy,
+		CreatedAt: workspace.CreatedAt,
+	}
+}
+
+func ToWorkspaceMembershipDTOs(workspaces []entity.WorkspaceMembership) []WorkspaceMembership {
+	workspaceDTOs := make([]WorkspaceMembership, len(workspaces))
+	for i, workspace := range workspaces {
+		workspaceDTOs[i] = WorkspaceMembership{
+			Workspace: ToWorkspaceDTO(&workspace.Workspace),
+			Role:      workspace.Role,
+		}
+	}
+	return workspaceDTOs
+}
+
+func ToWorkspaceMemberDTOs(members []entity.WorkspaceMember) []WorkspaceMember {
+	memberDTOs := make([]WorkspaceMember, len(members))
+	for i, member := range members {
+		memberDTOs[i] = WorkspaceMember{
+			UserID:    member.UserID,
+			Role:      member.Role,
+			CreatedAt: member.CreatedAt,
+		}
+	}
+	return memberDTOs
+}
@@ -0,0 +1,39 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	ActivityBoardCreated    = "board.created"
+	ActivityBoardUpdated    = "board.updated"
+	ActivityBoardDeleted    = "board.deleted"
+	ActivityColumnCreated   = "column.created"
+	ActivityColumnUpdated   = "column.updated"
+	ActivityColumnDeleted   = "column.deleted"
+	ActivityColumnMoved     = "column.moved"
+	ActivitySwimlaneCreated = "swimlane.created"
+	ActivitySwimlaneUpdated = "swimlane.updated"
+	ActivitySwimlaneDeleted = "swimlane.deleted"
+	ActivityCardCreated     = "card.created"
+	ActivityCardUpdated     = "card.updated"
+	ActivityCardMoved       = "card.moved"
+	ActivityCardArchived    = "card.archived"
+	ActivityCardDeleted     = "card.deleted"
+)
+
+// Activity is a change made to a board or to something on it. Exactly one
+// of Board, Column, Swimlane and Card is set: the entity after the change, or as it
+// was before a deletion. IDs grow with every activity recorded.
+type Activity struct {
+	ID        int64
+	BoardID   uuid.UUID
+	Kind      string
+	Board     *Board
+	Column    *Column
+	Swimlane  *Swimlane
+	Card      *Card
+	CreatedAt time.Time
+}
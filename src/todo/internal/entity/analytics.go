@@ -0,0 +1,64 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// CardFlow is how a card went across a board: when it entered its first
+// column, when it first left it and when it first reached a done column.
+// StartedAt is nil for a card that was created done.
+type CardFlow struct {
+	CardID    uuid.UUID
+	CreatedAt time.Time
+	StartedAt *time.Time
+	DoneAt    time.Time
+}
+
+// ColumnCount is how many cards were in a column at the end of a day.
+type ColumnCount struct {
+	Day         time.Time
+	ColumnID    uuid.UUID
+	ColumnTitle string
+	Count       int
+}
+
+// FlowTimes sums up how long the cards finished in a period took.
+type FlowTimes struct {
+	Cards   int
+	Average time.Duration
+	P50     time.Duration
+	P85     time.Duration
+	P95     time.Duration
+}
+
+// WeekThroughput is how many cards were finished in the week starting on
+// Monday WeekStart.
+type WeekThroughput struct {
+	WeekStart time.Time
+	Cards     int
+}
+
+// FlowSeries is the number of cards in a column at the end of each day of a
+// cumulative flow diagram.
+type FlowSeries struct {
+	ColumnID    uuid.UUID
+	ColumnTitle string
+	Counts      []int
+}
+
+// BoardAnalytics describes the flow of cards across a board over [From, To).
+// Lead time runs from the creation of a card until it is done, cycle time
+// from when it first leaves its first column. Flow has a series per column
+// in board order, with a count per day of Days.
+type BoardAnalytics struct {
+	BoardID    uuid.UUID
+	From       time.Time
+	To         time.Time
+	LeadTime   FlowTimes
+	CycleTime  FlowTimes
+	Throughput []WeekThroughput
+	Days       []time.Time
+	Flow       []FlowSeries
+}
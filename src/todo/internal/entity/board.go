@@ -7,9 +7,11 @@ import (
 )
 
 type Board struct {
-	ID        uuid.UUID
-	UserID    uuid.UUID
-	Title     string
-	CreatedAt time.Time
-	UpdatedAt time.Time
+	ID          uuid.UUID
+	UserID      uuid.UUID
+	WorkspaceID uuid.UUID
+	Title       string
+	Version     int
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
 }
@@ -0,0 +1,29 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// BoardStar marks a board a user wants at hand.
+type BoardStar struct {
+	UserID    uuid.UUID
+	BoardID   uuid.UUID
+	CreatedAt time.Time
+}
+
+// BoardView is the last time a user opened a board.
+type BoardView struct {
+	UserID   uuid.UUID
+	BoardID  uuid.UUID
+	ViewedAt time.Time
+}
+
+// MarkedBoard is a board with the marks a user left on it: when they starred
+// it and when they last opened it, nil if they did not or not recently.
+type MarkedBoard struct {
+	Board
+	StarredAt *time.Time
+	ViewedAt  *time.Time
+}
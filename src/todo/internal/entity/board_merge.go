@@ -0,0 +1,30 @@
+package entity
+
+import "github.com/google/uuid"
+
+// Strategies of a board merge for a source column titled like a column of
+// the target board, letter case and surrounding spaces aside.
+const (
+	// MergeColumnsCombine moves the cards of the source column into the
+	// target column and drops the source column.
+	MergeColumnsCombine = "combine"
+	// MergeColumnsKeep moves the source column as it is, leaving two
+	// columns of that title.
+	MergeColumnsKeep = "keep"
+	// MergeColumnsRename moves the source column with the title of its
+	// board appended to its own.
+	MergeColumnsRename = "rename"
+)
+
+// BoardMerge is the outcome of moving every column of the source board into
+// the target one.
+type BoardMerge struct {
+	SourceID uuid.UUID
+	TargetID uuid.UUID
+	Strategy string
+	// Moved are the columns now on the target board, as they are there.
+	Moved []Column
+	// Combined are the source columns whose cards went to the target column
+	// of the same title, as they were before they were dropped.
+	Combined []Column
+}
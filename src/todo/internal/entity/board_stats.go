@@ -0,0 +1,73 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ColumnCards is how many active cards a column has now.
+type ColumnCards struct {
+	ColumnID    uuid.UUID
+	ColumnTitle string
+	Done        bool
+	Cards       int
+}
+
+// CreatedCard is a card that entered a board, made by UserID. UserID is
+// nil for a card deleted since.
+type CreatedCard struct {
+	CardID    uuid.UUID
+	UserID    *uuid.UUID
+	CreatedAt time.Time
+}
+
+// CompletedCard is a card that first reached a done column of a board at
+// DoneAt, assigned to AssigneeID if anyone.
+type CompletedCard struct {
+	CardID     uuid.UUID
+	AssigneeID *uuid.UUID
+	DoneAt     time.Time
+}
+
+// StaleCard is an active card outside the done columns that has not been
+// changed since UpdatedAt.
+type StaleCard struct {
+	CardID      uuid.UUID
+	ColumnID    uuid.UUID
+	ColumnTitle string
+	Title       string
+	AssigneeID  *uuid.UUID
+	UpdatedAt   time.Time
+}
+
+// DayCards is how many cards were created on and completed on a day.
+type DayCards struct {
+	Day       time.Time
+	Created   int
+	Completed int
+}
+
+// Contributor is a user who created cards on a board or had cards assigned
+// to them completed.
+type Contributor struct {
+	UserID    uuid.UUID
+	Created   int
+	Completed int
+}
+
+// BoardStats sums up a board for its members. Columns has the cards in each
+// column now, in board order. Days has a count per day of [From, To) and
+// Contributors the users who contributed most over it, most active first.
+// Stale has the cards untouched for StaleDays days, least recently changed
+// first.
+type BoardStats struct {
+	BoardID      uuid.UUID
+	From         time.Time
+	To           time.Time
+	Columns      []ColumnCards
+	Days         []DayCards
+	StaleDays    int
+	Stale        []StaleCard
+	Contributors []Contributor
+}
@@ -0,0 +1,31 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// CalendarToken gives access to the calendar feed of a user without logging
+// in. Only the hash of the token is kept.
+type CalendarToken struct {
+	UserID    uuid.UUID
+	TokenHash string
+	CreatedAt time.Time
+}
+
+// CalendarCard is a card with a due date in the calendar feed of a user:
+// either on one of their boards or assigned to them. Done tells whether it
+// is in a done column.
+type CalendarCard struct {
+	CardID      uuid.UUID
+	BoardID     uuid.UUID
+	BoardTitle  string
+	Title       string
+	Description string
+	DueDate     time.Time
+	Done        bool
+	Version     int
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
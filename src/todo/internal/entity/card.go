@@ -6,13 +6,34 @@ import (
 	"github.com/google/uuid"
 )
 
+const (
+	PriorityNone = iota
+	PriorityLow
+	PriorityMedium
+	PriorityHigh
+	PriorityUrgent
+)
+
 type Card struct {
 	ID          uuid.UUID
 	UserID      uuid.UUID
 	ColumnID    uuid.UUID
+	SwimlaneID  uuid.UUID
+	ParentID    uuid.UUID
 	Title       string
 	Description string
 	Position    float64
+	Priority    int
+	AssigneeID  uuid.UUID
+	DueDate     *time.Time
+	Labels      []string
+	ArchivedAt  *time.Time
+	Version     int
 	CreatedAt   time.Time
 	UpdatedAt   time.Time
+
+	// ChildCount and DoneChildCount roll up the active children of the card,
+	// the latter counting those in done columns. They are read-only.
+	ChildCount     int
+	DoneChildCount int
 }
@@ -0,0 +1,30 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// CardTemplate is a format the cards of a board can be made in. Cards made
+// from it get TitlePattern and Description, placeholders such as {{date}}
+// and {{user}} filled in, the Labels and the Checklists.
+type CardTemplate struct {
+	ID           uuid.UUID
+	BoardID      uuid.UUID
+	Name         string
+	TitlePattern string
+	Description  string
+	Labels       []string
+	Checklists   []Checklist
+	CreatedBy    uuid.UUID
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+// Checklist is a titled list of items a card made from a template starts
+// with, none of them checked.
+type Checklist struct {
+	Title string
+	Items []string
+}
@@ -12,6 +12,8 @@ type Column struct {
 	BoardID   uuid.UUID
 	Title     string
 	Position  float64
+	Done      bool
+	Version   int
 	CreatedAt time.Time
 	UpdatedAt time.Time
 }
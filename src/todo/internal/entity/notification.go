@@ -0,0 +1,34 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// NotificationMention is the kind of the notification a user gets for being
+// mentioned on a card; watchers get the kind of the card activity instead.
+const NotificationMention = "mention"
+
+// Watch subscribes a user to the changes of a card, or of every card of a
+// board. Exactly one of BoardID and CardID is set.
+type Watch struct {
+	UserID    uuid.UUID
+	BoardID   uuid.UUID
+	CardID    uuid.UUID
+	CreatedAt time.Time
+}
+
+// Notification tells a user that ActorID did something on a card they were
+// mentioned on or watch. It is unread while ReadAt is nil.
+type Notification struct {
+	ID        uuid.UUID
+	UserID    uuid.UUID
+	ActorID   uuid.UUID
+	Kind      string
+	BoardID   uuid.UUID
+	CardID    uuid.UUID
+	CardTitle string
+	ReadAt    *time.Time
+	CreatedAt time.Time
+}
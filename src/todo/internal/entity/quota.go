@@ -0,0 +1,51 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Quota bounds what a user may keep. A bound of zero is no bound.
+type Quota struct {
+	BoardsPerUser     int
+	ColumnsPerBoard   int
+	CardsPerColumn    int
+	DescriptionLength int // In characters
+}
+
+// QuotaOverride sets bounds for one user in place of the configured ones.
+// The bounds it leaves nil stay as configured.
+type QuotaOverride struct {
+	UserID            uuid.UUID
+	BoardsPerUser     *int
+	ColumnsPerBoard   *int
+	CardsPerColumn    *int
+	DescriptionLength *int
+	UpdatedAt         time.Time
+}
+
+// Apply returns quota with the bounds the override sets in place.
+func (o QuotaOverride) Apply(quota Quota) Quota {
+	if o.BoardsPerUser != nil {
+		quota.BoardsPerUser = *o.BoardsPerUser
+	}
+	if o.ColumnsPerBoard != nil {
+		quota.ColumnsPerBoard = *o.ColumnsPerBoard
+	}
+	if o.CardsPerColumn != nil {
+		quota.CardsPerColumn = *o.CardsPerColumn
+	}
+	if o.DescriptionLength != nil {
+		quota.DescriptionLength = *o.DescriptionLength
+	}
+	return quota
+}
+
+// UserQuota is the quota a user is held to and the override, if any, it
+// comes from.
+type UserQuota struct {
+	UserID   uuid.UUID
+	Quota    Quota
+	Override *QuotaOverride
+}
@@ -0,0 +1,42 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Sprint is a period of work on a board, from StartDate to EndDate, both
+// days included. A closed sprint has a ClosedAt.
+type Sprint struct {
+	ID        uuid.UUID
+	BoardID   uuid.UUID
+	Name      string
+	StartDate time.Time
+	EndDate   time.Time
+	ClosedAt  *time.Time
+	CreatedAt time.Time
+}
+
+// SprintCard is a card taken into a sprint, worth Points story points.
+type SprintCard struct {
+	SprintID uuid.UUID
+	CardID   uuid.UUID
+	Points   int
+	AddedAt  time.Time
+
+	// CardTitle and Done, whether the card is in a done column, are
+	// read-only.
+	CardTitle string
+	Done      bool
+}
+
+// BurndownPoint is the work left in a sprint at the end of a day: the
+// cards of the sprint not in a done column then and their points.
+// IdealPoints is where the points would be, burning down evenly.
+type BurndownPoint struct {
+	Day         time.Time
+	Cards       int
+	Points      int
+	IdealPoints float64
+}
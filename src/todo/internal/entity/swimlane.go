@@ -0,0 +1,20 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Swimlane is a horizontal band of a board. Every card sits in one column
+// and one swimlane of its board.
+type Swimlane struct {
+	ID        uuid.UUID
+	UserID    uuid.UUID
+	BoardID   uuid.UUID
+	Title     string
+	Position  float64
+	Version   int
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
@@ -0,0 +1,29 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TimeEntry is time a user spent on a card. A running timer is an entry
+// with no EndedAt yet.
+type TimeEntry struct {
+	ID        uuid.UUID
+	CardID    uuid.UUID
+	UserID    uuid.UUID
+	StartedAt time.Time
+	EndedAt   *time.Time
+	Note      string
+	CreatedAt time.Time
+}
+
+// TimeReportRow totals the time a user logged on a card over one day. An
+// entry counts towards the day it started on.
+type TimeReportRow struct {
+	Day       time.Time
+	UserID    uuid.UUID
+	CardID    uuid.UUID
+	CardTitle string
+	Duration  time.Duration
+}
@@ -0,0 +1,43 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Roles of workspace members. Admins manage the workspace and its members,
+// members work on its boards and viewers only look at them.
+const (
+	RoleAdmin  = "admin"
+	RoleMember = "member"
+	RoleViewer = "viewer"
+)
+
+// Workspace groups boards and the users working on them. A personal
+// workspace belongs to the user who made it and has no other members.
+type Workspace struct {
+	ID        uuid.UUID
+	Name      string
+	Personal  bool
+	CreatedBy uuid.UUID
+	CreatedAt time.Time
+}
+
+type WorkspaceMember struct {
+	WorkspaceID uuid.UUID
+	UserID      uuid.UUID
+	Role        string
+	CreatedAt   time.Time
+}
+
+// WorkspaceMembership is a workspace with the role a user has in it.
+type WorkspaceMembership struct {
+	Workspace
+	Role string
+}
+
+// CanWrite reports whether the role lets its holder change boards.
+func (m *WorkspaceMember) CanWrite() bool {
+	return m.Role == RoleAdmin || m.Role == RoleMember
+}
@@ -0,0 +1,60 @@
+package v1
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"time"
+	"todo/internal/dto"
+	"todo/internal/repository"
+	"todo/internal/usecase"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+)
+
+type AnalyticsHandler struct {
+	analyticsUseCase usecase.AnalyticsUseCase
+}
+
+func NewAnalyticsHandler(analyticsUseCase usecase.AnalyticsUseCase) *AnalyticsHandler {
+	return &AnalyticsHandler{analyticsUseCase: analyticsUseCase}
+}
+
+// GetBoardAnalytics reports on the flow of cards across a board. The from
+// and to dates are both included.
+func (h *AnalyticsHandler) GetBoardAnalytics(w http.ResponseWriter, r *http.Request) {
+	boardID, err := uuid.Parse(mux.Vars(r)["id"])
+	if err != nil {
+		http.Error(w, ErrInvalidBoardID, http.StatusBadRequest)
+		return
+	}
+
+	values := r.URL.Query()
+
+	from, err := time.Parse(dateLayout, values.Get("from"))
+	if err != nil {
+		http.Error(w, ErrInvalidFromDate, http.StatusBadRequest)
+		return
+	}
+
+	to, err := time.Parse(dateLayout, values.Get("to"))
+	if err != nil {
+		http.Error(w, ErrInvalidToDate, http.StatusBadRequest)
+		return
+	}
+
+	analytics, err := h.analyticsUseCase.GetBoardAnalytics(r.Context(), boardID, from, to.AddDate(0, 0, 1))
+
+	if errors.Is(err, repository.ErrAnalyticsBounds) || errors.Is(err, repository.ErrAnalyticsRange) {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if err != nil {
+		http.Error(w, err.Error(), accessStatus(err))
+		return
+	}
+
+	json.NewEncoder(w).Encode(dto.ToBoardAnalyticsDTO(analytics))
+}
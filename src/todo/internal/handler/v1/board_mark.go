@@ -0,0 +1,146 @@
+package v1
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"todo/internal/config"
+	"todo/internal/dto"
+	"todo/internal/repository"
+	"todo/internal/usecase"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+)
+
+type BoardMarkHandler struct {
+	markUseCase usecase.BoardMarkUseCase
+	config      config.PaginationConfig
+}
+
+func NewBoardMarkHandler(markUseCase usecase.BoardMarkUseCase, config config.PaginationConfig) *BoardMarkHandler {
+	return &BoardMarkHandler{markUseCase: markUseCase, config: config}
+}
+
+func (h *BoardMarkHandler) StarBoard(w http.ResponseWriter, r *http.Request) {
+	boardID, err := uuid.Parse(mux.Vars(r)["id"])
+	if err != nil {
+		http.Error(w, ErrInvalidBoardID, http.StatusBadRequest)
+		return
+	}
+
+	var input dto.BoardMarkRequest
+
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	err = h.markUseCase.StarBoard(r.Context(), input.UserID, boardID)
+
+	if err != nil {
+		http.Error(w, err.Error(), boardMarkStatus(err))
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *BoardMarkHandler) UnstarBoard(w http.ResponseWriter, r *http.Request) {
+	boardID, err := uuid.Parse(mux.Vars(r)["id"])
+	if err != nil {
+		http.Error(w, ErrInvalidBoardID, http.StatusBadRequest)
+		return
+	}
+
+	userID, err := uuid.Parse(r.URL.Query().Get("user_id"))
+	if err != nil {
+		http.Error(w, ErrInvalidUserID, http.StatusBadRequest)
+		return
+	}
+
+	err = h.markUseCase.UnstarBoard(r.Context(), userID, boardID)
+
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *BoardMarkHandler) RecordBoardView(w http.ResponseWriter, r *http.Request) {
+	boardID, err := uuid.Parse(mux.Vars(r)["id"])
+	if err != nil {
+		http.Error(w, ErrInvalidBoardID, http.StatusBadRequest)
+		return
+	}
+
+	var input dto.BoardMarkRequest
+
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	err = h.markUseCase.RecordBoardView(r.Context(), input.UserID, boardID)
+
+	if err != nil {
+		http.Error(w, err.Error(), boardMarkStatus(err))
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *BoardMarkHandler) GetRecentBoards(w http.ResponseWriter, r *http.Request) {
+	userID, err := uuid.Parse(r.URL.Query().Get("user_id"))
+	if err != nil {
+		http.Error(w, ErrInvalidUserID, http.StatusBadRequest)
+		return
+	}
+
+	boards, err := h.markUseCase.GetRecentBoards(r.Context(), userID)
+
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	json.NewEncoder(w).Encode(dto.ToMarkedBoardDTOs(boards))
+}
+
+// GetMarkedBoards serves GET /boards in the starred sort mode.
+func (h *BoardMarkHandler) GetMarkedBoards(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	userID, err := uuid.Parse(query.Get("user_id"))
+	if err != nil {
+		http.Error(w, ErrInvalidUserID, http.StatusBadRequest)
+		return
+	}
+
+	page, errMsg := parsePage(query, h.config)
+	if errMsg != "" {
+		http.Error(w, errMsg, http.StatusBadRequest)
+		return
+	}
+
+	boards, next, err := h.markUseCase.GetMarkedBoards(r.Context(), userID, page)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	json.NewEncoder(w).Encode(dto.NewPage(dto.ToMarkedBoardDTOs(boards), next))
+}
+
+func boardMarkStatus(err error) int {
+	switch {
+	case errors.Is(err, repository.ErrBoardNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, repository.ErrBoardAccess):
+		return http.StatusForbidden
+	}
+
+	return http.StatusInternalServerError
+}
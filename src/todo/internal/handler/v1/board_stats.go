@@ -0,0 +1,87 @@
+package v1
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+	"todo/internal/dto"
+	"todo/internal/repository"
+	"todo/internal/usecase"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+)
+
+type BoardStatsHandler struct {
+	statsUseCase usecase.BoardStatsUseCase
+}
+
+func NewBoardStatsHandler(statsUseCase usecase.BoardStatsUseCase) *BoardStatsHandler {
+	return &BoardStatsHandler{statsUseCase: statsUseCase}
+}
+
+// GetBoardStats sums up a board for the member user_id. The from and to
+// dates are both included; cards go stale after stale_days days, 14 unless
+// given.
+func (h *BoardStatsHandler) GetBoardStats(w http.ResponseWriter, r *http.Request) {
+	boardID, err := uuid.Parse(mux.Vars(r)["id"])
+	if err != nil {
+		http.Error(w, ErrInvalidBoardID, http.StatusBadRequest)
+		return
+	}
+
+	values := r.URL.Query()
+
+	userID, err := uuid.Parse(values.Get("user_id"))
+	if err != nil {
+		http.Error(w, ErrInvalidUserID, http.StatusBadRequest)
+		return
+	}
+
+	from, err := time.Parse(dateLayout, values.Get("from"))
+	if err != nil {
+		http.Error(w, ErrInvalidFromDate, http.StatusBadRequest)
+		return
+	}
+
+	to, err := time.Parse(dateLayout, values.Get("to"))
+	if err != nil {
+		http.Error(w, ErrInvalidToDate, http.StatusBadRequest)
+		return
+	}
+
+	staleDays := repository.DefaultStaleDays
+	if s := values.Get("stale_days"); s != "" {
+		staleDays, err = strconv.Atoi(s)
+		if err != nil {
+			http.Error(w, ErrInvalidStaleDays, http.StatusBadRequest)
+			return
+		}
+	}
+
+	stats, err := h.statsUseCase.GetBoardStats(r.Context(), userID, boardID, from, to.AddDate(0, 0, 1), staleDays)
+
+	if errors.Is(err, repository.ErrBoardNotFound) {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+
+	if errors.Is(err, repository.ErrBoardAccess) {
+		http.Error(w, err.Error(), http.StatusForbidden)
+		return
+	}
+
+	if errors.Is(err, repository.ErrAnalyticsBounds) || errors.Is(err, repository.ErrAnalyticsRange) || errors.Is(err, repository.ErrStaleDays) {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	json.NewEncoder(w).Encode(dto.ToBoardStatsDTO(stats))
+}
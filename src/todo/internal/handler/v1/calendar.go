@@ -0,0 +1,62 @@
+package v1
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"todo/internal/dto"
+	"todo/internal/repository"
+	"todo/internal/usecase"
+
+	"github.com/gorilla/mux"
+)
+
+type CalendarHandler struct {
+	calendarUseCase usecase.CalendarUseCase
+}
+
+func NewCalendarHandler(calendarUseCase usecase.CalendarUseCase) *CalendarHandler {
+	return &CalendarHandler{calendarUseCase: calendarUseCase}
+}
+
+func (h *CalendarHandler) RegenerateCalendarToken(w http.ResponseWriter, r *http.Request) {
+	var input dto.RegenerateCalendarTokenRequest
+
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	token, calendarToken, err := h.calendarUseCase.RegenerateCalendarToken(r.Context(), input.UserID)
+
+	if errors.Is(err, repository.ErrCalendarNoUserID) {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(dto.ToCalendarTokenDTO(token, calendarToken))
+}
+
+func (h *CalendarHandler) GetCalendarCards(w http.ResponseWriter, r *http.Request) {
+	token := mux.Vars(r)["token"]
+
+	cards, err := h.calendarUseCase.GetCalendarCards(r.Context(), token)
+
+	if errors.Is(err, repository.ErrCalendarTokenNotFound) {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	json.NewEncoder(w).Encode(dto.ToCalendarCardDTOs(cards))
+}
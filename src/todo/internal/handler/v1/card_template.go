@@ -0,0 +1,132 @@
+package v1
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"todo/internal/dto"
+	"todo/internal/repository"
+	"todo/internal/usecase"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+)
+
+type CardTemplateHandler struct {
+	templateUseCase usecase.CardTemplateUseCase
+}
+
+func NewCardTemplateHandler(templateUseCase usecase.CardTemplateUseCase) *CardTemplateHandler {
+	return &CardTemplateHandler{templateUseCase: templateUseCase}
+}
+
+func (h *CardTemplateHandler) CreateCardTemplate(w http.ResponseWriter, r *http.Request) {
+	var input dto.CardTemplateRequest
+
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	template := dto.ToCardTemplateEntity(input)
+
+	err := h.templateUseCase.CreateCardTemplate(r.Context(), template)
+
+	if err != nil {
+		http.Error(w, err.Error(), cardTemplateStatus(err))
+		return
+	}
+
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(dto.ToCardTemplateDTO(template))
+}
+
+func (h *CardTemplateHandler) GetCardTemplateByID(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(mux.Vars(r)["id"])
+	if err != nil {
+		http.Error(w, ErrInvalidTemplateID, http.StatusBadRequest)
+		return
+	}
+
+	template, err := h.templateUseCase.GetCardTemplateByID(r.Context(), id)
+
+	if err != nil {
+		http.Error(w, err.Error(), cardTemplateStatus(err))
+		return
+	}
+
+	json.NewEncoder(w).Encode(dto.ToCardTemplateDTO(template))
+}
+
+func (h *CardTemplateHandler) GetCardTemplatesByBoard(w http.ResponseWriter, r *http.Request) {
+	boardID, err := uuid.Parse(r.URL.Query().Get("board_id"))
+	if err != nil {
+		http.Error(w, ErrInvalidBoardID, http.StatusBadRequest)
+		return
+	}
+
+	templates, err := h.templateUseCase.GetCardTemplatesByBoard(r.Context(), boardID)
+	if err != nil {
+		http.Error(w, err.Error(), cardTemplateStatus(err))
+		return
+	}
+
+	json.NewEncoder(w).Encode(dto.ToCardTemplateDTOs(templates))
+}
+
+func (h *CardTemplateHandler) UpdateCardTemplate(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(mux.Vars(r)["id"])
+	if err != nil {
+		http.Error(w, ErrInvalidTemplateID, http.StatusBadRequest)
+		return
+	}
+
+	var input dto.CardTemplateRequest
+
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	template := dto.ToCardTemplateEntity(input)
+	template.ID = id
+
+	err = h.templateUseCase.UpdateCardTemplate(r.Context(), template)
+
+	if err != nil {
+		http.Error(w, err.Error(), cardTemplateStatus(err))
+		return
+	}
+
+	json.NewEncoder(w).Encode(dto.ToCardTemplateDTO(template))
+}
+
+func (h *CardTemplateHandler) DeleteCardTemplate(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(mux.Vars(r)["id"])
+	if err != nil {
+		http.Error(w, ErrInvalidTemplateID, http.StatusBadRequest)
+		return
+	}
+
+	err = h.templateUseCase.DeleteCardTemplate(r.Context(), id)
+
+	if err != nil {
+		http.Error(w, err.Error(), cardTemplateStatus(err))
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func cardTemplateStatus(err error) int {
+	switch {
+	case errors.Is(err, repository.ErrCardTemplateNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, repository.ErrCardTemplateExists):
+		return http.StatusConflict
+	case errors.Is(err, repository.ErrCardTemplateNoName), errors.Is(err, repository.ErrCardTemplateInvalid):
+		return http.StatusBadRequest
+	}
+
+	return accessStatus(err)
+}
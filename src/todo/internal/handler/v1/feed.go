@@ -0,0 +1,138 @@
+package v1
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+	"todo/internal/dto"
+	"todo/internal/entity"
+	"todo/internal/usecase"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+)
+
+var (
+	ErrInvalidLastEventID = "invalid Last-Event-ID"
+	ErrNoStreaming        = "streaming is not supported"
+)
+
+const (
+	// backlogPage is how many missed activities are read at a time when a
+	// watcher resumes.
+	backlogPage = 500
+	// resumeWindow is how far below Last-Event-ID the backlog of a resuming
+	// watcher starts. Activity ids are taken before their transactions
+	// commit, so one can become visible after a higher one has been sent.
+	resumeWindow = 100
+	// keepAlive is how often an idle stream gets a comment, so that proxies
+	// do not close it.
+	keepAlive = 30 * time.Second
+)
+
+type FeedHandler struct {
+	feed usecase.FeedUseCase
+}
+
+func NewFeedHandler(feed usecase.FeedUseCase) *FeedHandler {
+	return &FeedHandler{
+		feed: feed,
+	}
+}
+
+// WatchBoard streams the activities of a board as Server-Sent Events. A
+// watcher passing Last-Event-ID first gets what it has missed since.
+//
+// Ids are not in commit order: an activity with a lower id than the last one
+// sent may commit later. The backlog therefore starts resumeWindow ids below
+// Last-Event-ID and sends again some activities the watcher has had, which
+// it drops by id. An activity that commits more than resumeWindow ids late
+// is still missed on resume.
+func (h *FeedHandler) WatchBoard(w http.ResponseWriter, r *http.Request) {
+	boardID, err := uuid.Parse(mux.Vars(r)["id"])
+	if err != nil {
+		http.Error(w, ErrInvalidBoardID, http.StatusBadRequest)
+		return
+	}
+
+	var lastID int64
+	resume := r.Header.Get("Last-Event-ID")
+	if resume != "" {
+		lastID, err = strconv.ParseInt(resume, 10, 64)
+		if err != nil || lastID < 0 {
+			http.Error(w, ErrInvalidLastEventID, http.StatusBadRequest)
+			return
+		}
+	}
+
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, ErrNoStreaming, http.StatusInternalServerError)
+		return
+	}
+
+	if _, err := h.feed.GetBoardByID(r.Context(), boardID); err != nil {
+		http.Error(w, err.Error(), accessStatus(err))
+		return
+	}
+
+	// Subscribe before reading the backlog, so that nothing recorded in
+	// between is missed; what arrives twice is skipped by its id.
+	activities, cancel := h.feed.WatchBoard(r.Context(), boardID)
+	defer cancel()
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.WriteHeader(http.StatusOK)
+
+	sent := make(map[int64]bool)
+
+	if resume != "" {
+		afterID := max(lastID-resumeWindow, 0)
+		for {
+			backlog, err := h.feed.GetBoardActivities(r.Context(), boardID, afterID, backlogPage)
+			if err != nil {
+				return
+			}
+			for _, activity := range backlog {
+				writeEvent(w, &activity)
+				sent[activity.ID] = true
+				afterID = activity.ID
+			}
+			if len(backlog) < backlogPage {
+				break
+			}
+		}
+	}
+	flusher.Flush()
+
+	ticker := time.NewTicker(keepAlive)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case activity, ok := <-activities:
+			if !ok {
+				return
+			}
+			if sent[activity.ID] {
+				continue
+			}
+			writeEvent(w, &activity)
+			flusher.Flush()
+		case <-ticker.C:
+			fmt.Fprint(w, ": keep-alive\n\n")
+			flusher.Flush()
+		}
+	}
+}
+
+func writeEvent(w http.ResponseWriter, activity *entity.Activity) {
+	data, _ := json.Marshal(dto.ToActivityDTO(activity))
+	fmt.Fprintf(w, "id: %d\nevent: %s\ndata: %s\n\n", activity.ID, activity.Kind, data)
+}
@@ -0,0 +1,67 @@
+package v1_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"testing"
+	"todo/internal/entity"
+	v1 "todo/internal/handler/v1"
+	"todo/internal/testdata"
+	"todo/mocks"
+
+	"github.com/gorilla/mux"
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/runner"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestWatchBoardResume(t *testing.T) {
+	runner.Run(t, "TestWatchBoardResume", func(pt provider.T) {
+		mom := &testdata.ObjectMother{}
+
+		boardID := mom.GetUUID(0)
+
+		activity := func(id int64) entity.Activity {
+			return entity.Activity{ID: id, BoardID: boardID, Kind: entity.ActivityCardUpdated}
+		}
+
+		// 145 committed after the watcher saw 150, so it is in the window
+		// replayed below Last-Event-ID; it also comes live, as the watch is
+		// opened before the backlog is read. 130 commits later still and
+		// comes live only.
+		live := make(chan entity.Activity, 3)
+		live <- activity(145)
+		live <- activity(151)
+		live <- activity(130)
+		close(live)
+
+		mockFeed := new(mocks.FeedUseCase)
+		mockFeed.On("GetBoardByID", mock.Anything, boardID).Return(&entity.Board{ID: boardID}, nil)
+		mockFeed.On("WatchBoard", mock.Anything, boardID).Return((<-chan entity.Activity)(live), func() {})
+		mockFeed.On("GetBoardActivities", mock.Anything, boardID, int64(50), mock.Anything).
+			Return([]entity.Activity{activity(120), activity(145), activity(150)}, nil)
+
+		h := v1.NewFeedHandler(mockFeed)
+
+		pt.WithNewStep("Call WatchBoard", func(sCtx provider.StepCtx) {
+			r := httptest.NewRequest(http.MethodGet, "/api/v1/boards/"+boardID.String()+"/events", nil)
+			r.Header.Set("Last-Event-ID", "150")
+			r = mux.SetURLVars(r, map[string]string{"id": boardID.String()})
+
+			w := httptest.NewRecorder()
+			h.WatchBoard(w, r)
+
+			sCtx.Assert().Equal(http.StatusOK, w.Code)
+
+			var ids []string
+			for _, m := range regexp.MustCompile(`(?m)^id: (\d+)$`).FindAllStringSubmatch(w.Body.String(), -1) {
+				ids = append(ids, m[1])
+			}
+
+			sCtx.Assert().Equal([]string{"120", "145", "150", "151", "130"}, ids)
+		})
+
+		mockFeed.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,181 @@
+package v1
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"todo/internal/config"
+	"todo/internal/dto"
+	"todo/internal/entity"
+	"todo/internal/repository"
+	"todo/internal/usecase"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+)
+
+type NotificationHandler struct {
+	notifyUseCase usecase.NotificationUseCase
+	config        config.PaginationConfig
+}
+
+func NewNotificationHandler(notifyUseCase usecase.NotificationUseCase, config config.PaginationConfig) *NotificationHandler {
+	return &NotificationHandler{notifyUseCase: notifyUseCase, config: config}
+}
+
+func (h *NotificationHandler) AddBoardWatch(w http.ResponseWriter, r *http.Request) {
+	boardID, err := uuid.Parse(mux.Vars(r)["id"])
+	if err != nil {
+		http.Error(w, ErrInvalidBoardID, http.StatusBadRequest)
+		return
+	}
+
+	h.addWatch(w, r, &entity.Watch{BoardID: boardID})
+}
+
+func (h *NotificationHandler) AddCardWatch(w http.ResponseWriter, r *http.Request) {
+	cardID, err := uuid.Parse(mux.Vars(r)["id"])
+	if err != nil {
+		http.Error(w, ErrInvalidCardID, http.StatusBadRequest)
+		return
+	}
+
+	h.addWatch(w, r, &entity.Watch{CardID: cardID})
+}
+
+func (h *NotificationHandler) addWatch(w http.ResponseWriter, r *http.Request, watch *entity.Watch) {
+	var input dto.WatchRequest
+
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	watch.UserID = input.UserID
+
+	err := h.notifyUseCase.Watch(r.Context(), watch)
+
+	if err != nil {
+		http.Error(w, err.Error(), notificationStatus(err))
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *NotificationHandler) RemoveBoardWatch(w http.ResponseWriter, r *http.Request) {
+	boardID, err := uuid.Parse(mux.Vars(r)["id"])
+	if err != nil {
+		http.Error(w, ErrInvalidBoardID, http.StatusBadRequest)
+		return
+	}
+
+	h.removeWatch(w, r, &entity.Watch{BoardID: boardID})
+}
+
+func (h *NotificationHandler) RemoveCardWatch(w http.ResponseWriter, r *http.Request) {
+	cardID, err := uuid.Parse(mux.Vars(r)["id"])
+	if err != nil {
+		http.Error(w, ErrInvalidCardID, http.StatusBadRequest)
+		return
+	}
+
+	h.removeWatch(w, r, &entity.Watch{CardID: cardID})
+}
+
+func (h *NotificationHandler) removeWatch(w http.ResponseWriter, r *http.Request, watch *entity.Watch) {
+	userID, err := uuid.Parse(r.URL.Query().Get("user_id"))
+	if err != nil {
+		http.Error(w, ErrInvalidUserID, http.StatusBadRequest)
+		return
+	}
+
+	watch.UserID = userID
+
+	err = h.notifyUseCase.Unwatch(r.Context(), watch)
+
+	if err != nil {
+		http.Error(w, err.Error(), notificationStatus(err))
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *NotificationHandler) NotifyCard(w http.ResponseWriter, r *http.Request) {
+	var input dto.NotifyCardRequest
+
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	notifications, err := h.notifyUseCase.NotifyCard(r.Context(), input.ActorID, input.CardID, input.Kind, input.Mentioned)
+
+	if err != nil {
+		http.Error(w, err.Error(), notificationStatus(err))
+		return
+	}
+
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(dto.ToNotificationDTOs(notifications))
+}
+
+// GetNotifications serves the inbox of a user, ?unread=true for the unread
+// notifications only.
+func (h *NotificationHandler) GetNotifications(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	userID, err := uuid.Parse(query.Get("user_id"))
+	if err != nil {
+		http.Error(w, ErrInvalidUserID, http.StatusBadRequest)
+		return
+	}
+
+	page, errMsg := parsePage(query, h.config)
+	if errMsg != "" {
+		http.Error(w, errMsg, http.StatusBadRequest)
+		return
+	}
+
+	unreadOnly := query.Get("unread") == "true"
+
+	notifications, next, err := h.notifyUseCase.GetNotifications(r.Context(), userID, unreadOnly, page)
+
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	json.NewEncoder(w).Encode(dto.NewPage(dto.ToNotificationDTOs(notifications), next))
+}
+
+func (h *NotificationHandler) MarkNotificationsRead(w http.ResponseWriter, r *http.Request) {
+	var input dto.MarkNotificationsReadRequest
+
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	err := h.notifyUseCase.MarkNotificationsRead(r.Context(), input.UserID, input.IDs)
+
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func notificationStatus(err error) int {
+	switch {
+	case errors.Is(err, repository.ErrBoardNotFound), errors.Is(err, repository.ErrCardNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, repository.ErrBoardAccess):
+		return http.StatusForbidden
+	case errors.Is(err, repository.ErrInvalidWatch), errors.Is(err, repository.ErrInvalidNotificationKind):
+		return http.StatusBadRequest
+	}
+
+	return http.StatusInternalServerError
+}
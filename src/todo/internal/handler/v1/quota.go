@@ -0,0 +1,98 @@
+package v1
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"todo/internal/dto"
+	"todo/internal/repository"
+	"todo/internal/usecase"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+)
+
+type QuotaHandler struct {
+	quotaUseCase usecase.QuotaUseCase
+}
+
+func NewQuotaHandler(quotaUseCase usecase.QuotaUseCase) *QuotaHandler {
+	return &QuotaHandler{quotaUseCase: quotaUseCase}
+}
+
+func (h *QuotaHandler) GetUserQuota(w http.ResponseWriter, r *http.Request) {
+	userID, err := uuid.Parse(mux.Vars(r)["id"])
+	if err != nil {
+		http.Error(w, ErrInvalidUserID, http.StatusBadRequest)
+		return
+	}
+
+	quota, err := h.quotaUseCase.GetUserQuota(r.Context(), userID)
+
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	json.NewEncoder(w).Encode(dto.ToUserQuotaDTO(quota))
+}
+
+// SetQuotaOverride replaces the override of the user and answers with the
+// quota they are now held to.
+func (h *QuotaHandler) SetQuotaOverride(w http.ResponseWriter, r *http.Request) {
+	userID, err := uuid.Parse(mux.Vars(r)["id"])
+	if err != nil {
+		http.Error(w, ErrInvalidUserID, http.StatusBadRequest)
+		return
+	}
+
+	var input dto.QuotaOverrideRequest
+
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	err = h.quotaUseCase.SetQuotaOverride(r.Context(), dto.ToQuotaOverrideEntity(userID, input))
+
+	if errors.Is(err, repository.ErrQuotaInvalid) {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	quota, err := h.quotaUseCase.GetUserQuota(r.Context(), userID)
+
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	json.NewEncoder(w).Encode(dto.ToUserQuotaDTO(quota))
+}
+
+func (h *QuotaHandler) DeleteQuotaOverride(w http.ResponseWriter, r *http.Request) {
+	userID, err := uuid.Parse(mux.Vars(r)["id"])
+	if err != nil {
+		http.Error(w, ErrInvalidUserID, http.StatusBadRequest)
+		return
+	}
+
+	err = h.quotaUseCase.DeleteQuotaOverride(r.Context(), userID)
+
+	if errors.Is(err, repository.ErrQuotaOverrideNotFound) {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,264 @@
+package v1
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"todo/internal/dto"
+	"todo/internal/entity"
+	"todo/internal/repository"
+	"todo/internal/usecase"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+)
+
+type SprintHandler struct {
+	sprintUseCase usecase.SprintUseCase
+}
+
+func NewSprintHandler(sprintUseCase usecase.SprintUseCase) *SprintHandler {
+	return &SprintHandler{sprintUseCase: sprintUseCase}
+}
+
+func (h *SprintHandler) CreateSprint(w http.ResponseWriter, r *http.Request) {
+	var input dto.CreateSprintRequest
+
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	sprint := &entity.Sprint{
+		BoardID:   input.BoardID,
+		Name:      input.Name,
+		StartDate: input.StartDate,
+		EndDate:   input.EndDate,
+	}
+
+	err := h.sprintUseCase.CreateSprint(r.Context(), sprint)
+
+	if errors.Is(err, repository.ErrBoardNotFound) {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+
+	if errors.Is(err, repository.ErrSprintNoName) || errors.Is(err, repository.ErrSprintDates) {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if err != nil {
+		http.Error(w, err.Error(), accessStatus(err))
+		return
+	}
+
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(dto.ToSprintDTO(sprint))
+}
+
+func (h *SprintHandler) GetSprintByID(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(mux.Vars(r)["id"])
+	if err != nil {
+		http.Error(w, ErrInvalidSprintID, http.StatusBadRequest)
+		return
+	}
+
+	sprint, err := h.sprintUseCase.GetSprintByID(r.Context(), id)
+
+	if errors.Is(err, repository.ErrSprintNotFound) {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+
+	if err != nil {
+		http.Error(w, err.Error(), accessStatus(err))
+		return
+	}
+
+	json.NewEncoder(w).Encode(dto.ToSprintDTO(sprint))
+}
+
+func (h *SprintHandler) GetSprintsByBoard(w http.ResponseWriter, r *http.Request) {
+	boardID, err := uuid.Parse(r.URL.Query().Get("board_id"))
+	if err != nil {
+		http.Error(w, ErrInvalidBoardID, http.StatusBadRequest)
+		return
+	}
+
+	sprints, err := h.sprintUseCase.GetSprintsByBoard(r.Context(), boardID)
+	if err != nil {
+		http.Error(w, err.Error(), accessStatus(err))
+		return
+	}
+
+	json.NewEncoder(w).Encode(dto.ToSprintDTOs(sprints))
+}
+
+func (h *SprintHandler) AddSprintCard(w http.ResponseWriter, r *http.Request) {
+	sprintID, err := uuid.Parse(mux.Vars(r)["id"])
+	if err != nil {
+		http.Error(w, ErrInvalidSprintID, http.StatusBadRequest)
+		return
+	}
+
+	var input dto.AddSprintCardRequest
+
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	card := &entity.SprintCard{
+		SprintID: sprintID,
+		CardID:   input.CardID,
+		Points:   input.Points,
+	}
+
+	err = h.sprintUseCase.AddSprintCard(r.Context(), card)
+
+	if errors.Is(err, repository.ErrSprintNotFound) || errors.Is(err, repository.ErrCardNotFound) {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+
+	if errors.Is(err, repository.ErrSprintClosed) {
+		http.Error(w, err.Error(), http.StatusConflict)
+		return
+	}
+
+	if errors.Is(err, repository.ErrSprintPoints) || errors.Is(err, repository.ErrSprintCardBoard) {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if err != nil {
+		http.Error(w, err.Error(), accessStatus(err))
+		return
+	}
+
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(dto.ToSprintCardDTO(card))
+}
+
+func (h *SprintHandler) RemoveSprintCard(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+
+	sprintID, err := uuid.Parse(vars["id"])
+	if err != nil {
+		http.Error(w, ErrInvalidSprintID, http.StatusBadRequest)
+		return
+	}
+
+	cardID, err := uuid.Parse(vars["card_id"])
+	if err != nil {
+		http.Error(w, ErrInvalidCardID, http.StatusBadRequest)
+		return
+	}
+
+	err = h.sprintUseCase.RemoveSprintCard(r.Context(), sprintID, cardID)
+
+	if errors.Is(err, repository.ErrSprintNotFound) || errors.Is(err, repository.ErrSprintCardNotFound) {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+
+	if err != nil {
+		http.Error(w, err.Error(), accessStatus(err))
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *SprintHandler) GetSprintCards(w http.ResponseWriter, r *http.Request) {
+	sprintID, err := uuid.Parse(mux.Vars(r)["id"])
+	if err != nil {
+		http.Error(w, ErrInvalidSprintID, http.StatusBadRequest)
+		return
+	}
+
+	cards, err := h.sprintUseCase.GetSprintCards(r.Context(), sprintID)
+	if errors.Is(err, repository.ErrSprintNotFound) {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+
+	if err != nil {
+		http.Error(w, err.Error(), accessStatus(err))
+		return
+	}
+
+	json.NewEncoder(w).Encode(dto.ToSprintCardDTOs(cards))
+}
+
+// CloseSprint closes a sprint and carries its unfinished cards over to the
+// next one. The body is optional.
+func (h *SprintHandler) CloseSprint(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(mux.Vars(r)["id"])
+	if err != nil {
+		http.Error(w, ErrInvalidSprintID, http.StatusBadRequest)
+		return
+	}
+
+	var input dto.CloseSprintRequest
+
+	if r.ContentLength != 0 {
+		if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+	}
+
+	next, carried, err := h.sprintUseCase.CloseSprint(r.Context(), id, input.NextSprintID)
+
+	if errors.Is(err, repository.ErrSprintNotFound) {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+
+	if errors.Is(err, repository.ErrSprintClosed) {
+		http.Error(w, err.Error(), http.StatusConflict)
+		return
+	}
+
+	if errors.Is(err, repository.ErrSprintNext) {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if err != nil {
+		http.Error(w, err.Error(), accessStatus(err))
+		return
+	}
+
+	resp := dto.CloseSprintResponse{Carried: carried}
+	if next != nil {
+		nextDTO := dto.ToSprintDTO(next)
+		resp.NextSprint = &nextDTO
+	}
+
+	json.NewEncoder(w).Encode(resp)
+}
+
+func (h *SprintHandler) GetSprintBurndown(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(mux.Vars(r)["id"])
+	if err != nil {
+		http.Error(w, ErrInvalidSprintID, http.StatusBadRequest)
+		return
+	}
+
+	points, err := h.sprintUseCase.GetSprintBurndown(r.Context(), id)
+
+	if errors.Is(err, repository.ErrSprintNotFound) {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+
+	if err != nil {
+		http.Error(w, err.Error(), accessStatus(err))
+		return
+	}
+
+	json.NewEncoder(w).Encode(dto.ToBurndownDTOs(points))
+}
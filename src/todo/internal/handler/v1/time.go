@@ -0,0 +1,196 @@
+package v1
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"time"
+	"todo/internal/dto"
+	"todo/internal/entity"
+	"todo/internal/repository"
+	"todo/internal/usecase"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+)
+
+type TimeHandler struct {
+	timeUseCase usecase.TimeUseCase
+}
+
+func NewTimeHandler(timeUseCase usecase.TimeUseCase) *TimeHandler {
+	return &TimeHandler{timeUseCase: timeUseCase}
+}
+
+func (h *TimeHandler) StartTimer(w http.ResponseWriter, r *http.Request) {
+	var input dto.StartTimerRequest
+
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	entry := &entity.TimeEntry{
+		UserID: input.UserID,
+		CardID: input.CardID,
+		Note:   input.Note,
+	}
+
+	err := h.timeUseCase.StartTimer(r.Context(), entry)
+
+	if errors.Is(err, repository.ErrTimerRunning) {
+		http.Error(w, err.Error(), http.StatusConflict)
+		return
+	}
+
+	if errors.Is(err, repository.ErrCardNotFound) {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+
+	if errors.Is(err, repository.ErrTimeEntryNoUserID) {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if err != nil {
+		http.Error(w, err.Error(), accessStatus(err))
+		return
+	}
+
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(dto.ToTimeEntryDTO(entry))
+}
+
+func (h *TimeHandler) StopTimer(w http.ResponseWriter, r *http.Request) {
+	var input dto.StopTimerRequest
+
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	entry, err := h.timeUseCase.StopTimer(r.Context(), input.UserID)
+
+	if errors.Is(err, repository.ErrNoTimerRunning) {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	json.NewEncoder(w).Encode(dto.ToTimeEntryDTO(entry))
+}
+
+func (h *TimeHandler) LogTime(w http.ResponseWriter, r *http.Request) {
+	var input dto.LogTimeRequest
+
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	entry := &entity.TimeEntry{
+		UserID:    input.UserID,
+		CardID:    input.CardID,
+		StartedAt: input.StartedAt,
+		EndedAt:   &input.EndedAt,
+		Note:      input.Note,
+	}
+
+	err := h.timeUseCase.LogTime(r.Context(), entry)
+
+	if errors.Is(err, repository.ErrCardNotFound) {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+
+	if errors.Is(err, repository.ErrTimeEntryNoUserID) || errors.Is(err, repository.ErrTimeEntryBounds) ||
+		errors.Is(err, repository.ErrTimeEntryInFuture) {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if err != nil {
+		http.Error(w, err.Error(), accessStatus(err))
+		return
+	}
+
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(dto.ToTimeEntryDTO(entry))
+}
+
+func (h *TimeHandler) GetCardTimeEntries(w http.ResponseWriter, r *http.Request) {
+	cardID, err := uuid.Parse(mux.Vars(r)["id"])
+	if err != nil {
+		http.Error(w, ErrInvalidCardID, http.StatusBadRequest)
+		return
+	}
+
+	entries, err := h.timeUseCase.GetCardTimeEntries(r.Context(), cardID)
+
+	if err != nil {
+		http.Error(w, err.Error(), accessStatus(err))
+		return
+	}
+
+	json.NewEncoder(w).Encode(dto.ToTimeEntryDTOs(entries))
+}
+
+// GetTimeReport totals the time logged on a board, by a user or both, by
+// day. The from and to dates are both included.
+func (h *TimeHandler) GetTimeReport(w http.ResponseWriter, r *http.Request) {
+	values := r.URL.Query()
+
+	var query repository.TimeReportQuery
+
+	if v := values.Get("board_id"); v != "" {
+		id, err := uuid.Parse(v)
+		if err != nil {
+			http.Error(w, ErrInvalidBoardID, http.StatusBadRequest)
+			return
+		}
+		query.BoardID = &id
+	}
+
+	if v := values.Get("user_id"); v != "" {
+		id, err := uuid.Parse(v)
+		if err != nil {
+			http.Error(w, ErrInvalidUserID, http.StatusBadRequest)
+			return
+		}
+		query.UserID = &id
+	}
+
+	from, err := time.Parse(dateLayout, values.Get("from"))
+	if err != nil {
+		http.Error(w, ErrInvalidFromDate, http.StatusBadRequest)
+		return
+	}
+
+	to, err := time.Parse(dateLayout, values.Get("to"))
+	if err != nil {
+		http.Error(w, ErrInvalidToDate, http.StatusBadRequest)
+		return
+	}
+
+	query.From = from
+	query.To = to.AddDate(0, 0, 1)
+
+	rows, err := h.timeUseCase.GetTimeReport(r.Context(), query)
+
+	if errors.Is(err, repository.ErrTimeReportNoScope) || errors.Is(err, repository.ErrTimeReportBounds) {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if err != nil {
+		http.Error(w, err.Error(), accessStatus(err))
+		return
+	}
+
+	json.NewEncoder(w).Encode(dto.ToTimeReportDTOs(rows))
+}
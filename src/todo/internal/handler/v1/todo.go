@@ -2,12 +2,16 @@ package v1
 
 import (
 	"encoding/json"
+	"errors"
 	"net/http"
+	"net/url"
 	"strconv"
+	"strings"
 	"time"
 	"todo/internal/config"
 	"todo/internal/dto"
 	"todo/internal/entity"
+	"todo/internal/repository"
 	"todo/internal/usecase"
 
 	"github.com/google/uuid"
@@ -15,21 +19,38 @@ import (
 )
 
 var (
-	ErrInvalidUserID   = "invalid user id"
-	ErrInvalidBoardID  = "invalid board id"
-	ErrInvalidColumnID = "invalid column id"
-	ErrInvalidCardID   = "invalid card id"
-	ErrInvalidFromDate = "invalid <<from>> date"
-	ErrInvalidToDate   = "invalid <<to>> date"
+	ErrInvalidUserID      = "invalid user id"
+	ErrInvalidBoardID     = "invalid board id"
+	ErrInvalidColumnID    = "invalid column id"
+	ErrInvalidLaneID      = "invalid swimlane id"
+	ErrInvalidCardID      = "invalid card id"
+	ErrInvalidSprintID    = "invalid sprint id"
+	ErrInvalidTemplateID  = "invalid card template id"
+	ErrInvalidWorkspaceID = "invalid workspace id"
+	ErrInvalidActorID     = "invalid actor id"
+	ErrInvalidFromDate    = "invalid <<from>> date"
+	ErrInvalidToDate      = "invalid <<to>> date"
+	ErrInvalidPriority    = "invalid priority"
+	ErrInvalidSort        = "invalid sort order"
+	ErrInvalidDate        = "invalid date, expected DD-MM-YYYY"
+	ErrNoCardScope        = "one of board_id, column_id, swimlane_id and parent_id is required"
+	ErrInvalidCursor      = "invalid cursor"
+	ErrNoIfMatch          = "If-Match header is required"
+	ErrInvalidIfMatch     = "invalid If-Match header"
+	ErrInvalidArchived    = "invalid archived flag, expected true or false"
+	ErrInvalidStaleDays   = "invalid stale_days, expected a number of days"
 )
 
+const dateLayout = "02-01-2006" // DD-MM-YYYY
+
 type TodoHandler struct {
-	todoUseCase usecase.TodoUseCase
-	config      config.PaginationConfig
+	todoUseCase     usecase.TodoUseCase
+	templateUseCase usecase.CardTemplateUseCase
+	config          config.PaginationConfig
 }
 
-func NewTodoHandler(todoUseCase usecase.TodoUseCase, config config.PaginationConfig) *TodoHandler {
-	return &TodoHandler{todoUseCase: todoUseCase, config: config}
+func NewTodoHandler(todoUseCase usecase.TodoUseCase, templateUseCase usecase.CardTemplateUseCase, config config.PaginationConfig) *TodoHandler {
+	return &TodoHandler{todoUseCase: todoUseCase, templateUseCase: templateUseCase, config: config}
 }
 
 func (h *TodoHandler) CreateBoard(w http.ResponseWriter, r *http.Request) {
@@ -44,11 +65,24 @@ func (h *TodoHandler) CreateBoard(w http.ResponseWriter, r *http.Request) {
 		UserID: input.UserID,
 		Title:  input.Title,
 	}
+	if input.WorkspaceID != nil {
+		board.WorkspaceID = *input.WorkspaceID
+	}
 
 	err := h.todoUseCase.CreateBoard(r.Context(), board)
 
+	if errors.Is(err, repository.ErrWorkspaceAccess) {
+		http.Error(w, err.Error(), http.StatusForbidden)
+		return
+	}
+
+	if errors.Is(err, repository.ErrQuotaExceeded) {
+		http.Error(w, err.Error(), http.StatusUnprocessableEntity)
+		return
+	}
+
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		http.Error(w, err.Error(), accessStatus(err))
 		return
 	}
 
@@ -67,12 +101,13 @@ func (h *TodoHandler) GetBoardByID(w http.ResponseWriter, r *http.Request) {
 	board, err := h.todoUseCase.GetBoardByID(r.Context(), id)
 
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		http.Error(w, err.Error(), accessStatus(err))
 		return
 	}
 
 	boardDTO := dto.ToBoardDTO(board)
 
+	w.Header().Set("ETag", etag(board.Version))
 	json.NewEncoder(w).Encode(boardDTO)
 }
 
@@ -85,33 +120,21 @@ func (h *TodoHandler) GetBoardsByUser(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	limit := h.config.Limit
-	if _, ok := query["limit"]; ok {
-		limitStr := query.Get("limit")
-		limitInt, err := strconv.Atoi(limitStr)
-		if err == nil {
-			limit = limitInt
-		}
-	}
-
-	offset := h.config.Offset
-	if _, ok := query["offset"]; ok {
-		offsetStr := query.Get("offset")
-		offsetInt, err := strconv.Atoi(offsetStr)
-		if err == nil {
-			offset = offsetInt
-		}
+	page, errMsg := h.parsePage(query)
+	if errMsg != "" {
+		http.Error(w, errMsg, http.StatusBadRequest)
+		return
 	}
 
-	boards, err := h.todoUseCase.GetBoardsByUser(r.Context(), id, limit, offset)
+	boards, next, err := h.todoUseCase.GetBoardsByUser(r.Context(), id, page)
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		http.Error(w, err.Error(), accessStatus(err))
 		return
 	}
 
 	boardDTOs := dto.ToBoardDTOs(boards)
 
-	json.NewEncoder(w).Encode(boardDTOs)
+	json.NewEncoder(w).Encode(dto.NewPage(boardDTOs, next))
 }
 
 func (h *TodoHandler) UpdateBoard(w http.ResponseWriter, r *http.Request) {
@@ -122,18 +145,31 @@ func (h *TodoHandler) UpdateBoard(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	version, errMsg, status := ifMatch(r)
+	if errMsg != "" {
+		http.Error(w, errMsg, status)
+		return
+	}
+
 	board := &entity.Board{
-		ID:    input.ID,
-		Title: input.Title,
+		ID:      input.ID,
+		Title:   input.Title,
+		Version: version,
 	}
 
 	err := h.todoUseCase.UpdateBoard(r.Context(), board)
 
+	if errors.Is(err, repository.ErrVersionMismatch) {
+		http.Error(w, err.Error(), http.StatusPreconditionFailed)
+		return
+	}
+
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		http.Error(w, err.Error(), accessStatus(err))
 		return
 	}
 
+	w.Header().Set("ETag", etag(board.Version))
 	w.WriteHeader(http.StatusOK)
 }
 
@@ -147,10 +183,21 @@ func (h *TodoHandler) DeleteBoard(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	err = h.todoUseCase.DeleteBoard(r.Context(), id)
+	version, errMsg, status := ifMatch(r)
+	if errMsg != "" {
+		http.Error(w, errMsg, status)
+		return
+	}
+
+	err = h.todoUseCase.DeleteBoard(r.Context(), id, version)
+
+	if errors.Is(err, repository.ErrVersionMismatch) {
+		http.Error(w, err.Error(), http.StatusPreconditionFailed)
+		return
+	}
 
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		http.Error(w, err.Error(), accessStatus(err))
 		return
 	}
 
@@ -170,12 +217,18 @@ func (h *TodoHandler) CreateColumn(w http.ResponseWriter, r *http.Request) {
 		BoardID:  input.BoardID,
 		Title:    input.Title,
 		Position: input.Position,
+		Done:     input.Done,
 	}
 
 	err := h.todoUseCase.CreateColumn(r.Context(), column)
 
+	if errors.Is(err, repository.ErrQuotaExceeded) {
+		http.Error(w, err.Error(), http.StatusUnprocessableEntity)
+		return
+	}
+
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		http.Error(w, err.Error(), accessStatus(err))
 	}
 
 	w.WriteHeader(http.StatusCreated)
@@ -193,12 +246,13 @@ func (h *TodoHandler) GetColumnByID(w http.ResponseWriter, r *http.Request) {
 	column, err := h.todoUseCase.GetColumnByID(r.Context(), id)
 
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		http.Error(w, err.Error(), accessStatus(err))
 		return
 	}
 
 	columnDTO := dto.ToColumnDTO(column)
 
+	w.Header().Set("ETag", etag(column.Version))
 	json.NewEncoder(w).Encode(columnDTO)
 }
 
@@ -211,33 +265,21 @@ func (h *TodoHandler) GetColumnsByBoard(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	limit := h.config.Limit
-	if _, ok := query["limit"]; ok {
-		limitStr := query.Get("limit")
-		limitInt, err := strconv.Atoi(limitStr)
-		if err == nil {
-			limit = limitInt
-		}
-	}
-
-	offset := h.config.Offset
-	if _, ok := query["offset"]; ok {
-		offsetStr := query.Get("offset")
-		offsetInt, err := strconv.Atoi(offsetStr)
-		if err == nil {
-			offset = offsetInt
-		}
+	page, errMsg := h.parsePage(query)
+	if errMsg != "" {
+		http.Error(w, errMsg, http.StatusBadRequest)
+		return
 	}
 
-	columns, err := h.todoUseCase.GetColumnsByBoard(r.Context(), id, limit, offset)
+	columns, next, err := h.todoUseCase.GetColumnsByBoard(r.Context(), id, page)
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		http.Error(w, err.Error(), accessStatus(err))
 		return
 	}
 
 	columnDTOs := dto.ToColumnDTOs(columns)
 
-	json.NewEncoder(w).Encode(columnDTOs)
+	json.NewEncoder(w).Encode(dto.NewPage(columnDTOs, next))
 }
 
 func (h *TodoHandler) UpdateColumn(w http.ResponseWriter, r *http.Request) {
@@ -248,22 +290,97 @@ func (h *TodoHandler) UpdateColumn(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	version, errMsg, status := ifMatch(r)
+	if errMsg != "" {
+		http.Error(w, errMsg, status)
+		return
+	}
+
 	column := &entity.Column{
 		ID:       input.ID,
 		Title:    input.Title,
 		Position: input.Position,
+		Done:     input.Done,
+		Version:  version,
 	}
 
 	err := h.todoUseCase.UpdateColumn(r.Context(), column)
 
+	if errors.Is(err, repository.ErrVersionMismatch) {
+		http.Error(w, err.Error(), http.StatusPreconditionFailed)
+		return
+	}
+
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		http.Error(w, err.Error(), accessStatus(err))
 		return
 	}
 
+	w.Header().Set("ETag", etag(column.Version))
 	w.WriteHeader(http.StatusOK)
 }
 
+func (h *TodoHandler) MoveColumn(w http.ResponseWriter, r *http.Request) {
+	var input dto.MoveColumnRequest
+
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	version, errMsg, status := ifMatch(r)
+	if errMsg != "" {
+		http.Error(w, errMsg, status)
+		return
+	}
+
+	column, err := h.todoUseCase.MoveColumn(r.Context(), input.UserID, input.ID, input.BoardID, version)
+
+	if err != nil {
+		http.Error(w, err.Error(), reorganiseStatus(err))
+		return
+	}
+
+	w.Header().Set("ETag", etag(column.Version))
+	json.NewEncoder(w).Encode(dto.ToColumnDTO(column))
+}
+
+func (h *TodoHandler) MergeBoards(w http.ResponseWriter, r *http.Request) {
+	var input dto.MergeBoardsRequest
+
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	merge, err := h.todoUseCase.MergeBoards(r.Context(), input.UserID, input.SourceID, input.TargetID, input.Strategy)
+
+	if err != nil {
+		http.Error(w, err.Error(), reorganiseStatus(err))
+		return
+	}
+
+	json.NewEncoder(w).Encode(dto.ToBoardMergeDTO(merge))
+}
+
+// reorganiseStatus is the status of a failed column move or board merge.
+func reorganiseStatus(err error) int {
+	switch {
+	case errors.Is(err, repository.ErrColumnNotFound), errors.Is(err, repository.ErrBoardNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, repository.ErrBoardAccess):
+		return http.StatusForbidden
+	case errors.Is(err, repository.ErrColumnSameBoard), errors.Is(err, repository.ErrMergeSameBoard), errors.Is(err, repository.ErrMergeStrategy):
+		return http.StatusBadRequest
+	case errors.Is(err, repository.ErrVersionMismatch):
+		return http.StatusPreconditionFailed
+	case errors.Is(err, repository.ErrQuotaExceeded):
+		return http.StatusUnprocessableEntity
+	}
+
+	return accessStatus(err)
+}
+
 func (h *TodoHandler) DeleteColumn(w http.ResponseWriter, r *http.Request) {
 	query := r.URL.Query()
 	columnID := query.Get("id")
@@ -274,10 +391,167 @@ func (h *TodoHandler) DeleteColumn(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	err = h.todoUseCase.DeleteColumn(r.Context(), id)
+	version, errMsg, status := ifMatch(r)
+	if errMsg != "" {
+		http.Error(w, errMsg, status)
+		return
+	}
+
+	err = h.todoUseCase.DeleteColumn(r.Context(), id, version)
+
+	if errors.Is(err, repository.ErrVersionMismatch) {
+		http.Error(w, err.Error(), http.StatusPreconditionFailed)
+		return
+	}
+
+	if err != nil {
+		http.Error(w, err.Error(), accessStatus(err))
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+func (h *TodoHandler) CreateSwimlane(w http.ResponseWriter, r *http.Request) {
+	var input dto.CreateSwimlaneRequest
+
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	swimlane := &entity.Swimlane{
+		UserID:   input.UserID,
+		BoardID:  input.BoardID,
+		Title:    input.Title,
+		Position: input.Position,
+	}
+
+	err := h.todoUseCase.CreateSwimlane(r.Context(), swimlane)
+
+	if err != nil {
+		http.Error(w, err.Error(), accessStatus(err))
+		return
+	}
+
+	w.WriteHeader(http.StatusCreated)
+}
+
+func (h *TodoHandler) GetSwimlaneByID(w http.ResponseWriter, r *http.Request) {
+	swimlaneID := mux.Vars(r)["id"]
+	id, err := uuid.Parse(swimlaneID)
+
+	if err != nil {
+		http.Error(w, ErrInvalidLaneID, http.StatusBadRequest)
+		return
+	}
+
+	swimlane, err := h.todoUseCase.GetSwimlaneByID(r.Context(), id)
+
+	if err != nil {
+		http.Error(w, err.Error(), accessStatus(err))
+		return
+	}
+
+	swimlaneDTO := dto.ToSwimlaneDTO(swimlane)
+
+	w.Header().Set("ETag", etag(swimlane.Version))
+	json.NewEncoder(w).Encode(swimlaneDTO)
+}
+
+func (h *TodoHandler) GetSwimlanesByBoard(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	boardID := query.Get("board_id")
+	id, err := uuid.Parse(boardID)
+	if err != nil {
+		http.Error(w, ErrInvalidBoardID, http.StatusBadRequest)
+		return
+	}
+
+	page, errMsg := h.parsePage(query)
+	if errMsg != "" {
+		http.Error(w, errMsg, http.StatusBadRequest)
+		return
+	}
+
+	swimlanes, next, err := h.todoUseCase.GetSwimlanesByBoard(r.Context(), id, page)
+	if err != nil {
+		http.Error(w, err.Error(), accessStatus(err))
+		return
+	}
+
+	swimlaneDTOs := dto.ToSwimlaneDTOs(swimlanes)
+
+	json.NewEncoder(w).Encode(dto.NewPage(swimlaneDTOs, next))
+}
+
+func (h *TodoHandler) UpdateSwimlane(w http.ResponseWriter, r *http.Request) {
+	var input dto.UpdateSwimlaneRequest
+
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	version, errMsg, status := ifMatch(r)
+	if errMsg != "" {
+		http.Error(w, errMsg, status)
+		return
+	}
+
+	swimlane := &entity.Swimlane{
+		ID:       input.ID,
+		Title:    input.Title,
+		Position: input.Position,
+		Version:  version,
+	}
+
+	err := h.todoUseCase.UpdateSwimlane(r.Context(), swimlane)
+
+	if errors.Is(err, repository.ErrVersionMismatch) {
+		http.Error(w, err.Error(), http.StatusPreconditionFailed)
+		return
+	}
+
+	if err != nil {
+		http.Error(w, err.Error(), accessStatus(err))
+		return
+	}
+
+	w.Header().Set("ETag", etag(swimlane.Version))
+	w.WriteHeader(http.StatusOK)
+}
+
+func (h *TodoHandler) DeleteSwimlane(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	swimlaneID := query.Get("id")
+	id, err := uuid.Parse(swimlaneID)
+
+	if err != nil {
+		http.Error(w, ErrInvalidLaneID, http.StatusBadRequest)
+		return
+	}
+
+	version, errMsg, status := ifMatch(r)
+	if errMsg != "" {
+		http.Error(w, errMsg, status)
+		return
+	}
+
+	err = h.todoUseCase.DeleteSwimlane(r.Context(), id, version)
+
+	if errors.Is(err, repository.ErrVersionMismatch) {
+		http.Error(w, err.Error(), http.StatusPreconditionFailed)
+		return
+	}
+
+	if errors.Is(err, repository.ErrSwimlaneLast) {
+		http.Error(w, err.Error(), http.StatusConflict)
+		return
+	}
 
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		http.Error(w, err.Error(), accessStatus(err))
 		return
 	}
 
@@ -295,18 +569,46 @@ func (h *TodoHandler) CreateCard(w http.ResponseWriter, r *http.Request) {
 	card := &entity.Card{
 		UserID:      input.UserID,
 		ColumnID:    input.ColumnID,
+		SwimlaneID:  input.SwimlaneID,
+		ParentID:    input.ParentID,
 		Title:       input.Title,
 		Description: input.Description,
 		Position:    input.Position,
+		Priority:    input.Priority,
+		AssigneeID:  input.AssigneeID,
+		DueDate:     input.DueDate,
+		Labels:      input.Labels,
 	}
 
-	err := h.todoUseCase.CreateCard(r.Context(), card)
+	var err error
+	if input.TemplateID != nil {
+		err = h.templateUseCase.CreateCardFromTemplate(r.Context(), *input.TemplateID, card, input.Username)
+	} else {
+		err = h.todoUseCase.CreateCard(r.Context(), card)
+	}
+
+	if errors.Is(err, repository.ErrCardTemplateNotFound) || errors.Is(err, repository.ErrColumnNotFound) {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+
+	if errors.Is(err, repository.ErrCardTemplateBoard) {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if errors.Is(err, repository.ErrQuotaExceeded) {
+		http.Error(w, err.Error(), http.StatusUnprocessableEntity)
+		return
+	}
 
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		http.Error(w, err.Error(), accessStatus(err))
+		return
 	}
 
 	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(dto.ToCardDTO(card))
 }
 
 func (h *TodoHandler) GetCardByID(w http.ResponseWriter, r *http.Request) {
@@ -321,51 +623,185 @@ func (h *TodoHandler) GetCardByID(w http.ResponseWriter, r *http.Request) {
 	card, err := h.todoUseCase.GetCardByID(r.Context(), id)
 
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		http.Error(w, err.Error(), accessStatus(err))
 		return
 	}
 
 	cardDTO := dto.ToCardDTO(card)
 
+	w.Header().Set("ETag", etag(card.Version))
 	json.NewEncoder(w).Encode(cardDTO)
 }
 
-func (h *TodoHandler) GetCardsByColumn(w http.ResponseWriter, r *http.Request) {
-	query := r.URL.Query()
-	columnID := query.Get("column_id")
-	id, err := uuid.Parse(columnID)
+func (h *TodoHandler) GetCards(w http.ResponseWriter, r *http.Request) {
+	query, errMsg := h.parseCardQuery(r.URL.Query())
+	if errMsg != "" {
+		http.Error(w, errMsg, http.StatusBadRequest)
+		return
+	}
+
+	cards, next, err := h.todoUseCase.GetCards(r.Context(), query)
 	if err != nil {
-		http.Error(w, ErrInvalidColumnID, http.StatusBadRequest)
+		http.Error(w, err.Error(), cardQueryStatus(err))
 		return
 	}
 
-	limit := h.config.Limit
-	if _, ok := query["limit"]; ok {
-		limitStr := query.Get("limit")
-		limitInt, err := strconv.Atoi(limitStr)
-		if err == nil {
-			limit = limitInt
+	cardDTOs := dto.ToCardDTOs(cards)
+
+	json.NewEncoder(w).Encode(dto.NewPage(cardDTOs, next))
+}
+
+// cardQueryStatus is the status of a failed card listing.
+func cardQueryStatus(err error) int {
+	switch {
+	case errors.Is(err, repository.ErrCardQueryNoScope), errors.Is(err, repository.ErrCardQuerySortField),
+		errors.Is(err, repository.ErrCardInvalidPriority), errors.Is(err, repository.ErrNegativeLimit),
+		errors.Is(err, repository.ErrZeroLimit), errors.Is(err, repository.ErrInvalidTimeRange):
+		return http.StatusBadRequest
+	}
+
+	return accessStatus(err)
+}
+
+// accessStatus is the status of a request turned away on a board, column,
+// swimlane or card, or of any other failure.
+func accessStatus(err error) int {
+	switch {
+	case errors.Is(err, repository.ErrNoCaller):
+		return http.StatusUnauthorized
+	case errors.Is(err, repository.ErrBoardAccess):
+		return http.StatusForbidden
+	case errors.Is(err, repository.ErrBoardNotFound), errors.Is(err, repository.ErrColumnNotFound),
+		errors.Is(err, repository.ErrSwimlaneNotFound), errors.Is(err, repository.ErrCardNotFound):
+		return http.StatusNotFound
+	}
+
+	return http.StatusInternalServerError
+}
+
+// parseCardQuery reads a card listing query string. Date bounds are whole
+// days, so <<to>> dates include the day they name.
+func (h *TodoHandler) parseCardQuery(values url.Values) (repository.CardQuery, string) {
+	var query repository.CardQuery
+
+	page, errMsg := h.parsePage(values)
+	if errMsg != "" {
+		return query, errMsg
+	}
+	query.Page = page
+
+	ids := []struct {
+		param  string
+		target **uuid.UUID
+		errMsg string
+	}{
+		{"board_id", &query.BoardID, ErrInvalidBoardID},
+		{"column_id", &query.ColumnID, ErrInvalidColumnID},
+		{"swimlane_id", &query.SwimlaneID, ErrInvalidLaneID},
+		{"parent_id", &query.ParentID, ErrInvalidCardID},
+		{"user_id", &query.UserID, ErrInvalidUserID},
+		{"assignee_id", &query.AssigneeID, ErrInvalidUserID},
+	}
+	for _, p := range ids {
+		if v := values.Get(p.param); v != "" {
+			id, err := uuid.Parse(v)
+			if err != nil {
+				return query, p.errMsg
+			}
+			*p.target = &id
 		}
 	}
 
-	offset := h.config.Offset
-	if _, ok := query["offset"]; ok {
-		offsetStr := query.Get("offset")
-		offsetInt, err := strconv.Atoi(offsetStr)
-		if err == nil {
-			offset = offsetInt
+	if query.BoardID == nil && query.ColumnID == nil && query.SwimlaneID == nil && query.ParentID == nil {
+		return query, ErrNoCardScope
+	}
+
+	for _, v := range values["priority"] {
+		for _, part := range strings.Split(v, ",") {
+			priority, err := strconv.Atoi(strings.TrimSpace(part))
+			if err != nil {
+				return query, ErrInvalidPriority
+			}
+			query.Priorities = append(query.Priorities, priority)
 		}
 	}
 
-	cards, err := h.todoUseCase.GetCardsByColumn(r.Context(), id, limit, offset)
-	if err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
-		return
+	if v := values.Get("label"); v != "" {
+		query.Label = &v
 	}
 
-	cardDTOs := dto.ToCardDTOs(cards)
+	if v := values.Get("q"); v != "" {
+		query.Text = &v
+	}
+
+	dates := []struct {
+		param  string
+		target **time.Time
+		endOf  bool
+	}{
+		{"created_from", &query.CreatedFrom, false},
+		{"created_to", &query.CreatedTo, true},
+		{"updated_from", &query.UpdatedFrom, false},
+		{"updated_to", &query.UpdatedTo, true},
+		{"due_from", &query.DueFrom, false},
+		{"due_to", &query.DueTo, true},
+	}
+	for _, p := range dates {
+		if v := values.Get(p.param); v != "" {
+			t, err := time.Parse(dateLayout, v)
+			if err != nil {
+				return query, ErrInvalidDate
+			}
+			if p.endOf {
+				t = t.AddDate(0, 0, 1)
+			}
+			*p.target = &t
+		}
+	}
 
-	json.NewEncoder(w).Encode(cardDTOs)
+	if v := values.Get("archived"); v != "" {
+		archived, err := strconv.ParseBool(v)
+		if err != nil {
+			return query, ErrInvalidArchived
+		}
+		query.Archived = archived
+	}
+
+	query.SortBy = repository.CardSortField(values.Get("sort"))
+
+	switch values.Get("order") {
+	case "", "asc":
+	case "desc":
+		query.Descending = true
+	default:
+		return query, ErrInvalidSort
+	}
+
+	return query, ""
+}
+
+// parsePage reads the limit and cursor of a listing, falling back to the
+// configured page size.
+func (h *TodoHandler) parsePage(values url.Values) (repository.Page, string) {
+	return parsePage(values, h.config)
+}
+
+func parsePage(values url.Values, config config.PaginationConfig) (repository.Page, string) {
+	page := repository.Page{Limit: config.Limit}
+
+	if limit, err := strconv.Atoi(values.Get("limit")); err == nil {
+		page.Limit = limit
+	}
+
+	if token := values.Get("cursor"); token != "" {
+		after, err := repository.DecodeCursor(token)
+		if err != nil {
+			return page, ErrInvalidCursor
+		}
+		page.After = after
+	}
+
+	return page, ""
 }
 
 func (h *TodoHandler) GetNewCards(w http.ResponseWriter, r *http.Request) {
@@ -385,7 +821,18 @@ func (h *TodoHandler) GetNewCards(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	cards, err := h.todoUseCase.GetNewCards(r.Context(), from, to)
+	page, errMsg := h.parsePage(r.URL.Query())
+	if errMsg != "" {
+		http.Error(w, errMsg, http.StatusBadRequest)
+		return
+	}
+
+	cards, next, err := h.todoUseCase.GetNewCards(r.Context(), from, to, page)
+	if errors.Is(err, repository.ErrNoCaller) {
+		http.Error(w, err.Error(), http.StatusUnauthorized)
+		return
+	}
+
 	if err != nil {
 		http.Error(w, err.Error(), http.StatusNotFound)
 		return
@@ -393,7 +840,7 @@ func (h *TodoHandler) GetNewCards(w http.ResponseWriter, r *http.Request) {
 
 	cardDTOs := dto.ToCardDTOs(cards)
 
-	json.NewEncoder(w).Encode(cardDTOs)
+	json.NewEncoder(w).Encode(dto.NewPage(cardDTOs, next))
 }
 
 func (h *TodoHandler) UpdateCard(w http.ResponseWriter, r *http.Request) {
@@ -404,24 +851,108 @@ func (h *TodoHandler) UpdateCard(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	version, errMsg, status := ifMatch(r)
+	if errMsg != "" {
+		http.Error(w, errMsg, status)
+		return
+	}
+
 	card := &entity.Card{
 		ID:          input.ID,
 		ColumnID:    input.ColumnID,
+		SwimlaneID:  input.SwimlaneID,
 		Title:       input.Title,
 		Description: input.Description,
 		Position:    input.Position,
+		Priority:    input.Priority,
+		AssigneeID:  input.AssigneeID,
+		DueDate:     input.DueDate,
+		Labels:      input.Labels,
+		Version:     version,
 	}
 
 	err := h.todoUseCase.UpdateCard(r.Context(), card)
 
+	if errors.Is(err, repository.ErrVersionMismatch) {
+		http.Error(w, err.Error(), http.StatusPreconditionFailed)
+		return
+	}
+
+	if errors.Is(err, repository.ErrQuotaExceeded) {
+		http.Error(w, err.Error(), http.StatusUnprocessableEntity)
+		return
+	}
+
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		http.Error(w, err.Error(), accessStatus(err))
 		return
 	}
 
+	w.Header().Set("ETag", etag(card.Version))
 	w.WriteHeader(http.StatusOK)
 }
 
+func (h *TodoHandler) SetCardParent(w http.ResponseWriter, r *http.Request) {
+	var input dto.SetCardParentRequest
+
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	version, errMsg, status := ifMatch(r)
+	if errMsg != "" {
+		http.Error(w, errMsg, status)
+		return
+	}
+
+	card := &entity.Card{
+		ID:       input.ID,
+		ParentID: input.ParentID,
+		Version:  version,
+	}
+
+	err := h.todoUseCase.SetCardParent(r.Context(), card)
+
+	if errors.Is(err, repository.ErrVersionMismatch) {
+		http.Error(w, err.Error(), http.StatusPreconditionFailed)
+		return
+	}
+
+	if errors.Is(err, repository.ErrCardParentCycle) || errors.Is(err, repository.ErrCardTooDeep) {
+		http.Error(w, err.Error(), http.StatusConflict)
+		return
+	}
+
+	if err != nil {
+		http.Error(w, err.Error(), accessStatus(err))
+		return
+	}
+
+	w.Header().Set("ETag", etag(card.Version))
+	w.WriteHeader(http.StatusOK)
+}
+
+// GetCardAncestors lists the parent of a card up to the root of its tree.
+func (h *TodoHandler) GetCardAncestors(w http.ResponseWriter, r *http.Request) {
+	cardID := mux.Vars(r)["id"]
+	id, err := uuid.Parse(cardID)
+
+	if err != nil {
+		http.Error(w, ErrInvalidCardID, http.StatusBadRequest)
+		return
+	}
+
+	cards, err := h.todoUseCase.GetCardAncestors(r.Context(), id)
+
+	if err != nil {
+		http.Error(w, err.Error(), accessStatus(err))
+		return
+	}
+
+	json.NewEncoder(w).Encode(dto.ToCardDTOs(cards))
+}
+
 func (h *TodoHandler) DeleteCard(w http.ResponseWriter, r *http.Request) {
 	query := r.URL.Query()
 	cardID := query.Get("id")
@@ -432,12 +963,72 @@ func (h *TodoHandler) DeleteCard(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	err = h.todoUseCase.DeleteCard(r.Context(), id)
+	version, errMsg, status := ifMatch(r)
+	if errMsg != "" {
+		http.Error(w, errMsg, status)
+		return
+	}
+
+	err = h.todoUseCase.DeleteCard(r.Context(), id, version)
+
+	if errors.Is(err, repository.ErrVersionMismatch) {
+		http.Error(w, err.Error(), http.StatusPreconditionFailed)
+		return
+	}
 
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		http.Error(w, err.Error(), accessStatus(err))
 		return
 	}
 
 	w.WriteHeader(http.StatusOK)
 }
+
+func (h *TodoHandler) BulkCards(w http.ResponseWriter, r *http.Request) {
+	var input dto.BulkCardsRequest
+
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	results, err := h.todoUseCase.ApplyCardOps(r.Context(), dto.ToCardOps(input.Ops), input.AllOrNothing)
+
+	if errors.Is(err, repository.ErrBulkEmpty) || errors.Is(err, repository.ErrBulkTooLarge) {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if err != nil {
+		http.Error(w, err.Error(), accessStatus(err))
+		return
+	}
+
+	json.NewEncoder(w).Encode(dto.ToBulkCardsResponse(input.Ops, results))
+}
+
+// etag renders a row version as a strong entity tag.
+func etag(version int) string {
+	return strconv.Quote(strconv.Itoa(version))
+}
+
+// ifMatch reads the version a write is conditioned on. Writes without one
+// are refused, so that no client overwrites changes it has not seen.
+func ifMatch(r *http.Request) (int, string, int) {
+	header := r.Header.Get("If-Match")
+	if header == "" {
+		return 0, ErrNoIfMatch, http.StatusPreconditionRequired
+	}
+
+	tag, err := strconv.Unquote(header)
+	if err != nil {
+		return 0, ErrInvalidIfMatch, http.StatusBadRequest
+	}
+
+	version, err := strconv.Atoi(tag)
+	if err != nil || version <= 0 {
+		return 0, ErrInvalidIfMatch, http.StatusBadRequest
+	}
+
+	return version, "", 0
+}
@@ -0,0 +1,144 @@
+package v1_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"todo/internal/config"
+	"todo/internal/entity"
+	v1 "todo/internal/handler/v1"
+	"todo/internal/repository"
+	"todo/internal/testdata"
+	"todo/mocks"
+
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/runner"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestGetCards(t *testing.T) {
+	runner.Run(t, "TestGetCards", func(pt provider.T) {
+		mom := &testdata.ObjectMother{}
+
+		boardID := mom.GetUUID(0)
+		cards := []entity.Card{{ID: mom.GetUUID(1), Title: "Card"}}
+
+		// Validation errors come back wrapped, as the usecase returns them.
+		invalid := func(err error) error {
+			return fmt.Errorf("GetCards: Validation failed: %w", err)
+		}
+
+		tests := []struct {
+			name      string
+			target    string
+			mockSetup func(mockTodoUseCase *mocks.TodoUseCase)
+			status    int
+		}{
+			{
+				name:   "positive",
+				target: "/api/v1/cards?board_id=" + boardID.String(),
+				mockSetup: func(mockTodoUseCase *mocks.TodoUseCase) {
+					mockTodoUseCase.On("GetCards", mock.Anything, mock.MatchedBy(func(query repository.CardQuery) bool {
+						return query.BoardID != nil && *query.BoardID == boardID && query.Page.Limit == 20
+					})).Return(cards, nil, nil)
+				},
+				status: http.StatusOK,
+			},
+			{
+				name:      "no scope",
+				target:    "/api/v1/cards",
+				mockSetup: func(mockTodoUseCase *mocks.TodoUseCase) {},
+				status:    http.StatusBadRequest,
+			},
+			{
+				name:   "unknown sort field",
+				target: "/api/v1/cards?sort=colour&board_id=" + boardID.String(),
+				mockSetup: func(mockTodoUseCase *mocks.TodoUseCase) {
+					mockTodoUseCase.On("GetCards", mock.Anything, mock.Anything).Return(nil, nil, invalid(repository.ErrCardQuerySortField))
+				},
+				status: http.StatusBadRequest,
+			},
+			{
+				name:   "invalid priority",
+				target: "/api/v1/cards?priority=9&board_id=" + boardID.String(),
+				mockSetup: func(mockTodoUseCase *mocks.TodoUseCase) {
+					mockTodoUseCase.On("GetCards", mock.Anything, mock.Anything).Return(nil, nil, invalid(repository.ErrCardInvalidPriority))
+				},
+				status: http.StatusBadRequest,
+			},
+			{
+				name:   "negative limit",
+				target: "/api/v1/cards?limit=-1&board_id=" + boardID.String(),
+				mockSetup: func(mockTodoUseCase *mocks.TodoUseCase) {
+					mockTodoUseCase.On("GetCards", mock.Anything, mock.Anything).Return(nil, nil, invalid(repository.ErrNegativeLimit))
+				},
+				status: http.StatusBadRequest,
+			},
+			{
+				name:   "zero limit",
+				target: "/api/v1/cards?limit=0&board_id=" + boardID.String(),
+				mockSetup: func(mockTodoUseCase *mocks.TodoUseCase) {
+					mockTodoUseCase.On("GetCards", mock.Anything, mock.Anything).Return(nil, nil, invalid(repository.ErrZeroLimit))
+				},
+				status: http.StatusBadRequest,
+			},
+			{
+				name:   "reversed date range",
+				target: "/api/v1/cards?created_from=02-01-2024&created_to=01-01-2024&board_id=" + boardID.String(),
+				mockSetup: func(mockTodoUseCase *mocks.TodoUseCase) {
+					mockTodoUseCase.On("GetCards", mock.Anything, mock.Anything).Return(nil, nil, invalid(repository.ErrInvalidTimeRange))
+				},
+				status: http.StatusBadRequest,
+			},
+			{
+				name:   "no access",
+				target: "/api/v1/cards?board_id=" + boardID.String(),
+				mockSetup: func(mockTodoUseCase *mocks.TodoUseCase) {
+					mockTodoUseCase.On("GetCards", mock.Anything, mock.Anything).Return(nil, nil, fmt.Errorf("GetCards: %w", repository.ErrBoardAccess))
+				},
+				status: http.StatusForbidden,
+			},
+			{
+				name:   "no caller",
+				target: "/api/v1/cards?board_id=" + boardID.String(),
+				mockSetup: func(mockTodoUseCase *mocks.TodoUseCase) {
+					mockTodoUseCase.On("GetCards", mock.Anything, mock.Anything).Return(nil, nil, fmt.Errorf("GetCards: %w", repository.ErrNoCaller))
+				},
+				status: http.StatusUnauthorized,
+			},
+			{
+				name:   "negative",
+				target: "/api/v1/cards?board_id=" + boardID.String(),
+				mockSetup: func(mockTodoUseCase *mocks.TodoUseCase) {
+					mockTodoUseCase.On("GetCards", mock.Anything, mock.Anything).Return(nil, nil, errors.New(""))
+				},
+				status: http.StatusInternalServerError,
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				t.Parallel()
+
+				runner.Run(t, tt.name, func(pt provider.T) {
+					mockTodoUseCase := new(mocks.TodoUseCase)
+
+					h := v1.NewTodoHandler(mockTodoUseCase, nil, config.PaginationConfig{Limit: 20})
+
+					tt.mockSetup(mockTodoUseCase)
+
+					pt.WithNewStep("Call GetCards", func(sCtx provider.StepCtx) {
+						w := httptest.NewRecorder()
+						h.GetCards(w, httptest.NewRequest(http.MethodGet, tt.target, nil))
+
+						sCtx.Assert().Equal(tt.status, w.Code)
+					})
+
+					mockTodoUseCase.AssertExpectations(t)
+				})
+			})
+		}
+	})
+}
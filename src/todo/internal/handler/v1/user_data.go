@@ -0,0 +1,103 @@
+package v1
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"todo/internal/dto"
+	"todo/internal/repository"
+	"todo/internal/usecase"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+)
+
+type UserDataHandler struct {
+	userDataUseCase usecase.UserDataUseCase
+}
+
+func NewUserDataHandler(userDataUseCase usecase.UserDataUseCase) *UserDataHandler {
+	return &UserDataHandler{userDataUseCase: userDataUseCase}
+}
+
+func (h *UserDataHandler) TransferBoard(w http.ResponseWriter, r *http.Request) {
+	boardID, err := uuid.Parse(mux.Vars(r)["id"])
+	if err != nil {
+		http.Error(w, ErrInvalidBoardID, http.StatusBadRequest)
+		return
+	}
+
+	var input dto.TransferBoardRequest
+
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	board, err := h.userDataUseCase.TransferBoard(r.Context(), boardID, input.UserID)
+
+	if err != nil {
+		http.Error(w, err.Error(), transferStatus(err))
+		return
+	}
+
+	json.NewEncoder(w).Encode(dto.ToBoardDTO(board))
+}
+
+func (h *UserDataHandler) TransferUserBoards(w http.ResponseWriter, r *http.Request) {
+	userID, err := uuid.Parse(mux.Vars(r)["id"])
+	if err != nil {
+		http.Error(w, ErrInvalidUserID, http.StatusBadRequest)
+		return
+	}
+
+	var input dto.TransferUserBoardsRequest
+
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	boards, err := h.userDataUseCase.TransferUserBoards(r.Context(), userID, input.UserID, input.BoardIDs)
+
+	if err != nil {
+		http.Error(w, err.Error(), transferStatus(err))
+		return
+	}
+
+	json.NewEncoder(w).Encode(dto.ToBoardDTOs(boards))
+}
+
+func (h *UserDataHandler) DeleteUserData(w http.ResponseWriter, r *http.Request) {
+	userID, err := uuid.Parse(mux.Vars(r)["id"])
+	if err != nil {
+		http.Error(w, ErrInvalidUserID, http.StatusBadRequest)
+		return
+	}
+
+	err = h.userDataUseCase.DeleteUserData(r.Context(), userID)
+
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func transferStatus(err error) int {
+	switch {
+	case errors.Is(err, repository.ErrBoardNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, repository.ErrBoardAccess):
+		return http.StatusForbidden
+	case errors.Is(err, repository.ErrTransferNoUserID), errors.Is(err, repository.ErrTransferToSelf):
+		return http.StatusBadRequest
+	case errors.Is(err, repository.ErrVersionMismatch):
+		return http.StatusConflict
+	case errors.Is(err, repository.ErrQuotaExceeded):
+		return http.StatusUnprocessableEntity
+	}
+
+	return http.StatusInternalServerError
+}
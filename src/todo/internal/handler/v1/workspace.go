@@ -0,0 +1,211 @@
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"todo/internal/config"
+	"todo/internal/dto"
+	"todo/internal/entity"
+	"todo/internal/repository"
+	"todo/internal/usecase"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+)
+
+type WorkspaceHandler struct {
+	workspaceUseCase usecase.WorkspaceUseCase
+	config           config.PaginationConfig
+}
+
+func NewWorkspaceHandler(workspaceUseCase usecase.WorkspaceUseCase, config config.PaginationConfig) *WorkspaceHandler {
+	return &WorkspaceHandler{workspaceUseCase: workspaceUseCase, config: config}
+}
+
+func (h *WorkspaceHandler) CreateWorkspace(w http.ResponseWriter, r *http.Request) {
+	var input dto.CreateWorkspaceRequest
+
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	workspace := &entity.Workspace{Name: input.Name, CreatedBy: input.UserID}
+
+	err := h.workspaceUseCase.CreateWorkspace(r.Context(), workspace)
+
+	if err != nil {
+		http.Error(w, err.Error(), workspaceStatus(err))
+		return
+	}
+
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(dto.ToWorkspaceDTO(workspace))
+}
+
+func (h *WorkspaceHandler) GetWorkspacesByUser(w http.ResponseWriter, r *http.Request) {
+	userID, err := uuid.Parse(r.URL.Query().Get("user_id"))
+	if err != nil {
+		http.Error(w, ErrInvalidUserID, http.StatusBadRequest)
+		return
+	}
+
+	workspaces, err := h.workspaceUseCase.GetWorkspacesByUser(r.Context(), userID)
+
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	json.NewEncoder(w).Encode(dto.ToWorkspaceMembershipDTOs(workspaces))
+}
+
+func (h *WorkspaceHandler) GetWorkspaceMembers(w http.ResponseWriter, r *http.Request) {
+	workspaceID, err := uuid.Parse(mux.Vars(r)["id"])
+	if err != nil {
+		http.Error(w, ErrInvalidWorkspaceID, http.StatusBadRequest)
+		return
+	}
+
+	userID, err := uuid.Parse(r.URL.Query().Get("user_id"))
+	if err != nil {
+		http.Error(w, ErrInvalidUserID, http.StatusBadRequest)
+		return
+	}
+
+	members, err := h.workspaceUseCase.GetWorkspaceMembers(r.Context(), userID, workspaceID)
+
+	if err != nil {
+		http.Error(w, err.Error(), workspaceStatus(err))
+		return
+	}
+
+	json.NewEncoder(w).Encode(dto.ToWorkspaceMemberDTOs(members))
+}
+
+func (h *WorkspaceHandler) AddWorkspaceMember(w http.ResponseWriter, r *http.Request) {
+	h.changeWorkspaceMember(w, r, h.workspaceUseCase.AddWorkspaceMember)
+}
+
+func (h *WorkspaceHandler) UpdateWorkspaceMember(w http.ResponseWriter, r *http.Request) {
+	h.changeWorkspaceMember(w, r, h.workspaceUseCase.UpdateWorkspaceMember)
+}
+
+func (h *WorkspaceHandler) changeWorkspaceMember(
+	w http.ResponseWriter,
+	r *http.Request,
+	change func(ctx context.Context, actorID uuid.UUID, member *entity.WorkspaceMember) error,
+) {
+	workspaceID, err := uuid.Parse(mux.Vars(r)["id"])
+	if err != nil {
+		http.Error(w, ErrInvalidWorkspaceID, http.StatusBadRequest)
+		return
+	}
+
+	var input dto.WorkspaceMemberRequest
+
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	member := &entity.WorkspaceMember{
+		WorkspaceID: workspaceID,
+		UserID:      input.UserID,
+		Role:        input.Role,
+	}
+
+	err = change(r.Context(), input.ActorID, member)
+
+	if err != nil {
+		http.Error(w, err.Error(), workspaceStatus(err))
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *WorkspaceHandler) RemoveWorkspaceMember(w http.ResponseWriter, r *http.Request) {
+	workspaceID, err := uuid.Parse(mux.Vars(r)["id"])
+	if err != nil {
+		http.Error(w, ErrInvalidWorkspaceID, http.StatusBadRequest)
+		return
+	}
+
+	query := r.URL.Query()
+
+	actorID, err := uuid.Parse(query.Get("actor_id"))
+	if err != nil {
+		http.Error(w, ErrInvalidActorID, http.StatusBadRequest)
+		return
+	}
+
+	userID, err := uuid.Parse(query.Get("user_id"))
+	if err != nil {
+		http.Error(w, ErrInvalidUserID, http.StatusBadRequest)
+		return
+	}
+
+	err = h.workspaceUseCase.RemoveWorkspaceMember(r.Context(), actorID, workspaceID, userID)
+
+	if err != nil {
+		http.Error(w, err.Error(), workspaceStatus(err))
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *WorkspaceHandler) GetWorkspaceBoards(w http.ResponseWriter, r *http.Request) {
+	workspaceID, err := uuid.Parse(mux.Vars(r)["id"])
+	if err != nil {
+		http.Error(w, ErrInvalidWorkspaceID, http.StatusBadRequest)
+		return
+	}
+
+	query := r.URL.Query()
+
+	userID, err := uuid.Parse(query.Get("user_id"))
+	if err != nil {
+		http.Error(w, ErrInvalidUserID, http.StatusBadRequest)
+		return
+	}
+
+	page, errMsg := parsePage(query, h.config)
+	if errMsg != "" {
+		http.Error(w, errMsg, http.StatusBadRequest)
+		return
+	}
+
+	boards, next, err := h.workspaceUseCase.GetWorkspaceBoards(r.Context(), userID, workspaceID, page)
+
+	if err != nil {
+		http.Error(w, err.Error(), workspaceStatus(err))
+		return
+	}
+
+	json.NewEncoder(w).Encode(dto.NewPage(dto.ToBoardDTOs(boards), next))
+}
+
+func workspaceStatus(err error) int {
+	switch {
+	case errors.Is(err, repository.ErrWorkspaceNotFound),
+		errors.Is(err, repository.ErrWorkspaceMemberNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, repository.ErrWorkspaceAccess):
+		return http.StatusForbidden
+	case errors.Is(err, repository.ErrWorkspaceMemberExists),
+		errors.Is(err, repository.ErrWorkspaceLastAdmin),
+		errors.Is(err, repository.ErrWorkspacePersonal):
+		return http.StatusConflict
+	case errors.Is(err, repository.ErrWorkspaceNoName),
+		errors.Is(err, repository.ErrWorkspaceNoCreator),
+		errors.Is(err, repository.ErrWorkspaceMemberNoUserID),
+		errors.Is(err, repository.ErrWorkspaceRole):
+		return http.StatusBadRequest
+	}
+
+	return http.StatusInternalServerError
+}
@@ -0,0 +1,45 @@
+package middleware
+
+import (
+	"net/http"
+	"todo/internal/usecase"
+
+	"github.com/google/uuid"
+)
+
+const (
+	UserIDHeader = "X-User-ID"
+	RoleHeader   = "X-User-Role"
+)
+
+// CallerMiddleware puts the user the aggregator forwards a request for on
+// its context. Requests without one, such as calendar feeds, pass as they
+// are; the paths that need a caller turn them away.
+type CallerMiddleware struct{}
+
+func NewCallerMiddleware() *CallerMiddleware {
+	return &CallerMiddleware{}
+}
+
+func (cm *CallerMiddleware) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		header := r.Header.Get(UserIDHeader)
+		if header == "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		userID, err := uuid.Parse(header)
+		if err != nil {
+			http.Error(w, "invalid "+UserIDHeader+" header", http.StatusBadRequest)
+			return
+		}
+
+		caller := usecase.Caller{
+			UserID: userID,
+			Admin:  r.Header.Get(RoleHeader) == "admin",
+		}
+
+		next.ServeHTTP(w, r.WithContext(usecase.WithCaller(r.Context(), caller)))
+	})
+}
@@ -0,0 +1,58 @@
+package repository
+
+import (
+	"encoding/json"
+	"time"
+	"todo/internal/entity"
+
+	"github.com/google/uuid"
+)
+
+// Activity keeps the changed entity as JSON in Payload.
+type Activity struct {
+	ID        int64     `db:"id"         bson:"_id"`
+	BoardID   uuid.UUID `db:"board_id"   bson:"board_id"`
+	Kind      string    `db:"kind"       bson:"kind"`
+	Payload   string    `db:"payload"    bson:"payload"`
+	CreatedAt time.Time `db:"created_at" bson:"created_at"`
+}
+
+type activityPayload struct {
+	Board    *entity.Board    `json:"board,omitempty"`
+	Column   *entity.Column   `json:"column,omitempty"`
+	Swimlane *entity.Swimlane `json:"swimlane,omitempty"`
+	Card     *entity.Card     `json:"card,omitempty"`
+}
+
+func RepoActivity(e entity.Activity) (Activity, error) {
+	payload, err := json.Marshal(activityPayload{Board: e.Board, Column: e.Column, Swimlane: e.Swimlane, Card: e.Card})
+	if err != nil {
+		return Activity{}, err
+	}
+
+	return Activity{
+		ID:        e.ID,
+		BoardID:   e.BoardID,
+		Kind:      e.Kind,
+		Payload:   string(payload),
+		CreatedAt: e.CreatedAt,
+	}, nil
+}
+
+func ActivityToEntity(r Activity) (entity.Activity, error) {
+	var payload activityPayload
+	if err := json.Unmarshal([]byte(r.Payload), &payload); err != nil {
+		return entity.Activity{}, err
+	}
+
+	return entity.Activity{
+		ID:        r.ID,
+		BoardID:   r.BoardID,
+		Kind:      r.Kind,
+		Board:     payload.Board,
+		Column:    payload.Column,
+		Swimlane:  payload.Swimlane,
+		Card:      payload.Card,
+		CreatedAt: r.CreatedAt,
+	}, nil
+}
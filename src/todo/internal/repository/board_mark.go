@@ -0,0 +1,71 @@
+package repository
+
+import (
+	"time"
+	"todo/internal/entity"
+
+	"github.com/google/uuid"
+)
+
+// RecentBoardsLimit is how many of the boards a user opened last are kept
+// as recently viewed.
+const RecentBoardsLimit = 10
+
+type BoardStar struct {
+	UserID    uuid.UUID `db:"user_id"`
+	BoardID   uuid.UUID `db:"board_id"`
+	CreatedAt time.Time `db:"created_at"`
+}
+
+type BoardView struct {
+	UserID   uuid.UUID `db:"user_id"`
+	BoardID  uuid.UUID `db:"board_id"`
+	ViewedAt time.Time `db:"viewed_at"`
+}
+
+type MarkedBoard struct {
+	Board
+	StarredAt *time.Time `db:"starred_at"`
+	ViewedAt  *time.Time `db:"viewed_at"`
+}
+
+func RepoBoardStar(s entity.BoardStar) BoardStar {
+	return BoardStar{
+		UserID:    s.UserID,
+		BoardID:   s.BoardID,
+		CreatedAt: s.CreatedAt,
+	}
+}
+
+func RepoBoardView(v entity.BoardView) BoardView {
+	return BoardView{
+		UserID:   v.UserID,
+		BoardID:  v.BoardID,
+		ViewedAt: v.ViewedAt,
+	}
+}
+
+func MarkedBoardToEntity(r MarkedBoard) entity.MarkedBoard {
+	return entity.MarkedBoard{
+		Board:     BoardToEntity(r.Board),
+		StarredAt: r.StarredAt,
+		ViewedAt:  r.ViewedAt,
+	}
+}
+
+// MarkedBoardRank is where a board falls in the starred-first listing of
+// boards: starred boards come first, then the recently viewed ones, both by
+// the last time they were opened, and then the rest in the order they were
+// created. Starred boards not viewed recently count as opened when starred.
+func MarkedBoardRank(board entity.MarkedBoard) (int, time.Time) {
+	switch {
+	case board.StarredAt != nil && board.ViewedAt != nil:
+		return 0, *board.ViewedAt
+	case board.StarredAt != nil:
+		return 0, *board.StarredAt
+	case board.ViewedAt != nil:
+		return 1, *board.ViewedAt
+	default:
+		return 2, board.CreatedAt
+	}
+}
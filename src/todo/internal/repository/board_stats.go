@@ -0,0 +1,87 @@
+package repository
+
+import (
+	"errors"
+	"time"
+	"todo/internal/entity"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// DefaultStaleDays is how long a card goes untouched before board stats
+	// call it stale, unless asked otherwise.
+	DefaultStaleDays = 14
+	// MaxStaleDays caps the stale threshold board stats take.
+	MaxStaleDays = 3650
+	// MaxStaleCards caps the stale cards board stats list.
+	MaxStaleCards = 100
+	// MaxContributors caps the contributors board stats rank.
+	MaxContributors = 10
+)
+
+var ErrStaleDays = errors.New("stale threshold should be from 1 to 3650 days")
+
+type ColumnCards struct {
+	ColumnID    uuid.UUID `db:"column_id"`
+	ColumnTitle string    `db:"column_title"`
+	Done        bool      `db:"done"`
+	Cards       int       `db:"cards"`
+}
+
+type CreatedCard struct {
+	CardID    uuid.UUID  `db:"card_id"`
+	UserID    *uuid.UUID `db:"user_id"`
+	CreatedAt time.Time  `db:"created_at"`
+}
+
+type CompletedCard struct {
+	CardID     uuid.UUID  `db:"card_id"`
+	AssigneeID *uuid.UUID `db:"assignee_id"`
+	DoneAt     time.Time  `db:"done_at"`
+}
+
+type StaleCard struct {
+	CardID      uuid.UUID  `db:"card_id"`
+	ColumnID    uuid.UUID  `db:"column_id"`
+	ColumnTitle string     `db:"column_title"`
+	Title       string     `db:"title"`
+	AssigneeID  *uuid.UUID `db:"assignee_id"`
+	UpdatedAt   time.Time  `db:"updated_at"`
+}
+
+func ColumnCardsToEntity(r ColumnCards) entity.ColumnCards {
+	return entity.ColumnCards{
+		ColumnID:    r.ColumnID,
+		ColumnTitle: r.ColumnTitle,
+		Done:        r.Done,
+		Cards:       r.Cards,
+	}
+}
+
+func CreatedCardToEntity(r CreatedCard) entity.CreatedCard {
+	return entity.CreatedCard{
+		CardID:    r.CardID,
+		UserID:    r.UserID,
+		CreatedAt: r.CreatedAt,
+	}
+}
+
+func CompletedCardToEntity(r CompletedCard) entity.CompletedCard {
+	return entity.CompletedCard{
+		CardID:     r.CardID,
+		AssigneeID: r.AssigneeID,
+		DoneAt:     r.DoneAt,
+	}
+}
+
+func StaleCardToEntity(r StaleCard) entity.StaleCard {
+	return entity.StaleCard{
+		CardID:      r.CardID,
+		ColumnID:    r.ColumnID,
+		ColumnTitle: r.ColumnTitle,
+		Title:       r.Title,
+		AssigneeID:  r.AssigneeID,
+		UpdatedAt:   r.UpdatedAt,
+	}
+}
@@ -0,0 +1,64 @@
+package repository
+
+import (
+	"errors"
+	"time"
+	"todo/internal/entity"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrCalendarTokenNotFound = errors.New("calendar token not found")
+	ErrCalendarNoUserID      = errors.New("calendar token should have a user")
+)
+
+type CalendarToken struct {
+	UserID    uuid.UUID `db:"user_id"`
+	TokenHash string    `db:"token_hash"`
+	CreatedAt time.Time `db:"created_at"`
+}
+
+type CalendarCard struct {
+	CardID      uuid.UUID `db:"card_id"`
+	BoardID     uuid.UUID `db:"board_id"`
+	BoardTitle  string    `db:"board_title"`
+	Title       string    `db:"title"`
+	Description string    `db:"description"`
+	DueDate     time.Time `db:"due_date"`
+	Done        bool      `db:"done"`
+	Version     int       `db:"version"`
+	CreatedAt   time.Time `db:"created_at"`
+	UpdatedAt   time.Time `db:"updated_at"`
+}
+
+func RepoCalendarToken(t entity.CalendarToken) CalendarToken {
+	return CalendarToken{
+		UserID:    t.UserID,
+		TokenHash: t.TokenHash,
+		CreatedAt: t.CreatedAt,
+	}
+}
+
+func CalendarTokenToEntity(r CalendarToken) entity.CalendarToken {
+	return entity.CalendarToken{
+		UserID:    r.UserID,
+		TokenHash: r.TokenHash,
+		CreatedAt: r.CreatedAt,
+	}
+}
+
+func CalendarCardToEntity(r CalendarCard) entity.CalendarCard {
+	return entity.CalendarCard{
+		CardID:      r.CardID,
+		BoardID:     r.BoardID,
+		BoardTitle:  r.BoardTitle,
+		Title:       r.Title,
+		Description: r.Description,
+		DueDate:     r.DueDate,
+		Done:        r.Done,
+		Version:     r.Version,
+		CreatedAt:   r.CreatedAt,
+		UpdatedAt:   r.UpdatedAt,
+	}
+}
@@ -0,0 +1,51 @@
+package repository
+
+import (
+	"errors"
+	"time"
+	"todo/internal/entity"
+
+	"github.com/google/uuid"
+)
+
+// MaxAnalyticsDays caps the number of days board analytics are computed
+// over.
+const MaxAnalyticsDays = 366
+
+var (
+	ErrBoardNotFound   = errors.New("board not found")
+	ErrAnalyticsBounds = errors.New("analytics period should end after it starts")
+	ErrAnalyticsRange  = errors.New("analytics period should be at most 366 days long")
+)
+
+type CardFlow struct {
+	CardID    uuid.UUID  `db:"card_id"`
+	CreatedAt time.Time  `db:"created_at"`
+	StartedAt *time.Time `db:"started_at"`
+	DoneAt    time.Time  `db:"done_at"`
+}
+
+type ColumnCount struct {
+	Day         time.Time `db:"day"`
+	ColumnID    uuid.UUID `db:"column_id"`
+	ColumnTitle string    `db:"column_title"`
+	Count       int       `db:"count"`
+}
+
+func CardFlowToEntity(r CardFlow) entity.CardFlow {
+	return entity.CardFlow{
+		CardID:    r.CardID,
+		CreatedAt: r.CreatedAt,
+		StartedAt: r.StartedAt,
+		DoneAt:    r.DoneAt,
+	}
+}
+
+func ColumnCountToEntity(r ColumnCount) entity.ColumnCount {
+	return entity.ColumnCount{
+		Day:         r.Day,
+		ColumnID:    r.ColumnID,
+		ColumnTitle: r.ColumnTitle,
+		Count:       r.Count,
+	}
+}
@@ -0,0 +1,61 @@
+package repository
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrCardNotFound = errors.New("card not found")
+	ErrBulkEmpty    = errors.New("bulk operation should have at least one item")
+	ErrBulkTooLarge = errors.New("bulk operation has too many items")
+)
+
+type CardOpKind string
+
+const (
+	CardOpMove        CardOpKind = "move"
+	CardOpArchive     CardOpKind = "archive"
+	CardOpDelete      CardOpKind = "delete"
+	CardOpSetLabel    CardOpKind = "set_label"
+	CardOpSetAssignee CardOpKind = "set_assignee"
+)
+
+// CardOp is one item of a bulk card operation. Only the fields its kind
+// needs are read. Version is the version of the card the operation was
+// made against; it fails if the card has changed since. Cascade tells
+// whether archiving a card archives its descendants too; it has to be set
+// for cards with active children.
+type CardOp struct {
+	Kind       CardOpKind
+	CardID     uuid.UUID
+	Version    int
+	ColumnID   uuid.UUID
+	Label      string
+	AssigneeID uuid.UUID
+	Cascade    *bool
+}
+
+// CardBatch is a list of operations applied in one transaction. Unless
+// AllOrNothing is set, a failed operation is undone on its own and the rest
+// of the batch still commits.
+type CardBatch struct {
+	Ops          []CardOp
+	AllOrNothing bool
+	At           time.Time
+}
+
+type CardOpStatus string
+
+const (
+	CardOpApplied    CardOpStatus = "applied"
+	CardOpFailed     CardOpStatus = "failed"
+	CardOpRolledBack CardOpStatus = "rolled_back"
+)
+
+type CardOpResult struct {
+	Status CardOpStatus
+	Err    error
+}
@@ -0,0 +1,102 @@
+package repository
+
+import (
+	"encoding/json"
+	"errors"
+	"time"
+	"todo/internal/entity"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrCardTemplateNotFound = errors.New("card template not found")
+	ErrCardTemplateExists   = errors.New("board already has a card template of that name")
+	ErrCardTemplateNoName   = errors.New("card template should have a name")
+	ErrCardTemplateInvalid  = errors.New("card template labels and checklists should not be empty or repeat")
+	ErrCardTemplateBoard    = errors.New("card should be on the board of its template")
+)
+
+// CardTemplate keeps the labels and checklists as JSON arrays.
+type CardTemplate struct {
+	ID           uuid.UUID `db:"id"`
+	BoardID      uuid.UUID `db:"board_id"`
+	Name         string    `db:"name"`
+	TitlePattern string    `db:"title_pattern"`
+	Description  string    `db:"description"`
+	Labels       string    `db:"labels"`
+	Checklists   string    `db:"checklists"`
+	CreatedBy    uuid.UUID `db:"created_by"`
+	CreatedAt    time.Time `db:"created_at"`
+	UpdatedAt    time.Time `db:"updated_at"`
+}
+
+type checklist struct {
+	Title string   `json:"title"`
+	Items []string `json:"items"`
+}
+
+func RepoCardTemplate(t entity.CardTemplate) (CardTemplate, error) {
+	labels := t.Labels
+	if labels == nil {
+		labels = []string{}
+	}
+
+	checklists := make([]checklist, len(t.Checklists))
+	for i, c := range t.Checklists {
+		checklists[i] = checklist{Title: c.Title, Items: c.Items}
+	}
+
+	labelsJSON, err := json.Marshal(labels)
+	if err != nil {
+		return CardTemplate{}, err
+	}
+
+	checklistsJSON, err := json.Marshal(checklists)
+	if err != nil {
+		return CardTemplate{}, err
+	}
+
+	return CardTemplate{
+		ID:           t.ID,
+		BoardID:      t.BoardID,
+		Name:         t.Name,
+		TitlePattern: t.TitlePattern,
+		Description:  t.Description,
+		Labels:       string(labelsJSON),
+		Checklists:   string(checklistsJSON),
+		CreatedBy:    t.CreatedBy,
+		CreatedAt:    t.CreatedAt,
+		UpdatedAt:    t.UpdatedAt,
+	}, nil
+}
+
+func CardTemplateToEntity(r CardTemplate) (entity.CardTemplate, error) {
+	var labels []string
+	if err := json.Unmarshal([]byte(r.Labels), &labels); err != nil {
+		return entity.CardTemplate{}, err
+	}
+
+	var checklists []checklist
+	if err := json.Unmarshal([]byte(r.Checklists), &checklists); err != nil {
+		return entity.CardTemplate{}, err
+	}
+
+	t := entity.CardTemplate{
+		ID:           r.ID,
+		BoardID:      r.BoardID,
+		Name:         r.Name,
+		TitlePattern: r.TitlePattern,
+		Description:  r.Description,
+		Labels:       labels,
+		CreatedBy:    r.CreatedBy,
+		CreatedAt:    r.CreatedAt,
+		UpdatedAt:    r.UpdatedAt,
+	}
+
+	for _, c := range checklists {
+		t.Checklists = append(t.Checklists, entity.Checklist{Title: c.Title, Items: c.Items})
+	}
+
+	return t, nil
+}
@@ -0,0 +1,12 @@
+package repository
+
+import "errors"
+
+var (
+	ErrColumnNotFound  = errors.New("column not found")
+	ErrBoardAccess     = errors.New("user has no access to the board")
+	ErrNoCaller        = errors.New("request should name the user it is made for")
+	ErrColumnSameBoard = errors.New("column is already on that board")
+	ErrMergeSameBoard  = errors.New("board cannot be merged into itself")
+	ErrMergeStrategy   = errors.New("column merge strategy should be combine, keep or rename")
+)
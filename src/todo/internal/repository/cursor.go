@@ -0,0 +1,88 @@
+package repository
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"time"
+	"todo/internal/entity"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrInvalidCursor = errors.New("invalid cursor")
+	ErrNegativeLimit = errors.New("limit cannot be negative")
+	ErrZeroLimit     = errors.New("limit cannot be zero")
+)
+
+// Cursor is the keyset position of the last row of a page: its sort key and
+// id. Which key field is set depends on the order of the listing; a cursor
+// with neither set stands for a row whose key is NULL.
+type Cursor struct {
+	Time   *time.Time `json:"t,omitempty"`
+	Number *float64   `json:"n,omitempty"`
+	ID     uuid.UUID  `json:"id"`
+}
+
+// Page selects up to Limit rows following After, or the first rows of a
+// listing when After is nil.
+type Page struct {
+	After *Cursor
+	Limit int
+}
+
+// Encode renders the cursor as an opaque URL-safe token.
+func (c Cursor) Encode() string {
+	data, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func DecodeCursor(token string) (*Cursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	var c Cursor
+	if err := json.Unmarshal(data, &c); err != nil || c.ID == uuid.Nil {
+		return nil, ErrInvalidCursor
+	}
+
+	return &c, nil
+}
+
+func TimeCursor(t time.Time, id uuid.UUID) *Cursor {
+	return &Cursor{Time: &t, ID: id}
+}
+
+func NumberCursor(n float64, id uuid.UUID) *Cursor {
+	return &Cursor{Number: &n, ID: id}
+}
+
+// CardCursor returns the cursor of card in a listing sorted by sortBy.
+func CardCursor(card entity.Card, sortBy CardSortField) *Cursor {
+	switch sortBy {
+	case SortByPriority:
+		return NumberCursor(float64(card.Priority), card.ID)
+	case SortByCreated:
+		return TimeCursor(card.CreatedAt, card.ID)
+	case SortByUpdated:
+		return TimeCursor(card.UpdatedAt, card.ID)
+	case SortByDue:
+		if card.DueDate == nil {
+			return &Cursor{ID: card.ID}
+		}
+		return TimeCursor(*card.DueDate, card.ID)
+	default:
+		return NumberCursor(card.Position, card.ID)
+	}
+}
+
+// MarkedBoardCursor returns the cursor of board in the starred-first listing
+// of boards: its rank group as the number and its rank time.
+func MarkedBoardCursor(board entity.MarkedBoard) *Cursor {
+	group, at := MarkedBoardRank(board)
+	n := float64(group)
+	return &Cursor{Time: &at, Number: &n, ID: board.ID}
+}
@@ -8,51 +8,94 @@ import (
 )
 
 type Board struct {
-	ID        uuid.UUID `db:"id"`
-	UserID    uuid.UUID `db:"user_id"`
-	Title     string    `db:"title"`
-	CreatedAt time.Time `db:"created_at"`
-	UpdatedAt time.Time `db:"updated_at"`
+	ID          uuid.UUID `db:"id"           bson:"_id"`
+	UserID      uuid.UUID `db:"user_id"      bson:"user_id"`
+	WorkspaceID uuid.UUID `db:"workspace_id" bson:"workspace_id"`
+	Title       string    `db:"title"        bson:"title"`
+	Version     int       `db:"version"      bson:"version"`
+	CreatedAt   time.Time `db:"created_at"   bson:"created_at"`
+	UpdatedAt   time.Time `db:"updated_at"   bson:"updated_at"`
 }
 
 type Column struct {
-	ID        uuid.UUID `db:"id"`
-	UserID    uuid.UUID `db:"user_id"`
-	BoardID   uuid.UUID `db:"board_id"`
-	Title     string    `db:"title"`
-	Position  float64   `db:"position"`
-	CreatedAt time.Time `db:"created_at"`
-	UpdatedAt time.Time `db:"updated_at"`
+	ID        uuid.UUID `db:"id"         bson:"_id"`
+	UserID    uuid.UUID `db:"user_id"    bson:"user_id"`
+	BoardID   uuid.UUID `db:"board_id"   bson:"board_id"`
+	Title     string    `db:"title"      bson:"title"`
+	Position  float64   `db:"position"   bson:"position"`
+	Done      bool      `db:"done"       bson:"done"`
+	Version   int       `db:"version"    bson:"version"`
+	CreatedAt time.Time `db:"created_at" bson:"created_at"`
+	UpdatedAt time.Time `db:"updated_at" bson:"updated_at"`
+}
+
+type Swimlane struct {
+	ID        uuid.UUID `db:"id"         bson:"_id"`
+	UserID    uuid.UUID `db:"user_id"    bson:"user_id"`
+	BoardID   uuid.UUID `db:"board_id"   bson:"board_id"`
+	Title     string    `db:"title"      bson:"title"`
+	Position  float64   `db:"position"   bson:"position"`
+	Version   int       `db:"version"    bson:"version"`
+	CreatedAt time.Time `db:"created_at" bson:"created_at"`
+	UpdatedAt time.Time `db:"updated_at" bson:"updated_at"`
 }
 
 type Card struct {
-	ID          uuid.UUID `db:"id"`
-	UserID      uuid.UUID `db:"user_id"`
-	ColumnID    uuid.UUID `db:"column_id"`
-	Title       string    `db:"title"`
-	Description string    `db:"description"`
-	Position    float64   `db:"position"`
-	CreatedAt   time.Time `db:"created_at"`
-	UpdatedAt   time.Time `db:"updated_at"`
+	ID          uuid.UUID     `db:"id"          bson:"_id"`
+	UserID      uuid.UUID     `db:"user_id"     bson:"user_id"`
+	ColumnID    uuid.UUID     `db:"column_id"   bson:"column_id"`
+	SwimlaneID  uuid.NullUUID `db:"swimlane_id" bson:"swimlane_id"`
+	ParentID    uuid.NullUUID `db:"parent_id"   bson:"parent_id"`
+	Title       string        `db:"title"       bson:"title"`
+	Description string        `db:"description" bson:"description"`
+	Position    float64       `db:"position"    bson:"position"`
+	Priority    int           `db:"priority"    bson:"priority"`
+	AssigneeID  uuid.NullUUID `db:"assignee_id" bson:"assignee_id"`
+	DueDate     *time.Time    `db:"due_date"    bson:"due_date"`
+	Labels      []string      `db:"-"           bson:"labels"`
+	ArchivedAt  *time.Time    `db:"archived_at" bson:"archived_at"`
+	Version     int           `db:"version"     bson:"version"`
+	CreatedAt   time.Time     `db:"created_at"  bson:"created_at"`
+	UpdatedAt   time.Time     `db:"updated_at"  bson:"updated_at"`
+
+	ChildCount     int `db:"-" bson:"-"`
+	DoneChildCount int `db:"-" bson:"-"`
 }
 
 func RepoBoard(e entity.Board) Board {
 	return Board{
+		ID:          e.ID,
+		UserID:      e.UserID,
+		WorkspaceID: e.WorkspaceID,
+		Title:       e.Title,
+		Version:     e.Version,
+		CreatedAt:   e.CreatedAt,
+		UpdatedAt:   e.UpdatedAt,
+	}
+}
+
+func RepoColumn(e entity.Column) Column {
+	return Column{
 		ID:        e.ID,
 		UserID:    e.UserID,
+		BoardID:   e.BoardID,
 		Title:     e.Title,
+		Position:  e.Position,
+		Done:      e.Done,
+		Version:   e.Version,
 		CreatedAt: e.CreatedAt,
 		UpdatedAt: e.UpdatedAt,
 	}
 }
 
-func RepoColumn(e entity.Column) Column {
-	return Column{
+func RepoSwimlane(e entity.Swimlane) Swimlane {
+	return Swimlane{
 		ID:        e.ID,
 		UserID:    e.UserID,
 		BoardID:   e.BoardID,
 		Title:     e.Title,
 		Position:  e.Position,
+		Version:   e.Version,
 		CreatedAt: e.CreatedAt,
 		UpdatedAt: e.UpdatedAt,
 	}
@@ -63,31 +106,59 @@ func RepoCard(e entity.Card) Card {
 		ID:          e.ID,
 		UserID:      e.UserID,
 		ColumnID:    e.ColumnID,
+		SwimlaneID:  uuid.NullUUID{UUID: e.SwimlaneID, Valid: e.SwimlaneID != uuid.Nil},
+		ParentID:    uuid.NullUUID{UUID: e.ParentID, Valid: e.ParentID != uuid.Nil},
 		Title:       e.Title,
 		Description: e.Description,
 		Position:    e.Position,
+		Priority:    e.Priority,
+		AssigneeID:  uuid.NullUUID{UUID: e.AssigneeID, Valid: e.AssigneeID != uuid.Nil},
+		DueDate:     e.DueDate,
+		Labels:      e.Labels,
+		ArchivedAt:  e.ArchivedAt,
+		Version:     e.Version,
 		CreatedAt:   e.CreatedAt,
 		UpdatedAt:   e.UpdatedAt,
+
+		ChildCount:     e.ChildCount,
+		DoneChildCount: e.DoneChildCount,
 	}
 }
 
 func BoardToEntity(r Board) entity.Board {
 	return entity.Board{
+		ID:          r.ID,
+		UserID:      r.UserID,
+		WorkspaceID: r.WorkspaceID,
+		Title:       r.Title,
+		Version:     r.Version,
+		CreatedAt:   r.CreatedAt,
+		UpdatedAt:   r.UpdatedAt,
+	}
+}
+
+func ColumnToEntity(r Column) entity.Column {
+	return entity.Column{
 		ID:        r.ID,
 		UserID:    r.UserID,
+		BoardID:   r.BoardID,
 		Title:     r.Title,
+		Position:  r.Position,
+		Done:      r.Done,
+		Version:   r.Version,
 		CreatedAt: r.CreatedAt,
 		UpdatedAt: r.UpdatedAt,
 	}
 }
 
-func ColumnToEntity(r Column) entity.Column {
-	return entity.Column{
+func SwimlaneToEntity(r Swimlane) entity.Swimlane {
+	return entity.Swimlane{
 		ID:        r.ID,
 		UserID:    r.UserID,
 		BoardID:   r.BoardID,
 		Title:     r.Title,
 		Position:  r.Position,
+		Version:   r.Version,
 		CreatedAt: r.CreatedAt,
 		UpdatedAt: r.UpdatedAt,
 	}
@@ -98,10 +169,21 @@ func CardToEntity(r Card) entity.Card {
 		ID:          r.ID,
 		UserID:      r.UserID,
 		ColumnID:    r.ColumnID,
+		SwimlaneID:  r.SwimlaneID.UUID,
+		ParentID:    r.ParentID.UUID,
 		Title:       r.Title,
 		Description: r.Description,
 		Position:    r.Position,
+		Priority:    r.Priority,
+		AssigneeID:  r.AssigneeID.UUID,
+		DueDate:     r.DueDate,
+		Labels:      r.Labels,
+		ArchivedAt:  r.ArchivedAt,
+		Version:     r.Version,
 		CreatedAt:   r.CreatedAt,
 		UpdatedAt:   r.UpdatedAt,
+
+		ChildCount:     r.ChildCount,
+		DoneChildCount: r.DoneChildCount,
 	}
 }
@@ -2,34 +2,339 @@ package repository
 
 import (
 	"context"
+	"errors"
 	"time"
 	"todo/internal/entity"
 
 	"github.com/google/uuid"
 )
 
+// ErrVersionMismatch is returned by updates and deletes when the stored
+// version differs from the one the caller read.
+var ErrVersionMismatch = errors.New("version mismatch")
+
+// ErrSwimlaneLast is returned when deleting the only swimlane of a board.
+var ErrSwimlaneLast = errors.New("board should keep at least one swimlane")
+
+var ErrSwimlaneNotFound = errors.New("swimlane not found")
+
+// DefaultSwimlaneTitle is the title of the swimlane every board starts with.
+const DefaultSwimlaneTitle = "Default"
+
+// ErrCardParentCycle is returned when a card would become its own ancestor.
+var ErrCardParentCycle = errors.New("card cannot be a descendant of itself")
+
+// ErrCardTooDeep is returned when a card would sit deeper than MaxCardDepth
+// levels in its tree.
+var ErrCardTooDeep = errors.New("card tree is too deep")
+
+// ErrCardHasChildren is returned when archiving a card with active children
+// without saying whether they go too.
+var ErrCardHasChildren = errors.New("card has sub-cards; say whether to cascade the archive to them")
+
+// MaxCardDepth bounds the levels of a card tree, root included.
+const MaxCardDepth = 64
+
 type BoardRepository interface {
+	// CreateBoard also gives the board its default swimlane.
 	CreateBoard(ctx context.Context, board *entity.Board) error
 	GetBoardByID(ctx context.Context, id uuid.UUID) (*entity.Board, error)
-	GetBoardsByUser(ctx context.Context, userID uuid.UUID, limit, offset int) ([]entity.Board, error)
+	GetBoardsByUser(ctx context.Context, userID uuid.UUID, page Page) ([]entity.Board, error)
 	UpdateBoard(ctx context.Context, board *entity.Board) error
-	DeleteBoard(ctx context.Context, id uuid.UUID) error
+	// TransferBoard gives the board to its UserID and moves it to its
+	// WorkspaceID. Like UpdateBoard it checks and bumps the version.
+	TransferBoard(ctx context.Context, board *entity.Board) error
+	DeleteBoard(ctx context.Context, id uuid.UUID, version int) error
 }
 
 type ColumnRepository interface {
 	CreateColumn(ctx context.Context, column *entity.Column) error
 	GetColumnByID(ctx context.Context, id uuid.UUID) (*entity.Column, error)
-	GetColumnsByBoard(ctx context.Context, boardID uuid.UUID, limit, offset int) ([]entity.Column, error)
+	GetColumnsByBoard(ctx context.Context, boardID uuid.UUID, page Page) ([]entity.Column, error)
 	UpdateColumn(ctx context.Context, column *entity.Column) error
-	DeleteColumn(ctx context.Context, id uuid.UUID) error
+	// MoveColumn puts the column on column.BoardID under column.Title at
+	// column.Position. Its cards go along, to the first swimlane there, and
+	// leave the open sprints of the board they come from.
+	MoveColumn(ctx context.Context, column *entity.Column) error
+	// MergeColumn moves the cards of the source column after those of the
+	// column targetID, to the first swimlane of its board, and deletes the
+	// source column.
+	MergeColumn(ctx context.Context, source *entity.Column, targetID uuid.UUID) error
+	DeleteColumn(ctx context.Context, id uuid.UUID, version int) error
+}
+
+type SwimlaneRepository interface {
+	CreateSwimlane(ctx context.Context, swimlane *entity.Swimlane) error
+	GetSwimlaneByID(ctx context.Context, id uuid.UUID) (*entity.Swimlane, error)
+	// GetSwimlanesByBoard lists the swimlanes of a board top to bottom, by
+	// position.
+	GetSwimlanesByBoard(ctx context.Context, boardID uuid.UUID, page Page) ([]entity.Swimlane, error)
+	UpdateSwimlane(ctx context.Context, swimlane *entity.Swimlane) error
+	DeleteSwimlane(ctx context.Context, id uuid.UUID, version int) error
+}
+
+type ActivityRepository interface {
+	// AddActivity stores the activity and sets its ID.
+	AddActivity(ctx context.Context, activity *entity.Activity) error
+	// GetActivities returns up to limit activities of the board recorded
+	// after the one with the given ID, oldest first.
+	GetActivities(ctx context.Context, boardID uuid.UUID, afterID int64, limit int) ([]entity.Activity, error)
+}
+
+type TimeEntryRepository interface {
+	// AddTimeEntry stores the entry. Adding a running one returns
+	// ErrTimerRunning if the user already has one.
+	AddTimeEntry(ctx context.Context, entry *entity.TimeEntry) error
+	// GetRunningTimeEntry returns ErrNoTimerRunning if the user has none.
+	GetRunningTimeEntry(ctx context.Context, userID uuid.UUID) (*entity.TimeEntry, error)
+	// StopTimeEntry ends the running entry of the user at endedAt. It
+	// returns ErrNoTimerRunning if the user has none.
+	StopTimeEntry(ctx context.Context, userID uuid.UUID, endedAt time.Time) (*entity.TimeEntry, error)
+	// GetTimeEntriesByCard lists the entries of a card, oldest first.
+	GetTimeEntriesByCard(ctx context.Context, cardID uuid.UUID) ([]entity.TimeEntry, error)
+	// GetTimeReport totals the finished entries by day, user and card.
+	GetTimeReport(ctx context.Context, query TimeReportQuery) ([]entity.TimeReportRow, error)
+}
+
+// CardFlowRepository reads the history of the columns cards have been in,
+// which the card repository keeps as cards are created, moved, archived and
+// deleted.
+type CardFlowRepository interface {
+	// GetCardFlows lists the cards of a board first done in [from, to), in
+	// the order they were done.
+	GetCardFlows(ctx context.Context, boardID uuid.UUID, from, to time.Time) ([]entity.CardFlow, error)
+	// GetColumnCounts counts the cards in each column of a board at the end
+	// of every day in [from, to), by day and then in board order.
+	GetColumnCounts(ctx context.Context, boardID uuid.UUID, from, to time.Time) ([]entity.ColumnCount, error)
+}
+
+// BoardStatsRepository reads what board stats are made of from the cards of
+// a board and the history of the columns they have been in.
+type BoardStatsRepository interface {
+	// GetColumnCards counts the active cards of every column of a board, in
+	// board order.
+	GetColumnCards(ctx context.Context, boardID uuid.UUID) ([]entity.ColumnCards, error)
+	// GetCreatedCards lists the cards that entered the board in [from, to),
+	// in the order they did.
+	GetCreatedCards(ctx context.Context, boardID uuid.UUID, from, to time.Time) ([]entity.CreatedCard, error)
+	// GetCompletedCards lists the cards of a board first done in [from, to),
+	// in the order they were done.
+	GetCompletedCards(ctx context.Context, boardID uuid.UUID, from, to time.Time) ([]entity.CompletedCard, error)
+	// GetStaleCards lists up to limit active cards of a board outside its
+	// done columns last changed before the time given, least recently
+	// changed first.
+	GetStaleCards(ctx context.Context, boardID uuid.UUID, before time.Time, limit int) ([]entity.StaleCard, error)
+}
+
+// SprintRepository keeps the sprints of boards and the cards taken into
+// them.
+type SprintRepository interface {
+	CreateSprint(ctx context.Context, sprint *entity.Sprint) error
+	GetSprintByID(ctx context.Context, id uuid.UUID) (*entity.Sprint, error)
+	// GetSprintsByBoard lists the sprints of a board in the order they
+	// start.
+	GetSprintsByBoard(ctx context.Context, boardID uuid.UUID) ([]entity.Sprint, error)
+
+	// AddSprintCard takes a card into a sprint, or sets its points if it is
+	// there already, and out of the other open sprints it was in.
+	AddSprintCard(ctx context.Context, card *entity.SprintCard) error
+	RemoveSprintCard(ctx context.Context, sprintID, cardID uuid.UUID) error
+	GetSprintCards(ctx context.Context, sprintID uuid.UUID) ([]entity.SprintCard, error)
+
+	// CloseSprint closes an open sprint and, unless nextID is nil, carries
+	// its active cards outside done columns over to the next sprint. It
+	// returns how many cards were carried over.
+	CloseSprint(ctx context.Context, id uuid.UUID, nextID *uuid.UUID, at time.Time) (int, error)
+	// GetSprintBurndown counts the work left in a sprint at the end of every
+	// day in [from, to).
+	GetSprintBurndown(ctx context.Context, id uuid.UUID, from, to time.Time) ([]entity.BurndownPoint, error)
+}
+
+// CalendarRepository keeps the calendar feed tokens of users and lists the
+// cards their feeds show.
+type CalendarRepository interface {
+	// SetCalendarToken saves the token of a user, replacing the one they
+	// had.
+	SetCalendarToken(ctx context.Context, token *entity.CalendarToken) error
+	GetCalendarTokenByHash(ctx context.Context, tokenHash string) (*entity.CalendarToken, error)
+	// GetCalendarCards lists the active cards with a due date on the boards
+	// of every workspace the user is a member of, by due date. A card
+	// assigned to the user on a board they can no longer see is left out.
+	GetCalendarCards(ctx context.Context, userID uuid.UUID) ([]entity.CalendarCard, error)
+	// DeleteCalendarToken forgets the token of a user, if they have one.
+	DeleteCalendarToken(ctx context.Context, userID uuid.UUID) error
+}
+
+// BoardMarkRepository keeps the boards users have starred and the ones they
+// have opened lately.
+type BoardMarkRepository interface {
+	// StarBoard stars the board for the user; starring it again keeps the
+	// first star.
+	StarBoard(ctx context.Context, star *entity.BoardStar) error
+	UnstarBoard(ctx context.Context, userID, boardID uuid.UUID) error
+	// RecordBoardView saves the view and forgets the views of the user past
+	// the RecentBoardsLimit latest.
+	RecordBoardView(ctx context.Context, view *entity.BoardView) error
+	// GetRecentBoards lists the boards the user opened lately and is still a
+	// member of, the latest first.
+	GetRecentBoards(ctx context.Context, userID uuid.UUID) ([]entity.MarkedBoard, error)
+	// GetMarkedBoards lists the boards GetMemberBoards lists for the user, in
+	// the order of MarkedBoardRank.
+	GetMarkedBoards(ctx context.Context, userID uuid.UUID, page Page) ([]entity.MarkedBoard, error)
+	// DeleteUserMarks forgets the stars and views of a user.
+	DeleteUserMarks(ctx context.Context, userID uuid.UUID) error
+}
+
+// NotificationRepository keeps the cards and boards users watch and the
+// notifications in their inboxes.
+type NotificationRepository interface {
+	// AddWatch saves the watch; watching again keeps the first watch.
+	AddWatch(ctx context.Context, watch *entity.Watch) error
+	RemoveWatch(ctx context.Context, watch *entity.Watch) error
+	// GetWatchers lists the users watching the card or the board it is on.
+	GetWatchers(ctx context.Context, boardID, cardID uuid.UUID) ([]uuid.UUID, error)
+	AddNotifications(ctx context.Context, notifications []entity.Notification) error
+	// GetNotifications lists the notifications of the user, the latest
+	// first, only the unread ones if unreadOnly is set.
+	GetNotifications(ctx context.Context, userID uuid.UUID, unreadOnly bool, page Page) ([]entity.Notification, error)
+	// MarkNotificationsRead marks the unread notifications of the user with
+	// the ids, or all of them without any, read at the time given.
+	MarkNotificationsRead(ctx context.Context, userID uuid.UUID, ids []uuid.UUID, at time.Time) error
+	// DeleteUserNotifications forgets the watches and notifications of a
+	// user.
+	DeleteUserNotifications(ctx context.Context, userID uuid.UUID) error
+}
+
+// CardTemplateRepository keeps the card templates of boards.
+type CardTemplateRepository interface {
+	// CreateCardTemplate returns ErrCardTemplateExists if the board has a
+	// template of that name already.
+	CreateCardTemplate(ctx context.Context, template *entity.CardTemplate) error
+	GetCardTemplateByID(ctx context.Context, id uuid.UUID) (*entity.CardTemplate, error)
+	// GetCardTemplatesByBoard lists the templates of a board by name.
+	GetCardTemplatesByBoard(ctx context.Context, boardID uuid.UUID) ([]entity.CardTemplate, error)
+	// UpdateCardTemplate saves all but the board and the creation of the
+	// template, and returns ErrCardTemplateExists like CreateCardTemplate.
+	UpdateCardTemplate(ctx context.Context, template *entity.CardTemplate) error
+	DeleteCardTemplate(ctx context.Context, id uuid.UUID) error
+}
+
+// QuotaRepository keeps the quota overrides admins set for single users.
+type QuotaRepository interface {
+	// GetQuotaOverride returns ErrQuotaOverrideNotFound if the user has
+	// none.
+	GetQuotaOverride(ctx context.Context, userID uuid.UUID) (*entity.QuotaOverride, error)
+	// SetQuotaOverride saves the override, replacing the one the user had.
+	SetQuotaOverride(ctx context.Context, override *entity.QuotaOverride) error
+	DeleteQuotaOverride(ctx context.Context, userID uuid.UUID) error
+	// LockQuota holds back the other units of work that lock the scope, a
+	// user, board or column the quota counts things of, until the one it
+	// is called in ends, so that they do not count the same things.
+	LockQuota(ctx context.Context, scope uuid.UUID) error
+}
+
+// WorkspaceRepository keeps workspaces and their members.
+type WorkspaceRepository interface {
+	// CreateWorkspace stores the workspace with its first admin.
+	CreateWorkspace(ctx context.Context, workspace *entity.Workspace, admin *entity.WorkspaceMember) error
+	GetWorkspaceByID(ctx context.Context, id uuid.UUID) (*entity.Workspace, error)
+	// DeleteWorkspace deletes the workspace with its members and boards. It
+	// returns ErrWorkspaceNotFound if there is no such workspace.
+	DeleteWorkspace(ctx context.Context, id uuid.UUID) error
+	// EnsurePersonalWorkspace returns the personal workspace of the user,
+	// making it at the given time if they have none yet.
+	EnsurePersonalWorkspace(ctx context.Context, userID uuid.UUID, at time.Time) (*entity.Workspace, error)
+	// GetWorkspacesByUser lists the workspaces the user is a member of, in
+	// the order they were made.
+	GetWorkspacesByUser(ctx context.Context, userID uuid.UUID) ([]entity.WorkspaceMembership, error)
+
+	// AddWorkspaceMember returns ErrWorkspaceMemberExists if the user is a
+	// member already.
+	AddWorkspaceMember(ctx context.Context, member *entity.WorkspaceMember) error
+	// UpdateWorkspaceMember sets the role of a member. It returns
+	// ErrWorkspaceMemberNotFound if the user is not one.
+	UpdateWorkspaceMember(ctx context.Context, member *entity.WorkspaceMember) error
+	// RemoveWorkspaceMember returns ErrWorkspaceMemberNotFound if the user
+	// is not a member.
+	RemoveWorkspaceMember(ctx context.Context, workspaceID, userID uuid.UUID) error
+	// GetWorkspaceMember returns ErrWorkspaceMemberNotFound if the user is
+	// not a member.
+	GetWorkspaceMember(ctx context.Context, workspaceID, userID uuid.UUID) (*entity.WorkspaceMember, error)
+	// GetWorkspaceMembers lists the members of a workspace in the order they
+	// joined.
+	GetWorkspaceMembers(ctx context.Context, workspaceID uuid.UUID) ([]entity.WorkspaceMember, error)
+
+	// GetWorkspaceBoards lists the boards of a workspace in the order they
+	// were made.
+	GetWorkspaceBoards(ctx context.Context, workspaceID uuid.UUID, page Page) ([]entity.Board, error)
+	// GetMemberBoards lists the boards of every workspace the user is a
+	// member of, in the order they were made.
+	GetMemberBoards(ctx context.Context, userID uuid.UUID, page Page) ([]entity.Board, error)
+}
+
+var (
+	ErrCardQueryNoScope    = errors.New("card query should have a board id, a column id, a swimlane id or a parent id")
+	ErrCardQuerySortField  = errors.New("unknown card sort field")
+	ErrCardInvalidPriority = errors.New("card priority is out of range")
+	ErrInvalidTimeRange    = errors.New("<<from>> cannot be greater than <<to>> date")
+)
+
+type CardSortField string
+
+const (
+	SortByPosition CardSortField = "position"
+	SortByPriority CardSortField = "priority"
+	SortByCreated  CardSortField = "created"
+	SortByUpdated  CardSortField = "updated"
+	SortByDue      CardSortField = "due"
+)
+
+// CardQuery describes a card listing. Nil fields are not filtered on.
+// Time ranges are half-open: From is inclusive, To is exclusive. Archived
+// selects archived cards instead of the active ones.
+type CardQuery struct {
+	BoardID    *uuid.UUID
+	ColumnID   *uuid.UUID
+	SwimlaneID *uuid.UUID
+	ParentID   *uuid.UUID
+	Priorities []int
+	UserID     *uuid.UUID
+	AssigneeID *uuid.UUID
+	Label      *string
+	Text       *string
+
+	CreatedFrom *time.Time
+	CreatedTo   *time.Time
+	UpdatedFrom *time.Time
+	UpdatedTo   *time.Time
+	DueFrom     *time.Time
+	DueTo       *time.Time
+	Archived    bool
+
+	SortBy     CardSortField
+	Descending bool
+	Page       Page
 }
 
+// Cards created without a swimlane go to the first swimlane of their board.
+// Cards moved without one keep theirs, unless it is on another board than the
+// column they are moved to; then they go to the first swimlane there too.
 type CardRepository interface {
 	CreateCard(ctx context.Context, card *entity.Card) error
 	GetCardByID(ctx context.Context, id uuid.UUID) (*entity.Card, error)
-	GetCardsByColumn(ctx context.Context, columnID uuid.UUID, limit, offset int) ([]entity.Card, error)
-	GetNewCards(ctx context.Context, from, to time.Time) ([]entity.Card, error)
+	GetCardsByColumn(ctx context.Context, columnID uuid.UUID, page Page) ([]entity.Card, error)
+	GetCards(ctx context.Context, query CardQuery) ([]entity.Card, error)
+	// GetCardAncestors lists the parent of a card, its parent and so on up
+	// to the root, at most MaxCardDepth of them.
+	GetCardAncestors(ctx context.Context, id uuid.UUID) ([]entity.Card, error)
+	GetNewCards(ctx context.Context, from, to time.Time, page Page) ([]entity.Card, error)
 	UpdateCard(ctx context.Context, card *entity.Card) error
 	MoveCard(ctx context.Context, card *entity.Card) error
-	DeleteCard(ctx context.Context, id uuid.UUID) error
+	// SetCardParent moves the card under ParentID, or to the top level when
+	// it is nil. It does not check for cycles.
+	SetCardParent(ctx context.Context, card *entity.Card) error
+	DeleteCard(ctx context.Context, id uuid.UUID, version int) error
+	// ApplyCardBatch returns a result per operation of the batch. In
+	// all-or-nothing mode nothing is committed if any operation failed.
+	ApplyCardBatch(ctx context.Context, batch CardBatch) ([]CardOpResult, error)
 }
@@ -0,0 +1,90 @@
+package repository
+
+import (
+	"errors"
+	"time"
+	"todo/internal/entity"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrInvalidWatch            = errors.New("watch should name either a card or a board")
+	ErrInvalidNotificationKind = errors.New("unknown notification kind")
+)
+
+// NotificationKinds are the card activities watchers are told of.
+var NotificationKinds = map[string]bool{
+	entity.ActivityCardCreated:  true,
+	entity.ActivityCardUpdated:  true,
+	entity.ActivityCardMoved:    true,
+	entity.ActivityCardArchived: true,
+}
+
+type BoardWatch struct {
+	UserID    uuid.UUID `db:"user_id"`
+	BoardID   uuid.UUID `db:"board_id"`
+	CreatedAt time.Time `db:"created_at"`
+}
+
+type CardWatch struct {
+	UserID    uuid.UUID `db:"user_id"`
+	CardID    uuid.UUID `db:"card_id"`
+	CreatedAt time.Time `db:"created_at"`
+}
+
+type Notification struct {
+	ID        uuid.UUID  `db:"id"`
+	UserID    uuid.UUID  `db:"user_id"`
+	ActorID   uuid.UUID  `db:"actor_id"`
+	Kind      string     `db:"kind"`
+	BoardID   uuid.UUID  `db:"board_id"`
+	CardID    uuid.UUID  `db:"card_id"`
+	CardTitle string     `db:"card_title"`
+	ReadAt    *time.Time `db:"read_at"`
+	CreatedAt time.Time  `db:"created_at"`
+}
+
+func RepoBoardWatch(w entity.Watch) BoardWatch {
+	return BoardWatch{
+		UserID:    w.UserID,
+		BoardID:   w.BoardID,
+		CreatedAt: w.CreatedAt,
+	}
+}
+
+func RepoCardWatch(w entity.Watch) CardWatch {
+	return CardWatch{
+		UserID:    w.UserID,
+		CardID:    w.CardID,
+		CreatedAt: w.CreatedAt,
+	}
+}
+
+func RepoNotification(n entity.Notification) Notification {
+	return Notification{
+		ID:        n.ID,
+		UserID:    n.UserID,
+		ActorID:   n.ActorID,
+		Kind:      n.Kind,
+		BoardID:   n.BoardID,
+		CardID:    n.CardID,
+		CardTitle: n.CardTitle,
+		ReadAt:    n.ReadAt,
+		CreatedAt: n.CreatedAt,
+	}
+}
+
+func NotificationToEntity(r Notification) entity.Notification {
+	return entity.Notification{
+		ID:        r.ID,
+		UserID:    r.UserID,
+		ActorID:   r.ActorID,
+		Kind:      r.Kind,
+		BoardID:   r.BoardID,
+		CardID:    r.CardID,
+		CardTitle: r.CardTitle,
+		ReadAt:    r.ReadAt,
+		CreatedAt: r.CreatedAt,
+	}
+}
@@ -0,0 +1,89 @@
+package repository
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+	"todo/internal/entity"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrQuotaOverrideNotFound = errors.New("user has no quota override")
+	ErrQuotaInvalid          = errors.New("quota override should have a user id and no negative bounds")
+
+	// ErrQuotaExceeded is matched by every QuotaError.
+	ErrQuotaExceeded = errors.New("quota exceeded")
+)
+
+// The quotas a QuotaError can name, as config.toml names them.
+const (
+	QuotaBoardsPerUser     = "boards_per_user"
+	QuotaColumnsPerBoard   = "columns_per_board"
+	QuotaCardsPerColumn    = "cards_per_column"
+	QuotaDescriptionLength = "description_length"
+)
+
+// QuotaError is returned when a change would take a user past a bound of
+// their quota.
+type QuotaError struct {
+	Quota string
+	Limit int
+}
+
+func (e *QuotaError) Error() string {
+	return fmt.Sprintf("%s: %s is %d", ErrQuotaExceeded, e.Quota, e.Limit)
+}
+
+func (e *QuotaError) Is(target error) bool {
+	return target == ErrQuotaExceeded
+}
+
+// QuotaOverride keeps the bounds it leaves as configured as NULL.
+type QuotaOverride struct {
+	UserID            uuid.UUID     `db:"user_id"            bson:"_id"`
+	BoardsPerUser     sql.NullInt64 `db:"boards_per_user"    bson:"boards_per_user"`
+	ColumnsPerBoard   sql.NullInt64 `db:"columns_per_board"  bson:"columns_per_board"`
+	CardsPerColumn    sql.NullInt64 `db:"cards_per_column"   bson:"cards_per_column"`
+	DescriptionLength sql.NullInt64 `db:"description_length" bson:"description_length"`
+	UpdatedAt         time.Time     `db:"updated_at"         bson:"updated_at"`
+}
+
+func RepoQuotaOverride(o entity.QuotaOverride) QuotaOverride {
+	return QuotaOverride{
+		UserID:            o.UserID,
+		BoardsPerUser:     nullBound(o.BoardsPerUser),
+		ColumnsPerBoard:   nullBound(o.ColumnsPerBoard),
+		CardsPerColumn:    nullBound(o.CardsPerColumn),
+		DescriptionLength: nullBound(o.DescriptionLength),
+		UpdatedAt:         o.UpdatedAt,
+	}
+}
+
+func QuotaOverrideToEntity(r QuotaOverride) entity.QuotaOverride {
+	return entity.QuotaOverride{
+		UserID:            r.UserID,
+		BoardsPerUser:     bound(r.BoardsPerUser),
+		ColumnsPerBoard:   bound(r.ColumnsPerBoard),
+		CardsPerColumn:    bound(r.CardsPerColumn),
+		DescriptionLength: bound(r.DescriptionLength),
+		UpdatedAt:         r.UpdatedAt,
+	}
+}
+
+func nullBound(b *int) sql.NullInt64 {
+	if b == nil {
+		return sql.NullInt64{}
+	}
+	return sql.NullInt64{Int64: int64(*b), Valid: true}
+}
+
+func bound(n sql.NullInt64) *int {
+	if !n.Valid {
+		return nil
+	}
+	b := int(n.Int64)
+	return &b
+}
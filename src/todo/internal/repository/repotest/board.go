@@ -0,0 +1,188 @@
+package repotest
+
+import (
+	"testing"
+	"todo/internal/entity"
+	"todo/internal/repository"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestBoardRepository runs the board repository contract against the
+// backend newRepos makes.
+func TestBoardRepository(t *testing.T, newRepos NewRepos) {
+	t.Run("CreateAndGet", func(t *testing.T) {
+		f := newFixture(t, newRepos)
+		board := f.board(uuid.New(), "Board", at(0))
+
+		got, err := f.repos.Board.GetBoardByID(f.ctx, board.ID)
+		require.NoError(t, err)
+		assertBoard(t, board, *got)
+
+		lanes, err := f.repos.Swimlane.GetSwimlanesByBoard(f.ctx, board.ID, repository.Page{Limit: 10})
+		require.NoError(t, err)
+		require.Len(t, lanes, 1, "a board starts with its default swimlane")
+		assert.Equal(t, repository.DefaultSwimlaneTitle, lanes[0].Title)
+	})
+
+	t.Run("GetMissing", func(t *testing.T) {
+		f := newFixture(t, newRepos)
+
+		_, err := f.repos.Board.GetBoardByID(f.ctx, uuid.New())
+		assert.Error(t, err)
+	})
+
+	t.Run("GetByUserPages", func(t *testing.T) {
+		f := newFixture(t, newRepos)
+		userID := uuid.New()
+		second := f.board(userID, "Second", at(2))
+		first := f.board(userID, "First", at(0))
+		middle := f.board(userID, "Middle", at(1))
+		f.board(uuid.New(), "Other", at(0))
+
+		page, err := f.repos.Board.GetBoardsByUser(f.ctx, userID, repository.Page{Limit: 2})
+		require.NoError(t, err)
+		assert.Equal(t, []uuid.UUID{first.ID, middle.ID}, ids(page, boardID))
+
+		last := page[len(page)-1]
+		page, err = f.repos.Board.GetBoardsByUser(f.ctx, userID, repository.Page{
+			After: repository.TimeCursor(last.CreatedAt, last.ID),
+			Limit: 2,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []uuid.UUID{second.ID}, ids(page, boardID))
+	})
+
+	t.Run("GetByMemberPages", func(t *testing.T) {
+		f := newFixture(t, newRepos)
+		userID := uuid.New()
+		own := f.board(userID, "Own", at(1))
+		shared := f.board(uuid.New(), "Shared", at(0))
+		later := f.board(userID, "Later", at(2))
+		f.board(uuid.New(), "Other", at(0))
+
+		require.NoError(t, f.repos.Workspace.AddWorkspaceMember(f.ctx, &entity.WorkspaceMember{
+			WorkspaceID: shared.WorkspaceID,
+			UserID:      userID,
+			Role:        entity.RoleViewer,
+			CreatedAt:   at(0),
+		}))
+
+		page, err := f.repos.Workspace.GetMemberBoards(f.ctx, userID, repository.Page{Limit: 2})
+		require.NoError(t, err)
+		assert.Equal(t, []uuid.UUID{shared.ID, own.ID}, ids(page, boardID))
+
+		last := page[len(page)-1]
+		page, err = f.repos.Workspace.GetMemberBoards(f.ctx, userID, repository.Page{
+			After: repository.TimeCursor(last.CreatedAt, last.ID),
+			Limit: 2,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []uuid.UUID{later.ID}, ids(page, boardID))
+	})
+
+	t.Run("UpdateChecksVersion", func(t *testing.T) {
+		f := newFixture(t, newRepos)
+		board := f.board(uuid.New(), "Board", at(0))
+
+		board.Title = "Renamed"
+		board.UpdatedAt = at(10)
+		require.NoError(t, f.repos.Board.UpdateBoard(f.ctx, &board))
+		assert.Equal(t, 2, board.Version)
+
+		got, err := f.repos.Board.GetBoardByID(f.ctx, board.ID)
+		require.NoError(t, err)
+		assertBoard(t, board, *got)
+
+		stale := board
+		stale.Version = 1
+		stale.Title = "Stale"
+		assert.ErrorIs(t, f.repos.Board.UpdateBoard(f.ctx, &stale), repository.ErrVersionMismatch)
+	})
+
+	t.Run("TransferChecksVersion", func(t *testing.T) {
+		f := newFixture(t, newRepos)
+		board := f.board(uuid.New(), "Board", at(0))
+		card := f.card(f.column(board, "Column", 0, at(0)), "Card", 0, at(0))
+		to, err := f.repos.Workspace.EnsurePersonalWorkspace(f.ctx, uuid.New(), at(0))
+		require.NoError(t, err)
+
+		board.UserID = to.CreatedBy
+		board.WorkspaceID = to.ID
+		board.UpdatedAt = at(10)
+		require.NoError(t, f.repos.Board.TransferBoard(f.ctx, &board))
+		assert.Equal(t, 2, board.Version)
+
+		got, err := f.repos.Board.GetBoardByID(f.ctx, board.ID)
+		require.NoError(t, err)
+		assertBoard(t, board, *got)
+
+		owned, err := f.repos.Board.GetBoardsByUser(f.ctx, to.CreatedBy, repository.Page{Limit: 10})
+		require.NoError(t, err)
+		assert.Equal(t, []uuid.UUID{board.ID}, ids(owned, boardID))
+
+		_, err = f.repos.Card.GetCardByID(f.ctx, card.ID)
+		assert.NoError(t, err, "the cards of a board move with it")
+
+		stale := board
+		stale.Version = 1
+		assert.ErrorIs(t, f.repos.Board.TransferBoard(f.ctx, &stale), repository.ErrVersionMismatch)
+	})
+
+	t.Run("DeleteWorkspaceCascades", func(t *testing.T) {
+		f := newFixture(t, newRepos)
+		board := f.board(uuid.New(), "Board", at(0))
+		card := f.card(f.column(board, "Column", 0, at(0)), "Card", 0, at(0))
+		kept := f.board(uuid.New(), "Kept", at(0))
+
+		require.NoError(t, f.repos.Workspace.DeleteWorkspace(f.ctx, board.WorkspaceID))
+
+		_, err := f.repos.Workspace.GetWorkspaceByID(f.ctx, board.WorkspaceID)
+		assert.ErrorIs(t, err, repository.ErrWorkspaceNotFound)
+		_, err = f.repos.Workspace.GetWorkspaceMember(f.ctx, board.WorkspaceID, board.UserID)
+		assert.ErrorIs(t, err, repository.ErrWorkspaceMemberNotFound, "the members of a workspace go with it")
+		_, err = f.repos.Board.GetBoardByID(f.ctx, board.ID)
+		assert.Error(t, err, "the boards of a workspace go with it")
+		_, err = f.repos.Card.GetCardByID(f.ctx, card.ID)
+		assert.Error(t, err, "and so do their cards")
+		_, err = f.repos.Board.GetBoardByID(f.ctx, kept.ID)
+		assert.NoError(t, err)
+
+		err = f.repos.Workspace.DeleteWorkspace(f.ctx, board.WorkspaceID)
+		assert.ErrorIs(t, err, repository.ErrWorkspaceNotFound)
+	})
+
+	t.Run("DeleteChecksVersion", func(t *testing.T) {
+		f := newFixture(t, newRepos)
+		board := f.board(uuid.New(), "Board", at(0))
+		column := f.column(board, "Column", 0, at(0))
+		card := f.card(column, "Card", 0, at(0))
+
+		err := f.repos.Board.DeleteBoard(f.ctx, board.ID, board.Version+1)
+		assert.ErrorIs(t, err, repository.ErrVersionMismatch)
+
+		require.NoError(t, f.repos.Board.DeleteBoard(f.ctx, board.ID, board.Version))
+
+		_, err = f.repos.Board.GetBoardByID(f.ctx, board.ID)
+		assert.Error(t, err)
+		_, err = f.repos.Column.GetColumnByID(f.ctx, column.ID)
+		assert.Error(t, err, "the columns of a board go with it")
+		_, err = f.repos.Card.GetCardByID(f.ctx, card.ID)
+		assert.Error(t, err, "the cards of a board go with it")
+	})
+}
+
+func boardID(b entity.Board) uuid.UUID { return b.ID }
+
+func assertBoard(t *testing.T, want, got entity.Board) {
+	t.Helper()
+	assert.Equal(t, want.ID, got.ID)
+	assert.Equal(t, want.UserID, got.UserID)
+	assert.Equal(t, want.WorkspaceID, got.WorkspaceID)
+	assert.Equal(t, want.Title, got.Title)
+	assert.Equal(t, want.Version, got.Version)
+	assertTime(t, want.CreatedAt, got.CreatedAt, "CreatedAt")
+	assertTime(t, want.UpdatedAt, got.UpdatedAt, "UpdatedAt")
+}
@@ -0,0 +1,255 @@
+package repotest
+
+import (
+	"testing"
+	"todo/internal/entity"
+	"todo/internal/repository"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestCardRepository runs the card repository contract against the backend
+// newRepos makes.
+func TestCardRepository(t *testing.T, newRepos NewRepos) {
+	t.Run("CreateAndGet", func(t *testing.T) {
+		f := newFixture(t, newRepos)
+		column := f.column(f.board(uuid.New(), "Board", at(0)), "Column", 0, at(0))
+		due := at(3600)
+
+		card := entity.Card{
+			ID:          uuid.New(),
+			UserID:      column.UserID,
+			ColumnID:    column.ID,
+			Title:       "Card",
+			Description: "Description",
+			Position:    1.5,
+			Priority:    entity.PriorityHigh,
+			AssigneeID:  uuid.New(),
+			DueDate:     &due,
+			Labels:      []string{"bug", "ui"},
+			Version:     1,
+			CreatedAt:   at(1),
+			UpdatedAt:   at(2),
+		}
+		require.NoError(t, f.repos.Card.CreateCard(f.ctx, &card))
+
+		lanes, err := f.repos.Swimlane.GetSwimlanesByBoard(f.ctx, column.BoardID, repository.Page{Limit: 1})
+		require.NoError(t, err)
+		assert.Equal(t, lanes[0].ID, card.SwimlaneID, "a card made without a swimlane goes to the first one")
+
+		got, err := f.repos.Card.GetCardByID(f.ctx, card.ID)
+		require.NoError(t, err)
+		assertCard(t, card, *got)
+	})
+
+	t.Run("GetMissing", func(t *testing.T) {
+		f := newFixture(t, newRepos)
+
+		_, err := f.repos.Card.GetCardByID(f.ctx, uuid.New())
+		assert.Error(t, err)
+	})
+
+	t.Run("GetByColumnPages", func(t *testing.T) {
+		f := newFixture(t, newRepos)
+		board := f.board(uuid.New(), "Board", at(0))
+		column := f.column(board, "Column", 0, at(0))
+		second := f.card(column, "Second", 0, at(2))
+		first := f.card(column, "First", 1, at(0))
+		middle := f.card(column, "Middle", 2, at(1))
+		f.card(f.column(board, "Other", 1, at(0)), "Other", 0, at(0))
+
+		page, err := f.repos.Card.GetCardsByColumn(f.ctx, column.ID, repository.Page{Limit: 2})
+		require.NoError(t, err)
+		assert.Equal(t, []uuid.UUID{first.ID, middle.ID}, ids(page, cardID), "cards are listed in the order they were made")
+
+		last := page[len(page)-1]
+		page, err = f.repos.Card.GetCardsByColumn(f.ctx, column.ID, repository.Page{
+			After: repository.TimeCursor(last.CreatedAt, last.ID),
+			Limit: 2,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []uuid.UUID{second.ID}, ids(page, cardID))
+	})
+
+	t.Run("GetCardsFilters", func(t *testing.T) {
+		f := newFixture(t, newRepos)
+		column := f.column(f.board(uuid.New(), "Board", at(0)), "Column", 0, at(0))
+
+		cards := make([]entity.Card, 4)
+		for i := range cards {
+			cards[i] = f.card(column, "Card", float64(len(cards)-i), at(i))
+			cards[i].Priority = i % 2
+			if i < 3 {
+				cards[i].Labels = []string{"bug"}
+			}
+			require.NoError(t, f.repos.Card.UpdateCard(f.ctx, &cards[i]))
+		}
+
+		label := "bug"
+		got, err := f.repos.Card.GetCards(f.ctx, repository.CardQuery{
+			ColumnID:   &column.ID,
+			Label:      &label,
+			Priorities: []int{0},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []uuid.UUID{cards[2].ID, cards[0].ID}, ids(got, cardID), "cards are sorted by position by default")
+
+		got, err = f.repos.Card.GetCards(f.ctx, repository.CardQuery{
+			ColumnID:   &column.ID,
+			SortBy:     repository.SortByCreated,
+			Descending: true,
+			Page:       repository.Page{Limit: 2},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []uuid.UUID{cards[3].ID, cards[2].ID}, ids(got, cardID))
+	})
+
+	t.Run("UpdateChecksVersion", func(t *testing.T) {
+		f := newFixture(t, newRepos)
+		card := f.card(f.column(f.board(uuid.New(), "Board", at(0)), "Column", 0, at(0)), "Card", 0, at(0))
+		due := at(7200)
+
+		card.Title = "Renamed"
+		card.Description = "Changed"
+		card.Position = 4
+		card.Priority = entity.PriorityUrgent
+		card.AssigneeID = uuid.New()
+		card.DueDate = &due
+		card.Labels = []string{"a", "b"}
+		card.UpdatedAt = at(10)
+		require.NoError(t, f.repos.Card.UpdateCard(f.ctx, &card))
+		assert.Equal(t, 2, card.Version)
+
+		card.Labels = []string{"c"}
+		require.NoError(t, f.repos.Card.UpdateCard(f.ctx, &card))
+
+		got, err := f.repos.Card.GetCardByID(f.ctx, card.ID)
+		require.NoError(t, err)
+		assertCard(t, card, *got)
+
+		stale := card
+		stale.Version = 1
+		assert.ErrorIs(t, f.repos.Card.UpdateCard(f.ctx, &stale), repository.ErrVersionMismatch)
+	})
+
+	t.Run("Move", func(t *testing.T) {
+		f := newFixture(t, newRepos)
+		board := f.board(uuid.New(), "Board", at(0))
+		other := f.board(board.UserID, "Other", at(0))
+		todo := f.column(board, "To do", 0, at(0))
+		doing := f.column(board, "Doing", 1, at(0))
+		elsewhere := f.column(other, "Elsewhere", 0, at(0))
+		lane := f.swimlane(board, "Lane", 1)
+		card := f.card(todo, "Card", 0, at(0))
+
+		card.ColumnID = uuid.Nil
+		card.SwimlaneID = lane.ID
+		card.UpdatedAt = at(1)
+		require.NoError(t, f.repos.Card.MoveCard(f.ctx, &card))
+		got := f.get(card.ID)
+		assert.Equal(t, todo.ID, got.ColumnID, "a card moved to a swimlane alone keeps its column")
+		assert.Equal(t, lane.ID, got.SwimlaneID)
+
+		card.ColumnID = doing.ID
+		card.SwimlaneID = uuid.Nil
+		require.NoError(t, f.repos.Card.MoveCard(f.ctx, &card))
+		got = f.get(card.ID)
+		assert.Equal(t, doing.ID, got.ColumnID)
+		assert.Equal(t, lane.ID, got.SwimlaneID, "a card moved on its board keeps its swimlane")
+
+		card.ColumnID = elsewhere.ID
+		require.NoError(t, f.repos.Card.MoveCard(f.ctx, &card))
+		lanes, err := f.repos.Swimlane.GetSwimlanesByBoard(f.ctx, other.ID, repository.Page{Limit: 1})
+		require.NoError(t, err)
+		got = f.get(card.ID)
+		assert.Equal(t, elsewhere.ID, got.ColumnID)
+		assert.Equal(t, lanes[0].ID, got.SwimlaneID, "a card moved to another board goes to its first swimlane")
+		assert.Equal(t, 4, got.Version)
+
+		stale := card
+		stale.Version = 1
+		stale.ColumnID = todo.ID
+		assert.ErrorIs(t, f.repos.Card.MoveCard(f.ctx, &stale), repository.ErrVersionMismatch)
+	})
+
+	t.Run("ParentAndAncestors", func(t *testing.T) {
+		f := newFixture(t, newRepos)
+		column := f.column(f.board(uuid.New(), "Board", at(0)), "Column", 0, at(0))
+		root := f.card(column, "Root", 0, at(0))
+		middle := f.card(column, "Middle", 1, at(0))
+		leaf := f.card(column, "Leaf", 2, at(0))
+
+		middle.ParentID = root.ID
+		require.NoError(t, f.repos.Card.SetCardParent(f.ctx, &middle))
+		leaf.ParentID = middle.ID
+		require.NoError(t, f.repos.Card.SetCardParent(f.ctx, &leaf))
+		assert.Equal(t, 2, leaf.Version)
+
+		ancestors, err := f.repos.Card.GetCardAncestors(f.ctx, leaf.ID)
+		require.NoError(t, err)
+		assert.Equal(t, []uuid.UUID{middle.ID, root.ID}, ids(ancestors, cardID), "ancestors are listed from the parent up")
+
+		assert.Equal(t, 1, f.get(root.ID).ChildCount)
+		assert.Equal(t, root.ID, f.get(middle.ID).ParentID)
+
+		middle.ParentID = uuid.Nil
+		require.NoError(t, f.repos.Card.SetCardParent(f.ctx, &middle))
+		assert.Equal(t, uuid.Nil, f.get(middle.ID).ParentID)
+		assert.Equal(t, 0, f.get(root.ID).ChildCount)
+
+		ancestors, err = f.repos.Card.GetCardAncestors(f.ctx, root.ID)
+		require.NoError(t, err)
+		assert.Empty(t, ancestors)
+
+		stale := leaf
+		stale.Version = 1
+		assert.ErrorIs(t, f.repos.Card.SetCardParent(f.ctx, &stale), repository.ErrVersionMismatch)
+	})
+
+	t.Run("DeleteChecksVersion", func(t *testing.T) {
+		f := newFixture(t, newRepos)
+		card := f.card(f.column(f.board(uuid.New(), "Board", at(0)), "Column", 0, at(0)), "Card", 0, at(0))
+
+		err := f.repos.Card.DeleteCard(f.ctx, card.ID, card.Version+1)
+		assert.ErrorIs(t, err, repository.ErrVersionMismatch)
+
+		require.NoError(t, f.repos.Card.DeleteCard(f.ctx, card.ID, card.Version))
+
+		_, err = f.repos.Card.GetCardByID(f.ctx, card.ID)
+		assert.Error(t, err)
+	})
+}
+
+func (f *fixture) get(id uuid.UUID) entity.Card {
+	f.t.Helper()
+	card, err := f.repos.Card.GetCardByID(f.ctx, id)
+	require.NoError(f.t, err)
+	return *card
+}
+
+func cardID(c entity.Card) uuid.UUID { return c.ID }
+
+func assertCard(t *testing.T, want, got entity.Card) {
+	t.Helper()
+	assert.Equal(t, want.ID, got.ID)
+	assert.Equal(t, want.UserID, got.UserID)
+	assert.Equal(t, want.ColumnID, got.ColumnID)
+	assert.Equal(t, want.SwimlaneID, got.SwimlaneID)
+	assert.Equal(t, want.ParentID, got.ParentID)
+	assert.Equal(t, want.Title, got.Title)
+	assert.Equal(t, want.Description, got.Description)
+	assert.Equal(t, want.Position, got.Position)
+	assert.Equal(t, want.Priority, got.Priority)
+	assert.Equal(t, want.AssigneeID, got.AssigneeID)
+	assert.ElementsMatch(t, want.Labels, got.Labels)
+	assert.Equal(t, want.Version, got.Version)
+	assertTime(t, want.CreatedAt, got.CreatedAt, "CreatedAt")
+	assertTime(t, want.UpdatedAt, got.UpdatedAt, "UpdatedAt")
+
+	if assert.Equal(t, want.DueDate == nil, got.DueDate == nil, "DueDate") && want.DueDate != nil {
+		assertTime(t, *want.DueDate, *got.DueDate, "DueDate")
+	}
+	assert.Nil(t, got.ArchivedAt)
+}
@@ -0,0 +1,158 @@
+package repotest
+
+import (
+	"testing"
+	"todo/internal/entity"
+	"todo/internal/repository"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestColumnRepository runs the column repository contract against the
+// backend newRepos makes.
+func TestColumnRepository(t *testing.T, newRepos NewRepos) {
+	t.Run("CreateAndGet", func(t *testing.T) {
+		f := newFixture(t, newRepos)
+		board := f.board(uuid.New(), "Board", at(0))
+
+		column := entity.Column{
+			ID:        uuid.New(),
+			UserID:    board.UserID,
+			BoardID:   board.ID,
+			Title:     "Done",
+			Position:  2.5,
+			Done:      true,
+			Version:   1,
+			CreatedAt: at(1),
+			UpdatedAt: at(2),
+		}
+		require.NoError(t, f.repos.Column.CreateColumn(f.ctx, &column))
+
+		got, err := f.repos.Column.GetColumnByID(f.ctx, column.ID)
+		require.NoError(t, err)
+		assertColumn(t, column, *got)
+	})
+
+	t.Run("GetMissing", func(t *testing.T) {
+		f := newFixture(t, newRepos)
+
+		_, err := f.repos.Column.GetColumnByID(f.ctx, uuid.New())
+		assert.Error(t, err)
+	})
+
+	t.Run("GetByBoardPages", func(t *testing.T) {
+		f := newFixture(t, newRepos)
+		board := f.board(uuid.New(), "Board", at(0))
+		second := f.column(board, "Second", 0, at(2))
+		first := f.column(board, "First", 1, at(0))
+		middle := f.column(board, "Middle", 2, at(1))
+		f.column(f.board(uuid.New(), "Other", at(0)), "Other", 0, at(0))
+
+		page, err := f.repos.Column.GetColumnsByBoard(f.ctx, board.ID, repository.Page{Limit: 2})
+		require.NoError(t, err)
+		assert.Equal(t, []uuid.UUID{first.ID, middle.ID}, ids(page, columnID), "columns are listed in the order they were made")
+
+		last := page[len(page)-1]
+		page, err = f.repos.Column.GetColumnsByBoard(f.ctx, board.ID, repository.Page{
+			After: repository.TimeCursor(last.CreatedAt, last.ID),
+			Limit: 2,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []uuid.UUID{second.ID}, ids(page, columnID))
+	})
+
+	t.Run("UpdateChecksVersion", func(t *testing.T) {
+		f := newFixture(t, newRepos)
+		column := f.column(f.board(uuid.New(), "Board", at(0)), "Column", 0, at(0))
+
+		column.Title = "Renamed"
+		column.Position = 3
+		column.Done = true
+		column.UpdatedAt = at(10)
+		require.NoError(t, f.repos.Column.UpdateColumn(f.ctx, &column))
+		assert.Equal(t, 2, column.Version)
+
+		got, err := f.repos.Column.GetColumnByID(f.ctx, column.ID)
+		require.NoError(t, err)
+		assertColumn(t, column, *got)
+
+		stale := column
+		stale.Version = 1
+		assert.ErrorIs(t, f.repos.Column.UpdateColumn(f.ctx, &stale), repository.ErrVersionMismatch)
+	})
+
+	t.Run("DeleteChecksVersion", func(t *testing.T) {
+		f := newFixture(t, newRepos)
+		column := f.column(f.board(uuid.New(), "Board", at(0)), "Column", 0, at(0))
+		card := f.card(column, "Card", 0, at(0))
+
+		err := f.repos.Column.DeleteColumn(f.ctx, column.ID, column.Version+1)
+		assert.ErrorIs(t, err, repository.ErrVersionMismatch)
+
+		require.NoError(t, f.repos.Column.DeleteColumn(f.ctx, column.ID, column.Version))
+
+		_, err = f.repos.Column.GetColumnByID(f.ctx, column.ID)
+		assert.Error(t, err)
+		_, err = f.repos.Card.GetCardByID(f.ctx, card.ID)
+		assert.Error(t, err, "the cards of a column go with it")
+	})
+
+	t.Run("Merge", func(t *testing.T) {
+		f := newFixture(t, newRepos)
+		from := f.board(uuid.New(), "From", at(0))
+		to := f.board(from.UserID, "To", at(0))
+		source := f.column(from, "Source", 0, at(0))
+		target := f.column(to, "Target", 0, at(0))
+
+		kept := f.card(target, "Kept", 5, at(0))
+		later := f.card(source, "Later", 1, at(0))
+		earlier := f.card(source, "Earlier", 0, at(1))
+
+		require.NoError(t, f.repos.Column.MergeColumn(f.ctx, &source, target.ID))
+
+		_, err := f.repos.Column.GetColumnByID(f.ctx, source.ID)
+		assert.Error(t, err, "the source column is deleted")
+
+		cards, err := f.repos.Card.GetCards(f.ctx, repository.CardQuery{ColumnID: &target.ID})
+		require.NoError(t, err)
+		assert.Equal(t, []uuid.UUID{kept.ID, earlier.ID, later.ID}, ids(cards, cardID),
+			"the cards of the source go after those of the target, in their order")
+
+		lanes, err := f.repos.Swimlane.GetSwimlanesByBoard(f.ctx, to.ID, repository.Page{Limit: 1})
+		require.NoError(t, err)
+		for _, card := range cards {
+			assert.Equal(t, lanes[0].ID, card.SwimlaneID, "card %s is in the first swimlane of the target board", card.Title)
+		}
+	})
+
+	t.Run("MergeChecksVersion", func(t *testing.T) {
+		f := newFixture(t, newRepos)
+		board := f.board(uuid.New(), "Board", at(0))
+		source := f.column(board, "Source", 0, at(0))
+		target := f.column(board, "Target", 1, at(0))
+
+		stale := source
+		stale.Version++
+		assert.ErrorIs(t, f.repos.Column.MergeColumn(f.ctx, &stale, target.ID), repository.ErrVersionMismatch)
+
+		_, err := f.repos.Column.GetColumnByID(f.ctx, source.ID)
+		assert.NoError(t, err)
+	})
+}
+
+func columnID(c entity.Column) uuid.UUID { return c.ID }
+
+func assertColumn(t *testing.T, want, got entity.Column) {
+	t.Helper()
+	assert.Equal(t, want.ID, got.ID)
+	assert.Equal(t, want.UserID, got.UserID)
+	assert.Equal(t, want.BoardID, got.BoardID)
+	assert.Equal(t, want.Title, got.Title)
+	assert.Equal(t, want.Position, got.Position)
+	assert.Equal(t, want.Done, got.Done)
+	assert.Equal(t, want.Version, got.Version)
+	assertTime(t, want.CreatedAt, got.CreatedAt, "CreatedAt")
+	assertTime(t, want.UpdatedAt, got.UpdatedAt, "UpdatedAt")
+}
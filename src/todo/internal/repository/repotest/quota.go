@@ -0,0 +1,90 @@
+package repotest
+
+import (
+	"testing"
+	"todo/internal/entity"
+	"todo/internal/repository"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestQuotaRepository runs the quota repository contract against the
+// backend newRepos makes.
+func TestQuotaRepository(t *testing.T, newRepos NewRepos) {
+	t.Run("GetMissing", func(t *testing.T) {
+		f := newFixture(t, newRepos)
+
+		_, err := f.repos.Quota.GetQuotaOverride(f.ctx, uuid.New())
+		assert.ErrorIs(t, err, repository.ErrQuotaOverrideNotFound)
+	})
+
+	t.Run("SetAndGet", func(t *testing.T) {
+		f := newFixture(t, newRepos)
+
+		override := entity.QuotaOverride{
+			UserID:         uuid.New(),
+			BoardsPerUser:  bound(0),
+			CardsPerColumn: bound(1000),
+			UpdatedAt:      at(0),
+		}
+		require.NoError(t, f.repos.Quota.SetQuotaOverride(f.ctx, &override))
+
+		got, err := f.repos.Quota.GetQuotaOverride(f.ctx, override.UserID)
+		require.NoError(t, err)
+		assertOverride(t, override, *got)
+	})
+
+	t.Run("SetReplaces", func(t *testing.T) {
+		f := newFixture(t, newRepos)
+		userID := uuid.New()
+
+		first := entity.QuotaOverride{UserID: userID, BoardsPerUser: bound(5), UpdatedAt: at(0)}
+		require.NoError(t, f.repos.Quota.SetQuotaOverride(f.ctx, &first))
+
+		second := entity.QuotaOverride{UserID: userID, DescriptionLength: bound(500), UpdatedAt: at(1)}
+		require.NoError(t, f.repos.Quota.SetQuotaOverride(f.ctx, &second))
+
+		got, err := f.repos.Quota.GetQuotaOverride(f.ctx, userID)
+		require.NoError(t, err)
+		assertOverride(t, second, *got)
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		f := newFixture(t, newRepos)
+
+		override := entity.QuotaOverride{UserID: uuid.New(), ColumnsPerBoard: bound(3), UpdatedAt: at(0)}
+		require.NoError(t, f.repos.Quota.SetQuotaOverride(f.ctx, &override))
+		require.NoError(t, f.repos.Quota.DeleteQuotaOverride(f.ctx, override.UserID))
+
+		_, err := f.repos.Quota.GetQuotaOverride(f.ctx, override.UserID)
+		assert.ErrorIs(t, err, repository.ErrQuotaOverrideNotFound)
+
+		err = f.repos.Quota.DeleteQuotaOverride(f.ctx, override.UserID)
+		assert.ErrorIs(t, err, repository.ErrQuotaOverrideNotFound)
+	})
+
+	t.Run("Lock", func(t *testing.T) {
+		f := newFixture(t, newRepos)
+		scope := uuid.New()
+
+		require.NoError(t, f.repos.Quota.LockQuota(f.ctx, scope))
+		assert.NoError(t, f.repos.Quota.LockQuota(f.ctx, scope), "a scope can be locked again once the lock is let go")
+	})
+}
+
+func bound(n int) *int {
+	return &n
+}
+
+// assertOverride compares the bounds by value: nil is left as configured.
+func assertOverride(t *testing.T, want, got entity.QuotaOverride) {
+	t.Helper()
+	assert.Equal(t, want.UserID, got.UserID)
+	assert.Equal(t, want.BoardsPerUser, got.BoardsPerUser, "boards per user")
+	assert.Equal(t, want.ColumnsPerBoard, got.ColumnsPerBoard, "columns per board")
+	assert.Equal(t, want.CardsPerColumn, got.CardsPerColumn, "cards per column")
+	assert.Equal(t, want.DescriptionLength, got.DescriptionLength, "description length")
+	assertTime(t, want.UpdatedAt, got.UpdatedAt, "updated at")
+}
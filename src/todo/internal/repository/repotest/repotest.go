@@ -0,0 +1,132 @@
+// Package repotest holds the contract the board, column, card and quota
+// repositories keep whatever they store their data in. A backend runs the
+// suites from its own tests, so that it cannot drift from the others
+// unnoticed.
+package repotest
+
+import (
+	"context"
+	"testing"
+	"time"
+	"todo/internal/entity"
+	"todo/internal/repository"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Repos are the repositories of one backend. The suites need the swimlane
+// and workspace ones to set up the boards they work on.
+type Repos struct {
+	Board     repository.BoardRepository
+	Column    repository.ColumnRepository
+	Swimlane  repository.SwimlaneRepository
+	Card      repository.CardRepository
+	Workspace repository.WorkspaceRepository
+	Quota     repository.QuotaRepository
+}
+
+// NewRepos returns the repositories of a backend over an empty store. It is
+// called once for every test of a suite.
+type NewRepos func(t *testing.T) Repos
+
+// base is the time the rows of the suites are made around. It is in whole
+// seconds and in UTC, so that every backend stores it as it is.
+var base = time.Date(2024, time.March, 1, 12, 0, 0, 0, time.UTC)
+
+func at(seconds int) time.Time {
+	return base.Add(time.Duration(seconds) * time.Second)
+}
+
+// fixture makes the rows the tests build on through repos.
+type fixture struct {
+	t     *testing.T
+	ctx   context.Context
+	repos Repos
+}
+
+func newFixture(t *testing.T, newRepos NewRepos) *fixture {
+	return &fixture{t: t, ctx: context.Background(), repos: newRepos(t)}
+}
+
+func (f *fixture) board(userID uuid.UUID, title string, createdAt time.Time) entity.Board {
+	workspace, err := f.repos.Workspace.EnsurePersonalWorkspace(f.ctx, userID, createdAt)
+	require.NoError(f.t, err)
+
+	board := entity.Board{
+		ID:          uuid.New(),
+		UserID:      userID,
+		WorkspaceID: workspace.ID,
+		Title:       title,
+		Version:     1,
+		CreatedAt:   createdAt,
+		UpdatedAt:   createdAt,
+	}
+	require.NoError(f.t, f.repos.Board.CreateBoard(f.ctx, &board))
+
+	return board
+}
+
+func (f *fixture) column(board entity.Board, title string, position float64, createdAt time.Time) entity.Column {
+	column := entity.Column{
+		ID:        uuid.New(),
+		UserID:    board.UserID,
+		BoardID:   board.ID,
+		Title:     title,
+		Position:  position,
+		Version:   1,
+		CreatedAt: createdAt,
+		UpdatedAt: createdAt,
+	}
+	require.NoError(f.t, f.repos.Column.CreateColumn(f.ctx, &column))
+
+	return column
+}
+
+func (f *fixture) swimlane(board entity.Board, title string, position float64) entity.Swimlane {
+	swimlane := entity.Swimlane{
+		ID:        uuid.New(),
+		UserID:    board.UserID,
+		BoardID:   board.ID,
+		Title:     title,
+		Position:  position,
+		Version:   1,
+		CreatedAt: base,
+		UpdatedAt: base,
+	}
+	require.NoError(f.t, f.repos.Swimlane.CreateSwimlane(f.ctx, &swimlane))
+
+	return swimlane
+}
+
+func (f *fixture) card(column entity.Column, title string, position float64, createdAt time.Time) entity.Card {
+	card := entity.Card{
+		ID:        uuid.New(),
+		UserID:    column.UserID,
+		ColumnID:  column.ID,
+		Title:     title,
+		Position:  position,
+		Version:   1,
+		CreatedAt: createdAt,
+		UpdatedAt: createdAt,
+	}
+	require.NoError(f.t, f.repos.Card.CreateCard(f.ctx, &card))
+
+	return card
+}
+
+// assertTime compares the instants only: backends hand times back in the
+// location they keep them in.
+func assertTime(t *testing.T, want, got time.Time, field string) {
+	t.Helper()
+	assert.True(t, want.Equal(got), "%s: want %v, got %v", field, want, got)
+}
+
+func ids[T any](items []T, id func(T) uuid.UUID) []uuid.UUID {
+	out := make([]uuid.UUID, len(items))
+	for i, item := range items {
+		out[i] = id(item)
+	}
+	return out
+}
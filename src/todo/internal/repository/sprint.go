@@ -0,0 +1,97 @@
+package repository
+
+import (
+	"errors"
+	"time"
+	"todo/internal/entity"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrSprintNotFound     = errors.New("sprint not found")
+	ErrSprintCardNotFound = errors.New("card is not in the sprint")
+	ErrSprintNoName       = errors.New("sprint should have a name")
+	ErrSprintDates        = errors.New("sprint should end on or after the day it starts")
+	ErrSprintClosed       = errors.New("sprint is closed")
+	ErrSprintNext         = errors.New("next sprint should be another open sprint of the same board")
+	ErrSprintCardBoard    = errors.New("card should be on the board of the sprint")
+	ErrSprintPoints       = errors.New("story points should not be negative")
+)
+
+type Sprint struct {
+	ID        uuid.UUID  `db:"id"`
+	BoardID   uuid.UUID  `db:"board_id"`
+	Name      string     `db:"name"`
+	StartDate time.Time  `db:"start_date"`
+	EndDate   time.Time  `db:"end_date"`
+	ClosedAt  *time.Time `db:"closed_at"`
+	CreatedAt time.Time  `db:"created_at"`
+}
+
+type SprintCard struct {
+	SprintID  uuid.UUID `db:"sprint_id"`
+	CardID    uuid.UUID `db:"card_id"`
+	Points    int       `db:"points"`
+	AddedAt   time.Time `db:"added_at"`
+	CardTitle string    `db:"card_title"`
+	Done      bool      `db:"done"`
+}
+
+type BurndownPoint struct {
+	Day    time.Time `db:"day"`
+	Cards  int       `db:"cards"`
+	Points int       `db:"points"`
+}
+
+func RepoSprint(s entity.Sprint) Sprint {
+	return Sprint{
+		ID:        s.ID,
+		BoardID:   s.BoardID,
+		Name:      s.Name,
+		StartDate: s.StartDate,
+		EndDate:   s.EndDate,
+		ClosedAt:  s.ClosedAt,
+		CreatedAt: s.CreatedAt,
+	}
+}
+
+func SprintToEntity(r Sprint) entity.Sprint {
+	return entity.Sprint{
+		ID:        r.ID,
+		BoardID:   r.BoardID,
+		Name:      r.Name,
+		StartDate: r.StartDate,
+		EndDate:   r.EndDate,
+		ClosedAt:  r.ClosedAt,
+		CreatedAt: r.CreatedAt,
+	}
+}
+
+func RepoSprintCard(c entity.SprintCard) SprintCard {
+	return SprintCard{
+		SprintID: c.SprintID,
+		CardID:   c.CardID,
+		Points:   c.Points,
+		AddedAt:  c.AddedAt,
+	}
+}
+
+func SprintCardToEntity(r SprintCard) entity.SprintCard {
+	return entity.SprintCard{
+		SprintID:  r.SprintID,
+		CardID:    r.CardID,
+		Points:    r.Points,
+		AddedAt:   r.AddedAt,
+		CardTitle: r.CardTitle,
+		Done:      r.Done,
+	}
+}
+
+func BurndownPointToEntity(r BurndownPoint) entity.BurndownPoint {
+	return entity.BurndownPoint{
+		Day:    r.Day,
+		Cards:  r.Cards,
+		Points: r.Points,
+	}
+}
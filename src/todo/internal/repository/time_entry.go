@@ -0,0 +1,80 @@
+package repository
+
+import (
+	"errors"
+	"time"
+	"todo/internal/entity"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrTimerRunning      = errors.New("user already has a running timer")
+	ErrNoTimerRunning    = errors.New("user has no running timer")
+	ErrTimeEntryNoUserID = errors.New("time entry should have a user")
+	ErrTimeEntryBounds   = errors.New("time entry should end after it starts")
+	ErrTimeEntryInFuture = errors.New("time entry should not end in the future")
+	ErrTimeReportNoScope = errors.New("time report should be of a board or of a user")
+	ErrTimeReportBounds  = errors.New("time report should end after it starts")
+)
+
+// TimeReportQuery selects the finished time entries started in [From, To),
+// of a board, of a user or both. Nil fields are not filtered on.
+type TimeReportQuery struct {
+	BoardID *uuid.UUID
+	UserID  *uuid.UUID
+	From    time.Time
+	To      time.Time
+}
+
+type TimeEntry struct {
+	ID        uuid.UUID  `db:"id"`
+	CardID    uuid.UUID  `db:"card_id"`
+	UserID    uuid.UUID  `db:"user_id"`
+	StartedAt time.Time  `db:"started_at"`
+	EndedAt   *time.Time `db:"ended_at"`
+	Note      string     `db:"note"`
+	CreatedAt time.Time  `db:"created_at"`
+}
+
+type TimeReportRow struct {
+	Day       time.Time `db:"day"`
+	UserID    uuid.UUID `db:"user_id"`
+	CardID    uuid.UUID `db:"card_id"`
+	CardTitle string    `db:"card_title"`
+	Seconds   int64     `db:"seconds"`
+}
+
+func RepoTimeEntry(e entity.TimeEntry) TimeEntry {
+	return TimeEntry{
+		ID:        e.ID,
+		CardID:    e.CardID,
+		UserID:    e.UserID,
+		StartedAt: e.StartedAt,
+		EndedAt:   e.EndedAt,
+		Note:      e.Note,
+		CreatedAt: e.CreatedAt,
+	}
+}
+
+func TimeEntryToEntity(r TimeEntry) entity.TimeEntry {
+	return entity.TimeEntry{
+		ID:        r.ID,
+		CardID:    r.CardID,
+		UserID:    r.UserID,
+		StartedAt: r.StartedAt,
+		EndedAt:   r.EndedAt,
+		Note:      r.Note,
+		CreatedAt: r.CreatedAt,
+	}
+}
+
+func TimeReportRowToEntity(r TimeReportRow) entity.TimeReportRow {
+	return entity.TimeReportRow{
+		Day:       r.Day,
+		UserID:    r.UserID,
+		CardID:    r.CardID,
+		CardTitle: r.CardTitle,
+		Duration:  time.Duration(r.Seconds) * time.Second,
+	}
+}
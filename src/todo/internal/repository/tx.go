@@ -0,0 +1,10 @@
+package repository
+
+import "context"
+
+// TxManager runs units of work. Repository calls made with the context fn is
+// given share one transaction, committed if fn returns nil and rolled back
+// otherwise. A unit of work started inside another one joins it.
+type TxManager interface {
+	WithinTx(ctx context.Context, fn func(ctx context.Context) error) error
+}
@@ -0,0 +1,8 @@
+package repository
+
+import "errors"
+
+var (
+	ErrTransferNoUserID = errors.New("transfer should name the user the boards go to")
+	ErrTransferToSelf   = errors.New("boards cannot be transferred to the user who owns them")
+)
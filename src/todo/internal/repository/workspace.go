@@ -0,0 +1,91 @@
+package repository
+
+import (
+	"errors"
+	"time"
+	"todo/internal/entity"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrWorkspaceNoName         = errors.New("workspace should have a name")
+	ErrWorkspaceNoCreator      = errors.New("workspace should have a creator")
+	ErrWorkspaceMemberNoUserID = errors.New("workspace member should have a user id")
+	ErrWorkspaceRole           = errors.New("workspace role should be admin, member or viewer")
+	ErrWorkspaceNotFound       = errors.New("workspace not found")
+	ErrWorkspaceAccess         = errors.New("user has no access to the workspace")
+	ErrWorkspaceMemberNotFound = errors.New("user is not a member of the workspace")
+	ErrWorkspaceMemberExists   = errors.New("user is already a member of the workspace")
+	ErrWorkspaceLastAdmin      = errors.New("workspace should keep at least one admin")
+	ErrWorkspacePersonal       = errors.New("personal workspace cannot have other members")
+)
+
+// PersonalWorkspaceName is the name of the workspace every user gets for the
+// boards they make outside any other.
+const PersonalWorkspaceName = "Personal"
+
+type Workspace struct {
+	ID        uuid.UUID `db:"id"         bson:"_id"`
+	Name      string    `db:"name"       bson:"name"`
+	Personal  bool      `db:"personal"   bson:"personal"`
+	CreatedBy uuid.UUID `db:"created_by" bson:"created_by"`
+	CreatedAt time.Time `db:"created_at" bson:"created_at"`
+}
+
+type WorkspaceMember struct {
+	WorkspaceID uuid.UUID `db:"workspace_id" bson:"workspace_id"`
+	UserID      uuid.UUID `db:"user_id"      bson:"user_id"`
+	Role        string    `db:"role"         bson:"role"`
+	CreatedAt   time.Time `db:"created_at"   bson:"created_at"`
+}
+
+type WorkspaceMembership struct {
+	Workspace
+	Role string `db:"role"`
+}
+
+func RepoWorkspace(e entity.Workspace) Workspace {
+	return Workspace{
+		ID:        e.ID,
+		Name:      e.Name,
+		Personal:  e.Personal,
+		CreatedBy: e.CreatedBy,
+		CreatedAt: e.CreatedAt,
+	}
+}
+
+func RepoWorkspaceMember(e entity.WorkspaceMember) WorkspaceMember {
+	return WorkspaceMember{
+		WorkspaceID: e.WorkspaceID,
+		UserID:      e.UserID,
+		Role:        e.Role,
+		CreatedAt:   e.CreatedAt,
+	}
+}
+
+func WorkspaceToEntity(r Workspace) entity.Workspace {
+	return entity.Workspace{
+		ID:        r.ID,
+		Name:      r.Name,
+		Personal:  r.Personal,
+		CreatedBy: r.CreatedBy,
+		CreatedAt: r.CreatedAt,
+	}
+}
+
+func WorkspaceMemberToEntity(r WorkspaceMember) entity.WorkspaceMember {
+	return entity.WorkspaceMember{
+		WorkspaceID: r.WorkspaceID,
+		UserID:      r.UserID,
+		Role:        r.Role,
+		CreatedAt:   r.CreatedAt,
+	}
+}
+
+func WorkspaceMembershipToEntity(r WorkspaceMembership) entity.WorkspaceMembership {
+	return entity.WorkspaceMembership{
+		Workspace: WorkspaceToEntity(r.Workspace),
+		Role:      r.Role,
+	}
+}
@@ -0,0 +1,26 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// Caller is the user a request is made for, as vouched for by the
+// aggregator that authenticated them.
+type Caller struct {
+	UserID uuid.UUID
+	Admin  bool
+}
+
+type callerKey struct{}
+
+func WithCaller(ctx context.Context, caller Caller) context.Context {
+	return context.WithValue(ctx, callerKey{}, caller)
+}
+
+// CallerFrom returns the caller the request is made for, if it names one.
+func CallerFrom(ctx context.Context) (Caller, bool) {
+	caller, ok := ctx.Value(callerKey{}).(Caller)
+	return caller, ok
+}
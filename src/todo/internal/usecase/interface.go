@@ -4,28 +4,221 @@ import (
 	"context"
 	"time"
 	"todo/internal/entity"
+	"todo/internal/repository"
 
 	"github.com/google/uuid"
 )
 
 type TodoUseCase interface {
+	// CreateBoard puts the board in the workspace board.WorkspaceID, which
+	// the user should be able to write to, or in their personal workspace
+	// without one.
 	CreateBoard(ctx context.Context, board *entity.Board) error
 	CreateColumn(ctx context.Context, column *entity.Column) error
+	CreateSwimlane(ctx context.Context, swimlane *entity.Swimlane) error
 	CreateCard(ctx context.Context, card *entity.Card) error
 
 	GetBoardByID(ctx context.Context, id uuid.UUID) (*entity.Board, error)
 	GetColumnByID(ctx context.Context, id uuid.UUID) (*entity.Column, error)
+	GetSwimlaneByID(ctx context.Context, id uuid.UUID) (*entity.Swimlane, error)
 	GetCardByID(ctx context.Context, id uuid.UUID) (*entity.Card, error)
-	GetBoardsByUser(ctx context.Context, userID uuid.UUID, limit, offset int) ([]entity.Board, error)
-	GetColumnsByBoard(ctx context.Context, boardID uuid.UUID, limit, offset int) ([]entity.Column, error)
-	GetCardsByColumn(ctx context.Context, columnID uuid.UUID, limit, offset int) ([]entity.Card, error)
-	GetNewCards(ctx context.Context, from, to time.Time) ([]entity.Card, error)
+	GetBoardsByUser(ctx context.Context, userID uuid.UUID, page repository.Page) ([]entity.Board, *repository.Cursor, error)
+	GetColumnsByBoard(ctx context.Context, boardID uuid.UUID, page repository.Page) ([]entity.Column, *repository.Cursor, error)
+	GetSwimlanesByBoard(ctx context.Context, boardID uuid.UUID, page repository.Page) ([]entity.Swimlane, *repository.Cursor, error)
+	GetCardsByColumn(ctx context.Context, columnID uuid.UUID, page repository.Page) ([]entity.Card, *repository.Cursor, error)
+	GetCards(ctx context.Context, query repository.CardQuery) ([]entity.Card, *repository.Cursor, error)
+	GetCardAncestors(ctx context.Context, id uuid.UUID) ([]entity.Card, error)
+	GetNewCards(ctx context.Context, from, to time.Time, page repository.Page) ([]entity.Card, *repository.Cursor, error)
 
 	UpdateBoard(ctx context.Context, board *entity.Board) error
 	UpdateColumn(ctx context.Context, column *entity.Column) error
+	UpdateSwimlane(ctx context.Context, swimlane *entity.Swimlane) error
 	UpdateCard(ctx context.Context, card *entity.Card) error
+	SetCardParent(ctx context.Context, card *entity.Card) error
 
-	DeleteBoard(ctx context.Context, id uuid.UUID) error
-	DeleteColumn(ctx context.Context, id uuid.UUID) error
-	DeleteCard(ctx context.Context, id uuid.UUID) error
+	DeleteBoard(ctx context.Context, id uuid.UUID, version int) error
+	DeleteColumn(ctx context.Context, id uuid.UUID, version int) error
+	DeleteSwimlane(ctx context.Context, id uuid.UUID, version int) error
+	DeleteCard(ctx context.Context, id uuid.UUID, version int) error
+
+	ApplyCardOps(ctx context.Context, ops []repository.CardOp, allOrNothing bool) ([]repository.CardOpResult, error)
+
+	// MoveColumn moves the column with its cards after the last column of
+	// the board boardID. The user should be able to write to both boards.
+	MoveColumn(ctx context.Context, userID, id, boardID uuid.UUID, version int) (*entity.Column, error)
+	// MergeBoards moves every column of the board sourceID after the last
+	// column of the board targetID, dealing with matching titles by the
+	// strategy, one of the entity.MergeColumns* constants. The source board
+	// is left without columns. The user should be able to write to both
+	// boards.
+	MergeBoards(ctx context.Context, userID, sourceID, targetID uuid.UUID, strategy string) (*entity.BoardMerge, error)
+}
+
+// QuotaUseCase is a TodoUseCase that holds users to their quota and lets
+// admins override it for single users. Columns, cards and card descriptions
+// count against the quota of the owner of their board.
+type QuotaUseCase interface {
+	TodoUseCase
+
+	// GetUserQuota returns the quota the user is held to: the configured
+	// one with their override, if they have one, in place.
+	GetUserQuota(ctx context.Context, userID uuid.UUID) (*entity.UserQuota, error)
+	// SetQuotaOverride replaces the override the user had, if any.
+	SetQuotaOverride(ctx context.Context, override *entity.QuotaOverride) error
+	DeleteQuotaOverride(ctx context.Context, userID uuid.UUID) error
+}
+
+// ActivityHub delivers activities to the watchers of their board as they
+// happen.
+type ActivityHub interface {
+	Publish(activity entity.Activity)
+	// Subscribe returns the activities of the board published from now on
+	// and a function to stop receiving them. The channel is closed if the
+	// watcher falls behind.
+	Subscribe(boardID uuid.UUID) (<-chan entity.Activity, func())
+}
+
+// FeedUseCase is a TodoUseCase that records every change it makes as an
+// activity of the board changed and lets boards be watched.
+type FeedUseCase interface {
+	TodoUseCase
+
+	GetBoardActivities(ctx context.Context, boardID uuid.UUID, afterID int64, limit int) ([]entity.Activity, error)
+	WatchBoard(ctx context.Context, boardID uuid.UUID) (<-chan entity.Activity, func())
+}
+
+// TimeUseCase logs the time users spend on cards, either with a timer or
+// after the fact, and reports it.
+type TimeUseCase interface {
+	// StartTimer starts a running entry for the user on the card; a user
+	// can have only one at a time.
+	StartTimer(ctx context.Context, entry *entity.TimeEntry) error
+	StopTimer(ctx context.Context, userID uuid.UUID) (*entity.TimeEntry, error)
+	LogTime(ctx context.Context, entry *entity.TimeEntry) error
+
+	GetCardTimeEntries(ctx context.Context, cardID uuid.UUID) ([]entity.TimeEntry, error)
+	GetTimeReport(ctx context.Context, query repository.TimeReportQuery) ([]entity.TimeReportRow, error)
+}
+
+// AnalyticsUseCase measures how cards flow across boards.
+type AnalyticsUseCase interface {
+	// GetBoardAnalytics computes the lead and cycle times and the weekly
+	// throughput of the cards done in [from, to), and the cumulative flow of
+	// the board over the period.
+	GetBoardAnalytics(ctx context.Context, boardID uuid.UUID, from, to time.Time) (*entity.BoardAnalytics, error)
+}
+
+// BoardStatsUseCase sums up boards for their members.
+type BoardStatsUseCase interface {
+	// GetBoardStats counts the cards in each column of the board and the
+	// cards created and completed each day of [from, to), ranks the users
+	// who contributed most over it and lists the cards untouched for
+	// staleDays days. The user should be a member of the board.
+	GetBoardStats(ctx context.Context, userID, boardID uuid.UUID, from, to time.Time, staleDays int) (*entity.BoardStats, error)
+}
+
+// SprintUseCase plans work on boards in sprints and follows it with
+// burndown data.
+type SprintUseCase interface {
+	CreateSprint(ctx context.Context, sprint *entity.Sprint) error
+	GetSprintByID(ctx context.Context, id uuid.UUID) (*entity.Sprint, error)
+	GetSprintsByBoard(ctx context.Context, boardID uuid.UUID) ([]entity.Sprint, error)
+
+	AddSprintCard(ctx context.Context, card *entity.SprintCard) error
+	RemoveSprintCard(ctx context.Context, sprintID, cardID uuid.UUID) error
+	GetSprintCards(ctx context.Context, sprintID uuid.UUID) ([]entity.SprintCard, error)
+
+	// CloseSprint closes a sprint and carries its unfinished cards over to
+	// the next one: nextID if given, else the first open sprint of the board
+	// starting no earlier. It returns the next sprint, nil if there is none,
+	// and how many cards were carried over.
+	CloseSprint(ctx context.Context, id uuid.UUID, nextID *uuid.UUID) (*entity.Sprint, int, error)
+	// GetSprintBurndown has a point per day of the sprint up to today.
+	GetSprintBurndown(ctx context.Context, id uuid.UUID) ([]entity.BurndownPoint, error)
+}
+
+// CardTemplateUseCase keeps the card templates of boards and makes cards
+// from them.
+type CardTemplateUseCase interface {
+	CreateCardTemplate(ctx context.Context, template *entity.CardTemplate) error
+	GetCardTemplateByID(ctx context.Context, id uuid.UUID) (*entity.CardTemplate, error)
+	GetCardTemplatesByBoard(ctx context.Context, boardID uuid.UUID) ([]entity.CardTemplate, error)
+	// UpdateCardTemplate keeps the board and the creation of the template.
+	UpdateCardTemplate(ctx context.Context, template *entity.CardTemplate) error
+	DeleteCardTemplate(ctx context.Context, id uuid.UUID) error
+
+	// CreateCardFromTemplate creates the card with the template applied,
+	// {{user}} filled in with username. The card should be on the board of
+	// the template.
+	CreateCardFromTemplate(ctx context.Context, templateID uuid.UUID, card *entity.Card, username string) error
+}
+
+// CalendarUseCase serves the cards due of users as calendar feeds, reached
+// with a secret token instead of a login.
+type CalendarUseCase interface {
+	// RegenerateCalendarToken gives a user a new calendar token, revoking
+	// the one they had. The token is only ever returned here.
+	RegenerateCalendarToken(ctx context.Context, userID uuid.UUID) (string, *entity.CalendarToken, error)
+	GetCalendarCards(ctx context.Context, token string) ([]entity.CalendarCard, error)
+}
+
+// BoardMarkUseCase lets users star boards and keeps the boards they opened
+// lately, to bring both to the top of their board list.
+type BoardMarkUseCase interface {
+	StarBoard(ctx context.Context, userID, boardID uuid.UUID) error
+	UnstarBoard(ctx context.Context, userID, boardID uuid.UUID) error
+	RecordBoardView(ctx context.Context, userID, boardID uuid.UUID) error
+	GetRecentBoards(ctx context.Context, userID uuid.UUID) ([]entity.MarkedBoard, error)
+	// GetMarkedBoards lists the boards of the user starred first, then the
+	// recently viewed ones and then the rest.
+	GetMarkedBoards(ctx context.Context, userID uuid.UUID, page repository.Page) ([]entity.MarkedBoard, *repository.Cursor, error)
+}
+
+// NotificationUseCase lets users watch cards and boards and keeps the inbox
+// of notifications they get for them and for being mentioned.
+type NotificationUseCase interface {
+	// Watch subscribes the user to the card, or to the board without one.
+	Watch(ctx context.Context, watch *entity.Watch) error
+	Unwatch(ctx context.Context, watch *entity.Watch) error
+	// NotifyCard tells the users mentioned and the watchers of the card, but
+	// not the actor, of what the actor did on it. Users with no access to the
+	// board of the card are left out.
+	NotifyCard(ctx context.Context, actorID, cardID uuid.UUID, kind string, mentioned []uuid.UUID) ([]entity.Notification, error)
+	GetNotifications(ctx context.Context, userID uuid.UUID, unreadOnly bool, page repository.Page) ([]entity.Notification, *repository.Cursor, error)
+	// MarkNotificationsRead marks the notifications of the user with the
+	// ids, or all of them without any, read.
+	MarkNotificationsRead(ctx context.Context, userID uuid.UUID, ids []uuid.UUID) error
+}
+
+// WorkspaceUseCase manages workspaces and their members. The role of a
+// member decides what they can do with the workspace and its boards.
+type WorkspaceUseCase interface {
+	// CreateWorkspace makes the user who creates it its first admin.
+	CreateWorkspace(ctx context.Context, workspace *entity.Workspace) error
+	GetWorkspacesByUser(ctx context.Context, userID uuid.UUID) ([]entity.WorkspaceMembership, error)
+	// GetWorkspaceMembers is open to the members of the workspace.
+	GetWorkspaceMembers(ctx context.Context, actorID, workspaceID uuid.UUID) ([]entity.WorkspaceMember, error)
+
+	// AddWorkspaceMember, UpdateWorkspaceMember and RemoveWorkspaceMember
+	// are open to the admins of the workspace; members can also remove
+	// themselves. The last admin cannot be demoted or removed.
+	AddWorkspaceMember(ctx context.Context, actorID uuid.UUID, member *entity.WorkspaceMember) error
+	UpdateWorkspaceMember(ctx context.Context, actorID uuid.UUID, member *entity.WorkspaceMember) error
+	RemoveWorkspaceMember(ctx context.Context, actorID, workspaceID, userID uuid.UUID) error
+
+	// GetWorkspaceBoards lists every board of the workspace to its admins.
+	GetWorkspaceBoards(ctx context.Context, actorID, workspaceID uuid.UUID, page repository.Page) ([]entity.Board, *repository.Cursor, error)
+}
+
+// UserDataUseCase hands the boards of a user to another and deletes what a
+// user leaves behind, for the aggregator to run when users go.
+type UserDataUseCase interface {
+	TransferBoard(ctx context.Context, boardID, toUserID uuid.UUID) (*entity.Board, error)
+	// TransferUserBoards hands the boards with the ids, or all the boards of
+	// the user without any, to another user and returns them.
+	TransferUserBoards(ctx context.Context, fromUserID, toUserID uuid.UUID, boardIDs []uuid.UUID) ([]entity.Board, error)
+	// DeleteUserData deletes the boards, workspace memberships, board marks,
+	// calendar token, watches and notifications of the user. It has nothing
+	// to do for a user with no data, so it can be run again.
+	DeleteUserData(ctx context.Context, userID uuid.UUID) error
 }
@@ -0,0 +1,524 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+	"todo/internal/common/logger"
+	"todo/internal/entity"
+	"todo/internal/repository"
+	"todo/internal/usecase"
+
+	"github.com/google/uuid"
+)
+
+// accessUseCase wraps a FeedUseCase. Every board, column, swimlane and card
+// is reached on behalf of the caller on the context, who should be a member
+// of the workspace of its board, and one who can write to it for changes.
+// Things that do not exist are reported missing before access is denied.
+type accessUseCase struct {
+	usecase.FeedUseCase
+
+	boardRepo     repository.BoardRepository
+	columnRepo    repository.ColumnRepository
+	swimlaneRepo  repository.SwimlaneRepository
+	cardRepo      repository.CardRepository
+	workspaceRepo repository.WorkspaceRepository
+	log           logger.Logger
+}
+
+func NewAccessUseCase(
+	uc usecase.FeedUseCase,
+	boardRepo repository.BoardRepository,
+	columnRepo repository.ColumnRepository,
+	swimlaneRepo repository.SwimlaneRepository,
+	cardRepo repository.CardRepository,
+	workspaceRepo repository.WorkspaceRepository,
+	log logger.Logger,
+) usecase.FeedUseCase {
+	return &accessUseCase{
+		FeedUseCase:   uc,
+		boardRepo:     boardRepo,
+		columnRepo:    columnRepo,
+		swimlaneRepo:  swimlaneRepo,
+		cardRepo:      cardRepo,
+		workspaceRepo: workspaceRepo,
+		log:           log,
+	}
+}
+
+func (uc *accessUseCase) CreateBoard(ctx context.Context, board *entity.Board) error {
+	header := "CreateBoard: "
+
+	if err := uc.self(ctx, header, board.UserID); err != nil {
+		return err
+	}
+
+	return uc.FeedUseCase.CreateBoard(ctx, board)
+}
+
+func (uc *accessUseCase) CreateColumn(ctx context.Context, column *entity.Column) error {
+	header := "CreateColumn: "
+
+	if err := uc.board(ctx, header, column.BoardID, true); err != nil {
+		return err
+	}
+
+	return uc.FeedUseCase.CreateColumn(ctx, column)
+}
+
+func (uc *accessUseCase) CreateSwimlane(ctx context.Context, swimlane *entity.Swimlane) error {
+	header := "CreateSwimlane: "
+
+	if err := uc.board(ctx, header, swimlane.BoardID, true); err != nil {
+		return err
+	}
+
+	return uc.FeedUseCase.CreateSwimlane(ctx, swimlane)
+}
+
+// CreateCard checks the column of the card and its parent, if it has one.
+// The swimlane is checked by the wrapped usecase to be on the board of the
+// column.
+func (uc *accessUseCase) CreateCard(ctx context.Context, card *entity.Card) error {
+	header := "CreateCard: "
+
+	if err := uc.column(ctx, header, card.ColumnID, true); err != nil {
+		return err
+	}
+
+	if card.ParentID != uuid.Nil {
+		if _, err := uc.card(ctx, header, card.ParentID, true); err != nil {
+			return err
+		}
+	}
+
+	return uc.FeedUseCase.CreateCard(ctx, card)
+}
+
+func (uc *accessUseCase) GetBoardByID(ctx context.Context, id uuid.UUID) (*entity.Board, error) {
+	header := "GetBoardByID: "
+
+	if err := uc.board(ctx, header, id, false); err != nil {
+		return nil, err
+	}
+
+	return uc.FeedUseCase.GetBoardByID(ctx, id)
+}
+
+func (uc *accessUseCase) GetColumnByID(ctx context.Context, id uuid.UUID) (*entity.Column, error) {
+	header := "GetColumnByID: "
+
+	if err := uc.column(ctx, header, id, false); err != nil {
+		return nil, err
+	}
+
+	return uc.FeedUseCase.GetColumnByID(ctx, id)
+}
+
+func (uc *accessUseCase) GetSwimlaneByID(ctx context.Context, id uuid.UUID) (*entity.Swimlane, error) {
+	header := "GetSwimlaneByID: "
+
+	if err := uc.swimlane(ctx, header, id, false); err != nil {
+		return nil, err
+	}
+
+	return uc.FeedUseCase.GetSwimlaneByID(ctx, id)
+}
+
+func (uc *accessUseCase) GetCardByID(ctx context.Context, id uuid.UUID) (*entity.Card, error) {
+	header := "GetCardByID: "
+
+	if _, err := uc.card(ctx, header, id, false); err != nil {
+		return nil, err
+	}
+
+	return uc.FeedUseCase.GetCardByID(ctx, id)
+}
+
+// GetBoardsByUser lists only the boards of the caller.
+func (uc *accessUseCase) GetBoardsByUser(ctx context.Context, userID uuid.UUID, page repository.Page) ([]entity.Board, *repository.Cursor, error) {
+	header := "GetBoardsByUser: "
+
+	if err := uc.self(ctx, header, userID); err != nil {
+		return nil, nil, err
+	}
+
+	return uc.FeedUseCase.GetBoardsByUser(ctx, userID, page)
+}
+
+func (uc *accessUseCase) GetColumnsByBoard(ctx context.Context, boardID uuid.UUID, page repository.Page) ([]entity.Column, *repository.Cursor, error) {
+	header := "GetColumnsByBoard: "
+
+	if err := uc.board(ctx, header, boardID, false); err != nil {
+		return nil, nil, err
+	}
+
+	return uc.FeedUseCase.GetColumnsByBoard(ctx, boardID, page)
+}
+
+func (uc *accessUseCase) GetSwimlanesByBoard(ctx context.Context, boardID uuid.UUID, page repository.Page) ([]entity.Swimlane, *repository.Cursor, error) {
+	header := "GetSwimlanesByBoard: "
+
+	if err := uc.board(ctx, header, boardID, false); err != nil {
+		return nil, nil, err
+	}
+
+	return uc.FeedUseCase.GetSwimlanesByBoard(ctx, boardID, page)
+}
+
+func (uc *accessUseCase) GetCardsByColumn(ctx context.Context, columnID uuid.UUID, page repository.Page) ([]entity.Card, *repository.Cursor, error) {
+	header := "GetCardsByColumn: "
+
+	if err := uc.column(ctx, header, columnID, false); err != nil {
+		return nil, nil, err
+	}
+
+	return uc.FeedUseCase.GetCardsByColumn(ctx, columnID, page)
+}
+
+// GetCards checks every scope the query names; queries naming none are
+// left for the wrapped usecase to reject.
+func (uc *accessUseCase) GetCards(ctx context.Context, query repository.CardQuery) ([]entity.Card, *repository.Cursor, error) {
+	header := "GetCards: "
+
+	if query.BoardID != nil {
+		if err := uc.board(ctx, header, *query.BoardID, false); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	if query.ColumnID != nil {
+		if err := uc.column(ctx, header, *query.ColumnID, false); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	if query.SwimlaneID != nil {
+		if err := uc.swimlane(ctx, header, *query.SwimlaneID, false); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	if query.ParentID != nil {
+		if _, err := uc.card(ctx, header, *query.ParentID, false); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	return uc.FeedUseCase.GetCards(ctx, query)
+}
+
+func (uc *accessUseCase) GetCardAncestors(ctx context.Context, id uuid.UUID) ([]entity.Card, error) {
+	header := "GetCardAncestors: "
+
+	if _, err := uc.card(ctx, header, id, false); err != nil {
+		return nil, err
+	}
+
+	return uc.FeedUseCase.GetCardAncestors(ctx, id)
+}
+
+// GetNewCards spans every board for admins. Other callers get the cards of
+// the page on boards they can read, and the cursor of the whole page.
+func (uc *accessUseCase) GetNewCards(ctx context.Context, from, to time.Time, page repository.Page) ([]entity.Card, *repository.Cursor, error) {
+	header := "GetNewCards: "
+
+	caller, err := uc.caller(ctx, header)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	cards, next, err := uc.FeedUseCase.GetNewCards(ctx, from, to, page)
+	if err != nil || caller.Admin {
+		return cards, next, err
+	}
+
+	uc.log.Info(ctx, header+"Leaving out cards on boards the caller cannot read", "userID", caller.UserID)
+
+	readable := make(map[uuid.UUID]bool)
+	visible := make([]entity.Card, 0, len(cards))
+
+	for _, card := range cards {
+		ok, seen := readable[card.ColumnID]
+		if !seen {
+			err := uc.column(ctx, header, card.ColumnID, false)
+			if err != nil && !accessDenied(err) {
+				return nil, nil, err
+			}
+			ok = err == nil
+			readable[card.ColumnID] = ok
+		}
+
+		if ok {
+			visible = append(visible, card)
+		}
+	}
+
+	return visible, next, nil
+}
+
+func (uc *accessUseCase) UpdateBoard(ctx context.Context, board *entity.Board) error {
+	header := "UpdateBoard: "
+
+	if err := uc.board(ctx, header, board.ID, true); err != nil {
+		return err
+	}
+
+	return uc.FeedUseCase.UpdateBoard(ctx, board)
+}
+
+func (uc *accessUseCase) UpdateColumn(ctx context.Context, column *entity.Column) error {
+	header := "UpdateColumn: "
+
+	if err := uc.column(ctx, header, column.ID, true); err != nil {
+		return err
+	}
+
+	return uc.FeedUseCase.UpdateColumn(ctx, column)
+}
+
+func (uc *accessUseCase) UpdateSwimlane(ctx context.Context, swimlane *entity.Swimlane) error {
+	header := "UpdateSwimlane: "
+
+	if err := uc.swimlane(ctx, header, swimlane.ID, true); err != nil {
+		return err
+	}
+
+	return uc.FeedUseCase.UpdateSwimlane(ctx, swimlane)
+}
+
+// UpdateCard checks the card and, if it is moved, the column it goes to.
+func (uc *accessUseCase) UpdateCard(ctx context.Context, card *entity.Card) error {
+	header := "UpdateCard: "
+
+	stored, err := uc.card(ctx, header, card.ID, true)
+	if err != nil {
+		return err
+	}
+
+	if card.ColumnID != uuid.Nil && card.ColumnID != stored.ColumnID {
+		if err := uc.column(ctx, header, card.ColumnID, true); err != nil {
+			return err
+		}
+	}
+
+	return uc.FeedUseCase.UpdateCard(ctx, card)
+}
+
+func (uc *accessUseCase) SetCardParent(ctx context.Context, card *entity.Card) error {
+	header := "SetCardParent: "
+
+	if _, err := uc.card(ctx, header, card.ID, true); err != nil {
+		return err
+	}
+
+	if card.ParentID != uuid.Nil {
+		if _, err := uc.card(ctx, header, card.ParentID, true); err != nil {
+			return err
+		}
+	}
+
+	return uc.FeedUseCase.SetCardParent(ctx, card)
+}
+
+func (uc *accessUseCase) DeleteBoard(ctx context.Context, id uuid.UUID, version int) error {
+	header := "DeleteBoard: "
+
+	if err := uc.board(ctx, header, id, true); err != nil {
+		return err
+	}
+
+	return uc.FeedUseCase.DeleteBoard(ctx, id, version)
+}
+
+func (uc *accessUseCase) DeleteColumn(ctx context.Context, id uuid.UUID, version int) error {
+	header := "DeleteColumn: "
+
+	if err := uc.column(ctx, header, id, true); err != nil {
+		return err
+	}
+
+	return uc.FeedUseCase.DeleteColumn(ctx, id, version)
+}
+
+func (uc *accessUseCase) DeleteSwimlane(ctx context.Context, id uuid.UUID, version int) error {
+	header := "DeleteSwimlane: "
+
+	if err := uc.swimlane(ctx, header, id, true); err != nil {
+		return err
+	}
+
+	return uc.FeedUseCase.DeleteSwimlane(ctx, id, version)
+}
+
+func (uc *accessUseCase) DeleteCard(ctx context.Context, id uuid.UUID, version int) error {
+	header := "DeleteCard: "
+
+	if _, err := uc.card(ctx, header, id, true); err != nil {
+		return err
+	}
+
+	return uc.FeedUseCase.DeleteCard(ctx, id, version)
+}
+
+// ApplyCardOps checks every card of the batch and, for moves, the column
+// it goes to. Operations the caller may not make fail on their own, as
+// invalid ones do, and fail the whole batch when it is all or nothing.
+func (uc *accessUseCase) ApplyCardOps(ctx context.Context, ops []repository.CardOp, allOrNothing bool) ([]repository.CardOpResult, error) {
+	header := "ApplyCardOps: "
+
+	refused := make([]error, len(ops))
+	denied := false
+
+	for i, op := range ops {
+		err := uc.cardOp(ctx, header, op)
+
+		if err != nil && !accessDenied(err) {
+			return nil, err
+		}
+
+		refused[i] = err
+		denied = denied || err != nil
+	}
+
+	if denied {
+		uc.log.Info(ctx, header+"Access denied to some operations", "refused", refused)
+	}
+
+	return applyUnrefused(ops, refused, allOrNothing, func(ops []repository.CardOp) ([]repository.CardOpResult, error) {
+		return uc.FeedUseCase.ApplyCardOps(ctx, ops, allOrNothing)
+	})
+}
+
+// cardOp checks the card of the operation and, for a move, the column it
+// goes to. Operations without a card are left for the wrapped usecase to
+// reject.
+func (uc *accessUseCase) cardOp(ctx context.Context, header string, op repository.CardOp) error {
+	if op.CardID == uuid.Nil {
+		return nil
+	}
+
+	if _, err := uc.card(ctx, header, op.CardID, true); err != nil {
+		return err
+	}
+
+	if op.Kind == repository.CardOpMove && op.ColumnID != uuid.Nil {
+		return uc.column(ctx, header, op.ColumnID, true)
+	}
+
+	return nil
+}
+
+func (uc *accessUseCase) MoveColumn(ctx context.Context, userID, id, boardID uuid.UUID, version int) (*entity.Column, error) {
+	header := "MoveColumn: "
+
+	if err := uc.self(ctx, header, userID); err != nil {
+		return nil, err
+	}
+
+	return uc.FeedUseCase.MoveColumn(ctx, userID, id, boardID, version)
+}
+
+func (uc *accessUseCase) MergeBoards(ctx context.Context, userID, sourceID, targetID uuid.UUID, strategy string) (*entity.BoardMerge, error) {
+	header := "MergeBoards: "
+
+	if err := uc.self(ctx, header, userID); err != nil {
+		return nil, err
+	}
+
+	return uc.FeedUseCase.MergeBoards(ctx, userID, sourceID, targetID, strategy)
+}
+
+func (uc *accessUseCase) GetBoardActivities(ctx context.Context, boardID uuid.UUID, afterID int64, limit int) ([]entity.Activity, error) {
+	header := "GetBoardActivities: "
+
+	if err := uc.board(ctx, header, boardID, false); err != nil {
+		return nil, err
+	}
+
+	return uc.FeedUseCase.GetBoardActivities(ctx, boardID, afterID, limit)
+}
+
+func (uc *accessUseCase) caller(ctx context.Context, header string) (usecase.Caller, error) {
+	caller, ok := usecase.CallerFrom(ctx)
+
+	if !ok {
+		info := "Request names no caller"
+		uc.log.Info(ctx, header+info)
+		return usecase.Caller{}, fmt.Errorf(header+info+": %w", repository.ErrNoCaller)
+	}
+
+	return caller, nil
+}
+
+// self lets the caller act only as themselves.
+func (uc *accessUseCase) self(ctx context.Context, header string, userID uuid.UUID) error {
+	caller, err := uc.caller(ctx, header)
+	if err != nil {
+		return err
+	}
+
+	if caller.UserID != userID {
+		info := "Caller acts for another user"
+		uc.log.Info(ctx, header+info, "callerID", caller.UserID, "userID", userID)
+		return fmt.Errorf(header+info+": %w", repository.ErrBoardAccess)
+	}
+
+	return nil
+}
+
+func (uc *accessUseCase) board(ctx context.Context, header string, boardID uuid.UUID, write bool) error {
+	_, err := callerAccess(ctx, uc.boardRepo, uc.workspaceRepo, uc.log, header, boardID, write)
+	return err
+}
+
+func (uc *accessUseCase) column(ctx context.Context, header string, id uuid.UUID, write bool) error {
+	column, err := uc.columnRepo.GetColumnByID(ctx, id)
+
+	if err != nil {
+		info := "Column not found"
+		uc.log.Info(ctx, header+info, "columnID", id, "err", err.Error())
+		return fmt.Errorf(header+info+": %w", repository.ErrColumnNotFound)
+	}
+
+	return uc.board(ctx, header, column.BoardID, write)
+}
+
+func (uc *accessUseCase) swimlane(ctx context.Context, header string, id uuid.UUID, write bool) error {
+	swimlane, err := uc.swimlaneRepo.GetSwimlaneByID(ctx, id)
+
+	if err != nil {
+		info := "Swimlane not found"
+		uc.log.Info(ctx, header+info, "swimlaneID", id, "err", err.Error())
+		return fmt.Errorf(header+info+": %w", repository.ErrSwimlaneNotFound)
+	}
+
+	return uc.board(ctx, header, swimlane.BoardID, write)
+}
+
+// card checks the board of the column of the card and returns the card.
+func (uc *accessUseCase) card(ctx context.Context, header string, id uuid.UUID, write bool) (*entity.Card, error) {
+	card, err := uc.cardRepo.GetCardByID(ctx, id)
+
+	if err != nil {
+		info := "Card not found"
+		uc.log.Info(ctx, header+info, "cardID", id, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", repository.ErrCardNotFound)
+	}
+
+	if err := uc.column(ctx, header, card.ColumnID, write); err != nil {
+		return nil, err
+	}
+
+	return card, nil
+}
+
+// accessDenied tells whether err turns the caller away, rather than being
+// a failure to check.
+func accessDenied(err error) bool {
+	return errors.Is(err, repository.ErrBoardAccess) || errors.Is(err, repository.ErrBoardNotFound) ||
+		errors.Is(err, repository.ErrColumnNotFound) || errors.Is(err, repository.ErrSwimlaneNotFound) ||
+		errors.Is(err, repository.ErrCardNotFound)
+}
@@ -0,0 +1,444 @@
+package v1_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+	log "todo/internal/adapter/logger"
+	"todo/internal/entity"
+	"todo/internal/repository"
+	"todo/internal/testdata"
+	"todo/internal/usecase"
+	v1 "todo/internal/usecase/v1"
+	"todo/mocks"
+
+	"github.com/google/uuid"
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/runner"
+	"github.com/stretchr/testify/mock"
+)
+
+type accessMocks struct {
+	boardRepo     *mocks.BoardRepository
+	columnRepo    *mocks.ColumnRepository
+	swimlaneRepo  *mocks.SwimlaneRepository
+	cardRepo      *mocks.CardRepository
+	workspaceRepo *mocks.WorkspaceRepository
+	feed          *mocks.FeedUseCase
+}
+
+func newAccessMocks() accessMocks {
+	return accessMocks{
+		boardRepo:     new(mocks.BoardRepository),
+		columnRepo:    new(mocks.ColumnRepository),
+		swimlaneRepo:  new(mocks.SwimlaneRepository),
+		cardRepo:      new(mocks.CardRepository),
+		workspaceRepo: new(mocks.WorkspaceRepository),
+		feed:          new(mocks.FeedUseCase),
+	}
+}
+
+func (m accessMocks) useCase() usecase.FeedUseCase {
+	return v1.NewAccessUseCase(m.feed, m.boardRepo, m.columnRepo, m.swimlaneRepo, m.cardRepo, m.workspaceRepo, log.NewEmptyLogger())
+}
+
+func (m accessMocks) member(board entity.Board, userID uuid.UUID, role string) {
+	mockMember(m.boardRepo, m.workspaceRepo, board, userID, role)
+}
+
+// mockMember puts the board in a workspace the user has the role in, or
+// none without a role.
+func mockMember(boardRepo *mocks.BoardRepository, workspaceRepo *mocks.WorkspaceRepository, board entity.Board, userID uuid.UUID, role string) {
+	boardRepo.On("GetBoardByID", mock.Anything, board.ID).Return(&board, nil)
+
+	if role == "" {
+		workspaceRepo.On("GetWorkspaceMember", mock.Anything, board.WorkspaceID, userID).Return(nil, repository.ErrWorkspaceMemberNotFound)
+		return
+	}
+
+	workspaceRepo.On("GetWorkspaceMember", mock.Anything, board.WorkspaceID, userID).
+		Return(&entity.WorkspaceMember{WorkspaceID: board.WorkspaceID, UserID: userID, Role: role}, nil)
+}
+
+func (m accessMocks) assert(t *testing.T) {
+	m.boardRepo.AssertExpectations(t)
+	m.columnRepo.AssertExpectations(t)
+	m.swimlaneRepo.AssertExpectations(t)
+	m.cardRepo.AssertExpectations(t)
+	m.workspaceRepo.AssertExpectations(t)
+	m.feed.AssertExpectations(t)
+}
+
+func TestAccessGetBoardByID(t *testing.T) {
+	runner.Run(t, "TestAccessGetBoardByID", func(pt provider.T) {
+		mom := &testdata.ObjectMother{}
+
+		userID := mom.GetUUID(0)
+		board := entity.Board{ID: mom.GetUUID(1), WorkspaceID: mom.GetUUID(2)}
+		caller := usecase.WithCaller(context.Background(), usecase.Caller{UserID: userID})
+
+		tests := []struct {
+			name      string
+			ctx       context.Context
+			mockSetup func(m accessMocks)
+			wantErr   bool
+			err       error
+		}{
+			{
+				name: "positive viewer",
+				ctx:  caller,
+				mockSetup: func(m accessMocks) {
+					m.member(board, userID, entity.RoleViewer)
+					m.feed.On("GetBoardByID", caller, board.ID).Return(&board, nil)
+				},
+				wantErr: false,
+			},
+			{
+				name:      "no caller",
+				ctx:       context.Background(),
+				mockSetup: func(m accessMocks) {},
+				wantErr:   true,
+				err:       repository.ErrNoCaller,
+			},
+			{
+				name: "not a member",
+				ctx:  caller,
+				mockSetup: func(m accessMocks) {
+					m.member(board, userID, "")
+				},
+				wantErr: true,
+				err:     repository.ErrBoardAccess,
+			},
+			{
+				name: "board not found",
+				ctx:  caller,
+				mockSetup: func(m accessMocks) {
+					m.boardRepo.On("GetBoardByID", mock.Anything, board.ID).Return(nil, errors.New(""))
+				},
+				wantErr: true,
+				err:     repository.ErrBoardNotFound,
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				t.Parallel()
+
+				runner.Run(t, tt.name, func(pt provider.T) {
+					m := newAccessMocks()
+					tt.mockSetup(m)
+
+					pt.WithNewStep("Call GetBoardByID", func(sCtx provider.StepCtx) {
+						_, err := m.useCase().GetBoardByID(tt.ctx, board.ID)
+
+						if tt.wantErr {
+							sCtx.Assert().Error(err, "Expected error")
+							sCtx.Assert().ErrorIs(err, tt.err)
+						} else {
+							sCtx.Assert().NoError(err, "Expected no error")
+						}
+
+						m.assert(t)
+					})
+				})
+			})
+		}
+	})
+}
+
+func TestAccessUpdateCard(t *testing.T) {
+	runner.Run(t, "TestAccessUpdateCard", func(pt provider.T) {
+		mom := &testdata.ObjectMother{}
+
+		userID := mom.GetUUID(0)
+		board := entity.Board{ID: mom.GetUUID(1), WorkspaceID: mom.GetUUID(2)}
+		other := entity.Board{ID: mom.GetUUID(3), WorkspaceID: mom.GetUUID(4)}
+		column := entity.Column{ID: mom.GetUUID(5), BoardID: board.ID}
+		otherColumn := entity.Column{ID: mom.GetUUID(6), BoardID: other.ID}
+		stored := entity.Card{ID: mom.GetUUID(7), ColumnID: column.ID}
+		ctx := usecase.WithCaller(context.Background(), usecase.Caller{UserID: userID})
+
+		tests := []struct {
+			name      string
+			card      entity.Card
+			mockSetup func(m accessMocks)
+			wantErr   bool
+			err       error
+		}{
+			{
+				name: "positive edit",
+				card: entity.Card{ID: stored.ID, Title: "Card"},
+				mockSetup: func(m accessMocks) {
+					m.cardRepo.On("GetCardByID", mock.Anything, stored.ID).Return(&stored, nil)
+					m.columnRepo.On("GetColumnByID", mock.Anything, column.ID).Return(&column, nil)
+					m.member(board, userID, entity.RoleMember)
+					m.feed.On("UpdateCard", ctx, mock.Anything).Return(nil)
+				},
+				wantErr: false,
+			},
+			{
+				name: "viewer",
+				card: entity.Card{ID: stored.ID, Title: "Card"},
+				mockSetup: func(m accessMocks) {
+					m.cardRepo.On("GetCardByID", mock.Anything, stored.ID).Return(&stored, nil)
+					m.columnRepo.On("GetColumnByID", mock.Anything, column.ID).Return(&column, nil)
+					m.member(board, userID, entity.RoleViewer)
+				},
+				wantErr: true,
+				err:     repository.ErrBoardAccess,
+			},
+			{
+				name: "move to a board of no access",
+				card: entity.Card{ID: stored.ID, ColumnID: otherColumn.ID, Title: "Card"},
+				mockSetup: func(m accessMocks) {
+					m.cardRepo.On("GetCardByID", mock.Anything, stored.ID).Return(&stored, nil)
+					m.columnRepo.On("GetColumnByID", mock.Anything, column.ID).Return(&column, nil)
+					m.columnRepo.On("GetColumnByID", mock.Anything, otherColumn.ID).Return(&otherColumn, nil)
+					m.member(board, userID, entity.RoleMember)
+					m.member(other, userID, "")
+				},
+				wantErr: true,
+				err:     repository.ErrBoardAccess,
+			},
+			{
+				name: "card not found",
+				card: entity.Card{ID: stored.ID, Title: "Card"},
+				mockSetup: func(m accessMocks) {
+					m.cardRepo.On("GetCardByID", mock.Anything, stored.ID).Return(nil, repository.ErrCardNotFound)
+				},
+				wantErr: true,
+				err:     repository.ErrCardNotFound,
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				t.Parallel()
+
+				runner.Run(t, tt.name, func(pt provider.T) {
+					m := newAccessMocks()
+					tt.mockSetup(m)
+
+					pt.WithNewStep("Call UpdateCard", func(sCtx provider.StepCtx) {
+						card := tt.card
+						err := m.useCase().UpdateCard(ctx, &card)
+
+						if tt.wantErr {
+							sCtx.Assert().Error(err, "Expected error")
+							sCtx.Assert().ErrorIs(err, tt.err)
+						} else {
+							sCtx.Assert().NoError(err, "Expected no error")
+						}
+
+						m.assert(t)
+					})
+				})
+			})
+		}
+	})
+}
+
+func TestAccessGetBoardsByUser(t *testing.T) {
+	runner.Run(t, "TestAccessGetBoardsByUser", func(pt provider.T) {
+		mom := &testdata.ObjectMother{}
+
+		userID := mom.GetUUID(0)
+		page := repository.Page{Limit: 10}
+		ctx := usecase.WithCaller(context.Background(), usecase.Caller{UserID: userID})
+
+		tests := []struct {
+			name      string
+			userID    uuid.UUID
+			mockSetup func(m accessMocks)
+			wantErr   bool
+			err       error
+		}{
+			{
+				name:   "positive",
+				userID: userID,
+				mockSetup: func(m accessMocks) {
+					m.feed.On("GetBoardsByUser", ctx, userID, page).Return([]entity.Board{}, nil, nil)
+				},
+				wantErr: false,
+			},
+			{
+				name:      "another user",
+				userID:    mom.GetUUID(1),
+				mockSetup: func(m accessMocks) {},
+				wantErr:   true,
+				err:       repository.ErrBoardAccess,
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				t.Parallel()
+
+				runner.Run(t, tt.name, func(pt provider.T) {
+					m := newAccessMocks()
+					tt.mockSetup(m)
+
+					pt.WithNewStep("Call GetBoardsByUser", func(sCtx provider.StepCtx) {
+						_, _, err := m.useCase().GetBoardsByUser(ctx, tt.userID, page)
+
+						if tt.wantErr {
+							sCtx.Assert().Error(err, "Expected error")
+							sCtx.Assert().ErrorIs(err, tt.err)
+						} else {
+							sCtx.Assert().NoError(err, "Expected no error")
+						}
+
+						m.assert(t)
+					})
+				})
+			})
+		}
+	})
+}
+
+func TestAccessApplyCardOps(t *testing.T) {
+	runner.Run(t, "TestAccessApplyCardOps", func(pt provider.T) {
+		mom := &testdata.ObjectMother{}
+
+		userID := mom.GetUUID(0)
+		board := entity.Board{ID: mom.GetUUID(1), WorkspaceID: mom.GetUUID(2)}
+		other := entity.Board{ID: mom.GetUUID(3), WorkspaceID: mom.GetUUID(4)}
+		column := entity.Column{ID: mom.GetUUID(5), BoardID: board.ID}
+		otherColumn := entity.Column{ID: mom.GetUUID(6), BoardID: other.ID}
+		card := entity.Card{ID: mom.GetUUID(7), ColumnID: column.ID}
+		ctx := usecase.WithCaller(context.Background(), usecase.Caller{UserID: userID})
+
+		allowed := repository.CardOp{Kind: repository.CardOpArchive, CardID: card.ID, Version: 1}
+		denied := repository.CardOp{Kind: repository.CardOpMove, CardID: card.ID, ColumnID: otherColumn.ID, Version: 1}
+
+		setup := func(m accessMocks) {
+			m.cardRepo.On("GetCardByID", mock.Anything, card.ID).Return(&card, nil)
+			m.columnRepo.On("GetColumnByID", mock.Anything, column.ID).Return(&column, nil)
+			m.columnRepo.On("GetColumnByID", mock.Anything, otherColumn.ID).Return(&otherColumn, nil)
+			m.member(board, userID, entity.RoleMember)
+			m.member(other, userID, entity.RoleViewer)
+		}
+
+		tests := []struct {
+			name         string
+			allOrNothing bool
+			mockSetup    func(m accessMocks)
+			statuses     []repository.CardOpStatus
+		}{
+			{
+				name: "denied op fails alone",
+				mockSetup: func(m accessMocks) {
+					setup(m)
+					m.feed.On("ApplyCardOps", ctx, []repository.CardOp{allowed}, false).
+						Return([]repository.CardOpResult{{Status: repository.CardOpApplied}}, nil)
+				},
+				statuses: []repository.CardOpStatus{repository.CardOpApplied, repository.CardOpFailed},
+			},
+			{
+				name:         "denied op fails all or nothing",
+				allOrNothing: true,
+				mockSetup:    setup,
+				statuses:     []repository.CardOpStatus{repository.CardOpRolledBack, repository.CardOpFailed},
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				t.Parallel()
+
+				runner.Run(t, tt.name, func(pt provider.T) {
+					m := newAccessMocks()
+					tt.mockSetup(m)
+
+					pt.WithNewStep("Call ApplyCardOps", func(sCtx provider.StepCtx) {
+						results, err := m.useCase().ApplyCardOps(ctx, []repository.CardOp{allowed, denied}, tt.allOrNothing)
+
+						sCtx.Assert().NoError(err, "Expected no error")
+						sCtx.Require().Len(results, len(tt.statuses))
+						for i, status := range tt.statuses {
+							sCtx.Assert().Equal(status, results[i].Status)
+						}
+						sCtx.Assert().ErrorIs(results[1].Err, repository.ErrBoardAccess)
+
+						m.assert(t)
+					})
+				})
+			})
+		}
+	})
+}
+
+func TestAccessGetNewCards(t *testing.T) {
+	runner.Run(t, "TestAccessGetNewCards", func(pt provider.T) {
+		mom := &testdata.ObjectMother{}
+
+		userID := mom.GetUUID(0)
+		board := entity.Board{ID: mom.GetUUID(1), WorkspaceID: mom.GetUUID(2)}
+		other := entity.Board{ID: mom.GetUUID(3), WorkspaceID: mom.GetUUID(4)}
+		column := entity.Column{ID: mom.GetUUID(5), BoardID: board.ID}
+		otherColumn := entity.Column{ID: mom.GetUUID(6), BoardID: other.ID}
+		cards := []entity.Card{
+			{ID: mom.GetUUID(7), ColumnID: column.ID},
+			{ID: mom.GetUUID(8), ColumnID: otherColumn.ID},
+			{ID: mom.GetUUID(9), ColumnID: column.ID},
+		}
+		from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+		to := from.AddDate(0, 1, 0)
+		page := repository.Page{Limit: 3}
+
+		tests := []struct {
+			name      string
+			caller    usecase.Caller
+			mockSetup func(m accessMocks)
+			ids       []uuid.UUID
+		}{
+			{
+				name:   "member",
+				caller: usecase.Caller{UserID: userID},
+				mockSetup: func(m accessMocks) {
+					m.feed.On("GetNewCards", mock.Anything, from, to, page).Return(cards, nil, nil)
+					m.columnRepo.On("GetColumnByID", mock.Anything, column.ID).Return(&column, nil).Once()
+					m.columnRepo.On("GetColumnByID", mock.Anything, otherColumn.ID).Return(&otherColumn, nil).Once()
+					m.member(board, userID, entity.RoleViewer)
+					m.member(other, userID, "")
+				},
+				ids: []uuid.UUID{cards[0].ID, cards[2].ID},
+			},
+			{
+				name:   "admin",
+				caller: usecase.Caller{UserID: userID, Admin: true},
+				mockSetup: func(m accessMocks) {
+					m.feed.On("GetNewCards", mock.Anything, from, to, page).Return(cards, nil, nil)
+				},
+				ids: []uuid.UUID{cards[0].ID, cards[1].ID, cards[2].ID},
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				t.Parallel()
+
+				runner.Run(t, tt.name, func(pt provider.T) {
+					m := newAccessMocks()
+					tt.mockSetup(m)
+
+					pt.WithNewStep("Call GetNewCards", func(sCtx provider.StepCtx) {
+						ctx := usecase.WithCaller(context.Background(), tt.caller)
+						got, _, err := m.useCase().GetNewCards(ctx, from, to, page)
+
+						sCtx.Assert().NoError(err, "Expected no error")
+
+						ids := make([]uuid.UUID, len(got))
+						for i, card := range got {
+							ids[i] = card.ID
+						}
+						sCtx.Assert().Equal(tt.ids, ids)
+
+						m.assert(t)
+					})
+				})
+			})
+		}
+	})
+}
@@ -0,0 +1,206 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+	"todo/internal/common/logger"
+	"todo/internal/entity"
+	"todo/internal/repository"
+	"todo/internal/usecase"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrGetBoardAnalytics = errors.New("failed to get board analytics")
+)
+
+const day = 24 * time.Hour
+
+type analyticsUseCase struct {
+	flowRepo      repository.CardFlowRepository
+	boardRepo     repository.BoardRepository
+	workspaceRepo repository.WorkspaceRepository
+	log           logger.Logger
+}
+
+func NewAnalyticsUseCase(
+	flowRepo repository.CardFlowRepository,
+	boardRepo repository.BoardRepository,
+	workspaceRepo repository.WorkspaceRepository,
+	log logger.Logger,
+) usecase.AnalyticsUseCase {
+	return &analyticsUseCase{
+		flowRepo:      flowRepo,
+		boardRepo:     boardRepo,
+		workspaceRepo: workspaceRepo,
+		log:           log,
+	}
+}
+
+func (uc *analyticsUseCase) GetBoardAnalytics(ctx context.Context, boardID uuid.UUID, from, to time.Time) (*entity.BoardAnalytics, error) {
+	header := "GetBoardAnalytics: "
+
+	uc.log.Info(ctx, header+"Usecase called; Validating period", "boardID", boardID, "from", from, "to", to)
+
+	err := validateAnalyticsPeriod(from, to)
+
+	if err != nil {
+		info := "Validation failed"
+		uc.log.Info(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	if _, err := callerAccess(ctx, uc.boardRepo, uc.workspaceRepo, uc.log, header, boardID, false); err != nil {
+		return nil, err
+	}
+
+	uc.log.Info(ctx, header+"Making request to card flow repo (GetCardFlows)")
+
+	flows, err := uc.flowRepo.GetCardFlows(ctx, boardID, from, to)
+
+	if err != nil {
+		info := "Failed to get card flows"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", ErrGetBoardAnalytics)
+	}
+
+	uc.log.Info(ctx, header+"Making request to card flow repo (GetColumnCounts)")
+
+	counts, err := uc.flowRepo.GetColumnCounts(ctx, boardID, from, to)
+
+	if err != nil {
+		info := "Failed to get column counts"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", ErrGetBoardAnalytics)
+	}
+
+	var lead, cycle []time.Duration
+	for _, f := range flows {
+		lead = append(lead, f.DoneAt.Sub(f.CreatedAt))
+		if f.StartedAt != nil {
+			cycle = append(cycle, f.DoneAt.Sub(*f.StartedAt))
+		}
+	}
+
+	days := analyticsDays(from, to)
+
+	analytics := &entity.BoardAnalytics{
+		BoardID:    boardID,
+		From:       from,
+		To:         to,
+		LeadTime:   flowTimes(lead),
+		CycleTime:  flowTimes(cycle),
+		Throughput: weekThroughput(flows, from, to),
+		Days:       days,
+		Flow:       flowSeries(counts, from, len(days)),
+	}
+
+	uc.log.Info(ctx, header+"Successfully got board analytics", "cards", len(flows))
+
+	return analytics, nil
+}
+
+func validateAnalyticsPeriod(from, to time.Time) error {
+	if !to.After(from) {
+		return repository.ErrAnalyticsBounds
+	}
+
+	if to.Sub(from) > repository.MaxAnalyticsDays*day {
+		return repository.ErrAnalyticsRange
+	}
+
+	return nil
+}
+
+// flowTimes sums up durations, with nearest-rank percentiles.
+func flowTimes(durations []time.Duration) entity.FlowTimes {
+	if len(durations) == 0 {
+		return entity.FlowTimes{}
+	}
+
+	sorted := append([]time.Duration(nil), durations...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+
+	var total time.Duration
+	for _, d := range sorted {
+		total += d
+	}
+
+	percentile := func(p int) time.Duration {
+		rank := (p*len(sorted) + 99) / 100
+		return sorted[rank-1]
+	}
+
+	return entity.FlowTimes{
+		Cards:   len(sorted),
+		Average: total / time.Duration(len(sorted)),
+		P50:     percentile(50),
+		P85:     percentile(85),
+		P95:     percentile(95),
+	}
+}
+
+func analyticsDays(from, to time.Time) []time.Time {
+	var days []time.Time
+	for d := from; d.Before(to); d = d.Add(day) {
+		days = append(days, d)
+	}
+	return days
+}
+
+// weekStart is midnight on the Monday of the week of t, in the location of
+// t. Days are cut on the wall date, not in UTC as Truncate does.
+func weekStart(t time.Time) time.Time {
+	d := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+	return d.AddDate(0, 0, -(int(d.Weekday())+6)%7)
+}
+
+// weekThroughput counts the cards done each week of the period, weeks with
+// none included.
+func weekThroughput(flows []entity.CardFlow, from, to time.Time) []entity.WeekThroughput {
+	var weeks []entity.WeekThroughput
+	index := make(map[time.Time]int)
+
+	for w := weekStart(from); w.Before(to); w = w.AddDate(0, 0, 7) {
+		index[w] = len(weeks)
+		weeks = append(weeks, entity.WeekThroughput{WeekStart: w})
+	}
+
+	for _, f := range flows {
+		if i, ok := index[weekStart(f.DoneAt.In(from.Location()))]; ok {
+			weeks[i].Cards++
+		}
+	}
+
+	return weeks
+}
+
+// flowSeries turns the daily column counts into a series per column, in
+// the order the columns first appear.
+func flowSeries(counts []entity.ColumnCount, from time.Time, days int) []entity.FlowSeries {
+	var series []entity.FlowSeries
+	index := make(map[uuid.UUID]int)
+
+	for _, c := range counts {
+		i, ok := index[c.ColumnID]
+		if !ok {
+			i = len(series)
+			index[c.ColumnID] = i
+			series = append(series, entity.FlowSeries{
+				ColumnID:    c.ColumnID,
+				ColumnTitle: c.ColumnTitle,
+				Counts:      make([]int, days),
+			})
+		}
+
+		if d := int(c.Day.Sub(from) / day); d >= 0 && d < days {
+			series[i].Counts[d] = c.Count
+		}
+	}
+
+	return series
+}
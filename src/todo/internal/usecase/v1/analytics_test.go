@@ -0,0 +1,222 @@
+package v1_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+	log "todo/internal/adapter/logger"
+	"todo/internal/entity"
+	"todo/internal/repository"
+	"todo/internal/testdata"
+	"todo/internal/usecase"
+	v1 "todo/internal/usecase/v1"
+	"todo/mocks"
+
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/runner"
+	"github.com/stretchr/testify/mock"
+)
+
+type analyticsMocks struct {
+	flowRepo      *mocks.CardFlowRepository
+	boardRepo     *mocks.BoardRepository
+	workspaceRepo *mocks.WorkspaceRepository
+}
+
+func newAnalyticsMocks() analyticsMocks {
+	return analyticsMocks{
+		flowRepo:      new(mocks.CardFlowRepository),
+		boardRepo:     new(mocks.BoardRepository),
+		workspaceRepo: new(mocks.WorkspaceRepository),
+	}
+}
+
+func (m analyticsMocks) useCase() usecase.AnalyticsUseCase {
+	return v1.NewAnalyticsUseCase(m.flowRepo, m.boardRepo, m.workspaceRepo, log.NewEmptyLogger())
+}
+
+func (m analyticsMocks) assert(t *testing.T) {
+	m.flowRepo.AssertExpectations(t)
+	m.boardRepo.AssertExpectations(t)
+	m.workspaceRepo.AssertExpectations(t)
+}
+
+func TestGetBoardAnalytics(t *testing.T) {
+	runner.Run(t, "TestGetBoardAnalytics", func(pt provider.T) {
+		mom := &testdata.ObjectMother{}
+
+		board := entity.Board{ID: mom.GetUUID(0), WorkspaceID: mom.GetUUID(4)}
+		boardID := board.ID
+		columnID := mom.GetUUID(1)
+		userID := mom.GetUUID(5)
+		ctx := usecase.WithCaller(context.Background(), usecase.Caller{UserID: userID})
+
+		// Monday 1 January 2024, two weeks.
+		from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+		to := from.AddDate(0, 0, 14)
+		day := 24 * time.Hour
+		started := from.Add(day)
+
+		flows := []entity.CardFlow{
+			{CardID: mom.GetUUID(2), CreatedAt: from, StartedAt: &started, DoneAt: from.Add(2 * day)},
+			{CardID: mom.GetUUID(3), CreatedAt: from, DoneAt: from.Add(8 * day)},
+		}
+		counts := []entity.ColumnCount{
+			{Day: from, ColumnID: columnID, ColumnTitle: "Done", Count: 1},
+			{Day: from.Add(13 * day), ColumnID: columnID, ColumnTitle: "Done", Count: 2},
+		}
+
+		tests := []struct {
+			name      string
+			from      time.Time
+			to        time.Time
+			mockSetup func(m analyticsMocks)
+			wantErr   bool
+			err       error
+		}{
+			{
+				name: "positive",
+				from: from,
+				to:   to,
+				mockSetup: func(m analyticsMocks) {
+					mockMember(m.boardRepo, m.workspaceRepo, board, userID, entity.RoleViewer)
+					m.flowRepo.On("GetCardFlows", mock.Anything, boardID, from, to).Return(flows, nil)
+					m.flowRepo.On("GetColumnCounts", mock.Anything, boardID, from, to).Return(counts, nil)
+				},
+				wantErr: false,
+			},
+			{
+				name:      "empty period",
+				from:      from,
+				to:        from,
+				mockSetup: func(m analyticsMocks) {},
+				wantErr:   true,
+				err:       repository.ErrAnalyticsBounds,
+			},
+			{
+				name:      "period too long",
+				from:      from,
+				to:        from.AddDate(2, 0, 0),
+				mockSetup: func(m analyticsMocks) {},
+				wantErr:   true,
+				err:       repository.ErrAnalyticsRange,
+			},
+			{
+				name: "no board",
+				from: from,
+				to:   to,
+				mockSetup: func(m analyticsMocks) {
+					m.boardRepo.On("GetBoardByID", mock.Anything, boardID).Return(nil, repository.ErrBoardNotFound)
+				},
+				wantErr: true,
+				err:     repository.ErrBoardNotFound,
+			},
+			{
+				name: "negative",
+				from: from,
+				to:   to,
+				mockSetup: func(m analyticsMocks) {
+					mockMember(m.boardRepo, m.workspaceRepo, board, userID, entity.RoleViewer)
+					m.flowRepo.On("GetCardFlows", mock.Anything, boardID, from, to).Return(nil, errors.New(""))
+				},
+				wantErr: true,
+				err:     v1.ErrGetBoardAnalytics,
+			},
+			{
+				name: "not a member",
+				from: from,
+				to:   to,
+				mockSetup: func(m analyticsMocks) {
+					mockMember(m.boardRepo, m.workspaceRepo, board, userID, "")
+				},
+				wantErr: true,
+				err:     repository.ErrBoardAccess,
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				t.Parallel()
+
+				runner.Run(t, tt.name, func(pt provider.T) {
+					m := newAnalyticsMocks()
+
+					uc := m.useCase()
+
+					tt.mockSetup(m)
+
+					pt.WithNewStep("Call GetBoardAnalytics", func(sCtx provider.StepCtx) {
+						analytics, err := uc.GetBoardAnalytics(ctx, boardID, tt.from, tt.to)
+
+						if tt.wantErr {
+							sCtx.Assert().Error(err, "Expected error")
+							sCtx.Assert().ErrorIs(err, tt.err)
+						} else {
+							sCtx.Assert().NoError(err, "Expected no error")
+
+							sCtx.Assert().Equal(entity.FlowTimes{
+								Cards: 2, Average: 5 * day, P50: 2 * day, P85: 8 * day, P95: 8 * day,
+							}, analytics.LeadTime)
+							sCtx.Assert().Equal(entity.FlowTimes{
+								Cards: 1, Average: day, P50: day, P85: day, P95: day,
+							}, analytics.CycleTime)
+
+							sCtx.Assert().Equal([]entity.WeekThroughput{
+								{WeekStart: from, Cards: 1},
+								{WeekStart: from.AddDate(0, 0, 7), Cards: 1},
+							}, analytics.Throughput)
+
+							sCtx.Assert().Len(analytics.Days, 14)
+							sCtx.Assert().Len(analytics.Flow, 1)
+							sCtx.Assert().Equal(columnID, analytics.Flow[0].ColumnID)
+							sCtx.Assert().Equal(1, analytics.Flow[0].Counts[0])
+							sCtx.Assert().Equal(0, analytics.Flow[0].Counts[1])
+							sCtx.Assert().Equal(2, analytics.Flow[0].Counts[13])
+						}
+
+						m.assert(t)
+					})
+				})
+			})
+		}
+	})
+}
+
+func TestGetBoardAnalyticsOffsetWeeks(t *testing.T) {
+	runner.Run(t, "TestGetBoardAnalyticsOffsetWeeks", func(pt provider.T) {
+		mom := &testdata.ObjectMother{}
+
+		board := entity.Board{ID: mom.GetUUID(0), WorkspaceID: mom.GetUUID(4)}
+		userID := mom.GetUUID(5)
+		ctx := usecase.WithCaller(context.Background(), usecase.Caller{UserID: userID})
+
+		// Monday 1 January 2024 at midnight three hours east of UTC, which is
+		// still Sunday in UTC.
+		east := time.FixedZone("UTC+3", 3*60*60)
+		from := time.Date(2024, 1, 1, 0, 0, 0, 0, east)
+		to := from.AddDate(0, 0, 14)
+
+		// Monday 8 January at 01:00 there, but Sunday 7 January in UTC.
+		flows := []entity.CardFlow{
+			{CardID: mom.GetUUID(2), CreatedAt: from.UTC(), DoneAt: time.Date(2024, 1, 7, 22, 0, 0, 0, time.UTC)},
+		}
+
+		m := newAnalyticsMocks()
+		mockMember(m.boardRepo, m.workspaceRepo, board, userID, entity.RoleMember)
+		m.flowRepo.On("GetCardFlows", mock.Anything, board.ID, from, to).Return(flows, nil)
+		m.flowRepo.On("GetColumnCounts", mock.Anything, board.ID, from, to).Return(nil, nil)
+
+		pt.WithNewStep("Call GetBoardAnalytics", func(sCtx provider.StepCtx) {
+			analytics, err := m.useCase().GetBoardAnalytics(ctx, board.ID, from, to)
+
+			sCtx.Require().NoError(err, "Expected no error")
+			sCtx.Assert().Equal([]entity.WeekThroughput{
+				{WeekStart: from, Cards: 0},
+				{WeekStart: from.AddDate(0, 0, 7), Cards: 1},
+			}, analytics.Throughput)
+
+			m.assert(t)
+		})
+	})
+}
@@ -0,0 +1,164 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+	"todo/internal/common/logger"
+	"todo/internal/entity"
+	"todo/internal/repository"
+	"todo/internal/usecase"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrStarBoard       = errors.New("failed to star board")
+	ErrUnstarBoard     = errors.New("failed to unstar board")
+	ErrRecordBoardView = errors.New("failed to record board view")
+	ErrGetRecentBoards = errors.New("failed to get recent boards")
+	ErrGetMarkedBoards = errors.New("failed to get boards")
+)
+
+type boardMarkUseCase struct {
+	markRepo      repository.BoardMarkRepository
+	boardRepo     repository.BoardRepository
+	workspaceRepo repository.WorkspaceRepository
+	log           logger.Logger
+}
+
+func NewBoardMarkUseCase(
+	markRepo repository.BoardMarkRepository,
+	boardRepo repository.BoardRepository,
+	workspaceRepo repository.WorkspaceRepository,
+	log logger.Logger,
+) usecase.BoardMarkUseCase {
+	return &boardMarkUseCase{
+		markRepo:      markRepo,
+		boardRepo:     boardRepo,
+		workspaceRepo: workspaceRepo,
+		log:           log,
+	}
+}
+
+func (uc *boardMarkUseCase) StarBoard(ctx context.Context, userID, boardID uuid.UUID) error {
+	header := "StarBoard: "
+
+	uc.log.Info(ctx, header+"Usecase called; Checking board", "userID", userID, "boardID", boardID)
+
+	if _, err := boardAccess(ctx, uc.boardRepo, uc.workspaceRepo, uc.log, header, userID, boardID, false); err != nil {
+		return err
+	}
+
+	star := &entity.BoardStar{UserID: userID, BoardID: boardID, CreatedAt: time.Now()}
+
+	uc.log.Info(ctx, header+"Making request to board mark repo (StarBoard)", "star", star)
+
+	err := uc.markRepo.StarBoard(ctx, star)
+
+	if err != nil {
+		info := "Failed to star board"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return fmt.Errorf(header+info+": %w", ErrStarBoard)
+	}
+
+	uc.log.Info(ctx, header+"Board successfully starred")
+
+	return nil
+}
+
+func (uc *boardMarkUseCase) UnstarBoard(ctx context.Context, userID, boardID uuid.UUID) error {
+	header := "UnstarBoard: "
+
+	uc.log.Info(ctx, header+"Usecase called; Making request to board mark repo (UnstarBoard)", "userID", userID, "boardID", boardID)
+
+	err := uc.markRepo.UnstarBoard(ctx, userID, boardID)
+
+	if err != nil {
+		info := "Failed to unstar board"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return fmt.Errorf(header+info+": %w", ErrUnstarBoard)
+	}
+
+	uc.log.Info(ctx, header+"Board successfully unstarred")
+
+	return nil
+}
+
+func (uc *boardMarkUseCase) RecordBoardView(ctx context.Context, userID, boardID uuid.UUID) error {
+	header := "RecordBoardView: "
+
+	uc.log.Info(ctx, header+"Usecase called; Checking board", "userID", userID, "boardID", boardID)
+
+	if _, err := boardAccess(ctx, uc.boardRepo, uc.workspaceRepo, uc.log, header, userID, boardID, false); err != nil {
+		return err
+	}
+
+	view := &entity.BoardView{UserID: userID, BoardID: boardID, ViewedAt: time.Now()}
+
+	uc.log.Info(ctx, header+"Making request to board mark repo (RecordBoardView)", "view", view)
+
+	err := uc.markRepo.RecordBoardView(ctx, view)
+
+	if err != nil {
+		info := "Failed to record view"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return fmt.Errorf(header+info+": %w", ErrRecordBoardView)
+	}
+
+	uc.log.Info(ctx, header+"Board view successfully recorded")
+
+	return nil
+}
+
+func (uc *boardMarkUseCase) GetRecentBoards(ctx context.Context, userID uuid.UUID) ([]entity.MarkedBoard, error) {
+	header := "GetRecentBoards: "
+
+	uc.log.Info(ctx, header+"Usecase called; Making request to board mark repo (GetRecentBoards)", "userID", userID)
+
+	boards, err := uc.markRepo.GetRecentBoards(ctx, userID)
+
+	if err != nil {
+		info := "Failed to get recent boards"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", ErrGetRecentBoards)
+	}
+
+	uc.log.Info(ctx, header+"Got recent boards", "count", len(boards))
+
+	return boards, nil
+}
+
+func (uc *boardMarkUseCase) GetMarkedBoards(ctx context.Context, userID uuid.UUID, page repository.Page) ([]entity.MarkedBoard, *repository.Cursor, error) {
+	header := "GetMarkedBoards: "
+
+	uc.log.Info(ctx, header+"Usecase called; Validating page", "userID", userID, "page", page)
+
+	err := validatePage(page)
+
+	if err != nil {
+		info := "Validation failed"
+		uc.log.Info(ctx, header+info, "err", err.Error())
+		return nil, nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	uc.log.Info(ctx, header+"Making request to board mark repo (GetMarkedBoards)", "userID", userID, "page", page)
+
+	boards, err := uc.markRepo.GetMarkedBoards(ctx, userID, page)
+
+	if err != nil {
+		info := "Failed to get boards"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return nil, nil, fmt.Errorf(header+info+": %w", ErrGetMarkedBoards)
+	}
+
+	uc.log.Info(ctx, header+"Got boards", "count", len(boards))
+
+	var next *repository.Cursor
+	if len(boards) == page.Limit {
+		next = repository.MarkedBoardCursor(boards[len(boards)-1])
+	}
+
+	return boards, next, nil
+}
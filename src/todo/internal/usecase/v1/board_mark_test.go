@@ -0,0 +1,318 @@
+package v1_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+	log "todo/internal/adapter/logger"
+	"todo/internal/entity"
+	"todo/internal/repository"
+	"todo/internal/testdata"
+	v1 "todo/internal/usecase/v1"
+	"todo/mocks"
+
+	"github.com/google/uuid"
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/runner"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestStarBoard(t *testing.T) {
+	runner.Run(t, "TestStarBoard", func(pt provider.T) {
+		mom := &testdata.ObjectMother{}
+
+		userID := mom.GetUUID(0)
+		boardID := mom.GetUUID(1)
+		workspaceID := mom.GetUUID(3)
+		board := &entity.Board{ID: boardID, UserID: mom.GetUUID(2), WorkspaceID: workspaceID}
+		viewer := &entity.WorkspaceMember{WorkspaceID: workspaceID, UserID: userID, Role: entity.RoleViewer}
+
+		tests := []struct {
+			name      string
+			mockSetup func(mockMarkRepo *mocks.BoardMarkRepository, mockBoardRepo *mocks.BoardRepository, mockWorkspaceRepo *mocks.WorkspaceRepository)
+			wantErr   bool
+			err       error
+		}{
+			{
+				name: "positive",
+				mockSetup: func(mockMarkRepo *mocks.BoardMarkRepository, mockBoardRepo *mocks.BoardRepository, mockWorkspaceRepo *mocks.WorkspaceRepository) {
+					mockBoardRepo.On("GetBoardByID", mock.Anything, boardID).Return(board, nil)
+					mockWorkspaceRepo.On("GetWorkspaceMember", mock.Anything, workspaceID, userID).Return(viewer, nil)
+					mockMarkRepo.On("StarBoard", mock.Anything, mock.MatchedBy(func(s *entity.BoardStar) bool {
+						return s.UserID == userID && s.BoardID == boardID && !s.CreatedAt.IsZero()
+					})).Return(nil)
+				},
+				wantErr: false,
+			},
+			{
+				name: "board not found",
+				mockSetup: func(mockMarkRepo *mocks.BoardMarkRepository, mockBoardRepo *mocks.BoardRepository, mockWorkspaceRepo *mocks.WorkspaceRepository) {
+					mockBoardRepo.On("GetBoardByID", mock.Anything, boardID).Return(nil, errors.New(""))
+				},
+				wantErr: true,
+				err:     repository.ErrBoardNotFound,
+			},
+			{
+				name: "not a member of the workspace",
+				mockSetup: func(mockMarkRepo *mocks.BoardMarkRepository, mockBoardRepo *mocks.BoardRepository, mockWorkspaceRepo *mocks.WorkspaceRepository) {
+					mockBoardRepo.On("GetBoardByID", mock.Anything, boardID).Return(board, nil)
+					mockWorkspaceRepo.On("GetWorkspaceMember", mock.Anything, workspaceID, userID).Return(nil, repository.ErrWorkspaceMemberNotFound)
+				},
+				wantErr: true,
+				err:     repository.ErrBoardAccess,
+			},
+			{
+				name: "negative",
+				mockSetup: func(mockMarkRepo *mocks.BoardMarkRepository, mockBoardRepo *mocks.BoardRepository, mockWorkspaceRepo *mocks.WorkspaceRepository) {
+					mockBoardRepo.On("GetBoardByID", mock.Anything, boardID).Return(board, nil)
+					mockWorkspaceRepo.On("GetWorkspaceMember", mock.Anything, workspaceID, userID).Return(viewer, nil)
+					mockMarkRepo.On("StarBoard", mock.Anything, mock.Anything).Return(errors.New(""))
+				},
+				wantErr: true,
+				err:     v1.ErrStarBoard,
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				t.Parallel()
+
+				runner.Run(t, tt.name, func(pt provider.T) {
+					mockMarkRepo := new(mocks.BoardMarkRepository)
+					mockBoardRepo := new(mocks.BoardRepository)
+					mockWorkspaceRepo := new(mocks.WorkspaceRepository)
+					logger := log.NewEmptyLogger()
+
+					uc := v1.NewBoardMarkUseCase(mockMarkRepo, mockBoardRepo, mockWorkspaceRepo, logger)
+
+					tt.mockSetup(mockMarkRepo, mockBoardRepo, mockWorkspaceRepo)
+
+					pt.WithNewStep("Call StarBoard", func(sCtx provider.StepCtx) {
+						err := uc.StarBoard(context.Background(), userID, boardID)
+
+						if tt.wantErr {
+							sCtx.Assert().Error(err, "Expected error")
+							sCtx.Assert().ErrorIs(err, tt.err)
+						} else {
+							sCtx.Assert().NoError(err, "Expected no error")
+						}
+
+						mockMarkRepo.AssertExpectations(t)
+						mockBoardRepo.AssertExpectations(t)
+						mockWorkspaceRepo.AssertExpectations(t)
+					})
+				})
+			})
+		}
+	})
+}
+
+func TestRecordBoardView(t *testing.T) {
+	runner.Run(t, "TestRecordBoardView", func(pt provider.T) {
+		mom := &testdata.ObjectMother{}
+
+		userID := mom.GetUUID(0)
+		boardID := mom.GetUUID(1)
+		workspaceID := mom.GetUUID(3)
+		board := &entity.Board{ID: boardID, UserID: mom.GetUUID(2), WorkspaceID: workspaceID}
+		viewer := &entity.WorkspaceMember{WorkspaceID: workspaceID, UserID: userID, Role: entity.RoleViewer}
+
+		tests := []struct {
+			name      string
+			mockSetup func(mockMarkRepo *mocks.BoardMarkRepository, mockBoardRepo *mocks.BoardRepository, mockWorkspaceRepo *mocks.WorkspaceRepository)
+			wantErr   bool
+			err       error
+		}{
+			{
+				name: "positive",
+				mockSetup: func(mockMarkRepo *mocks.BoardMarkRepository, mockBoardRepo *mocks.BoardRepository, mockWorkspaceRepo *mocks.WorkspaceRepository) {
+					mockBoardRepo.On("GetBoardByID", mock.Anything, boardID).Return(board, nil)
+					mockWorkspaceRepo.On("GetWorkspaceMember", mock.Anything, workspaceID, userID).Return(viewer, nil)
+					mockMarkRepo.On("RecordBoardView", mock.Anything, mock.MatchedBy(func(v *entity.BoardView) bool {
+						return v.UserID == userID && v.BoardID == boardID && !v.ViewedAt.IsZero()
+					})).Return(nil)
+				},
+				wantErr: false,
+			},
+			{
+				name: "not a member of the workspace",
+				mockSetup: func(mockMarkRepo *mocks.BoardMarkRepository, mockBoardRepo *mocks.BoardRepository, mockWorkspaceRepo *mocks.WorkspaceRepository) {
+					mockBoardRepo.On("GetBoardByID", mock.Anything, boardID).Return(board, nil)
+					mockWorkspaceRepo.On("GetWorkspaceMember", mock.Anything, workspaceID, userID).Return(nil, repository.ErrWorkspaceMemberNotFound)
+				},
+				wantErr: true,
+				err:     repository.ErrBoardAccess,
+			},
+			{
+				name: "negative",
+				mockSetup: func(mockMarkRepo *mocks.BoardMarkRepository, mockBoardRepo *mocks.BoardRepository, mockWorkspaceRepo *mocks.WorkspaceRepository) {
+					mockBoardRepo.On("GetBoardByID", mock.Anything, boardID).Return(board, nil)
+					mockWorkspaceRepo.On("GetWorkspaceMember", mock.Anything, workspaceID, userID).Return(viewer, nil)
+					mockMarkRepo.On("RecordBoardView", mock.Anything, mock.Anything).Return(errors.New(""))
+				},
+				wantErr: true,
+				err:     v1.ErrRecordBoardView,
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				t.Parallel()
+
+				runner.Run(t, tt.name, func(pt provider.T) {
+					mockMarkRepo := new(mocks.BoardMarkRepository)
+					mockBoardRepo := new(mocks.BoardRepository)
+					mockWorkspaceRepo := new(mocks.WorkspaceRepository)
+					logger := log.NewEmptyLogger()
+
+					uc := v1.NewBoardMarkUseCase(mockMarkRepo, mockBoardRepo, mockWorkspaceRepo, logger)
+
+					tt.mockSetup(mockMarkRepo, mockBoardRepo, mockWorkspaceRepo)
+
+					pt.WithNewStep("Call RecordBoardView", func(sCtx provider.StepCtx) {
+						err := uc.RecordBoardView(context.Background(), userID, boardID)
+
+						if tt.wantErr {
+							sCtx.Assert().Error(err, "Expected error")
+							sCtx.Assert().ErrorIs(err, tt.err)
+						} else {
+							sCtx.Assert().NoError(err, "Expected no error")
+						}
+
+						mockMarkRepo.AssertExpectations(t)
+						mockBoardRepo.AssertExpectations(t)
+						mockWorkspaceRepo.AssertExpectations(t)
+					})
+				})
+			})
+		}
+	})
+}
+
+func TestGetMarkedBoards(t *testing.T) {
+	runner.Run(t, "TestGetMarkedBoards", func(pt provider.T) {
+		mom := &testdata.ObjectMother{}
+
+		userID := mom.GetUUID(0)
+		starredAt := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+		viewedAt := starredAt.Add(time.Hour)
+		createdAt := starredAt.Add(-time.Hour)
+
+		starred := entity.MarkedBoard{Board: entity.Board{ID: mom.GetUUID(1), CreatedAt: createdAt}, StarredAt: &starredAt}
+		viewed := entity.MarkedBoard{Board: entity.Board{ID: mom.GetUUID(2), CreatedAt: createdAt}, ViewedAt: &viewedAt}
+		starredGroup, recentGroup := 0.0, 1.0
+		plain := entity.MarkedBoard{Board: entity.Board{ID: mom.GetUUID(3), CreatedAt: createdAt}}
+
+		tests := []struct {
+			name      string
+			page      repository.Page
+			mockSetup func(mockMarkRepo *mocks.BoardMarkRepository)
+			wantNext  *repository.Cursor
+			wantErr   bool
+			err       error
+		}{
+			{
+				name: "full page ends with a starred board",
+				page: repository.Page{Limit: 1},
+				mockSetup: func(mockMarkRepo *mocks.BoardMarkRepository) {
+					mockMarkRepo.On("GetMarkedBoards", mock.Anything, userID, repository.Page{Limit: 1}).
+						Return([]entity.MarkedBoard{starred}, nil)
+				},
+				wantNext: &repository.Cursor{Time: &starredAt, Number: &starredGroup, ID: starred.ID},
+			},
+			{
+				name: "full page ends with a recent board",
+				page: repository.Page{Limit: 2},
+				mockSetup: func(mockMarkRepo *mocks.BoardMarkRepository) {
+					mockMarkRepo.On("GetMarkedBoards", mock.Anything, userID, repository.Page{Limit: 2}).
+						Return([]entity.MarkedBoard{starred, viewed}, nil)
+				},
+				wantNext: &repository.Cursor{Time: &viewedAt, Number: &recentGroup, ID: viewed.ID},
+			},
+			{
+				name: "last page",
+				page: repository.Page{Limit: 4},
+				mockSetup: func(mockMarkRepo *mocks.BoardMarkRepository) {
+					mockMarkRepo.On("GetMarkedBoards", mock.Anything, userID, repository.Page{Limit: 4}).
+						Return([]entity.MarkedBoard{starred, viewed, plain}, nil)
+				},
+				wantNext: nil,
+			},
+			{
+				name:      "zero limit",
+				page:      repository.Page{Limit: 0},
+				mockSetup: func(mockMarkRepo *mocks.BoardMarkRepository) {},
+				wantErr:   true,
+				err:       repository.ErrZeroLimit,
+			},
+			{
+				name: "negative",
+				page: repository.Page{Limit: 1},
+				mockSetup: func(mockMarkRepo *mocks.BoardMarkRepository) {
+					mockMarkRepo.On("GetMarkedBoards", mock.Anything, userID, mock.Anything).Return(nil, errors.New(""))
+				},
+				wantErr: true,
+				err:     v1.ErrGetMarkedBoards,
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				t.Parallel()
+
+				runner.Run(t, tt.name, func(pt provider.T) {
+					mockMarkRepo := new(mocks.BoardMarkRepository)
+					logger := log.NewEmptyLogger()
+
+					uc := v1.NewBoardMarkUseCase(mockMarkRepo, new(mocks.BoardRepository), new(mocks.WorkspaceRepository), logger)
+
+					tt.mockSetup(mockMarkRepo)
+
+					pt.WithNewStep("Call GetMarkedBoards", func(sCtx provider.StepCtx) {
+						_, next, err := uc.GetMarkedBoards(context.Background(), userID, tt.page)
+
+						if tt.wantErr {
+							sCtx.Assert().Error(err, "Expected error")
+							sCtx.Assert().ErrorIs(err, tt.err)
+						} else {
+							sCtx.Assert().NoError(err, "Expected no error")
+							sCtx.Assert().Equal(tt.wantNext, next)
+						}
+
+						mockMarkRepo.AssertExpectations(t)
+					})
+				})
+			})
+		}
+	})
+}
+
+func TestMarkedBoardRank(t *testing.T) {
+	runner.Run(t, "TestMarkedBoardRank", func(pt provider.T) {
+		createdAt := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+		starredAt := createdAt.Add(time.Hour)
+		viewedAt := createdAt.Add(2 * time.Hour)
+
+		tests := []struct {
+			name      string
+			board     entity.MarkedBoard
+			wantGroup int
+			wantAt    time.Time
+		}{
+			{"starred and viewed", entity.MarkedBoard{StarredAt: &starredAt, ViewedAt: &viewedAt}, 0, viewedAt},
+			{"starred only", entity.MarkedBoard{StarredAt: &starredAt}, 0, starredAt},
+			{"viewed only", entity.MarkedBoard{ViewedAt: &viewedAt}, 1, viewedAt},
+			{"neither", entity.MarkedBoard{Board: entity.Board{ID: uuid.New(), CreatedAt: createdAt}}, 2, createdAt},
+		}
+
+		for _, tt := range tests {
+			pt.WithNewStep(tt.name, func(sCtx provider.StepCtx) {
+				group, at := repository.MarkedBoardRank(tt.board)
+
+				sCtx.Assert().Equal(tt.wantGroup, group)
+				sCtx.Assert().Equal(tt.wantAt, at)
+			})
+		}
+	})
+}
@@ -0,0 +1,200 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+	"todo/internal/common/logger"
+	"todo/internal/entity"
+	"todo/internal/repository"
+	"todo/internal/usecase"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrGetBoardStats = errors.New("failed to get board stats")
+)
+
+type boardStatsUseCase struct {
+	statsRepo     repository.BoardStatsRepository
+	boardRepo     repository.BoardRepository
+	workspaceRepo repository.WorkspaceRepository
+	log           logger.Logger
+}
+
+func NewBoardStatsUseCase(
+	statsRepo repository.BoardStatsRepository,
+	boardRepo repository.BoardRepository,
+	workspaceRepo repository.WorkspaceRepository,
+	log logger.Logger,
+) usecase.BoardStatsUseCase {
+	return &boardStatsUseCase{
+		statsRepo:     statsRepo,
+		boardRepo:     boardRepo,
+		workspaceRepo: workspaceRepo,
+		log:           log,
+	}
+}
+
+func (uc *boardStatsUseCase) GetBoardStats(ctx context.Context, userID, boardID uuid.UUID, from, to time.Time, staleDays int) (*entity.BoardStats, error) {
+	header := "GetBoardStats: "
+
+	uc.log.Info(ctx, header+"Usecase called; Validating period and stale threshold", "boardID", boardID, "from", from, "to", to, "staleDays", staleDays)
+
+	err := validateAnalyticsPeriod(from, to)
+
+	if err == nil && (staleDays < 1 || staleDays > repository.MaxStaleDays) {
+		err = repository.ErrStaleDays
+	}
+
+	if err != nil {
+		info := "Validation failed"
+		uc.log.Info(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	if _, err := boardAccess(ctx, uc.boardRepo, uc.workspaceRepo, uc.log, header, userID, boardID, false); err != nil {
+		return nil, err
+	}
+
+	uc.log.Info(ctx, header+"Making request to board stats repo (GetColumnCards)")
+
+	columns, err := uc.statsRepo.GetColumnCards(ctx, boardID)
+
+	if err != nil {
+		info := "Failed to get column cards"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", ErrGetBoardStats)
+	}
+
+	uc.log.Info(ctx, header+"Making request to board stats repo (GetCreatedCards)")
+
+	created, err := uc.statsRepo.GetCreatedCards(ctx, boardID, from, to)
+
+	if err != nil {
+		info := "Failed to get created cards"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", ErrGetBoardStats)
+	}
+
+	uc.log.Info(ctx, header+"Making request to board stats repo (GetCompletedCards)")
+
+	completed, err := uc.statsRepo.GetCompletedCards(ctx, boardID, from, to)
+
+	if err != nil {
+		info := "Failed to get completed cards"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", ErrGetBoardStats)
+	}
+
+	uc.log.Info(ctx, header+"Making request to board stats repo (GetStaleCards)")
+
+	before := time.Now().Add(-time.Duration(staleDays) * day)
+	stale, err := uc.statsRepo.GetStaleCards(ctx, boardID, before, repository.MaxStaleCards)
+
+	if err != nil {
+		info := "Failed to get stale cards"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", ErrGetBoardStats)
+	}
+
+	stats := &entity.BoardStats{
+		BoardID:      boardID,
+		From:         from,
+		To:           to,
+		Columns:      columns,
+		Days:         dayCards(created, completed, from, to),
+		StaleDays:    staleDays,
+		Stale:        stale,
+		Contributors: contributors(created, completed),
+	}
+
+	uc.log.Info(ctx, header+"Successfully got board stats", "created", len(created), "completed", len(completed), "stale", len(stale))
+
+	return stats, nil
+}
+
+// dayCards counts the cards created and completed each day of [from, to),
+// days with none included. Cards are put on days by their wall clock date,
+// the way they are stored, whatever zone the times come labelled with.
+func dayCards(created []entity.CreatedCard, completed []entity.CompletedCard, from, to time.Time) []entity.DayCards {
+	days := analyticsDays(from, to)
+	counts := make([]entity.DayCards, len(days))
+	for i, d := range days {
+		counts[i].Day = d
+	}
+
+	first := wallDate(from)
+	index := func(t time.Time) int {
+		if d := int(wallDate(t).Sub(first) / day); d >= 0 && d < len(counts) {
+			return d
+		}
+		return -1
+	}
+
+	for _, c := range created {
+		if d := index(c.CreatedAt); d >= 0 {
+			counts[d].Created++
+		}
+	}
+
+	for _, c := range completed {
+		if d := index(c.DoneAt); d >= 0 {
+			counts[d].Completed++
+		}
+	}
+
+	return counts
+}
+
+// wallDate is the date of the wall clock reading of t, as midnight UTC.
+func wallDate(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// contributors ranks the users by the cards they created plus the cards
+// assigned to them completed, keeping the first MaxContributors. Ties go
+// to the lower user id.
+func contributors(created []entity.CreatedCard, completed []entity.CompletedCard) []entity.Contributor {
+	var ranked []entity.Contributor
+	index := make(map[uuid.UUID]int)
+
+	contributor := func(userID uuid.UUID) *entity.Contributor {
+		i, ok := index[userID]
+		if !ok {
+			i = len(ranked)
+			index[userID] = i
+			ranked = append(ranked, entity.Contributor{UserID: userID})
+		}
+		return &ranked[i]
+	}
+
+	for _, c := range created {
+		if c.UserID != nil {
+			contributor(*c.UserID).Created++
+		}
+	}
+
+	for _, c := range completed {
+		if c.AssigneeID != nil {
+			contributor(*c.AssigneeID).Completed++
+		}
+	}
+
+	sort.Slice(ranked, func(i, j int) bool {
+		a, b := ranked[i].Created+ranked[i].Completed, ranked[j].Created+ranked[j].Completed
+		if a != b {
+			return a > b
+		}
+		return ranked[i].UserID.String() < ranked[j].UserID.String()
+	})
+
+	if len(ranked) > repository.MaxContributors {
+		ranked = ranked[:repository.MaxContributors]
+	}
+
+	return ranked
+}
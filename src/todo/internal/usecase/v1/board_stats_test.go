@@ -0,0 +1,281 @@
+package v1_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+	log "todo/internal/adapter/logger"
+	"todo/internal/entity"
+	"todo/internal/repository"
+	"todo/internal/testdata"
+	v1 "todo/internal/usecase/v1"
+	"todo/mocks"
+
+	"github.com/google/uuid"
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/runner"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestGetBoardStats(t *testing.T) {
+	runner.Run(t, "TestGetBoardStats", func(pt provider.T) {
+		mom := &testdata.ObjectMother{}
+
+		userID := mom.GetUUID(0)
+		boardID := mom.GetUUID(1)
+		workspaceID := mom.GetUUID(2)
+		otherID := mom.GetUUID(3)
+		board := &entity.Board{ID: boardID, UserID: userID, WorkspaceID: workspaceID}
+		viewer := &entity.WorkspaceMember{WorkspaceID: workspaceID, UserID: userID, Role: entity.RoleViewer}
+
+		// Monday 1 January 2024, one week.
+		from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+		to := from.AddDate(0, 0, 7)
+		day := 24 * time.Hour
+
+		columns := []entity.ColumnCards{
+			{ColumnID: mom.GetUUID(4), ColumnTitle: "To Do", Cards: 3},
+			{ColumnID: mom.GetUUID(5), ColumnTitle: "Done", Done: true, Cards: 1},
+		}
+		created := []entity.CreatedCard{
+			{CardID: mom.GetUUID(6), UserID: &otherID, CreatedAt: from},
+			{CardID: mom.GetUUID(7), UserID: &userID, CreatedAt: from.Add(day)},
+			{CardID: mom.GetUUID(8), CreatedAt: from.Add(day)},
+		}
+		completed := []entity.CompletedCard{
+			{CardID: mom.GetUUID(6), AssigneeID: &userID, DoneAt: from.Add(3 * day)},
+		}
+		stale := []entity.StaleCard{
+			{CardID: mom.GetUUID(9), ColumnID: mom.GetUUID(4), Title: "Old"},
+		}
+
+		member := func(mockBoardRepo *mocks.BoardRepository, mockWorkspaceRepo *mocks.WorkspaceRepository) {
+			mockBoardRepo.On("GetBoardByID", mock.Anything, boardID).Return(board, nil)
+			mockWorkspaceRepo.On("GetWorkspaceMember", mock.Anything, workspaceID, userID).Return(viewer, nil)
+		}
+
+		tests := []struct {
+			name      string
+			from      time.Time
+			to        time.Time
+			staleDays int
+			mockSetup func(mockStatsRepo *mocks.BoardStatsRepository, mockBoardRepo *mocks.BoardRepository, mockWorkspaceRepo *mocks.WorkspaceRepository)
+			wantErr   bool
+			err       error
+		}{
+			{
+				name:      "positive",
+				from:      from,
+				to:        to,
+				staleDays: 14,
+				mockSetup: func(mockStatsRepo *mocks.BoardStatsRepository, mockBoardRepo *mocks.BoardRepository, mockWorkspaceRepo *mocks.WorkspaceRepository) {
+					member(mockBoardRepo, mockWorkspaceRepo)
+					mockStatsRepo.On("GetColumnCards", mock.Anything, boardID).Return(columns, nil)
+					mockStatsRepo.On("GetCreatedCards", mock.Anything, boardID, from, to).Return(created, nil)
+					mockStatsRepo.On("GetCompletedCards", mock.Anything, boardID, from, to).Return(completed, nil)
+					mockStatsRepo.On("GetStaleCards", mock.Anything, boardID, mock.MatchedBy(func(before time.Time) bool {
+						return time.Since(before) >= 14*day
+					}), repository.MaxStaleCards).Return(stale, nil)
+				},
+				wantErr: false,
+			},
+			{
+				name:      "empty period",
+				from:      from,
+				to:        from,
+				staleDays: 14,
+				mockSetup: func(mockStatsRepo *mocks.BoardStatsRepository, mockBoardRepo *mocks.BoardRepository, mockWorkspaceRepo *mocks.WorkspaceRepository) {
+				},
+				wantErr: true,
+				err:     repository.ErrAnalyticsBounds,
+			},
+			{
+				name:      "invalid stale days",
+				from:      from,
+				to:        to,
+				staleDays: 0,
+				mockSetup: func(mockStatsRepo *mocks.BoardStatsRepository, mockBoardRepo *mocks.BoardRepository, mockWorkspaceRepo *mocks.WorkspaceRepository) {
+				},
+				wantErr: true,
+				err:     repository.ErrStaleDays,
+			},
+			{
+				name:      "not a member",
+				from:      from,
+				to:        to,
+				staleDays: 14,
+				mockSetup: func(mockStatsRepo *mocks.BoardStatsRepository, mockBoardRepo *mocks.BoardRepository, mockWorkspaceRepo *mocks.WorkspaceRepository) {
+					mockBoardRepo.On("GetBoardByID", mock.Anything, boardID).Return(board, nil)
+					mockWorkspaceRepo.On("GetWorkspaceMember", mock.Anything, workspaceID, userID).Return(nil, repository.ErrWorkspaceMemberNotFound)
+				},
+				wantErr: true,
+				err:     repository.ErrBoardAccess,
+			},
+			{
+				name:      "no board",
+				from:      from,
+				to:        to,
+				staleDays: 14,
+				mockSetup: func(mockStatsRepo *mocks.BoardStatsRepository, mockBoardRepo *mocks.BoardRepository, mockWorkspaceRepo *mocks.WorkspaceRepository) {
+					mockBoardRepo.On("GetBoardByID", mock.Anything, boardID).Return(nil, errors.New(""))
+				},
+				wantErr: true,
+				err:     repository.ErrBoardNotFound,
+			},
+			{
+				name:      "negative",
+				from:      from,
+				to:        to,
+				staleDays: 14,
+				mockSetup: func(mockStatsRepo *mocks.BoardStatsRepository, mockBoardRepo *mocks.BoardRepository, mockWorkspaceRepo *mocks.WorkspaceRepository) {
+					member(mockBoardRepo, mockWorkspaceRepo)
+					mockStatsRepo.On("GetColumnCards", mock.Anything, boardID).Return(columns, nil)
+					mockStatsRepo.On("GetCreatedCards", mock.Anything, boardID, from, to).Return(nil, errors.New(""))
+				},
+				wantErr: true,
+				err:     v1.ErrGetBoardStats,
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				t.Parallel()
+
+				runner.Run(t, tt.name, func(pt provider.T) {
+					mockStatsRepo := new(mocks.BoardStatsRepository)
+					mockBoardRepo := new(mocks.BoardRepository)
+					mockWorkspaceRepo := new(mocks.WorkspaceRepository)
+					logger := log.NewEmptyLogger()
+
+					uc := v1.NewBoardStatsUseCase(mockStatsRepo, mockBoardRepo, mockWorkspaceRepo, logger)
+
+					tt.mockSetup(mockStatsRepo, mockBoardRepo, mockWorkspaceRepo)
+
+					pt.WithNewStep("Call GetBoardStats", func(sCtx provider.StepCtx) {
+						stats, err := uc.GetBoardStats(context.Background(), userID, boardID, tt.from, tt.to, tt.staleDays)
+
+						if tt.wantErr {
+							sCtx.Assert().Error(err, "Expected error")
+							sCtx.Assert().ErrorIs(err, tt.err)
+						} else {
+							sCtx.Assert().NoError(err, "Expected no error")
+
+							sCtx.Assert().Equal(columns, stats.Columns)
+							sCtx.Assert().Equal(stale, stats.Stale)
+							sCtx.Assert().Equal(14, stats.StaleDays)
+
+							sCtx.Assert().Len(stats.Days, 7)
+							sCtx.Assert().Equal(entity.DayCards{Day: from, Created: 1}, stats.Days[0])
+							sCtx.Assert().Equal(entity.DayCards{Day: from.Add(day), Created: 2}, stats.Days[1])
+							sCtx.Assert().Equal(entity.DayCards{Day: from.Add(3 * day), Completed: 1}, stats.Days[3])
+
+							// A deleted card has no creator to credit.
+							sCtx.Assert().Equal([]entity.Contributor{
+								{UserID: userID, Created: 1, Completed: 1},
+								{UserID: otherID, Created: 1},
+							}, stats.Contributors)
+						}
+					})
+
+					mockStatsRepo.AssertExpectations(t)
+					mockBoardRepo.AssertExpectations(t)
+					mockWorkspaceRepo.AssertExpectations(t)
+				})
+			})
+		}
+	})
+}
+
+func TestGetBoardStatsContributorsCapped(t *testing.T) {
+	runner.Run(t, "TestGetBoardStatsContributorsCapped", func(pt provider.T) {
+		mom := &testdata.ObjectMother{}
+
+		userID := mom.GetUUID(0)
+		boardID := mom.GetUUID(1)
+		workspaceID := mom.GetUUID(2)
+
+		from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+		to := from.AddDate(0, 0, 1)
+
+		var created []entity.CreatedCard
+		for i := 0; i < repository.MaxContributors+5; i++ {
+			creator := uuid.New()
+			for n := 0; n <= i; n++ {
+				created = append(created, entity.CreatedCard{CardID: uuid.New(), UserID: &creator, CreatedAt: from})
+			}
+		}
+
+		mockStatsRepo := new(mocks.BoardStatsRepository)
+		mockBoardRepo := new(mocks.BoardRepository)
+		mockWorkspaceRepo := new(mocks.WorkspaceRepository)
+
+		mockBoardRepo.On("GetBoardByID", mock.Anything, boardID).Return(&entity.Board{ID: boardID, WorkspaceID: workspaceID}, nil)
+		mockWorkspaceRepo.On("GetWorkspaceMember", mock.Anything, workspaceID, userID).
+			Return(&entity.WorkspaceMember{WorkspaceID: workspaceID, UserID: userID, Role: entity.RoleViewer}, nil)
+		mockStatsRepo.On("GetColumnCards", mock.Anything, boardID).Return(nil, nil)
+		mockStatsRepo.On("GetCreatedCards", mock.Anything, boardID, from, to).Return(created, nil)
+		mockStatsRepo.On("GetCompletedCards", mock.Anything, boardID, from, to).Return(nil, nil)
+		mockStatsRepo.On("GetStaleCards", mock.Anything, boardID, mock.Anything, repository.MaxStaleCards).Return(nil, nil)
+
+		uc := v1.NewBoardStatsUseCase(mockStatsRepo, mockBoardRepo, mockWorkspaceRepo, log.NewEmptyLogger())
+
+		pt.WithNewStep("Call GetBoardStats", func(sCtx provider.StepCtx) {
+			stats, err := uc.GetBoardStats(context.Background(), userID, boardID, from, to, repository.DefaultStaleDays)
+
+			sCtx.Assert().NoError(err, "Expected no error")
+			sCtx.Assert().Len(stats.Contributors, repository.MaxContributors)
+			sCtx.Assert().Equal(repository.MaxContributors+5, stats.Contributors[0].Created, "most active first")
+			sCtx.Assert().Equal(6, stats.Contributors[repository.MaxContributors-1].Created)
+		})
+	})
+}
+
+func TestGetBoardStatsDaysNearMidnight(t *testing.T) {
+	runner.Run(t, "TestGetBoardStatsDaysNearMidnight", func(pt provider.T) {
+		mom := &testdata.ObjectMother{}
+
+		userID := mom.GetUUID(0)
+		boardID := mom.GetUUID(1)
+		workspaceID := mom.GetUUID(2)
+
+		from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+		to := from.AddDate(0, 0, 2)
+
+		// Half an hour either side of midnight on the wall clock, in zones
+		// whose instants fall on the other day in UTC.
+		east := time.FixedZone("UTC+1", 60*60)
+		west := time.FixedZone("UTC-5", -5*60*60)
+		created := []entity.CreatedCard{
+			{CardID: mom.GetUUID(3), CreatedAt: time.Date(2024, 1, 2, 0, 30, 0, 0, east)},
+			{CardID: mom.GetUUID(4), CreatedAt: time.Date(2024, 1, 1, 23, 30, 0, 0, west)},
+		}
+		completed := []entity.CompletedCard{
+			{CardID: mom.GetUUID(3), DoneAt: time.Date(2024, 1, 2, 23, 59, 0, 0, west)},
+		}
+
+		mockStatsRepo := new(mocks.BoardStatsRepository)
+		mockBoardRepo := new(mocks.BoardRepository)
+		mockWorkspaceRepo := new(mocks.WorkspaceRepository)
+
+		mockBoardRepo.On("GetBoardByID", mock.Anything, boardID).Return(&entity.Board{ID: boardID, WorkspaceID: workspaceID}, nil)
+		mockWorkspaceRepo.On("GetWorkspaceMember", mock.Anything, workspaceID, userID).
+			Return(&entity.WorkspaceMember{WorkspaceID: workspaceID, UserID: userID, Role: entity.RoleViewer}, nil)
+		mockStatsRepo.On("GetColumnCards", mock.Anything, boardID).Return(nil, nil)
+		mockStatsRepo.On("GetCreatedCards", mock.Anything, boardID, from, to).Return(created, nil)
+		mockStatsRepo.On("GetCompletedCards", mock.Anything, boardID, from, to).Return(completed, nil)
+		mockStatsRepo.On("GetStaleCards", mock.Anything, boardID, mock.Anything, repository.MaxStaleCards).Return(nil, nil)
+
+		uc := v1.NewBoardStatsUseCase(mockStatsRepo, mockBoardRepo, mockWorkspaceRepo, log.NewEmptyLogger())
+
+		pt.WithNewStep("Call GetBoardStats", func(sCtx provider.StepCtx) {
+			stats, err := uc.GetBoardStats(context.Background(), userID, boardID, from, to, repository.DefaultStaleDays)
+
+			sCtx.Assert().NoError(err, "Expected no error")
+			sCtx.Assert().Equal([]entity.DayCards{
+				{Day: from, Created: 1},
+				{Day: from.AddDate(0, 0, 1), Created: 1, Completed: 1},
+			}, stats.Days)
+		})
+	})
+}
@@ -0,0 +1,119 @@
+package v1
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+	"todo/internal/common/logger"
+	"todo/internal/entity"
+	"todo/internal/repository"
+	"todo/internal/usecase"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrRegenerateCalendarToken = errors.New("failed to regenerate calendar token")
+	ErrGetCalendarCards        = errors.New("failed to get calendar cards")
+)
+
+// calendarTokenSize is how many random bytes a calendar token has.
+const calendarTokenSize = 32
+
+type calendarUseCase struct {
+	calendarRepo repository.CalendarRepository
+	log          logger.Logger
+}
+
+func NewCalendarUseCase(calendarRepo repository.CalendarRepository, log logger.Logger) usecase.CalendarUseCase {
+	return &calendarUseCase{
+		calendarRepo: calendarRepo,
+		log:          log,
+	}
+}
+
+// hashCalendarToken is what is kept of a token, so that a leaked table does
+// not give the feeds away.
+func hashCalendarToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+func (uc *calendarUseCase) RegenerateCalendarToken(ctx context.Context, userID uuid.UUID) (string, *entity.CalendarToken, error) {
+	header := "RegenerateCalendarToken: "
+
+	uc.log.Info(ctx, header+"Usecase called; Validating user id", "userID", userID)
+
+	if userID == uuid.Nil {
+		info := "Validation failed"
+		uc.log.Info(ctx, header+info, "err", repository.ErrCalendarNoUserID.Error())
+		return "", nil, fmt.Errorf(header+info+": %w", repository.ErrCalendarNoUserID)
+	}
+
+	buf := make([]byte, calendarTokenSize)
+	if _, err := rand.Read(buf); err != nil {
+		info := "Failed to generate token"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return "", nil, fmt.Errorf(header+info+": %w", ErrRegenerateCalendarToken)
+	}
+	token := base64.RawURLEncoding.EncodeToString(buf)
+
+	calendarToken := &entity.CalendarToken{
+		UserID:    userID,
+		TokenHash: hashCalendarToken(token),
+		CreatedAt: time.Now(),
+	}
+
+	uc.log.Info(ctx, header+"Making request to calendar repo (SetCalendarToken)", "userID", userID)
+
+	err := uc.calendarRepo.SetCalendarToken(ctx, calendarToken)
+
+	if err != nil {
+		info := "Failed to save token"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return "", nil, fmt.Errorf(header+info+": %w", ErrRegenerateCalendarToken)
+	}
+
+	uc.log.Info(ctx, header+"Calendar token successfully regenerated", "userID", userID)
+
+	return token, calendarToken, nil
+}
+
+func (uc *calendarUseCase) GetCalendarCards(ctx context.Context, token string) ([]entity.CalendarCard, error) {
+	header := "GetCalendarCards: "
+
+	uc.log.Info(ctx, header+"Usecase called; Making request to calendar repo (GetCalendarTokenByHash)")
+
+	calendarToken, err := uc.calendarRepo.GetCalendarTokenByHash(ctx, hashCalendarToken(token))
+
+	if errors.Is(err, repository.ErrCalendarTokenNotFound) {
+		info := "Unknown token"
+		uc.log.Info(ctx, header+info)
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	if err != nil {
+		info := "Failed to look up token"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", ErrGetCalendarCards)
+	}
+
+	uc.log.Info(ctx, header+"Making request to calendar repo (GetCalendarCards)", "userID", calendarToken.UserID)
+
+	cards, err := uc.calendarRepo.GetCalendarCards(ctx, calendarToken.UserID)
+
+	if err != nil {
+		info := "Failed to get cards"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", ErrGetCalendarCards)
+	}
+
+	uc.log.Info(ctx, header+"Got calendar cards", "count", len(cards))
+
+	return cards, nil
+}
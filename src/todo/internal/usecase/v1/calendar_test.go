@@ -0,0 +1,197 @@
+package v1_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"testing"
+	"time"
+	log "todo/internal/adapter/logger"
+	"todo/internal/entity"
+	"todo/internal/repository"
+	"todo/internal/testdata"
+	v1 "todo/internal/usecase/v1"
+	"todo/mocks"
+
+	"github.com/google/uuid"
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/runner"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestRegenerateCalendarToken(t *testing.T) {
+	runner.Run(t, "TestRegenerateCalendarToken", func(pt provider.T) {
+		mom := &testdata.ObjectMother{}
+
+		userID := mom.GetUUID(0)
+
+		tests := []struct {
+			name      string
+			userID    uuid.UUID
+			mockSetup func(mockCalendarRepo *mocks.CalendarRepository)
+			wantErr   bool
+			err       error
+		}{
+			{
+				name:   "positive",
+				userID: userID,
+				mockSetup: func(mockCalendarRepo *mocks.CalendarRepository) {
+					mockCalendarRepo.On("SetCalendarToken", mock.Anything, mock.MatchedBy(func(t *entity.CalendarToken) bool {
+						return t.UserID == userID && t.TokenHash != "" && !t.CreatedAt.IsZero()
+					})).Return(nil)
+				},
+				wantErr: false,
+			},
+			{
+				name:      "no user",
+				userID:    uuid.Nil,
+				mockSetup: func(mockCalendarRepo *mocks.CalendarRepository) {},
+				wantErr:   true,
+				err:       repository.ErrCalendarNoUserID,
+			},
+			{
+				name:   "negative",
+				userID: userID,
+				mockSetup: func(mockCalendarRepo *mocks.CalendarRepository) {
+					mockCalendarRepo.On("SetCalendarToken", mock.Anything, mock.Anything).Return(errors.New(""))
+				},
+				wantErr: true,
+				err:     v1.ErrRegenerateCalendarToken,
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				t.Parallel()
+
+				runner.Run(t, tt.name, func(pt provider.T) {
+					mockCalendarRepo := new(mocks.CalendarRepository)
+					logger := log.NewEmptyLogger()
+
+					uc := v1.NewCalendarUseCase(mockCalendarRepo, logger)
+
+					tt.mockSetup(mockCalendarRepo)
+
+					pt.WithNewStep("Call RegenerateCalendarToken", func(sCtx provider.StepCtx) {
+						token, calendarToken, err := uc.RegenerateCalendarToken(context.Background(), tt.userID)
+
+						if tt.wantErr {
+							sCtx.Assert().Error(err, "Expected error")
+							sCtx.Assert().ErrorIs(err, tt.err)
+						} else {
+							sCtx.Assert().NoError(err, "Expected no error")
+
+							sum := sha256.Sum256([]byte(token))
+							sCtx.Assert().NotEmpty(token)
+							sCtx.Assert().Equal(hex.EncodeToString(sum[:]), calendarToken.TokenHash)
+						}
+
+						mockCalendarRepo.AssertExpectations(t)
+					})
+				})
+			})
+		}
+	})
+}
+
+func TestRegenerateCalendarTokenIsRandom(t *testing.T) {
+	runner.Run(t, "TestRegenerateCalendarTokenIsRandom", func(pt provider.T) {
+		mom := &testdata.ObjectMother{}
+
+		mockCalendarRepo := new(mocks.CalendarRepository)
+		mockCalendarRepo.On("SetCalendarToken", mock.Anything, mock.Anything).Return(nil)
+
+		uc := v1.NewCalendarUseCase(mockCalendarRepo, log.NewEmptyLogger())
+
+		pt.WithNewStep("Regenerate twice", func(sCtx provider.StepCtx) {
+			first, _, err := uc.RegenerateCalendarToken(context.Background(), mom.GetUUID(0))
+			sCtx.Require().NoError(err)
+
+			second, _, err := uc.RegenerateCalendarToken(context.Background(), mom.GetUUID(0))
+			sCtx.Require().NoError(err)
+
+			sCtx.Assert().NotEqual(first, second)
+		})
+	})
+}
+
+func TestGetCalendarCards(t *testing.T) {
+	runner.Run(t, "TestGetCalendarCards", func(pt provider.T) {
+		mom := &testdata.ObjectMother{}
+
+		userID := mom.GetUUID(0)
+		token := "secret"
+		sum := sha256.Sum256([]byte(token))
+		tokenHash := hex.EncodeToString(sum[:])
+
+		cards := []entity.CalendarCard{
+			{CardID: mom.GetUUID(1), Title: "Release", DueDate: time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)},
+		}
+
+		tests := []struct {
+			name      string
+			mockSetup func(mockCalendarRepo *mocks.CalendarRepository)
+			wantErr   bool
+			err       error
+		}{
+			{
+				name: "positive",
+				mockSetup: func(mockCalendarRepo *mocks.CalendarRepository) {
+					mockCalendarRepo.On("GetCalendarTokenByHash", mock.Anything, tokenHash).
+						Return(&entity.CalendarToken{UserID: userID, TokenHash: tokenHash}, nil)
+					mockCalendarRepo.On("GetCalendarCards", mock.Anything, userID).Return(cards, nil)
+				},
+				wantErr: false,
+			},
+			{
+				name: "unknown token",
+				mockSetup: func(mockCalendarRepo *mocks.CalendarRepository) {
+					mockCalendarRepo.On("GetCalendarTokenByHash", mock.Anything, tokenHash).
+						Return(nil, repository.ErrCalendarTokenNotFound)
+				},
+				wantErr: true,
+				err:     repository.ErrCalendarTokenNotFound,
+			},
+			{
+				name: "negative",
+				mockSetup: func(mockCalendarRepo *mocks.CalendarRepository) {
+					mockCalendarRepo.On("GetCalendarTokenByHash", mock.Anything, tokenHash).
+						Return(&entity.CalendarToken{UserID: userID, TokenHash: tokenHash}, nil)
+					mockCalendarRepo.On("GetCalendarCards", mock.Anything, userID).Return(nil, errors.New(""))
+				},
+				wantErr: true,
+				err:     v1.ErrGetCalendarCards,
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				t.Parallel()
+
+				runner.Run(t, tt.name, func(pt provider.T) {
+					mockCalendarRepo := new(mocks.CalendarRepository)
+					logger := log.NewEmptyLogger()
+
+					uc := v1.NewCalendarUseCase(mockCalendarRepo, logger)
+
+					tt.mockSetup(mockCalendarRepo)
+
+					pt.WithNewStep("Call GetCalendarCards", func(sCtx provider.StepCtx) {
+						got, err := uc.GetCalendarCards(context.Background(), token)
+
+						if tt.wantErr {
+							sCtx.Assert().Error(err, "Expected error")
+							sCtx.Assert().ErrorIs(err, tt.err)
+						} else {
+							sCtx.Assert().NoError(err, "Expected no error")
+							sCtx.Assert().Equal(cards, got)
+						}
+
+						mockCalendarRepo.AssertExpectations(t)
+					})
+				})
+			})
+		}
+	})
+}
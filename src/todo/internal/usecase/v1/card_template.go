@@ -0,0 +1,394 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+	"todo/internal/common/logger"
+	"todo/internal/entity"
+	"todo/internal/repository"
+	"todo/internal/usecase"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrCreateCardTemplate   = errors.New("failed to create card template")
+	ErrGetCardTemplateByID  = errors.New("failed to get card template by id")
+	ErrGetCardTemplates     = errors.New("failed to get card templates of board")
+	ErrUpdateCardTemplate   = errors.New("failed to update card template")
+	ErrDeleteCardTemplate   = errors.New("failed to delete card template")
+	ErrCreateCardByTemplate = errors.New("failed to create card from template")
+)
+
+// templateDateLayout is how {{date}} is filled in.
+const templateDateLayout = "2006-01-02"
+
+// cardTemplateUseCase makes cards from templates through a TodoUseCase, so
+// that they are validated and recorded like any other card.
+type cardTemplateUseCase struct {
+	templateRepo  repository.CardTemplateRepository
+	boardRepo     repository.BoardRepository
+	columnRepo    repository.ColumnRepository
+	workspaceRepo repository.WorkspaceRepository
+	todo          usecase.TodoUseCase
+	log           logger.Logger
+}
+
+func NewCardTemplateUseCase(
+	templateRepo repository.CardTemplateRepository,
+	boardRepo repository.BoardRepository,
+	columnRepo repository.ColumnRepository,
+	workspaceRepo repository.WorkspaceRepository,
+	todo usecase.TodoUseCase,
+	log logger.Logger,
+) usecase.CardTemplateUseCase {
+	return &cardTemplateUseCase{
+		templateRepo:  templateRepo,
+		boardRepo:     boardRepo,
+		columnRepo:    columnRepo,
+		workspaceRepo: workspaceRepo,
+		todo:          todo,
+		log:           log,
+	}
+}
+
+func (uc *cardTemplateUseCase) CreateCardTemplate(ctx context.Context, template *entity.CardTemplate) error {
+	header := "CreateCardTemplate: "
+
+	uc.log.Info(ctx, header+"Usecase called; Validating card template", "template", template)
+
+	err := validateCardTemplate(template)
+
+	if err != nil {
+		info := "Validation failed"
+		uc.log.Info(ctx, header+info, "err", err.Error())
+		return fmt.Errorf(header+info+": %w", err)
+	}
+
+	if _, err := callerAccess(ctx, uc.boardRepo, uc.workspaceRepo, uc.log, header, template.BoardID, true); err != nil {
+		return err
+	}
+
+	template.ID = uuid.New()
+	template.CreatedAt = time.Now()
+	template.UpdatedAt = template.CreatedAt
+
+	uc.log.Info(ctx, header+"Making request to card template repo (CreateCardTemplate)", "template", template)
+
+	err = uc.templateRepo.CreateCardTemplate(ctx, template)
+
+	if errors.Is(err, repository.ErrCardTemplateExists) {
+		info := "Template name taken"
+		uc.log.Info(ctx, header+info, "name", template.Name)
+		return fmt.Errorf(header+info+": %w", err)
+	}
+
+	if err != nil {
+		info := "Failed to create card template"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return fmt.Errorf(header+info+": %w", ErrCreateCardTemplate)
+	}
+
+	uc.log.Info(ctx, header+"Card template successfully created")
+
+	return nil
+}
+
+func validateCardTemplate(template *entity.CardTemplate) error {
+	template.Name = strings.TrimSpace(template.Name)
+
+	if template.Name == "" {
+		return repository.ErrCardTemplateNoName
+	}
+
+	seen := make(map[string]bool, len(template.Labels))
+	for _, label := range template.Labels {
+		if label == "" || len(label) > maxLabelLength || seen[label] {
+			return repository.ErrCardTemplateInvalid
+		}
+		seen[label] = true
+	}
+
+	for _, checklist := range template.Checklists {
+		if strings.TrimSpace(checklist.Title) == "" || len(checklist.Items) == 0 {
+			return repository.ErrCardTemplateInvalid
+		}
+
+		for _, item := range checklist.Items {
+			if strings.TrimSpace(item) == "" {
+				return repository.ErrCardTemplateInvalid
+			}
+		}
+	}
+
+	return nil
+}
+
+func (uc *cardTemplateUseCase) GetCardTemplateByID(ctx context.Context, id uuid.UUID) (*entity.CardTemplate, error) {
+	header := "GetCardTemplateByID: "
+
+	uc.log.Info(ctx, header+"Usecase called", "id", id)
+
+	template, err := uc.template(ctx, header, id, false, ErrGetCardTemplateByID)
+	if err != nil {
+		return nil, err
+	}
+
+	uc.log.Info(ctx, header+"Got card template", "template", template)
+
+	return template, nil
+}
+
+// template gets the template if the caller can see its board, or change it
+// with write set.
+func (uc *cardTemplateUseCase) template(ctx context.Context, header string, id uuid.UUID, write bool, failed error) (*entity.CardTemplate, error) {
+	uc.log.Info(ctx, header+"Making request to card template repo (GetCardTemplateByID)", "id", id)
+
+	template, err := uc.templateRepo.GetCardTemplateByID(ctx, id)
+
+	if errors.Is(err, repository.ErrCardTemplateNotFound) {
+		info := "Card template not found"
+		uc.log.Info(ctx, header+info, "id", id)
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	if err != nil {
+		info := "Failed to get card template by id"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", failed)
+	}
+
+	if _, err := callerAccess(ctx, uc.boardRepo, uc.workspaceRepo, uc.log, header, template.BoardID, write); err != nil {
+		return nil, err
+	}
+
+	return template, nil
+}
+
+func (uc *cardTemplateUseCase) GetCardTemplatesByBoard(ctx context.Context, boardID uuid.UUID) ([]entity.CardTemplate, error) {
+	header := "GetCardTemplatesByBoard: "
+
+	uc.log.Info(ctx, header+"Usecase called", "boardID", boardID)
+
+	if _, err := callerAccess(ctx, uc.boardRepo, uc.workspaceRepo, uc.log, header, boardID, false); err != nil {
+		return nil, err
+	}
+
+	uc.log.Info(ctx, header+"Making request to card template repo (GetCardTemplatesByBoard)", "boardID", boardID)
+
+	templates, err := uc.templateRepo.GetCardTemplatesByBoard(ctx, boardID)
+
+	if err != nil {
+		info := "Failed to get card templates of board"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", ErrGetCardTemplates)
+	}
+
+	uc.log.Info(ctx, header+"Got card templates", "count", len(templates))
+
+	return templates, nil
+}
+
+func (uc *cardTemplateUseCase) UpdateCardTemplate(ctx context.Context, template *entity.CardTemplate) error {
+	header := "UpdateCardTemplate: "
+
+	uc.log.Info(ctx, header+"Usecase called; Validating card template", "template", template)
+
+	err := validateCardTemplate(template)
+
+	if err != nil {
+		info := "Validation failed"
+		uc.log.Info(ctx, header+info, "err", err.Error())
+		return fmt.Errorf(header+info+": %w", err)
+	}
+
+	stored, err := uc.template(ctx, header, template.ID, true, ErrUpdateCardTemplate)
+	if err != nil {
+		return err
+	}
+
+	template.BoardID = stored.BoardID
+	template.CreatedBy = stored.CreatedBy
+	template.CreatedAt = stored.CreatedAt
+	template.UpdatedAt = time.Now()
+
+	uc.log.Info(ctx, header+"Making request to card template repo (UpdateCardTemplate)", "template", template)
+
+	err = uc.templateRepo.UpdateCardTemplate(ctx, template)
+
+	if errors.Is(err, repository.ErrCardTemplateExists) || errors.Is(err, repository.ErrCardTemplateNotFound) {
+		info := "Card template was not updated"
+		uc.log.Info(ctx, header+info, "err", err.Error())
+		return fmt.Errorf(header+info+": %w", err)
+	}
+
+	if err != nil {
+		info := "Failed to update card template"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return fmt.Errorf(header+info+": %w", ErrUpdateCardTemplate)
+	}
+
+	uc.log.Info(ctx, header+"Card template successfully updated")
+
+	return nil
+}
+
+func (uc *cardTemplateUseCase) DeleteCardTemplate(ctx context.Context, id uuid.UUID) error {
+	header := "DeleteCardTemplate: "
+
+	uc.log.Info(ctx, header+"Usecase called", "id", id)
+
+	if _, err := uc.template(ctx, header, id, true, ErrDeleteCardTemplate); err != nil {
+		return err
+	}
+
+	uc.log.Info(ctx, header+"Making request to card template repo (DeleteCardTemplate)", "id", id)
+
+	err := uc.templateRepo.DeleteCardTemplate(ctx, id)
+
+	if errors.Is(err, repository.ErrCardTemplateNotFound) {
+		info := "Card template not found"
+		uc.log.Info(ctx, header+info, "id", id)
+		return fmt.Errorf(header+info+": %w", err)
+	}
+
+	if err != nil {
+		info := "Failed to delete card template"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return fmt.Errorf(header+info+": %w", ErrDeleteCardTemplate)
+	}
+
+	uc.log.Info(ctx, header+"Card template successfully deleted")
+
+	return nil
+}
+
+func (uc *cardTemplateUseCase) CreateCardFromTemplate(ctx context.Context, templateID uuid.UUID, card *entity.Card, username string) error {
+	header := "CreateCardFromTemplate: "
+
+	uc.log.Info(ctx, header+"Usecase called; Making request to card template repo (GetCardTemplateByID)", "templateID", templateID)
+
+	template, err := uc.GetCardTemplateByID(ctx, templateID)
+
+	if err != nil {
+		return fmt.Errorf(header+"Failed to get card template: %w", err)
+	}
+
+	column, err := uc.columnRepo.GetColumnByID(ctx, card.ColumnID)
+
+	if errors.Is(err, repository.ErrColumnNotFound) {
+		info := "Column not found"
+		uc.log.Info(ctx, header+info, "columnID", card.ColumnID)
+		return fmt.Errorf(header+info+": %w", err)
+	}
+
+	if err != nil {
+		info := "Failed to get column of card"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return fmt.Errorf(header+info+": %w", ErrCreateCardByTemplate)
+	}
+
+	if column.BoardID != template.BoardID {
+		info := "Validation failed"
+		uc.log.Info(ctx, header+info, "columnBoardID", column.BoardID, "templateBoardID", template.BoardID)
+		return fmt.Errorf(header+info+": %w", repository.ErrCardTemplateBoard)
+	}
+
+	board, err := uc.boardRepo.GetBoardByID(ctx, template.BoardID)
+
+	if err != nil {
+		info := "Failed to get board of template"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return fmt.Errorf(header+info+": %w", ErrCreateCardByTemplate)
+	}
+
+	if username == "" {
+		username = card.UserID.String()
+	}
+
+	applyCardTemplate(template, card, templateValues{
+		user:        username,
+		board:       board.Title,
+		title:       card.Title,
+		description: card.Description,
+		date:        time.Now(),
+	})
+
+	uc.log.Info(ctx, header+"Applied template; Making request to todo usecase (CreateCard)", "card", card)
+
+	err = uc.todo.CreateCard(ctx, card)
+
+	if err != nil {
+		return fmt.Errorf(header+"Failed to create card: %w", err)
+	}
+
+	return nil
+}
+
+// templateValues are what the placeholders of a template are filled in
+// with. title and description are those the card was asked for.
+type templateValues struct {
+	user        string
+	board       string
+	title       string
+	description string
+	date        time.Time
+}
+
+func (v templateValues) replacer() *strings.Replacer {
+	return strings.NewReplacer(
+		"{{date}}", v.date.Format(templateDateLayout),
+		"{{user}}", v.user,
+		"{{board}}", v.board,
+		"{{title}}", v.title,
+		"{{description}}", v.description,
+	)
+}
+
+// applyCardTemplate gives the card the title, description, labels and
+// checklists of the template. A title or description the card was asked
+// for goes in the {{title}} or {{description}} placeholder, and replaces
+// the one of the template if it has none. Checklists are appended to the
+// description as Markdown task lists.
+func applyCardTemplate(template *entity.CardTemplate, card *entity.Card, values templateValues) {
+	r := values.replacer()
+
+	card.Title = strings.TrimSpace(fillTemplate(r, template.TitlePattern, "{{title}}", card.Title))
+	card.Description = fillTemplate(r, template.Description, "{{description}}", card.Description)
+
+	for _, checklist := range template.Checklists {
+		var b strings.Builder
+
+		b.WriteString("### " + r.Replace(checklist.Title) + "\n\n")
+		for _, item := range checklist.Items {
+			b.WriteString("- [ ] " + r.Replace(item) + "\n")
+		}
+
+		if card.Description != "" {
+			card.Description = strings.TrimRight(card.Description, "\n") + "\n\n"
+		}
+		card.Description += strings.TrimRight(b.String(), "\n")
+	}
+
+	labels := append([]string(nil), template.Labels...)
+	for _, label := range card.Labels {
+		if !slices.Contains(labels, label) {
+			labels = append(labels, label)
+		}
+	}
+	card.Labels = labels
+}
+
+// fillTemplate fills the placeholders of text in, unless the card was
+// asked for a value of its own and text has no placeholder for it.
+func fillTemplate(r *strings.Replacer, text, placeholder, own string) string {
+	if text == "" || (own != "" && !strings.Contains(text, placeholder)) {
+		return own
+	}
+
+	return r.Replace(text)
+}
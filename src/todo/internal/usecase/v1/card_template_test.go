@@ -0,0 +1,587 @@
+package v1_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+	log "todo/internal/adapter/logger"
+	"todo/internal/entity"
+	"todo/internal/repository"
+	"todo/internal/testdata"
+	"todo/internal/usecase"
+	v1 "todo/internal/usecase/v1"
+	"todo/mocks"
+
+	"github.com/google/uuid"
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/runner"
+	"github.com/stretchr/testify/mock"
+)
+
+type templateMocks struct {
+	templateRepo  *mocks.CardTemplateRepository
+	boardRepo     *mocks.BoardRepository
+	columnRepo    *mocks.ColumnRepository
+	workspaceRepo *mocks.WorkspaceRepository
+	todo          *mocks.TodoUseCase
+}
+
+func newTemplateMocks() templateMocks {
+	return templateMocks{
+		templateRepo:  new(mocks.CardTemplateRepository),
+		boardRepo:     new(mocks.BoardRepository),
+		columnRepo:    new(mocks.ColumnRepository),
+		workspaceRepo: new(mocks.WorkspaceRepository),
+		todo:          new(mocks.TodoUseCase),
+	}
+}
+
+func (m templateMocks) useCase() usecase.CardTemplateUseCase {
+	return v1.NewCardTemplateUseCase(m.templateRepo, m.boardRepo, m.columnRepo, m.workspaceRepo, m.todo, log.NewEmptyLogger())
+}
+
+func (m templateMocks) member(board entity.Board, userID uuid.UUID, role string) {
+	mockMember(m.boardRepo, m.workspaceRepo, board, userID, role)
+}
+
+func (m templateMocks) assert(t *testing.T) {
+	m.templateRepo.AssertExpectations(t)
+	m.boardRepo.AssertExpectations(t)
+	m.columnRepo.AssertExpectations(t)
+	m.workspaceRepo.AssertExpectations(t)
+	m.todo.AssertExpectations(t)
+}
+
+func TestCreateCardTemplate(t *testing.T) {
+	runner.Run(t, "TestCreateCardTemplate", func(pt provider.T) {
+		mom := &testdata.ObjectMother{}
+
+		userID := mom.GetUUID(1)
+		board := entity.Board{ID: mom.GetUUID(0), WorkspaceID: mom.GetUUID(2)}
+		boardID := board.ID
+		ctx := usecase.WithCaller(context.Background(), usecase.Caller{UserID: userID})
+		checklists := []entity.Checklist{{Title: "Triage", Items: []string{"Reproduce", "Find owner"}}}
+
+		tests := []struct {
+			name      string
+			template  entity.CardTemplate
+			mockSetup func(m templateMocks)
+			wantErr   bool
+			err       error
+		}{
+			{
+				name:     "positive",
+				template: entity.CardTemplate{BoardID: boardID, Name: " Bug ", TitlePattern: "[Bug] {{title}}", Labels: []string{"bug"}, Checklists: checklists},
+				mockSetup: func(m templateMocks) {
+					m.member(board, userID, entity.RoleMember)
+					m.templateRepo.On("CreateCardTemplate", mock.Anything, mock.MatchedBy(func(t *entity.CardTemplate) bool {
+						return t.ID != uuid.Nil && t.Name == "Bug" && !t.CreatedAt.IsZero()
+					})).Return(nil)
+				},
+				wantErr: false,
+			},
+			{
+				name:      "no name",
+				template:  entity.CardTemplate{BoardID: boardID, Name: "  "},
+				mockSetup: func(m templateMocks) {},
+				wantErr:   true,
+				err:       repository.ErrCardTemplateNoName,
+			},
+			{
+				name:      "repeated label",
+				template:  entity.CardTemplate{BoardID: boardID, Name: "Bug", Labels: []string{"bug", "bug"}},
+				mockSetup: func(m templateMocks) {},
+				wantErr:   true,
+				err:       repository.ErrCardTemplateInvalid,
+			},
+			{
+				name:      "empty checklist",
+				template:  entity.CardTemplate{BoardID: boardID, Name: "Bug", Checklists: []entity.Checklist{{Title: "Triage"}}},
+				mockSetup: func(m templateMocks) {},
+				wantErr:   true,
+				err:       repository.ErrCardTemplateInvalid,
+			},
+			{
+				name:     "no board",
+				template: entity.CardTemplate{BoardID: boardID, Name: "Bug"},
+				mockSetup: func(m templateMocks) {
+					m.boardRepo.On("GetBoardByID", mock.Anything, boardID).Return(nil, repository.ErrBoardNotFound)
+				},
+				wantErr: true,
+				err:     repository.ErrBoardNotFound,
+			},
+			{
+				name:     "not a member",
+				template: entity.CardTemplate{BoardID: boardID, Name: "Bug"},
+				mockSetup: func(m templateMocks) {
+					m.member(board, userID, "")
+				},
+				wantErr: true,
+				err:     repository.ErrBoardAccess,
+			},
+			{
+				name:     "viewer",
+				template: entity.CardTemplate{BoardID: boardID, Name: "Bug"},
+				mockSetup: func(m templateMocks) {
+					m.member(board, userID, entity.RoleViewer)
+				},
+				wantErr: true,
+				err:     repository.ErrBoardAccess,
+			},
+			{
+				name:     "name taken",
+				template: entity.CardTemplate{BoardID: boardID, Name: "Bug"},
+				mockSetup: func(m templateMocks) {
+					m.member(board, userID, entity.RoleMember)
+					m.templateRepo.On("CreateCardTemplate", mock.Anything, mock.Anything).Return(repository.ErrCardTemplateExists)
+				},
+				wantErr: true,
+				err:     repository.ErrCardTemplateExists,
+			},
+			{
+				name:     "negative",
+				template: entity.CardTemplate{BoardID: boardID, Name: "Bug"},
+				mockSetup: func(m templateMocks) {
+					m.member(board, userID, entity.RoleMember)
+					m.templateRepo.On("CreateCardTemplate", mock.Anything, mock.Anything).Return(errors.New(""))
+				},
+				wantErr: true,
+				err:     v1.ErrCreateCardTemplate,
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				t.Parallel()
+
+				runner.Run(t, tt.name, func(pt provider.T) {
+					m := newTemplateMocks()
+					uc := m.useCase()
+
+					tt.mockSetup(m)
+
+					pt.WithNewStep("Call CreateCardTemplate", func(sCtx provider.StepCtx) {
+						template := tt.template
+						err := uc.CreateCardTemplate(ctx, &template)
+
+						if tt.wantErr {
+							sCtx.Assert().Error(err, "Expected error")
+							sCtx.Assert().ErrorIs(err, tt.err)
+						} else {
+							sCtx.Assert().NoError(err, "Expected no error")
+						}
+
+						m.assert(t)
+					})
+				})
+			})
+		}
+	})
+}
+
+func TestGetCardTemplatesByBoard(t *testing.T) {
+	runner.Run(t, "TestGetCardTemplatesByBoard", func(pt provider.T) {
+		mom := &testdata.ObjectMother{}
+
+		userID := mom.GetUUID(0)
+		board := entity.Board{ID: mom.GetUUID(1), WorkspaceID: mom.GetUUID(2)}
+		ctx := usecase.WithCaller(context.Background(), usecase.Caller{UserID: userID})
+
+		templates := []entity.CardTemplate{{ID: mom.GetUUID(3), BoardID: board.ID, Name: "Bug"}}
+
+		tests := []struct {
+			name      string
+			mockSetup func(m templateMocks)
+			wantErr   bool
+			err       error
+		}{
+			{
+				name: "viewer",
+				mockSetup: func(m templateMocks) {
+					m.member(board, userID, entity.RoleViewer)
+					m.templateRepo.On("GetCardTemplatesByBoard", mock.Anything, board.ID).Return(templates, nil)
+				},
+				wantErr: false,
+			},
+			{
+				name: "not a member",
+				mockSetup: func(m templateMocks) {
+					m.member(board, userID, "")
+				},
+				wantErr: true,
+				err:     repository.ErrBoardAccess,
+			},
+			{
+				name: "negative",
+				mockSetup: func(m templateMocks) {
+					m.member(board, userID, entity.RoleViewer)
+					m.templateRepo.On("GetCardTemplatesByBoard", mock.Anything, board.ID).Return(nil, errors.New(""))
+				},
+				wantErr: true,
+				err:     v1.ErrGetCardTemplates,
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				t.Parallel()
+
+				runner.Run(t, tt.name, func(pt provider.T) {
+					m := newTemplateMocks()
+					uc := m.useCase()
+
+					tt.mockSetup(m)
+
+					pt.WithNewStep("Call GetCardTemplatesByBoard", func(sCtx provider.StepCtx) {
+						got, err := uc.GetCardTemplatesByBoard(ctx, board.ID)
+
+						if tt.wantErr {
+							sCtx.Assert().Error(err, "Expected error")
+							sCtx.Assert().ErrorIs(err, tt.err)
+						} else {
+							sCtx.Assert().NoError(err, "Expected no error")
+							sCtx.Assert().Equal(templates, got)
+						}
+
+						m.assert(t)
+					})
+				})
+			})
+		}
+	})
+}
+
+func TestUpdateCardTemplate(t *testing.T) {
+	runner.Run(t, "TestUpdateCardTemplate", func(pt provider.T) {
+		mom := &testdata.ObjectMother{}
+
+		userID := mom.GetUUID(0)
+		board := entity.Board{ID: mom.GetUUID(1), WorkspaceID: mom.GetUUID(2)}
+		templateID := mom.GetUUID(3)
+		ctx := usecase.WithCaller(context.Background(), usecase.Caller{UserID: userID})
+
+		stored := &entity.CardTemplate{ID: templateID, BoardID: board.ID, Name: "Bug", CreatedBy: userID}
+
+		tests := []struct {
+			name      string
+			mockSetup func(m templateMocks)
+			wantErr   bool
+			err       error
+		}{
+			{
+				name: "positive",
+				mockSetup: func(m templateMocks) {
+					m.templateRepo.On("GetCardTemplateByID", mock.Anything, templateID).Return(stored, nil)
+					m.member(board, userID, entity.RoleMember)
+					m.templateRepo.On("UpdateCardTemplate", mock.Anything, mock.MatchedBy(func(t *entity.CardTemplate) bool {
+						return t.ID == templateID && t.BoardID == board.ID && t.Name == "Defect"
+					})).Return(nil)
+				},
+				wantErr: false,
+			},
+			{
+				name: "not found",
+				mockSetup: func(m templateMocks) {
+					m.templateRepo.On("GetCardTemplateByID", mock.Anything, templateID).Return(nil, repository.ErrCardTemplateNotFound)
+				},
+				wantErr: true,
+				err:     repository.ErrCardTemplateNotFound,
+			},
+			{
+				name: "not a member",
+				mockSetup: func(m templateMocks) {
+					m.templateRepo.On("GetCardTemplateByID", mock.Anything, templateID).Return(stored, nil)
+					m.member(board, userID, "")
+				},
+				wantErr: true,
+				err:     repository.ErrBoardAccess,
+			},
+			{
+				name: "viewer",
+				mockSetup: func(m templateMocks) {
+					m.templateRepo.On("GetCardTemplateByID", mock.Anything, templateID).Return(stored, nil)
+					m.member(board, userID, entity.RoleViewer)
+				},
+				wantErr: true,
+				err:     repository.ErrBoardAccess,
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				t.Parallel()
+
+				runner.Run(t, tt.name, func(pt provider.T) {
+					m := newTemplateMocks()
+					uc := m.useCase()
+
+					tt.mockSetup(m)
+
+					pt.WithNewStep("Call UpdateCardTemplate", func(sCtx provider.StepCtx) {
+						err := uc.UpdateCardTemplate(ctx, &entity.CardTemplate{ID: templateID, Name: "Defect"})
+
+						if tt.wantErr {
+							sCtx.Assert().Error(err, "Expected error")
+							sCtx.Assert().ErrorIs(err, tt.err)
+						} else {
+							sCtx.Assert().NoError(err, "Expected no error")
+						}
+
+						m.assert(t)
+					})
+				})
+			})
+		}
+	})
+}
+
+func TestDeleteCardTemplate(t *testing.T) {
+	runner.Run(t, "TestDeleteCardTemplate", func(pt provider.T) {
+		mom := &testdata.ObjectMother{}
+
+		userID := mom.GetUUID(0)
+		board := entity.Board{ID: mom.GetUUID(1), WorkspaceID: mom.GetUUID(2)}
+		templateID := mom.GetUUID(3)
+		ctx := usecase.WithCaller(context.Background(), usecase.Caller{UserID: userID})
+
+		stored := &entity.CardTemplate{ID: templateID, BoardID: board.ID, Name: "Bug"}
+
+		tests := []struct {
+			name      string
+			mockSetup func(m templateMocks)
+			wantErr   bool
+			err       error
+		}{
+			{
+				name: "positive",
+				mockSetup: func(m templateMocks) {
+					m.templateRepo.On("GetCardTemplateByID", mock.Anything, templateID).Return(stored, nil)
+					m.member(board, userID, entity.RoleMember)
+					m.templateRepo.On("DeleteCardTemplate", mock.Anything, templateID).Return(nil)
+				},
+				wantErr: false,
+			},
+			{
+				name: "not found",
+				mockSetup: func(m templateMocks) {
+					m.templateRepo.On("GetCardTemplateByID", mock.Anything, templateID).Return(nil, repository.ErrCardTemplateNotFound)
+				},
+				wantErr: true,
+				err:     repository.ErrCardTemplateNotFound,
+			},
+			{
+				name: "not a member",
+				mockSetup: func(m templateMocks) {
+					m.templateRepo.On("GetCardTemplateByID", mock.Anything, templateID).Return(stored, nil)
+					m.member(board, userID, "")
+				},
+				wantErr: true,
+				err:     repository.ErrBoardAccess,
+			},
+			{
+				name: "viewer",
+				mockSetup: func(m templateMocks) {
+					m.templateRepo.On("GetCardTemplateByID", mock.Anything, templateID).Return(stored, nil)
+					m.member(board, userID, entity.RoleViewer)
+				},
+				wantErr: true,
+				err:     repository.ErrBoardAccess,
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				t.Parallel()
+
+				runner.Run(t, tt.name, func(pt provider.T) {
+					m := newTemplateMocks()
+					uc := m.useCase()
+
+					tt.mockSetup(m)
+
+					pt.WithNewStep("Call DeleteCardTemplate", func(sCtx provider.StepCtx) {
+						err := uc.DeleteCardTemplate(ctx, templateID)
+
+						if tt.wantErr {
+							sCtx.Assert().Error(err, "Expected error")
+							sCtx.Assert().ErrorIs(err, tt.err)
+						} else {
+							sCtx.Assert().NoError(err, "Expected no error")
+						}
+
+						m.assert(t)
+					})
+				})
+			})
+		}
+	})
+}
+
+func TestCreateCardFromTemplate(t *testing.T) {
+	runner.Run(t, "TestCreateCardFromTemplate", func(pt provider.T) {
+		mom := &testdata.ObjectMother{}
+
+		boardID := mom.GetUUID(0)
+		templateID := mom.GetUUID(1)
+		columnID := mom.GetUUID(2)
+		userID := mom.GetUUID(3)
+		ctx := usecase.WithCaller(context.Background(), usecase.Caller{UserID: userID})
+		today := time.Now().Format("2006-01-02")
+
+		template := &entity.CardTemplate{
+			ID:           templateID,
+			BoardID:      boardID,
+			Name:         "Bug",
+			TitlePattern: "[Bug] {{title}}",
+			Description:  "Reported by {{user}} on {{date}}.\n\n{{description}}",
+			Labels:       []string{"bug"},
+			Checklists:   []entity.Checklist{{Title: "Triage", Items: []string{"Reproduce", "Find owner"}}},
+		}
+		fixed := &entity.CardTemplate{
+			ID:           templateID,
+			BoardID:      boardID,
+			Name:         "Standup",
+			TitlePattern: "Standup {{date}} on {{board}}",
+			Description:  "Notes",
+		}
+		board := entity.Board{ID: boardID, WorkspaceID: mom.GetUUID(5), Title: "Backend"}
+		column := &entity.Column{ID: columnID, BoardID: boardID}
+
+		tests := []struct {
+			name      string
+			card      entity.Card
+			username  string
+			mockSetup func(m templateMocks)
+			wantErr   bool
+			err       error
+			wantCard  entity.Card
+		}{
+			{
+				name:     "positive",
+				card:     entity.Card{UserID: userID, ColumnID: columnID, Title: "Login fails", Description: "Steps below.", Labels: []string{"bug", "auth"}},
+				username: "alice",
+				mockSetup: func(m templateMocks) {
+					m.templateRepo.On("GetCardTemplateByID", mock.Anything, templateID).Return(template, nil)
+					m.member(board, userID, entity.RoleMember)
+					m.columnRepo.On("GetColumnByID", mock.Anything, columnID).Return(column, nil)
+					m.todo.On("CreateCard", mock.Anything, mock.Anything).Return(nil)
+				},
+				wantErr: false,
+				wantCard: entity.Card{
+					UserID:      userID,
+					ColumnID:    columnID,
+					Title:       "[Bug] Login fails",
+					Description: "Reported by alice on " + today + ".\n\nSteps below.\n\n### Triage\n\n- [ ] Reproduce\n- [ ] Find owner",
+					Labels:      []string{"bug", "auth"},
+				},
+			},
+			{
+				name: "own title over fixed pattern",
+				card: entity.Card{UserID: userID, ColumnID: columnID, Title: "Retro"},
+				mockSetup: func(m templateMocks) {
+					m.templateRepo.On("GetCardTemplateByID", mock.Anything, templateID).Return(fixed, nil)
+					m.member(board, userID, entity.RoleMember)
+					m.columnRepo.On("GetColumnByID", mock.Anything, columnID).Return(column, nil)
+					m.todo.On("CreateCard", mock.Anything, mock.Anything).Return(nil)
+				},
+				wantErr: false,
+				wantCard: entity.Card{
+					UserID:      userID,
+					ColumnID:    columnID,
+					Title:       "Retro",
+					Description: "Notes",
+					Labels:      nil,
+				},
+			},
+			{
+				name: "fixed pattern",
+				card: entity.Card{UserID: userID, ColumnID: columnID},
+				mockSetup: func(m templateMocks) {
+					m.templateRepo.On("GetCardTemplateByID", mock.Anything, templateID).Return(fixed, nil)
+					m.member(board, userID, entity.RoleMember)
+					m.columnRepo.On("GetColumnByID", mock.Anything, columnID).Return(column, nil)
+					m.todo.On("CreateCard", mock.Anything, mock.Anything).Return(nil)
+				},
+				wantErr: false,
+				wantCard: entity.Card{
+					UserID:      userID,
+					ColumnID:    columnID,
+					Title:       "Standup " + today + " on Backend",
+					Description: "Notes",
+					Labels:      nil,
+				},
+			},
+			{
+				name: "template not found",
+				card: entity.Card{UserID: userID, ColumnID: columnID},
+				mockSetup: func(m templateMocks) {
+					m.templateRepo.On("GetCardTemplateByID", mock.Anything, templateID).Return(nil, repository.ErrCardTemplateNotFound)
+				},
+				wantErr: true,
+				err:     repository.ErrCardTemplateNotFound,
+			},
+			{
+				name: "not a member",
+				card: entity.Card{UserID: userID, ColumnID: columnID},
+				mockSetup: func(m templateMocks) {
+					m.templateRepo.On("GetCardTemplateByID", mock.Anything, templateID).Return(template, nil)
+					m.member(board, userID, "")
+				},
+				wantErr: true,
+				err:     repository.ErrBoardAccess,
+			},
+			{
+				name: "column of another board",
+				card: entity.Card{UserID: userID, ColumnID: columnID},
+				mockSetup: func(m templateMocks) {
+					m.templateRepo.On("GetCardTemplateByID", mock.Anything, templateID).Return(template, nil)
+					m.member(board, userID, entity.RoleMember)
+					m.columnRepo.On("GetColumnByID", mock.Anything, columnID).Return(&entity.Column{ID: columnID, BoardID: mom.GetUUID(4)}, nil)
+				},
+				wantErr: true,
+				err:     repository.ErrCardTemplateBoard,
+			},
+			{
+				name: "negative",
+				card: entity.Card{UserID: userID, ColumnID: columnID, Title: "Login fails"},
+				mockSetup: func(m templateMocks) {
+					m.templateRepo.On("GetCardTemplateByID", mock.Anything, templateID).Return(template, nil)
+					m.member(board, userID, entity.RoleMember)
+					m.columnRepo.On("GetColumnByID", mock.Anything, columnID).Return(column, nil)
+					m.todo.On("CreateCard", mock.Anything, mock.Anything).Return(v1.ErrCreateCard)
+				},
+				wantErr: true,
+				err:     v1.ErrCreateCard,
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				t.Parallel()
+
+				runner.Run(t, tt.name, func(pt provider.T) {
+					m := newTemplateMocks()
+					uc := m.useCase()
+
+					tt.mockSetup(m)
+
+					pt.WithNewStep("Call CreateCardFromTemplate", func(sCtx provider.StepCtx) {
+						card := tt.card
+						err := uc.CreateCardFromTemplate(ctx, templateID, &card, tt.username)
+
+						if tt.wantErr {
+							sCtx.Assert().Error(err, "Expected error")
+							sCtx.Assert().ErrorIs(err, tt.err)
+						} else {
+							sCtx.Assert().NoError(err, "Expected no error")
+							sCtx.Assert().Equal(tt.wantCard, card)
+						}
+
+						m.assert(t)
+					})
+				})
+			})
+		}
+	})
+}
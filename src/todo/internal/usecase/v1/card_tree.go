@@ -0,0 +1,108 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+	"todo/internal/entity"
+	"todo/internal/repository"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrSetCardParent    = errors.New("failed to set card parent")
+	ErrGetCardAncestors = errors.New("failed to get card ancestors")
+)
+
+func (uc *todoUseCase) GetCardAncestors(ctx context.Context, id uuid.UUID) ([]entity.Card, error) {
+	header := "GetCardAncestors: "
+
+	uc.log.Info(ctx, header+"Usecase called; Making request to card repo (GetCardAncestors)", "id", id)
+
+	cards, err := uc.cardRepo.GetCardAncestors(ctx, id)
+
+	if err != nil {
+		info := "Failed to get card ancestors"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", ErrGetCardAncestors)
+	}
+
+	uc.log.Info(ctx, header+"Successfully got card ancestors", "count", len(cards))
+
+	return cards, nil
+}
+
+// SetCardParent puts a card under ParentID, or back to the top level when it
+// is nil. A card cannot go under itself or one of its descendants.
+func (uc *todoUseCase) SetCardParent(ctx context.Context, card *entity.Card) error {
+	header := "SetCardParent: "
+
+	uc.log.Info(ctx, header+"Usecase called; Checking card parent", "id", card.ID, "parentID", card.ParentID)
+
+	err := uc.tx.WithinTx(ctx, func(ctx context.Context) error {
+		err := uc.checkCardParent(ctx, card.ID, card.ParentID)
+		if err != nil {
+			return err
+		}
+
+		card.UpdatedAt = time.Now()
+
+		uc.log.Info(ctx, header+"Making request to card repo (SetCardParent)", "card", card)
+
+		return uc.cardRepo.SetCardParent(ctx, card)
+	})
+
+	if errors.Is(err, repository.ErrCardParentCycle) || errors.Is(err, repository.ErrCardTooDeep) {
+		info := "Validation failed"
+		uc.log.Info(ctx, header+info, "err", err.Error())
+		return fmt.Errorf(header+info+": %w", err)
+	}
+
+	if errors.Is(err, repository.ErrVersionMismatch) {
+		info := "Card was changed concurrently"
+		uc.log.Info(ctx, header+info, "id", card.ID, "version", card.Version)
+		return fmt.Errorf(header+info+": %w", err)
+	}
+
+	if err != nil {
+		info := "Failed to set card parent"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return fmt.Errorf(header+info+": %w", ErrSetCardParent)
+	}
+
+	uc.log.Info(ctx, header+"Successfully set card parent")
+
+	return nil
+}
+
+// checkCardParent makes sure that a card can go under parentID: the parent
+// is not the card or one of its descendants, and the tree stays within
+// repository.MaxCardDepth levels. New cards have no id yet and no subtree.
+func (uc *todoUseCase) checkCardParent(ctx context.Context, id, parentID uuid.UUID) error {
+	if parentID == uuid.Nil {
+		return nil
+	}
+
+	if parentID == id {
+		return repository.ErrCardParentCycle
+	}
+
+	ancestors, err := uc.cardRepo.GetCardAncestors(ctx, parentID)
+	if err != nil {
+		return err
+	}
+
+	for _, ancestor := range ancestors {
+		if ancestor.ID == id {
+			return repository.ErrCardParentCycle
+		}
+	}
+
+	if len(ancestors)+2 > repository.MaxCardDepth {
+		return repository.ErrCardTooDeep
+	}
+
+	return nil
+}
@@ -0,0 +1,146 @@
+package v1_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	log "todo/internal/adapter/logger"
+	"todo/internal/adapter/repository/memory"
+	"todo/internal/entity"
+	"todo/internal/repository"
+	"todo/internal/testdata"
+	v1 "todo/internal/usecase/v1"
+	"todo/mocks"
+
+	"github.com/google/uuid"
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/runner"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestSetCardParent(t *testing.T) {
+	runner.Run(t, "TestSetCardParent", func(pt provider.T) {
+		mom := &testdata.ObjectMother{}
+
+		cardID := mom.GetUUID(0)
+		parentID := mom.GetUUID(1)
+		root := entity.Card{ID: mom.GetUUID(2)}
+
+		deep := make([]entity.Card, repository.MaxCardDepth-1)
+		for i := range deep {
+			deep[i] = entity.Card{ID: uuid.New()}
+		}
+
+		tests := []struct {
+			name           string
+			parentID       uuid.UUID
+			mockSetup      func(mockCardRepo *mocks.CardRepository)
+			wantErr        bool
+			err            error
+			wantRolledBack int
+		}{
+			{
+				name:     "positive",
+				parentID: parentID,
+				mockSetup: func(mockCardRepo *mocks.CardRepository) {
+					mockCardRepo.On("GetCardAncestors", mock.Anything, parentID).Return([]entity.Card{root}, nil)
+					mockCardRepo.On("SetCardParent", mock.Anything, mock.MatchedBy(func(c *entity.Card) bool {
+						return c.ID == cardID && c.ParentID == parentID
+					})).Return(nil)
+				},
+				wantErr: false,
+			},
+			{
+				name:     "to top level",
+				parentID: uuid.Nil,
+				mockSetup: func(mockCardRepo *mocks.CardRepository) {
+					mockCardRepo.On("SetCardParent", mock.Anything, mock.Anything).Return(nil)
+				},
+				wantErr: false,
+			},
+			{
+				name:           "own parent",
+				parentID:       cardID,
+				mockSetup:      func(mockCardRepo *mocks.CardRepository) {},
+				wantErr:        true,
+				err:            repository.ErrCardParentCycle,
+				wantRolledBack: 1,
+			},
+			{
+				name:     "under a descendant",
+				parentID: parentID,
+				mockSetup: func(mockCardRepo *mocks.CardRepository) {
+					mockCardRepo.On("GetCardAncestors", mock.Anything, parentID).
+						Return([]entity.Card{{ID: cardID}, root}, nil)
+				},
+				wantErr:        true,
+				err:            repository.ErrCardParentCycle,
+				wantRolledBack: 1,
+			},
+			{
+				name:     "too deep",
+				parentID: parentID,
+				mockSetup: func(mockCardRepo *mocks.CardRepository) {
+					mockCardRepo.On("GetCardAncestors", mock.Anything, parentID).Return(deep, nil)
+				},
+				wantErr:        true,
+				err:            repository.ErrCardTooDeep,
+				wantRolledBack: 1,
+			},
+			{
+				name:     "version mismatch",
+				parentID: parentID,
+				mockSetup: func(mockCardRepo *mocks.CardRepository) {
+					mockCardRepo.On("GetCardAncestors", mock.Anything, parentID).Return([]entity.Card{root}, nil)
+					mockCardRepo.On("SetCardParent", mock.Anything, mock.Anything).Return(repository.ErrVersionMismatch)
+				},
+				wantErr:        true,
+				err:            repository.ErrVersionMismatch,
+				wantRolledBack: 1,
+			},
+			{
+				name:     "negative",
+				parentID: parentID,
+				mockSetup: func(mockCardRepo *mocks.CardRepository) {
+					mockCardRepo.On("GetCardAncestors", mock.Anything, parentID).Return(nil, errors.New(""))
+				},
+				wantErr:        true,
+				err:            v1.ErrSetCardParent,
+				wantRolledBack: 1,
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				t.Parallel()
+
+				runner.Run(t, tt.name, func(pt provider.T) {
+					mockCardRepo := new(mocks.CardRepository)
+					txManager := memory.NewTxManager()
+					logger := log.NewEmptyLogger()
+
+					uc := v1.NewTodoUseCase(new(mocks.BoardRepository), new(mocks.ColumnRepository), new(mocks.SwimlaneRepository),
+						mockCardRepo, new(mocks.WorkspaceRepository), txManager, logger)
+
+					tt.mockSetup(mockCardRepo)
+
+					pt.WithNewStep("Call SetCardParent", func(sCtx provider.StepCtx) {
+						card := &entity.Card{ID: cardID, ParentID: tt.parentID, Version: 1}
+						err := uc.SetCardParent(context.Background(), card)
+
+						if tt.wantErr {
+							sCtx.Assert().Error(err, "Expected error")
+							sCtx.Assert().ErrorIs(err, tt.err)
+						} else {
+							sCtx.Assert().NoError(err, "Expected no error")
+						}
+
+						sCtx.Assert().Equal(tt.wantRolledBack, txManager.RolledBack())
+
+						mockCardRepo.AssertExpectations(t)
+					})
+				})
+			})
+		}
+	})
+}
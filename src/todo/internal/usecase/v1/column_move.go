@@ -0,0 +1,254 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+	"todo/internal/entity"
+	"todo/internal/repository"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrMoveColumn  = errors.New("failed to move column")
+	ErrMergeBoards = errors.New("failed to merge boards")
+)
+
+// boardColumnsPage is the page size boardColumns reads columns with.
+const boardColumnsPage = 100
+
+func (uc *todoUseCase) MoveColumn(ctx context.Context, userID, id, boardID uuid.UUID, version int) (*entity.Column, error) {
+	header := "MoveColumn: "
+
+	uc.log.Info(ctx, header+"Usecase called; Making request to column repo (GetColumnByID)", "userID", userID, "id", id, "boardID", boardID, "version", version)
+
+	var column *entity.Column
+
+	err := uc.tx.WithinTx(ctx, func(ctx context.Context) error {
+		var err error
+		column, err = uc.columnRepo.GetColumnByID(ctx, id)
+
+		if err != nil {
+			info := "Column not found"
+			uc.log.Info(ctx, header+info, "id", id, "err", err.Error())
+			return fmt.Errorf(header+info+": %w", repository.ErrColumnNotFound)
+		}
+
+		for _, id := range []uuid.UUID{column.BoardID, boardID} {
+			if _, err := uc.userBoard(ctx, header, userID, id); err != nil {
+				return err
+			}
+		}
+
+		if column.BoardID == boardID {
+			info := "Validation failed"
+			uc.log.Info(ctx, header+info, "err", repository.ErrColumnSameBoard.Error())
+			return fmt.Errorf(header+info+": %w", repository.ErrColumnSameBoard)
+		}
+
+		columns, err := boardColumns(ctx, uc.columnRepo, boardID)
+
+		if err != nil {
+			info := "Failed to get columns of the board"
+			uc.log.Error(ctx, header+info, "err", err.Error())
+			return fmt.Errorf(header+info+": %w", ErrMoveColumn)
+		}
+
+		column.BoardID = boardID
+		column.Position = nextColumnPosition(columns)
+		column.Version = version
+		column.UpdatedAt = time.Now()
+
+		uc.log.Info(ctx, header+"Making request to column repo (MoveColumn)", "column", column)
+
+		err = uc.columnRepo.MoveColumn(ctx, column)
+
+		if errors.Is(err, repository.ErrVersionMismatch) {
+			info := "Column was changed concurrently"
+			uc.log.Info(ctx, header+info, "id", id, "version", version)
+			return fmt.Errorf(header+info+": %w", err)
+		}
+
+		if err != nil {
+			info := "Failed to move column"
+			uc.log.Error(ctx, header+info, "err", err.Error())
+			return fmt.Errorf(header+info+": %w", ErrMoveColumn)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	uc.log.Info(ctx, header+"Column successfully moved", "column", column)
+
+	return column, nil
+}
+
+func (uc *todoUseCase) MergeBoards(ctx context.Context, userID, sourceID, targetID uuid.UUID, strategy string) (*entity.BoardMerge, error) {
+	header := "MergeBoards: "
+
+	uc.log.Info(ctx, header+"Usecase called; Validating merge", "userID", userID, "sourceID", sourceID, "targetID", targetID, "strategy", strategy)
+
+	err := validateMerge(sourceID, targetID, strategy)
+
+	if err != nil {
+		info := "Validation failed"
+		uc.log.Info(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	merge := &entity.BoardMerge{SourceID: sourceID, TargetID: targetID, Strategy: strategy}
+
+	err = uc.tx.WithinTx(ctx, func(ctx context.Context) error {
+		source, err := uc.userBoard(ctx, header, userID, sourceID)
+		if err != nil {
+			return err
+		}
+
+		if _, err := uc.userBoard(ctx, header, userID, targetID); err != nil {
+			return err
+		}
+
+		sources, err := boardColumns(ctx, uc.columnRepo, sourceID)
+
+		if err != nil {
+			info := "Failed to get columns of the source board"
+			uc.log.Error(ctx, header+info, "err", err.Error())
+			return fmt.Errorf(header+info+": %w", ErrMergeBoards)
+		}
+
+		targets, err := boardColumns(ctx, uc.columnRepo, targetID)
+
+		if err != nil {
+			info := "Failed to get columns of the target board"
+			uc.log.Error(ctx, header+info, "err", err.Error())
+			return fmt.Errorf(header+info+": %w", ErrMergeBoards)
+		}
+
+		sort.SliceStable(sources, func(i, j int) bool { return sources[i].Position < sources[j].Position })
+
+		position := nextColumnPosition(targets)
+		now := time.Now()
+
+		for _, column := range sources {
+			match := matchingColumn(targets, column.Title)
+
+			if match != nil && strategy == entity.MergeColumnsCombine {
+				uc.log.Info(ctx, header+"Making request to column repo (MergeColumn)", "column", column, "targetID", match.ID)
+
+				if err := uc.columnRepo.MergeColumn(ctx, &column, match.ID); err != nil {
+					return uc.mergeFailed(ctx, header, err)
+				}
+
+				merge.Combined = append(merge.Combined, column)
+				continue
+			}
+
+			if match != nil && strategy == entity.MergeColumnsRename {
+				column.Title = fmt.Sprintf("%s (%s)", column.Title, source.Title)
+			}
+
+			column.BoardID = targetID
+			column.Position = position
+			column.UpdatedAt = now
+			position++
+
+			uc.log.Info(ctx, header+"Making request to column repo (MoveColumn)", "column", column)
+
+			if err := uc.columnRepo.MoveColumn(ctx, &column); err != nil {
+				return uc.mergeFailed(ctx, header, err)
+			}
+
+			merge.Moved = append(merge.Moved, column)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	uc.log.Info(ctx, header+"Boards successfully merged", "moved", len(merge.Moved), "combined", len(merge.Combined))
+
+	return merge, nil
+}
+
+func validateMerge(sourceID, targetID uuid.UUID, strategy string) error {
+	if sourceID == targetID {
+		return repository.ErrMergeSameBoard
+	}
+
+	switch strategy {
+	case entity.MergeColumnsCombine, entity.MergeColumnsKeep, entity.MergeColumnsRename:
+		return nil
+	}
+
+	return repository.ErrMergeStrategy
+}
+
+func (uc *todoUseCase) mergeFailed(ctx context.Context, header string, err error) error {
+	if errors.Is(err, repository.ErrVersionMismatch) {
+		info := "Column was changed concurrently"
+		uc.log.Info(ctx, header+info, "err", err.Error())
+		return fmt.Errorf(header+info+": %w", err)
+	}
+
+	info := "Failed to move column"
+	uc.log.Error(ctx, header+info, "err", err.Error())
+	return fmt.Errorf(header+info+": %w", ErrMergeBoards)
+}
+
+// boardColumns reads every column of the board, page by page.
+func boardColumns(ctx context.Context, columnRepo repository.ColumnRepository, boardID uuid.UUID) ([]entity.Column, error) {
+	var columns []entity.Column
+
+	page := repository.Page{Limit: boardColumnsPage}
+	for {
+		batch, err := columnRepo.GetColumnsByBoard(ctx, boardID, page)
+		if err != nil {
+			return nil, err
+		}
+
+		columns = append(columns, batch...)
+
+		if len(batch) < page.Limit {
+			return columns, nil
+		}
+
+		last := batch[len(batch)-1]
+		page.After = repository.TimeCursor(last.CreatedAt, last.ID)
+	}
+}
+
+// nextColumnPosition is the position after the last of the columns.
+func nextColumnPosition(columns []entity.Column) float64 {
+	position := 0.0
+	for _, column := range columns {
+		if column.Position+1 > position {
+			position = column.Position + 1
+		}
+	}
+
+	return position
+}
+
+// matchingColumn finds the column titled title, letter case and surrounding
+// spaces aside.
+func matchingColumn(columns []entity.Column, title string) *entity.Column {
+	title = strings.TrimSpace(title)
+	for i := range columns {
+		if strings.EqualFold(strings.TrimSpace(columns[i].Title), title) {
+			return &columns[i]
+		}
+	}
+
+	return nil
+}
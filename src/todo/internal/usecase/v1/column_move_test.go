@@ -0,0 +1,347 @@
+package v1_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	log "todo/internal/adapter/logger"
+	"todo/internal/adapter/repository/memory"
+	"todo/internal/entity"
+	"todo/internal/repository"
+	"todo/internal/testdata"
+	v1 "todo/internal/usecase/v1"
+	"todo/mocks"
+
+	"github.com/google/uuid"
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/runner"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestMoveColumn(t *testing.T) {
+	runner.Run(t, "TestMoveColumn", func(pt provider.T) {
+		mom := &testdata.ObjectMother{}
+
+		userID := mom.GetUUID(0)
+		otherID := mom.GetUUID(1)
+		columnID := mom.GetUUID(2)
+		fromID := mom.GetUUID(3)
+		toID := mom.GetUUID(4)
+		workspaceID := mom.GetUUID(5)
+		otherWorkspaceID := mom.GetUUID(6)
+		viewedWorkspaceID := mom.GetUUID(7)
+
+		tests := []struct {
+			name         string
+			boardID      uuid.UUID
+			mockSetup    func(mockBoardRepo *mocks.BoardRepository, mockColumnRepo *mocks.ColumnRepository)
+			wantPosition float64
+			wantErr      bool
+			err          error
+		}{
+			{
+				name:    "positive",
+				boardID: toID,
+				mockSetup: func(mockBoardRepo *mocks.BoardRepository, mockColumnRepo *mocks.ColumnRepository) {
+					mockColumnRepo.On("GetColumnByID", mock.Anything, columnID).Return(&entity.Column{ID: columnID, BoardID: fromID, Title: "Doing", Position: 7}, nil)
+					mockBoardRepo.On("GetBoardByID", mock.Anything, fromID).Return(&entity.Board{ID: fromID, UserID: userID, WorkspaceID: workspaceID}, nil)
+					mockBoardRepo.On("GetBoardByID", mock.Anything, toID).Return(&entity.Board{ID: toID, UserID: userID, WorkspaceID: workspaceID}, nil)
+					mockColumnRepo.On("GetColumnsByBoard", mock.Anything, toID, mock.Anything).Return([]entity.Column{{Position: 0}, {Position: 2.5}}, nil)
+					mockColumnRepo.On("MoveColumn", mock.Anything, mock.MatchedBy(func(c *entity.Column) bool {
+						return c.BoardID == toID && c.Title == "Doing" && c.Version == 3
+					})).Return(nil)
+				},
+				wantPosition: 3.5,
+				wantErr:      false,
+			},
+			{
+				name:    "no column",
+				boardID: toID,
+				mockSetup: func(mockBoardRepo *mocks.BoardRepository, mockColumnRepo *mocks.ColumnRepository) {
+					mockColumnRepo.On("GetColumnByID", mock.Anything, columnID).Return(nil, errors.New(""))
+				},
+				wantErr: true,
+				err:     repository.ErrColumnNotFound,
+			},
+			{
+				name:    "board outside the workspaces of the user",
+				boardID: toID,
+				mockSetup: func(mockBoardRepo *mocks.BoardRepository, mockColumnRepo *mocks.ColumnRepository) {
+					mockColumnRepo.On("GetColumnByID", mock.Anything, columnID).Return(&entity.Column{ID: columnID, BoardID: fromID}, nil)
+					mockBoardRepo.On("GetBoardByID", mock.Anything, fromID).Return(&entity.Board{ID: fromID, UserID: userID, WorkspaceID: workspaceID}, nil)
+					mockBoardRepo.On("GetBoardByID", mock.Anything, toID).Return(&entity.Board{ID: toID, UserID: otherID, WorkspaceID: otherWorkspaceID}, nil)
+				},
+				wantErr: true,
+				err:     repository.ErrBoardAccess,
+			},
+			{
+				name:    "viewer of the board",
+				boardID: toID,
+				mockSetup: func(mockBoardRepo *mocks.BoardRepository, mockColumnRepo *mocks.ColumnRepository) {
+					mockColumnRepo.On("GetColumnByID", mock.Anything, columnID).Return(&entity.Column{ID: columnID, BoardID: fromID}, nil)
+					mockBoardRepo.On("GetBoardByID", mock.Anything, fromID).Return(&entity.Board{ID: fromID, UserID: userID, WorkspaceID: workspaceID}, nil)
+					mockBoardRepo.On("GetBoardByID", mock.Anything, toID).Return(&entity.Board{ID: toID, UserID: otherID, WorkspaceID: viewedWorkspaceID}, nil)
+				},
+				wantErr: true,
+				err:     repository.ErrBoardAccess,
+			},
+			{
+				name:    "same board",
+				boardID: fromID,
+				mockSetup: func(mockBoardRepo *mocks.BoardRepository, mockColumnRepo *mocks.ColumnRepository) {
+					mockColumnRepo.On("GetColumnByID", mock.Anything, columnID).Return(&entity.Column{ID: columnID, BoardID: fromID}, nil)
+					mockBoardRepo.On("GetBoardByID", mock.Anything, fromID).Return(&entity.Board{ID: fromID, UserID: userID, WorkspaceID: workspaceID}, nil)
+				},
+				wantErr: true,
+				err:     repository.ErrColumnSameBoard,
+			},
+			{
+				name:    "version mismatch",
+				boardID: toID,
+				mockSetup: func(mockBoardRepo *mocks.BoardRepository, mockColumnRepo *mocks.ColumnRepository) {
+					mockColumnRepo.On("GetColumnByID", mock.Anything, columnID).Return(&entity.Column{ID: columnID, BoardID: fromID}, nil)
+					mockBoardRepo.On("GetBoardByID", mock.Anything, fromID).Return(&entity.Board{ID: fromID, UserID: userID, WorkspaceID: workspaceID}, nil)
+					mockBoardRepo.On("GetBoardByID", mock.Anything, toID).Return(&entity.Board{ID: toID, UserID: userID, WorkspaceID: workspaceID}, nil)
+					mockColumnRepo.On("GetColumnsByBoard", mock.Anything, toID, mock.Anything).Return(nil, nil)
+					mockColumnRepo.On("MoveColumn", mock.Anything, mock.Anything).Return(repository.ErrVersionMismatch)
+				},
+				wantErr: true,
+				err:     repository.ErrVersionMismatch,
+			},
+			{
+				name:    "negative",
+				boardID: toID,
+				mockSetup: func(mockBoardRepo *mocks.BoardRepository, mockColumnRepo *mocks.ColumnRepository) {
+					mockColumnRepo.On("GetColumnByID", mock.Anything, columnID).Return(&entity.Column{ID: columnID, BoardID: fromID}, nil)
+					mockBoardRepo.On("GetBoardByID", mock.Anything, fromID).Return(&entity.Board{ID: fromID, UserID: userID, WorkspaceID: workspaceID}, nil)
+					mockBoardRepo.On("GetBoardByID", mock.Anything, toID).Return(&entity.Board{ID: toID, UserID: userID, WorkspaceID: workspaceID}, nil)
+					mockColumnRepo.On("GetColumnsByBoard", mock.Anything, toID, mock.Anything).Return(nil, nil)
+					mockColumnRepo.On("MoveColumn", mock.Anything, mock.Anything).Return(errors.New(""))
+				},
+				wantErr: true,
+				err:     v1.ErrMoveColumn,
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				t.Parallel()
+
+				runner.Run(t, tt.name, func(pt provider.T) {
+					mockBoardRepo := new(mocks.BoardRepository)
+					mockColumnRepo := new(mocks.ColumnRepository)
+					txManager := memory.NewTxManager()
+					logger := log.NewEmptyLogger()
+
+					mockWorkspaceRepo := new(mocks.WorkspaceRepository)
+					mockWorkspaceRepo.On("GetWorkspaceMember", mock.Anything, workspaceID, userID).
+						Return(&entity.WorkspaceMember{WorkspaceID: workspaceID, UserID: userID, Role: entity.RoleMember}, nil).Maybe()
+					mockWorkspaceRepo.On("GetWorkspaceMember", mock.Anything, otherWorkspaceID, userID).
+						Return(nil, repository.ErrWorkspaceMemberNotFound).Maybe()
+					mockWorkspaceRepo.On("GetWorkspaceMember", mock.Anything, viewedWorkspaceID, userID).
+						Return(&entity.WorkspaceMember{WorkspaceID: viewedWorkspaceID, UserID: userID, Role: entity.RoleViewer}, nil).Maybe()
+
+					uc := v1.NewTodoUseCase(mockBoardRepo, mockColumnRepo, new(mocks.SwimlaneRepository),
+						new(mocks.CardRepository), mockWorkspaceRepo, txManager, logger)
+
+					tt.mockSetup(mockBoardRepo, mockColumnRepo)
+
+					pt.WithNewStep("Call MoveColumn", func(sCtx provider.StepCtx) {
+						column, err := uc.MoveColumn(context.Background(), userID, columnID, tt.boardID, 3)
+
+						if tt.wantErr {
+							sCtx.Assert().Error(err, "Expected error")
+							sCtx.Assert().ErrorIs(err, tt.err)
+							sCtx.Assert().Equal(1, txManager.RolledBack())
+						} else {
+							sCtx.Assert().NoError(err, "Expected no error")
+							sCtx.Assert().Equal(tt.boardID, column.BoardID)
+							sCtx.Assert().Equal(tt.wantPosition, column.Position)
+						}
+
+						mockBoardRepo.AssertExpectations(t)
+						mockColumnRepo.AssertExpectations(t)
+					})
+				})
+			})
+		}
+	})
+}
+
+func TestMergeBoards(t *testing.T) {
+	runner.Run(t, "TestMergeBoards", func(pt provider.T) {
+		mom := &testdata.ObjectMother{}
+
+		userID := mom.GetUUID(0)
+		sourceID := mom.GetUUID(1)
+		targetID := mom.GetUUID(2)
+		todoID := mom.GetUUID(3)
+		doneID := mom.GetUUID(4)
+		targetDoneID := mom.GetUUID(5)
+		workspaceID := mom.GetUUID(6)
+		otherWorkspaceID := mom.GetUUID(7)
+
+		boards := func(mockBoardRepo *mocks.BoardRepository) {
+			mockBoardRepo.On("GetBoardByID", mock.Anything, sourceID).Return(&entity.Board{ID: sourceID, UserID: userID, WorkspaceID: workspaceID, Title: "Old"}, nil)
+			mockBoardRepo.On("GetBoardByID", mock.Anything, targetID).Return(&entity.Board{ID: targetID, UserID: userID, WorkspaceID: workspaceID}, nil)
+		}
+
+		columns := func(mockColumnRepo *mocks.ColumnRepository) {
+			mockColumnRepo.On("GetColumnsByBoard", mock.Anything, sourceID, mock.Anything).Return([]entity.Column{
+				{ID: doneID, BoardID: sourceID, Title: " done ", Position: 1},
+				{ID: todoID, BoardID: sourceID, Title: "To do", Position: 0},
+			}, nil)
+			mockColumnRepo.On("GetColumnsByBoard", mock.Anything, targetID, mock.Anything).Return([]entity.Column{
+				{ID: targetDoneID, BoardID: targetID, Title: "Done", Position: 4},
+			}, nil)
+		}
+
+		moved := func(id uuid.UUID, title string, position float64) interface{} {
+			return mock.MatchedBy(func(c *entity.Column) bool {
+				return c.ID == id && c.BoardID == targetID && c.Title == title && c.Position == position
+			})
+		}
+
+		tests := []struct {
+			name         string
+			sourceID     uuid.UUID
+			strategy     string
+			mockSetup    func(mockBoardRepo *mocks.BoardRepository, mockColumnRepo *mocks.ColumnRepository)
+			wantMoved    []string
+			wantCombined []string
+			wantErr      bool
+			err          error
+		}{
+			{
+				name:     "combine",
+				sourceID: sourceID,
+				strategy: entity.MergeColumnsCombine,
+				mockSetup: func(mockBoardRepo *mocks.BoardRepository, mockColumnRepo *mocks.ColumnRepository) {
+					boards(mockBoardRepo)
+					columns(mockColumnRepo)
+					mockColumnRepo.On("MoveColumn", mock.Anything, moved(todoID, "To do", 5)).Return(nil)
+					mockColumnRepo.On("MergeColumn", mock.Anything, mock.MatchedBy(func(c *entity.Column) bool {
+						return c.ID == doneID
+					}), targetDoneID).Return(nil)
+				},
+				wantMoved:    []string{"To do"},
+				wantCombined: []string{" done "},
+				wantErr:      false,
+			},
+			{
+				name:     "keep",
+				sourceID: sourceID,
+				strategy: entity.MergeColumnsKeep,
+				mockSetup: func(mockBoardRepo *mocks.BoardRepository, mockColumnRepo *mocks.ColumnRepository) {
+					boards(mockBoardRepo)
+					columns(mockColumnRepo)
+					mockColumnRepo.On("MoveColumn", mock.Anything, moved(todoID, "To do", 5)).Return(nil)
+					mockColumnRepo.On("MoveColumn", mock.Anything, moved(doneID, " done ", 6)).Return(nil)
+				},
+				wantMoved: []string{"To do", " done "},
+				wantErr:   false,
+			},
+			{
+				name:     "rename",
+				sourceID: sourceID,
+				strategy: entity.MergeColumnsRename,
+				mockSetup: func(mockBoardRepo *mocks.BoardRepository, mockColumnRepo *mocks.ColumnRepository) {
+					boards(mockBoardRepo)
+					columns(mockColumnRepo)
+					mockColumnRepo.On("MoveColumn", mock.Anything, moved(todoID, "To do", 5)).Return(nil)
+					mockColumnRepo.On("MoveColumn", mock.Anything, moved(doneID, " done  (Old)", 6)).Return(nil)
+				},
+				wantMoved: []string{"To do", " done  (Old)"},
+				wantErr:   false,
+			},
+			{
+				name:      "same board",
+				sourceID:  targetID,
+				strategy:  entity.MergeColumnsKeep,
+				mockSetup: func(mockBoardRepo *mocks.BoardRepository, mockColumnRepo *mocks.ColumnRepository) {},
+				wantErr:   true,
+				err:       repository.ErrMergeSameBoard,
+			},
+			{
+				name:      "unknown strategy",
+				sourceID:  sourceID,
+				strategy:  "zip",
+				mockSetup: func(mockBoardRepo *mocks.BoardRepository, mockColumnRepo *mocks.ColumnRepository) {},
+				wantErr:   true,
+				err:       repository.ErrMergeStrategy,
+			},
+			{
+				name:     "board outside the workspaces of the user",
+				sourceID: sourceID,
+				strategy: entity.MergeColumnsKeep,
+				mockSetup: func(mockBoardRepo *mocks.BoardRepository, mockColumnRepo *mocks.ColumnRepository) {
+					mockBoardRepo.On("GetBoardByID", mock.Anything, sourceID).Return(&entity.Board{ID: sourceID, UserID: targetID, WorkspaceID: otherWorkspaceID}, nil)
+				},
+				wantErr: true,
+				err:     repository.ErrBoardAccess,
+			},
+			{
+				name:     "negative",
+				sourceID: sourceID,
+				strategy: entity.MergeColumnsKeep,
+				mockSetup: func(mockBoardRepo *mocks.BoardRepository, mockColumnRepo *mocks.ColumnRepository) {
+					boards(mockBoardRepo)
+					columns(mockColumnRepo)
+					mockColumnRepo.On("MoveColumn", mock.Anything, moved(todoID, "To do", 5)).Return(nil)
+					mockColumnRepo.On("MoveColumn", mock.Anything, mock.Anything).Return(errors.New(""))
+				},
+				wantErr: true,
+				err:     v1.ErrMergeBoards,
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				t.Parallel()
+
+				runner.Run(t, tt.name, func(pt provider.T) {
+					mockBoardRepo := new(mocks.BoardRepository)
+					mockColumnRepo := new(mocks.ColumnRepository)
+					txManager := memory.NewTxManager()
+					logger := log.NewEmptyLogger()
+
+					mockWorkspaceRepo := new(mocks.WorkspaceRepository)
+					mockWorkspaceRepo.On("GetWorkspaceMember", mock.Anything, workspaceID, userID).
+						Return(&entity.WorkspaceMember{WorkspaceID: workspaceID, UserID: userID, Role: entity.RoleAdmin}, nil).Maybe()
+					mockWorkspaceRepo.On("GetWorkspaceMember", mock.Anything, otherWorkspaceID, userID).
+						Return(nil, repository.ErrWorkspaceMemberNotFound).Maybe()
+
+					uc := v1.NewTodoUseCase(mockBoardRepo, mockColumnRepo, new(mocks.SwimlaneRepository),
+						new(mocks.CardRepository), mockWorkspaceRepo, txManager, logger)
+
+					tt.mockSetup(mockBoardRepo, mockColumnRepo)
+
+					pt.WithNewStep("Call MergeBoards", func(sCtx provider.StepCtx) {
+						merge, err := uc.MergeBoards(context.Background(), userID, tt.sourceID, targetID, tt.strategy)
+
+						if tt.wantErr {
+							sCtx.Assert().Error(err, "Expected error")
+							sCtx.Assert().ErrorIs(err, tt.err)
+						} else {
+							sCtx.Assert().NoError(err, "Expected no error")
+							sCtx.Assert().Equal(tt.wantMoved, titles(merge.Moved))
+							sCtx.Assert().Equal(tt.wantCombined, titles(merge.Combined))
+						}
+
+						mockBoardRepo.AssertExpectations(t)
+						mockColumnRepo.AssertExpectations(t)
+					})
+				})
+			})
+		}
+	})
+}
+
+func titles(columns []entity.Column) []string {
+	var titles []string
+	for _, column := range columns {
+		titles = append(titles, column.Title)
+	}
+
+	return titles
+}
@@ -0,0 +1,505 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+	"todo/internal/common/logger"
+	"todo/internal/entity"
+	"todo/internal/repository"
+	"todo/internal/usecase"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrRecordActivity      = errors.New("failed to record activity")
+	ErrGetBoardActivities  = errors.New("failed to get board activities")
+	ErrActivityLimitBounds = errors.New("activity limit should be between 1 and 1000")
+)
+
+const maxActivityLimit = 1000
+
+// feedUseCase wraps a TodoUseCase. Each write runs in one unit of work with
+// the recording of its activities, which are published once it commits.
+type feedUseCase struct {
+	usecase.TodoUseCase
+
+	activityRepo repository.ActivityRepository
+	boardRepo    repository.BoardRepository
+	columnRepo   repository.ColumnRepository
+	swimlaneRepo repository.SwimlaneRepository
+	cardRepo     repository.CardRepository
+	tx           repository.TxManager
+	hub          usecase.ActivityHub
+	log          logger.Logger
+}
+
+func NewFeedUseCase(
+	uc usecase.TodoUseCase,
+	activityRepo repository.ActivityRepository,
+	boardRepo repository.BoardRepository,
+	columnRepo repository.ColumnRepository,
+	swimlaneRepo repository.SwimlaneRepository,
+	cardRepo repository.CardRepository,
+	tx repository.TxManager,
+	hub usecase.ActivityHub,
+	log logger.Logger,
+) usecase.FeedUseCase {
+	return &feedUseCase{
+		TodoUseCase:  uc,
+		activityRepo: activityRepo,
+		boardRepo:    boardRepo,
+		columnRepo:   columnRepo,
+		swimlaneRepo: swimlaneRepo,
+		cardRepo:     cardRepo,
+		tx:           tx,
+		hub:          hub,
+		log:          log,
+	}
+}
+
+func (uc *feedUseCase) GetBoardActivities(ctx context.Context, boardID uuid.UUID, afterID int64, limit int) ([]entity.Activity, error) {
+	header := "GetBoardActivities: "
+
+	uc.log.Info(ctx, header+"Usecase called; Validating limit", "boardID", boardID, "afterID", afterID, "limit", limit)
+
+	if limit < 1 || limit > maxActivityLimit {
+		info := "Validation failed"
+		uc.log.Info(ctx, header+info, "err", ErrActivityLimitBounds.Error())
+		return nil, fmt.Errorf(header+info+": %w", ErrActivityLimitBounds)
+	}
+
+	activities, err := uc.activityRepo.GetActivities(ctx, boardID, afterID, limit)
+
+	if err != nil {
+		info := "Failed to get board activities"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", ErrGetBoardActivities)
+	}
+
+	uc.log.Info(ctx, header+"Got board activities", "count", len(activities))
+
+	return activities, nil
+}
+
+func (uc *feedUseCase) WatchBoard(ctx context.Context, boardID uuid.UUID) (<-chan entity.Activity, func()) {
+	uc.log.Info(ctx, "WatchBoard: Usecase called; Subscribing to board", "boardID", boardID)
+
+	return uc.hub.Subscribe(boardID)
+}
+
+// track runs write, which returns the activities it has caused, in one unit
+// of work with their recording, and publishes them once it commits.
+func (uc *feedUseCase) track(ctx context.Context, header string, write func(ctx context.Context) ([]entity.Activity, error)) error {
+	var activities []entity.Activity
+
+	err := uc.tx.WithinTx(ctx, func(ctx context.Context) error {
+		var err error
+		activities, err = write(ctx)
+		if err != nil {
+			return err
+		}
+
+		now := time.Now()
+		for i := range activities {
+			activities[i].CreatedAt = now
+			if err := uc.activityRepo.AddActivity(ctx, &activities[i]); err != nil {
+				return uc.recordFailed(ctx, header, err)
+			}
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return err
+	}
+
+	for _, activity := range activities {
+		uc.hub.Publish(activity)
+	}
+
+	return nil
+}
+
+func (uc *feedUseCase) recordFailed(ctx context.Context, header string, err error) error {
+	info := "Failed to record activity"
+	uc.log.Error(ctx, header+info, "err", err.Error())
+	return fmt.Errorf(header+info+": %w", ErrRecordActivity)
+}
+
+func (uc *feedUseCase) cardActivity(ctx context.Context, kind string, card *entity.Card) (entity.Activity, error) {
+	column, err := uc.columnRepo.GetColumnByID(ctx, card.ColumnID)
+	if err != nil {
+		return entity.Activity{}, err
+	}
+
+	return entity.Activity{BoardID: column.BoardID, Kind: kind, Card: card}, nil
+}
+
+func (uc *feedUseCase) CreateBoard(ctx context.Context, board *entity.Board) error {
+	header := "CreateBoard: "
+
+	return uc.track(ctx, header, func(ctx context.Context) ([]entity.Activity, error) {
+		if err := uc.TodoUseCase.CreateBoard(ctx, board); err != nil {
+			return nil, err
+		}
+
+		return []entity.Activity{{BoardID: board.ID, Kind: entity.ActivityBoardCreated, Board: board}}, nil
+	})
+}
+
+func (uc *feedUseCase) UpdateBoard(ctx context.Context, board *entity.Board) error {
+	header := "UpdateBoard: "
+
+	return uc.track(ctx, header, func(ctx context.Context) ([]entity.Activity, error) {
+		if err := uc.TodoUseCase.UpdateBoard(ctx, board); err != nil {
+			return nil, err
+		}
+
+		current, err := uc.boardRepo.GetBoardByID(ctx, board.ID)
+		if err != nil {
+			return nil, uc.recordFailed(ctx, header, err)
+		}
+
+		return []entity.Activity{{BoardID: board.ID, Kind: entity.ActivityBoardUpdated, Board: current}}, nil
+	})
+}
+
+// The deletes read what they delete first, for the activity to show it. If
+// it cannot be read, the delete is left to tell why it fails.
+
+func (uc *feedUseCase) DeleteBoard(ctx context.Context, id uuid.UUID, version int) error {
+	header := "DeleteBoard: "
+
+	return uc.track(ctx, header, func(ctx context.Context) ([]entity.Activity, error) {
+		board, err := uc.boardRepo.GetBoardByID(ctx, id)
+		if err != nil {
+			return nil, uc.TodoUseCase.DeleteBoard(ctx, id, version)
+		}
+
+		if err := uc.TodoUseCase.DeleteBoard(ctx, id, version); err != nil {
+			return nil, err
+		}
+
+		return []entity.Activity{{BoardID: id, Kind: entity.ActivityBoardDeleted, Board: board}}, nil
+	})
+}
+
+func (uc *feedUseCase) CreateColumn(ctx context.Context, column *entity.Column) error {
+	header := "CreateColumn: "
+
+	return uc.track(ctx, header, func(ctx context.Context) ([]entity.Activity, error) {
+		if err := uc.TodoUseCase.CreateColumn(ctx, column); err != nil {
+			return nil, err
+		}
+
+		return []entity.Activity{{BoardID: column.BoardID, Kind: entity.ActivityColumnCreated, Column: column}}, nil
+	})
+}
+
+func (uc *feedUseCase) UpdateColumn(ctx context.Context, column *entity.Column) error {
+	header := "UpdateColumn: "
+
+	return uc.track(ctx, header, func(ctx context.Context) ([]entity.Activity, error) {
+		if err := uc.TodoUseCase.UpdateColumn(ctx, column); err != nil {
+			return nil, err
+		}
+
+		current, err := uc.columnRepo.GetColumnByID(ctx, column.ID)
+		if err != nil {
+			return nil, uc.recordFailed(ctx, header, err)
+		}
+
+		return []entity.Activity{{BoardID: current.BoardID, Kind: entity.ActivityColumnUpdated, Column: current}}, nil
+	})
+}
+
+func (uc *feedUseCase) DeleteColumn(ctx context.Context, id uuid.UUID, version int) error {
+	header := "DeleteColumn: "
+
+	return uc.track(ctx, header, func(ctx context.Context) ([]entity.Activity, error) {
+		column, err := uc.columnRepo.GetColumnByID(ctx, id)
+		if err != nil {
+			return nil, uc.TodoUseCase.DeleteColumn(ctx, id, version)
+		}
+
+		if err := uc.TodoUseCase.DeleteColumn(ctx, id, version); err != nil {
+			return nil, err
+		}
+
+		return []entity.Activity{{BoardID: column.BoardID, Kind: entity.ActivityColumnDeleted, Column: column}}, nil
+	})
+}
+
+// A column moved to another board shows up on the feeds of both boards.
+
+func (uc *feedUseCase) MoveColumn(ctx context.Context, userID, id, boardID uuid.UUID, version int) (*entity.Column, error) {
+	header := "MoveColumn: "
+
+	var column *entity.Column
+
+	err := uc.track(ctx, header, func(ctx context.Context) ([]entity.Activity, error) {
+		from, err := uc.columnRepo.GetColumnByID(ctx, id)
+		if err != nil {
+			_, err := uc.TodoUseCase.MoveColumn(ctx, userID, id, boardID, version)
+			return nil, err
+		}
+
+		column, err = uc.TodoUseCase.MoveColumn(ctx, userID, id, boardID, version)
+		if err != nil {
+			return nil, err
+		}
+
+		return []entity.Activity{
+			{BoardID: from.BoardID, Kind: entity.ActivityColumnMoved, Column: column},
+			{BoardID: column.BoardID, Kind: entity.ActivityColumnMoved, Column: column},
+		}, nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return column, nil
+}
+
+func (uc *feedUseCase) MergeBoards(ctx context.Context, userID, sourceID, targetID uuid.UUID, strategy string) (*entity.BoardMerge, error) {
+	header := "MergeBoards: "
+
+	var merge *entity.BoardMerge
+
+	err := uc.track(ctx, header, func(ctx context.Context) ([]entity.Activity, error) {
+		var err error
+		merge, err = uc.TodoUseCase.MergeBoards(ctx, userID, sourceID, targetID, strategy)
+		if err != nil {
+			return nil, err
+		}
+
+		var activities []entity.Activity
+		for i := range merge.Moved {
+			column := &merge.Moved[i]
+			activities = append(activities,
+				entity.Activity{BoardID: sourceID, Kind: entity.ActivityColumnMoved, Column: column},
+				entity.Activity{BoardID: targetID, Kind: entity.ActivityColumnMoved, Column: column},
+			)
+		}
+
+		for i := range merge.Combined {
+			activities = append(activities, entity.Activity{BoardID: sourceID, Kind: entity.ActivityColumnDeleted, Column: &merge.Combined[i]})
+		}
+
+		return activities, nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return merge, nil
+}
+
+func (uc *feedUseCase) CreateSwimlane(ctx context.Context, swimlane *entity.Swimlane) error {
+	header := "CreateSwimlane: "
+
+	return uc.track(ctx, header, func(ctx context.Context) ([]entity.Activity, error) {
+		if err := uc.TodoUseCase.CreateSwimlane(ctx, swimlane); err != nil {
+			return nil, err
+		}
+
+		return []entity.Activity{{BoardID: swimlane.BoardID, Kind: entity.ActivitySwimlaneCreated, Swimlane: swimlane}}, nil
+	})
+}
+
+func (uc *feedUseCase) UpdateSwimlane(ctx context.Context, swimlane *entity.Swimlane) error {
+	header := "UpdateSwimlane: "
+
+	return uc.track(ctx, header, func(ctx context.Context) ([]entity.Activity, error) {
+		if err := uc.TodoUseCase.UpdateSwimlane(ctx, swimlane); err != nil {
+			return nil, err
+		}
+
+		current, err := uc.swimlaneRepo.GetSwimlaneByID(ctx, swimlane.ID)
+		if err != nil {
+			return nil, uc.recordFailed(ctx, header, err)
+		}
+
+		return []entity.Activity{{BoardID: current.BoardID, Kind: entity.ActivitySwimlaneUpdated, Swimlane: current}}, nil
+	})
+}
+
+func (uc *feedUseCase) DeleteSwimlane(ctx context.Context, id uuid.UUID, version int) error {
+	header := "DeleteSwimlane: "
+
+	return uc.track(ctx, header, func(ctx context.Context) ([]entity.Activity, error) {
+		swimlane, err := uc.swimlaneRepo.GetSwimlaneByID(ctx, id)
+		if err != nil {
+			return nil, uc.TodoUseCase.DeleteSwimlane(ctx, id, version)
+		}
+
+		if err := uc.TodoUseCase.DeleteSwimlane(ctx, id, version); err != nil {
+			return nil, err
+		}
+
+		return []entity.Activity{{BoardID: swimlane.BoardID, Kind: entity.ActivitySwimlaneDeleted, Swimlane: swimlane}}, nil
+	})
+}
+
+func (uc *feedUseCase) CreateCard(ctx context.Context, card *entity.Card) error {
+	header := "CreateCard: "
+
+	return uc.track(ctx, header, func(ctx context.Context) ([]entity.Activity, error) {
+		if err := uc.TodoUseCase.CreateCard(ctx, card); err != nil {
+			return nil, err
+		}
+
+		activity, err := uc.cardActivity(ctx, entity.ActivityCardCreated, card)
+		if err != nil {
+			return nil, uc.recordFailed(ctx, header, err)
+		}
+
+		return []entity.Activity{activity}, nil
+	})
+}
+
+func (uc *feedUseCase) UpdateCard(ctx context.Context, card *entity.Card) error {
+	header := "UpdateCard: "
+
+	kind := entity.ActivityCardUpdated
+	if card.ColumnID != uuid.Nil || card.SwimlaneID != uuid.Nil {
+		kind = entity.ActivityCardMoved
+	}
+
+	return uc.track(ctx, header, func(ctx context.Context) ([]entity.Activity, error) {
+		if err := uc.TodoUseCase.UpdateCard(ctx, card); err != nil {
+			return nil, err
+		}
+
+		current, err := uc.cardRepo.GetCardByID(ctx, card.ID)
+		if err != nil {
+			return nil, uc.recordFailed(ctx, header, err)
+		}
+
+		activity, err := uc.cardActivity(ctx, kind, current)
+		if err != nil {
+			return nil, uc.recordFailed(ctx, header, err)
+		}
+
+		return []entity.Activity{activity}, nil
+	})
+}
+
+func (uc *feedUseCase) SetCardParent(ctx context.Context, card *entity.Card) error {
+	header := "SetCardParent: "
+
+	return uc.track(ctx, header, func(ctx context.Context) ([]entity.Activity, error) {
+		if err := uc.TodoUseCase.SetCardParent(ctx, card); err != nil {
+			return nil, err
+		}
+
+		current, err := uc.cardRepo.GetCardByID(ctx, card.ID)
+		if err != nil {
+			return nil, uc.recordFailed(ctx, header, err)
+		}
+
+		activity, err := uc.cardActivity(ctx, entity.ActivityCardUpdated, current)
+		if err != nil {
+			return nil, uc.recordFailed(ctx, header, err)
+		}
+
+		return []entity.Activity{activity}, nil
+	})
+}
+
+func (uc *feedUseCase) DeleteCard(ctx context.Context, id uuid.UUID, version int) error {
+	header := "DeleteCard: "
+
+	return uc.track(ctx, header, func(ctx context.Context) ([]entity.Activity, error) {
+		card, err := uc.cardRepo.GetCardByID(ctx, id)
+		if err != nil {
+			return nil, uc.TodoUseCase.DeleteCard(ctx, id, version)
+		}
+
+		activity, err := uc.cardActivity(ctx, entity.ActivityCardDeleted, card)
+		if err != nil {
+			return nil, uc.recordFailed(ctx, header, err)
+		}
+
+		if err := uc.TodoUseCase.DeleteCard(ctx, id, version); err != nil {
+			return nil, err
+		}
+
+		return []entity.Activity{activity}, nil
+	})
+}
+
+var cardOpActivities = map[repository.CardOpKind]string{
+	repository.CardOpMove:        entity.ActivityCardMoved,
+	repository.CardOpArchive:     entity.ActivityCardArchived,
+	repository.CardOpDelete:      entity.ActivityCardDeleted,
+	repository.CardOpSetLabel:    entity.ActivityCardUpdated,
+	repository.CardOpSetAssignee: entity.ActivityCardUpdated,
+}
+
+func (uc *feedUseCase) ApplyCardOps(ctx context.Context, ops []repository.CardOp, allOrNothing bool) ([]repository.CardOpResult, error) {
+	header := "ApplyCardOps: "
+
+	var results []repository.CardOpResult
+	err := uc.track(ctx, header, func(ctx context.Context) ([]entity.Activity, error) {
+		// Deleted cards can only be described as they were before the batch.
+		before := make(map[uuid.UUID]entity.Activity)
+		for _, op := range ops {
+			if op.Kind != repository.CardOpDelete {
+				continue
+			}
+			if card, err := uc.cardRepo.GetCardByID(ctx, op.CardID); err == nil {
+				if activity, err := uc.cardActivity(ctx, entity.ActivityCardDeleted, card); err == nil {
+					before[op.CardID] = activity
+				}
+			}
+		}
+
+		var err error
+		results, err = uc.TodoUseCase.ApplyCardOps(ctx, ops, allOrNothing)
+		if err != nil {
+			return nil, err
+		}
+
+		var activities []entity.Activity
+		for i, result := range results {
+			if result.Status != repository.CardOpApplied {
+				continue
+			}
+
+			op := ops[i]
+			if op.Kind == repository.CardOpDelete {
+				if activity, ok := before[op.CardID]; ok {
+					activities = append(activities, activity)
+				}
+				continue
+			}
+
+			card, err := uc.cardRepo.GetCardByID(ctx, op.CardID)
+			if err != nil {
+				return nil, uc.recordFailed(ctx, header, err)
+			}
+
+			activity, err := uc.cardActivity(ctx, cardOpActivities[op.Kind], card)
+			if err != nil {
+				return nil, uc.recordFailed(ctx, header, err)
+			}
+
+			activities = append(activities, activity)
+		}
+
+		return activities, nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
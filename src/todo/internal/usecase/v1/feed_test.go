@@ -0,0 +1,202 @@
+package v1_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"todo/internal/adapter/feed"
+	log "todo/internal/adapter/logger"
+	"todo/internal/adapter/repository/memory"
+	"todo/internal/entity"
+	"todo/internal/testdata"
+	v1 "todo/internal/usecase/v1"
+	"todo/mocks"
+
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/runner"
+	"github.com/stretchr/testify/mock"
+)
+
+type feedMocks struct {
+	uc           *mocks.TodoUseCase
+	activityRepo *mocks.ActivityRepository
+	boardRepo    *mocks.BoardRepository
+	columnRepo   *mocks.ColumnRepository
+	swimlaneRepo *mocks.SwimlaneRepository
+	cardRepo     *mocks.CardRepository
+}
+
+func TestFeedUpdateCard(t *testing.T) {
+	runner.Run(t, "TestFeedUpdateCard", func(pt provider.T) {
+		mom := &testdata.ObjectMother{}
+
+		card := entity.Card{ID: mom.GetUUID(0), Title: "Card", Version: 1}
+		stored := entity.Card{ID: mom.GetUUID(0), ColumnID: mom.GetUUID(1), Title: "Card", Version: 2}
+		column := entity.Column{ID: mom.GetUUID(1), BoardID: mom.GetUUID(2)}
+
+		tests := []struct {
+			name          string
+			mockSetup     func(m feedMocks)
+			wantErr       bool
+			err           error
+			wantPublished bool
+			wantCommitted int
+		}{
+			{
+				name: "positive",
+				mockSetup: func(m feedMocks) {
+					m.uc.On("UpdateCard", mock.Anything, &card).Return(nil)
+					m.cardRepo.On("GetCardByID", mock.Anything, card.ID).Return(&stored, nil)
+					m.columnRepo.On("GetColumnByID", mock.Anything, column.ID).Return(&column, nil)
+					m.activityRepo.On("AddActivity", mock.Anything, mock.MatchedBy(func(a *entity.Activity) bool {
+						return a.BoardID == column.BoardID && a.Kind == entity.ActivityCardUpdated && a.Card.Version == 2
+					})).Run(func(args mock.Arguments) {
+						args.Get(1).(*entity.Activity).ID = 7
+					}).Return(nil)
+				},
+				wantErr:       false,
+				wantPublished: true,
+				wantCommitted: 1,
+			},
+			{
+				name: "update fails",
+				mockSetup: func(m feedMocks) {
+					m.uc.On("UpdateCard", mock.Anything, &card).Return(v1.ErrUpdateCard)
+				},
+				wantErr: true,
+				err:     v1.ErrUpdateCard,
+			},
+			{
+				name: "recording fails",
+				mockSetup: func(m feedMocks) {
+					m.uc.On("UpdateCard", mock.Anything, &card).Return(nil)
+					m.cardRepo.On("GetCardByID", mock.Anything, card.ID).Return(&stored, nil)
+					m.columnRepo.On("GetColumnByID", mock.Anything, column.ID).Return(&column, nil)
+					m.activityRepo.On("AddActivity", mock.Anything, mock.Anything).Return(errors.New(""))
+				},
+				wantErr: true,
+				err:     v1.ErrRecordActivity,
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				t.Parallel()
+
+				runner.Run(t, tt.name, func(pt provider.T) {
+					m := feedMocks{
+						uc:           new(mocks.TodoUseCase),
+						activityRepo: new(mocks.ActivityRepository),
+						boardRepo:    new(mocks.BoardRepository),
+						columnRepo:   new(mocks.ColumnRepository),
+						swimlaneRepo: new(mocks.SwimlaneRepository),
+						cardRepo:     new(mocks.CardRepository),
+					}
+					txManager := memory.NewTxManager()
+					hub := feed.NewHub()
+
+					uc := v1.NewFeedUseCase(m.uc, m.activityRepo, m.boardRepo, m.columnRepo, m.swimlaneRepo, m.cardRepo, txManager, hub, log.NewEmptyLogger())
+
+					tt.mockSetup(m)
+
+					activities, cancel := uc.WatchBoard(context.Background(), column.BoardID)
+					defer cancel()
+
+					pt.WithNewStep("Call UpdateCard", func(sCtx provider.StepCtx) {
+						c := card
+						err := uc.UpdateCard(context.Background(), &c)
+
+						if tt.wantErr {
+							sCtx.Assert().Error(err, "Expected error")
+							sCtx.Assert().ErrorIs(err, tt.err)
+						} else {
+							sCtx.Assert().NoError(err, "Expected no error")
+						}
+
+						sCtx.Assert().Equal(tt.wantCommitted, txManager.Committed())
+
+						if tt.wantPublished {
+							sCtx.Require().Len(activities, 1)
+							activity := <-activities
+							sCtx.Assert().Equal(int64(7), activity.ID)
+						} else {
+							sCtx.Assert().Len(activities, 0)
+						}
+
+						m.uc.AssertExpectations(t)
+						m.activityRepo.AssertExpectations(t)
+					})
+				})
+			})
+		}
+	})
+}
+
+func TestGetBoardActivities(t *testing.T) {
+	runner.Run(t, "TestGetBoardActivities", func(pt provider.T) {
+		mom := &testdata.ObjectMother{}
+
+		tests := []struct {
+			name      string
+			limit     int
+			mockSetup func(mockActivityRepo *mocks.ActivityRepository)
+			wantErr   bool
+			err       error
+		}{
+			{
+				name:  "positive",
+				limit: 10,
+				mockSetup: func(mockActivityRepo *mocks.ActivityRepository) {
+					mockActivityRepo.On("GetActivities", context.Background(), mom.GetUUID(0), int64(5), 10).
+						Return([]entity.Activity{{ID: 6}}, nil)
+				},
+				wantErr: false,
+			},
+			{
+				name:      "limit out of bounds",
+				limit:     0,
+				mockSetup: func(mockActivityRepo *mocks.ActivityRepository) {},
+				wantErr:   true,
+				err:       v1.ErrActivityLimitBounds,
+			},
+			{
+				name:  "negative",
+				limit: 10,
+				mockSetup: func(mockActivityRepo *mocks.ActivityRepository) {
+					mockActivityRepo.On("GetActivities", context.Background(), mom.GetUUID(0), int64(5), 10).
+						Return(nil, errors.New(""))
+				},
+				wantErr: true,
+				err:     v1.ErrGetBoardActivities,
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				t.Parallel()
+
+				runner.Run(t, tt.name, func(pt provider.T) {
+					mockActivityRepo := new(mocks.ActivityRepository)
+
+					uc := v1.NewFeedUseCase(new(mocks.TodoUseCase), mockActivityRepo, new(mocks.BoardRepository),
+						new(mocks.ColumnRepository), new(mocks.SwimlaneRepository), new(mocks.CardRepository), memory.NewTxManager(), feed.NewHub(), log.NewEmptyLogger())
+
+					tt.mockSetup(mockActivityRepo)
+
+					pt.WithNewStep("Call GetBoardActivities", func(sCtx provider.StepCtx) {
+						_, err := uc.GetBoardActivities(context.Background(), mom.GetUUID(0), 5, tt.limit)
+
+						if tt.wantErr {
+							sCtx.Assert().Error(err, "Expected error")
+							sCtx.Assert().ErrorIs(err, tt.err)
+						} else {
+							sCtx.Assert().NoError(err, "Expected no error")
+						}
+
+						mockActivityRepo.AssertExpectations(t)
+					})
+				})
+			})
+		}
+	})
+}
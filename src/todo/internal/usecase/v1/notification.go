@@ -0,0 +1,309 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+	"todo/internal/common/logger"
+	"todo/internal/entity"
+	"todo/internal/repository"
+	"todo/internal/usecase"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrWatch             = errors.New("failed to watch")
+	ErrUnwatch           = errors.New("failed to unwatch")
+	ErrNotifyCard        = errors.New("failed to notify card watchers")
+	ErrGetNotifications  = errors.New("failed to get notifications")
+	ErrMarkNotifications = errors.New("failed to mark notifications read")
+)
+
+type notificationUseCase struct {
+	notifyRepo    repository.NotificationRepository
+	boardRepo     repository.BoardRepository
+	columnRepo    repository.ColumnRepository
+	cardRepo      repository.CardRepository
+	workspaceRepo repository.WorkspaceRepository
+	log           logger.Logger
+}
+
+func NewNotificationUseCase(
+	notifyRepo repository.NotificationRepository,
+	boardRepo repository.BoardRepository,
+	columnRepo repository.ColumnRepository,
+	cardRepo repository.CardRepository,
+	workspaceRepo repository.WorkspaceRepository,
+	log logger.Logger,
+) usecase.NotificationUseCase {
+	return &notificationUseCase{
+		notifyRepo:    notifyRepo,
+		boardRepo:     boardRepo,
+		columnRepo:    columnRepo,
+		cardRepo:      cardRepo,
+		workspaceRepo: workspaceRepo,
+		log:           log,
+	}
+}
+
+func (uc *notificationUseCase) Watch(ctx context.Context, watch *entity.Watch) error {
+	header := "Watch: "
+
+	uc.log.Info(ctx, header+"Usecase called; Checking watch", "watch", watch)
+
+	if err := uc.checkWatch(ctx, header, watch); err != nil {
+		return err
+	}
+
+	watch.CreatedAt = time.Now()
+
+	uc.log.Info(ctx, header+"Making request to notification repo (AddWatch)", "watch", watch)
+
+	err := uc.notifyRepo.AddWatch(ctx, watch)
+
+	if err != nil {
+		info := "Failed to watch"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return fmt.Errorf(header+info+": %w", ErrWatch)
+	}
+
+	uc.log.Info(ctx, header+"Watch successfully added")
+
+	return nil
+}
+
+func (uc *notificationUseCase) Unwatch(ctx context.Context, watch *entity.Watch) error {
+	header := "Unwatch: "
+
+	uc.log.Info(ctx, header+"Usecase called; Validating watch", "watch", watch)
+
+	if err := validateWatch(watch); err != nil {
+		info := "Validation failed"
+		uc.log.Info(ctx, header+info, "err", err.Error())
+		return fmt.Errorf(header+info+": %w", err)
+	}
+
+	uc.log.Info(ctx, header+"Making request to notification repo (RemoveWatch)", "watch", watch)
+
+	err := uc.notifyRepo.RemoveWatch(ctx, watch)
+
+	if err != nil {
+		info := "Failed to unwatch"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return fmt.Errorf(header+info+": %w", ErrUnwatch)
+	}
+
+	uc.log.Info(ctx, header+"Watch successfully removed")
+
+	return nil
+}
+
+func validateWatch(watch *entity.Watch) error {
+	if (watch.BoardID == uuid.Nil) == (watch.CardID == uuid.Nil) {
+		return repository.ErrInvalidWatch
+	}
+
+	return nil
+}
+
+// checkWatch validates the watch and checks the user can read the board it
+// is on.
+func (uc *notificationUseCase) checkWatch(ctx context.Context, header string, watch *entity.Watch) error {
+	if err := validateWatch(watch); err != nil {
+		info := "Validation failed"
+		uc.log.Info(ctx, header+info, "err", err.Error())
+		return fmt.Errorf(header+info+": %w", err)
+	}
+
+	boardID := watch.BoardID
+
+	if watch.CardID != uuid.Nil {
+		var err error
+		if _, boardID, err = uc.cardBoard(ctx, header, watch.CardID, ErrWatch); err != nil {
+			return err
+		}
+	}
+
+	_, err := boardAccess(ctx, uc.boardRepo, uc.workspaceRepo, uc.log, header, watch.UserID, boardID, false)
+
+	return err
+}
+
+// cardBoard returns the card and the id of the board it is on, wrapping
+// failed if its column cannot be read.
+func (uc *notificationUseCase) cardBoard(ctx context.Context, header string, cardID uuid.UUID, failed error) (*entity.Card, uuid.UUID, error) {
+	uc.log.Info(ctx, header+"Making request to card repo (GetCardByID)", "cardID", cardID)
+
+	card, err := uc.cardRepo.GetCardByID(ctx, cardID)
+
+	if err != nil {
+		info := "Card not found"
+		uc.log.Info(ctx, header+info, "cardID", cardID, "err", err.Error())
+		return nil, uuid.Nil, fmt.Errorf(header+info+": %w", repository.ErrCardNotFound)
+	}
+
+	uc.log.Info(ctx, header+"Making request to column repo (GetColumnByID)", "columnID", card.ColumnID)
+
+	column, err := uc.columnRepo.GetColumnByID(ctx, card.ColumnID)
+
+	if err != nil {
+		info := "Failed to get column of card"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return nil, uuid.Nil, fmt.Errorf(header+info+": %w", failed)
+	}
+
+	return card, column.BoardID, nil
+}
+
+func (uc *notificationUseCase) NotifyCard(ctx context.Context, actorID, cardID uuid.UUID, kind string, mentioned []uuid.UUID) ([]entity.Notification, error) {
+	header := "NotifyCard: "
+
+	uc.log.Info(ctx, header+"Usecase called; Validating kind", "actorID", actorID, "cardID", cardID, "kind", kind, "mentioned", mentioned)
+
+	if !repository.NotificationKinds[kind] {
+		info := "Validation failed"
+		uc.log.Info(ctx, header+info, "err", repository.ErrInvalidNotificationKind.Error())
+		return nil, fmt.Errorf(header+info+": %w", repository.ErrInvalidNotificationKind)
+	}
+
+	card, boardID, err := uc.cardBoard(ctx, header, cardID, ErrNotifyCard)
+	if err != nil {
+		return nil, err
+	}
+
+	uc.log.Info(ctx, header+"Making request to notification repo (GetWatchers)", "boardID", boardID, "cardID", cardID)
+
+	watchers, err := uc.notifyRepo.GetWatchers(ctx, boardID, cardID)
+
+	if err != nil {
+		info := "Failed to get watchers"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", ErrNotifyCard)
+	}
+
+	// A user mentioned is told of the mention only, even if they watch the
+	// card too.
+	kinds := make(map[uuid.UUID]string)
+	var recipients []uuid.UUID
+
+	add := func(userIDs []uuid.UUID, kind string) {
+		for _, id := range userIDs {
+			if _, ok := kinds[id]; ok || id == actorID || id == uuid.Nil {
+				continue
+			}
+
+			kinds[id] = kind
+			recipients = append(recipients, id)
+		}
+	}
+
+	add(mentioned, entity.NotificationMention)
+	add(watchers, kind)
+
+	uc.log.Info(ctx, header+"Making request to board repo (GetBoardByID)", "boardID", boardID)
+
+	board, err := uc.boardRepo.GetBoardByID(ctx, boardID)
+
+	if err != nil {
+		info := "Board not found"
+		uc.log.Info(ctx, header+info, "boardID", boardID, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", repository.ErrBoardNotFound)
+	}
+
+	now := time.Now()
+	notifications := make([]entity.Notification, 0, len(recipients))
+
+	for _, userID := range recipients {
+		_, err := uc.workspaceRepo.GetWorkspaceMember(ctx, board.WorkspaceID, userID)
+
+		if errors.Is(err, repository.ErrWorkspaceMemberNotFound) {
+			uc.log.Info(ctx, header+"Leaving out user with no access to the board", "userID", userID)
+			continue
+		}
+
+		if err != nil {
+			info := "Failed to get membership"
+			uc.log.Error(ctx, header+info, "err", err.Error())
+			return nil, fmt.Errorf(header+info+": %w", ErrNotifyCard)
+		}
+
+		notifications = append(notifications, entity.Notification{
+			ID:        uuid.New(),
+			UserID:    userID,
+			ActorID:   actorID,
+			Kind:      kinds[userID],
+			BoardID:   boardID,
+			CardID:    cardID,
+			CardTitle: card.Title,
+			CreatedAt: now,
+		})
+	}
+
+	uc.log.Info(ctx, header+"Making request to notification repo (AddNotifications)", "count", len(notifications))
+
+	err = uc.notifyRepo.AddNotifications(ctx, notifications)
+
+	if err != nil {
+		info := "Failed to add notifications"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", ErrNotifyCard)
+	}
+
+	uc.log.Info(ctx, header+"Card watchers successfully notified", "count", len(notifications))
+
+	return notifications, nil
+}
+
+func (uc *notificationUseCase) GetNotifications(ctx context.Context, userID uuid.UUID, unreadOnly bool, page repository.Page) ([]entity.Notification, *repository.Cursor, error) {
+	header := "GetNotifications: "
+
+	uc.log.Info(ctx, header+"Usecase called; Validating page", "userID", userID, "unreadOnly", unreadOnly, "page", page)
+
+	err := validatePage(page)
+
+	if err != nil {
+		info := "Validation failed"
+		uc.log.Info(ctx, header+info, "err", err.Error())
+		return nil, nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	uc.log.Info(ctx, header+"Making request to notification repo (GetNotifications)", "userID", userID, "page", page)
+
+	notifications, err := uc.notifyRepo.GetNotifications(ctx, userID, unreadOnly, page)
+
+	if err != nil {
+		info := "Failed to get notifications"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return nil, nil, fmt.Errorf(header+info+": %w", ErrGetNotifications)
+	}
+
+	uc.log.Info(ctx, header+"Got notifications", "count", len(notifications))
+
+	var next *repository.Cursor
+	if len(notifications) == page.Limit {
+		last := notifications[len(notifications)-1]
+		next = repository.TimeCursor(last.CreatedAt, last.ID)
+	}
+
+	return notifications, next, nil
+}
+
+func (uc *notificationUseCase) MarkNotificationsRead(ctx context.Context, userID uuid.UUID, ids []uuid.UUID) error {
+	header := "MarkNotificationsRead: "
+
+	uc.log.Info(ctx, header+"Usecase called; Making request to notification repo (MarkNotificationsRead)", "userID", userID, "ids", ids)
+
+	err := uc.notifyRepo.MarkNotificationsRead(ctx, userID, ids, time.Now())
+
+	if err != nil {
+		info := "Failed to mark notifications read"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return fmt.Errorf(header+info+": %w", ErrMarkNotifications)
+	}
+
+	uc.log.Info(ctx, header+"Notifications successfully marked read")
+
+	return nil
+}
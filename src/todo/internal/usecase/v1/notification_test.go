@@ -0,0 +1,353 @@
+package v1_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+	log "todo/internal/adapter/logger"
+	"todo/internal/entity"
+	"todo/internal/repository"
+	"todo/internal/testdata"
+	v1 "todo/internal/usecase/v1"
+	"todo/mocks"
+
+	"github.com/google/uuid"
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/runner"
+	"github.com/stretchr/testify/mock"
+)
+
+type notificationMocks struct {
+	notifyRepo    *mocks.NotificationRepository
+	boardRepo     *mocks.BoardRepository
+	columnRepo    *mocks.ColumnRepository
+	cardRepo      *mocks.CardRepository
+	workspaceRepo *mocks.WorkspaceRepository
+}
+
+func newNotificationMocks() notificationMocks {
+	return notificationMocks{
+		notifyRepo:    new(mocks.NotificationRepository),
+		boardRepo:     new(mocks.BoardRepository),
+		columnRepo:    new(mocks.ColumnRepository),
+		cardRepo:      new(mocks.CardRepository),
+		workspaceRepo: new(mocks.WorkspaceRepository),
+	}
+}
+
+func (m notificationMocks) assertExpectations(t *testing.T) {
+	m.notifyRepo.AssertExpectations(t)
+	m.boardRepo.AssertExpectations(t)
+	m.columnRepo.AssertExpectations(t)
+	m.cardRepo.AssertExpectations(t)
+	m.workspaceRepo.AssertExpectations(t)
+}
+
+func TestWatch(t *testing.T) {
+	runner.Run(t, "TestWatch", func(pt provider.T) {
+		mom := &testdata.ObjectMother{}
+
+		userID := mom.GetUUID(0)
+		boardID := mom.GetUUID(1)
+		columnID := mom.GetUUID(2)
+		cardID := mom.GetUUID(3)
+		workspaceID := mom.GetUUID(4)
+		board := &entity.Board{ID: boardID, UserID: mom.GetUUID(5), WorkspaceID: workspaceID}
+		card := &entity.Card{ID: cardID, ColumnID: columnID}
+		viewer := &entity.WorkspaceMember{WorkspaceID: workspaceID, UserID: userID, Role: entity.RoleViewer}
+
+		tests := []struct {
+			name      string
+			watch     entity.Watch
+			mockSetup func(m notificationMocks)
+			wantErr   bool
+			err       error
+		}{
+			{
+				name:  "positive card",
+				watch: entity.Watch{UserID: userID, CardID: cardID},
+				mockSetup: func(m notificationMocks) {
+					m.cardRepo.On("GetCardByID", mock.Anything, cardID).Return(card, nil)
+					m.columnRepo.On("GetColumnByID", mock.Anything, columnID).Return(&entity.Column{ID: columnID, BoardID: boardID}, nil)
+					m.boardRepo.On("GetBoardByID", mock.Anything, boardID).Return(board, nil)
+					m.workspaceRepo.On("GetWorkspaceMember", mock.Anything, workspaceID, userID).Return(viewer, nil)
+					m.notifyRepo.On("AddWatch", mock.Anything, mock.MatchedBy(func(w *entity.Watch) bool {
+						return w.UserID == userID && w.CardID == cardID && !w.CreatedAt.IsZero()
+					})).Return(nil)
+				},
+				wantErr: false,
+			},
+			{
+				name:  "positive board",
+				watch: entity.Watch{UserID: userID, BoardID: boardID},
+				mockSetup: func(m notificationMocks) {
+					m.boardRepo.On("GetBoardByID", mock.Anything, boardID).Return(board, nil)
+					m.workspaceRepo.On("GetWorkspaceMember", mock.Anything, workspaceID, userID).Return(viewer, nil)
+					m.notifyRepo.On("AddWatch", mock.Anything, mock.Anything).Return(nil)
+				},
+				wantErr: false,
+			},
+			{
+				name:      "both card and board",
+				watch:     entity.Watch{UserID: userID, BoardID: boardID, CardID: cardID},
+				mockSetup: func(m notificationMocks) {},
+				wantErr:   true,
+				err:       repository.ErrInvalidWatch,
+			},
+			{
+				name:  "card not found",
+				watch: entity.Watch{UserID: userID, CardID: cardID},
+				mockSetup: func(m notificationMocks) {
+					m.cardRepo.On("GetCardByID", mock.Anything, cardID).Return(nil, errors.New(""))
+				},
+				wantErr: true,
+				err:     repository.ErrCardNotFound,
+			},
+			{
+				name:  "not a member of the workspace",
+				watch: entity.Watch{UserID: userID, BoardID: boardID},
+				mockSetup: func(m notificationMocks) {
+					m.boardRepo.On("GetBoardByID", mock.Anything, boardID).Return(board, nil)
+					m.workspaceRepo.On("GetWorkspaceMember", mock.Anything, workspaceID, userID).Return(nil, repository.ErrWorkspaceMemberNotFound)
+				},
+				wantErr: true,
+				err:     repository.ErrBoardAccess,
+			},
+			{
+				name:  "negative",
+				watch: entity.Watch{UserID: userID, BoardID: boardID},
+				mockSetup: func(m notificationMocks) {
+					m.boardRepo.On("GetBoardByID", mock.Anything, boardID).Return(board, nil)
+					m.workspaceRepo.On("GetWorkspaceMember", mock.Anything, workspaceID, userID).Return(viewer, nil)
+					m.notifyRepo.On("AddWatch", mock.Anything, mock.Anything).Return(errors.New(""))
+				},
+				wantErr: true,
+				err:     v1.ErrWatch,
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				t.Parallel()
+
+				runner.Run(t, tt.name, func(pt provider.T) {
+					m := newNotificationMocks()
+					uc := v1.NewNotificationUseCase(m.notifyRepo, m.boardRepo, m.columnRepo, m.cardRepo, m.workspaceRepo, log.NewEmptyLogger())
+
+					tt.mockSetup(m)
+
+					pt.WithNewStep("Call Watch", func(sCtx provider.StepCtx) {
+						watch := tt.watch
+						err := uc.Watch(context.Background(), &watch)
+
+						if tt.wantErr {
+							sCtx.Assert().Error(err, "Expected error")
+							sCtx.Assert().ErrorIs(err, tt.err)
+						} else {
+							sCtx.Assert().NoError(err, "Expected no error")
+						}
+
+						m.assertExpectations(t)
+					})
+				})
+			})
+		}
+	})
+}
+
+func TestNotifyCard(t *testing.T) {
+	runner.Run(t, "TestNotifyCard", func(pt provider.T) {
+		mom := &testdata.ObjectMother{}
+
+		actorID := mom.GetUUID(0)
+		boardID := mom.GetUUID(1)
+		columnID := mom.GetUUID(2)
+		cardID := mom.GetUUID(3)
+		workspaceID := mom.GetUUID(4)
+		mentionedID := mom.GetUUID(5)
+		watcherID := mom.GetUUID(6)
+		outsiderID := mom.GetUUID(7)
+		board := &entity.Board{ID: boardID, UserID: actorID, WorkspaceID: workspaceID}
+		card := &entity.Card{ID: cardID, ColumnID: columnID, Title: "Card"}
+		member := func(userID uuid.UUID) *entity.WorkspaceMember {
+			return &entity.WorkspaceMember{WorkspaceID: workspaceID, UserID: userID, Role: entity.RoleMember}
+		}
+
+		cardSetup := func(m notificationMocks) {
+			m.cardRepo.On("GetCardByID", mock.Anything, cardID).Return(card, nil)
+			m.columnRepo.On("GetColumnByID", mock.Anything, columnID).Return(&entity.Column{ID: columnID, BoardID: boardID}, nil)
+		}
+
+		tests := []struct {
+			name      string
+			kind      string
+			mentioned []uuid.UUID
+			mockSetup func(m notificationMocks)
+			want      map[uuid.UUID]string
+			wantErr   bool
+			err       error
+		}{
+			{
+				name:      "positive",
+				kind:      entity.ActivityCardUpdated,
+				mentioned: []uuid.UUID{mentionedID, actorID, outsiderID},
+				mockSetup: func(m notificationMocks) {
+					cardSetup(m)
+					m.notifyRepo.On("GetWatchers", mock.Anything, boardID, cardID).Return([]uuid.UUID{actorID, mentionedID, watcherID}, nil)
+					m.boardRepo.On("GetBoardByID", mock.Anything, boardID).Return(board, nil)
+					m.workspaceRepo.On("GetWorkspaceMember", mock.Anything, workspaceID, mentionedID).Return(member(mentionedID), nil)
+					m.workspaceRepo.On("GetWorkspaceMember", mock.Anything, workspaceID, watcherID).Return(member(watcherID), nil)
+					m.workspaceRepo.On("GetWorkspaceMember", mock.Anything, workspaceID, outsiderID).Return(nil, repository.ErrWorkspaceMemberNotFound)
+					m.notifyRepo.On("AddNotifications", mock.Anything, mock.MatchedBy(func(ns []entity.Notification) bool {
+						return len(ns) == 2
+					})).Return(nil)
+				},
+				want: map[uuid.UUID]string{
+					mentionedID: entity.NotificationMention,
+					watcherID:   entity.ActivityCardUpdated,
+				},
+				wantErr: false,
+			},
+			{
+				name:      "unknown kind",
+				kind:      entity.ActivityBoardUpdated,
+				mockSetup: func(m notificationMocks) {},
+				wantErr:   true,
+				err:       repository.ErrInvalidNotificationKind,
+			},
+			{
+				name: "card not found",
+				kind: entity.ActivityCardCreated,
+				mockSetup: func(m notificationMocks) {
+					m.cardRepo.On("GetCardByID", mock.Anything, cardID).Return(nil, errors.New(""))
+				},
+				wantErr: true,
+				err:     repository.ErrCardNotFound,
+			},
+			{
+				name: "negative",
+				kind: entity.ActivityCardCreated,
+				mockSetup: func(m notificationMocks) {
+					cardSetup(m)
+					m.notifyRepo.On("GetWatchers", mock.Anything, boardID, cardID).Return([]uuid.UUID{watcherID}, nil)
+					m.boardRepo.On("GetBoardByID", mock.Anything, boardID).Return(board, nil)
+					m.workspaceRepo.On("GetWorkspaceMember", mock.Anything, workspaceID, watcherID).Return(member(watcherID), nil)
+					m.notifyRepo.On("AddNotifications", mock.Anything, mock.Anything).Return(errors.New(""))
+				},
+				wantErr: true,
+				err:     v1.ErrNotifyCard,
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				t.Parallel()
+
+				runner.Run(t, tt.name, func(pt provider.T) {
+					m := newNotificationMocks()
+					uc := v1.NewNotificationUseCase(m.notifyRepo, m.boardRepo, m.columnRepo, m.cardRepo, m.workspaceRepo, log.NewEmptyLogger())
+
+					tt.mockSetup(m)
+
+					pt.WithNewStep("Call NotifyCard", func(sCtx provider.StepCtx) {
+						notifications, err := uc.NotifyCard(context.Background(), actorID, cardID, tt.kind, tt.mentioned)
+
+						if tt.wantErr {
+							sCtx.Assert().Error(err, "Expected error")
+							sCtx.Assert().ErrorIs(err, tt.err)
+						} else {
+							sCtx.Assert().NoError(err, "Expected no error")
+							sCtx.Assert().Len(notifications, len(tt.want))
+							for _, n := range notifications {
+								sCtx.Assert().Equal(tt.want[n.UserID], n.Kind)
+								sCtx.Assert().Equal(actorID, n.ActorID)
+								sCtx.Assert().Equal(card.Title, n.CardTitle)
+							}
+						}
+
+						m.assertExpectations(t)
+					})
+				})
+			})
+		}
+	})
+}
+
+func TestGetNotifications(t *testing.T) {
+	runner.Run(t, "TestGetNotifications", func(pt provider.T) {
+		mom := &testdata.ObjectMother{}
+
+		userID := mom.GetUUID(0)
+		now := time.Now()
+		notifications := []entity.Notification{
+			{ID: mom.GetUUID(1), UserID: userID, CreatedAt: now},
+			{ID: mom.GetUUID(2), UserID: userID, CreatedAt: now.Add(-time.Minute)},
+		}
+
+		tests := []struct {
+			name      string
+			page      repository.Page
+			mockSetup func(m notificationMocks)
+			wantNext  bool
+			wantErr   bool
+			err       error
+		}{
+			{
+				name: "positive with next page",
+				page: repository.Page{Limit: 2},
+				mockSetup: func(m notificationMocks) {
+					m.notifyRepo.On("GetNotifications", mock.Anything, userID, true, repository.Page{Limit: 2}).Return(notifications, nil)
+				},
+				wantNext: true,
+				wantErr:  false,
+			},
+			{
+				name:      "zero limit",
+				page:      repository.Page{},
+				mockSetup: func(m notificationMocks) {},
+				wantErr:   true,
+				err:       repository.ErrZeroLimit,
+			},
+			{
+				name: "negative",
+				page: repository.Page{Limit: 10},
+				mockSetup: func(m notificationMocks) {
+					m.notifyRepo.On("GetNotifications", mock.Anything, userID, true, mock.Anything).Return(nil, errors.New(""))
+				},
+				wantErr: true,
+				err:     v1.ErrGetNotifications,
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				t.Parallel()
+
+				runner.Run(t, tt.name, func(pt provider.T) {
+					m := newNotificationMocks()
+					uc := v1.NewNotificationUseCase(m.notifyRepo, m.boardRepo, m.columnRepo, m.cardRepo, m.workspaceRepo, log.NewEmptyLogger())
+
+					tt.mockSetup(m)
+
+					pt.WithNewStep("Call GetNotifications", func(sCtx provider.StepCtx) {
+						got, next, err := uc.GetNotifications(context.Background(), userID, true, tt.page)
+
+						if tt.wantErr {
+							sCtx.Assert().Error(err, "Expected error")
+							sCtx.Assert().ErrorIs(err, tt.err)
+						} else {
+							sCtx.Assert().NoError(err, "Expected no error")
+							sCtx.Assert().Equal(notifications, got)
+							if tt.wantNext {
+								sCtx.Assert().Equal(notifications[1].ID, next.ID)
+							}
+						}
+
+						m.assertExpectations(t)
+					})
+				})
+			})
+		}
+	})
+}
@@ -0,0 +1,627 @@
+package v1
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+	"todo/internal/common/logger"
+	"todo/internal/entity"
+	"todo/internal/repository"
+	"todo/internal/usecase"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrGetQuota    = errors.New("failed to get quota")
+	ErrSetQuota    = errors.New("failed to set quota override")
+	ErrDeleteQuota = errors.New("failed to delete quota override")
+)
+
+// quotaUseCase wraps a TodoUseCase. Boards, columns and cards are counted
+// before they are made or moved, in one unit of work with the write, which
+// locks the quota of what it counts first. Writes the wrapped usecase would
+// reject are passed on unchecked for it to.
+type quotaUseCase struct {
+	usecase.TodoUseCase
+
+	quotaRepo  repository.QuotaRepository
+	boardRepo  repository.BoardRepository
+	columnRepo repository.ColumnRepository
+	cardRepo   repository.CardRepository
+	tx         repository.TxManager
+	quota      entity.Quota
+	log        logger.Logger
+}
+
+func NewQuotaUseCase(
+	uc usecase.TodoUseCase,
+	quotaRepo repository.QuotaRepository,
+	boardRepo repository.BoardRepository,
+	columnRepo repository.ColumnRepository,
+	cardRepo repository.CardRepository,
+	tx repository.TxManager,
+	quota entity.Quota,
+	log logger.Logger,
+) usecase.QuotaUseCase {
+	return &quotaUseCase{
+		TodoUseCase: uc,
+		quotaRepo:   quotaRepo,
+		boardRepo:   boardRepo,
+		columnRepo:  columnRepo,
+		cardRepo:    cardRepo,
+		tx:          tx,
+		quota:       quota,
+		log:         log,
+	}
+}
+
+func (uc *quotaUseCase) CreateBoard(ctx context.Context, board *entity.Board) error {
+	header := "CreateBoard: "
+
+	if validateBoard(board) != nil {
+		return uc.TodoUseCase.CreateBoard(ctx, board)
+	}
+
+	return uc.tx.WithinTx(ctx, func(ctx context.Context) error {
+		err := checkBoardQuota(ctx, uc.quotaRepo, uc.boardRepo, uc.quota, uc.log, header, board.UserID, 1, ErrCreateBoard)
+		if err != nil {
+			return err
+		}
+
+		return uc.TodoUseCase.CreateBoard(ctx, board)
+	})
+}
+
+func (uc *quotaUseCase) CreateColumn(ctx context.Context, column *entity.Column) error {
+	header := "CreateColumn: "
+
+	if validateColumn(column) != nil {
+		return uc.TodoUseCase.CreateColumn(ctx, column)
+	}
+
+	return uc.tx.WithinTx(ctx, func(ctx context.Context) error {
+		if err := uc.checkColumns(ctx, header, column.BoardID, 1, ErrCreateColumn); err != nil {
+			return err
+		}
+
+		return uc.TodoUseCase.CreateColumn(ctx, column)
+	})
+}
+
+// MoveColumn checks the columns of the board the column goes to.
+func (uc *quotaUseCase) MoveColumn(ctx context.Context, userID, id, boardID uuid.UUID, version int) (*entity.Column, error) {
+	header := "MoveColumn: "
+
+	var column *entity.Column
+
+	err := uc.tx.WithinTx(ctx, func(ctx context.Context) error {
+		uc.log.Info(ctx, header+"Checking quota; Making request to column repo (GetColumnByID)", "id", id)
+
+		stored, err := uc.columnRepo.GetColumnByID(ctx, id)
+
+		if err == nil && stored.BoardID != boardID {
+			if err := uc.checkColumns(ctx, header, boardID, 1, ErrMoveColumn); err != nil {
+				return err
+			}
+		}
+
+		column, err = uc.TodoUseCase.MoveColumn(ctx, userID, id, boardID, version)
+		return err
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return column, nil
+}
+
+// MergeBoards checks the columns of the target board against those the
+// merge adds to it: the source columns not combined with one of its own.
+func (uc *quotaUseCase) MergeBoards(ctx context.Context, userID, sourceID, targetID uuid.UUID, strategy string) (*entity.BoardMerge, error) {
+	header := "MergeBoards: "
+
+	if validateMerge(sourceID, targetID, strategy) != nil {
+		return uc.TodoUseCase.MergeBoards(ctx, userID, sourceID, targetID, strategy)
+	}
+
+	var merge *entity.BoardMerge
+
+	err := uc.tx.WithinTx(ctx, func(ctx context.Context) error {
+		uc.log.Info(ctx, header+"Checking quota; Making request to column repo (GetColumnsByBoard)", "sourceID", sourceID, "targetID", targetID)
+
+		sources, err := boardColumns(ctx, uc.columnRepo, sourceID)
+
+		if err != nil {
+			info := "Failed to get columns of the source board"
+			uc.log.Error(ctx, header+info, "err", err.Error())
+			return fmt.Errorf(header+info+": %w", ErrMergeBoards)
+		}
+
+		targets, err := boardColumns(ctx, uc.columnRepo, targetID)
+
+		if err != nil {
+			info := "Failed to get columns of the target board"
+			uc.log.Error(ctx, header+info, "err", err.Error())
+			return fmt.Errorf(header+info+": %w", ErrMergeBoards)
+		}
+
+		adding := len(sources)
+		if strategy == entity.MergeColumnsCombine {
+			for _, column := range sources {
+				if matchingColumn(targets, column.Title) != nil {
+					adding--
+				}
+			}
+		}
+
+		if err := uc.checkColumns(ctx, header, targetID, adding, ErrMergeBoards); err != nil {
+			return err
+		}
+
+		merge, err = uc.TodoUseCase.MergeBoards(ctx, userID, sourceID, targetID, strategy)
+		return err
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return merge, nil
+}
+
+// checkColumns locks the columns of the board and holds adding more of them
+// to the quota of the owner of the board. Failures to check are failed.
+func (uc *quotaUseCase) checkColumns(ctx context.Context, header string, boardID uuid.UUID, adding int, failed error) error {
+	quota, err := uc.lockBoardQuota(ctx, header, boardID, boardID)
+
+	if errors.Is(err, repository.ErrBoardNotFound) {
+		// The wrapped usecase reports the missing board.
+		return nil
+	}
+
+	if err != nil {
+		info := "Failed to get quota of board owner"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return fmt.Errorf(header+info+": %w", failed)
+	}
+
+	uc.log.Info(ctx, header+"Checking quota; Making request to column repo (GetColumnsByBoard)", "boardID", boardID, "quota", quota)
+
+	over, err := exceeds(quota.ColumnsPerBoard, adding, func(page repository.Page) (int, error) {
+		columns, err := uc.columnRepo.GetColumnsByBoard(ctx, boardID, page)
+		return len(columns), err
+	})
+
+	if err != nil {
+		info := "Failed to count columns of board"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return fmt.Errorf(header+info+": %w", failed)
+	}
+
+	if over {
+		return quotaExceeded(ctx, uc.log, header, repository.QuotaColumnsPerBoard, quota.ColumnsPerBoard)
+	}
+
+	return nil
+}
+
+func (uc *quotaUseCase) CreateCard(ctx context.Context, card *entity.Card) error {
+	header := "CreateCard: "
+
+	if validateCard(card) != nil {
+		return uc.TodoUseCase.CreateCard(ctx, card)
+	}
+
+	return uc.tx.WithinTx(ctx, func(ctx context.Context) error {
+		if err := uc.checkCard(ctx, header, card, true, ErrCreateCard); err != nil {
+			return err
+		}
+
+		return uc.TodoUseCase.CreateCard(ctx, card)
+	})
+}
+
+// UpdateCard checks the description of the card and, if it goes to
+// another column, the cards there.
+func (uc *quotaUseCase) UpdateCard(ctx context.Context, card *entity.Card) error {
+	header := "UpdateCard: "
+
+	if err := validateCard(card); err != nil && err != ErrCardNoColumnID && err != ErrCardNoUserID {
+		return uc.TodoUseCase.UpdateCard(ctx, card)
+	}
+
+	return uc.tx.WithinTx(ctx, func(ctx context.Context) error {
+		uc.log.Info(ctx, header+"Checking quota; Making request to card repo (GetCardByID)", "id", card.ID)
+
+		stored, err := uc.cardRepo.GetCardByID(ctx, card.ID)
+
+		if errors.Is(err, repository.ErrCardNotFound) {
+			info := "Card not found"
+			uc.log.Info(ctx, header+info, "id", card.ID)
+			return fmt.Errorf(header+info+": %w", err)
+		}
+
+		if err != nil {
+			info := "Failed to get card"
+			uc.log.Error(ctx, header+info, "err", err.Error())
+			return fmt.Errorf(header+info+": %w", ErrUpdateCard)
+		}
+
+		target := *card
+		if target.ColumnID == uuid.Nil {
+			target.ColumnID = stored.ColumnID
+		}
+
+		if err := uc.checkCard(ctx, header, &target, target.ColumnID != stored.ColumnID, ErrUpdateCard); err != nil {
+			return err
+		}
+
+		return uc.TodoUseCase.UpdateCard(ctx, card)
+	})
+}
+
+// checkCard holds the description of the card, and the cards of its column
+// if it is to join them, to the quota of the owner of its board. Failures
+// to check are failed.
+func (uc *quotaUseCase) checkCard(ctx context.Context, header string, card *entity.Card, joins bool, failed error) error {
+	uc.log.Info(ctx, header+"Checking quota; Making request to column repo (GetColumnByID)", "columnID", card.ColumnID)
+
+	column, err := uc.columnRepo.GetColumnByID(ctx, card.ColumnID)
+
+	if errors.Is(err, repository.ErrColumnNotFound) {
+		info := "Column not found"
+		uc.log.Info(ctx, header+info, "columnID", card.ColumnID)
+		return fmt.Errorf(header+info+": %w", err)
+	}
+
+	if err != nil {
+		info := "Failed to get column"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return fmt.Errorf(header+info+": %w", failed)
+	}
+
+	quota, err := uc.lockBoardQuota(ctx, header, column.BoardID, column.ID)
+
+	if err != nil {
+		info := "Failed to get quota of board owner"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return fmt.Errorf(header+info+": %w", failed)
+	}
+
+	if quota.DescriptionLength > 0 && utf8.RuneCountInString(card.Description) > quota.DescriptionLength {
+		return quotaExceeded(ctx, uc.log, header, repository.QuotaDescriptionLength, quota.DescriptionLength)
+	}
+
+	if !joins {
+		return nil
+	}
+
+	uc.log.Info(ctx, header+"Making request to card repo (GetCardsByColumn)", "columnID", card.ColumnID, "quota", quota)
+
+	over, err := exceeds(quota.CardsPerColumn, 1, func(page repository.Page) (int, error) {
+		cards, err := uc.cardRepo.GetCardsByColumn(ctx, card.ColumnID, page)
+		return len(cards), err
+	})
+
+	if err != nil {
+		info := "Failed to count cards of column"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return fmt.Errorf(header+info+": %w", failed)
+	}
+
+	if over {
+		return quotaExceeded(ctx, uc.log, header, repository.QuotaCardsPerColumn, quota.CardsPerColumn)
+	}
+
+	return nil
+}
+
+// ApplyCardOps checks the cards of every column the batch moves cards to.
+// Moves past the quota of a column fail on their own, the later ones in
+// the batch first, and fail the whole batch when it is all or nothing.
+func (uc *quotaUseCase) ApplyCardOps(ctx context.Context, ops []repository.CardOp, allOrNothing bool) ([]repository.CardOpResult, error) {
+	header := "ApplyCardOps: "
+
+	var results []repository.CardOpResult
+
+	err := uc.tx.WithinTx(ctx, func(ctx context.Context) error {
+		refused, err := uc.checkMoves(ctx, header, ops)
+		if err != nil {
+			return err
+		}
+
+		results, err = applyUnrefused(ops, refused, allOrNothing, func(ops []repository.CardOp) ([]repository.CardOpResult, error) {
+			return uc.TodoUseCase.ApplyCardOps(ctx, ops, allOrNothing)
+		})
+		return err
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+// checkMoves tells, for every operation, why it is refused, if it is.
+// Moves the wrapped usecase would reject are left for it to.
+func (uc *quotaUseCase) checkMoves(ctx context.Context, header string, ops []repository.CardOp) ([]error, error) {
+	refused := make([]error, len(ops))
+
+	// moves holds the operations moving a card into each column from
+	// another one, in the order of the batch.
+	moves := make(map[uuid.UUID][]int)
+	var columns []uuid.UUID
+
+	for i, op := range ops {
+		if op.Kind != repository.CardOpMove || validateCardOp(op) != nil {
+			continue
+		}
+
+		uc.log.Info(ctx, header+"Checking quota; Making request to card repo (GetCardByID)", "id", op.CardID)
+
+		card, err := uc.cardRepo.GetCardByID(ctx, op.CardID)
+
+		if errors.Is(err, repository.ErrCardNotFound) {
+			continue
+		}
+
+		if err != nil {
+			info := "Failed to get card"
+			uc.log.Error(ctx, header+info, "err", err.Error())
+			return nil, fmt.Errorf(header+info+": %w", ErrApplyCardOps)
+		}
+
+		if card.ColumnID == op.ColumnID {
+			continue
+		}
+
+		if moves[op.ColumnID] == nil {
+			columns = append(columns, op.ColumnID)
+		}
+		moves[op.ColumnID] = append(moves[op.ColumnID], i)
+	}
+
+	// Columns are locked in one order, so that batches moving cards into the
+	// same columns do not wait on each other.
+	sort.Slice(columns, func(i, j int) bool { return bytes.Compare(columns[i][:], columns[j][:]) < 0 })
+
+	for _, columnID := range columns {
+		uc.log.Info(ctx, header+"Checking quota; Making request to column repo (GetColumnByID)", "columnID", columnID)
+
+		column, err := uc.columnRepo.GetColumnByID(ctx, columnID)
+
+		if errors.Is(err, repository.ErrColumnNotFound) {
+			continue
+		}
+
+		if err != nil {
+			info := "Failed to get column"
+			uc.log.Error(ctx, header+info, "err", err.Error())
+			return nil, fmt.Errorf(header+info+": %w", ErrApplyCardOps)
+		}
+
+		quota, err := uc.lockBoardQuota(ctx, header, column.BoardID, columnID)
+
+		if err != nil {
+			info := "Failed to get quota of board owner"
+			uc.log.Error(ctx, header+info, "err", err.Error())
+			return nil, fmt.Errorf(header+info+": %w", ErrApplyCardOps)
+		}
+
+		if quota.CardsPerColumn <= 0 {
+			continue
+		}
+
+		uc.log.Info(ctx, header+"Making request to card repo (GetCardsByColumn)", "columnID", columnID, "quota", quota)
+
+		cards, err := uc.cardRepo.GetCardsByColumn(ctx, columnID, repository.Page{Limit: quota.CardsPerColumn})
+
+		if err != nil {
+			info := "Failed to count cards of column"
+			uc.log.Error(ctx, header+info, "err", err.Error())
+			return nil, fmt.Errorf(header+info+": %w", ErrApplyCardOps)
+		}
+
+		room := quota.CardsPerColumn - len(cards)
+		for k, i := range moves[columnID] {
+			if k >= room {
+				refused[i] = quotaExceeded(ctx, uc.log, header, repository.QuotaCardsPerColumn, quota.CardsPerColumn)
+			}
+		}
+	}
+
+	return refused, nil
+}
+
+// lockBoardQuota locks the scope, the board or one of its columns, and
+// reads the quota of the owner of the board.
+func (uc *quotaUseCase) lockBoardQuota(ctx context.Context, header string, boardID, scope uuid.UUID) (entity.Quota, error) {
+	uc.log.Info(ctx, header+"Making request to quota repo (LockQuota)", "scope", scope)
+
+	if err := uc.quotaRepo.LockQuota(ctx, scope); err != nil {
+		return entity.Quota{}, err
+	}
+
+	uc.log.Info(ctx, header+"Making request to board repo (GetBoardByID)", "boardID", boardID)
+
+	board, err := uc.boardRepo.GetBoardByID(ctx, boardID)
+	if err != nil {
+		return entity.Quota{}, err
+	}
+
+	return userQuota(ctx, uc.quotaRepo, uc.quota, board.UserID)
+}
+
+// checkBoardQuota locks the boards of the user and holds adding more of them
+// to their quota. Failures to check are failed.
+func checkBoardQuota(ctx context.Context, quotaRepo repository.QuotaRepository, boardRepo repository.BoardRepository,
+	configured entity.Quota, log logger.Logger, header string, userID uuid.UUID, adding int, failed error) error {
+	log.Info(ctx, header+"Checking quota; Making request to quota repo (LockQuota)", "userID", userID)
+
+	if err := quotaRepo.LockQuota(ctx, userID); err != nil {
+		info := "Failed to lock quota of user"
+		log.Error(ctx, header+info, "err", err.Error())
+		return fmt.Errorf(header+info+": %w", failed)
+	}
+
+	quota, err := userQuota(ctx, quotaRepo, configured, userID)
+
+	if err != nil {
+		info := "Failed to get quota of user"
+		log.Error(ctx, header+info, "err", err.Error())
+		return fmt.Errorf(header+info+": %w", failed)
+	}
+
+	log.Info(ctx, header+"Making request to board repo (GetBoardsByUser)", "userID", userID, "quota", quota)
+
+	over, err := exceeds(quota.BoardsPerUser, adding, func(page repository.Page) (int, error) {
+		boards, err := boardRepo.GetBoardsByUser(ctx, userID, page)
+		return len(boards), err
+	})
+
+	if err != nil {
+		info := "Failed to count boards of user"
+		log.Error(ctx, header+info, "err", err.Error())
+		return fmt.Errorf(header+info+": %w", failed)
+	}
+
+	if over {
+		return quotaExceeded(ctx, log, header, repository.QuotaBoardsPerUser, quota.BoardsPerUser)
+	}
+
+	return nil
+}
+
+// exceeds tells whether adding things to those already there, which count
+// counts up to the limit of the page it is given, takes them past bound. A
+// bound of zero is never passed.
+func exceeds(bound, adding int, count func(page repository.Page) (int, error)) (bool, error) {
+	if bound <= 0 || adding <= 0 {
+		return false, nil
+	}
+
+	n, err := count(repository.Page{Limit: bound})
+	if err != nil {
+		return false, err
+	}
+
+	return n+adding > bound, nil
+}
+
+func quotaExceeded(ctx context.Context, log logger.Logger, header, quota string, limit int) error {
+	err := &repository.QuotaError{Quota: quota, Limit: limit}
+
+	info := "Quota exceeded"
+	log.Info(ctx, header+info, "err", err.Error())
+	return fmt.Errorf(header+info+": %w", err)
+}
+
+// userQuota is the configured quota with the override of the user, if they
+// have one, applied.
+func userQuota(ctx context.Context, quotaRepo repository.QuotaRepository, quota entity.Quota, userID uuid.UUID) (entity.Quota, error) {
+	override, err := quotaRepo.GetQuotaOverride(ctx, userID)
+
+	if errors.Is(err, repository.ErrQuotaOverrideNotFound) {
+		return quota, nil
+	}
+
+	if err != nil {
+		return entity.Quota{}, err
+	}
+
+	return override.Apply(quota), nil
+}
+
+func (uc *quotaUseCase) GetUserQuota(ctx context.Context, userID uuid.UUID) (*entity.UserQuota, error) {
+	header := "GetUserQuota: "
+
+	uc.log.Info(ctx, header+"Usecase called; Making request to quota repo (GetQuotaOverride)", "userID", userID)
+
+	override, err := uc.quotaRepo.GetQuotaOverride(ctx, userID)
+
+	if errors.Is(err, repository.ErrQuotaOverrideNotFound) {
+		uc.log.Info(ctx, header+"User has the configured quota", "userID", userID)
+		return &entity.UserQuota{UserID: userID, Quota: uc.quota}, nil
+	}
+
+	if err != nil {
+		info := "Failed to get quota override"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", ErrGetQuota)
+	}
+
+	uc.log.Info(ctx, header+"Got quota override", "override", override)
+
+	return &entity.UserQuota{UserID: userID, Quota: override.Apply(uc.quota), Override: override}, nil
+}
+
+func (uc *quotaUseCase) SetQuotaOverride(ctx context.Context, override *entity.QuotaOverride) error {
+	header := "SetQuotaOverride: "
+
+	uc.log.Info(ctx, header+"Usecase called; Validating override", "override", override)
+
+	if !validQuotaOverride(override) {
+		info := "Validation failed"
+		uc.log.Info(ctx, header+info, "err", repository.ErrQuotaInvalid.Error())
+		return fmt.Errorf(header+info+": %w", repository.ErrQuotaInvalid)
+	}
+
+	override.UpdatedAt = time.Now()
+
+	uc.log.Info(ctx, header+"Making request to quota repo (SetQuotaOverride)", "override", override)
+
+	if err := uc.quotaRepo.SetQuotaOverride(ctx, override); err != nil {
+		info := "Failed to set quota override"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return fmt.Errorf(header+info+": %w", ErrSetQuota)
+	}
+
+	uc.log.Info(ctx, header+"Quota override successfully set")
+
+	return nil
+}
+
+func validQuotaOverride(override *entity.QuotaOverride) bool {
+	if override.UserID == uuid.Nil {
+		return false
+	}
+
+	for _, bound := range []*int{override.BoardsPerUser, override.ColumnsPerBoard, override.CardsPerColumn, override.DescriptionLength} {
+		if bound != nil && *bound < 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (uc *quotaUseCase) DeleteQuotaOverride(ctx context.Context, userID uuid.UUID) error {
+	header := "DeleteQuotaOverride: "
+
+	uc.log.Info(ctx, header+"Usecase called; Making request to quota repo (DeleteQuotaOverride)", "userID", userID)
+
+	err := uc.quotaRepo.DeleteQuotaOverride(ctx, userID)
+
+	if errors.Is(err, repository.ErrQuotaOverrideNotFound) {
+		info := "User has no quota override"
+		uc.log.Info(ctx, header+info, "userID", userID)
+		return fmt.Errorf(header+info+": %w", err)
+	}
+
+	if err != nil {
+		info := "Failed to delete quota override"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return fmt.Errorf(header+info+": %w", ErrDeleteQuota)
+	}
+
+	uc.log.Info(ctx, header+"Quota override successfully deleted")
+
+	return nil
+}
@@ -0,0 +1,766 @@
+package v1_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	log "todo/internal/adapter/logger"
+	"todo/internal/adapter/repository/memory"
+	"todo/internal/entity"
+	"todo/internal/repository"
+	"todo/internal/testdata"
+	v1 "todo/internal/usecase/v1"
+	"todo/mocks"
+
+	"github.com/google/uuid"
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/runner"
+	"github.com/stretchr/testify/mock"
+)
+
+type quotaMocks struct {
+	quotaRepo  *mocks.QuotaRepository
+	boardRepo  *mocks.BoardRepository
+	columnRepo *mocks.ColumnRepository
+	cardRepo   *mocks.CardRepository
+	todo       *mocks.TodoUseCase
+}
+
+func newQuotaMocks() quotaMocks {
+	return quotaMocks{
+		quotaRepo:  new(mocks.QuotaRepository),
+		boardRepo:  new(mocks.BoardRepository),
+		columnRepo: new(mocks.ColumnRepository),
+		cardRepo:   new(mocks.CardRepository),
+		todo:       new(mocks.TodoUseCase),
+	}
+}
+
+func (m quotaMocks) assert(t *testing.T) {
+	m.quotaRepo.AssertExpectations(t)
+	m.boardRepo.AssertExpectations(t)
+	m.columnRepo.AssertExpectations(t)
+	m.cardRepo.AssertExpectations(t)
+	m.todo.AssertExpectations(t)
+}
+
+var testQuota = entity.Quota{BoardsPerUser: 2, ColumnsPerBoard: 2, CardsPerColumn: 2, DescriptionLength: 10}
+
+func bound(n int) *int {
+	return &n
+}
+
+func TestQuotaCreateBoard(t *testing.T) {
+	runner.Run(t, "TestQuotaCreateBoard", func(pt provider.T) {
+		mom := &testdata.ObjectMother{}
+
+		userID := mom.GetUUID(0)
+		board := entity.Board{UserID: userID, Title: "Backend"}
+
+		tests := []struct {
+			name      string
+			mockSetup func(m quotaMocks)
+			wantErr   bool
+			err       error
+			quota     string
+		}{
+			{
+				name: "positive",
+				mockSetup: func(m quotaMocks) {
+					m.quotaRepo.On("LockQuota", mock.Anything, userID).Return(nil)
+					m.quotaRepo.On("GetQuotaOverride", mock.Anything, userID).Return(nil, repository.ErrQuotaOverrideNotFound)
+					m.boardRepo.On("GetBoardsByUser", mock.Anything, userID, repository.Page{Limit: 2}).Return([]entity.Board{{}}, nil)
+					m.todo.On("CreateBoard", mock.Anything, mock.Anything).Return(nil)
+				},
+				wantErr: false,
+			},
+			{
+				name: "at bound",
+				mockSetup: func(m quotaMocks) {
+					m.quotaRepo.On("LockQuota", mock.Anything, userID).Return(nil)
+					m.quotaRepo.On("GetQuotaOverride", mock.Anything, userID).Return(nil, repository.ErrQuotaOverrideNotFound)
+					m.boardRepo.On("GetBoardsByUser", mock.Anything, userID, repository.Page{Limit: 2}).Return([]entity.Board{{}, {}}, nil)
+				},
+				wantErr: true,
+				err:     repository.ErrQuotaExceeded,
+				quota:   repository.QuotaBoardsPerUser,
+			},
+			{
+				name: "override raises bound",
+				mockSetup: func(m quotaMocks) {
+					m.quotaRepo.On("LockQuota", mock.Anything, userID).Return(nil)
+					m.quotaRepo.On("GetQuotaOverride", mock.Anything, userID).
+						Return(&entity.QuotaOverride{UserID: userID, BoardsPerUser: bound(3)}, nil)
+					m.boardRepo.On("GetBoardsByUser", mock.Anything, userID, repository.Page{Limit: 3}).Return([]entity.Board{{}, {}}, nil)
+					m.todo.On("CreateBoard", mock.Anything, mock.Anything).Return(nil)
+				},
+				wantErr: false,
+			},
+			{
+				name: "override unreadable",
+				mockSetup: func(m quotaMocks) {
+					m.quotaRepo.On("LockQuota", mock.Anything, userID).Return(nil)
+					m.quotaRepo.On("GetQuotaOverride", mock.Anything, userID).Return(nil, errors.New(""))
+				},
+				wantErr: true,
+				err:     v1.ErrCreateBoard,
+			},
+			{
+				name: "negative",
+				mockSetup: func(m quotaMocks) {
+					m.quotaRepo.On("LockQuota", mock.Anything, userID).Return(nil)
+					m.quotaRepo.On("GetQuotaOverride", mock.Anything, userID).Return(nil, repository.ErrQuotaOverrideNotFound)
+					m.boardRepo.On("GetBoardsByUser", mock.Anything, userID, repository.Page{Limit: 2}).Return(nil, errors.New(""))
+				},
+				wantErr: true,
+				err:     v1.ErrCreateBoard,
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				t.Parallel()
+
+				runner.Run(t, tt.name, func(pt provider.T) {
+					m := newQuotaMocks()
+					logger := log.NewEmptyLogger()
+
+					uc := v1.NewQuotaUseCase(m.todo, m.quotaRepo, m.boardRepo, m.columnRepo, m.cardRepo, memory.NewTxManager(), testQuota, logger)
+
+					tt.mockSetup(m)
+
+					pt.WithNewStep("Call CreateBoard", func(sCtx provider.StepCtx) {
+						board := board
+						err := uc.CreateBoard(context.Background(), &board)
+
+						if tt.wantErr {
+							sCtx.Assert().Error(err, "Expected error")
+							sCtx.Assert().ErrorIs(err, tt.err)
+							assertQuota(sCtx, err, tt.quota)
+						} else {
+							sCtx.Assert().NoError(err, "Expected no error")
+						}
+
+						m.assert(t)
+					})
+				})
+			})
+		}
+	})
+}
+
+func TestQuotaCreateCard(t *testing.T) {
+	runner.Run(t, "TestQuotaCreateCard", func(pt provider.T) {
+		mom := &testdata.ObjectMother{}
+
+		ownerID := mom.GetUUID(0)
+		userID := mom.GetUUID(1)
+		boardID := mom.GetUUID(2)
+		columnID := mom.GetUUID(3)
+
+		board := &entity.Board{ID: boardID, UserID: ownerID}
+		column := &entity.Column{ID: columnID, BoardID: boardID}
+
+		tests := []struct {
+			name      string
+			card      entity.Card
+			mockSetup func(m quotaMocks)
+			wantErr   bool
+			err       error
+			quota     string
+		}{
+			{
+				name: "positive",
+				card: entity.Card{UserID: userID, ColumnID: columnID, Title: "Fix login", Description: "Soon"},
+				mockSetup: func(m quotaMocks) {
+					m.columnRepo.On("GetColumnByID", mock.Anything, columnID).Return(column, nil)
+					m.quotaRepo.On("LockQuota", mock.Anything, columnID).Return(nil)
+					m.boardRepo.On("GetBoardByID", mock.Anything, boardID).Return(board, nil)
+					m.quotaRepo.On("GetQuotaOverride", mock.Anything, ownerID).Return(nil, repository.ErrQuotaOverrideNotFound)
+					m.cardRepo.On("GetCardsByColumn", mock.Anything, columnID, repository.Page{Limit: 2}).Return([]entity.Card{{}}, nil)
+					m.todo.On("CreateCard", mock.Anything, mock.Anything).Return(nil)
+				},
+				wantErr: false,
+			},
+			{
+				name: "column full",
+				card: entity.Card{UserID: userID, ColumnID: columnID, Title: "Fix login"},
+				mockSetup: func(m quotaMocks) {
+					m.columnRepo.On("GetColumnByID", mock.Anything, columnID).Return(column, nil)
+					m.quotaRepo.On("LockQuota", mock.Anything, columnID).Return(nil)
+					m.boardRepo.On("GetBoardByID", mock.Anything, boardID).Return(board, nil)
+					m.quotaRepo.On("GetQuotaOverride", mock.Anything, ownerID).Return(nil, repository.ErrQuotaOverrideNotFound)
+					m.cardRepo.On("GetCardsByColumn", mock.Anything, columnID, repository.Page{Limit: 2}).Return([]entity.Card{{}, {}}, nil)
+				},
+				wantErr: true,
+				err:     repository.ErrQuotaExceeded,
+				quota:   repository.QuotaCardsPerColumn,
+			},
+			{
+				name: "description too long",
+				card: entity.Card{UserID: userID, ColumnID: columnID, Title: "Fix login", Description: "Ещё не скоро"},
+				mockSetup: func(m quotaMocks) {
+					m.columnRepo.On("GetColumnByID", mock.Anything, columnID).Return(column, nil)
+					m.quotaRepo.On("LockQuota", mock.Anything, columnID).Return(nil)
+					m.boardRepo.On("GetBoardByID", mock.Anything, boardID).Return(board, nil)
+					m.quotaRepo.On("GetQuotaOverride", mock.Anything, ownerID).Return(nil, repository.ErrQuotaOverrideNotFound)
+				},
+				wantErr: true,
+				err:     repository.ErrQuotaExceeded,
+				quota:   repository.QuotaDescriptionLength,
+			},
+			{
+				name: "override of owner lifts bound",
+				card: entity.Card{UserID: userID, ColumnID: columnID, Title: "Fix login"},
+				mockSetup: func(m quotaMocks) {
+					m.columnRepo.On("GetColumnByID", mock.Anything, columnID).Return(column, nil)
+					m.quotaRepo.On("LockQuota", mock.Anything, columnID).Return(nil)
+					m.boardRepo.On("GetBoardByID", mock.Anything, boardID).Return(board, nil)
+					m.quotaRepo.On("GetQuotaOverride", mock.Anything, ownerID).
+						Return(&entity.QuotaOverride{UserID: ownerID, CardsPerColumn: bound(0)}, nil)
+					m.todo.On("CreateCard", mock.Anything, mock.Anything).Return(nil)
+				},
+				wantErr: false,
+			},
+			{
+				name: "column not found",
+				card: entity.Card{UserID: userID, ColumnID: columnID, Title: "Fix login"},
+				mockSetup: func(m quotaMocks) {
+					m.columnRepo.On("GetColumnByID", mock.Anything, columnID).Return(nil, repository.ErrColumnNotFound)
+				},
+				wantErr: true,
+				err:     repository.ErrColumnNotFound,
+			},
+			{
+				name: "invalid card passed on unchecked",
+				card: entity.Card{UserID: userID, ColumnID: columnID},
+				mockSetup: func(m quotaMocks) {
+					m.todo.On("CreateCard", mock.Anything, mock.Anything).Return(v1.ErrCardEmptyTitle)
+				},
+				wantErr: true,
+				err:     v1.ErrCardEmptyTitle,
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				t.Parallel()
+
+				runner.Run(t, tt.name, func(pt provider.T) {
+					m := newQuotaMocks()
+					logger := log.NewEmptyLogger()
+
+					uc := v1.NewQuotaUseCase(m.todo, m.quotaRepo, m.boardRepo, m.columnRepo, m.cardRepo, memory.NewTxManager(), testQuota, logger)
+
+					tt.mockSetup(m)
+
+					pt.WithNewStep("Call CreateCard", func(sCtx provider.StepCtx) {
+						err := uc.CreateCard(context.Background(), &tt.card)
+
+						if tt.wantErr {
+							sCtx.Assert().Error(err, "Expected error")
+							sCtx.Assert().ErrorIs(err, tt.err)
+							assertQuota(sCtx, err, tt.quota)
+						} else {
+							sCtx.Assert().NoError(err, "Expected no error")
+						}
+
+						m.assert(t)
+					})
+				})
+			})
+		}
+	})
+}
+
+func TestQuotaUpdateCard(t *testing.T) {
+	runner.Run(t, "TestQuotaUpdateCard", func(pt provider.T) {
+		mom := &testdata.ObjectMother{}
+
+		ownerID := mom.GetUUID(0)
+		cardID := mom.GetUUID(1)
+		boardID := mom.GetUUID(2)
+		columnID := mom.GetUUID(3)
+		otherID := mom.GetUUID(4)
+
+		board := &entity.Board{ID: boardID, UserID: ownerID}
+		stored := &entity.Card{ID: cardID, ColumnID: columnID, Title: "Fix login"}
+
+		tests := []struct {
+			name      string
+			card      entity.Card
+			mockSetup func(m quotaMocks)
+			wantErr   bool
+			err       error
+			quota     string
+		}{
+			{
+				name: "positive in its column",
+				card: entity.Card{ID: cardID, Title: "Fix login", Version: 1},
+				mockSetup: func(m quotaMocks) {
+					m.cardRepo.On("GetCardByID", mock.Anything, cardID).Return(stored, nil)
+					m.columnRepo.On("GetColumnByID", mock.Anything, columnID).Return(&entity.Column{ID: columnID, BoardID: boardID}, nil)
+					m.quotaRepo.On("LockQuota", mock.Anything, columnID).Return(nil)
+					m.boardRepo.On("GetBoardByID", mock.Anything, boardID).Return(board, nil)
+					m.quotaRepo.On("GetQuotaOverride", mock.Anything, ownerID).Return(nil, repository.ErrQuotaOverrideNotFound)
+					m.todo.On("UpdateCard", mock.Anything, mock.Anything).Return(nil)
+				},
+				wantErr: false,
+			},
+			{
+				name: "into a full column",
+				card: entity.Card{ID: cardID, ColumnID: otherID, Title: "Fix login", Version: 1},
+				mockSetup: func(m quotaMocks) {
+					m.cardRepo.On("GetCardByID", mock.Anything, cardID).Return(stored, nil)
+					m.columnRepo.On("GetColumnByID", mock.Anything, otherID).Return(&entity.Column{ID: otherID, BoardID: boardID}, nil)
+					m.quotaRepo.On("LockQuota", mock.Anything, otherID).Return(nil)
+					m.boardRepo.On("GetBoardByID", mock.Anything, boardID).Return(board, nil)
+					m.quotaRepo.On("GetQuotaOverride", mock.Anything, ownerID).Return(nil, repository.ErrQuotaOverrideNotFound)
+					m.cardRepo.On("GetCardsByColumn", mock.Anything, otherID, repository.Page{Limit: 2}).Return([]entity.Card{{}, {}}, nil)
+				},
+				wantErr: true,
+				err:     repository.ErrQuotaExceeded,
+				quota:   repository.QuotaCardsPerColumn,
+			},
+			{
+				name: "card not found",
+				card: entity.Card{ID: cardID, Title: "Fix login", Version: 1},
+				mockSetup: func(m quotaMocks) {
+					m.cardRepo.On("GetCardByID", mock.Anything, cardID).Return(nil, repository.ErrCardNotFound)
+				},
+				wantErr: true,
+				err:     repository.ErrCardNotFound,
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				t.Parallel()
+
+				runner.Run(t, tt.name, func(pt provider.T) {
+					m := newQuotaMocks()
+					logger := log.NewEmptyLogger()
+
+					uc := v1.NewQuotaUseCase(m.todo, m.quotaRepo, m.boardRepo, m.columnRepo, m.cardRepo, memory.NewTxManager(), testQuota, logger)
+
+					tt.mockSetup(m)
+
+					pt.WithNewStep("Call UpdateCard", func(sCtx provider.StepCtx) {
+						err := uc.UpdateCard(context.Background(), &tt.card)
+
+						if tt.wantErr {
+							sCtx.Assert().Error(err, "Expected error")
+							sCtx.Assert().ErrorIs(err, tt.err)
+							assertQuota(sCtx, err, tt.quota)
+						} else {
+							sCtx.Assert().NoError(err, "Expected no error")
+						}
+
+						m.assert(t)
+					})
+				})
+			})
+		}
+	})
+}
+
+func TestQuotaMoveColumn(t *testing.T) {
+	runner.Run(t, "TestQuotaMoveColumn", func(pt provider.T) {
+		mom := &testdata.ObjectMother{}
+
+		userID := mom.GetUUID(0)
+		columnID := mom.GetUUID(1)
+		sourceID := mom.GetUUID(2)
+		targetID := mom.GetUUID(3)
+
+		target := &entity.Board{ID: targetID, UserID: userID}
+		column := &entity.Column{ID: columnID, BoardID: sourceID}
+
+		tests := []struct {
+			name      string
+			boardID   uuid.UUID
+			mockSetup func(m quotaMocks)
+			wantErr   bool
+			err       error
+			quota     string
+		}{
+			{
+				name:    "positive",
+				boardID: targetID,
+				mockSetup: func(m quotaMocks) {
+					m.columnRepo.On("GetColumnByID", mock.Anything, columnID).Return(column, nil)
+					m.quotaRepo.On("LockQuota", mock.Anything, targetID).Return(nil)
+					m.boardRepo.On("GetBoardByID", mock.Anything, targetID).Return(target, nil)
+					m.quotaRepo.On("GetQuotaOverride", mock.Anything, userID).Return(nil, repository.ErrQuotaOverrideNotFound)
+					m.columnRepo.On("GetColumnsByBoard", mock.Anything, targetID, repository.Page{Limit: 2}).Return([]entity.Column{{}}, nil)
+					m.todo.On("MoveColumn", mock.Anything, userID, columnID, targetID, 1).Return(&entity.Column{ID: columnID, BoardID: targetID}, nil)
+				},
+				wantErr: false,
+			},
+			{
+				name:    "board full",
+				boardID: targetID,
+				mockSetup: func(m quotaMocks) {
+					m.columnRepo.On("GetColumnByID", mock.Anything, columnID).Return(column, nil)
+					m.quotaRepo.On("LockQuota", mock.Anything, targetID).Return(nil)
+					m.boardRepo.On("GetBoardByID", mock.Anything, targetID).Return(target, nil)
+					m.quotaRepo.On("GetQuotaOverride", mock.Anything, userID).Return(nil, repository.ErrQuotaOverrideNotFound)
+					m.columnRepo.On("GetColumnsByBoard", mock.Anything, targetID, repository.Page{Limit: 2}).Return([]entity.Column{{}, {}}, nil)
+				},
+				wantErr: true,
+				err:     repository.ErrQuotaExceeded,
+				quota:   repository.QuotaColumnsPerBoard,
+			},
+			{
+				name:    "same board passed on unchecked",
+				boardID: sourceID,
+				mockSetup: func(m quotaMocks) {
+					m.columnRepo.On("GetColumnByID", mock.Anything, columnID).Return(column, nil)
+					m.todo.On("MoveColumn", mock.Anything, userID, columnID, sourceID, 1).Return(nil, repository.ErrColumnSameBoard)
+				},
+				wantErr: true,
+				err:     repository.ErrColumnSameBoard,
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				t.Parallel()
+
+				runner.Run(t, tt.name, func(pt provider.T) {
+					m := newQuotaMocks()
+					logger := log.NewEmptyLogger()
+
+					uc := v1.NewQuotaUseCase(m.todo, m.quotaRepo, m.boardRepo, m.columnRepo, m.cardRepo, memory.NewTxManager(), testQuota, logger)
+
+					tt.mockSetup(m)
+
+					pt.WithNewStep("Call MoveColumn", func(sCtx provider.StepCtx) {
+						_, err := uc.MoveColumn(context.Background(), userID, columnID, tt.boardID, 1)
+
+						if tt.wantErr {
+							sCtx.Assert().Error(err, "Expected error")
+							sCtx.Assert().ErrorIs(err, tt.err)
+							assertQuota(sCtx, err, tt.quota)
+						} else {
+							sCtx.Assert().NoError(err, "Expected no error")
+						}
+
+						m.assert(t)
+					})
+				})
+			})
+		}
+	})
+}
+
+func TestQuotaMergeBoards(t *testing.T) {
+	runner.Run(t, "TestQuotaMergeBoards", func(pt provider.T) {
+		mom := &testdata.ObjectMother{}
+
+		userID := mom.GetUUID(0)
+		sourceID := mom.GetUUID(1)
+		targetID := mom.GetUUID(2)
+
+		target := &entity.Board{ID: targetID, UserID: userID}
+		sources := []entity.Column{{Title: "To do"}, {Title: "Review"}}
+		targets := []entity.Column{{Title: "to do "}}
+
+		tests := []struct {
+			name      string
+			strategy  string
+			mockSetup func(m quotaMocks)
+			wantErr   bool
+			err       error
+			quota     string
+		}{
+			{
+				name:     "combined columns not counted",
+				strategy: entity.MergeColumnsCombine,
+				mockSetup: func(m quotaMocks) {
+					m.todo.On("MergeBoards", mock.Anything, userID, sourceID, targetID, entity.MergeColumnsCombine).Return(&entity.BoardMerge{}, nil)
+				},
+				wantErr: false,
+			},
+			{
+				name:      "kept columns past bound",
+				strategy:  entity.MergeColumnsKeep,
+				mockSetup: func(m quotaMocks) {},
+				wantErr:   true,
+				err:       repository.ErrQuotaExceeded,
+				quota:     repository.QuotaColumnsPerBoard,
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				t.Parallel()
+
+				runner.Run(t, tt.name, func(pt provider.T) {
+					m := newQuotaMocks()
+					logger := log.NewEmptyLogger()
+
+					uc := v1.NewQuotaUseCase(m.todo, m.quotaRepo, m.boardRepo, m.columnRepo, m.cardRepo, memory.NewTxManager(), testQuota, logger)
+
+					m.columnRepo.On("GetColumnsByBoard", mock.Anything, sourceID, mock.Anything).Return(sources, nil)
+					m.columnRepo.On("GetColumnsByBoard", mock.Anything, targetID, repository.Page{Limit: 100}).Return(targets, nil)
+					m.quotaRepo.On("LockQuota", mock.Anything, targetID).Return(nil)
+					m.boardRepo.On("GetBoardByID", mock.Anything, targetID).Return(target, nil)
+					m.quotaRepo.On("GetQuotaOverride", mock.Anything, userID).Return(nil, repository.ErrQuotaOverrideNotFound)
+					m.columnRepo.On("GetColumnsByBoard", mock.Anything, targetID, repository.Page{Limit: 2}).Return(targets, nil)
+
+					tt.mockSetup(m)
+
+					pt.WithNewStep("Call MergeBoards", func(sCtx provider.StepCtx) {
+						_, err := uc.MergeBoards(context.Background(), userID, sourceID, targetID, tt.strategy)
+
+						if tt.wantErr {
+							sCtx.Assert().Error(err, "Expected error")
+							sCtx.Assert().ErrorIs(err, tt.err)
+							assertQuota(sCtx, err, tt.quota)
+						} else {
+							sCtx.Assert().NoError(err, "Expected no error")
+						}
+
+						m.assert(t)
+					})
+				})
+			})
+		}
+	})
+}
+
+func TestQuotaApplyCardOps(t *testing.T) {
+	runner.Run(t, "TestQuotaApplyCardOps", func(pt provider.T) {
+		mom := &testdata.ObjectMother{}
+
+		ownerID := mom.GetUUID(0)
+		boardID := mom.GetUUID(1)
+		fromID := mom.GetUUID(2)
+		toID := mom.GetUUID(3)
+
+		board := &entity.Board{ID: boardID, UserID: ownerID}
+		ops := []repository.CardOp{
+			{Kind: repository.CardOpMove, CardID: mom.GetUUID(4), ColumnID: toID, Version: 1},
+			{Kind: repository.CardOpArchive, CardID: mom.GetUUID(5), Version: 1},
+			{Kind: repository.CardOpMove, CardID: mom.GetUUID(6), ColumnID: toID, Version: 1},
+		}
+
+		tests := []struct {
+			name         string
+			allOrNothing bool
+			mockSetup    func(m quotaMocks)
+			want         []repository.CardOpStatus
+		}{
+			{
+				name: "later move refused",
+				mockSetup: func(m quotaMocks) {
+					m.todo.On("ApplyCardOps", mock.Anything, ops[:2], false).Return([]repository.CardOpResult{
+						{Status: repository.CardOpApplied}, {Status: repository.CardOpApplied},
+					}, nil)
+				},
+				want: []repository.CardOpStatus{repository.CardOpApplied, repository.CardOpApplied, repository.CardOpFailed},
+			},
+			{
+				name:         "all or nothing",
+				allOrNothing: true,
+				mockSetup:    func(m quotaMocks) {},
+				want:         []repository.CardOpStatus{repository.CardOpRolledBack, repository.CardOpRolledBack, repository.CardOpFailed},
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				t.Parallel()
+
+				runner.Run(t, tt.name, func(pt provider.T) {
+					m := newQuotaMocks()
+					logger := log.NewEmptyLogger()
+
+					uc := v1.NewQuotaUseCase(m.todo, m.quotaRepo, m.boardRepo, m.columnRepo, m.cardRepo, memory.NewTxManager(), testQuota, logger)
+
+					m.cardRepo.On("GetCardByID", mock.Anything, ops[0].CardID).Return(&entity.Card{ID: ops[0].CardID, ColumnID: fromID}, nil)
+					m.cardRepo.On("GetCardByID", mock.Anything, ops[2].CardID).Return(&entity.Card{ID: ops[2].CardID, ColumnID: fromID}, nil)
+					m.columnRepo.On("GetColumnByID", mock.Anything, toID).Return(&entity.Column{ID: toID, BoardID: boardID}, nil)
+					m.quotaRepo.On("LockQuota", mock.Anything, toID).Return(nil)
+					m.boardRepo.On("GetBoardByID", mock.Anything, boardID).Return(board, nil)
+					m.quotaRepo.On("GetQuotaOverride", mock.Anything, ownerID).Return(nil, repository.ErrQuotaOverrideNotFound)
+					m.cardRepo.On("GetCardsByColumn", mock.Anything, toID, repository.Page{Limit: 2}).Return([]entity.Card{{}}, nil)
+
+					tt.mockSetup(m)
+
+					pt.WithNewStep("Call ApplyCardOps", func(sCtx provider.StepCtx) {
+						results, err := uc.ApplyCardOps(context.Background(), ops, tt.allOrNothing)
+
+						sCtx.Assert().NoError(err, "Expected no error")
+						sCtx.Require().Len(results, len(tt.want))
+						for i, status := range tt.want {
+							sCtx.Assert().Equal(status, results[i].Status)
+						}
+						assertQuota(sCtx, results[2].Err, repository.QuotaCardsPerColumn)
+
+						m.assert(t)
+					})
+				})
+			})
+		}
+	})
+}
+
+func TestSetQuotaOverride(t *testing.T) {
+	runner.Run(t, "TestSetQuotaOverride", func(pt provider.T) {
+		mom := &testdata.ObjectMother{}
+
+		userID := mom.GetUUID(0)
+
+		tests := []struct {
+			name      string
+			override  entity.QuotaOverride
+			mockSetup func(m quotaMocks)
+			wantErr   bool
+			err       error
+		}{
+			{
+				name:     "positive",
+				override: entity.QuotaOverride{UserID: userID, CardsPerColumn: bound(1000)},
+				mockSetup: func(m quotaMocks) {
+					m.quotaRepo.On("SetQuotaOverride", mock.Anything, mock.Anything).Return(nil)
+				},
+				wantErr: false,
+			},
+			{
+				name:      "negative bound",
+				override:  entity.QuotaOverride{UserID: userID, BoardsPerUser: bound(-1)},
+				mockSetup: func(m quotaMocks) {},
+				wantErr:   true,
+				err:       repository.ErrQuotaInvalid,
+			},
+			{
+				name:     "negative",
+				override: entity.QuotaOverride{UserID: userID},
+				mockSetup: func(m quotaMocks) {
+					m.quotaRepo.On("SetQuotaOverride", mock.Anything, mock.Anything).Return(errors.New(""))
+				},
+				wantErr: true,
+				err:     v1.ErrSetQuota,
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				t.Parallel()
+
+				runner.Run(t, tt.name, func(pt provider.T) {
+					m := newQuotaMocks()
+					logger := log.NewEmptyLogger()
+
+					uc := v1.NewQuotaUseCase(m.todo, m.quotaRepo, m.boardRepo, m.columnRepo, m.cardRepo, memory.NewTxManager(), testQuota, logger)
+
+					tt.mockSetup(m)
+
+					pt.WithNewStep("Call SetQuotaOverride", func(sCtx provider.StepCtx) {
+						err := uc.SetQuotaOverride(context.Background(), &tt.override)
+
+						if tt.wantErr {
+							sCtx.Assert().Error(err, "Expected error")
+							sCtx.Assert().ErrorIs(err, tt.err)
+						} else {
+							sCtx.Assert().NoError(err, "Expected no error")
+							sCtx.Assert().False(tt.override.UpdatedAt.IsZero())
+						}
+
+						m.assert(t)
+					})
+				})
+			})
+		}
+	})
+}
+
+func TestGetUserQuota(t *testing.T) {
+	runner.Run(t, "TestGetUserQuota", func(pt provider.T) {
+		mom := &testdata.ObjectMother{}
+
+		userID := mom.GetUUID(0)
+		override := &entity.QuotaOverride{UserID: userID, ColumnsPerBoard: bound(0), DescriptionLength: bound(500)}
+
+		tests := []struct {
+			name      string
+			mockSetup func(m quotaMocks)
+			wantErr   bool
+			err       error
+			want      entity.UserQuota
+		}{
+			{
+				name: "configured",
+				mockSetup: func(m quotaMocks) {
+					m.quotaRepo.On("GetQuotaOverride", mock.Anything, userID).Return(nil, repository.ErrQuotaOverrideNotFound)
+				},
+				wantErr: false,
+				want:    entity.UserQuota{UserID: userID, Quota: testQuota},
+			},
+			{
+				name: "overridden",
+				mockSetup: func(m quotaMocks) {
+					m.quotaRepo.On("GetQuotaOverride", mock.Anything, userID).Return(override, nil)
+				},
+				wantErr: false,
+				want: entity.UserQuota{
+					UserID:   userID,
+					Quota:    entity.Quota{BoardsPerUser: 2, ColumnsPerBoard: 0, CardsPerColumn: 2, DescriptionLength: 500},
+					Override: override,
+				},
+			},
+			{
+				name: "negative",
+				mockSetup: func(m quotaMocks) {
+					m.quotaRepo.On("GetQuotaOverride", mock.Anything, userID).Return(nil, errors.New(""))
+				},
+				wantErr: true,
+				err:     v1.ErrGetQuota,
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				t.Parallel()
+
+				runner.Run(t, tt.name, func(pt provider.T) {
+					m := newQuotaMocks()
+					logger := log.NewEmptyLogger()
+
+					uc := v1.NewQuotaUseCase(m.todo, m.quotaRepo, m.boardRepo, m.columnRepo, m.cardRepo, memory.NewTxManager(), testQuota, logger)
+
+					tt.mockSetup(m)
+
+					pt.WithNewStep("Call GetUserQuota", func(sCtx provider.StepCtx) {
+						quota, err := uc.GetUserQuota(context.Background(), userID)
+
+						if tt.wantErr {
+							sCtx.Assert().Error(err, "Expected error")
+							sCtx.Assert().ErrorIs(err, tt.err)
+						} else {
+							sCtx.Assert().NoError(err, "Expected no error")
+							sCtx.Assert().Equal(tt.want, *quota)
+						}
+
+						m.assert(t)
+					})
+				})
+			})
+		}
+	})
+}
+
+// assertQuota checks that err names the quota exceeded, if one should be.
+func assertQuota(sCtx provider.StepCtx, err error, quota string) {
+	if quota == "" {
+		return
+	}
+
+	var quotaErr *repository.QuotaError
+	sCtx.Assert().True(errors.As(err, &quotaErr), "Expected a quota error")
+	if quotaErr != nil {
+		sCtx.Assert().Equal(quota, quotaErr.Quota)
+	}
+}
@@ -0,0 +1,425 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+	"todo/internal/common/logger"
+	"todo/internal/entity"
+	"todo/internal/repository"
+	"todo/internal/usecase"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrCreateSprint      = errors.New("failed to create sprint")
+	ErrGetSprintByID     = errors.New("failed to get sprint by id")
+	ErrGetSprintsByBoard = errors.New("failed to get sprints by board")
+	ErrAddSprintCard     = errors.New("failed to add card to sprint")
+	ErrRemoveSprintCard  = errors.New("failed to remove card from sprint")
+	ErrGetSprintCards    = errors.New("failed to get sprint cards")
+	ErrCloseSprint       = errors.New("failed to close sprint")
+	ErrGetBurndown       = errors.New("failed to get sprint burndown")
+)
+
+type sprintUseCase struct {
+	sprintRepo    repository.SprintRepository
+	boardRepo     repository.BoardRepository
+	columnRepo    repository.ColumnRepository
+	cardRepo      repository.CardRepository
+	workspaceRepo repository.WorkspaceRepository
+	tx            repository.TxManager
+	log           logger.Logger
+}
+
+func NewSprintUseCase(
+	sprintRepo repository.SprintRepository,
+	boardRepo repository.BoardRepository,
+	columnRepo repository.ColumnRepository,
+	cardRepo repository.CardRepository,
+	workspaceRepo repository.WorkspaceRepository,
+	tx repository.TxManager,
+	log logger.Logger,
+) usecase.SprintUseCase {
+	return &sprintUseCase{
+		sprintRepo:    sprintRepo,
+		boardRepo:     boardRepo,
+		columnRepo:    columnRepo,
+		cardRepo:      cardRepo,
+		workspaceRepo: workspaceRepo,
+		tx:            tx,
+		log:           log,
+	}
+}
+
+// calendarDay is the midnight starting the day of t where t was given, so
+// that sprint dates mean the same days to everyone.
+func calendarDay(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+func (uc *sprintUseCase) CreateSprint(ctx context.Context, sprint *entity.Sprint) error {
+	header := "CreateSprint: "
+
+	uc.log.Info(ctx, header+"Usecase called; Validating sprint", "sprint", sprint)
+
+	sprint.StartDate = calendarDay(sprint.StartDate)
+	sprint.EndDate = calendarDay(sprint.EndDate)
+
+	err := validateSprint(sprint)
+
+	if err != nil {
+		info := "Validation failed"
+		uc.log.Info(ctx, header+info, "err", err.Error())
+		return fmt.Errorf(header+info+": %w", err)
+	}
+
+	if _, err := callerAccess(ctx, uc.boardRepo, uc.workspaceRepo, uc.log, header, sprint.BoardID, true); err != nil {
+		return err
+	}
+
+	sprint.ID = uuid.New()
+	sprint.ClosedAt = nil
+	sprint.CreatedAt = time.Now()
+
+	uc.log.Info(ctx, header+"Making request to sprint repo (CreateSprint)", "sprint", sprint)
+
+	err = uc.sprintRepo.CreateSprint(ctx, sprint)
+
+	if err != nil {
+		info := "Failed to create sprint"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return fmt.Errorf(header+info+": %w", ErrCreateSprint)
+	}
+
+	uc.log.Info(ctx, header+"Sprint successfully created")
+
+	return nil
+}
+
+func validateSprint(sprint *entity.Sprint) error {
+	if sprint.Name == "" {
+		return repository.ErrSprintNoName
+	}
+
+	if sprint.EndDate.Before(sprint.StartDate) {
+		return repository.ErrSprintDates
+	}
+
+	return nil
+}
+
+func (uc *sprintUseCase) GetSprintByID(ctx context.Context, id uuid.UUID) (*entity.Sprint, error) {
+	header := "GetSprintByID: "
+
+	uc.log.Info(ctx, header+"Usecase called", "id", id)
+
+	sprint, err := uc.sprint(ctx, header, id, false, ErrGetSprintByID)
+	if err != nil {
+		return nil, err
+	}
+
+	uc.log.Info(ctx, header+"Got sprint", "sprint", sprint)
+
+	return sprint, nil
+}
+
+// sprint gets the sprint if the caller can see its board, or change it with
+// write set.
+func (uc *sprintUseCase) sprint(ctx context.Context, header string, id uuid.UUID, write bool, failed error) (*entity.Sprint, error) {
+	uc.log.Info(ctx, header+"Making request to sprint repo (GetSprintByID)", "id", id)
+
+	sprint, err := uc.sprintRepo.GetSprintByID(ctx, id)
+
+	if errors.Is(err, repository.ErrSprintNotFound) {
+		info := "Sprint not found"
+		uc.log.Info(ctx, header+info, "id", id)
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	if err != nil {
+		info := "Failed to get sprint by id"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", failed)
+	}
+
+	if _, err := callerAccess(ctx, uc.boardRepo, uc.workspaceRepo, uc.log, header, sprint.BoardID, write); err != nil {
+		return nil, err
+	}
+
+	return sprint, nil
+}
+
+func (uc *sprintUseCase) GetSprintsByBoard(ctx context.Context, boardID uuid.UUID) ([]entity.Sprint, error) {
+	header := "GetSprintsByBoard: "
+
+	uc.log.Info(ctx, header+"Usecase called", "boardID", boardID)
+
+	if _, err := callerAccess(ctx, uc.boardRepo, uc.workspaceRepo, uc.log, header, boardID, false); err != nil {
+		return nil, err
+	}
+
+	uc.log.Info(ctx, header+"Making request to sprint repo (GetSprintsByBoard)", "boardID", boardID)
+
+	sprints, err := uc.sprintRepo.GetSprintsByBoard(ctx, boardID)
+
+	if err != nil {
+		info := "Failed to get sprints by board"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", ErrGetSprintsByBoard)
+	}
+
+	uc.log.Info(ctx, header+"Got sprints", "count", len(sprints))
+
+	return sprints, nil
+}
+
+func (uc *sprintUseCase) AddSprintCard(ctx context.Context, card *entity.SprintCard) error {
+	header := "AddSprintCard: "
+
+	uc.log.Info(ctx, header+"Usecase called; Validating sprint card", "card", card)
+
+	if card.Points < 0 {
+		info := "Validation failed"
+		uc.log.Info(ctx, header+info, "err", repository.ErrSprintPoints.Error())
+		return fmt.Errorf(header+info+": %w", repository.ErrSprintPoints)
+	}
+
+	if _, err := uc.sprint(ctx, header, card.SprintID, true, ErrAddSprintCard); err != nil {
+		return err
+	}
+
+	card.AddedAt = time.Now()
+
+	err := uc.tx.WithinTx(ctx, func(ctx context.Context) error {
+		sprint, err := uc.sprintRepo.GetSprintByID(ctx, card.SprintID)
+		if err != nil {
+			return err
+		}
+		if sprint.ClosedAt != nil {
+			return repository.ErrSprintClosed
+		}
+
+		c, err := uc.cardRepo.GetCardByID(ctx, card.CardID)
+		if err != nil {
+			uc.log.Info(ctx, header+"Card not found", "cardID", card.CardID, "err", err.Error())
+			return repository.ErrCardNotFound
+		}
+
+		column, err := uc.columnRepo.GetColumnByID(ctx, c.ColumnID)
+		if err != nil {
+			return err
+		}
+		if column.BoardID != sprint.BoardID {
+			return repository.ErrSprintCardBoard
+		}
+
+		uc.log.Info(ctx, header+"Making request to sprint repo (AddSprintCard)", "card", card)
+
+		return uc.sprintRepo.AddSprintCard(ctx, card)
+	})
+
+	if errors.Is(err, repository.ErrSprintNotFound) || errors.Is(err, repository.ErrSprintClosed) ||
+		errors.Is(err, repository.ErrCardNotFound) || errors.Is(err, repository.ErrSprintCardBoard) {
+		info := "Validation failed"
+		uc.log.Info(ctx, header+info, "err", err.Error())
+		return fmt.Errorf(header+info+": %w", err)
+	}
+
+	if err != nil {
+		info := "Failed to add card to sprint"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return fmt.Errorf(header+info+": %w", ErrAddSprintCard)
+	}
+
+	uc.log.Info(ctx, header+"Card successfully added to sprint")
+
+	return nil
+}
+
+func (uc *sprintUseCase) RemoveSprintCard(ctx context.Context, sprintID, cardID uuid.UUID) error {
+	header := "RemoveSprintCard: "
+
+	uc.log.Info(ctx, header+"Usecase called", "sprintID", sprintID, "cardID", cardID)
+
+	if _, err := uc.sprint(ctx, header, sprintID, true, ErrRemoveSprintCard); err != nil {
+		return err
+	}
+
+	uc.log.Info(ctx, header+"Making request to sprint repo (RemoveSprintCard)", "sprintID", sprintID, "cardID", cardID)
+
+	err := uc.sprintRepo.RemoveSprintCard(ctx, sprintID, cardID)
+
+	if errors.Is(err, repository.ErrSprintCardNotFound) {
+		info := "Card is not in the sprint"
+		uc.log.Info(ctx, header+info, "sprintID", sprintID, "cardID", cardID)
+		return fmt.Errorf(header+info+": %w", err)
+	}
+
+	if err != nil {
+		info := "Failed to remove card from sprint"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return fmt.Errorf(header+info+": %w", ErrRemoveSprintCard)
+	}
+
+	uc.log.Info(ctx, header+"Card successfully removed from sprint")
+
+	return nil
+}
+
+func (uc *sprintUseCase) GetSprintCards(ctx context.Context, sprintID uuid.UUID) ([]entity.SprintCard, error) {
+	header := "GetSprintCards: "
+
+	uc.log.Info(ctx, header+"Usecase called", "sprintID", sprintID)
+
+	if _, err := uc.sprint(ctx, header, sprintID, false, ErrGetSprintCards); err != nil {
+		return nil, err
+	}
+
+	uc.log.Info(ctx, header+"Making request to sprint repo (GetSprintCards)", "sprintID", sprintID)
+
+	cards, err := uc.sprintRepo.GetSprintCards(ctx, sprintID)
+
+	if err != nil {
+		info := "Failed to get sprint cards"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", ErrGetSprintCards)
+	}
+
+	uc.log.Info(ctx, header+"Got sprint cards", "count", len(cards))
+
+	return cards, nil
+}
+
+func (uc *sprintUseCase) CloseSprint(ctx context.Context, id uuid.UUID, nextID *uuid.UUID) (*entity.Sprint, int, error) {
+	header := "CloseSprint: "
+
+	uc.log.Info(ctx, header+"Usecase called", "id", id, "nextID", nextID)
+
+	if _, err := uc.sprint(ctx, header, id, true, ErrCloseSprint); err != nil {
+		return nil, 0, err
+	}
+
+	var next *entity.Sprint
+	var carried int
+
+	err := uc.tx.WithinTx(ctx, func(ctx context.Context) error {
+		sprint, err := uc.sprintRepo.GetSprintByID(ctx, id)
+		if err != nil {
+			return err
+		}
+		if sprint.ClosedAt != nil {
+			return repository.ErrSprintClosed
+		}
+
+		next, err = uc.nextSprint(ctx, sprint, nextID)
+		if err != nil {
+			return err
+		}
+
+		var carryTo *uuid.UUID
+		if next != nil {
+			carryTo = &next.ID
+		}
+
+		uc.log.Info(ctx, header+"Making request to sprint repo (CloseSprint)", "id", id, "nextID", carryTo)
+
+		carried, err = uc.sprintRepo.CloseSprint(ctx, id, carryTo, time.Now())
+		return err
+	})
+
+	if errors.Is(err, repository.ErrSprintNotFound) || errors.Is(err, repository.ErrSprintClosed) ||
+		errors.Is(err, repository.ErrSprintNext) {
+		info := "Validation failed"
+		uc.log.Info(ctx, header+info, "err", err.Error())
+		return nil, 0, fmt.Errorf(header+info+": %w", err)
+	}
+
+	if err != nil {
+		info := "Failed to close sprint"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return nil, 0, fmt.Errorf(header+info+": %w", ErrCloseSprint)
+	}
+
+	uc.log.Info(ctx, header+"Sprint successfully closed", "carried", carried)
+
+	return next, carried, nil
+}
+
+// nextSprint checks the sprint asked for, or else finds the first open
+// sprint of the board starting no earlier than the one closed.
+func (uc *sprintUseCase) nextSprint(ctx context.Context, sprint *entity.Sprint, nextID *uuid.UUID) (*entity.Sprint, error) {
+	if nextID != nil {
+		next, err := uc.sprintRepo.GetSprintByID(ctx, *nextID)
+		if errors.Is(err, repository.ErrSprintNotFound) {
+			return nil, repository.ErrSprintNext
+		}
+		if err != nil {
+			return nil, err
+		}
+		if next.ID == sprint.ID || next.BoardID != sprint.BoardID || next.ClosedAt != nil {
+			return nil, repository.ErrSprintNext
+		}
+		return next, nil
+	}
+
+	sprints, err := uc.sprintRepo.GetSprintsByBoard(ctx, sprint.BoardID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, s := range sprints {
+		if s.ID != sprint.ID && s.ClosedAt == nil && !s.StartDate.Before(sprint.StartDate) {
+			return &s, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func (uc *sprintUseCase) GetSprintBurndown(ctx context.Context, id uuid.UUID) ([]entity.BurndownPoint, error) {
+	header := "GetSprintBurndown: "
+
+	uc.log.Info(ctx, header+"Usecase called", "id", id)
+
+	sprint, err := uc.sprint(ctx, header, id, false, ErrGetBurndown)
+	if err != nil {
+		return nil, err
+	}
+
+	end := sprint.EndDate.AddDate(0, 0, 1)
+	to := end
+	if today := calendarDay(time.Now()).AddDate(0, 0, 1); today.Before(to) {
+		to = today
+	}
+
+	if !to.After(sprint.StartDate) {
+		uc.log.Info(ctx, header+"Sprint has not started", "startDate", sprint.StartDate)
+		return []entity.BurndownPoint{}, nil
+	}
+
+	uc.log.Info(ctx, header+"Making request to sprint repo (GetSprintBurndown)", "from", sprint.StartDate, "to", to)
+
+	points, err := uc.sprintRepo.GetSprintBurndown(ctx, id, sprint.StartDate, to)
+
+	if err != nil {
+		info := "Failed to get sprint burndown"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", ErrGetBurndown)
+	}
+
+	// The ideal line runs from the points of the first day down to none on
+	// the last day of the sprint.
+	if days := int(end.Sub(sprint.StartDate)/day) - 1; len(points) > 0 && days > 0 {
+		start := float64(points[0].Points)
+		for i := range points {
+			points[i].IdealPoints = start * float64(days-i) / float64(days)
+		}
+	}
+
+	uc.log.Info(ctx, header+"Got sprint burndown", "count", len(points))
+
+	return points, nil
+}
@@ -0,0 +1,682 @@
+package v1_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+	log "todo/internal/adapter/logger"
+	"todo/internal/adapter/repository/memory"
+	"todo/internal/entity"
+	"todo/internal/repository"
+	"todo/internal/testdata"
+	"todo/internal/usecase"
+	v1 "todo/internal/usecase/v1"
+	"todo/mocks"
+
+	"github.com/google/uuid"
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/runner"
+	"github.com/stretchr/testify/mock"
+)
+
+type sprintMocks struct {
+	sprintRepo    *mocks.SprintRepository
+	boardRepo     *mocks.BoardRepository
+	columnRepo    *mocks.ColumnRepository
+	cardRepo      *mocks.CardRepository
+	workspaceRepo *mocks.WorkspaceRepository
+}
+
+func newSprintMocks() sprintMocks {
+	return sprintMocks{
+		sprintRepo:    new(mocks.SprintRepository),
+		boardRepo:     new(mocks.BoardRepository),
+		columnRepo:    new(mocks.ColumnRepository),
+		cardRepo:      new(mocks.CardRepository),
+		workspaceRepo: new(mocks.WorkspaceRepository),
+	}
+}
+
+func (m sprintMocks) useCase(tx repository.TxManager) usecase.SprintUseCase {
+	return v1.NewSprintUseCase(m.sprintRepo, m.boardRepo, m.columnRepo, m.cardRepo, m.workspaceRepo, tx, log.NewEmptyLogger())
+}
+
+func (m sprintMocks) member(board entity.Board, userID uuid.UUID, role string) {
+	mockMember(m.boardRepo, m.workspaceRepo, board, userID, role)
+}
+
+func (m sprintMocks) assert(t *testing.T) {
+	m.sprintRepo.AssertExpectations(t)
+	m.boardRepo.AssertExpectations(t)
+	m.columnRepo.AssertExpectations(t)
+	m.cardRepo.AssertExpectations(t)
+	m.workspaceRepo.AssertExpectations(t)
+}
+
+func TestCreateSprint(t *testing.T) {
+	runner.Run(t, "TestCreateSprint", func(pt provider.T) {
+		mom := &testdata.ObjectMother{}
+
+		userID := mom.GetUUID(0)
+		board := entity.Board{ID: mom.GetUUID(1), WorkspaceID: mom.GetUUID(2)}
+		start := time.Date(2024, 3, 4, 15, 30, 0, 0, time.UTC)
+		caller := usecase.WithCaller(context.Background(), usecase.Caller{UserID: userID})
+
+		tests := []struct {
+			name      string
+			ctx       context.Context
+			sprint    entity.Sprint
+			mockSetup func(m sprintMocks)
+			wantErr   bool
+			err       error
+		}{
+			{
+				name:   "positive",
+				ctx:    caller,
+				sprint: entity.Sprint{BoardID: board.ID, Name: "Sprint 1", StartDate: start, EndDate: start.AddDate(0, 0, 13)},
+				mockSetup: func(m sprintMocks) {
+					m.member(board, userID, entity.RoleMember)
+					m.sprintRepo.On("CreateSprint", mock.Anything, mock.MatchedBy(func(s *entity.Sprint) bool {
+						return s.ID != uuid.Nil && s.StartDate.Equal(time.Date(2024, 3, 4, 0, 0, 0, 0, time.UTC))
+					})).Return(nil)
+				},
+				wantErr: false,
+			},
+			{
+				name:      "no name",
+				ctx:       caller,
+				sprint:    entity.Sprint{BoardID: board.ID, StartDate: start, EndDate: start},
+				mockSetup: func(m sprintMocks) {},
+				wantErr:   true,
+				err:       repository.ErrSprintNoName,
+			},
+			{
+				name:      "ends before it starts",
+				ctx:       caller,
+				sprint:    entity.Sprint{BoardID: board.ID, Name: "Sprint 1", StartDate: start, EndDate: start.AddDate(0, 0, -1)},
+				mockSetup: func(m sprintMocks) {},
+				wantErr:   true,
+				err:       repository.ErrSprintDates,
+			},
+			{
+				name:   "no board",
+				ctx:    caller,
+				sprint: entity.Sprint{BoardID: board.ID, Name: "Sprint 1", StartDate: start, EndDate: start},
+				mockSetup: func(m sprintMocks) {
+					m.boardRepo.On("GetBoardByID", mock.Anything, board.ID).Return(nil, errors.New(""))
+				},
+				wantErr: true,
+				err:     repository.ErrBoardNotFound,
+			},
+			{
+				name:      "no caller",
+				ctx:       context.Background(),
+				sprint:    entity.Sprint{BoardID: board.ID, Name: "Sprint 1", StartDate: start, EndDate: start},
+				mockSetup: func(m sprintMocks) {},
+				wantErr:   true,
+				err:       repository.ErrNoCaller,
+			},
+			{
+				name:   "not a member",
+				ctx:    caller,
+				sprint: entity.Sprint{BoardID: board.ID, Name: "Sprint 1", StartDate: start, EndDate: start},
+				mockSetup: func(m sprintMocks) {
+					m.member(board, userID, "")
+				},
+				wantErr: true,
+				err:     repository.ErrBoardAccess,
+			},
+			{
+				name:   "viewer",
+				ctx:    caller,
+				sprint: entity.Sprint{BoardID: board.ID, Name: "Sprint 1", StartDate: start, EndDate: start},
+				mockSetup: func(m sprintMocks) {
+					m.member(board, userID, entity.RoleViewer)
+				},
+				wantErr: true,
+				err:     repository.ErrBoardAccess,
+			},
+			{
+				name:   "negative",
+				ctx:    caller,
+				sprint: entity.Sprint{BoardID: board.ID, Name: "Sprint 1", StartDate: start, EndDate: start},
+				mockSetup: func(m sprintMocks) {
+					m.member(board, userID, entity.RoleMember)
+					m.sprintRepo.On("CreateSprint", mock.Anything, mock.Anything).Return(errors.New(""))
+				},
+				wantErr: true,
+				err:     v1.ErrCreateSprint,
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				t.Parallel()
+
+				runner.Run(t, tt.name, func(pt provider.T) {
+					m := newSprintMocks()
+					uc := m.useCase(memory.NewTxManager())
+
+					tt.mockSetup(m)
+
+					pt.WithNewStep("Call CreateSprint", func(sCtx provider.StepCtx) {
+						sprint := tt.sprint
+						err := uc.CreateSprint(tt.ctx, &sprint)
+
+						if tt.wantErr {
+							sCtx.Assert().Error(err, "Expected error")
+							sCtx.Assert().ErrorIs(err, tt.err)
+						} else {
+							sCtx.Assert().NoError(err, "Expected no error")
+						}
+
+						m.assert(t)
+					})
+				})
+			})
+		}
+	})
+}
+
+func TestGetSprintByID(t *testing.T) {
+	runner.Run(t, "TestGetSprintByID", func(pt provider.T) {
+		mom := &testdata.ObjectMother{}
+
+		userID := mom.GetUUID(0)
+		board := entity.Board{ID: mom.GetUUID(1), WorkspaceID: mom.GetUUID(2)}
+		sprintID := mom.GetUUID(3)
+		ctx := usecase.WithCaller(context.Background(), usecase.Caller{UserID: userID})
+
+		sprint := &entity.Sprint{ID: sprintID, BoardID: board.ID}
+
+		tests := []struct {
+			name      string
+			mockSetup func(m sprintMocks)
+			wantErr   bool
+			err       error
+		}{
+			{
+				name: "viewer",
+				mockSetup: func(m sprintMocks) {
+					m.sprintRepo.On("GetSprintByID", mock.Anything, sprintID).Return(sprint, nil)
+					m.member(board, userID, entity.RoleViewer)
+				},
+				wantErr: false,
+			},
+			{
+				name: "not found",
+				mockSetup: func(m sprintMocks) {
+					m.sprintRepo.On("GetSprintByID", mock.Anything, sprintID).Return(nil, repository.ErrSprintNotFound)
+				},
+				wantErr: true,
+				err:     repository.ErrSprintNotFound,
+			},
+			{
+				name: "not a member",
+				mockSetup: func(m sprintMocks) {
+					m.sprintRepo.On("GetSprintByID", mock.Anything, sprintID).Return(sprint, nil)
+					m.member(board, userID, "")
+				},
+				wantErr: true,
+				err:     repository.ErrBoardAccess,
+			},
+			{
+				name: "negative",
+				mockSetup: func(m sprintMocks) {
+					m.sprintRepo.On("GetSprintByID", mock.Anything, sprintID).Return(nil, errors.New(""))
+				},
+				wantErr: true,
+				err:     v1.ErrGetSprintByID,
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				t.Parallel()
+
+				runner.Run(t, tt.name, func(pt provider.T) {
+					m := newSprintMocks()
+					uc := m.useCase(memory.NewTxManager())
+
+					tt.mockSetup(m)
+
+					pt.WithNewStep("Call GetSprintByID", func(sCtx provider.StepCtx) {
+						got, err := uc.GetSprintByID(ctx, sprintID)
+
+						if tt.wantErr {
+							sCtx.Assert().Error(err, "Expected error")
+							sCtx.Assert().ErrorIs(err, tt.err)
+						} else {
+							sCtx.Assert().NoError(err, "Expected no error")
+							sCtx.Assert().Equal(sprint, got)
+						}
+
+						m.assert(t)
+					})
+				})
+			})
+		}
+	})
+}
+
+func TestGetSprintsByBoard(t *testing.T) {
+	runner.Run(t, "TestGetSprintsByBoard", func(pt provider.T) {
+		mom := &testdata.ObjectMother{}
+
+		userID := mom.GetUUID(0)
+		board := entity.Board{ID: mom.GetUUID(1), WorkspaceID: mom.GetUUID(2)}
+		ctx := usecase.WithCaller(context.Background(), usecase.Caller{UserID: userID})
+
+		sprints := []entity.Sprint{{ID: mom.GetUUID(3), BoardID: board.ID}}
+
+		tests := []struct {
+			name      string
+			mockSetup func(m sprintMocks)
+			wantErr   bool
+			err       error
+		}{
+			{
+				name: "viewer",
+				mockSetup: func(m sprintMocks) {
+					m.member(board, userID, entity.RoleViewer)
+					m.sprintRepo.On("GetSprintsByBoard", mock.Anything, board.ID).Return(sprints, nil)
+				},
+				wantErr: false,
+			},
+			{
+				name: "not a member",
+				mockSetup: func(m sprintMocks) {
+					m.member(board, userID, "")
+				},
+				wantErr: true,
+				err:     repository.ErrBoardAccess,
+			},
+			{
+				name: "negative",
+				mockSetup: func(m sprintMocks) {
+					m.member(board, userID, entity.RoleViewer)
+					m.sprintRepo.On("GetSprintsByBoard", mock.Anything, board.ID).Return(nil, errors.New(""))
+				},
+				wantErr: true,
+				err:     v1.ErrGetSprintsByBoard,
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				t.Parallel()
+
+				runner.Run(t, tt.name, func(pt provider.T) {
+					m := newSprintMocks()
+					uc := m.useCase(memory.NewTxManager())
+
+					tt.mockSetup(m)
+
+					pt.WithNewStep("Call GetSprintsByBoard", func(sCtx provider.StepCtx) {
+						got, err := uc.GetSprintsByBoard(ctx, board.ID)
+
+						if tt.wantErr {
+							sCtx.Assert().Error(err, "Expected error")
+							sCtx.Assert().ErrorIs(err, tt.err)
+						} else {
+							sCtx.Assert().NoError(err, "Expected no error")
+							sCtx.Assert().Equal(sprints, got)
+						}
+
+						m.assert(t)
+					})
+				})
+			})
+		}
+	})
+}
+
+func TestAddSprintCard(t *testing.T) {
+	runner.Run(t, "TestAddSprintCard", func(pt provider.T) {
+		mom := &testdata.ObjectMother{}
+
+		userID := mom.GetUUID(5)
+		board := entity.Board{ID: mom.GetUUID(0), WorkspaceID: mom.GetUUID(6)}
+		sprintID := mom.GetUUID(1)
+		columnID := mom.GetUUID(2)
+		cardID := mom.GetUUID(3)
+		closedAt := time.Now()
+		ctx := usecase.WithCaller(context.Background(), usecase.Caller{UserID: userID})
+
+		openSprint := &entity.Sprint{ID: sprintID, BoardID: board.ID}
+		closedSprint := &entity.Sprint{ID: sprintID, BoardID: board.ID, ClosedAt: &closedAt}
+		card := &entity.Card{ID: cardID, ColumnID: columnID}
+
+		tests := []struct {
+			name      string
+			points    int
+			mockSetup func(m sprintMocks)
+			wantErr   bool
+			err       error
+		}{
+			{
+				name:   "positive",
+				points: 5,
+				mockSetup: func(m sprintMocks) {
+					m.sprintRepo.On("GetSprintByID", mock.Anything, sprintID).Return(openSprint, nil)
+					m.member(board, userID, entity.RoleMember)
+					m.cardRepo.On("GetCardByID", mock.Anything, cardID).Return(card, nil)
+					m.columnRepo.On("GetColumnByID", mock.Anything, columnID).Return(&entity.Column{ID: columnID, BoardID: board.ID}, nil)
+					m.sprintRepo.On("AddSprintCard", mock.Anything, mock.MatchedBy(func(c *entity.SprintCard) bool {
+						return c.SprintID == sprintID && c.CardID == cardID && c.Points == 5
+					})).Return(nil)
+				},
+				wantErr: false,
+			},
+			{
+				name:      "negative points",
+				points:    -1,
+				mockSetup: func(m sprintMocks) {},
+				wantErr:   true,
+				err:       repository.ErrSprintPoints,
+			},
+			{
+				name:   "closed sprint",
+				points: 5,
+				mockSetup: func(m sprintMocks) {
+					m.sprintRepo.On("GetSprintByID", mock.Anything, sprintID).Return(closedSprint, nil)
+					m.member(board, userID, entity.RoleMember)
+				},
+				wantErr: true,
+				err:     repository.ErrSprintClosed,
+			},
+			{
+				name:   "card of another board",
+				points: 5,
+				mockSetup: func(m sprintMocks) {
+					m.sprintRepo.On("GetSprintByID", mock.Anything, sprintID).Return(openSprint, nil)
+					m.member(board, userID, entity.RoleMember)
+					m.cardRepo.On("GetCardByID", mock.Anything, cardID).Return(card, nil)
+					m.columnRepo.On("GetColumnByID", mock.Anything, columnID).Return(&entity.Column{ID: columnID, BoardID: mom.GetUUID(4)}, nil)
+				},
+				wantErr: true,
+				err:     repository.ErrSprintCardBoard,
+			},
+			{
+				name:   "not a member",
+				points: 5,
+				mockSetup: func(m sprintMocks) {
+					m.sprintRepo.On("GetSprintByID", mock.Anything, sprintID).Return(openSprint, nil)
+					m.member(board, userID, "")
+				},
+				wantErr: true,
+				err:     repository.ErrBoardAccess,
+			},
+			{
+				name:   "viewer",
+				points: 5,
+				mockSetup: func(m sprintMocks) {
+					m.sprintRepo.On("GetSprintByID", mock.Anything, sprintID).Return(openSprint, nil)
+					m.member(board, userID, entity.RoleViewer)
+				},
+				wantErr: true,
+				err:     repository.ErrBoardAccess,
+			},
+			{
+				name:   "negative",
+				points: 5,
+				mockSetup: func(m sprintMocks) {
+					m.sprintRepo.On("GetSprintByID", mock.Anything, sprintID).Return(openSprint, nil)
+					m.member(board, userID, entity.RoleMember)
+					m.cardRepo.On("GetCardByID", mock.Anything, cardID).Return(card, nil)
+					m.columnRepo.On("GetColumnByID", mock.Anything, columnID).Return(&entity.Column{ID: columnID, BoardID: board.ID}, nil)
+					m.sprintRepo.On("AddSprintCard", mock.Anything, mock.Anything).Return(errors.New(""))
+				},
+				wantErr: true,
+				err:     v1.ErrAddSprintCard,
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				t.Parallel()
+
+				runner.Run(t, tt.name, func(pt provider.T) {
+					m := newSprintMocks()
+					txManager := memory.NewTxManager()
+					uc := m.useCase(txManager)
+
+					tt.mockSetup(m)
+
+					pt.WithNewStep("Call AddSprintCard", func(sCtx provider.StepCtx) {
+						err := uc.AddSprintCard(ctx, &entity.SprintCard{SprintID: sprintID, CardID: cardID, Points: tt.points})
+
+						if tt.wantErr {
+							sCtx.Assert().Error(err, "Expected error")
+							sCtx.Assert().ErrorIs(err, tt.err)
+						} else {
+							sCtx.Assert().NoError(err, "Expected no error")
+							sCtx.Assert().Equal(1, txManager.Committed())
+						}
+
+						m.assert(t)
+					})
+				})
+			})
+		}
+	})
+}
+
+func TestRemoveSprintCard(t *testing.T) {
+	runner.Run(t, "TestRemoveSprintCard", func(pt provider.T) {
+		mom := &testdata.ObjectMother{}
+
+		userID := mom.GetUUID(0)
+		board := entity.Board{ID: mom.GetUUID(1), WorkspaceID: mom.GetUUID(2)}
+		sprintID := mom.GetUUID(3)
+		cardID := mom.GetUUID(4)
+		ctx := usecase.WithCaller(context.Background(), usecase.Caller{UserID: userID})
+
+		sprint := &entity.Sprint{ID: sprintID, BoardID: board.ID}
+
+		tests := []struct {
+			name      string
+			mockSetup func(m sprintMocks)
+			wantErr   bool
+			err       error
+		}{
+			{
+				name: "positive",
+				mockSetup: func(m sprintMocks) {
+					m.sprintRepo.On("GetSprintByID", mock.Anything, sprintID).Return(sprint, nil)
+					m.member(board, userID, entity.RoleMember)
+					m.sprintRepo.On("RemoveSprintCard", mock.Anything, sprintID, cardID).Return(nil)
+				},
+				wantErr: false,
+			},
+			{
+				name: "card not in sprint",
+				mockSetup: func(m sprintMocks) {
+					m.sprintRepo.On("GetSprintByID", mock.Anything, sprintID).Return(sprint, nil)
+					m.member(board, userID, entity.RoleMember)
+					m.sprintRepo.On("RemoveSprintCard", mock.Anything, sprintID, cardID).Return(repository.ErrSprintCardNotFound)
+				},
+				wantErr: true,
+				err:     repository.ErrSprintCardNotFound,
+			},
+			{
+				name: "not a member",
+				mockSetup: func(m sprintMocks) {
+					m.sprintRepo.On("GetSprintByID", mock.Anything, sprintID).Return(sprint, nil)
+					m.member(board, userID, "")
+				},
+				wantErr: true,
+				err:     repository.ErrBoardAccess,
+			},
+			{
+				name: "viewer",
+				mockSetup: func(m sprintMocks) {
+					m.sprintRepo.On("GetSprintByID", mock.Anything, sprintID).Return(sprint, nil)
+					m.member(board, userID, entity.RoleViewer)
+				},
+				wantErr: true,
+				err:     repository.ErrBoardAccess,
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				t.Parallel()
+
+				runner.Run(t, tt.name, func(pt provider.T) {
+					m := newSprintMocks()
+					uc := m.useCase(memory.NewTxManager())
+
+					tt.mockSetup(m)
+
+					pt.WithNewStep("Call RemoveSprintCard", func(sCtx provider.StepCtx) {
+						err := uc.RemoveSprintCard(ctx, sprintID, cardID)
+
+						if tt.wantErr {
+							sCtx.Assert().Error(err, "Expected error")
+							sCtx.Assert().ErrorIs(err, tt.err)
+						} else {
+							sCtx.Assert().NoError(err, "Expected no error")
+						}
+
+						m.assert(t)
+					})
+				})
+			})
+		}
+	})
+}
+
+func TestCloseSprint(t *testing.T) {
+	runner.Run(t, "TestCloseSprint", func(pt provider.T) {
+		mom := &testdata.ObjectMother{}
+
+		userID := mom.GetUUID(5)
+		board := entity.Board{ID: mom.GetUUID(0), WorkspaceID: mom.GetUUID(6)}
+		sprintID := mom.GetUUID(1)
+		nextID := mom.GetUUID(2)
+		start := time.Date(2024, 3, 4, 0, 0, 0, 0, time.UTC)
+		closedAt := time.Now()
+		ctx := usecase.WithCaller(context.Background(), usecase.Caller{UserID: userID})
+
+		sprint := entity.Sprint{ID: sprintID, BoardID: board.ID, StartDate: start}
+		next := entity.Sprint{ID: nextID, BoardID: board.ID, StartDate: start.AddDate(0, 0, 14)}
+		earlier := entity.Sprint{ID: mom.GetUUID(3), BoardID: board.ID, StartDate: start.AddDate(0, 0, -14)}
+		closed := entity.Sprint{ID: sprintID, BoardID: board.ID, ClosedAt: &closedAt}
+
+		tests := []struct {
+			name        string
+			nextID      *uuid.UUID
+			mockSetup   func(m sprintMocks)
+			wantErr     bool
+			err         error
+			wantNext    *uuid.UUID
+			wantCarried int
+		}{
+			{
+				name: "positive",
+				mockSetup: func(m sprintMocks) {
+					m.sprintRepo.On("GetSprintByID", mock.Anything, sprintID).Return(&sprint, nil)
+					m.member(board, userID, entity.RoleMember)
+					m.sprintRepo.On("GetSprintsByBoard", mock.Anything, board.ID).Return([]entity.Sprint{earlier, sprint, next}, nil)
+					m.sprintRepo.On("CloseSprint", mock.Anything, sprintID, &nextID, mock.Anything).Return(3, nil)
+				},
+				wantErr:     false,
+				wantNext:    &nextID,
+				wantCarried: 3,
+			},
+			{
+				name: "no next sprint",
+				mockSetup: func(m sprintMocks) {
+					m.sprintRepo.On("GetSprintByID", mock.Anything, sprintID).Return(&sprint, nil)
+					m.member(board, userID, entity.RoleMember)
+					m.sprintRepo.On("GetSprintsByBoard", mock.Anything, board.ID).Return([]entity.Sprint{earlier, sprint}, nil)
+					m.sprintRepo.On("CloseSprint", mock.Anything, sprintID, (*uuid.UUID)(nil), mock.Anything).Return(0, nil)
+				},
+				wantErr: false,
+			},
+			{
+				name:   "next of another board",
+				nextID: &nextID,
+				mockSetup: func(m sprintMocks) {
+					m.sprintRepo.On("GetSprintByID", mock.Anything, sprintID).Return(&sprint, nil)
+					m.member(board, userID, entity.RoleMember)
+					m.sprintRepo.On("GetSprintByID", mock.Anything, nextID).
+						Return(&entity.Sprint{ID: nextID, BoardID: mom.GetUUID(4)}, nil)
+				},
+				wantErr: true,
+				err:     repository.ErrSprintNext,
+			},
+			{
+				name: "already closed",
+				mockSetup: func(m sprintMocks) {
+					m.sprintRepo.On("GetSprintByID", mock.Anything, sprintID).Return(&closed, nil)
+					m.member(board, userID, entity.RoleMember)
+				},
+				wantErr: true,
+				err:     repository.ErrSprintClosed,
+			},
+			{
+				name: "not a member",
+				mockSetup: func(m sprintMocks) {
+					m.sprintRepo.On("GetSprintByID", mock.Anything, sprintID).Return(&sprint, nil)
+					m.member(board, userID, "")
+				},
+				wantErr: true,
+				err:     repository.ErrBoardAccess,
+			},
+			{
+				name: "viewer",
+				mockSetup: func(m sprintMocks) {
+					m.sprintRepo.On("GetSprintByID", mock.Anything, sprintID).Return(&sprint, nil)
+					m.member(board, userID, entity.RoleViewer)
+				},
+				wantErr: true,
+				err:     repository.ErrBoardAccess,
+			},
+			{
+				name: "negative",
+				mockSetup: func(m sprintMocks) {
+					m.sprintRepo.On("GetSprintByID", mock.Anything, sprintID).Return(&sprint, nil)
+					m.member(board, userID, entity.RoleMember)
+					m.sprintRepo.On("GetSprintsByBoard", mock.Anything, board.ID).Return(nil, errors.New(""))
+				},
+				wantErr: true,
+				err:     v1.ErrCloseSprint,
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				t.Parallel()
+
+				runner.Run(t, tt.name, func(pt provider.T) {
+					m := newSprintMocks()
+					uc := m.useCase(memory.NewTxManager())
+
+					tt.mockSetup(m)
+
+					pt.WithNewStep("Call CloseSprint", func(sCtx provider.StepCtx) {
+						next, carried, err := uc.CloseSprint(ctx, sprintID, tt.nextID)
+
+						if tt.wantErr {
+							sCtx.Assert().Error(err, "Expected error")
+							sCtx.Assert().ErrorIs(err, tt.err)
+						} else {
+							sCtx.Assert().NoError(err, "Expected no error")
+							sCtx.Assert().Equal(tt.wantCarried, carried)
+							if tt.wantNext == nil {
+								sCtx.Assert().Nil(next)
+							} else {
+								sCtx.Assert().Equal(*tt.wantNext, next.ID)
+							}
+						}
+
+						m.assert(t)
+					})
+				})
+			})
+		}
+	})
+}
@@ -0,0 +1,254 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+	"todo/internal/entity"
+	"todo/internal/repository"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrSwimlaneEmptyTitle       = errors.New("swimlane should have a title")
+	ErrSwimlaneNoUserID         = errors.New("swimlane should have a user id")
+	ErrSwimlaneNoBoardID        = errors.New("swimlane should have a board id")
+	ErrSwimlaneNegativePosition = errors.New("swimlane cannot have a negative position")
+	ErrCardSwimlaneBoard        = errors.New("card swimlane and column should be on the same board")
+	ErrGetSwimlaneByID          = errors.New("failed to get swimlane by id")
+	ErrGetSwimlanesByBoard      = errors.New("failed to get swimlanes by board")
+	ErrCreateSwimlane           = errors.New("failed to create swimlane")
+	ErrUpdateSwimlane           = errors.New("failed to update swimlane")
+	ErrDeleteSwimlane           = errors.New("failed to delete swimlane")
+)
+
+func (uc *todoUseCase) CreateSwimlane(ctx context.Context, swimlane *entity.Swimlane) error {
+	header := "CreateSwimlane: "
+
+	uc.log.Info(ctx, header+"Usecase called; Validating swimlane", "swimlane", swimlane)
+
+	err := validateSwimlane(swimlane)
+
+	if err != nil {
+		info := "Validation failed"
+		uc.log.Info(ctx, header+info, "err", err.Error())
+		return fmt.Errorf(header+info+": %w", err)
+	}
+
+	swimlane.ID = uuid.New()
+	swimlane.Version = 1
+	swimlane.CreatedAt = time.Now()
+	swimlane.UpdatedAt = time.Now()
+
+	uc.log.Info(ctx, header+"Successful validation; Assigned uuid to swimlane", "uuid", swimlane.ID)
+
+	uc.log.Info(ctx, header+"Making request to swimlane repo (CreateSwimlane)", "swimlane", swimlane)
+
+	err = uc.swimlaneRepo.CreateSwimlane(ctx, swimlane)
+
+	if err != nil {
+		info := "Failed to create swimlane"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return fmt.Errorf(header+info+": %w", ErrCreateSwimlane)
+	}
+
+	uc.log.Info(ctx, header+"Swimlane successfully created")
+
+	return nil
+}
+
+func validateSwimlane(swimlane *entity.Swimlane) error {
+	if swimlane.Title == "" {
+		return ErrSwimlaneEmptyTitle
+	}
+
+	if swimlane.UserID == uuid.Nil {
+		return ErrSwimlaneNoUserID
+	}
+
+	if swimlane.BoardID == uuid.Nil {
+		return ErrSwimlaneNoBoardID
+	}
+
+	if swimlane.Position < 0 {
+		return ErrSwimlaneNegativePosition
+	}
+
+	return nil
+}
+
+func (uc *todoUseCase) GetSwimlaneByID(ctx context.Context, id uuid.UUID) (*entity.Swimlane, error) {
+	header := "GetSwimlaneByID: "
+
+	uc.log.Info(ctx, header+"Usecase called; Making request to swimlane repo (GetSwimlaneByID)", "id", id)
+
+	swimlane, err := uc.swimlaneRepo.GetSwimlaneByID(ctx, id)
+
+	if err != nil {
+		info := "Failed to get swimlane by id"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", ErrGetSwimlaneByID)
+	}
+
+	uc.log.Info(ctx, header+"Got swimlane", "swimlane", swimlane)
+
+	return swimlane, nil
+}
+
+func (uc *todoUseCase) GetSwimlanesByBoard(ctx context.Context, boardID uuid.UUID, page repository.Page) ([]entity.Swimlane, *repository.Cursor, error) {
+	header := "GetSwimlanesByBoard: "
+
+	uc.log.Info(ctx, header+"Usecase called; Validating page", "boardID", boardID, "page", page)
+
+	err := validatePage(page)
+
+	if err != nil {
+		info := "Validation failed"
+		uc.log.Info(ctx, header+info, "err", err.Error())
+		return nil, nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	uc.log.Info(ctx, header+"Validation successful; Making request to swimlane repo (GetSwimlanesByBoard)", "boardID", boardID, "page", page)
+
+	swimlanes, err := uc.swimlaneRepo.GetSwimlanesByBoard(ctx, boardID, page)
+
+	if err != nil {
+		info := "Failed to get swimlanes by board"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return nil, nil, fmt.Errorf(header+info+": %w", ErrGetSwimlanesByBoard)
+	}
+
+	uc.log.Info(ctx, header+"Got swimlanes", "swimlanes", swimlanes)
+
+	var next *repository.Cursor
+	if len(swimlanes) == page.Limit {
+		last := swimlanes[len(swimlanes)-1]
+		next = repository.NumberCursor(last.Position, last.ID)
+	}
+
+	return swimlanes, next, nil
+}
+
+func (uc *todoUseCase) UpdateSwimlane(ctx context.Context, swimlane *entity.Swimlane) error {
+	header := "UpdateSwimlane: "
+
+	uc.log.Info(ctx, header+"Usecase called; Validating swimlane", "swimlane", swimlane)
+
+	err := validateSwimlane(swimlane)
+	if err == ErrSwimlaneNoUserID || err == ErrSwimlaneNoBoardID {
+		err = nil
+	}
+
+	if err != nil {
+		info := "Validation failed"
+		uc.log.Info(ctx, header+info, "err", err.Error())
+		return fmt.Errorf(header+info+": %w", err)
+	}
+
+	swimlane.UpdatedAt = time.Now()
+
+	uc.log.Info(ctx, header+"Validation successful; Making request to swimlane repo (UpdateSwimlane)", "swimlane", swimlane)
+
+	err = uc.swimlaneRepo.UpdateSwimlane(ctx, swimlane)
+
+	if errors.Is(err, repository.ErrVersionMismatch) {
+		info := "Swimlane was changed concurrently"
+		uc.log.Info(ctx, header+info, "id", swimlane.ID, "version", swimlane.Version)
+		return fmt.Errorf(header+info+": %w", err)
+	}
+
+	if err != nil {
+		info := "Failed to update swimlane"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return fmt.Errorf(header+info+": %w", ErrUpdateSwimlane)
+	}
+
+	uc.log.Info(ctx, header+"Swimlane successfully updated")
+
+	return nil
+}
+
+// DeleteSwimlane deletes a swimlane with its cards. The last swimlane of a
+// board is kept, for new cards to have somewhere to go.
+func (uc *todoUseCase) DeleteSwimlane(ctx context.Context, id uuid.UUID, version int) error {
+	header := "DeleteSwimlane: "
+
+	uc.log.Info(ctx, header+"Usecase called; Checking swimlane is not the last one", "id", id, "version", version)
+
+	err := uc.tx.WithinTx(ctx, func(ctx context.Context) error {
+		swimlane, err := uc.swimlaneRepo.GetSwimlaneByID(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		swimlanes, err := uc.swimlaneRepo.GetSwimlanesByBoard(ctx, swimlane.BoardID, repository.Page{Limit: 2})
+		if err != nil {
+			return err
+		}
+
+		if len(swimlanes) < 2 {
+			return repository.ErrSwimlaneLast
+		}
+
+		uc.log.Info(ctx, header+"Making request to swimlane repo (DeleteSwimlane)", "id", id, "version", version)
+
+		return uc.swimlaneRepo.DeleteSwimlane(ctx, id, version)
+	})
+
+	if errors.Is(err, repository.ErrSwimlaneLast) {
+		info := "Validation failed"
+		uc.log.Info(ctx, header+info, "err", err.Error())
+		return fmt.Errorf(header+info+": %w", err)
+	}
+
+	if errors.Is(err, repository.ErrVersionMismatch) {
+		info := "Swimlane was changed concurrently"
+		uc.log.Info(ctx, header+info, "id", id, "version", version)
+		return fmt.Errorf(header+info+": %w", err)
+	}
+
+	if err != nil {
+		info := "Failed to delete swimlane"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return fmt.Errorf(header+info+": %w", ErrDeleteSwimlane)
+	}
+
+	uc.log.Info(ctx, header+"Successfully deleted swimlane")
+
+	return nil
+}
+
+// checkCardSwimlane makes sure that the swimlane a card is put in, if any,
+// is on the board of its column: the one it is put in, or else its own.
+func (uc *todoUseCase) checkCardSwimlane(ctx context.Context, card *entity.Card) error {
+	if card.SwimlaneID == uuid.Nil {
+		return nil
+	}
+
+	swimlane, err := uc.swimlaneRepo.GetSwimlaneByID(ctx, card.SwimlaneID)
+	if err != nil {
+		return err
+	}
+
+	columnID := card.ColumnID
+	if columnID == uuid.Nil {
+		current, err := uc.cardRepo.GetCardByID(ctx, card.ID)
+		if err != nil {
+			return err
+		}
+		columnID = current.ColumnID
+	}
+
+	column, err := uc.columnRepo.GetColumnByID(ctx, columnID)
+	if err != nil {
+		return err
+	}
+
+	if column.BoardID != swimlane.BoardID {
+		return ErrCardSwimlaneBoard
+	}
+
+	return nil
+}
@@ -0,0 +1,262 @@
+package v1_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	log "todo/internal/adapter/logger"
+	"todo/internal/adapter/repository/memory"
+	"todo/internal/entity"
+	"todo/internal/repository"
+	"todo/internal/testdata"
+	v1 "todo/internal/usecase/v1"
+	"todo/mocks"
+
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/runner"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCreateSwimlane(t *testing.T) {
+	runner.Run(t, "TestCreateSwimlane", func(pt provider.T) {
+		mom := &testdata.ObjectMother{}
+
+		tests := []struct {
+			name      string
+			swimlane  entity.Swimlane
+			mockSetup func(mockSwimlaneRepo *mocks.SwimlaneRepository, swimlane *entity.Swimlane)
+			wantErr   bool
+			err       error
+		}{
+			{
+				name: "positive",
+				swimlane: entity.Swimlane{
+					UserID:  mom.GetUUID(1),
+					BoardID: mom.GetUUID(2),
+					Title:   "PositiveSwimlane",
+				},
+				mockSetup: func(mockSwimlaneRepo *mocks.SwimlaneRepository, swimlane *entity.Swimlane) {
+					mockSwimlaneRepo.On("CreateSwimlane", context.Background(), swimlane).Return(nil)
+				},
+				wantErr: false,
+			},
+			{
+				name: "no board",
+				swimlane: entity.Swimlane{
+					UserID: mom.GetUUID(1),
+					Title:  "NoBoardSwimlane",
+				},
+				mockSetup: func(mockSwimlaneRepo *mocks.SwimlaneRepository, swimlane *entity.Swimlane) {},
+				wantErr:   true,
+				err:       v1.ErrSwimlaneNoBoardID,
+			},
+			{
+				name: "negative",
+				swimlane: entity.Swimlane{
+					UserID:  mom.GetUUID(1),
+					BoardID: mom.GetUUID(2),
+					Title:   "NegativeSwimlane",
+				},
+				mockSetup: func(mockSwimlaneRepo *mocks.SwimlaneRepository, swimlane *entity.Swimlane) {
+					mockSwimlaneRepo.On("CreateSwimlane", context.Background(), swimlane).Return(errors.New(""))
+				},
+				wantErr: true,
+				err:     v1.ErrCreateSwimlane,
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				t.Parallel()
+
+				runner.Run(t, tt.name, func(pt provider.T) {
+					mockSwimlaneRepo := new(mocks.SwimlaneRepository)
+					logger := log.NewEmptyLogger()
+
+					uc := v1.NewTodoUseCase(new(mocks.BoardRepository), new(mocks.ColumnRepository), mockSwimlaneRepo,
+						new(mocks.CardRepository), new(mocks.WorkspaceRepository), memory.NewTxManager(), logger)
+
+					tt.mockSetup(mockSwimlaneRepo, &tt.swimlane)
+
+					pt.WithNewStep("Call CreateSwimlane", func(sCtx provider.StepCtx) {
+						err := uc.CreateSwimlane(context.Background(), &tt.swimlane)
+
+						if tt.wantErr {
+							sCtx.Assert().Error(err, "Expected error")
+							sCtx.Assert().ErrorIs(err, tt.err)
+						} else {
+							sCtx.Assert().NoError(err, "Expected no error")
+							sCtx.Assert().Equal(1, tt.swimlane.Version)
+						}
+
+						mockSwimlaneRepo.AssertExpectations(t)
+					})
+				})
+			})
+		}
+	})
+}
+
+func TestDeleteSwimlane(t *testing.T) {
+	runner.Run(t, "TestDeleteSwimlane", func(pt provider.T) {
+		mom := &testdata.ObjectMother{}
+
+		lane := entity.Swimlane{ID: mom.GetUUID(0), BoardID: mom.GetUUID(2), Version: 1}
+		other := entity.Swimlane{ID: mom.GetUUID(1), BoardID: mom.GetUUID(2), Version: 1}
+
+		tests := []struct {
+			name           string
+			mockSetup      func(mockSwimlaneRepo *mocks.SwimlaneRepository)
+			wantErr        bool
+			err            error
+			wantRolledBack int
+		}{
+			{
+				name: "positive",
+				mockSetup: func(mockSwimlaneRepo *mocks.SwimlaneRepository) {
+					mockSwimlaneRepo.On("GetSwimlaneByID", mock.Anything, lane.ID).Return(&lane, nil)
+					mockSwimlaneRepo.On("GetSwimlanesByBoard", mock.Anything, lane.BoardID, repository.Page{Limit: 2}).
+						Return([]entity.Swimlane{lane, other}, nil)
+					mockSwimlaneRepo.On("DeleteSwimlane", mock.Anything, lane.ID, 1).Return(nil)
+				},
+				wantErr: false,
+			},
+			{
+				name: "last swimlane",
+				mockSetup: func(mockSwimlaneRepo *mocks.SwimlaneRepository) {
+					mockSwimlaneRepo.On("GetSwimlaneByID", mock.Anything, lane.ID).Return(&lane, nil)
+					mockSwimlaneRepo.On("GetSwimlanesByBoard", mock.Anything, lane.BoardID, repository.Page{Limit: 2}).
+						Return([]entity.Swimlane{lane}, nil)
+				},
+				wantErr:        true,
+				err:            repository.ErrSwimlaneLast,
+				wantRolledBack: 1,
+			},
+			{
+				name: "version mismatch",
+				mockSetup: func(mockSwimlaneRepo *mocks.SwimlaneRepository) {
+					mockSwimlaneRepo.On("GetSwimlaneByID", mock.Anything, lane.ID).Return(&lane, nil)
+					mockSwimlaneRepo.On("GetSwimlanesByBoard", mock.Anything, lane.BoardID, repository.Page{Limit: 2}).
+						Return([]entity.Swimlane{lane, other}, nil)
+					mockSwimlaneRepo.On("DeleteSwimlane", mock.Anything, lane.ID, 1).Return(repository.ErrVersionMismatch)
+				},
+				wantErr:        true,
+				err:            repository.ErrVersionMismatch,
+				wantRolledBack: 1,
+			},
+			{
+				name: "negative",
+				mockSetup: func(mockSwimlaneRepo *mocks.SwimlaneRepository) {
+					mockSwimlaneRepo.On("GetSwimlaneByID", mock.Anything, lane.ID).Return(nil, errors.New(""))
+				},
+				wantErr:        true,
+				err:            v1.ErrDeleteSwimlane,
+				wantRolledBack: 1,
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				t.Parallel()
+
+				runner.Run(t, tt.name, func(pt provider.T) {
+					mockSwimlaneRepo := new(mocks.SwimlaneRepository)
+					txManager := memory.NewTxManager()
+					logger := log.NewEmptyLogger()
+
+					uc := v1.NewTodoUseCase(new(mocks.BoardRepository), new(mocks.ColumnRepository), mockSwimlaneRepo,
+						new(mocks.CardRepository), new(mocks.WorkspaceRepository), txManager, logger)
+
+					tt.mockSetup(mockSwimlaneRepo)
+
+					pt.WithNewStep("Call DeleteSwimlane", func(sCtx provider.StepCtx) {
+						err := uc.DeleteSwimlane(context.Background(), lane.ID, 1)
+
+						if tt.wantErr {
+							sCtx.Assert().Error(err, "Expected error")
+							sCtx.Assert().ErrorIs(err, tt.err)
+						} else {
+							sCtx.Assert().NoError(err, "Expected no error")
+						}
+
+						sCtx.Assert().Equal(tt.wantRolledBack, txManager.RolledBack())
+
+						mockSwimlaneRepo.AssertExpectations(t)
+					})
+				})
+			})
+		}
+	})
+}
+
+func TestUpdateCardSwimlane(t *testing.T) {
+	runner.Run(t, "TestUpdateCardSwimlane", func(pt provider.T) {
+		mom := &testdata.ObjectMother{}
+
+		card := entity.Card{ID: mom.GetUUID(0), SwimlaneID: mom.GetUUID(1), Title: "Card", Version: 1}
+		current := entity.Card{ID: mom.GetUUID(0), ColumnID: mom.GetUUID(2), Title: "Card", Version: 1}
+		column := entity.Column{ID: mom.GetUUID(2), BoardID: mom.GetUUID(3)}
+
+		tests := []struct {
+			name      string
+			lane      entity.Swimlane
+			mockSetup func(mockCardRepo *mocks.CardRepository, card *entity.Card)
+			wantErr   bool
+			err       error
+		}{
+			{
+				name: "positive",
+				lane: entity.Swimlane{ID: mom.GetUUID(1), BoardID: mom.GetUUID(3)},
+				mockSetup: func(mockCardRepo *mocks.CardRepository, card *entity.Card) {
+					mockCardRepo.On("MoveCard", mock.Anything, card).Return(nil)
+					mockCardRepo.On("UpdateCard", mock.Anything, card).Return(nil)
+				},
+				wantErr: false,
+			},
+			{
+				name:      "swimlane on another board",
+				lane:      entity.Swimlane{ID: mom.GetUUID(1), BoardID: mom.GetUUID(4)},
+				mockSetup: func(mockCardRepo *mocks.CardRepository, card *entity.Card) {},
+				wantErr:   true,
+				err:       v1.ErrCardSwimlaneBoard,
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				t.Parallel()
+
+				runner.Run(t, tt.name, func(pt provider.T) {
+					mockColumnRepo := new(mocks.ColumnRepository)
+					mockSwimlaneRepo := new(mocks.SwimlaneRepository)
+					mockCardRepo := new(mocks.CardRepository)
+					logger := log.NewEmptyLogger()
+
+					uc := v1.NewTodoUseCase(new(mocks.BoardRepository), mockColumnRepo, mockSwimlaneRepo, mockCardRepo, new(mocks.WorkspaceRepository),
+						memory.NewTxManager(), logger)
+
+					// The card is moved to another swimlane of its current column.
+					c := card
+					mockSwimlaneRepo.On("GetSwimlaneByID", context.Background(), tt.lane.ID).Return(&tt.lane, nil)
+					mockCardRepo.On("GetCardByID", mock.Anything, card.ID).Return(&current, nil)
+					mockColumnRepo.On("GetColumnByID", context.Background(), column.ID).Return(&column, nil)
+					tt.mockSetup(mockCardRepo, &c)
+
+					pt.WithNewStep("Call UpdateCard", func(sCtx provider.StepCtx) {
+						err := uc.UpdateCard(context.Background(), &c)
+
+						if tt.wantErr {
+							sCtx.Assert().Error(err, "Expected error")
+							sCtx.Assert().ErrorIs(err, tt.err)
+						} else {
+							sCtx.Assert().NoError(err, "Expected no error")
+						}
+
+						mockCardRepo.AssertExpectations(t)
+						mockSwimlaneRepo.AssertExpectations(t)
+					})
+				})
+			})
+		}
+	})
+}
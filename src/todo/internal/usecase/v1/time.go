@@ -0,0 +1,323 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+	"todo/internal/common/logger"
+	"todo/internal/entity"
+	"todo/internal/repository"
+	"todo/internal/usecase"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrStartTimer     = errors.New("failed to start timer")
+	ErrStopTimer      = errors.New("failed to stop timer")
+	ErrLogTime        = errors.New("failed to log time")
+	ErrGetTimeEntries = errors.New("failed to get time entries")
+	ErrGetTimeReport  = errors.New("failed to get time report")
+)
+
+type timeUseCase struct {
+	timeRepo      repository.TimeEntryRepository
+	cardRepo      repository.CardRepository
+	columnRepo    repository.ColumnRepository
+	boardRepo     repository.BoardRepository
+	workspaceRepo repository.WorkspaceRepository
+	tx            repository.TxManager
+	log           logger.Logger
+}
+
+func NewTimeUseCase(
+	timeRepo repository.TimeEntryRepository,
+	cardRepo repository.CardRepository,
+	columnRepo repository.ColumnRepository,
+	boardRepo repository.BoardRepository,
+	workspaceRepo repository.WorkspaceRepository,
+	tx repository.TxManager,
+	log logger.Logger,
+) usecase.TimeUseCase {
+	return &timeUseCase{
+		timeRepo:      timeRepo,
+		cardRepo:      cardRepo,
+		columnRepo:    columnRepo,
+		boardRepo:     boardRepo,
+		workspaceRepo: workspaceRepo,
+		tx:            tx,
+		log:           log,
+	}
+}
+
+// card checks that the card exists and that the caller can see its board, or
+// change it with write set.
+func (uc *timeUseCase) card(ctx context.Context, header string, cardID uuid.UUID, write bool, failed error) error {
+	uc.log.Info(ctx, header+"Making request to card repo (GetCardByID)", "cardID", cardID)
+
+	card, err := uc.cardRepo.GetCardByID(ctx, cardID)
+
+	if err != nil {
+		info := "Card not found"
+		uc.log.Info(ctx, header+info, "cardID", cardID, "err", err.Error())
+		return fmt.Errorf(header+info+": %w", repository.ErrCardNotFound)
+	}
+
+	uc.log.Info(ctx, header+"Making request to column repo (GetColumnByID)", "columnID", card.ColumnID)
+
+	column, err := uc.columnRepo.GetColumnByID(ctx, card.ColumnID)
+
+	if err != nil {
+		info := "Failed to get column of card"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return fmt.Errorf(header+info+": %w", failed)
+	}
+
+	_, err = callerAccess(ctx, uc.boardRepo, uc.workspaceRepo, uc.log, header, column.BoardID, write)
+
+	return err
+}
+
+// accessRefused tells whether err is the caller being turned away from a board.
+func accessRefused(err error) bool {
+	return errors.Is(err, repository.ErrNoCaller) || errors.Is(err, repository.ErrBoardAccess) ||
+		errors.Is(err, repository.ErrBoardNotFound)
+}
+
+func (uc *timeUseCase) StartTimer(ctx context.Context, entry *entity.TimeEntry) error {
+	header := "StartTimer: "
+
+	uc.log.Info(ctx, header+"Usecase called; Validating time entry", "entry", entry)
+
+	if entry.UserID == uuid.Nil {
+		info := "Validation failed"
+		uc.log.Info(ctx, header+info, "err", repository.ErrTimeEntryNoUserID.Error())
+		return fmt.Errorf(header+info+": %w", repository.ErrTimeEntryNoUserID)
+	}
+
+	entry.ID = uuid.New()
+	entry.StartedAt = time.Now()
+	entry.EndedAt = nil
+	entry.CreatedAt = entry.StartedAt
+
+	err := uc.tx.WithinTx(ctx, func(ctx context.Context) error {
+		if err := uc.card(ctx, header, entry.CardID, true, ErrStartTimer); err != nil {
+			return err
+		}
+
+		running, err := uc.timeRepo.GetRunningTimeEntry(ctx, entry.UserID)
+		if err == nil {
+			uc.log.Info(ctx, header+"Timer already running", "entry", running)
+			return repository.ErrTimerRunning
+		}
+		if !errors.Is(err, repository.ErrNoTimerRunning) {
+			return err
+		}
+
+		uc.log.Info(ctx, header+"Making request to time entry repo (AddTimeEntry)", "entry", entry)
+
+		return uc.timeRepo.AddTimeEntry(ctx, entry)
+	})
+
+	if accessRefused(err) {
+		return err
+	}
+
+	if errors.Is(err, repository.ErrCardNotFound) || errors.Is(err, repository.ErrTimerRunning) {
+		info := "Validation failed"
+		uc.log.Info(ctx, header+info, "err", err.Error())
+		return fmt.Errorf(header+info+": %w", err)
+	}
+
+	if err != nil {
+		info := "Failed to start timer"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return fmt.Errorf(header+info+": %w", ErrStartTimer)
+	}
+
+	uc.log.Info(ctx, header+"Timer successfully started")
+
+	return nil
+}
+
+func (uc *timeUseCase) StopTimer(ctx context.Context, userID uuid.UUID) (*entity.TimeEntry, error) {
+	header := "StopTimer: "
+
+	uc.log.Info(ctx, header+"Usecase called; Making request to time entry repo (StopTimeEntry)", "userID", userID)
+
+	entry, err := uc.timeRepo.StopTimeEntry(ctx, userID, time.Now())
+
+	if errors.Is(err, repository.ErrNoTimerRunning) {
+		info := "No timer to stop"
+		uc.log.Info(ctx, header+info, "userID", userID)
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	if err != nil {
+		info := "Failed to stop timer"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", ErrStopTimer)
+	}
+
+	uc.log.Info(ctx, header+"Timer successfully stopped", "entry", entry)
+
+	return entry, nil
+}
+
+// LogTime records time spent earlier, without running a timer.
+func (uc *timeUseCase) LogTime(ctx context.Context, entry *entity.TimeEntry) error {
+	header := "LogTime: "
+
+	uc.log.Info(ctx, header+"Usecase called; Validating time entry", "entry", entry)
+
+	err := validateTimeEntry(entry)
+
+	if err != nil {
+		info := "Validation failed"
+		uc.log.Info(ctx, header+info, "err", err.Error())
+		return fmt.Errorf(header+info+": %w", err)
+	}
+
+	entry.ID = uuid.New()
+	entry.CreatedAt = time.Now()
+
+	err = uc.tx.WithinTx(ctx, func(ctx context.Context) error {
+		if err := uc.card(ctx, header, entry.CardID, true, ErrLogTime); err != nil {
+			return err
+		}
+
+		uc.log.Info(ctx, header+"Making request to time entry repo (AddTimeEntry)", "entry", entry)
+
+		return uc.timeRepo.AddTimeEntry(ctx, entry)
+	})
+
+	if accessRefused(err) {
+		return err
+	}
+
+	if errors.Is(err, repository.ErrCardNotFound) {
+		info := "Validation failed"
+		uc.log.Info(ctx, header+info, "err", err.Error())
+		return fmt.Errorf(header+info+": %w", err)
+	}
+
+	if err != nil {
+		info := "Failed to log time"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return fmt.Errorf(header+info+": %w", ErrLogTime)
+	}
+
+	uc.log.Info(ctx, header+"Time successfully logged")
+
+	return nil
+}
+
+func validateTimeEntry(entry *entity.TimeEntry) error {
+	if entry.UserID == uuid.Nil {
+		return repository.ErrTimeEntryNoUserID
+	}
+
+	if entry.EndedAt == nil || !entry.EndedAt.After(entry.StartedAt) {
+		return repository.ErrTimeEntryBounds
+	}
+
+	if entry.EndedAt.After(time.Now()) {
+		return repository.ErrTimeEntryInFuture
+	}
+
+	return nil
+}
+
+func (uc *timeUseCase) GetCardTimeEntries(ctx context.Context, cardID uuid.UUID) ([]entity.TimeEntry, error) {
+	header := "GetCardTimeEntries: "
+
+	uc.log.Info(ctx, header+"Usecase called", "cardID", cardID)
+
+	if err := uc.card(ctx, header, cardID, false, ErrGetTimeEntries); err != nil {
+		return nil, err
+	}
+
+	uc.log.Info(ctx, header+"Making request to time entry repo (GetTimeEntriesByCard)", "cardID", cardID)
+
+	entries, err := uc.timeRepo.GetTimeEntriesByCard(ctx, cardID)
+
+	if err != nil {
+		info := "Failed to get time entries"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", ErrGetTimeEntries)
+	}
+
+	uc.log.Info(ctx, header+"Successfully got time entries", "count", len(entries))
+
+	return entries, nil
+}
+
+func (uc *timeUseCase) GetTimeReport(ctx context.Context, query repository.TimeReportQuery) ([]entity.TimeReportRow, error) {
+	header := "GetTimeReport: "
+
+	uc.log.Info(ctx, header+"Usecase called; Validating report query", "query", query)
+
+	err := validateTimeReportQuery(query)
+
+	if err != nil {
+		info := "Validation failed"
+		uc.log.Info(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	if err := uc.reportAccess(ctx, header, query); err != nil {
+		return nil, err
+	}
+
+	uc.log.Info(ctx, header+"Making request to time entry repo (GetTimeReport)")
+
+	rows, err := uc.timeRepo.GetTimeReport(ctx, query)
+
+	if err != nil {
+		info := "Failed to get time report"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", ErrGetTimeReport)
+	}
+
+	uc.log.Info(ctx, header+"Successfully got time report", "count", len(rows))
+
+	return rows, nil
+}
+
+// reportAccess lets the caller see the report of a board they can see, and a
+// report across boards only of their own time.
+func (uc *timeUseCase) reportAccess(ctx context.Context, header string, query repository.TimeReportQuery) error {
+	if query.BoardID != nil {
+		_, err := callerAccess(ctx, uc.boardRepo, uc.workspaceRepo, uc.log, header, *query.BoardID, false)
+		return err
+	}
+
+	caller, ok := usecase.CallerFrom(ctx)
+
+	if !ok {
+		info := "Request names no caller"
+		uc.log.Info(ctx, header+info)
+		return fmt.Errorf(header+info+": %w", repository.ErrNoCaller)
+	}
+
+	if caller.UserID != *query.UserID {
+		info := "Report of another user was refused"
+		uc.log.Info(ctx, header+info, "callerID", caller.UserID, "userID", *query.UserID)
+		return fmt.Errorf(header+info+": %w", repository.ErrBoardAccess)
+	}
+
+	return nil
+}
+
+func validateTimeReportQuery(query repository.TimeReportQuery) error {
+	if query.BoardID == nil && query.UserID == nil {
+		return repository.ErrTimeReportNoScope
+	}
+
+	if !query.To.After(query.From) {
+		return repository.ErrTimeReportBounds
+	}
+
+	return nil
+}
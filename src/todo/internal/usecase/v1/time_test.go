@@ -0,0 +1,531 @@
+package v1_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+	log "todo/internal/adapter/logger"
+	"todo/internal/adapter/repository/memory"
+	"todo/internal/entity"
+	"todo/internal/repository"
+	"todo/internal/testdata"
+	"todo/internal/usecase"
+	v1 "todo/internal/usecase/v1"
+	"todo/mocks"
+
+	"github.com/google/uuid"
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/runner"
+	"github.com/stretchr/testify/mock"
+)
+
+type timeMocks struct {
+	timeRepo      *mocks.TimeEntryRepository
+	cardRepo      *mocks.CardRepository
+	columnRepo    *mocks.ColumnRepository
+	boardRepo     *mocks.BoardRepository
+	workspaceRepo *mocks.WorkspaceRepository
+}
+
+func newTimeMocks() timeMocks {
+	return timeMocks{
+		timeRepo:      new(mocks.TimeEntryRepository),
+		cardRepo:      new(mocks.CardRepository),
+		columnRepo:    new(mocks.ColumnRepository),
+		boardRepo:     new(mocks.BoardRepository),
+		workspaceRepo: new(mocks.WorkspaceRepository),
+	}
+}
+
+func (m timeMocks) useCase(tx repository.TxManager) usecase.TimeUseCase {
+	return v1.NewTimeUseCase(m.timeRepo, m.cardRepo, m.columnRepo, m.boardRepo, m.workspaceRepo, tx, log.NewEmptyLogger())
+}
+
+// card puts the card in a column of the board, which the user is a member of
+// with the role.
+func (m timeMocks) card(cardID uuid.UUID, board entity.Board, userID uuid.UUID, role string) {
+	columnID := uuid.New()
+	m.cardRepo.On("GetCardByID", mock.Anything, cardID).Return(&entity.Card{ID: cardID, ColumnID: columnID}, nil)
+	m.columnRepo.On("GetColumnByID", mock.Anything, columnID).Return(&entity.Column{ID: columnID, BoardID: board.ID}, nil)
+	mockMember(m.boardRepo, m.workspaceRepo, board, userID, role)
+}
+
+func (m timeMocks) assert(t *testing.T) {
+	m.timeRepo.AssertExpectations(t)
+	m.cardRepo.AssertExpectations(t)
+	m.columnRepo.AssertExpectations(t)
+	m.boardRepo.AssertExpectations(t)
+	m.workspaceRepo.AssertExpectations(t)
+}
+
+func TestStartTimer(t *testing.T) {
+	runner.Run(t, "TestStartTimer", func(pt provider.T) {
+		mom := &testdata.ObjectMother{}
+
+		userID := mom.GetUUID(0)
+		cardID := mom.GetUUID(1)
+		board := entity.Board{ID: mom.GetUUID(3), WorkspaceID: mom.GetUUID(4)}
+		ctx := usecase.WithCaller(context.Background(), usecase.Caller{UserID: userID})
+
+		tests := []struct {
+			name           string
+			userID         uuid.UUID
+			mockSetup      func(m timeMocks)
+			wantErr        bool
+			err            error
+			wantRolledBack int
+		}{
+			{
+				name:   "positive",
+				userID: userID,
+				mockSetup: func(m timeMocks) {
+					m.card(cardID, board, userID, entity.RoleMember)
+					m.timeRepo.On("GetRunningTimeEntry", mock.Anything, userID).Return(nil, repository.ErrNoTimerRunning)
+					m.timeRepo.On("AddTimeEntry", mock.Anything, mock.MatchedBy(func(e *entity.TimeEntry) bool {
+						return e.CardID == cardID && e.UserID == userID && e.EndedAt == nil && !e.StartedAt.IsZero()
+					})).Return(nil)
+				},
+				wantErr: false,
+			},
+			{
+				name:      "no user",
+				userID:    uuid.Nil,
+				mockSetup: func(m timeMocks) {},
+				wantErr:   true,
+				err:       repository.ErrTimeEntryNoUserID,
+			},
+			{
+				name:   "no card",
+				userID: userID,
+				mockSetup: func(m timeMocks) {
+					m.cardRepo.On("GetCardByID", mock.Anything, cardID).Return(nil, errors.New(""))
+				},
+				wantErr:        true,
+				err:            repository.ErrCardNotFound,
+				wantRolledBack: 1,
+			},
+			{
+				name:   "already running",
+				userID: userID,
+				mockSetup: func(m timeMocks) {
+					m.card(cardID, board, userID, entity.RoleMember)
+					m.timeRepo.On("GetRunningTimeEntry", mock.Anything, userID).
+						Return(&entity.TimeEntry{ID: mom.GetUUID(2), UserID: userID}, nil)
+				},
+				wantErr:        true,
+				err:            repository.ErrTimerRunning,
+				wantRolledBack: 1,
+			},
+			{
+				name:   "started concurrently",
+				userID: userID,
+				mockSetup: func(m timeMocks) {
+					m.card(cardID, board, userID, entity.RoleMember)
+					m.timeRepo.On("GetRunningTimeEntry", mock.Anything, userID).Return(nil, repository.ErrNoTimerRunning)
+					m.timeRepo.On("AddTimeEntry", mock.Anything, mock.Anything).Return(repository.ErrTimerRunning)
+				},
+				wantErr:        true,
+				err:            repository.ErrTimerRunning,
+				wantRolledBack: 1,
+			},
+			{
+				name:   "negative",
+				userID: userID,
+				mockSetup: func(m timeMocks) {
+					m.card(cardID, board, userID, entity.RoleMember)
+					m.timeRepo.On("GetRunningTimeEntry", mock.Anything, userID).Return(nil, errors.New(""))
+				},
+				wantErr:        true,
+				err:            v1.ErrStartTimer,
+				wantRolledBack: 1,
+			},
+			{
+				name:   "not a member",
+				userID: userID,
+				mockSetup: func(m timeMocks) {
+					m.card(cardID, board, userID, "")
+				},
+				wantErr:        true,
+				err:            repository.ErrBoardAccess,
+				wantRolledBack: 1,
+			},
+			{
+				name:   "viewer",
+				userID: userID,
+				mockSetup: func(m timeMocks) {
+					m.card(cardID, board, userID, entity.RoleViewer)
+				},
+				wantErr:        true,
+				err:            repository.ErrBoardAccess,
+				wantRolledBack: 1,
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				t.Parallel()
+
+				runner.Run(t, tt.name, func(pt provider.T) {
+					m := newTimeMocks()
+					txManager := memory.NewTxManager()
+
+					uc := m.useCase(txManager)
+
+					tt.mockSetup(m)
+
+					pt.WithNewStep("Call StartTimer", func(sCtx provider.StepCtx) {
+						entry := &entity.TimeEntry{CardID: cardID, UserID: tt.userID}
+						err := uc.StartTimer(ctx, entry)
+
+						if tt.wantErr {
+							sCtx.Assert().Error(err, "Expected error")
+							sCtx.Assert().ErrorIs(err, tt.err)
+						} else {
+							sCtx.Assert().NoError(err, "Expected no error")
+							sCtx.Assert().Equal(1, txManager.Committed())
+						}
+
+						sCtx.Assert().Equal(tt.wantRolledBack, txManager.RolledBack())
+
+						m.assert(t)
+					})
+				})
+			})
+		}
+	})
+}
+
+func TestLogTime(t *testing.T) {
+	runner.Run(t, "TestLogTime", func(pt provider.T) {
+		mom := &testdata.ObjectMother{}
+
+		userID := mom.GetUUID(0)
+		cardID := mom.GetUUID(1)
+		board := entity.Board{ID: mom.GetUUID(3), WorkspaceID: mom.GetUUID(4)}
+		ctx := usecase.WithCaller(context.Background(), usecase.Caller{UserID: userID})
+
+		start := time.Now().Add(-2 * time.Hour)
+		end := start.Add(time.Hour)
+		future := time.Now().Add(time.Hour)
+
+		tests := []struct {
+			name      string
+			startedAt time.Time
+			endedAt   *time.Time
+			mockSetup func(m timeMocks)
+			wantErr   bool
+			err       error
+		}{
+			{
+				name:      "positive",
+				startedAt: start,
+				endedAt:   &end,
+				mockSetup: func(m timeMocks) {
+					m.card(cardID, board, userID, entity.RoleMember)
+					m.timeRepo.On("AddTimeEntry", mock.Anything, mock.Anything).Return(nil)
+				},
+				wantErr: false,
+			},
+			{
+				name:      "ends before it starts",
+				startedAt: end,
+				endedAt:   &start,
+				mockSetup: func(m timeMocks) {},
+				wantErr:   true,
+				err:       repository.ErrTimeEntryBounds,
+			},
+			{
+				name:      "no end",
+				startedAt: start,
+				mockSetup: func(m timeMocks) {},
+				wantErr:   true,
+				err:       repository.ErrTimeEntryBounds,
+			},
+			{
+				name:      "ends in the future",
+				startedAt: start,
+				endedAt:   &future,
+				mockSetup: func(m timeMocks) {},
+				wantErr:   true,
+				err:       repository.ErrTimeEntryInFuture,
+			},
+			{
+				name:      "negative",
+				startedAt: start,
+				endedAt:   &end,
+				mockSetup: func(m timeMocks) {
+					m.card(cardID, board, userID, entity.RoleMember)
+					m.timeRepo.On("AddTimeEntry", mock.Anything, mock.Anything).Return(errors.New(""))
+				},
+				wantErr: true,
+				err:     v1.ErrLogTime,
+			},
+			{
+				name:      "not a member",
+				startedAt: start,
+				endedAt:   &end,
+				mockSetup: func(m timeMocks) {
+					m.card(cardID, board, userID, "")
+				},
+				wantErr: true,
+				err:     repository.ErrBoardAccess,
+			},
+			{
+				name:      "viewer",
+				startedAt: start,
+				endedAt:   &end,
+				mockSetup: func(m timeMocks) {
+					m.card(cardID, board, userID, entity.RoleViewer)
+				},
+				wantErr: true,
+				err:     repository.ErrBoardAccess,
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				t.Parallel()
+
+				runner.Run(t, tt.name, func(pt provider.T) {
+					m := newTimeMocks()
+
+					uc := m.useCase(memory.NewTxManager())
+
+					tt.mockSetup(m)
+
+					pt.WithNewStep("Call LogTime", func(sCtx provider.StepCtx) {
+						entry := &entity.TimeEntry{CardID: cardID, UserID: userID, StartedAt: tt.startedAt, EndedAt: tt.endedAt}
+						err := uc.LogTime(ctx, entry)
+
+						if tt.wantErr {
+							sCtx.Assert().Error(err, "Expected error")
+							sCtx.Assert().ErrorIs(err, tt.err)
+						} else {
+							sCtx.Assert().NoError(err, "Expected no error")
+						}
+
+						m.assert(t)
+					})
+				})
+			})
+		}
+	})
+}
+
+func TestGetCardTimeEntries(t *testing.T) {
+	runner.Run(t, "TestGetCardTimeEntries", func(pt provider.T) {
+		mom := &testdata.ObjectMother{}
+
+		userID := mom.GetUUID(0)
+		cardID := mom.GetUUID(1)
+		board := entity.Board{ID: mom.GetUUID(3), WorkspaceID: mom.GetUUID(4)}
+
+		tests := []struct {
+			name      string
+			ctx       context.Context
+			mockSetup func(m timeMocks)
+			wantErr   bool
+			err       error
+		}{
+			{
+				name: "positive",
+				ctx:  usecase.WithCaller(context.Background(), usecase.Caller{UserID: userID}),
+				mockSetup: func(m timeMocks) {
+					m.card(cardID, board, userID, entity.RoleViewer)
+					m.timeRepo.On("GetTimeEntriesByCard", mock.Anything, cardID).Return([]entity.TimeEntry{{CardID: cardID}}, nil)
+				},
+				wantErr: false,
+			},
+			{
+				name: "not a member",
+				ctx:  usecase.WithCaller(context.Background(), usecase.Caller{UserID: userID}),
+				mockSetup: func(m timeMocks) {
+					m.card(cardID, board, userID, "")
+				},
+				wantErr: true,
+				err:     repository.ErrBoardAccess,
+			},
+			{
+				name: "no caller",
+				ctx:  context.Background(),
+				mockSetup: func(m timeMocks) {
+					m.cardRepo.On("GetCardByID", mock.Anything, cardID).Return(&entity.Card{ID: cardID, ColumnID: mom.GetUUID(2)}, nil)
+					m.columnRepo.On("GetColumnByID", mock.Anything, mom.GetUUID(2)).Return(&entity.Column{BoardID: board.ID}, nil)
+				},
+				wantErr: true,
+				err:     repository.ErrNoCaller,
+			},
+			{
+				name: "no card",
+				ctx:  usecase.WithCaller(context.Background(), usecase.Caller{UserID: userID}),
+				mockSetup: func(m timeMocks) {
+					m.cardRepo.On("GetCardByID", mock.Anything, cardID).Return(nil, repository.ErrCardNotFound)
+				},
+				wantErr: true,
+				err:     repository.ErrCardNotFound,
+			},
+			{
+				name: "negative",
+				ctx:  usecase.WithCaller(context.Background(), usecase.Caller{UserID: userID}),
+				mockSetup: func(m timeMocks) {
+					m.card(cardID, board, userID, entity.RoleMember)
+					m.timeRepo.On("GetTimeEntriesByCard", mock.Anything, cardID).Return(nil, errors.New(""))
+				},
+				wantErr: true,
+				err:     v1.ErrGetTimeEntries,
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				t.Parallel()
+
+				runner.Run(t, tt.name, func(pt provider.T) {
+					m := newTimeMocks()
+
+					uc := m.useCase(memory.NewTxManager())
+
+					tt.mockSetup(m)
+
+					pt.WithNewStep("Call GetCardTimeEntries", func(sCtx provider.StepCtx) {
+						entries, err := uc.GetCardTimeEntries(tt.ctx, cardID)
+
+						if tt.wantErr {
+							sCtx.Assert().Error(err, "Expected error")
+							sCtx.Assert().ErrorIs(err, tt.err)
+						} else {
+							sCtx.Assert().NoError(err, "Expected no error")
+							sCtx.Assert().Len(entries, 1)
+						}
+
+						m.assert(t)
+					})
+				})
+			})
+		}
+	})
+}
+
+func TestGetTimeReport(t *testing.T) {
+	runner.Run(t, "TestGetTimeReport", func(pt provider.T) {
+		mom := &testdata.ObjectMother{}
+
+		userID := mom.GetUUID(1)
+		otherID := mom.GetUUID(2)
+		board := entity.Board{ID: mom.GetUUID(0), WorkspaceID: mom.GetUUID(4)}
+		boardID := board.ID
+		ctx := usecase.WithCaller(context.Background(), usecase.Caller{UserID: userID})
+		from := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+		to := from.AddDate(0, 0, 7)
+
+		tests := []struct {
+			name      string
+			ctx       context.Context
+			query     repository.TimeReportQuery
+			mockSetup func(m timeMocks)
+			wantErr   bool
+			err       error
+		}{
+			{
+				name:  "positive",
+				ctx:   ctx,
+				query: repository.TimeReportQuery{BoardID: &boardID, From: from, To: to},
+				mockSetup: func(m timeMocks) {
+					mockMember(m.boardRepo, m.workspaceRepo, board, userID, entity.RoleViewer)
+					m.timeRepo.On("GetTimeReport", mock.Anything, mock.Anything).Return([]entity.TimeReportRow{{Day: from}}, nil)
+				},
+				wantErr: false,
+			},
+			{
+				name:      "no scope",
+				ctx:       ctx,
+				query:     repository.TimeReportQuery{From: from, To: to},
+				mockSetup: func(m timeMocks) {},
+				wantErr:   true,
+				err:       repository.ErrTimeReportNoScope,
+			},
+			{
+				name:      "empty range",
+				ctx:       ctx,
+				query:     repository.TimeReportQuery{BoardID: &boardID, From: to, To: from},
+				mockSetup: func(m timeMocks) {},
+				wantErr:   true,
+				err:       repository.ErrTimeReportBounds,
+			},
+			{
+				name:  "negative",
+				ctx:   ctx,
+				query: repository.TimeReportQuery{BoardID: &boardID, From: from, To: to},
+				mockSetup: func(m timeMocks) {
+					mockMember(m.boardRepo, m.workspaceRepo, board, userID, entity.RoleMember)
+					m.timeRepo.On("GetTimeReport", mock.Anything, mock.Anything).Return(nil, errors.New(""))
+				},
+				wantErr: true,
+				err:     v1.ErrGetTimeReport,
+			},
+			{
+				name:  "not a member",
+				ctx:   ctx,
+				query: repository.TimeReportQuery{BoardID: &boardID, From: from, To: to},
+				mockSetup: func(m timeMocks) {
+					mockMember(m.boardRepo, m.workspaceRepo, board, userID, "")
+				},
+				wantErr: true,
+				err:     repository.ErrBoardAccess,
+			},
+			{
+				name:  "own time",
+				ctx:   ctx,
+				query: repository.TimeReportQuery{UserID: &userID, From: from, To: to},
+				mockSetup: func(m timeMocks) {
+					m.timeRepo.On("GetTimeReport", mock.Anything, mock.Anything).Return([]entity.TimeReportRow{{Day: from}}, nil)
+				},
+				wantErr: false,
+			},
+			{
+				name:      "time of another user",
+				ctx:       ctx,
+				query:     repository.TimeReportQuery{UserID: &otherID, From: from, To: to},
+				mockSetup: func(m timeMocks) {},
+				wantErr:   true,
+				err:       repository.ErrBoardAccess,
+			},
+			{
+				name:      "no caller",
+				ctx:       context.Background(),
+				query:     repository.TimeReportQuery{UserID: &userID, From: from, To: to},
+				mockSetup: func(m timeMocks) {},
+				wantErr:   true,
+				err:       repository.ErrNoCaller,
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				t.Parallel()
+
+				runner.Run(t, tt.name, func(pt provider.T) {
+					m := newTimeMocks()
+
+					uc := m.useCase(memory.NewTxManager())
+
+					tt.mockSetup(m)
+
+					pt.WithNewStep("Call GetTimeReport", func(sCtx provider.StepCtx) {
+						_, err := uc.GetTimeReport(tt.ctx, tt.query)
+
+						if tt.wantErr {
+							sCtx.Assert().Error(err, "Expected error")
+							sCtx.Assert().ErrorIs(err, tt.err)
+						} else {
+							sCtx.Assert().NoError(err, "Expected no error")
+						}
+
+						m.assert(t)
+					})
+				})
+			})
+		}
+	})
+}
@@ -20,12 +20,11 @@ var (
 	ErrColumnNoUserID         = errors.New("column should have a user id")
 	ErrColumnNoBoardID        = errors.New("column should have a board id")
 	ErrColumnNegativePosition = errors.New("column cannot have a negative position")
-	ErrNegativeLimitOrOffset  = errors.New("limit and offset cannot be negative")
-	ErrZeroLimit              = errors.New("limit cannot be zero")
 	ErrCardNoUserID           = errors.New("card should have a user id")
 	ErrCardNoColumnID         = errors.New("card should have a column id")
 	ErrCardNegativePosition   = errors.New("card cannot have a negative position")
 	ErrCardEmptyTitle         = errors.New("card should have a title")
+	ErrCardInvalidLabel       = errors.New("card labels should be non-empty, unique and at most 64 characters long")
 	ErrGetBoardByID           = errors.New("failed to get board by id")
 	ErrGetBoardsByUser        = errors.New("failed to get boards by user")
 	ErrCreateBoard            = errors.New("failed to create board")
@@ -38,31 +37,46 @@ var (
 	ErrDeleteColumn           = errors.New("failed to delete column")
 	ErrGetCardByID            = errors.New("failed to get card by id")
 	ErrGetCardsByColumn       = errors.New("failed to get cards by column")
+	ErrGetCards               = errors.New("failed to get cards")
 	ErrCreateCard             = errors.New("failed to create card")
 	ErrUpdateCard             = errors.New("failed to update card")
 	ErrDeleteCard             = errors.New("failed to delete card")
-	ErrInvalidTimeRange       = errors.New("<<from>> cannot be greater than <<to>> date")
 	ErrGetNewCards            = errors.New("failed to get new cards")
+	ErrCardOpKind             = errors.New("unknown card operation")
+	ErrCardOpNoCardID         = errors.New("card operation should have a card id")
+	ErrCardOpNoColumnID       = errors.New("move should have a column id")
+	ErrCardOpNoVersion        = errors.New("card operation should have the version of the card")
+	ErrApplyCardOps           = errors.New("failed to apply card operations")
+	ErrApplyCardOp            = errors.New("failed to apply card operation")
 )
 
 type todoUseCase struct {
-	boardRepo  repository.BoardRepository
-	columnRepo repository.ColumnRepository
-	cardRepo   repository.CardRepository
-	log        logger.Logger
+	boardRepo     repository.BoardRepository
+	columnRepo    repository.ColumnRepository
+	swimlaneRepo  repository.SwimlaneRepository
+	cardRepo      repository.CardRepository
+	workspaceRepo repository.WorkspaceRepository
+	tx            repository.TxManager
+	log           logger.Logger
 }
 
 func NewTodoUseCase(
 	boardRepo repository.BoardRepository,
 	columnRepo repository.ColumnRepository,
+	swimlaneRepo repository.SwimlaneRepository,
 	cardRepo repository.CardRepository,
+	workspaceRepo repository.WorkspaceRepository,
+	tx repository.TxManager,
 	log logger.Logger,
 ) usecase.TodoUseCase {
 	return &todoUseCase{
-		boardRepo:  boardRepo,
-		columnRepo: columnRepo,
-		cardRepo:   cardRepo,
-		log:        log,
+		boardRepo:     boardRepo,
+		columnRepo:    columnRepo,
+		swimlaneRepo:  swimlaneRepo,
+		cardRepo:      cardRepo,
+		workspaceRepo: workspaceRepo,
+		tx:            tx,
+		log:           log,
 	}
 }
 
@@ -80,19 +94,30 @@ func (uc *todoUseCase) CreateBoard(ctx context.Context, board *entity.Board) err
 	}
 
 	board.ID = uuid.New()
+	board.Version = 1
 	board.CreatedAt = time.Now()
 	board.UpdatedAt = time.Now()
 
 	uc.log.Info(ctx, header+"Successful validation; Assigned uuid to board", "uuid", board.ID)
 
-	uc.log.Info(ctx, header+"Making request to board repo (CreateBoard)", "board", board)
+	err = uc.tx.WithinTx(ctx, func(ctx context.Context) error {
+		if err := uc.boardWorkspace(ctx, header, board); err != nil {
+			return err
+		}
 
-	err = uc.boardRepo.CreateBoard(ctx, board)
+		uc.log.Info(ctx, header+"Making request to board repo (CreateBoard)", "board", board)
+
+		if err := uc.boardRepo.CreateBoard(ctx, board); err != nil {
+			info := "Failed to create board"
+			uc.log.Error(ctx, header+info, "err", err.Error())
+			return fmt.Errorf(header+info+": %w", ErrCreateBoard)
+		}
+
+		return nil
+	})
 
 	if err != nil {
-		info := "Failed to create board"
-		uc.log.Error(ctx, header+info, "err", err.Error())
-		return fmt.Errorf(header+info+": %w", ErrCreateBoard)
+		return err
 	}
 
 	uc.log.Info(ctx, header+"Board successfully created")
@@ -130,32 +155,38 @@ func (uc *todoUseCase) GetBoardByID(ctx context.Context, id uuid.UUID) (*entity.
 	return board, nil
 }
 
-func (uc *todoUseCase) GetBoardsByUser(ctx context.Context, userID uuid.UUID, limit, offset int) ([]entity.Board, error) {
+func (uc *todoUseCase) GetBoardsByUser(ctx context.Context, userID uuid.UUID, page repository.Page) ([]entity.Board, *repository.Cursor, error) {
 	header := "GetBoardsByUser: "
 
-	uc.log.Info(ctx, header+"Usecase called; Validating limit and offset", "userID", userID, "limit", limit, "offset", offset)
+	uc.log.Info(ctx, header+"Usecase called; Validating page", "userID", userID, "page", page)
 
-	err := validateLimitAndOffset(limit, offset)
+	err := validatePage(page)
 
 	if err != nil {
 		info := "Validation failed"
 		uc.log.Info(ctx, header+info, "err", err.Error())
-		return nil, fmt.Errorf(header+info+": %w", err)
+		return nil, nil, fmt.Errorf(header+info+": %w", err)
 	}
 
-	uc.log.Info(ctx, header+"Making request to board repo (GetBoardsByUser)", "userID", userID, "limit", limit, "offset", offset)
+	uc.log.Info(ctx, header+"Making request to workspace repo (GetMemberBoards)", "userID", userID, "page", page)
 
-	boards, err := uc.boardRepo.GetBoardsByUser(ctx, userID, limit, offset)
+	boards, err := uc.workspaceRepo.GetMemberBoards(ctx, userID, page)
 
 	if err != nil {
 		info := "Failed to get boards by user"
 		uc.log.Error(ctx, header+info, "err", err.Error())
-		return nil, fmt.Errorf(header+info+": %w", ErrGetBoardsByUser)
+		return nil, nil, fmt.Errorf(header+info+": %w", ErrGetBoardsByUser)
 	}
 
 	uc.log.Info(ctx, header+"Got boards", "boards", boards)
 
-	return boards, nil
+	var next *repository.Cursor
+	if len(boards) == page.Limit {
+		last := boards[len(boards)-1]
+		next = repository.TimeCursor(last.CreatedAt, last.ID)
+	}
+
+	return boards, next, nil
 }
 
 func (uc *todoUseCase) UpdateBoard(ctx context.Context, board *entity.Board) error {
@@ -180,6 +211,12 @@ func (uc *todoUseCase) UpdateBoard(ctx context.Context, board *entity.Board) err
 
 	err = uc.boardRepo.UpdateBoard(ctx, board)
 
+	if errors.Is(err, repository.ErrVersionMismatch) {
+		info := "Board was changed concurrently"
+		uc.log.Info(ctx, header+info, "id", board.ID, "version", board.Version)
+		return fmt.Errorf(header+info+": %w", err)
+	}
+
 	if err != nil {
 		info := "Failed to update board"
 		uc.log.Error(ctx, header+info, "err", err.Error())
@@ -191,12 +228,18 @@ func (uc *todoUseCase) UpdateBoard(ctx context.Context, board *entity.Board) err
 	return nil
 }
 
-func (uc *todoUseCase) DeleteBoard(ctx context.Context, id uuid.UUID) error {
+func (uc *todoUseCase) DeleteBoard(ctx context.Context, id uuid.UUID, version int) error {
 	header := "DeleteBoard: "
 
-	uc.log.Info(ctx, header+"Usecase called; Making request to board repo (DeleteBoard)", "id", id)
+	uc.log.Info(ctx, header+"Usecase called; Making request to board repo (DeleteBoard)", "id", id, "version", version)
 
-	err := uc.boardRepo.DeleteBoard(ctx, id)
+	err := uc.boardRepo.DeleteBoard(ctx, id, version)
+
+	if errors.Is(err, repository.ErrVersionMismatch) {
+		info := "Board was changed concurrently"
+		uc.log.Info(ctx, header+info, "id", id, "version", version)
+		return fmt.Errorf(header+info+": %w", err)
+	}
 
 	if err != nil {
 		info := "Failed to delete board"
@@ -223,6 +266,7 @@ func (uc *todoUseCase) CreateColumn(ctx context.Context, column *entity.Column)
 	}
 
 	column.ID = uuid.New()
+	column.Version = 1
 	column.CreatedAt = time.Now()
 	column.UpdatedAt = time.Now()
 
@@ -281,41 +325,47 @@ func (uc *todoUseCase) GetColumnByID(ctx context.Context, id uuid.UUID) (*entity
 	return column, nil
 }
 
-func (uc *todoUseCase) GetColumnsByBoard(ctx context.Context, boardID uuid.UUID, limit, offset int) ([]entity.Column, error) {
+func (uc *todoUseCase) GetColumnsByBoard(ctx context.Context, boardID uuid.UUID, page repository.Page) ([]entity.Column, *repository.Cursor, error) {
 	header := "GetColumnsByBoard: "
 
-	uc.log.Info(ctx, header+"Usecase called; Validating limit and offset", "boardID", boardID, "limit", limit, "offset", offset)
+	uc.log.Info(ctx, header+"Usecase called; Validating page", "boardID", boardID, "page", page)
 
-	err := validateLimitAndOffset(limit, offset)
+	err := validatePage(page)
 
 	if err != nil {
 		info := "Validation failed"
 		uc.log.Info(ctx, header+info, "err", err.Error())
-		return nil, fmt.Errorf(header+info+": %w", err)
+		return nil, nil, fmt.Errorf(header+info+": %w", err)
 	}
 
-	uc.log.Info(ctx, header+"Validation successful; Making request to column repo (GetColumnsByBoard)", "boardID", boardID, "limit", limit, "offset", offset)
+	uc.log.Info(ctx, header+"Validation successful; Making request to column repo (GetColumnsByBoard)", "boardID", boardID, "page", page)
 
-	columns, err := uc.columnRepo.GetColumnsByBoard(ctx, boardID, limit, offset)
+	columns, err := uc.columnRepo.GetColumnsByBoard(ctx, boardID, page)
 
 	if err != nil {
 		info := "Failed to get columns by board"
 		uc.log.Error(ctx, header+info, "err", err.Error())
-		return nil, fmt.Errorf(header+info+": %w", ErrGetColumnsByBoard)
+		return nil, nil, fmt.Errorf(header+info+": %w", ErrGetColumnsByBoard)
 	}
 
 	uc.log.Info(ctx, header+"Got columns", "columns", columns)
 
-	return columns, nil
+	var next *repository.Cursor
+	if len(columns) == page.Limit {
+		last := columns[len(columns)-1]
+		next = repository.TimeCursor(last.CreatedAt, last.ID)
+	}
+
+	return columns, next, nil
 }
 
-func validateLimitAndOffset(limit, offset int) error {
-	if limit < 0 || offset < 0 {
-		return ErrNegativeLimitOrOffset
+func validatePage(page repository.Page) error {
+	if page.Limit < 0 {
+		return repository.ErrNegativeLimit
 	}
 
-	if limit == 0 {
-		return ErrZeroLimit
+	if page.Limit == 0 {
+		return repository.ErrZeroLimit
 	}
 
 	return nil
@@ -343,6 +393,12 @@ func (uc *todoUseCase) UpdateColumn(ctx context.Context, column *entity.Column)
 
 	err = uc.columnRepo.UpdateColumn(ctx, column)
 
+	if errors.Is(err, repository.ErrVersionMismatch) {
+		info := "Column was changed concurrently"
+		uc.log.Info(ctx, header+info, "id", column.ID, "version", column.Version)
+		return fmt.Errorf(header+info+": %w", err)
+	}
+
 	if err != nil {
 		info := "Failed to update column"
 		uc.log.Error(ctx, header+info, "err", err.Error())
@@ -354,12 +410,26 @@ func (uc *todoUseCase) UpdateColumn(ctx context.Context, column *entity.Column)
 	return nil
 }
 
-func (uc *todoUseCase) DeleteColumn(ctx context.Context, id uuid.UUID) error {
+func (uc *todoUseCase) DeleteColumn(ctx context.Context, id uuid.UUID, version int) error {
 	header := "DeleteColumn: "
 
-	uc.log.Info(ctx, header+"Usecase called; Making request to column repo (DeleteColumn)", "id", id)
+	uc.log.Info(ctx, header+"Usecase called; Deleting the cards of the column and the column", "id", id, "version", version)
 
-	err := uc.columnRepo.DeleteColumn(ctx, id)
+	// The cards go first so that a column is never left half emptied: either
+	// both are gone or, on any failure, neither.
+	err := uc.tx.WithinTx(ctx, func(ctx context.Context) error {
+		if err := uc.deleteColumnCards(ctx, id); err != nil {
+			return err
+		}
+
+		return uc.columnRepo.DeleteColumn(ctx, id, version)
+	})
+
+	if errors.Is(err, repository.ErrVersionMismatch) {
+		info := "Column was changed concurrently"
+		uc.log.Info(ctx, header+info, "id", id, "version", version)
+		return fmt.Errorf(header+info+": %w", err)
+	}
 
 	if err != nil {
 		info := "Failed to delete column"
@@ -372,6 +442,31 @@ func (uc *todoUseCase) DeleteColumn(ctx context.Context, id uuid.UUID) error {
 	return nil
 }
 
+const columnCleanupPage = 100
+
+// deleteColumnCards deletes the active cards of a column a page at a time;
+// archived ones go with the column itself.
+func (uc *todoUseCase) deleteColumnCards(ctx context.Context, columnID uuid.UUID) error {
+	page := repository.Page{Limit: columnCleanupPage}
+
+	for {
+		cards, err := uc.cardRepo.GetCardsByColumn(ctx, columnID, page)
+		if err != nil {
+			return err
+		}
+
+		for _, card := range cards {
+			if err := uc.cardRepo.DeleteCard(ctx, card.ID, card.Version); err != nil {
+				return err
+			}
+		}
+
+		if len(cards) < page.Limit {
+			return nil
+		}
+	}
+}
+
 func (uc *todoUseCase) CreateCard(ctx context.Context, card *entity.Card) error {
 	header := "CreateCard: "
 
@@ -385,7 +480,36 @@ func (uc *todoUseCase) CreateCard(ctx context.Context, card *entity.Card) error
 		return fmt.Errorf(header+info+": %w", err)
 	}
 
+	err = uc.checkCardSwimlane(ctx, card)
+
+	if errors.Is(err, ErrCardSwimlaneBoard) {
+		info := "Validation failed"
+		uc.log.Info(ctx, header+info, "err", err.Error())
+		return fmt.Errorf(header+info+": %w", err)
+	}
+
+	if err != nil {
+		info := "Failed to check card swimlane"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return fmt.Errorf(header+info+": %w", ErrCreateCard)
+	}
+
+	err = uc.checkCardParent(ctx, uuid.Nil, card.ParentID)
+
+	if errors.Is(err, repository.ErrCardTooDeep) {
+		info := "Validation failed"
+		uc.log.Info(ctx, header+info, "err", err.Error())
+		return fmt.Errorf(header+info+": %w", err)
+	}
+
+	if err != nil {
+		info := "Failed to check card parent"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return fmt.Errorf(header+info+": %w", ErrCreateCard)
+	}
+
 	card.ID = uuid.New()
+	card.Version = 1
 	card.CreatedAt = time.Now()
 	card.UpdatedAt = time.Now()
 
@@ -421,9 +545,27 @@ func validateCard(card *entity.Card) error {
 		return ErrCardEmptyTitle
 	}
 
+	if !validPriority(card.Priority) {
+		return repository.ErrCardInvalidPriority
+	}
+
+	seen := make(map[string]bool, len(card.Labels))
+	for _, label := range card.Labels {
+		if label == "" || len(label) > maxLabelLength || seen[label] {
+			return ErrCardInvalidLabel
+		}
+		seen[label] = true
+	}
+
 	return nil
 }
 
+const maxLabelLength = 64
+
+func validPriority(priority int) bool {
+	return entity.PriorityNone <= priority && priority <= entity.PriorityUrgent
+}
+
 func (uc *todoUseCase) GetCardByID(ctx context.Context, id uuid.UUID) (*entity.Card, error) {
 	header := "GetCardByID: "
 
@@ -442,65 +584,147 @@ func (uc *todoUseCase) GetCardByID(ctx context.Context, id uuid.UUID) (*entity.C
 	return card, nil
 }
 
-func (uc *todoUseCase) GetCardsByColumn(ctx context.Context, columnID uuid.UUID, limit, offset int) ([]entity.Card, error) {
+func (uc *todoUseCase) GetCardsByColumn(ctx context.Context, columnID uuid.UUID, page repository.Page) ([]entity.Card, *repository.Cursor, error) {
 	header := "GetCardsByColumn: "
 
-	uc.log.Info(ctx, header+"Usecase called; Validating limit and offset", "columnID", columnID, "limit", limit, "offset", offset)
+	uc.log.Info(ctx, header+"Usecase called; Validating page", "columnID", columnID, "page", page)
 
-	err := validateLimitAndOffset(limit, offset)
+	err := validatePage(page)
 
 	if err != nil {
 		info := "Validation failed"
 		uc.log.Info(ctx, header+info, "err", err.Error())
-		return nil, fmt.Errorf(header+info+": %w", err)
+		return nil, nil, fmt.Errorf(header+info+": %w", err)
 	}
 
-	uc.log.Info(ctx, header+"Successful validation; Making request to card repo (GetCardsByColumn)", "columnID", columnID, "limit", limit, "offset", offset)
+	uc.log.Info(ctx, header+"Successful validation; Making request to card repo (GetCardsByColumn)", "columnID", columnID, "page", page)
 
-	cards, err := uc.cardRepo.GetCardsByColumn(ctx, columnID, limit, offset)
+	cards, err := uc.cardRepo.GetCardsByColumn(ctx, columnID, page)
 
 	if err != nil {
 		info := "Failed to get cards by column"
 		uc.log.Error(ctx, header+info, "err", err.Error())
-		return nil, fmt.Errorf(header+info+": %w", ErrGetCardsByColumn)
+		return nil, nil, fmt.Errorf(header+info+": %w", ErrGetCardsByColumn)
+	}
+
+	uc.log.Info(ctx, header+"Got cards", "cards", cards)
+
+	return cards, nextCardCursor(cards, page, repository.SortByCreated), nil
+}
+
+func (uc *todoUseCase) GetCards(ctx context.Context, query repository.CardQuery) ([]entity.Card, *repository.Cursor, error) {
+	header := "GetCards: "
+
+	uc.log.Info(ctx, header+"Usecase called; Validating card query", "query", query)
+
+	err := validateCardQuery(&query)
+
+	if err != nil {
+		info := "Validation failed"
+		uc.log.Info(ctx, header+info, "err", err.Error())
+		return nil, nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	uc.log.Info(ctx, header+"Successful validation; Making request to card repo (GetCards)", "query", query)
+
+	cards, err := uc.cardRepo.GetCards(ctx, query)
+
+	if err != nil {
+		info := "Failed to get cards"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return nil, nil, fmt.Errorf(header+info+": %w", ErrGetCards)
 	}
 
 	uc.log.Info(ctx, header+"Got cards", "cards", cards)
 
-	return cards, nil
+	return cards, nextCardCursor(cards, query.Page, query.SortBy), nil
+}
+
+// nextCardCursor points after the last card of a full page; a short page is
+// the last one.
+func nextCardCursor(cards []entity.Card, page repository.Page, sortBy repository.CardSortField) *repository.Cursor {
+	if len(cards) == 0 || len(cards) < page.Limit {
+		return nil
+	}
+
+	return repository.CardCursor(cards[len(cards)-1], sortBy)
+}
+
+func validateCardQuery(query *repository.CardQuery) error {
+	if query.BoardID == nil && query.ColumnID == nil && query.SwimlaneID == nil && query.ParentID == nil {
+		return repository.ErrCardQueryNoScope
+	}
+
+	err := validatePage(query.Page)
+	if err != nil {
+		return err
+	}
+
+	for _, p := range query.Priorities {
+		if !validPriority(p) {
+			return repository.ErrCardInvalidPriority
+		}
+	}
+
+	switch query.SortBy {
+	case "":
+		query.SortBy = repository.SortByPosition
+	case repository.SortByPosition, repository.SortByPriority, repository.SortByCreated,
+		repository.SortByUpdated, repository.SortByDue:
+	default:
+		return repository.ErrCardQuerySortField
+	}
+
+	ranges := [][2]*time.Time{
+		{query.CreatedFrom, query.CreatedTo},
+		{query.UpdatedFrom, query.UpdatedTo},
+		{query.DueFrom, query.DueTo},
+	}
+	for _, r := range ranges {
+		if r[0] != nil && r[1] != nil {
+			if err := validateFromToDate(*r[0], *r[1]); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
 }
 
-func (uc *todoUseCase) GetNewCards(ctx context.Context, from, to time.Time) ([]entity.Card, error) {
+func (uc *todoUseCase) GetNewCards(ctx context.Context, from, to time.Time, page repository.Page) ([]entity.Card, *repository.Cursor, error) {
 	header := "GetNewCards: "
 
-	uc.log.Info(ctx, header+"Usecase called; Validating from, to dates", "from", from, "to", to)
+	uc.log.Info(ctx, header+"Usecase called; Validating from, to dates and page", "from", from, "to", to, "page", page)
 
 	err := validateFromToDate(from, to)
+	if err == nil {
+		err = validatePage(page)
+	}
 
 	if err != nil {
 		info := "Validation failed"
 		uc.log.Info(ctx, header+info, "err", err.Error())
-		return nil, fmt.Errorf(header+info+": %w", err)
+		return nil, nil, fmt.Errorf(header+info+": %w", err)
 	}
 
-	uc.log.Info(ctx, header+"Successful validation; Making request to card repo (GetNewCards)", "from", from, "to", to)
+	uc.log.Info(ctx, header+"Successful validation; Making request to card repo (GetNewCards)", "from", from, "to", to, "page", page)
 
-	cards, err := uc.cardRepo.GetNewCards(ctx, from, to)
+	cards, err := uc.cardRepo.GetNewCards(ctx, from, to, page)
 
 	if err != nil {
 		info := "Failed get new cards"
 		uc.log.Error(ctx, header+info, "err", err.Error())
-		return nil, fmt.Errorf(header+info+": %w", ErrGetNewCards)
+		return nil, nil, fmt.Errorf(header+info+": %w", ErrGetNewCards)
 	}
 
 	uc.log.Info(ctx, header+"Got cards", "cards", cards)
 
-	return cards, nil
+	return cards, nextCardCursor(cards, page, repository.SortByCreated), nil
 }
 
 func validateFromToDate(from, to time.Time) error {
 	if from.Unix() > to.Unix() {
-		return ErrInvalidTimeRange
+		return repository.ErrInvalidTimeRange
 	}
 
 	return nil
@@ -522,14 +746,43 @@ func (uc *todoUseCase) UpdateCard(ctx context.Context, card *entity.Card) error
 		return fmt.Errorf(header+info+": %w", err)
 	}
 
+	err = uc.checkCardSwimlane(ctx, card)
+
+	if errors.Is(err, ErrCardSwimlaneBoard) {
+		info := "Validation failed"
+		uc.log.Info(ctx, header+info, "err", err.Error())
+		return fmt.Errorf(header+info+": %w", err)
+	}
+
+	if err != nil {
+		info := "Failed to check card swimlane"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return fmt.Errorf(header+info+": %w", ErrUpdateCard)
+	}
+
 	card.UpdatedAt = time.Now()
 
 	uc.log.Info(ctx, header+"Successful validation; Making request to card repo (UpdateCard)", "card", card)
 
-	if card.ColumnID == uuid.Nil {
-		err = uc.cardRepo.UpdateCard(ctx, card)
-	} else {
-		err = uc.cardRepo.MoveCard(ctx, card)
+	err = uc.tx.WithinTx(ctx, func(ctx context.Context) error {
+		moved, err := uc.cardMoved(ctx, card)
+		if err != nil {
+			return err
+		}
+
+		if moved {
+			if err := uc.cardRepo.MoveCard(ctx, card); err != nil {
+				return err
+			}
+		}
+
+		return uc.cardRepo.UpdateCard(ctx, card)
+	})
+
+	if errors.Is(err, repository.ErrVersionMismatch) {
+		info := "Card was changed concurrently"
+		uc.log.Info(ctx, header+info, "id", card.ID, "version", card.Version)
+		return fmt.Errorf(header+info+": %w", err)
 	}
 
 	if err != nil {
@@ -543,12 +796,34 @@ func (uc *todoUseCase) UpdateCard(ctx context.Context, card *entity.Card) error
 	return nil
 }
 
-func (uc *todoUseCase) DeleteCard(ctx context.Context, id uuid.UUID) error {
+// cardMoved tells whether the card is put in a column or a swimlane other
+// than the one it is in.
+func (uc *todoUseCase) cardMoved(ctx context.Context, card *entity.Card) (bool, error) {
+	if card.ColumnID == uuid.Nil && card.SwimlaneID == uuid.Nil {
+		return false, nil
+	}
+
+	stored, err := uc.cardRepo.GetCardByID(ctx, card.ID)
+	if err != nil {
+		return false, err
+	}
+
+	return card.ColumnID != uuid.Nil && card.ColumnID != stored.ColumnID ||
+		card.SwimlaneID != uuid.Nil && card.SwimlaneID != stored.SwimlaneID, nil
+}
+
+func (uc *todoUseCase) DeleteCard(ctx context.Context, id uuid.UUID, version int) error {
 	header := "DeleteCard: "
 
-	uc.log.Info(ctx, header+"Usecase called; Making request to card repo (DeleteCard)", "id", id)
+	uc.log.Info(ctx, header+"Usecase called; Making request to card repo (DeleteCard)", "id", id, "version", version)
 
-	err := uc.cardRepo.DeleteCard(ctx, id)
+	err := uc.cardRepo.DeleteCard(ctx, id, version)
+
+	if errors.Is(err, repository.ErrVersionMismatch) {
+		info := "Card was changed concurrently"
+		uc.log.Info(ctx, header+info, "id", id, "version", version)
+		return fmt.Errorf(header+info+": %w", err)
+	}
 
 	if err != nil {
 		info := "Failed to delete card"
@@ -560,3 +835,151 @@ func (uc *todoUseCase) DeleteCard(ctx context.Context, id uuid.UUID) error {
 
 	return nil
 }
+
+const maxBulkOps = 500
+
+func (uc *todoUseCase) ApplyCardOps(ctx context.Context, ops []repository.CardOp, allOrNothing bool) ([]repository.CardOpResult, error) {
+	header := "ApplyCardOps: "
+
+	uc.log.Info(ctx, header+"Usecase called; Validating operations", "ops", ops, "allOrNothing", allOrNothing)
+
+	var err error
+	if len(ops) == 0 {
+		err = repository.ErrBulkEmpty
+	} else if len(ops) > maxBulkOps {
+		err = repository.ErrBulkTooLarge
+	}
+
+	if err != nil {
+		info := "Validation failed"
+		uc.log.Info(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	// Invalid items never reach the repo; index maps the items that do back
+	// to their place in the request.
+	results := make([]repository.CardOpResult, len(ops))
+	valid := make([]repository.CardOp, 0, len(ops))
+	index := make([]int, 0, len(ops))
+
+	for i, op := range ops {
+		if err := validateCardOp(op); err != nil {
+			results[i] = repository.CardOpResult{Status: repository.CardOpFailed, Err: err}
+			continue
+		}
+		valid = append(valid, op)
+		index = append(index, i)
+	}
+
+	if len(valid) < len(ops) && allOrNothing {
+		uc.log.Info(ctx, header+"Validation failed; Nothing applied", "results", results)
+		for _, i := range index {
+			results[i].Status = repository.CardOpRolledBack
+		}
+		return results, nil
+	}
+
+	if len(valid) == 0 {
+		uc.log.Info(ctx, header+"Validation failed for every operation", "results", results)
+		return results, nil
+	}
+
+	batch := repository.CardBatch{
+		Ops:          valid,
+		AllOrNothing: allOrNothing,
+		At:           time.Now(),
+	}
+
+	uc.log.Info(ctx, header+"Making request to card repo (ApplyCardBatch)", "batch", batch)
+
+	applied, err := uc.cardRepo.ApplyCardBatch(ctx, batch)
+
+	if err != nil {
+		info := "Failed to apply card operations"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", ErrApplyCardOps)
+	}
+
+	for j, result := range applied {
+		// Only errors the caller can act on are passed through; anything else
+		// is a storage detail that belongs in the log.
+		if result.Err != nil && !errors.Is(result.Err, repository.ErrVersionMismatch) && !errors.Is(result.Err, repository.ErrCardNotFound) &&
+			!errors.Is(result.Err, repository.ErrCardHasChildren) {
+			uc.log.Error(ctx, header+"Failed to apply card operation", "op", valid[j], "err", result.Err.Error())
+			result.Err = ErrApplyCardOp
+		}
+		results[index[j]] = result
+	}
+
+	uc.log.Info(ctx, header+"Applied card operations", "results", results)
+
+	return results, nil
+}
+
+// applyUnrefused applies, with apply, the operations a decorator did not
+// refuse, refused[i] being why it refused the i-th one. Refused operations
+// fail on their own, as invalid ones do, and fail the whole batch when it
+// is all or nothing.
+func applyUnrefused(ops []repository.CardOp, refused []error, allOrNothing bool,
+	apply func(ops []repository.CardOp) ([]repository.CardOpResult, error)) ([]repository.CardOpResult, error) {
+	results := make([]repository.CardOpResult, len(ops))
+	unrefused := make([]repository.CardOp, 0, len(ops))
+	index := make([]int, 0, len(ops))
+
+	for i, op := range ops {
+		if refused[i] != nil {
+			results[i] = repository.CardOpResult{Status: repository.CardOpFailed, Err: refused[i]}
+			continue
+		}
+		unrefused = append(unrefused, op)
+		index = append(index, i)
+	}
+
+	if len(unrefused) == len(ops) {
+		return apply(ops)
+	}
+
+	if allOrNothing || len(unrefused) == 0 {
+		for _, i := range index {
+			results[i].Status = repository.CardOpRolledBack
+		}
+		return results, nil
+	}
+
+	applied, err := apply(unrefused)
+	if err != nil {
+		return nil, err
+	}
+
+	for j, result := range applied {
+		results[index[j]] = result
+	}
+
+	return results, nil
+}
+
+func validateCardOp(op repository.CardOp) error {
+	if op.CardID == uuid.Nil {
+		return ErrCardOpNoCardID
+	}
+
+	if op.Version < 1 {
+		return ErrCardOpNoVersion
+	}
+
+	switch op.Kind {
+	case repository.CardOpMove:
+		if op.ColumnID == uuid.Nil {
+			return ErrCardOpNoColumnID
+		}
+	case repository.CardOpSetLabel:
+		if op.Label == "" || len(op.Label) > maxLabelLength {
+			return ErrCardInvalidLabel
+		}
+	case repository.CardOpArchive, repository.CardOpDelete, repository.CardOpSetAssignee:
+	default:
+		return ErrCardOpKind
+	}
+
+	return nil
+}
@@ -3,10 +3,13 @@ package v1_test
 import (
 	"context"
 	"errors"
+	"reflect"
 	"testing"
 	"time"
 	log "todo/internal/adapter/logger"
+	"todo/internal/adapter/repository/memory"
 	"todo/internal/entity"
+	"todo/internal/repository"
 	"todo/mocks"
 
 	"todo/internal/testdata"
@@ -15,40 +18,91 @@ import (
 	"github.com/google/uuid"
 	"github.com/ozontech/allure-go/pkg/framework/provider"
 	"github.com/ozontech/allure-go/pkg/framework/runner"
+	"github.com/stretchr/testify/mock"
 )
 
 func TestCreateBoard(t *testing.T) {
 	runner.Run(t, "TestCreateBoard", func(pt provider.T) {
 		mom := &testdata.ObjectMother{}
 
+		userID := mom.GetUUID(1)
+		personalID := mom.GetUUID(2)
+		workspaceID := mom.GetUUID(3)
+
 		tests := []struct {
-			name      string
-			board     entity.Board
-			mockSetup func(mockBoardRepo *mocks.BoardRepository, board *entity.Board)
-			wantErr   bool
-			err       error
+			name          string
+			board         entity.Board
+			mockSetup     func(mockBoardRepo *mocks.BoardRepository, mockWorkspaceRepo *mocks.WorkspaceRepository, board *entity.Board)
+			wantWorkspace uuid.UUID
+			wantErr       bool
+			err           error
 		}{
 			{
 				name: "positive",
 				board: entity.Board{
 					ID:     mom.GetUUID(0),
-					UserID: mom.GetUUID(1),
+					UserID: userID,
 					Title:  "PositiveBoard",
 				},
-				mockSetup: func(mockBoardRepo *mocks.BoardRepository, board *entity.Board) {
-					mockBoardRepo.On("CreateBoard", context.Background(), board).Return(nil)
+				mockSetup: func(mockBoardRepo *mocks.BoardRepository, mockWorkspaceRepo *mocks.WorkspaceRepository, board *entity.Board) {
+					mockWorkspaceRepo.On("EnsurePersonalWorkspace", mock.Anything, userID, mock.Anything).Return(&entity.Workspace{ID: personalID}, nil)
+					mockBoardRepo.On("CreateBoard", mock.Anything, board).Return(nil)
 				},
-				wantErr: false,
+				wantWorkspace: personalID,
+				wantErr:       false,
+			},
+			{
+				name: "in a workspace",
+				board: entity.Board{
+					UserID:      userID,
+					WorkspaceID: workspaceID,
+					Title:       "WorkspaceBoard",
+				},
+				mockSetup: func(mockBoardRepo *mocks.BoardRepository, mockWorkspaceRepo *mocks.WorkspaceRepository, board *entity.Board) {
+					mockWorkspaceRepo.On("GetWorkspaceMember", mock.Anything, workspaceID, userID).
+						Return(&entity.WorkspaceMember{WorkspaceID: workspaceID, UserID: userID, Role: entity.RoleMember}, nil)
+					mockBoardRepo.On("CreateBoard", mock.Anything, board).Return(nil)
+				},
+				wantWorkspace: workspaceID,
+				wantErr:       false,
+			},
+			{
+				name: "viewer of the workspace",
+				board: entity.Board{
+					UserID:      userID,
+					WorkspaceID: workspaceID,
+					Title:       "WorkspaceBoard",
+				},
+				mockSetup: func(mockBoardRepo *mocks.BoardRepository, mockWorkspaceRepo *mocks.WorkspaceRepository, board *entity.Board) {
+					mockWorkspaceRepo.On("GetWorkspaceMember", mock.Anything, workspaceID, userID).
+						Return(&entity.WorkspaceMember{WorkspaceID: workspaceID, UserID: userID, Role: entity.RoleViewer}, nil)
+				},
+				wantErr: true,
+				err:     repository.ErrWorkspaceAccess,
+			},
+			{
+				name: "not a member of the workspace",
+				board: entity.Board{
+					UserID:      userID,
+					WorkspaceID: workspaceID,
+					Title:       "WorkspaceBoard",
+				},
+				mockSetup: func(mockBoardRepo *mocks.BoardRepository, mockWorkspaceRepo *mocks.WorkspaceRepository, board *entity.Board) {
+					mockWorkspaceRepo.On("GetWorkspaceMember", mock.Anything, workspaceID, userID).Return(nil, repository.ErrWorkspaceMemberNotFound)
+				},
+				wantErr: true,
+				err:     repository.ErrWorkspaceAccess,
 			},
 			{
 				name: "negative",
 				board: entity.Board{
 					ID:     mom.GetUUID(0),
-					UserID: mom.GetUUID(1),
+					UserID: userID,
 					Title:  "NegativeBoard",
 				},
-				mockSetup: func(mockBoardRepo *mocks.BoardRepository, board *entity.Board) {
-					mockBoardRepo.On("CreateBoard", context.Background(), board).Return(errors.New(""))
+				mockSetup: func(mockBoardRepo *mocks.BoardRepository, mockWorkspaceRepo *mocks.WorkspaceRepository, board *entity.Board) {
+					mockWorkspaceRepo.On("EnsurePersonalWorkspace", mock.Anything, userID, mock.Anything).Return(&entity.Workspace{ID: personalID}, nil)
+					mockBoardRepo.On("CreateBoard", mock.Anything, board).Return(errors.New(""))
 				},
 				wantErr: true,
 				err:     v1.ErrCreateBoard,
@@ -63,11 +117,12 @@ func TestCreateBoard(t *testing.T) {
 					mockBoardRepo := new(mocks.BoardRepository)
 					mockColumnRepo := new(mocks.ColumnRepository)
 					mockCardRepo := new(mocks.CardRepository)
+					mockWorkspaceRepo := new(mocks.WorkspaceRepository)
 					logger := log.NewEmptyLogger()
 
-					uc := v1.NewTodoUseCase(mockBoardRepo, mockColumnRepo, mockCardRepo, logger)
+					uc := v1.NewTodoUseCase(mockBoardRepo, mockColumnRepo, new(mocks.SwimlaneRepository), mockCardRepo, mockWorkspaceRepo, memory.NewTxManager(), logger)
 
-					tt.mockSetup(mockBoardRepo, &tt.board)
+					tt.mockSetup(mockBoardRepo, mockWorkspaceRepo, &tt.board)
 
 					pt.WithNewStep("Call CreateBoard", func(sCtx provider.StepCtx) {
 						err := uc.CreateBoard(context.Background(), &tt.board)
@@ -77,9 +132,11 @@ func TestCreateBoard(t *testing.T) {
 							sCtx.Assert().ErrorIs(err, tt.err)
 						} else {
 							sCtx.Assert().NoError(err, "Expected no error")
+							sCtx.Assert().Equal(tt.wantWorkspace, tt.board.WorkspaceID)
 						}
 
-						mockCardRepo.AssertExpectations(t)
+						mockBoardRepo.AssertExpectations(t)
+						mockWorkspaceRepo.AssertExpectations(t)
 					})
 				})
 			})
@@ -137,7 +194,7 @@ func TestCreateColumn(t *testing.T) {
 					mockCardRepo := new(mocks.CardRepository)
 					logger := log.NewEmptyLogger()
 
-					uc := v1.NewTodoUseCase(mockBoardRepo, mockColumnRepo, mockCardRepo, logger)
+					uc := v1.NewTodoUseCase(mockBoardRepo, mockColumnRepo, new(mocks.SwimlaneRepository), mockCardRepo, new(mocks.WorkspaceRepository), memory.NewTxManager(), logger)
 
 					tt.mockSetup(mockColumnRepo, &tt.column)
 
@@ -197,6 +254,19 @@ func TestCreateCard(t *testing.T) {
 				wantErr: true,
 				err:     v1.ErrCreateCard,
 			},
+			{
+				name: "invalid priority",
+				card: entity.Card{
+					ID:       mom.GetUUID(0),
+					UserID:   mom.GetUUID(1),
+					ColumnID: mom.GetUUID(2),
+					Title:    "UrgentCard",
+					Priority: entity.PriorityUrgent + 1,
+				},
+				mockSetup: func(mockCardRepo *mocks.CardRepository, card *entity.Card) {},
+				wantErr:   true,
+				err:       repository.ErrCardInvalidPriority,
+			},
 		}
 
 		for _, tt := range tests {
@@ -209,7 +279,7 @@ func TestCreateCard(t *testing.T) {
 					mockCardRepo := new(mocks.CardRepository)
 					logger := log.NewEmptyLogger()
 
-					uc := v1.NewTodoUseCase(mockBoardRepo, mockColumnRepo, mockCardRepo, logger)
+					uc := v1.NewTodoUseCase(mockBoardRepo, mockColumnRepo, new(mocks.SwimlaneRepository), mockCardRepo, new(mocks.WorkspaceRepository), memory.NewTxManager(), logger)
 
 					tt.mockSetup(mockCardRepo, &tt.card)
 
@@ -277,7 +347,7 @@ func TestGetBoardByID(t *testing.T) {
 					mockCardRepo := new(mocks.CardRepository)
 					logger := log.NewEmptyLogger()
 
-					uc := v1.NewTodoUseCase(mockBoardRepo, mockColumnRepo, mockCardRepo, logger)
+					uc := v1.NewTodoUseCase(mockBoardRepo, mockColumnRepo, new(mocks.SwimlaneRepository), mockCardRepo, new(mocks.WorkspaceRepository), memory.NewTxManager(), logger)
 
 					tt.mockSetup(mockBoardRepo, tt.id)
 
@@ -346,7 +416,7 @@ func TestGetColumnByID(t *testing.T) {
 					mockCardRepo := new(mocks.CardRepository)
 					logger := log.NewEmptyLogger()
 
-					uc := v1.NewTodoUseCase(mockBoardRepo, mockColumnRepo, mockCardRepo, logger)
+					uc := v1.NewTodoUseCase(mockBoardRepo, mockColumnRepo, new(mocks.SwimlaneRepository), mockCardRepo, new(mocks.WorkspaceRepository), memory.NewTxManager(), logger)
 
 					tt.mockSetup(mockColumnRepo, tt.id)
 
@@ -415,7 +485,7 @@ func TestGetCardByID(t *testing.T) {
 					mockCardRepo := new(mocks.CardRepository)
 					logger := log.NewEmptyLogger()
 
-					uc := v1.NewTodoUseCase(mockBoardRepo, mockColumnRepo, mockCardRepo, logger)
+					uc := v1.NewTodoUseCase(mockBoardRepo, mockColumnRepo, new(mocks.SwimlaneRepository), mockCardRepo, new(mocks.WorkspaceRepository), memory.NewTxManager(), logger)
 
 					tt.mockSetup(mockCardRepo, tt.id)
 
@@ -444,18 +514,16 @@ func TestGetBoardsByUser(t *testing.T) {
 		tests := []struct {
 			name      string
 			userID    uuid.UUID
-			limit     int
-			offset    int
-			mockSetup func(mockBoardRepo *mocks.BoardRepository, userID uuid.UUID, limit, offset int)
+			page      repository.Page
+			mockSetup func(mockWorkspaceRepo *mocks.WorkspaceRepository, userID uuid.UUID, page repository.Page)
 			wantErr   bool
 			err       error
 		}{
 			{
 				name:   "positive",
 				userID: mom.GetUUID(0),
-				limit:  3,
-				offset: 0,
-				mockSetup: func(mockBoardRepo *mocks.BoardRepository, userID uuid.UUID, limit, offset int) {
+				page:   repository.Page{Limit: 3},
+				mockSetup: func(mockWorkspaceRepo *mocks.WorkspaceRepository, userID uuid.UUID, page repository.Page) {
 					boardEntities := make([]entity.Board, 3)
 
 					boardEntities[0] = entity.Board{
@@ -474,17 +542,16 @@ func TestGetBoardsByUser(t *testing.T) {
 						Title:  "BoardTwo",
 					}
 
-					mockBoardRepo.On("GetBoardsByUser", context.Background(), userID, limit, offset).Return(boardEntities, nil)
+					mockWorkspaceRepo.On("GetMemberBoards", context.Background(), userID, page).Return(boardEntities, nil)
 				},
 				wantErr: false,
 			},
 			{
 				name:   "negative",
 				userID: mom.GetUUID(0),
-				limit:  3,
-				offset: 0,
-				mockSetup: func(mockBoardRepo *mocks.BoardRepository, userID uuid.UUID, limit, offset int) {
-					mockBoardRepo.On("GetBoardsByUser", context.Background(), userID, limit, offset).Return(nil, errors.New(""))
+				page:   repository.Page{Limit: 3},
+				mockSetup: func(mockWorkspaceRepo *mocks.WorkspaceRepository, userID uuid.UUID, page repository.Page) {
+					mockWorkspaceRepo.On("GetMemberBoards", context.Background(), userID, page).Return(nil, errors.New(""))
 				},
 				wantErr: true,
 				err:     v1.ErrGetBoardsByUser,
@@ -496,17 +563,15 @@ func TestGetBoardsByUser(t *testing.T) {
 				t.Parallel()
 
 				runner.Run(t, tt.name, func(pt provider.T) {
-					mockBoardRepo := new(mocks.BoardRepository)
-					mockColumnRepo := new(mocks.ColumnRepository)
-					mockCardRepo := new(mocks.CardRepository)
+					mockWorkspaceRepo := new(mocks.WorkspaceRepository)
 					logger := log.NewEmptyLogger()
 
-					uc := v1.NewTodoUseCase(mockBoardRepo, mockColumnRepo, mockCardRepo, logger)
+					uc := v1.NewTodoUseCase(new(mocks.BoardRepository), new(mocks.ColumnRepository), new(mocks.SwimlaneRepository), new(mocks.CardRepository), mockWorkspaceRepo, memory.NewTxManager(), logger)
 
-					tt.mockSetup(mockBoardRepo, tt.userID, tt.limit, tt.offset)
+					tt.mockSetup(mockWorkspaceRepo, tt.userID, tt.page)
 
 					pt.WithNewStep("Call GetBoardsByUser", func(sCtx provider.StepCtx) {
-						_, err := uc.GetBoardsByUser(context.Background(), tt.userID, tt.limit, tt.offset)
+						_, _, err := uc.GetBoardsByUser(context.Background(), tt.userID, tt.page)
 
 						if tt.wantErr {
 							sCtx.Assert().Error(err, "Expected error")
@@ -515,7 +580,7 @@ func TestGetBoardsByUser(t *testing.T) {
 							sCtx.Assert().NoError(err, "Expected no error")
 						}
 
-						mockBoardRepo.AssertExpectations(t)
+						mockWorkspaceRepo.AssertExpectations(t)
 					})
 				})
 			})
@@ -530,18 +595,16 @@ func TestGetColumnsByBoard(t *testing.T) {
 		tests := []struct {
 			name      string
 			boardID   uuid.UUID
-			limit     int
-			offset    int
-			mockSetup func(mockColumnRepo *mocks.ColumnRepository, boardID uuid.UUID, limit, offset int)
+			page      repository.Page
+			mockSetup func(mockColumnRepo *mocks.ColumnRepository, boardID uuid.UUID, page repository.Page)
 			wantErr   bool
 			err       error
 		}{
 			{
 				name:    "positive",
 				boardID: mom.GetUUID(0),
-				limit:   3,
-				offset:  0,
-				mockSetup: func(mockColumnRepo *mocks.ColumnRepository, boardID uuid.UUID, limit, offset int) {
+				page:    repository.Page{Limit: 3},
+				mockSetup: func(mockColumnRepo *mocks.ColumnRepository, boardID uuid.UUID, page repository.Page) {
 					columnEntities := make([]entity.Column, 3)
 
 					columnEntities[0] = entity.Column{
@@ -563,17 +626,16 @@ func TestGetColumnsByBoard(t *testing.T) {
 						Title:   "ColumnTwo",
 					}
 
-					mockColumnRepo.On("GetColumnsByBoard", context.Background(), boardID, limit, offset).Return(columnEntities, nil)
+					mockColumnRepo.On("GetColumnsByBoard", context.Background(), boardID, page).Return(columnEntities, nil)
 				},
 				wantErr: false,
 			},
 			{
 				name:    "negative",
 				boardID: mom.GetUUID(0),
-				limit:   3,
-				offset:  0,
-				mockSetup: func(mockColumnRepo *mocks.ColumnRepository, boardID uuid.UUID, limit, offset int) {
-					mockColumnRepo.On("GetColumnsByBoard", context.Background(), boardID, limit, offset).Return(nil, errors.New(""))
+				page:    repository.Page{Limit: 3},
+				mockSetup: func(mockColumnRepo *mocks.ColumnRepository, boardID uuid.UUID, page repository.Page) {
+					mockColumnRepo.On("GetColumnsByBoard", context.Background(), boardID, page).Return(nil, errors.New(""))
 				},
 				wantErr: true,
 				err:     v1.ErrGetColumnsByBoard,
@@ -590,12 +652,12 @@ func TestGetColumnsByBoard(t *testing.T) {
 					mockCardRepo := new(mocks.CardRepository)
 					logger := log.NewEmptyLogger()
 
-					uc := v1.NewTodoUseCase(mockBoardRepo, mockColumnRepo, mockCardRepo, logger)
+					uc := v1.NewTodoUseCase(mockBoardRepo, mockColumnRepo, new(mocks.SwimlaneRepository), mockCardRepo, new(mocks.WorkspaceRepository), memory.NewTxManager(), logger)
 
-					tt.mockSetup(mockColumnRepo, tt.boardID, tt.limit, tt.offset)
+					tt.mockSetup(mockColumnRepo, tt.boardID, tt.page)
 
 					pt.WithNewStep("Call GetColumnsByBoard", func(sCtx provider.StepCtx) {
-						_, err := uc.GetColumnsByBoard(context.Background(), tt.boardID, tt.limit, tt.offset)
+						_, _, err := uc.GetColumnsByBoard(context.Background(), tt.boardID, tt.page)
 
 						if tt.wantErr {
 							sCtx.Assert().Error(err, "Expected error")
@@ -619,18 +681,17 @@ func TestGetCardsByColumn(t *testing.T) {
 		tests := []struct {
 			name      string
 			columnID  uuid.UUID
-			limit     int
-			offset    int
-			mockSetup func(mockCardRepo *mocks.CardRepository, columnID uuid.UUID, limit, offset int)
+			page      repository.Page
+			mockSetup func(mockCardRepo *mocks.CardRepository, columnID uuid.UUID, page repository.Page)
+			wantNext  bool
 			wantErr   bool
 			err       error
 		}{
 			{
 				name:     "positive",
 				columnID: mom.GetUUID(0),
-				limit:    3,
-				offset:   0,
-				mockSetup: func(mockCardRepo *mocks.CardRepository, columnID uuid.UUID, limit, offset int) {
+				page:     repository.Page{Limit: 3},
+				mockSetup: func(mockCardRepo *mocks.CardRepository, columnID uuid.UUID, page repository.Page) {
 					cardEntities := make([]entity.Card, 3)
 
 					cardEntities[0] = entity.Card{
@@ -652,17 +713,39 @@ func TestGetCardsByColumn(t *testing.T) {
 						Title:    "CardTwo",
 					}
 
-					mockCardRepo.On("GetCardsByColumn", context.Background(), columnID, limit, offset).Return(cardEntities, nil)
+					mockCardRepo.On("GetCardsByColumn", context.Background(), columnID, page).Return(cardEntities, nil)
 				},
-				wantErr: false,
+				wantNext: true,
+				wantErr:  false,
+			},
+			{
+				name:     "last page",
+				columnID: mom.GetUUID(0),
+				page: repository.Page{
+					Limit: 3,
+					After: repository.TimeCursor(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), mom.GetUUID(1)),
+				},
+				mockSetup: func(mockCardRepo *mocks.CardRepository, columnID uuid.UUID, page repository.Page) {
+					cardEntities := []entity.Card{
+						{
+							ID:       mom.GetUUID(3),
+							UserID:   mom.GetUUID(4),
+							ColumnID: columnID,
+							Title:    "CardOne",
+						},
+					}
+
+					mockCardRepo.On("GetCardsByColumn", context.Background(), columnID, page).Return(cardEntities, nil)
+				},
+				wantNext: false,
+				wantErr:  false,
 			},
 			{
 				name:     "negative",
 				columnID: mom.GetUUID(0),
-				limit:    3,
-				offset:   0,
-				mockSetup: func(mockCardRepo *mocks.CardRepository, columnID uuid.UUID, limit, offset int) {
-					mockCardRepo.On("GetCardsByColumn", context.Background(), columnID, limit, offset).Return(nil, errors.New(""))
+				page:     repository.Page{Limit: 3},
+				mockSetup: func(mockCardRepo *mocks.CardRepository, columnID uuid.UUID, page repository.Page) {
+					mockCardRepo.On("GetCardsByColumn", context.Background(), columnID, page).Return(nil, errors.New(""))
 				},
 				wantErr: true,
 				err:     v1.ErrGetCardsByColumn,
@@ -679,12 +762,136 @@ func TestGetCardsByColumn(t *testing.T) {
 					mockCardRepo := new(mocks.CardRepository)
 					logger := log.NewEmptyLogger()
 
-					uc := v1.NewTodoUseCase(mockBoardRepo, mockColumnRepo, mockCardRepo, logger)
+					uc := v1.NewTodoUseCase(mockBoardRepo, mockColumnRepo, new(mocks.SwimlaneRepository), mockCardRepo, new(mocks.WorkspaceRepository), memory.NewTxManager(), logger)
 
-					tt.mockSetup(mockCardRepo, tt.columnID, tt.limit, tt.offset)
+					tt.mockSetup(mockCardRepo, tt.columnID, tt.page)
 
 					pt.WithNewStep("Call GetCardsByColumn", func(sCtx provider.StepCtx) {
-						_, err := uc.GetCardsByColumn(context.Background(), tt.columnID, tt.limit, tt.offset)
+						cards, next, err := uc.GetCardsByColumn(context.Background(), tt.columnID, tt.page)
+
+						if tt.wantErr {
+							sCtx.Assert().Error(err, "Expected error")
+							sCtx.Assert().ErrorIs(err, tt.err)
+						} else {
+							sCtx.Assert().NoError(err, "Expected no error")
+						}
+
+						if tt.wantNext {
+							last := cards[len(cards)-1]
+							sCtx.Assert().Equal(repository.TimeCursor(last.CreatedAt, last.ID), next)
+						} else {
+							sCtx.Assert().Nil(next)
+						}
+
+						mockCardRepo.AssertExpectations(t)
+					})
+				})
+			})
+		}
+	})
+}
+
+func TestGetCards(t *testing.T) {
+	runner.Run(t, "TestGetCards", func(pt provider.T) {
+		mom := &testdata.ObjectMother{}
+		boardID := mom.GetUUID(0)
+		label := "bug"
+
+		tests := []struct {
+			name      string
+			query     repository.CardQuery
+			mockSetup func(mockCardRepo *mocks.CardRepository, query repository.CardQuery)
+			wantErr   bool
+			err       error
+		}{
+			{
+				name: "positive",
+				query: repository.CardQuery{
+					BoardID:    &boardID,
+					Priorities: []int{entity.PriorityHigh, entity.PriorityUrgent},
+					Label:      &label,
+					Page:       repository.Page{Limit: 3},
+				},
+				mockSetup: func(mockCardRepo *mocks.CardRepository, query repository.CardQuery) {
+					query.SortBy = repository.SortByPosition
+
+					cardEntities := []entity.Card{
+						{
+							ID:       mom.GetUUID(1),
+							UserID:   mom.GetUUID(2),
+							ColumnID: mom.GetUUID(3),
+							Title:    "CardZero",
+							Priority: entity.PriorityUrgent,
+							Labels:   []string{label},
+						},
+					}
+
+					mockCardRepo.On("GetCards", context.Background(), query).Return(cardEntities, nil)
+				},
+				wantErr: false,
+			},
+			{
+				name: "negative",
+				query: repository.CardQuery{
+					BoardID: &boardID,
+					SortBy:  repository.SortByDue,
+					Page:    repository.Page{Limit: 3},
+				},
+				mockSetup: func(mockCardRepo *mocks.CardRepository, query repository.CardQuery) {
+					mockCardRepo.On("GetCards", context.Background(), query).Return(nil, errors.New(""))
+				},
+				wantErr: true,
+				err:     v1.ErrGetCards,
+			},
+			{
+				name: "no scope",
+				query: repository.CardQuery{
+					Page: repository.Page{Limit: 3},
+				},
+				mockSetup: func(mockCardRepo *mocks.CardRepository, query repository.CardQuery) {},
+				wantErr:   true,
+				err:       repository.ErrCardQueryNoScope,
+			},
+			{
+				name: "invalid priority",
+				query: repository.CardQuery{
+					BoardID:    &boardID,
+					Priorities: []int{-1},
+					Page:       repository.Page{Limit: 3},
+				},
+				mockSetup: func(mockCardRepo *mocks.CardRepository, query repository.CardQuery) {},
+				wantErr:   true,
+				err:       repository.ErrCardInvalidPriority,
+			},
+			{
+				name: "invalid sort field",
+				query: repository.CardQuery{
+					BoardID: &boardID,
+					SortBy:  "title; DROP TABLE cards",
+					Page:    repository.Page{Limit: 3},
+				},
+				mockSetup: func(mockCardRepo *mocks.CardRepository, query repository.CardQuery) {},
+				wantErr:   true,
+				err:       repository.ErrCardQuerySortField,
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				t.Parallel()
+
+				runner.Run(t, tt.name, func(pt provider.T) {
+					mockBoardRepo := new(mocks.BoardRepository)
+					mockColumnRepo := new(mocks.ColumnRepository)
+					mockCardRepo := new(mocks.CardRepository)
+					logger := log.NewEmptyLogger()
+
+					uc := v1.NewTodoUseCase(mockBoardRepo, mockColumnRepo, new(mocks.SwimlaneRepository), mockCardRepo, new(mocks.WorkspaceRepository), memory.NewTxManager(), logger)
+
+					tt.mockSetup(mockCardRepo, tt.query)
+
+					pt.WithNewStep("Call GetCards", func(sCtx provider.StepCtx) {
+						_, _, err := uc.GetCards(context.Background(), tt.query)
 
 						if tt.wantErr {
 							sCtx.Assert().Error(err, "Expected error")
@@ -706,6 +913,7 @@ func TestGetNewCards(t *testing.T) {
 		mom := &testdata.ObjectMother{}
 
 		fromTime := time.Date(2023, 9, 1, 0, 0, 0, 0, time.UTC)
+		page := repository.Page{Limit: 100}
 		toTime := time.Date(2023, 9, 30, 23, 59, 59, 0, time.UTC)
 
 		tests := []struct {
@@ -742,7 +950,7 @@ func TestGetNewCards(t *testing.T) {
 						Title:    "CardTwo",
 					}
 
-					mockCardRepo.On("GetNewCards", context.Background(), from, to).Return(cardEntities, nil)
+					mockCardRepo.On("GetNewCards", context.Background(), from, to, page).Return(cardEntities, nil)
 				},
 				wantErr: false,
 			},
@@ -751,7 +959,7 @@ func TestGetNewCards(t *testing.T) {
 				from: fromTime,
 				to:   toTime,
 				mockSetup: func(mockCardRepo *mocks.CardRepository, from, to time.Time) {
-					mockCardRepo.On("GetNewCards", context.Background(), from, to).Return(nil, errors.New(""))
+					mockCardRepo.On("GetNewCards", context.Background(), from, to, page).Return(nil, errors.New(""))
 				},
 				wantErr: true,
 				err:     v1.ErrGetNewCards,
@@ -768,12 +976,12 @@ func TestGetNewCards(t *testing.T) {
 					mockCardRepo := new(mocks.CardRepository)
 					logger := log.NewEmptyLogger()
 
-					uc := v1.NewTodoUseCase(mockBoardRepo, mockColumnRepo, mockCardRepo, logger)
+					uc := v1.NewTodoUseCase(mockBoardRepo, mockColumnRepo, new(mocks.SwimlaneRepository), mockCardRepo, new(mocks.WorkspaceRepository), memory.NewTxManager(), logger)
 
 					tt.mockSetup(mockCardRepo, tt.from, tt.to)
 
 					pt.WithNewStep("Call GetNewCards", func(sCtx provider.StepCtx) {
-						_, err := uc.GetNewCards(context.Background(), tt.from, tt.to)
+						_, _, err := uc.GetNewCards(context.Background(), tt.from, tt.to, page)
 
 						if tt.wantErr {
 							sCtx.Assert().Error(err, "Expected error")
@@ -826,6 +1034,20 @@ func TestUpdateBoard(t *testing.T) {
 				wantErr: true,
 				err:     v1.ErrUpdateBoard,
 			},
+			{
+				name: "version mismatch",
+				board: entity.Board{
+					ID:      mom.GetUUID(0),
+					UserID:  mom.GetUUID(1),
+					Title:   "StaleBoard",
+					Version: 1,
+				},
+				mockSetup: func(mockBoardRepo *mocks.BoardRepository, board *entity.Board) {
+					mockBoardRepo.On("UpdateBoard", context.Background(), board).Return(repository.ErrVersionMismatch)
+				},
+				wantErr: true,
+				err:     repository.ErrVersionMismatch,
+			},
 		}
 
 		for _, tt := range tests {
@@ -838,7 +1060,7 @@ func TestUpdateBoard(t *testing.T) {
 					mockCardRepo := new(mocks.CardRepository)
 					logger := log.NewEmptyLogger()
 
-					uc := v1.NewTodoUseCase(mockBoardRepo, mockColumnRepo, mockCardRepo, logger)
+					uc := v1.NewTodoUseCase(mockBoardRepo, mockColumnRepo, new(mocks.SwimlaneRepository), mockCardRepo, new(mocks.WorkspaceRepository), memory.NewTxManager(), logger)
 
 					tt.mockSetup(mockBoardRepo, &tt.board)
 
@@ -910,7 +1132,7 @@ func TestUpdateColumn(t *testing.T) {
 					mockCardRepo := new(mocks.CardRepository)
 					logger := log.NewEmptyLogger()
 
-					uc := v1.NewTodoUseCase(mockBoardRepo, mockColumnRepo, mockCardRepo, logger)
+					uc := v1.NewTodoUseCase(mockBoardRepo, mockColumnRepo, new(mocks.SwimlaneRepository), mockCardRepo, new(mocks.WorkspaceRepository), memory.NewTxManager(), logger)
 
 					tt.mockSetup(mockColumnRepo, &tt.column)
 
@@ -936,10 +1158,19 @@ func TestUpdateCard(t *testing.T) {
 	runner.Run(t, "TestUpdateCard", func(pt provider.T) {
 		mom := &testdata.ObjectMother{}
 
+		boardID := mom.GetUUID(4)
+		stored := &entity.Card{
+			ID:         mom.GetUUID(0),
+			UserID:     mom.GetUUID(1),
+			ColumnID:   mom.GetUUID(3),
+			SwimlaneID: mom.GetUUID(5),
+			Title:      "StoredCard",
+		}
+
 		tests := []struct {
 			name      string
 			card      entity.Card
-			mockSetup func(mockCardRepo *mocks.CardRepository, card *entity.Card)
+			mockSetup func(mockCardRepo *mocks.CardRepository, mockColumnRepo *mocks.ColumnRepository, mockSwimlaneRepo *mocks.SwimlaneRepository, card *entity.Card)
 			wantErr   bool
 			err       error
 		}{
@@ -951,8 +1182,26 @@ func TestUpdateCard(t *testing.T) {
 					ColumnID: uuid.Nil,
 					Title:    "PositiveCard",
 				},
-				mockSetup: func(mockCardRepo *mocks.CardRepository, card *entity.Card) {
-					mockCardRepo.On("UpdateCard", context.Background(), card).Return(nil)
+				mockSetup: func(mockCardRepo *mocks.CardRepository, mockColumnRepo *mocks.ColumnRepository, mockSwimlaneRepo *mocks.SwimlaneRepository, card *entity.Card) {
+					mockCardRepo.On("UpdateCard", mock.Anything, card).Return(nil)
+				},
+				wantErr: false,
+			},
+			{
+				name: "positive edit in swimlane",
+				card: entity.Card{
+					ID:         mom.GetUUID(0),
+					UserID:     mom.GetUUID(1),
+					SwimlaneID: mom.GetUUID(5),
+					Title:      "EditedCard",
+				},
+				mockSetup: func(mockCardRepo *mocks.CardRepository, mockColumnRepo *mocks.ColumnRepository, mockSwimlaneRepo *mocks.SwimlaneRepository, card *entity.Card) {
+					mockSwimlaneRepo.On("GetSwimlaneByID", mock.Anything, stored.SwimlaneID).Return(&entity.Swimlane{ID: stored.SwimlaneID, BoardID: boardID}, nil)
+					mockCardRepo.On("GetCardByID", mock.Anything, stored.ID).Return(stored, nil)
+					mockColumnRepo.On("GetColumnByID", mock.Anything, stored.ColumnID).Return(&entity.Column{ID: stored.ColumnID, BoardID: boardID}, nil)
+					mockCardRepo.On("UpdateCard", mock.Anything, mock.MatchedBy(func(c *entity.Card) bool {
+						return c.Title == "EditedCard"
+					})).Return(nil)
 				},
 				wantErr: false,
 			},
@@ -964,8 +1213,10 @@ func TestUpdateCard(t *testing.T) {
 					ColumnID: mom.GetUUID(2),
 					Title:    "PositiveCard",
 				},
-				mockSetup: func(mockCardRepo *mocks.CardRepository, card *entity.Card) {
-					mockCardRepo.On("MoveCard", context.Background(), card).Return(nil)
+				mockSetup: func(mockCardRepo *mocks.CardRepository, mockColumnRepo *mocks.ColumnRepository, mockSwimlaneRepo *mocks.SwimlaneRepository, card *entity.Card) {
+					mockCardRepo.On("GetCardByID", mock.Anything, stored.ID).Return(stored, nil)
+					mockCardRepo.On("MoveCard", mock.Anything, card).Return(nil)
+					mockCardRepo.On("UpdateCard", mock.Anything, card).Return(nil)
 				},
 				wantErr: false,
 			},
@@ -977,8 +1228,8 @@ func TestUpdateCard(t *testing.T) {
 					ColumnID: uuid.Nil,
 					Title:    "NegativeCard",
 				},
-				mockSetup: func(mockCardRepo *mocks.CardRepository, card *entity.Card) {
-					mockCardRepo.On("UpdateCard", context.Background(), card).Return(errors.New(""))
+				mockSetup: func(mockCardRepo *mocks.CardRepository, mockColumnRepo *mocks.ColumnRepository, mockSwimlaneRepo *mocks.SwimlaneRepository, card *entity.Card) {
+					mockCardRepo.On("UpdateCard", mock.Anything, card).Return(errors.New(""))
 				},
 				wantErr: true,
 				err:     v1.ErrUpdateCard,
@@ -991,8 +1242,9 @@ func TestUpdateCard(t *testing.T) {
 					ColumnID: mom.GetUUID(2),
 					Title:    "NegativeCard",
 				},
-				mockSetup: func(mockCardRepo *mocks.CardRepository, card *entity.Card) {
-					mockCardRepo.On("MoveCard", context.Background(), card).Return(errors.New(""))
+				mockSetup: func(mockCardRepo *mocks.CardRepository, mockColumnRepo *mocks.ColumnRepository, mockSwimlaneRepo *mocks.SwimlaneRepository, card *entity.Card) {
+					mockCardRepo.On("GetCardByID", mock.Anything, stored.ID).Return(stored, nil)
+					mockCardRepo.On("MoveCard", mock.Anything, card).Return(errors.New(""))
 				},
 				wantErr: true,
 				err:     v1.ErrUpdateCard,
@@ -1006,12 +1258,13 @@ func TestUpdateCard(t *testing.T) {
 				runner.Run(t, tt.name, func(pt provider.T) {
 					mockBoardRepo := new(mocks.BoardRepository)
 					mockColumnRepo := new(mocks.ColumnRepository)
+					mockSwimlaneRepo := new(mocks.SwimlaneRepository)
 					mockCardRepo := new(mocks.CardRepository)
 					logger := log.NewEmptyLogger()
 
-					uc := v1.NewTodoUseCase(mockBoardRepo, mockColumnRepo, mockCardRepo, logger)
+					uc := v1.NewTodoUseCase(mockBoardRepo, mockColumnRepo, mockSwimlaneRepo, mockCardRepo, new(mocks.WorkspaceRepository), memory.NewTxManager(), logger)
 
-					tt.mockSetup(mockCardRepo, &tt.card)
+					tt.mockSetup(mockCardRepo, mockColumnRepo, mockSwimlaneRepo, &tt.card)
 
 					pt.WithNewStep("Call UpdateCard", func(sCtx provider.StepCtx) {
 						err := uc.UpdateCard(context.Background(), &tt.card)
@@ -1024,6 +1277,8 @@ func TestUpdateCard(t *testing.T) {
 						}
 
 						mockCardRepo.AssertExpectations(t)
+						mockColumnRepo.AssertExpectations(t)
+						mockSwimlaneRepo.AssertExpectations(t)
 					})
 				})
 			})
@@ -1046,7 +1301,7 @@ func TestDeleteBoard(t *testing.T) {
 				name: "positive",
 				id:   mom.GetUUID(0),
 				mockSetup: func(mockBoardRepo *mocks.BoardRepository, id uuid.UUID) {
-					mockBoardRepo.On("DeleteBoard", context.Background(), id).Return(nil)
+					mockBoardRepo.On("DeleteBoard", context.Background(), id, 1).Return(nil)
 				},
 				wantErr: false,
 			},
@@ -1054,7 +1309,7 @@ func TestDeleteBoard(t *testing.T) {
 				name: "negative",
 				id:   mom.GetUUID(0),
 				mockSetup: func(mockBoardRepo *mocks.BoardRepository, id uuid.UUID) {
-					mockBoardRepo.On("DeleteBoard", context.Background(), id).Return(errors.New(""))
+					mockBoardRepo.On("DeleteBoard", context.Background(), id, 1).Return(errors.New(""))
 				},
 				wantErr: true,
 				err:     v1.ErrDeleteBoard,
@@ -1071,12 +1326,12 @@ func TestDeleteBoard(t *testing.T) {
 					mockCardRepo := new(mocks.CardRepository)
 					logger := log.NewEmptyLogger()
 
-					uc := v1.NewTodoUseCase(mockBoardRepo, mockColumnRepo, mockCardRepo, logger)
+					uc := v1.NewTodoUseCase(mockBoardRepo, mockColumnRepo, new(mocks.SwimlaneRepository), mockCardRepo, new(mocks.WorkspaceRepository), memory.NewTxManager(), logger)
 
 					tt.mockSetup(mockBoardRepo, tt.id)
 
 					pt.WithNewStep("Call DeleteBoard", func(sCtx provider.StepCtx) {
-						err := uc.DeleteBoard(context.Background(), tt.id)
+						err := uc.DeleteBoard(context.Background(), tt.id, 1)
 
 						if tt.wantErr {
 							sCtx.Assert().Error(err, "Expected error")
@@ -1097,26 +1352,41 @@ func TestDeleteColumn(t *testing.T) {
 	runner.Run(t, "TestDeleteColumn", func(pt provider.T) {
 		mom := &testdata.ObjectMother{}
 
+		card := entity.Card{ID: mom.GetUUID(1), ColumnID: mom.GetUUID(0), Version: 2}
+
 		tests := []struct {
 			name      string
 			id        uuid.UUID
-			mockSetup func(mockColumnRepo *mocks.ColumnRepository, id uuid.UUID)
+			mockSetup func(mockColumnRepo *mocks.ColumnRepository, mockCardRepo *mocks.CardRepository, id uuid.UUID)
 			wantErr   bool
 			err       error
 		}{
 			{
 				name: "positive",
 				id:   mom.GetUUID(0),
-				mockSetup: func(mockColumnRepo *mocks.ColumnRepository, id uuid.UUID) {
-					mockColumnRepo.On("DeleteColumn", context.Background(), id).Return(nil)
+				mockSetup: func(mockColumnRepo *mocks.ColumnRepository, mockCardRepo *mocks.CardRepository, id uuid.UUID) {
+					mockCardRepo.On("GetCardsByColumn", mock.Anything, id, mock.Anything).Return([]entity.Card{card}, nil)
+					mockCardRepo.On("DeleteCard", mock.Anything, card.ID, card.Version).Return(nil)
+					mockColumnRepo.On("DeleteColumn", mock.Anything, id, 1).Return(nil)
 				},
 				wantErr: false,
 			},
+			{
+				name: "version mismatch",
+				id:   mom.GetUUID(0),
+				mockSetup: func(mockColumnRepo *mocks.ColumnRepository, mockCardRepo *mocks.CardRepository, id uuid.UUID) {
+					mockCardRepo.On("GetCardsByColumn", mock.Anything, id, mock.Anything).Return(nil, nil)
+					mockColumnRepo.On("DeleteColumn", mock.Anything, id, 1).Return(repository.ErrVersionMismatch)
+				},
+				wantErr: true,
+				err:     repository.ErrVersionMismatch,
+			},
 			{
 				name: "negative",
 				id:   mom.GetUUID(0),
-				mockSetup: func(mockColumnRepo *mocks.ColumnRepository, id uuid.UUID) {
-					mockColumnRepo.On("DeleteColumn", context.Background(), id).Return(errors.New(""))
+				mockSetup: func(mockColumnRepo *mocks.ColumnRepository, mockCardRepo *mocks.CardRepository, id uuid.UUID) {
+					mockCardRepo.On("GetCardsByColumn", mock.Anything, id, mock.Anything).Return([]entity.Card{card}, nil)
+					mockCardRepo.On("DeleteCard", mock.Anything, card.ID, card.Version).Return(errors.New(""))
 				},
 				wantErr: true,
 				err:     v1.ErrDeleteColumn,
@@ -1133,12 +1403,12 @@ func TestDeleteColumn(t *testing.T) {
 					mockCardRepo := new(mocks.CardRepository)
 					logger := log.NewEmptyLogger()
 
-					uc := v1.NewTodoUseCase(mockBoardRepo, mockColumnRepo, mockCardRepo, logger)
+					uc := v1.NewTodoUseCase(mockBoardRepo, mockColumnRepo, new(mocks.SwimlaneRepository), mockCardRepo, new(mocks.WorkspaceRepository), memory.NewTxManager(), logger)
 
-					tt.mockSetup(mockColumnRepo, tt.id)
+					tt.mockSetup(mockColumnRepo, mockCardRepo, tt.id)
 
 					pt.WithNewStep("Call DeleteColumn", func(sCtx provider.StepCtx) {
-						err := uc.DeleteColumn(context.Background(), tt.id)
+						err := uc.DeleteColumn(context.Background(), tt.id, 1)
 
 						if tt.wantErr {
 							sCtx.Assert().Error(err, "Expected error")
@@ -1147,6 +1417,7 @@ func TestDeleteColumn(t *testing.T) {
 							sCtx.Assert().NoError(err, "Expected no error")
 						}
 
+						mockColumnRepo.AssertExpectations(t)
 						mockCardRepo.AssertExpectations(t)
 					})
 				})
@@ -1155,6 +1426,56 @@ func TestDeleteColumn(t *testing.T) {
 	})
 }
 
+// failingColumnRepo fails to delete columns, after the cards of the column
+// have already gone.
+type failingColumnRepo struct {
+	*memory.MemoryColumnRepository
+}
+
+func (r failingColumnRepo) DeleteColumn(ctx context.Context, id uuid.UUID, version int) error {
+	return errors.New("")
+}
+
+func TestDeleteColumnRollsBack(t *testing.T) {
+	runner.Run(t, "TestDeleteColumnRollsBack", func(pt provider.T) {
+		ctx := context.Background()
+		userID := uuid.New()
+		now := time.Now()
+
+		store := memory.NewStore()
+		workspaceRepo := memory.NewMemoryWorkspaceRepository(store)
+		boardRepo := memory.NewMemoryBoardRepository(store)
+		columnRepo := memory.NewMemoryColumnRepository(store)
+		cardRepo := memory.NewMemoryCardRepository(store)
+
+		workspace, err := workspaceRepo.EnsurePersonalWorkspace(ctx, userID, now)
+		pt.Require().NoError(err)
+
+		board := entity.Board{ID: uuid.New(), UserID: userID, WorkspaceID: workspace.ID, Title: "Board", Version: 1, CreatedAt: now, UpdatedAt: now}
+		pt.Require().NoError(boardRepo.CreateBoard(ctx, &board))
+
+		column := entity.Column{ID: uuid.New(), UserID: userID, BoardID: board.ID, Title: "Column", Version: 1, CreatedAt: now, UpdatedAt: now}
+		pt.Require().NoError(columnRepo.CreateColumn(ctx, &column))
+
+		card := entity.Card{ID: uuid.New(), UserID: userID, ColumnID: column.ID, Title: "Card", Version: 1, CreatedAt: now, UpdatedAt: now}
+		pt.Require().NoError(cardRepo.CreateCard(ctx, &card))
+
+		uc := v1.NewTodoUseCase(boardRepo, failingColumnRepo{columnRepo}, memory.NewMemorySwimlaneRepository(store), cardRepo, workspaceRepo,
+			memory.NewStoreTxManager(store), log.NewEmptyLogger())
+
+		pt.WithNewStep("Call DeleteColumn", func(sCtx provider.StepCtx) {
+			err := uc.DeleteColumn(ctx, column.ID, column.Version)
+
+			sCtx.Assert().ErrorIs(err, v1.ErrDeleteColumn)
+
+			// The card deleted before the column failed to go is back.
+			stored, err := cardRepo.GetCardByID(ctx, card.ID)
+			sCtx.Assert().NoError(err, "Expected the card to be kept")
+			sCtx.Assert().Equal(card.ID, stored.ID)
+		})
+	})
+}
+
 func TestDeleteCard(t *testing.T) {
 	runner.Run(t, "TestDeleteCard", func(pt provider.T) {
 		mom := &testdata.ObjectMother{}
@@ -1170,7 +1491,7 @@ func TestDeleteCard(t *testing.T) {
 				name: "positive",
 				id:   mom.GetUUID(0),
 				mockSetup: func(mockCardRepo *mocks.CardRepository, id uuid.UUID) {
-					mockCardRepo.On("DeleteCard", context.Background(), id).Return(nil)
+					mockCardRepo.On("DeleteCard", context.Background(), id, 1).Return(nil)
 				},
 				wantErr: false,
 			},
@@ -1178,11 +1499,20 @@ func TestDeleteCard(t *testing.T) {
 				name: "negative",
 				id:   mom.GetUUID(0),
 				mockSetup: func(mockCardRepo *mocks.CardRepository, id uuid.UUID) {
-					mockCardRepo.On("DeleteCard", context.Background(), id).Return(errors.New(""))
+					mockCardRepo.On("DeleteCard", context.Background(), id, 1).Return(errors.New(""))
 				},
 				wantErr: true,
 				err:     v1.ErrDeleteCard,
 			},
+			{
+				name: "version mismatch",
+				id:   mom.GetUUID(0),
+				mockSetup: func(mockCardRepo *mocks.CardRepository, id uuid.UUID) {
+					mockCardRepo.On("DeleteCard", context.Background(), id, 1).Return(repository.ErrVersionMismatch)
+				},
+				wantErr: true,
+				err:     repository.ErrVersionMismatch,
+			},
 		}
 
 		for _, tt := range tests {
@@ -1195,18 +1525,134 @@ func TestDeleteCard(t *testing.T) {
 					mockCardRepo := new(mocks.CardRepository)
 					logger := log.NewEmptyLogger()
 
-					uc := v1.NewTodoUseCase(mockBoardRepo, mockColumnRepo, mockCardRepo, logger)
+					uc := v1.NewTodoUseCase(mockBoardRepo, mockColumnRepo, new(mocks.SwimlaneRepository), mockCardRepo, new(mocks.WorkspaceRepository), memory.NewTxManager(), logger)
 
 					tt.mockSetup(mockCardRepo, tt.id)
 
 					pt.WithNewStep("Call DeleteCard", func(sCtx provider.StepCtx) {
-						err := uc.DeleteCard(context.Background(), tt.id)
+						err := uc.DeleteCard(context.Background(), tt.id, 1)
+
+						if tt.wantErr {
+							sCtx.Assert().Error(err, "Expected error")
+							sCtx.Assert().ErrorIs(err, tt.err)
+						} else {
+							sCtx.Assert().NoError(err, "Expected no error")
+						}
+
+						mockCardRepo.AssertExpectations(t)
+					})
+				})
+			})
+		}
+	})
+}
+
+func TestApplyCardOps(t *testing.T) {
+	runner.Run(t, "TestApplyCardOps", func(pt provider.T) {
+		mom := &testdata.ObjectMother{}
+
+		move := repository.CardOp{Kind: repository.CardOpMove, CardID: mom.GetUUID(0), ColumnID: mom.GetUUID(1), Version: 1}
+		archive := repository.CardOp{Kind: repository.CardOpArchive, CardID: mom.GetUUID(2), Version: 3}
+		invalid := repository.CardOp{Kind: repository.CardOpMove, CardID: mom.GetUUID(3), Version: 1}
+		unversioned := repository.CardOp{Kind: repository.CardOpDelete, CardID: mom.GetUUID(4)}
+
+		batchOf := func(allOrNothing bool, ops ...repository.CardOp) interface{} {
+			return mock.MatchedBy(func(batch repository.CardBatch) bool {
+				return batch.AllOrNothing == allOrNothing && reflect.DeepEqual(batch.Ops, ops)
+			})
+		}
+
+		tests := []struct {
+			name         string
+			ops          []repository.CardOp
+			allOrNothing bool
+			mockSetup    func(mockCardRepo *mocks.CardRepository)
+			want         []repository.CardOpStatus
+			wantErr      bool
+			err          error
+		}{
+			{
+				name: "positive",
+				ops:  []repository.CardOp{move, archive},
+				mockSetup: func(mockCardRepo *mocks.CardRepository) {
+					mockCardRepo.On("ApplyCardBatch", context.Background(), batchOf(false, move, archive)).
+						Return([]repository.CardOpResult{
+							{Status: repository.CardOpApplied},
+							{Status: repository.CardOpFailed, Err: repository.ErrVersionMismatch},
+						}, nil)
+				},
+				want: []repository.CardOpStatus{repository.CardOpApplied, repository.CardOpFailed},
+			},
+			{
+				name: "invalid operation",
+				ops:  []repository.CardOp{invalid, archive},
+				mockSetup: func(mockCardRepo *mocks.CardRepository) {
+					mockCardRepo.On("ApplyCardBatch", context.Background(), batchOf(false, archive)).
+						Return([]repository.CardOpResult{{Status: repository.CardOpApplied}}, nil)
+				},
+				want: []repository.CardOpStatus{repository.CardOpFailed, repository.CardOpApplied},
+			},
+			{
+				name: "no version",
+				ops:  []repository.CardOp{unversioned, archive},
+				mockSetup: func(mockCardRepo *mocks.CardRepository) {
+					mockCardRepo.On("ApplyCardBatch", context.Background(), batchOf(false, archive)).
+						Return([]repository.CardOpResult{{Status: repository.CardOpApplied}}, nil)
+				},
+				want: []repository.CardOpStatus{repository.CardOpFailed, repository.CardOpApplied},
+			},
+			{
+				name:         "invalid operation all or nothing",
+				ops:          []repository.CardOp{move, invalid},
+				allOrNothing: true,
+				mockSetup:    func(mockCardRepo *mocks.CardRepository) {},
+				want:         []repository.CardOpStatus{repository.CardOpRolledBack, repository.CardOpFailed},
+			},
+			{
+				name:      "empty",
+				ops:       nil,
+				mockSetup: func(mockCardRepo *mocks.CardRepository) {},
+				wantErr:   true,
+				err:       repository.ErrBulkEmpty,
+			},
+			{
+				name: "negative",
+				ops:  []repository.CardOp{move},
+				mockSetup: func(mockCardRepo *mocks.CardRepository) {
+					mockCardRepo.On("ApplyCardBatch", context.Background(), batchOf(false, move)).
+						Return(nil, errors.New(""))
+				},
+				wantErr: true,
+				err:     v1.ErrApplyCardOps,
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				t.Parallel()
+
+				runner.Run(t, tt.name, func(pt provider.T) {
+					mockBoardRepo := new(mocks.BoardRepository)
+					mockColumnRepo := new(mocks.ColumnRepository)
+					mockCardRepo := new(mocks.CardRepository)
+					logger := log.NewEmptyLogger()
+
+					uc := v1.NewTodoUseCase(mockBoardRepo, mockColumnRepo, new(mocks.SwimlaneRepository), mockCardRepo, new(mocks.WorkspaceRepository), memory.NewTxManager(), logger)
+
+					tt.mockSetup(mockCardRepo)
+
+					pt.WithNewStep("Call ApplyCardOps", func(sCtx provider.StepCtx) {
+						results, err := uc.ApplyCardOps(context.Background(), tt.ops, tt.allOrNothing)
 
 						if tt.wantErr {
 							sCtx.Assert().Error(err, "Expected error")
 							sCtx.Assert().ErrorIs(err, tt.err)
 						} else {
 							sCtx.Assert().NoError(err, "Expected no error")
+							sCtx.Require().Len(results, len(tt.want))
+							for i, status := range tt.want {
+								sCtx.Assert().Equal(status, results[i].Status)
+							}
 						}
 
 						mockCardRepo.AssertExpectations(t)
@@ -0,0 +1,436 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+	"todo/internal/common/logger"
+	"todo/internal/entity"
+	"todo/internal/repository"
+	"todo/internal/usecase"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrTransferBoards = errors.New("failed to transfer boards")
+	ErrDeleteUserData = errors.New("failed to delete user data")
+)
+
+// userBoardsLimit is the size of the pages the boards of a user are read
+// in.
+const userBoardsLimit = 100
+
+type userDataUseCase struct {
+	boardRepo     repository.BoardRepository
+	workspaceRepo repository.WorkspaceRepository
+	markRepo      repository.BoardMarkRepository
+	calendarRepo  repository.CalendarRepository
+	notifyRepo    repository.NotificationRepository
+	quotaRepo     repository.QuotaRepository
+	tx            repository.TxManager
+	quota         entity.Quota
+	log           logger.Logger
+}
+
+func NewUserDataUseCase(
+	boardRepo repository.BoardRepository,
+	workspaceRepo repository.WorkspaceRepository,
+	markRepo repository.BoardMarkRepository,
+	calendarRepo repository.CalendarRepository,
+	notifyRepo repository.NotificationRepository,
+	quotaRepo repository.QuotaRepository,
+	tx repository.TxManager,
+	quota entity.Quota,
+	log logger.Logger,
+) usecase.UserDataUseCase {
+	return &userDataUseCase{
+		boardRepo:     boardRepo,
+		workspaceRepo: workspaceRepo,
+		markRepo:      markRepo,
+		calendarRepo:  calendarRepo,
+		notifyRepo:    notifyRepo,
+		quotaRepo:     quotaRepo,
+		tx:            tx,
+		quota:         quota,
+		log:           log,
+	}
+}
+
+func (uc *userDataUseCase) TransferBoard(ctx context.Context, boardID, toUserID uuid.UUID) (*entity.Board, error) {
+	header := "TransferBoard: "
+
+	uc.log.Info(ctx, header+"Usecase called; Validating transfer", "boardID", boardID, "toUserID", toUserID)
+
+	if toUserID == uuid.Nil {
+		info := "Validation failed"
+		uc.log.Info(ctx, header+info, "err", repository.ErrTransferNoUserID.Error())
+		return nil, fmt.Errorf(header+info+": %w", repository.ErrTransferNoUserID)
+	}
+
+	var board *entity.Board
+
+	err := uc.tx.WithinTx(ctx, func(ctx context.Context) (err error) {
+		uc.log.Info(ctx, header+"Making request to board repo (GetBoardByID)", "boardID", boardID)
+
+		board, err = uc.boardRepo.GetBoardByID(ctx, boardID)
+
+		if err != nil {
+			info := "Board not found"
+			uc.log.Info(ctx, header+info, "boardID", boardID, "err", err.Error())
+			return fmt.Errorf(header+info+": %w", repository.ErrBoardNotFound)
+		}
+
+		if board.UserID == toUserID {
+			info := "User owns the board already"
+			uc.log.Info(ctx, header+info, "boardID", boardID, "userID", toUserID)
+			return fmt.Errorf(header+info+": %w", repository.ErrTransferToSelf)
+		}
+
+		err = checkBoardQuota(ctx, uc.quotaRepo, uc.boardRepo, uc.quota, uc.log, header, toUserID, 1, ErrTransferBoards)
+		if err != nil {
+			return err
+		}
+
+		return uc.transfer(ctx, header, board, toUserID, time.Now())
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	uc.log.Info(ctx, header+"Board successfully transferred")
+
+	return board, nil
+}
+
+func (uc *userDataUseCase) TransferUserBoards(ctx context.Context, fromUserID, toUserID uuid.UUID, boardIDs []uuid.UUID) ([]entity.Board, error) {
+	header := "TransferUserBoards: "
+
+	uc.log.Info(ctx, header+"Usecase called; Validating transfer", "fromUserID", fromUserID, "toUserID", toUserID, "boardIDs", boardIDs)
+
+	err := validateTransfer(fromUserID, toUserID)
+
+	if err != nil {
+		info := "Validation failed"
+		uc.log.Info(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	var boards []entity.Board
+
+	err = uc.tx.WithinTx(ctx, func(ctx context.Context) (err error) {
+		if len(boardIDs) == 0 {
+			boards, err = uc.userBoards(ctx, header, fromUserID, ErrTransferBoards)
+		} else {
+			boards, err = uc.ownBoards(ctx, header, fromUserID, boardIDs)
+		}
+
+		if err != nil {
+			return err
+		}
+
+		err = checkBoardQuota(ctx, uc.quotaRepo, uc.boardRepo, uc.quota, uc.log, header, toUserID, len(boards), ErrTransferBoards)
+		if err != nil {
+			return err
+		}
+
+		at := time.Now()
+		for i := range boards {
+			if err := uc.transfer(ctx, header, &boards[i], toUserID, at); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	uc.log.Info(ctx, header+"Boards successfully transferred", "count", len(boards))
+
+	return boards, nil
+}
+
+func validateTransfer(fromUserID, toUserID uuid.UUID) error {
+	if toUserID == uuid.Nil {
+		return repository.ErrTransferNoUserID
+	}
+
+	if fromUserID == toUserID {
+		return repository.ErrTransferToSelf
+	}
+
+	return nil
+}
+
+// ownBoards returns the boards with the ids, which should all be the user's.
+func (uc *userDataUseCase) ownBoards(ctx context.Context, header string, userID uuid.UUID, boardIDs []uuid.UUID) ([]entity.Board, error) {
+	boards := make([]entity.Board, 0, len(boardIDs))
+
+	for _, id := range boardIDs {
+		uc.log.Info(ctx, header+"Making request to board repo (GetBoardByID)", "boardID", id)
+
+		board, err := uc.boardRepo.GetBoardByID(ctx, id)
+
+		if err != nil {
+			info := "Board not found"
+			uc.log.Info(ctx, header+info, "boardID", id, "err", err.Error())
+			return nil, fmt.Errorf(header+info+": %w", repository.ErrBoardNotFound)
+		}
+
+		if board.UserID != userID {
+			info := "Board is not the user's"
+			uc.log.Info(ctx, header+info, "boardID", id, "userID", userID)
+			return nil, fmt.Errorf(header+info+": %w", repository.ErrBoardAccess)
+		}
+
+		boards = append(boards, *board)
+	}
+
+	return boards, nil
+}
+
+// transfer gives the board to the user. A board of a personal workspace
+// goes to the personal workspace of the user; a board of a shared one stays
+// there, and the user becomes a member who can write to it if they are not
+// one yet.
+func (uc *userDataUseCase) transfer(ctx context.Context, header string, board *entity.Board, toUserID uuid.UUID, at time.Time) error {
+	uc.log.Info(ctx, header+"Making request to workspace repo (GetWorkspaceByID)", "workspaceID", board.WorkspaceID)
+
+	workspace, err := uc.workspaceRepo.GetWorkspaceByID(ctx, board.WorkspaceID)
+
+	if err != nil {
+		info := "Failed to get workspace"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return fmt.Errorf(header+info+": %w", ErrTransferBoards)
+	}
+
+	if workspace.Personal {
+		uc.log.Info(ctx, header+"Making request to workspace repo (EnsurePersonalWorkspace)", "userID", toUserID)
+
+		workspace, err = uc.workspaceRepo.EnsurePersonalWorkspace(ctx, toUserID, at)
+
+		if err != nil {
+			info := "Failed to get personal workspace"
+			uc.log.Error(ctx, header+info, "err", err.Error())
+			return fmt.Errorf(header+info+": %w", ErrTransferBoards)
+		}
+	} else if err := uc.writer(ctx, header, workspace.ID, toUserID, at); err != nil {
+		return err
+	}
+
+	board.UserID = toUserID
+	board.WorkspaceID = workspace.ID
+	board.UpdatedAt = at
+
+	uc.log.Info(ctx, header+"Making request to board repo (TransferBoard)", "board", board)
+
+	err = uc.boardRepo.TransferBoard(ctx, board)
+
+	if errors.Is(err, repository.ErrVersionMismatch) {
+		info := "Board changed meanwhile"
+		uc.log.Info(ctx, header+info, "boardID", board.ID)
+		return fmt.Errorf(header+info+": %w", err)
+	}
+
+	if err != nil {
+		info := "Failed to transfer board"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return fmt.Errorf(header+info+": %w", ErrTransferBoards)
+	}
+
+	return nil
+}
+
+// writer makes the user a member of the workspace who can change its
+// boards, keeping the role of one who can already.
+func (uc *userDataUseCase) writer(ctx context.Context, header string, workspaceID, userID uuid.UUID, at time.Time) error {
+	member := &entity.WorkspaceMember{WorkspaceID: workspaceID, UserID: userID, Role: entity.RoleMember, CreatedAt: at}
+
+	uc.log.Info(ctx, header+"Making request to workspace repo (GetWorkspaceMember)", "workspaceID", workspaceID, "userID", userID)
+
+	current, err := uc.workspaceRepo.GetWorkspaceMember(ctx, workspaceID, userID)
+
+	switch {
+	case errors.Is(err, repository.ErrWorkspaceMemberNotFound):
+		uc.log.Info(ctx, header+"Making request to workspace repo (AddWorkspaceMember)", "member", member)
+		err = uc.workspaceRepo.AddWorkspaceMember(ctx, member)
+	case err == nil && !current.CanWrite():
+		uc.log.Info(ctx, header+"Making request to workspace repo (UpdateWorkspaceMember)", "member", member)
+		err = uc.workspaceRepo.UpdateWorkspaceMember(ctx, member)
+	}
+
+	if err != nil {
+		info := "Failed to make the user a member"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return fmt.Errorf(header+info+": %w", ErrTransferBoards)
+	}
+
+	return nil
+}
+
+func (uc *userDataUseCase) DeleteUserData(ctx context.Context, userID uuid.UUID) error {
+	header := "DeleteUserData: "
+
+	uc.log.Info(ctx, header+"Usecase called", "userID", userID)
+
+	err := uc.tx.WithinTx(ctx, func(ctx context.Context) error {
+		boards, err := uc.userBoards(ctx, header, userID, ErrDeleteUserData)
+		if err != nil {
+			return err
+		}
+
+		for _, board := range boards {
+			uc.log.Info(ctx, header+"Making request to board repo (DeleteBoard)", "boardID", board.ID)
+
+			if err := uc.boardRepo.DeleteBoard(ctx, board.ID, board.Version); err != nil {
+				info := "Failed to delete board"
+				uc.log.Error(ctx, header+info, "err", err.Error())
+				return fmt.Errorf(header+info+": %w", ErrDeleteUserData)
+			}
+		}
+
+		if err := uc.leaveWorkspaces(ctx, header, userID); err != nil {
+			return err
+		}
+
+		uc.log.Info(ctx, header+"Making request to board mark repo (DeleteUserMarks)", "userID", userID)
+
+		if err := uc.markRepo.DeleteUserMarks(ctx, userID); err != nil {
+			info := "Failed to delete board marks"
+			uc.log.Error(ctx, header+info, "err", err.Error())
+			return fmt.Errorf(header+info+": %w", ErrDeleteUserData)
+		}
+
+		uc.log.Info(ctx, header+"Making request to calendar repo (DeleteCalendarToken)", "userID", userID)
+
+		if err := uc.calendarRepo.DeleteCalendarToken(ctx, userID); err != nil {
+			info := "Failed to delete calendar token"
+			uc.log.Error(ctx, header+info, "err", err.Error())
+			return fmt.Errorf(header+info+": %w", ErrDeleteUserData)
+		}
+
+		uc.log.Info(ctx, header+"Making request to notification repo (DeleteUserNotifications)", "userID", userID)
+
+		if err := uc.notifyRepo.DeleteUserNotifications(ctx, userID); err != nil {
+			info := "Failed to delete notifications"
+			uc.log.Error(ctx, header+info, "err", err.Error())
+			return fmt.Errorf(header+info+": %w", ErrDeleteUserData)
+		}
+
+		uc.log.Info(ctx, header+"Making request to quota repo (DeleteQuotaOverride)", "userID", userID)
+
+		err = uc.quotaRepo.DeleteQuotaOverride(ctx, userID)
+		if err != nil && !errors.Is(err, repository.ErrQuotaOverrideNotFound) {
+			info := "Failed to delete quota override"
+			uc.log.Error(ctx, header+info, "err", err.Error())
+			return fmt.Errorf(header+info+": %w", ErrDeleteUserData)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return err
+	}
+
+	uc.log.Info(ctx, header+"User data successfully deleted")
+
+	return nil
+}
+
+// leaveWorkspaces takes the user out of their workspaces. Their personal
+// workspace and the shared ones with no other member are deleted; the
+// earliest member to join takes over a workspace the user was the last
+// admin of.
+func (uc *userDataUseCase) leaveWorkspaces(ctx context.Context, header string, userID uuid.UUID) error {
+	uc.log.Info(ctx, header+"Making request to workspace repo (GetWorkspacesByUser)", "userID", userID)
+
+	workspaces, err := uc.workspaceRepo.GetWorkspacesByUser(ctx, userID)
+
+	if err != nil {
+		info := "Failed to get workspaces"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return fmt.Errorf(header+info+": %w", ErrDeleteUserData)
+	}
+
+	for _, workspace := range workspaces {
+		uc.log.Info(ctx, header+"Making request to workspace repo (GetWorkspaceMembers)", "workspaceID", workspace.ID)
+
+		members, err := uc.workspaceRepo.GetWorkspaceMembers(ctx, workspace.ID)
+
+		if err != nil {
+			info := "Failed to get members"
+			uc.log.Error(ctx, header+info, "err", err.Error())
+			return fmt.Errorf(header+info+": %w", ErrDeleteUserData)
+		}
+
+		var others []entity.WorkspaceMember
+		admins := 0
+		for _, m := range members {
+			if m.UserID != userID {
+				others = append(others, m)
+				if m.Role == entity.RoleAdmin {
+					admins++
+				}
+			}
+		}
+
+		switch {
+		case workspace.Personal || len(others) == 0:
+			uc.log.Info(ctx, header+"Making request to workspace repo (DeleteWorkspace)", "workspaceID", workspace.ID)
+			err = uc.workspaceRepo.DeleteWorkspace(ctx, workspace.ID)
+		case admins == 0:
+			heir := others[0]
+			heir.Role = entity.RoleAdmin
+
+			uc.log.Info(ctx, header+"Making request to workspace repo (UpdateWorkspaceMember)", "member", heir)
+
+			if err = uc.workspaceRepo.UpdateWorkspaceMember(ctx, &heir); err == nil {
+				err = uc.workspaceRepo.RemoveWorkspaceMember(ctx, workspace.ID, userID)
+			}
+		default:
+			uc.log.Info(ctx, header+"Making request to workspace repo (RemoveWorkspaceMember)", "workspaceID", workspace.ID)
+			err = uc.workspaceRepo.RemoveWorkspaceMember(ctx, workspace.ID, userID)
+		}
+
+		if err != nil {
+			info := "Failed to leave workspace"
+			uc.log.Error(ctx, header+info, "workspaceID", workspace.ID, "err", err.Error())
+			return fmt.Errorf(header+info+": %w", ErrDeleteUserData)
+		}
+	}
+
+	return nil
+}
+
+// userBoards lists every board of the user, page by page.
+func (uc *userDataUseCase) userBoards(ctx context.Context, header string, userID uuid.UUID, failed error) ([]entity.Board, error) {
+	var boards []entity.Board
+	page := repository.Page{Limit: userBoardsLimit}
+
+	for {
+		uc.log.Info(ctx, header+"Making request to board repo (GetBoardsByUser)", "userID", userID, "page", page)
+
+		batch, err := uc.boardRepo.GetBoardsByUser(ctx, userID, page)
+
+		if err != nil {
+			info := "Failed to get boards"
+			uc.log.Error(ctx, header+info, "err", err.Error())
+			return nil, fmt.Errorf(header+info+": %w", failed)
+		}
+
+		boards = append(boards, batch...)
+
+		if len(batch) < page.Limit {
+			return boards, nil
+		}
+
+		last := batch[len(batch)-1]
+		page.After = repository.TimeCursor(last.CreatedAt, last.ID)
+	}
+}
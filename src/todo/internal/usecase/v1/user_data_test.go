@@ -0,0 +1,297 @@
+package v1_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	log "todo/internal/adapter/logger"
+	"todo/internal/adapter/repository/memory"
+	"todo/internal/entity"
+	"todo/internal/repository"
+	"todo/internal/testdata"
+	v1 "todo/internal/usecase/v1"
+	"todo/mocks"
+
+	"github.com/google/uuid"
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/runner"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestTransferUserBoards(t *testing.T) {
+	runner.Run(t, "TestTransferUserBoards", func(pt provider.T) {
+		mom := &testdata.ObjectMother{}
+
+		fromID := mom.GetUUID(0)
+		toID := mom.GetUUID(1)
+		personalID := mom.GetUUID(2)
+		targetID := mom.GetUUID(3)
+		teamID := mom.GetUUID(4)
+
+		personal := &entity.Workspace{ID: personalID, Personal: true, CreatedBy: fromID}
+		target := &entity.Workspace{ID: targetID, Personal: true, CreatedBy: toID}
+		team := &entity.Workspace{ID: teamID, Name: "Team"}
+
+		privateBoard := entity.Board{ID: mom.GetUUID(5), UserID: fromID, WorkspaceID: personalID, Version: 1}
+		teamBoard := entity.Board{ID: mom.GetUUID(6), UserID: fromID, WorkspaceID: teamID, Version: 1}
+		otherBoard := entity.Board{ID: mom.GetUUID(7), UserID: toID, WorkspaceID: targetID, Version: 1}
+
+		tests := []struct {
+			name      string
+			toID      uuid.UUID
+			boardIDs  []uuid.UUID
+			mockSetup func(mockBoardRepo *mocks.BoardRepository, mockWorkspaceRepo *mocks.WorkspaceRepository)
+			want      []uuid.UUID
+			wantErr   bool
+			err       error
+		}{
+			{
+				name: "all boards",
+				toID: toID,
+				mockSetup: func(mockBoardRepo *mocks.BoardRepository, mockWorkspaceRepo *mocks.WorkspaceRepository) {
+					mockBoardRepo.On("GetBoardsByUser", mock.Anything, fromID, mock.Anything).
+						Return([]entity.Board{privateBoard, teamBoard}, nil)
+					mockBoardRepo.On("GetBoardsByUser", mock.Anything, toID, repository.Page{Limit: 3}).Return([]entity.Board{otherBoard}, nil)
+					mockWorkspaceRepo.On("GetWorkspaceByID", mock.Anything, personalID).Return(personal, nil)
+					mockWorkspaceRepo.On("EnsurePersonalWorkspace", mock.Anything, toID, mock.Anything).Return(target, nil)
+					mockWorkspaceRepo.On("GetWorkspaceByID", mock.Anything, teamID).Return(team, nil)
+					mockWorkspaceRepo.On("GetWorkspaceMember", mock.Anything, teamID, toID).
+						Return(nil, repository.ErrWorkspaceMemberNotFound)
+					mockWorkspaceRepo.On("AddWorkspaceMember", mock.Anything, mock.MatchedBy(func(m *entity.WorkspaceMember) bool {
+						return m.WorkspaceID == teamID && m.UserID == toID && m.Role == entity.RoleMember
+					})).Return(nil)
+					mockBoardRepo.On("TransferBoard", mock.Anything, mock.MatchedBy(func(b *entity.Board) bool {
+						return b.ID == privateBoard.ID && b.UserID == toID && b.WorkspaceID == targetID
+					})).Return(nil)
+					mockBoardRepo.On("TransferBoard", mock.Anything, mock.MatchedBy(func(b *entity.Board) bool {
+						return b.ID == teamBoard.ID && b.UserID == toID && b.WorkspaceID == teamID
+					})).Return(nil)
+				},
+				want:    []uuid.UUID{privateBoard.ID, teamBoard.ID},
+				wantErr: false,
+			},
+			{
+				name:     "viewer becomes member",
+				toID:     toID,
+				boardIDs: []uuid.UUID{teamBoard.ID},
+				mockSetup: func(mockBoardRepo *mocks.BoardRepository, mockWorkspaceRepo *mocks.WorkspaceRepository) {
+					board := teamBoard
+					mockBoardRepo.On("GetBoardByID", mock.Anything, teamBoard.ID).Return(&board, nil)
+					mockBoardRepo.On("GetBoardsByUser", mock.Anything, toID, repository.Page{Limit: 3}).Return([]entity.Board{otherBoard}, nil)
+					mockWorkspaceRepo.On("GetWorkspaceByID", mock.Anything, teamID).Return(team, nil)
+					mockWorkspaceRepo.On("GetWorkspaceMember", mock.Anything, teamID, toID).
+						Return(&entity.WorkspaceMember{WorkspaceID: teamID, UserID: toID, Role: entity.RoleViewer}, nil)
+					mockWorkspaceRepo.On("UpdateWorkspaceMember", mock.Anything, mock.MatchedBy(func(m *entity.WorkspaceMember) bool {
+						return m.UserID == toID && m.Role == entity.RoleMember
+					})).Return(nil)
+					mockBoardRepo.On("TransferBoard", mock.Anything, mock.Anything).Return(nil)
+				},
+				want:    []uuid.UUID{teamBoard.ID},
+				wantErr: false,
+			},
+			{
+				name:     "board of someone else",
+				toID:     toID,
+				boardIDs: []uuid.UUID{otherBoard.ID},
+				mockSetup: func(mockBoardRepo *mocks.BoardRepository, mockWorkspaceRepo *mocks.WorkspaceRepository) {
+					board := otherBoard
+					mockBoardRepo.On("GetBoardByID", mock.Anything, otherBoard.ID).Return(&board, nil)
+				},
+				wantErr: true,
+				err:     repository.ErrBoardAccess,
+			},
+			{
+				name:      "to the same user",
+				toID:      fromID,
+				mockSetup: func(mockBoardRepo *mocks.BoardRepository, mockWorkspaceRepo *mocks.WorkspaceRepository) {},
+				wantErr:   true,
+				err:       repository.ErrTransferToSelf,
+			},
+			{
+				name: "recipient at quota",
+				toID: toID,
+				mockSetup: func(mockBoardRepo *mocks.BoardRepository, mockWorkspaceRepo *mocks.WorkspaceRepository) {
+					mockBoardRepo.On("GetBoardsByUser", mock.Anything, fromID, mock.Anything).
+						Return([]entity.Board{privateBoard, teamBoard}, nil)
+					mockBoardRepo.On("GetBoardsByUser", mock.Anything, toID, repository.Page{Limit: 3}).
+						Return([]entity.Board{otherBoard, otherBoard}, nil)
+				},
+				wantErr: true,
+				err:     repository.ErrQuotaExceeded,
+			},
+			{
+				name: "negative",
+				toID: toID,
+				mockSetup: func(mockBoardRepo *mocks.BoardRepository, mockWorkspaceRepo *mocks.WorkspaceRepository) {
+					mockBoardRepo.On("GetBoardsByUser", mock.Anything, fromID, mock.Anything).
+						Return([]entity.Board{privateBoard}, nil)
+					mockBoardRepo.On("GetBoardsByUser", mock.Anything, toID, repository.Page{Limit: 3}).Return([]entity.Board{otherBoard}, nil)
+					mockWorkspaceRepo.On("GetWorkspaceByID", mock.Anything, personalID).Return(personal, nil)
+					mockWorkspaceRepo.On("EnsurePersonalWorkspace", mock.Anything, toID, mock.Anything).Return(target, nil)
+					mockBoardRepo.On("TransferBoard", mock.Anything, mock.Anything).Return(errors.New(""))
+				},
+				wantErr: true,
+				err:     v1.ErrTransferBoards,
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				t.Parallel()
+
+				runner.Run(t, tt.name, func(pt provider.T) {
+					mockBoardRepo := new(mocks.BoardRepository)
+					mockWorkspaceRepo := new(mocks.WorkspaceRepository)
+					mockQuotaRepo := new(mocks.QuotaRepository)
+					logger := log.NewEmptyLogger()
+
+					mockQuotaRepo.On("LockQuota", mock.Anything, tt.toID).Return(nil).Maybe()
+					mockQuotaRepo.On("GetQuotaOverride", mock.Anything, tt.toID).Return(nil, repository.ErrQuotaOverrideNotFound).Maybe()
+
+					uc := v1.NewUserDataUseCase(mockBoardRepo, mockWorkspaceRepo, new(mocks.BoardMarkRepository), new(mocks.CalendarRepository),
+						new(mocks.NotificationRepository), mockQuotaRepo, memory.NewTxManager(), entity.Quota{BoardsPerUser: 3}, logger)
+
+					tt.mockSetup(mockBoardRepo, mockWorkspaceRepo)
+
+					pt.WithNewStep("Call TransferUserBoards", func(sCtx provider.StepCtx) {
+						boards, err := uc.TransferUserBoards(context.Background(), fromID, tt.toID, tt.boardIDs)
+
+						if tt.wantErr {
+							sCtx.Assert().Error(err, "Expected error")
+							sCtx.Assert().ErrorIs(err, tt.err)
+						} else {
+							sCtx.Assert().NoError(err, "Expected no error")
+
+							ids := make([]uuid.UUID, len(boards))
+							for i, b := range boards {
+								ids[i] = b.ID
+							}
+							sCtx.Assert().Equal(tt.want, ids)
+						}
+
+						mockBoardRepo.AssertExpectations(t)
+						mockWorkspaceRepo.AssertExpectations(t)
+					})
+				})
+			})
+		}
+	})
+}
+
+func TestDeleteUserData(t *testing.T) {
+	runner.Run(t, "TestDeleteUserData", func(pt provider.T) {
+		mom := &testdata.ObjectMother{}
+
+		userID := mom.GetUUID(0)
+		heirID := mom.GetUUID(1)
+		personalID := mom.GetUUID(2)
+		teamID := mom.GetUUID(3)
+		soloID := mom.GetUUID(4)
+		sharedID := mom.GetUUID(5)
+
+		board := entity.Board{ID: mom.GetUUID(6), UserID: userID, WorkspaceID: teamID, Version: 3}
+
+		workspaces := []entity.WorkspaceMembership{
+			{Workspace: entity.Workspace{ID: personalID, Personal: true, CreatedBy: userID}, Role: entity.RoleAdmin},
+			{Workspace: entity.Workspace{ID: teamID, Name: "Team"}, Role: entity.RoleAdmin},
+			{Workspace: entity.Workspace{ID: soloID, Name: "Solo"}, Role: entity.RoleAdmin},
+			{Workspace: entity.Workspace{ID: sharedID, Name: "Shared"}, Role: entity.RoleMember},
+		}
+
+		tests := []struct {
+			name      string
+			mockSetup func(mockBoardRepo *mocks.BoardRepository, mockWorkspaceRepo *mocks.WorkspaceRepository, mockMarkRepo *mocks.BoardMarkRepository, mockCalendarRepo *mocks.CalendarRepository, mockNotifyRepo *mocks.NotificationRepository, mockQuotaRepo *mocks.QuotaRepository)
+			wantErr   bool
+			err       error
+		}{
+			{
+				name: "positive",
+				mockSetup: func(mockBoardRepo *mocks.BoardRepository, mockWorkspaceRepo *mocks.WorkspaceRepository, mockMarkRepo *mocks.BoardMarkRepository, mockCalendarRepo *mocks.CalendarRepository, mockNotifyRepo *mocks.NotificationRepository, mockQuotaRepo *mocks.QuotaRepository) {
+					mockBoardRepo.On("GetBoardsByUser", mock.Anything, userID, mock.Anything).Return([]entity.Board{board}, nil)
+					mockBoardRepo.On("DeleteBoard", mock.Anything, board.ID, board.Version).Return(nil)
+					mockWorkspaceRepo.On("GetWorkspacesByUser", mock.Anything, userID).Return(workspaces, nil)
+
+					mockWorkspaceRepo.On("GetWorkspaceMembers", mock.Anything, personalID).Return([]entity.WorkspaceMember{
+						{WorkspaceID: personalID, UserID: userID, Role: entity.RoleAdmin},
+					}, nil)
+					mockWorkspaceRepo.On("DeleteWorkspace", mock.Anything, personalID).Return(nil)
+
+					mockWorkspaceRepo.On("GetWorkspaceMembers", mock.Anything, teamID).Return([]entity.WorkspaceMember{
+						{WorkspaceID: teamID, UserID: userID, Role: entity.RoleAdmin},
+						{WorkspaceID: teamID, UserID: heirID, Role: entity.RoleViewer},
+						{WorkspaceID: teamID, UserID: mom.GetUUID(7), Role: entity.RoleMember},
+					}, nil)
+					mockWorkspaceRepo.On("UpdateWorkspaceMember", mock.Anything, mock.MatchedBy(func(m *entity.WorkspaceMember) bool {
+						return m.WorkspaceID == teamID && m.UserID == heirID && m.Role == entity.RoleAdmin
+					})).Return(nil)
+					mockWorkspaceRepo.On("RemoveWorkspaceMember", mock.Anything, teamID, userID).Return(nil)
+
+					mockWorkspaceRepo.On("GetWorkspaceMembers", mock.Anything, soloID).Return([]entity.WorkspaceMember{
+						{WorkspaceID: soloID, UserID: userID, Role: entity.RoleAdmin},
+					}, nil)
+					mockWorkspaceRepo.On("DeleteWorkspace", mock.Anything, soloID).Return(nil)
+
+					mockWorkspaceRepo.On("GetWorkspaceMembers", mock.Anything, sharedID).Return([]entity.WorkspaceMember{
+						{WorkspaceID: sharedID, UserID: heirID, Role: entity.RoleAdmin},
+						{WorkspaceID: sharedID, UserID: userID, Role: entity.RoleMember},
+					}, nil)
+					mockWorkspaceRepo.On("RemoveWorkspaceMember", mock.Anything, sharedID, userID).Return(nil)
+
+					mockMarkRepo.On("DeleteUserMarks", mock.Anything, userID).Return(nil)
+					mockCalendarRepo.On("DeleteCalendarToken", mock.Anything, userID).Return(nil)
+					mockNotifyRepo.On("DeleteUserNotifications", mock.Anything, userID).Return(nil)
+					mockQuotaRepo.On("DeleteQuotaOverride", mock.Anything, userID).Return(repository.ErrQuotaOverrideNotFound)
+				},
+				wantErr: false,
+			},
+			{
+				name: "negative",
+				mockSetup: func(mockBoardRepo *mocks.BoardRepository, mockWorkspaceRepo *mocks.WorkspaceRepository, mockMarkRepo *mocks.BoardMarkRepository, mockCalendarRepo *mocks.CalendarRepository, mockNotifyRepo *mocks.NotificationRepository, mockQuotaRepo *mocks.QuotaRepository) {
+					mockBoardRepo.On("GetBoardsByUser", mock.Anything, userID, mock.Anything).Return([]entity.Board{board}, nil)
+					mockBoardRepo.On("DeleteBoard", mock.Anything, board.ID, board.Version).Return(errors.New(""))
+				},
+				wantErr: true,
+				err:     v1.ErrDeleteUserData,
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				t.Parallel()
+
+				runner.Run(t, tt.name, func(pt provider.T) {
+					mockBoardRepo := new(mocks.BoardRepository)
+					mockWorkspaceRepo := new(mocks.WorkspaceRepository)
+					mockMarkRepo := new(mocks.BoardMarkRepository)
+					mockCalendarRepo := new(mocks.CalendarRepository)
+					mockNotifyRepo := new(mocks.NotificationRepository)
+					mockQuotaRepo := new(mocks.QuotaRepository)
+					logger := log.NewEmptyLogger()
+
+					uc := v1.NewUserDataUseCase(mockBoardRepo, mockWorkspaceRepo, mockMarkRepo, mockCalendarRepo,
+						mockNotifyRepo, mockQuotaRepo, memory.NewTxManager(), entity.Quota{}, logger)
+
+					tt.mockSetup(mockBoardRepo, mockWorkspaceRepo, mockMarkRepo, mockCalendarRepo, mockNotifyRepo, mockQuotaRepo)
+
+					pt.WithNewStep("Call DeleteUserData", func(sCtx provider.StepCtx) {
+						err := uc.DeleteUserData(context.Background(), userID)
+
+						if tt.wantErr {
+							sCtx.Assert().Error(err, "Expected error")
+							sCtx.Assert().ErrorIs(err, tt.err)
+						} else {
+							sCtx.Assert().NoError(err, "Expected no error")
+						}
+
+						mockBoardRepo.AssertExpectations(t)
+						mockWorkspaceRepo.AssertExpectations(t)
+						mockMarkRepo.AssertExpectations(t)
+						mockCalendarRepo.AssertExpectations(t)
+						mockNotifyRepo.AssertExpectations(t)
+						mockQuotaRepo.AssertExpectations(t)
+					})
+				})
+			})
+		}
+	})
+}
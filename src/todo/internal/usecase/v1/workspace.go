@@ -0,0 +1,495 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+	"todo/internal/common/logger"
+	"todo/internal/entity"
+	"todo/internal/repository"
+	"todo/internal/usecase"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrCreateWorkspace          = errors.New("failed to create workspace")
+	ErrGetWorkspaces            = errors.New("failed to get workspaces")
+	ErrGetWorkspaceMembers      = errors.New("failed to get workspace members")
+	ErrAddWorkspaceMember       = errors.New("failed to add workspace member")
+	ErrUpdateWorkspaceMember    = errors.New("failed to update workspace member")
+	ErrRemoveWorkspaceMember    = errors.New("failed to remove workspace member")
+	ErrGetWorkspaceBoards       = errors.New("failed to get workspace boards")
+	ErrCheckBoardAccess         = errors.New("failed to check board access")
+	ErrCheckWorkspaceMembership = errors.New("failed to check workspace membership")
+)
+
+type workspaceUseCase struct {
+	workspaceRepo repository.WorkspaceRepository
+	tx            repository.TxManager
+	log           logger.Logger
+}
+
+func NewWorkspaceUseCase(
+	workspaceRepo repository.WorkspaceRepository,
+	tx repository.TxManager,
+	log logger.Logger,
+) usecase.WorkspaceUseCase {
+	return &workspaceUseCase{
+		workspaceRepo: workspaceRepo,
+		tx:            tx,
+		log:           log,
+	}
+}
+
+func (uc *workspaceUseCase) CreateWorkspace(ctx context.Context, workspace *entity.Workspace) error {
+	header := "CreateWorkspace: "
+
+	uc.log.Info(ctx, header+"Usecase called; Validating workspace", "workspace", workspace)
+
+	err := validateWorkspace(workspace)
+
+	if err != nil {
+		info := "Validation failed"
+		uc.log.Info(ctx, header+info, "err", err.Error())
+		return fmt.Errorf(header+info+": %w", err)
+	}
+
+	workspace.ID = uuid.New()
+	workspace.Personal = false
+	workspace.CreatedAt = time.Now()
+
+	admin := &entity.WorkspaceMember{
+		WorkspaceID: workspace.ID,
+		UserID:      workspace.CreatedBy,
+		Role:        entity.RoleAdmin,
+		CreatedAt:   workspace.CreatedAt,
+	}
+
+	uc.log.Info(ctx, header+"Making request to workspace repo (CreateWorkspace)", "workspace", workspace)
+
+	err = uc.workspaceRepo.CreateWorkspace(ctx, workspace, admin)
+
+	if err != nil {
+		info := "Failed to create workspace"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return fmt.Errorf(header+info+": %w", ErrCreateWorkspace)
+	}
+
+	uc.log.Info(ctx, header+"Workspace successfully created")
+
+	return nil
+}
+
+func validateWorkspace(workspace *entity.Workspace) error {
+	if workspace.Name == "" {
+		return repository.ErrWorkspaceNoName
+	}
+
+	if workspace.CreatedBy == uuid.Nil {
+		return repository.ErrWorkspaceNoCreator
+	}
+
+	return nil
+}
+
+func (uc *workspaceUseCase) GetWorkspacesByUser(ctx context.Context, userID uuid.UUID) ([]entity.WorkspaceMembership, error) {
+	header := "GetWorkspacesByUser: "
+
+	uc.log.Info(ctx, header+"Usecase called; Making request to workspace repo (GetWorkspacesByUser)", "userID", userID)
+
+	workspaces, err := uc.workspaceRepo.GetWorkspacesByUser(ctx, userID)
+
+	if err != nil {
+		info := "Failed to get workspaces"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", ErrGetWorkspaces)
+	}
+
+	uc.log.Info(ctx, header+"Got workspaces", "count", len(workspaces))
+
+	return workspaces, nil
+}
+
+func (uc *workspaceUseCase) GetWorkspaceMembers(ctx context.Context, actorID, workspaceID uuid.UUID) ([]entity.WorkspaceMember, error) {
+	header := "GetWorkspaceMembers: "
+
+	uc.log.Info(ctx, header+"Usecase called; Checking membership", "actorID", actorID, "workspaceID", workspaceID)
+
+	if _, _, err := uc.actor(ctx, header, actorID, workspaceID, false); err != nil {
+		return nil, err
+	}
+
+	uc.log.Info(ctx, header+"Making request to workspace repo (GetWorkspaceMembers)", "workspaceID", workspaceID)
+
+	members, err := uc.workspaceRepo.GetWorkspaceMembers(ctx, workspaceID)
+
+	if err != nil {
+		info := "Failed to get members"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", ErrGetWorkspaceMembers)
+	}
+
+	uc.log.Info(ctx, header+"Got members", "count", len(members))
+
+	return members, nil
+}
+
+func (uc *workspaceUseCase) AddWorkspaceMember(ctx context.Context, actorID uuid.UUID, member *entity.WorkspaceMember) error {
+	header := "AddWorkspaceMember: "
+
+	uc.log.Info(ctx, header+"Usecase called; Validating member", "actorID", actorID, "member", member)
+
+	err := validateWorkspaceMember(member)
+
+	if err != nil {
+		info := "Validation failed"
+		uc.log.Info(ctx, header+info, "err", err.Error())
+		return fmt.Errorf(header+info+": %w", err)
+	}
+
+	workspace, _, err := uc.actor(ctx, header, actorID, member.WorkspaceID, true)
+	if err != nil {
+		return err
+	}
+
+	if workspace.Personal {
+		info := "Workspace is personal"
+		uc.log.Info(ctx, header+info, "workspaceID", workspace.ID)
+		return fmt.Errorf(header+info+": %w", repository.ErrWorkspacePersonal)
+	}
+
+	member.CreatedAt = time.Now()
+
+	uc.log.Info(ctx, header+"Making request to workspace repo (AddWorkspaceMember)", "member", member)
+
+	err = uc.workspaceRepo.AddWorkspaceMember(ctx, member)
+
+	if errors.Is(err, repository.ErrWorkspaceMemberExists) {
+		info := "User is a member already"
+		uc.log.Info(ctx, header+info, "userID", member.UserID)
+		return fmt.Errorf(header+info+": %w", err)
+	}
+
+	if err != nil {
+		info := "Failed to add member"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return fmt.Errorf(header+info+": %w", ErrAddWorkspaceMember)
+	}
+
+	uc.log.Info(ctx, header+"Member successfully added")
+
+	return nil
+}
+
+func (uc *workspaceUseCase) UpdateWorkspaceMember(ctx context.Context, actorID uuid.UUID, member *entity.WorkspaceMember) error {
+	header := "UpdateWorkspaceMember: "
+
+	uc.log.Info(ctx, header+"Usecase called; Validating member", "actorID", actorID, "member", member)
+
+	err := validateWorkspaceMember(member)
+
+	if err != nil {
+		info := "Validation failed"
+		uc.log.Info(ctx, header+info, "err", err.Error())
+		return fmt.Errorf(header+info+": %w", err)
+	}
+
+	err = uc.tx.WithinTx(ctx, func(ctx context.Context) error {
+		if _, _, err := uc.actor(ctx, header, actorID, member.WorkspaceID, true); err != nil {
+			return err
+		}
+
+		if member.Role != entity.RoleAdmin {
+			if err := uc.keepAdmin(ctx, header, member.WorkspaceID, member.UserID, ErrUpdateWorkspaceMember); err != nil {
+				return err
+			}
+		}
+
+		uc.log.Info(ctx, header+"Making request to workspace repo (UpdateWorkspaceMember)", "member", member)
+
+		err := uc.workspaceRepo.UpdateWorkspaceMember(ctx, member)
+
+		if errors.Is(err, repository.ErrWorkspaceMemberNotFound) {
+			info := "User is not a member"
+			uc.log.Info(ctx, header+info, "userID", member.UserID)
+			return fmt.Errorf(header+info+": %w", err)
+		}
+
+		if err != nil {
+			info := "Failed to update member"
+			uc.log.Error(ctx, header+info, "err", err.Error())
+			return fmt.Errorf(header+info+": %w", ErrUpdateWorkspaceMember)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return err
+	}
+
+	uc.log.Info(ctx, header+"Member successfully updated")
+
+	return nil
+}
+
+func (uc *workspaceUseCase) RemoveWorkspaceMember(ctx context.Context, actorID, workspaceID, userID uuid.UUID) error {
+	header := "RemoveWorkspaceMember: "
+
+	uc.log.Info(ctx, header+"Usecase called; Checking membership", "actorID", actorID, "workspaceID", workspaceID, "userID", userID)
+
+	err := uc.tx.WithinTx(ctx, func(ctx context.Context) error {
+		if _, _, err := uc.actor(ctx, header, actorID, workspaceID, actorID != userID); err != nil {
+			return err
+		}
+
+		if err := uc.keepAdmin(ctx, header, workspaceID, userID, ErrRemoveWorkspaceMember); err != nil {
+			return err
+		}
+
+		uc.log.Info(ctx, header+"Making request to workspace repo (RemoveWorkspaceMember)", "workspaceID", workspaceID, "userID", userID)
+
+		err := uc.workspaceRepo.RemoveWorkspaceMember(ctx, workspaceID, userID)
+
+		if errors.Is(err, repository.ErrWorkspaceMemberNotFound) {
+			info := "User is not a member"
+			uc.log.Info(ctx, header+info, "userID", userID)
+			return fmt.Errorf(header+info+": %w", err)
+		}
+
+		if err != nil {
+			info := "Failed to remove member"
+			uc.log.Error(ctx, header+info, "err", err.Error())
+			return fmt.Errorf(header+info+": %w", ErrRemoveWorkspaceMember)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return err
+	}
+
+	uc.log.Info(ctx, header+"Member successfully removed")
+
+	return nil
+}
+
+func (uc *workspaceUseCase) GetWorkspaceBoards(ctx context.Context, actorID, workspaceID uuid.UUID, page repository.Page) ([]entity.Board, *repository.Cursor, error) {
+	header := "GetWorkspaceBoards: "
+
+	uc.log.Info(ctx, header+"Usecase called; Validating page", "actorID", actorID, "workspaceID", workspaceID, "page", page)
+
+	err := validatePage(page)
+
+	if err != nil {
+		info := "Validation failed"
+		uc.log.Info(ctx, header+info, "err", err.Error())
+		return nil, nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	if _, _, err := uc.actor(ctx, header, actorID, workspaceID, true); err != nil {
+		return nil, nil, err
+	}
+
+	uc.log.Info(ctx, header+"Making request to workspace repo (GetWorkspaceBoards)", "workspaceID", workspaceID, "page", page)
+
+	boards, err := uc.workspaceRepo.GetWorkspaceBoards(ctx, workspaceID, page)
+
+	if err != nil {
+		info := "Failed to get boards"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return nil, nil, fmt.Errorf(header+info+": %w", ErrGetWorkspaceBoards)
+	}
+
+	uc.log.Info(ctx, header+"Got boards", "count", len(boards))
+
+	var next *repository.Cursor
+	if len(boards) == page.Limit {
+		last := boards[len(boards)-1]
+		next = repository.TimeCursor(last.CreatedAt, last.ID)
+	}
+
+	return boards, next, nil
+}
+
+func validateWorkspaceMember(member *entity.WorkspaceMember) error {
+	if member.UserID == uuid.Nil {
+		return repository.ErrWorkspaceMemberNoUserID
+	}
+
+	switch member.Role {
+	case entity.RoleAdmin, entity.RoleMember, entity.RoleViewer:
+		return nil
+	}
+
+	return repository.ErrWorkspaceRole
+}
+
+// actor returns the workspace and the membership of the user acting on it,
+// who should be an admin if admin is set.
+func (uc *workspaceUseCase) actor(ctx context.Context, header string, actorID, workspaceID uuid.UUID, admin bool) (*entity.Workspace, *entity.WorkspaceMember, error) {
+	workspace, err := uc.workspaceRepo.GetWorkspaceByID(ctx, workspaceID)
+
+	if errors.Is(err, repository.ErrWorkspaceNotFound) {
+		info := "Workspace not found"
+		uc.log.Info(ctx, header+info, "workspaceID", workspaceID)
+		return nil, nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	if err != nil {
+		info := "Failed to get workspace"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return nil, nil, fmt.Errorf(header+info+": %w", ErrCheckWorkspaceMembership)
+	}
+
+	member, err := uc.workspaceRepo.GetWorkspaceMember(ctx, workspaceID, actorID)
+
+	if errors.Is(err, repository.ErrWorkspaceMemberNotFound) || err == nil && admin && member.Role != entity.RoleAdmin {
+		info := "User has no access to the workspace"
+		uc.log.Info(ctx, header+info, "workspaceID", workspaceID, "actorID", actorID)
+		return nil, nil, fmt.Errorf(header+info+": %w", repository.ErrWorkspaceAccess)
+	}
+
+	if err != nil {
+		info := "Failed to get membership"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return nil, nil, fmt.Errorf(header+info+": %w", ErrCheckWorkspaceMembership)
+	}
+
+	return workspace, member, nil
+}
+
+// keepAdmin fails with ErrWorkspaceLastAdmin if the user is the only admin
+// of the workspace, so they cannot stop being one.
+func (uc *workspaceUseCase) keepAdmin(ctx context.Context, header string, workspaceID, userID uuid.UUID, failed error) error {
+	members, err := uc.workspaceRepo.GetWorkspaceMembers(ctx, workspaceID)
+
+	if err != nil {
+		info := "Failed to get members"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return fmt.Errorf(header+info+": %w", failed)
+	}
+
+	admins, isAdmin := 0, false
+	for _, m := range members {
+		if m.Role == entity.RoleAdmin {
+			admins++
+			isAdmin = isAdmin || m.UserID == userID
+		}
+	}
+
+	if isAdmin && admins == 1 {
+		info := "User is the last admin"
+		uc.log.Info(ctx, header+info, "workspaceID", workspaceID, "userID", userID)
+		return fmt.Errorf(header+info+": %w", repository.ErrWorkspaceLastAdmin)
+	}
+
+	return nil
+}
+
+// boardWorkspace puts a board without a workspace in the personal workspace
+// of its user, and otherwise checks the user can add boards to its
+// workspace.
+func (uc *todoUseCase) boardWorkspace(ctx context.Context, header string, board *entity.Board) error {
+	if board.WorkspaceID == uuid.Nil {
+		uc.log.Info(ctx, header+"Making request to workspace repo (EnsurePersonalWorkspace)", "userID", board.UserID)
+
+		workspace, err := uc.workspaceRepo.EnsurePersonalWorkspace(ctx, board.UserID, board.CreatedAt)
+
+		if err != nil {
+			info := "Failed to get personal workspace"
+			uc.log.Error(ctx, header+info, "err", err.Error())
+			return fmt.Errorf(header+info+": %w", ErrCreateBoard)
+		}
+
+		board.WorkspaceID = workspace.ID
+
+		return nil
+	}
+
+	uc.log.Info(ctx, header+"Making request to workspace repo (GetWorkspaceMember)", "workspaceID", board.WorkspaceID, "userID", board.UserID)
+
+	member, err := uc.workspaceRepo.GetWorkspaceMember(ctx, board.WorkspaceID, board.UserID)
+
+	if errors.Is(err, repository.ErrWorkspaceMemberNotFound) || err == nil && !member.CanWrite() {
+		info := "User cannot add boards to the workspace"
+		uc.log.Info(ctx, header+info, "workspaceID", board.WorkspaceID, "userID", board.UserID)
+		return fmt.Errorf(header+info+": %w", repository.ErrWorkspaceAccess)
+	}
+
+	if err != nil {
+		info := "Failed to get membership"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return fmt.Errorf(header+info+": %w", ErrCreateBoard)
+	}
+
+	return nil
+}
+
+// userBoard returns the board if the user can change it.
+func (uc *todoUseCase) userBoard(ctx context.Context, header string, userID, boardID uuid.UUID) (*entity.Board, error) {
+	return boardAccess(ctx, uc.boardRepo, uc.workspaceRepo, uc.log, header, userID, boardID, true)
+}
+
+// boardAccess returns the board if the user is a member of its workspace,
+// logging why not otherwise. With write set, viewers are turned away too.
+func boardAccess(
+	ctx context.Context,
+	boardRepo repository.BoardRepository,
+	workspaceRepo repository.WorkspaceRepository,
+	log logger.Logger,
+	header string,
+	userID, boardID uuid.UUID,
+	write bool,
+) (*entity.Board, error) {
+	board, err := boardRepo.GetBoardByID(ctx, boardID)
+
+	if err != nil {
+		info := "Board not found"
+		log.Info(ctx, header+info, "boardID", boardID, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", repository.ErrBoardNotFound)
+	}
+
+	member, err := workspaceRepo.GetWorkspaceMember(ctx, board.WorkspaceID, userID)
+
+	if errors.Is(err, repository.ErrWorkspaceMemberNotFound) || err == nil && write && !member.CanWrite() {
+		info := "User has no access to the board"
+		log.Info(ctx, header+info, "boardID", boardID, "userID", userID)
+		return nil, fmt.Errorf(header+info+": %w", repository.ErrBoardAccess)
+	}
+
+	if err != nil {
+		info := "Failed to get membership"
+		log.Error(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", ErrCheckBoardAccess)
+	}
+
+	return board, nil
+}
+
+// callerAccess checks the board for the caller the request is made for, as
+// boardAccess does for a user.
+func callerAccess(
+	ctx context.Context,
+	boardRepo repository.BoardRepository,
+	workspaceRepo repository.WorkspaceRepository,
+	log logger.Logger,
+	header string,
+	boardID uuid.UUID,
+	write bool,
+) (*entity.Board, error) {
+	caller, ok := usecase.CallerFrom(ctx)
+
+	if !ok {
+		info := "Request names no caller"
+		log.Info(ctx, header+info)
+		return nil, fmt.Errorf(header+info+": %w", repository.ErrNoCaller)
+	}
+
+	log.Info(ctx, header+"Checking access", "userID", caller.UserID, "boardID", boardID, "write", write)
+
+	return boardAccess(ctx, boardRepo, workspaceRepo, log, header, caller.UserID, boardID, write)
+}
@@ -0,0 +1,479 @@
+package v1_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+	log "todo/internal/adapter/logger"
+	"todo/internal/adapter/repository/memory"
+	"todo/internal/entity"
+	"todo/internal/repository"
+	"todo/internal/testdata"
+	v1 "todo/internal/usecase/v1"
+	"todo/mocks"
+
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/runner"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCreateWorkspace(t *testing.T) {
+	runner.Run(t, "TestCreateWorkspace", func(pt provider.T) {
+		mom := &testdata.ObjectMother{}
+
+		userID := mom.GetUUID(0)
+
+		tests := []struct {
+			name      string
+			workspace entity.Workspace
+			mockSetup func(mockWorkspaceRepo *mocks.WorkspaceRepository)
+			wantErr   bool
+			err       error
+		}{
+			{
+				name:      "positive",
+				workspace: entity.Workspace{Name: "Team", CreatedBy: userID},
+				mockSetup: func(mockWorkspaceRepo *mocks.WorkspaceRepository) {
+					mockWorkspaceRepo.On("CreateWorkspace", mock.Anything,
+						mock.MatchedBy(func(w *entity.Workspace) bool { return w.Name == "Team" && !w.Personal }),
+						mock.MatchedBy(func(m *entity.WorkspaceMember) bool { return m.UserID == userID && m.Role == entity.RoleAdmin }),
+					).Return(nil)
+				},
+				wantErr: false,
+			},
+			{
+				name:      "no name",
+				workspace: entity.Workspace{CreatedBy: userID},
+				mockSetup: func(mockWorkspaceRepo *mocks.WorkspaceRepository) {},
+				wantErr:   true,
+				err:       repository.ErrWorkspaceNoName,
+			},
+			{
+				name:      "negative",
+				workspace: entity.Workspace{Name: "Team", CreatedBy: userID},
+				mockSetup: func(mockWorkspaceRepo *mocks.WorkspaceRepository) {
+					mockWorkspaceRepo.On("CreateWorkspace", mock.Anything, mock.Anything, mock.Anything).Return(errors.New(""))
+				},
+				wantErr: true,
+				err:     v1.ErrCreateWorkspace,
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				t.Parallel()
+
+				runner.Run(t, tt.name, func(pt provider.T) {
+					mockWorkspaceRepo := new(mocks.WorkspaceRepository)
+					logger := log.NewEmptyLogger()
+
+					uc := v1.NewWorkspaceUseCase(mockWorkspaceRepo, memory.NewTxManager(), logger)
+
+					tt.mockSetup(mockWorkspaceRepo)
+
+					pt.WithNewStep("Call CreateWorkspace", func(sCtx provider.StepCtx) {
+						err := uc.CreateWorkspace(context.Background(), &tt.workspace)
+
+						if tt.wantErr {
+							sCtx.Assert().Error(err, "Expected error")
+							sCtx.Assert().ErrorIs(err, tt.err)
+						} else {
+							sCtx.Assert().NoError(err, "Expected no error")
+						}
+
+						mockWorkspaceRepo.AssertExpectations(t)
+					})
+				})
+			})
+		}
+	})
+}
+
+func TestAddWorkspaceMember(t *testing.T) {
+	runner.Run(t, "TestAddWorkspaceMember", func(pt provider.T) {
+		mom := &testdata.ObjectMother{}
+
+		adminID := mom.GetUUID(0)
+		userID := mom.GetUUID(1)
+		workspaceID := mom.GetUUID(2)
+
+		workspace := &entity.Workspace{ID: workspaceID, Name: "Team"}
+		admin := &entity.WorkspaceMember{WorkspaceID: workspaceID, UserID: adminID, Role: entity.RoleAdmin}
+
+		tests := []struct {
+			name      string
+			role      string
+			mockSetup func(mockWorkspaceRepo *mocks.WorkspaceRepository)
+			wantErr   bool
+			err       error
+		}{
+			{
+				name: "positive",
+				role: entity.RoleViewer,
+				mockSetup: func(mockWorkspaceRepo *mocks.WorkspaceRepository) {
+					mockWorkspaceRepo.On("GetWorkspaceByID", mock.Anything, workspaceID).Return(workspace, nil)
+					mockWorkspaceRepo.On("GetWorkspaceMember", mock.Anything, workspaceID, adminID).Return(admin, nil)
+					mockWorkspaceRepo.On("AddWorkspaceMember", mock.Anything, mock.MatchedBy(func(m *entity.WorkspaceMember) bool {
+						return m.UserID == userID && m.Role == entity.RoleViewer && !m.CreatedAt.IsZero()
+					})).Return(nil)
+				},
+				wantErr: false,
+			},
+			{
+				name:      "unknown role",
+				role:      "owner",
+				mockSetup: func(mockWorkspaceRepo *mocks.WorkspaceRepository) {},
+				wantErr:   true,
+				err:       repository.ErrWorkspaceRole,
+			},
+			{
+				name: "not found",
+				role: entity.RoleMember,
+				mockSetup: func(mockWorkspaceRepo *mocks.WorkspaceRepository) {
+					mockWorkspaceRepo.On("GetWorkspaceByID", mock.Anything, workspaceID).Return(nil, repository.ErrWorkspaceNotFound)
+				},
+				wantErr: true,
+				err:     repository.ErrWorkspaceNotFound,
+			},
+			{
+				name: "actor is not an admin",
+				role: entity.RoleMember,
+				mockSetup: func(mockWorkspaceRepo *mocks.WorkspaceRepository) {
+					mockWorkspaceRepo.On("GetWorkspaceByID", mock.Anything, workspaceID).Return(workspace, nil)
+					mockWorkspaceRepo.On("GetWorkspaceMember", mock.Anything, workspaceID, adminID).
+						Return(&entity.WorkspaceMember{WorkspaceID: workspaceID, UserID: adminID, Role: entity.RoleMember}, nil)
+				},
+				wantErr: true,
+				err:     repository.ErrWorkspaceAccess,
+			},
+			{
+				name: "personal workspace",
+				role: entity.RoleMember,
+				mockSetup: func(mockWorkspaceRepo *mocks.WorkspaceRepository) {
+					mockWorkspaceRepo.On("GetWorkspaceByID", mock.Anything, workspaceID).Return(&entity.Workspace{ID: workspaceID, Personal: true}, nil)
+					mockWorkspaceRepo.On("GetWorkspaceMember", mock.Anything, workspaceID, adminID).Return(admin, nil)
+				},
+				wantErr: true,
+				err:     repository.ErrWorkspacePersonal,
+			},
+			{
+				name: "member already",
+				role: entity.RoleMember,
+				mockSetup: func(mockWorkspaceRepo *mocks.WorkspaceRepository) {
+					mockWorkspaceRepo.On("GetWorkspaceByID", mock.Anything, workspaceID).Return(workspace, nil)
+					mockWorkspaceRepo.On("GetWorkspaceMember", mock.Anything, workspaceID, adminID).Return(admin, nil)
+					mockWorkspaceRepo.On("AddWorkspaceMember", mock.Anything, mock.Anything).Return(repository.ErrWorkspaceMemberExists)
+				},
+				wantErr: true,
+				err:     repository.ErrWorkspaceMemberExists,
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				t.Parallel()
+
+				runner.Run(t, tt.name, func(pt provider.T) {
+					mockWorkspaceRepo := new(mocks.WorkspaceRepository)
+					logger := log.NewEmptyLogger()
+
+					uc := v1.NewWorkspaceUseCase(mockWorkspaceRepo, memory.NewTxManager(), logger)
+
+					tt.mockSetup(mockWorkspaceRepo)
+
+					pt.WithNewStep("Call AddWorkspaceMember", func(sCtx provider.StepCtx) {
+						member := &entity.WorkspaceMember{WorkspaceID: workspaceID, UserID: userID, Role: tt.role}
+						err := uc.AddWorkspaceMember(context.Background(), adminID, member)
+
+						if tt.wantErr {
+							sCtx.Assert().Error(err, "Expected error")
+							sCtx.Assert().ErrorIs(err, tt.err)
+						} else {
+							sCtx.Assert().NoError(err, "Expected no error")
+						}
+
+						mockWorkspaceRepo.AssertExpectations(t)
+					})
+				})
+			})
+		}
+	})
+}
+
+func TestRemoveWorkspaceMember(t *testing.T) {
+	runner.Run(t, "TestRemoveWorkspaceMember", func(pt provider.T) {
+		mom := &testdata.ObjectMother{}
+
+		adminID := mom.GetUUID(0)
+		userID := mom.GetUUID(1)
+		workspaceID := mom.GetUUID(2)
+
+		workspace := &entity.Workspace{ID: workspaceID, Name: "Team"}
+		admin := entity.WorkspaceMember{WorkspaceID: workspaceID, UserID: adminID, Role: entity.RoleAdmin}
+		member := entity.WorkspaceMember{WorkspaceID: workspaceID, UserID: userID, Role: entity.RoleMember}
+
+		tests := []struct {
+			name      string
+			actorID   string
+			userID    string
+			mockSetup func(mockWorkspaceRepo *mocks.WorkspaceRepository)
+			wantErr   bool
+			err       error
+		}{
+			{
+				name:    "admin removes a member",
+				actorID: "admin",
+				userID:  "member",
+				mockSetup: func(mockWorkspaceRepo *mocks.WorkspaceRepository) {
+					mockWorkspaceRepo.On("GetWorkspaceByID", mock.Anything, workspaceID).Return(workspace, nil)
+					mockWorkspaceRepo.On("GetWorkspaceMember", mock.Anything, workspaceID, adminID).Return(&admin, nil)
+					mockWorkspaceRepo.On("GetWorkspaceMembers", mock.Anything, workspaceID).Return([]entity.WorkspaceMember{admin, member}, nil)
+					mockWorkspaceRepo.On("RemoveWorkspaceMember", mock.Anything, workspaceID, userID).Return(nil)
+				},
+				wantErr: false,
+			},
+			{
+				name:    "member leaves",
+				actorID: "member",
+				userID:  "member",
+				mockSetup: func(mockWorkspaceRepo *mocks.WorkspaceRepository) {
+					mockWorkspaceRepo.On("GetWorkspaceByID", mock.Anything, workspaceID).Return(workspace, nil)
+					mockWorkspaceRepo.On("GetWorkspaceMember", mock.Anything, workspaceID, userID).Return(&member, nil)
+					mockWorkspaceRepo.On("GetWorkspaceMembers", mock.Anything, workspaceID).Return([]entity.WorkspaceMember{admin, member}, nil)
+					mockWorkspaceRepo.On("RemoveWorkspaceMember", mock.Anything, workspaceID, userID).Return(nil)
+				},
+				wantErr: false,
+			},
+			{
+				name:    "member removes the admin",
+				actorID: "member",
+				userID:  "admin",
+				mockSetup: func(mockWorkspaceRepo *mocks.WorkspaceRepository) {
+					mockWorkspaceRepo.On("GetWorkspaceByID", mock.Anything, workspaceID).Return(workspace, nil)
+					mockWorkspaceRepo.On("GetWorkspaceMember", mock.Anything, workspaceID, userID).Return(&member, nil)
+				},
+				wantErr: true,
+				err:     repository.ErrWorkspaceAccess,
+			},
+			{
+				name:    "last admin leaves",
+				actorID: "admin",
+				userID:  "admin",
+				mockSetup: func(mockWorkspaceRepo *mocks.WorkspaceRepository) {
+					mockWorkspaceRepo.On("GetWorkspaceByID", mock.Anything, workspaceID).Return(workspace, nil)
+					mockWorkspaceRepo.On("GetWorkspaceMember", mock.Anything, workspaceID, adminID).Return(&admin, nil)
+					mockWorkspaceRepo.On("GetWorkspaceMembers", mock.Anything, workspaceID).Return([]entity.WorkspaceMember{admin, member}, nil)
+				},
+				wantErr: true,
+				err:     repository.ErrWorkspaceLastAdmin,
+			},
+			{
+				name:    "negative",
+				actorID: "admin",
+				userID:  "member",
+				mockSetup: func(mockWorkspaceRepo *mocks.WorkspaceRepository) {
+					mockWorkspaceRepo.On("GetWorkspaceByID", mock.Anything, workspaceID).Return(workspace, nil)
+					mockWorkspaceRepo.On("GetWorkspaceMember", mock.Anything, workspaceID, adminID).Return(&admin, nil)
+					mockWorkspaceRepo.On("GetWorkspaceMembers", mock.Anything, workspaceID).Return([]entity.WorkspaceMember{admin, member}, nil)
+					mockWorkspaceRepo.On("RemoveWorkspaceMember", mock.Anything, workspaceID, userID).Return(errors.New(""))
+				},
+				wantErr: true,
+				err:     v1.ErrRemoveWorkspaceMember,
+			},
+		}
+
+		ids := map[string]entity.WorkspaceMember{"admin": admin, "member": member}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				t.Parallel()
+
+				runner.Run(t, tt.name, func(pt provider.T) {
+					mockWorkspaceRepo := new(mocks.WorkspaceRepository)
+					logger := log.NewEmptyLogger()
+
+					uc := v1.NewWorkspaceUseCase(mockWorkspaceRepo, memory.NewTxManager(), logger)
+
+					tt.mockSetup(mockWorkspaceRepo)
+
+					pt.WithNewStep("Call RemoveWorkspaceMember", func(sCtx provider.StepCtx) {
+						err := uc.RemoveWorkspaceMember(context.Background(), ids[tt.actorID].UserID, workspaceID, ids[tt.userID].UserID)
+
+						if tt.wantErr {
+							sCtx.Assert().Error(err, "Expected error")
+							sCtx.Assert().ErrorIs(err, tt.err)
+						} else {
+							sCtx.Assert().NoError(err, "Expected no error")
+						}
+
+						mockWorkspaceRepo.AssertExpectations(t)
+					})
+				})
+			})
+		}
+	})
+}
+
+func TestUpdateWorkspaceMember(t *testing.T) {
+	runner.Run(t, "TestUpdateWorkspaceMember", func(pt provider.T) {
+		mom := &testdata.ObjectMother{}
+
+		adminID := mom.GetUUID(0)
+		userID := mom.GetUUID(1)
+		workspaceID := mom.GetUUID(2)
+
+		workspace := &entity.Workspace{ID: workspaceID, Name: "Team"}
+		admin := entity.WorkspaceMember{WorkspaceID: workspaceID, UserID: adminID, Role: entity.RoleAdmin}
+		member := entity.WorkspaceMember{WorkspaceID: workspaceID, UserID: userID, Role: entity.RoleMember}
+
+		tests := []struct {
+			name      string
+			member    entity.WorkspaceMember
+			mockSetup func(mockWorkspaceRepo *mocks.WorkspaceRepository)
+			wantErr   bool
+			err       error
+		}{
+			{
+				name:   "promote a member",
+				member: entity.WorkspaceMember{WorkspaceID: workspaceID, UserID: userID, Role: entity.RoleAdmin},
+				mockSetup: func(mockWorkspaceRepo *mocks.WorkspaceRepository) {
+					mockWorkspaceRepo.On("UpdateWorkspaceMember", mock.Anything, mock.Anything).Return(nil)
+				},
+				wantErr: false,
+			},
+			{
+				name:   "demote the last admin",
+				member: entity.WorkspaceMember{WorkspaceID: workspaceID, UserID: adminID, Role: entity.RoleViewer},
+				mockSetup: func(mockWorkspaceRepo *mocks.WorkspaceRepository) {
+					mockWorkspaceRepo.On("GetWorkspaceMembers", mock.Anything, workspaceID).Return([]entity.WorkspaceMember{admin, member}, nil)
+				},
+				wantErr: true,
+				err:     repository.ErrWorkspaceLastAdmin,
+			},
+			{
+				name:   "not a member",
+				member: entity.WorkspaceMember{WorkspaceID: workspaceID, UserID: mom.GetUUID(3), Role: entity.RoleViewer},
+				mockSetup: func(mockWorkspaceRepo *mocks.WorkspaceRepository) {
+					mockWorkspaceRepo.On("GetWorkspaceMembers", mock.Anything, workspaceID).Return([]entity.WorkspaceMember{admin, member}, nil)
+					mockWorkspaceRepo.On("UpdateWorkspaceMember", mock.Anything, mock.Anything).Return(repository.ErrWorkspaceMemberNotFound)
+				},
+				wantErr: true,
+				err:     repository.ErrWorkspaceMemberNotFound,
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				t.Parallel()
+
+				runner.Run(t, tt.name, func(pt provider.T) {
+					mockWorkspaceRepo := new(mocks.WorkspaceRepository)
+					logger := log.NewEmptyLogger()
+
+					uc := v1.NewWorkspaceUseCase(mockWorkspaceRepo, memory.NewTxManager(), logger)
+
+					mockWorkspaceRepo.On("GetWorkspaceByID", mock.Anything, workspaceID).Return(workspace, nil)
+					mockWorkspaceRepo.On("GetWorkspaceMember", mock.Anything, workspaceID, adminID).Return(&admin, nil)
+					tt.mockSetup(mockWorkspaceRepo)
+
+					pt.WithNewStep("Call UpdateWorkspaceMember", func(sCtx provider.StepCtx) {
+						err := uc.UpdateWorkspaceMember(context.Background(), adminID, &tt.member)
+
+						if tt.wantErr {
+							sCtx.Assert().Error(err, "Expected error")
+							sCtx.Assert().ErrorIs(err, tt.err)
+						} else {
+							sCtx.Assert().NoError(err, "Expected no error")
+						}
+
+						mockWorkspaceRepo.AssertExpectations(t)
+					})
+				})
+			})
+		}
+	})
+}
+
+func TestGetWorkspaceBoards(t *testing.T) {
+	runner.Run(t, "TestGetWorkspaceBoards", func(pt provider.T) {
+		mom := &testdata.ObjectMother{}
+
+		adminID := mom.GetUUID(0)
+		workspaceID := mom.GetUUID(1)
+		createdAt := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+		workspace := &entity.Workspace{ID: workspaceID, Name: "Team"}
+		boards := []entity.Board{
+			{ID: mom.GetUUID(2), WorkspaceID: workspaceID, CreatedAt: createdAt},
+			{ID: mom.GetUUID(3), WorkspaceID: workspaceID, CreatedAt: createdAt.Add(time.Hour)},
+		}
+
+		tests := []struct {
+			name      string
+			role      string
+			mockSetup func(mockWorkspaceRepo *mocks.WorkspaceRepository)
+			wantNext  *repository.Cursor
+			wantErr   bool
+			err       error
+		}{
+			{
+				name: "positive",
+				role: entity.RoleAdmin,
+				mockSetup: func(mockWorkspaceRepo *mocks.WorkspaceRepository) {
+					mockWorkspaceRepo.On("GetWorkspaceBoards", mock.Anything, workspaceID, repository.Page{Limit: 2}).Return(boards, nil)
+				},
+				wantNext: repository.TimeCursor(boards[1].CreatedAt, boards[1].ID),
+				wantErr:  false,
+			},
+			{
+				name:      "member",
+				role:      entity.RoleMember,
+				mockSetup: func(mockWorkspaceRepo *mocks.WorkspaceRepository) {},
+				wantErr:   true,
+				err:       repository.ErrWorkspaceAccess,
+			},
+			{
+				name: "negative",
+				role: entity.RoleAdmin,
+				mockSetup: func(mockWorkspaceRepo *mocks.WorkspaceRepository) {
+					mockWorkspaceRepo.On("GetWorkspaceBoards", mock.Anything, workspaceID, mock.Anything).Return(nil, errors.New(""))
+				},
+				wantErr: true,
+				err:     v1.ErrGetWorkspaceBoards,
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				t.Parallel()
+
+				runner.Run(t, tt.name, func(pt provider.T) {
+					mockWorkspaceRepo := new(mocks.WorkspaceRepository)
+					logger := log.NewEmptyLogger()
+
+					uc := v1.NewWorkspaceUseCase(mockWorkspaceRepo, memory.NewTxManager(), logger)
+
+					mockWorkspaceRepo.On("GetWorkspaceByID", mock.Anything, workspaceID).Return(workspace, nil)
+					mockWorkspaceRepo.On("GetWorkspaceMember", mock.Anything, workspaceID, adminID).
+						Return(&entity.WorkspaceMember{WorkspaceID: workspaceID, UserID: adminID, Role: tt.role}, nil)
+					tt.mockSetup(mockWorkspaceRepo)
+
+					pt.WithNewStep("Call GetWorkspaceBoards", func(sCtx provider.StepCtx) {
+						_, next, err := uc.GetWorkspaceBoards(context.Background(), adminID, workspaceID, repository.Page{Limit: 2})
+
+						if tt.wantErr {
+							sCtx.Assert().Error(err, "Expected error")
+							sCtx.Assert().ErrorIs(err, tt.err)
+						} else {
+							sCtx.Assert().NoError(err, "Expected no error")
+							sCtx.Assert().Equal(tt.wantNext, next)
+						}
+
+						mockWorkspaceRepo.AssertExpectations(t)
+					})
+				})
+			})
+		}
+	})
+}
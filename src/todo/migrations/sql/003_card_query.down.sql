@@ -0,0 +1,10 @@
+DROP INDEX IF EXISTS idx_cards_assignee;
+DROP INDEX IF EXISTS idx_cards_priority;
+DROP INDEX IF EXISTS idx_cards_column_position;
+
+DROP TABLE IF EXISTS card_labels;
+
+ALTER TABLE cards
+    DROP COLUMN IF EXISTS due_date,
+    DROP COLUMN IF EXISTS assignee_id,
+    DROP COLUMN IF EXISTS priority;
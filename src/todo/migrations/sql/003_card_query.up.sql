@@ -0,0 +1,15 @@
+ALTER TABLE cards
+    ADD COLUMN priority SMALLINT NOT NULL DEFAULT 0,
+    ADD COLUMN assignee_id UUID, -- From user service
+    ADD COLUMN due_date TIMESTAMP;
+
+CREATE TABLE card_labels (
+    card_id UUID REFERENCES cards(id) ON DELETE CASCADE,
+    label VARCHAR(64) NOT NULL,
+    PRIMARY KEY (card_id, label)
+);
+
+CREATE INDEX idx_cards_column_position ON cards (column_id, position);
+CREATE INDEX idx_cards_priority ON cards (priority);
+CREATE INDEX idx_cards_assignee ON cards (assignee_id);
+CREATE INDEX idx_card_labels_label ON card_labels (label);
@@ -0,0 +1,3 @@
+ALTER TABLE boards ADD COLUMN version INTEGER NOT NULL DEFAULT 1;
+ALTER TABLE columns ADD COLUMN version INTEGER NOT NULL DEFAULT 1;
+ALTER TABLE cards ADD COLUMN version INTEGER NOT NULL DEFAULT 1;
@@ -0,0 +1 @@
+ALTER TABLE cards ADD COLUMN archived_at TIMESTAMP;
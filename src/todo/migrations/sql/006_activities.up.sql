@@ -0,0 +1,10 @@
+-- No foreign key on board_id: the activity of a deleted board outlives it.
+CREATE TABLE activities (
+    id BIGSERIAL PRIMARY KEY,
+    board_id UUID NOT NULL,
+    kind VARCHAR(32) NOT NULL,
+    payload TEXT NOT NULL,
+    created_at TIMESTAMP NOT NULL
+);
+
+CREATE INDEX activities_board_id_id_idx ON activities (board_id, id);
@@ -0,0 +1,2 @@
+ALTER TABLE cards DROP COLUMN IF EXISTS swimlane_id;
+DROP TABLE IF EXISTS swimlanes;
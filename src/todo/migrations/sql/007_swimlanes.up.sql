@@ -0,0 +1,26 @@
+CREATE TABLE swimlanes (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    board_id UUID REFERENCES boards(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL,
+    title VARCHAR(255) NOT NULL,
+    position REAL NOT NULL,
+    version INTEGER NOT NULL DEFAULT 1,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX swimlanes_board_id_idx ON swimlanes (board_id, position);
+
+-- Every board has at least one lane; existing cards go to the default one.
+INSERT INTO swimlanes (board_id, user_id, title, position)
+SELECT id, user_id, 'Default', 0 FROM boards;
+
+ALTER TABLE cards ADD COLUMN swimlane_id UUID REFERENCES swimlanes(id) ON DELETE CASCADE;
+
+UPDATE cards SET swimlane_id = s.id
+FROM columns c, swimlanes s
+WHERE cards.column_id = c.id AND s.board_id = c.board_id;
+
+ALTER TABLE cards ALTER COLUMN swimlane_id SET NOT NULL;
+
+CREATE INDEX cards_swimlane_id_idx ON cards (swimlane_id);
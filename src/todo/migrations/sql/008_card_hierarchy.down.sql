@@ -0,0 +1,2 @@
+ALTER TABLE cards DROP COLUMN IF EXISTS parent_id;
+ALTER TABLE columns DROP COLUMN IF EXISTS done;
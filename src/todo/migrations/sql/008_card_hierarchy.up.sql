@@ -0,0 +1,6 @@
+-- Cards in done columns count as finished in the rollups of their parents.
+ALTER TABLE columns ADD COLUMN done BOOLEAN NOT NULL DEFAULT FALSE;
+
+ALTER TABLE cards ADD COLUMN parent_id UUID REFERENCES cards(id) ON DELETE SET NULL;
+
+CREATE INDEX cards_parent_id_idx ON cards (parent_id);
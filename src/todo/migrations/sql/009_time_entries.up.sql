@@ -0,0 +1,14 @@
+-- A running timer is an entry with no ended_at yet; a user has at most one.
+CREATE TABLE time_entries (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    card_id UUID NOT NULL REFERENCES cards(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL,
+    started_at TIMESTAMP NOT NULL,
+    ended_at TIMESTAMP,
+    note TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL
+);
+
+CREATE INDEX time_entries_card_id_idx ON time_entries (card_id, started_at);
+CREATE INDEX time_entries_user_id_idx ON time_entries (user_id, started_at);
+CREATE UNIQUE INDEX time_entries_running_idx ON time_entries (user_id) WHERE ended_at IS NULL;
@@ -0,0 +1,21 @@
+-- A stay is the time a card spends in a column; it is open while the card is
+-- there. No foreign key on card_id: the history of a deleted card outlives it.
+CREATE TABLE card_column_stays (
+    id BIGSERIAL PRIMARY KEY,
+    card_id UUID NOT NULL,
+    board_id UUID NOT NULL,
+    column_id UUID NOT NULL,
+    entered_at TIMESTAMP NOT NULL,
+    left_at TIMESTAMP
+);
+
+CREATE INDEX card_column_stays_card_id_idx ON card_column_stays (card_id, entered_at);
+CREATE INDEX card_column_stays_board_id_idx ON card_column_stays (board_id, entered_at);
+CREATE UNIQUE INDEX card_column_stays_open_idx ON card_column_stays (card_id) WHERE left_at IS NULL;
+
+-- Cards older than the history are taken to have been in their column since
+-- they were created.
+INSERT INTO card_column_stays (card_id, board_id, column_id, entered_at)
+SELECT c.id, col.board_id, c.column_id, c.created_at
+FROM cards c JOIN columns col ON col.id = c.column_id
+WHERE c.archived_at IS NULL;
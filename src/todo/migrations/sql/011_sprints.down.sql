@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS sprint_cards;
+DROP TABLE IF EXISTS sprints;
@@ -0,0 +1,24 @@
+-- A sprint runs from start_date to end_date, both included. Closing it
+-- copies its unfinished cards into the next sprint and keeps them in the
+-- closed one too, so that its burndown stays as it was.
+CREATE TABLE sprints (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    board_id UUID NOT NULL REFERENCES boards(id) ON DELETE CASCADE,
+    name VARCHAR(255) NOT NULL,
+    start_date TIMESTAMP NOT NULL,
+    end_date TIMESTAMP NOT NULL,
+    closed_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL
+);
+
+CREATE INDEX sprints_board_id_idx ON sprints (board_id, start_date);
+
+CREATE TABLE sprint_cards (
+    sprint_id UUID NOT NULL REFERENCES sprints(id) ON DELETE CASCADE,
+    card_id UUID NOT NULL REFERENCES cards(id) ON DELETE CASCADE,
+    points INTEGER NOT NULL DEFAULT 0,
+    added_at TIMESTAMP NOT NULL,
+    PRIMARY KEY (sprint_id, card_id)
+);
+
+CREATE INDEX sprint_cards_card_id_idx ON sprint_cards (card_id);
@@ -0,0 +1,7 @@
+-- A user has at most one calendar feed token; regenerating it replaces the
+-- old one. Only a SHA-256 hash of the token is kept.
+CREATE TABLE calendar_tokens (
+    user_id UUID PRIMARY KEY,
+    token_hash TEXT NOT NULL UNIQUE,
+    created_at TIMESTAMP NOT NULL
+);
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS board_views;
+DROP TABLE IF EXISTS board_stars;
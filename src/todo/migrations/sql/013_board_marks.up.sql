@@ -0,0 +1,17 @@
+-- Boards users have starred, and the boards they have opened most recently.
+-- Only the last views of a user are kept; see RecentBoardsLimit.
+CREATE TABLE board_stars (
+    user_id UUID NOT NULL,
+    board_id UUID NOT NULL REFERENCES boards(id) ON DELETE CASCADE,
+    created_at TIMESTAMP NOT NULL,
+    PRIMARY KEY (user_id, board_id)
+);
+
+CREATE TABLE board_views (
+    user_id UUID NOT NULL,
+    board_id UUID NOT NULL REFERENCES boards(id) ON DELETE CASCADE,
+    viewed_at TIMESTAMP NOT NULL,
+    PRIMARY KEY (user_id, board_id)
+);
+
+CREATE INDEX board_views_user_viewed_at_idx ON board_views (user_id, viewed_at DESC);
@@ -0,0 +1,42 @@
+-- Workspaces group boards and the users working on them. Every member has a
+-- role in the workspace, which their access to its boards follows. Each user
+-- has one personal workspace, made when first needed.
+CREATE TABLE workspaces (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    name VARCHAR(255) NOT NULL,
+    personal BOOLEAN NOT NULL DEFAULT FALSE,
+    created_by UUID NOT NULL,
+    created_at TIMESTAMP NOT NULL
+);
+
+CREATE UNIQUE INDEX workspaces_personal_idx ON workspaces (created_by) WHERE personal;
+
+CREATE TABLE workspace_members (
+    workspace_id UUID NOT NULL REFERENCES workspaces(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL,
+    role VARCHAR(16) NOT NULL CHECK (role IN ('admin', 'member', 'viewer')),
+    created_at TIMESTAMP NOT NULL,
+    PRIMARY KEY (workspace_id, user_id)
+);
+
+CREATE INDEX workspace_members_user_idx ON workspace_members (user_id);
+
+-- Existing boards go to the personal workspace of their owner.
+INSERT INTO workspaces (id, name, personal, created_by, created_at)
+SELECT uuid_generate_v4(), 'Personal', TRUE, user_id, COALESCE(MIN(created_at), NOW())
+FROM boards
+GROUP BY user_id;
+
+INSERT INTO workspace_members (workspace_id, user_id, role, created_at)
+SELECT id, created_by, 'admin', created_at
+FROM workspaces;
+
+ALTER TABLE boards ADD COLUMN workspace_id UUID REFERENCES workspaces(id) ON DELETE CASCADE;
+
+UPDATE boards b SET workspace_id = w.id
+FROM workspaces w
+WHERE w.personal AND w.created_by = b.user_id;
+
+ALTER TABLE boards ALTER COLUMN workspace_id SET NOT NULL;
+
+CREATE INDEX boards_workspace_idx ON boards (workspace_id, created_at, id);
@@ -0,0 +1,16 @@
+-- Templates cards of a board can be made from. Labels and checklists are
+-- kept as JSON arrays: they are only ever read and written with the
+-- template.
+CREATE TABLE card_templates (
+    id UUID PRIMARY KEY,
+    board_id UUID NOT NULL REFERENCES boards(id) ON DELETE CASCADE,
+    name VARCHAR(255) NOT NULL,
+    title_pattern VARCHAR(255) NOT NULL DEFAULT '',
+    description TEXT NOT NULL DEFAULT '',
+    labels TEXT NOT NULL DEFAULT '[]',
+    checklists TEXT NOT NULL DEFAULT '[]',
+    created_by UUID NOT NULL,
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP NOT NULL,
+    UNIQUE (board_id, name)
+);
@@ -0,0 +1,15 @@
+DROP TABLE IF EXISTS board_views;
+DROP TABLE IF EXISTS board_stars;
+DROP TABLE IF EXISTS calendar_tokens;
+DROP TABLE IF EXISTS sprint_cards;
+DROP TABLE IF EXISTS sprints;
+DROP TABLE IF EXISTS card_column_stays;
+DROP TABLE IF EXISTS time_entries;
+DROP TABLE IF EXISTS activities;
+DROP TABLE IF EXISTS card_labels;
+DROP TABLE IF EXISTS cards;
+DROP TABLE IF EXISTS swimlanes;
+DROP TABLE IF EXISTS columns;
+DROP TABLE IF EXISTS boards;
+DROP TABLE IF EXISTS workspace_members;
+DROP TABLE IF EXISTS workspaces;
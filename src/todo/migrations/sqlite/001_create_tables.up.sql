@@ -0,0 +1,219 @@
+-- The schema migrations/sql builds up to in 001 to 014, at once: SQLite can
+-- neither add constraints to nor alter existing columns. UUIDs are kept as
+-- text, and times in the TIMESTAMP columns the driver reads them back from.
+CREATE TABLE workspaces (
+    id TEXT PRIMARY KEY,
+    name VARCHAR(255) NOT NULL,
+    personal BOOLEAN NOT NULL DEFAULT FALSE,
+    created_by TEXT NOT NULL,
+    created_at TIMESTAMP NOT NULL
+);
+
+CREATE UNIQUE INDEX workspaces_personal_idx ON workspaces (created_by) WHERE personal;
+
+CREATE TABLE workspace_members (
+    workspace_id TEXT NOT NULL REFERENCES workspaces(id) ON DELETE CASCADE,
+    user_id TEXT NOT NULL,
+    role VARCHAR(16) NOT NULL CHECK (role IN ('admin', 'member', 'viewer')),
+    created_at TIMESTAMP NOT NULL,
+    PRIMARY KEY (workspace_id, user_id)
+);
+
+CREATE INDEX workspace_members_user_idx ON workspace_members (user_id);
+
+CREATE TABLE boards (
+    id TEXT PRIMARY KEY,
+    user_id TEXT NOT NULL, -- From user service
+    workspace_id TEXT NOT NULL REFERENCES workspaces(id) ON DELETE CASCADE,
+    title VARCHAR(255) NOT NULL,
+    version INTEGER NOT NULL DEFAULT 1,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX boards_workspace_idx ON boards (workspace_id, created_at, id);
+
+CREATE TABLE columns (
+    id TEXT PRIMARY KEY,
+    board_id TEXT REFERENCES boards(id) ON DELETE CASCADE,
+    user_id TEXT NOT NULL,
+    title VARCHAR(255) NOT NULL,
+    position REAL NOT NULL,
+    done BOOLEAN NOT NULL DEFAULT FALSE,
+    version INTEGER NOT NULL DEFAULT 1,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE swimlanes (
+    id TEXT PRIMARY KEY,
+    board_id TEXT REFERENCES boards(id) ON DELETE CASCADE,
+    user_id TEXT NOT NULL,
+    title VARCHAR(255) NOT NULL,
+    position REAL NOT NULL,
+    version INTEGER NOT NULL DEFAULT 1,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX swimlanes_board_id_idx ON swimlanes (board_id, position);
+
+CREATE TABLE cards (
+    id TEXT PRIMARY KEY,
+    column_id TEXT REFERENCES columns(id) ON DELETE CASCADE,
+    swimlane_id TEXT NOT NULL REFERENCES swimlanes(id) ON DELETE CASCADE,
+    parent_id TEXT REFERENCES cards(id) ON DELETE SET NULL,
+    user_id TEXT NOT NULL,
+    title VARCHAR(255) NOT NULL,
+    description TEXT,
+    position REAL NOT NULL,
+    priority SMALLINT NOT NULL DEFAULT 0,
+    assignee_id TEXT, -- From user service
+    due_date TIMESTAMP,
+    archived_at TIMESTAMP,
+    version INTEGER NOT NULL DEFAULT 1,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_cards_column_position ON cards (column_id, position);
+CREATE INDEX idx_cards_priority ON cards (priority);
+CREATE INDEX idx_cards_assignee ON cards (assignee_id);
+CREATE INDEX cards_swimlane_id_idx ON cards (swimlane_id);
+CREATE INDEX cards_parent_id_idx ON cards (parent_id);
+
+CREATE TABLE card_labels (
+    card_id TEXT REFERENCES cards(id) ON DELETE CASCADE,
+    label VARCHAR(64) NOT NULL,
+    PRIMARY KEY (card_id, label)
+);
+
+CREATE INDEX idx_card_labels_label ON card_labels (label);
+
+-- No foreign key on board_id: the activity of a deleted board outlives it.
+CREATE TABLE activities (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    board_id TEXT NOT NULL,
+    kind VARCHAR(32) NOT NULL,
+    payload TEXT NOT NULL,
+    created_at TIMESTAMP NOT NULL
+);
+
+CREATE INDEX activities_board_id_id_idx ON activities (board_id, id);
+
+-- A running timer is an entry with no ended_at yet; a user has at most one.
+CREATE TABLE time_entries (
+    id TEXT PRIMARY KEY,
+    card_id TEXT NOT NULL REFERENCES cards(id) ON DELETE CASCADE,
+    user_id TEXT NOT NULL,
+    started_at TIMESTAMP NOT NULL,
+    ended_at TIMESTAMP,
+    note TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL
+);
+
+CREATE INDEX time_entries_card_id_idx ON time_entries (card_id, started_at);
+CREATE INDEX time_entries_user_id_idx ON time_entries (user_id, started_at);
+CREATE UNIQUE INDEX time_entries_running_idx ON time_entries (user_id) WHERE ended_at IS NULL;
+
+-- A stay is the time a card spends in a column; it is open while the card is
+-- there. No foreign key on card_id: the history of a deleted card outlives it.
+CREATE TABLE card_column_stays (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    card_id TEXT NOT NULL,
+    board_id TEXT NOT NULL,
+    column_id TEXT NOT NULL,
+    entered_at TIMESTAMP NOT NULL,
+    left_at TIMESTAMP
+);
+
+CREATE INDEX card_column_stays_card_id_idx ON card_column_stays (card_id, entered_at);
+CREATE INDEX card_column_stays_board_id_idx ON card_column_stays (board_id, entered_at);
+CREATE UNIQUE INDEX card_column_stays_open_idx ON card_column_stays (card_id) WHERE left_at IS NULL;
+
+-- A sprint runs from start_date to end_date, both included. Closing it
+-- copies its unfinished cards into the next sprint and keeps them in the
+-- closed one too, so that its burndown stays as it was.
+CREATE TABLE sprints (
+    id TEXT PRIMARY KEY,
+    board_id TEXT NOT NULL REFERENCES boards(id) ON DELETE CASCADE,
+    name VARCHAR(255) NOT NULL,
+    start_date TIMESTAMP NOT NULL,
+    end_date TIMESTAMP NOT NULL,
+    closed_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL
+);
+
+CREATE INDEX sprints_board_id_idx ON sprints (board_id, start_date);
+
+CREATE TABLE sprint_cards (
+    sprint_id TEXT NOT NULL REFERENCES sprints(id) ON DELETE CASCADE,
+    card_id TEXT NOT NULL REFERENCES cards(id) ON DELETE CASCADE,
+    points INTEGER NOT NULL DEFAULT 0,
+    added_at TIMESTAMP NOT NULL,
+    PRIMARY KEY (sprint_id, card_id)
+);
+
+CREATE INDEX sprint_cards_card_id_idx ON sprint_cards (card_id);
+
+-- A user has at most one calendar feed token; regenerating it replaces the
+-- old one. Only a SHA-256 hash of the token is kept.
+CREATE TABLE calendar_tokens (
+    user_id TEXT PRIMARY KEY,
+    token_hash TEXT NOT NULL UNIQUE,
+    created_at TIMESTAMP NOT NULL
+);
+
+-- Boards users have starred, and the boards they have opened most recently.
+-- Only the last views of a user are kept; see RecentBoardsLimit.
+CREATE TABLE board_stars (
+    user_id TEXT NOT NULL,
+    board_id TEXT NOT NULL REFERENCES boards(id) ON DELETE CASCADE,
+    created_at TIMESTAMP NOT NULL,
+    PRIMARY KEY (user_id, board_id)
+);
+
+CREATE TABLE board_views (
+    user_id TEXT NOT NULL,
+    board_id TEXT NOT NULL REFERENCES boards(id) ON DELETE CASCADE,
+    viewed_at TIMESTAMP NOT NULL,
+    PRIMARY KEY (user_id, board_id)
+);
+
+CREATE INDEX board_views_user_viewed_at_idx ON board_views (user_id, viewed_at DESC);
+
+-- The rows migrations/sql starts with, in the personal workspace and the
+-- default swimlane its later migrations make for them.
+INSERT INTO workspaces
+(id, name, personal, created_by, created_at)
+VALUES
+('aaaaaaaa-0000-aaaa-0000-dddddddddddd', 'Personal', TRUE, '00000000-0000-eeee-0000-000000000000', CURRENT_TIMESTAMP);
+
+INSERT INTO workspace_members
+(workspace_id, user_id, role, created_at)
+VALUES
+('aaaaaaaa-0000-aaaa-0000-dddddddddddd', '00000000-0000-eeee-0000-000000000000', 'admin', CURRENT_TIMESTAMP);
+
+INSERT INTO boards
+(id, user_id, workspace_id, title)
+VALUES
+('bbbbbbbb-0000-aaaa-0000-dddddddddddd', '00000000-0000-eeee-0000-000000000000', 'aaaaaaaa-0000-aaaa-0000-dddddddddddd', 'Initial Board');
+
+INSERT INTO columns
+(id, board_id, user_id, title, position)
+VALUES
+('cccccccc-0000-0000-0000-000000000000', 'bbbbbbbb-0000-aaaa-0000-dddddddddddd', '00000000-0000-eeee-0000-000000000000', 'Initial Column', 0);
+
+INSERT INTO swimlanes
+(id, board_id, user_id, title, position)
+VALUES
+('dddddddd-0000-aaaa-0000-dddddddddddd', 'bbbbbbbb-0000-aaaa-0000-dddddddddddd', '00000000-0000-eeee-0000-000000000000', 'Default', 0);
+
+INSERT INTO cards
+(id, column_id, swimlane_id, user_id, title, description, position)
+VALUES
+('cccccccc-aaaa-0000-dddd-dddddddddddd', 'cccccccc-0000-0000-0000-000000000000', 'dddddddd-0000-aaaa-0000-dddddddddddd', '00000000-0000-eeee-0000-000000000000', 'Initial Card', 'Initial Description', 0);
+
+INSERT INTO card_column_stays (card_id, board_id, column_id, entered_at)
+SELECT c.id, col.board_id, c.column_id, c.created_at
+FROM cards c JOIN columns col ON col.id = c.column_id;
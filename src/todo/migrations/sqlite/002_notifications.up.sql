@@ -0,0 +1,30 @@
+-- Cards and boards users watch, and the inbox of notifications they get for
+-- being mentioned on or watching a card. The title of the card is kept as it
+-- was when the notification was made.
+CREATE TABLE board_watches (
+    user_id TEXT NOT NULL,
+    board_id TEXT NOT NULL REFERENCES boards(id) ON DELETE CASCADE,
+    created_at TIMESTAMP NOT NULL,
+    PRIMARY KEY (user_id, board_id)
+);
+
+CREATE TABLE card_watches (
+    user_id TEXT NOT NULL,
+    card_id TEXT NOT NULL REFERENCES cards(id) ON DELETE CASCADE,
+    created_at TIMESTAMP NOT NULL,
+    PRIMARY KEY (user_id, card_id)
+);
+
+CREATE TABLE notifications (
+    id TEXT PRIMARY KEY,
+    user_id TEXT NOT NULL,
+    actor_id TEXT NOT NULL,
+    kind VARCHAR(32) NOT NULL,
+    board_id TEXT NOT NULL REFERENCES boards(id) ON DELETE CASCADE,
+    card_id TEXT NOT NULL REFERENCES cards(id) ON DELETE CASCADE,
+    card_title VARCHAR(255) NOT NULL,
+    read_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL
+);
+
+CREATE INDEX notifications_user_created_at_idx ON notifications (user_id, created_at DESC, id DESC);
@@ -0,0 +1,10 @@
+-- Quota bounds set for single users in place of the configured ones. A NULL
+-- bound stays as configured.
+CREATE TABLE quota_overrides (
+    user_id TEXT PRIMARY KEY,
+    boards_per_user INTEGER,
+    columns_per_board INTEGER,
+    cards_per_column INTEGER,
+    description_length INTEGER,
+    updated_at TIMESTAMP NOT NULL
+);
@@ -8,6 +8,8 @@ import (
 
 	mock "github.com/stretchr/testify/mock"
 
+	repository "todo/internal/repository"
+
 	uuid "github.com/google/uuid"
 )
 
@@ -34,17 +36,17 @@ func (_m *BoardRepository) CreateBoard(ctx context.Context, board *entity.Board)
 	return r0
 }
 
-// DeleteBoard provides a mock function with given fields: ctx, id
-func (_m *BoardRepository) DeleteBoard(ctx context.Context, id uuid.UUID) error {
-	ret := _m.Called(ctx, id)
+// DeleteBoard provides a mock function with given fields: ctx, id, version
+func (_m *BoardRepository) DeleteBoard(ctx context.Context, id uuid.UUID, version int) error {
+	ret := _m.Called(ctx, id, version)
 
 	if len(ret) == 0 {
 		panic("no return value specified for DeleteBoard")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
-		r0 = rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int) error); ok {
+		r0 = rf(ctx, id, version)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -82,9 +84,9 @@ func (_m *BoardRepository) GetBoardByID(ctx context.Context, id uuid.UUID) (*ent
 	return r0, r1
 }
 
-// GetBoardsByUser provides a mock function with given fields: ctx, userID, limit, offset
-func (_m *BoardRepository) GetBoardsByUser(ctx context.Context, userID uuid.UUID, limit int, offset int) ([]entity.Board, error) {
-	ret := _m.Called(ctx, userID, limit, offset)
+// GetBoardsByUser provides a mock function with given fields: ctx, userID, page
+func (_m *BoardRepository) GetBoardsByUser(ctx context.Context, userID uuid.UUID, page repository.Page) ([]entity.Board, error) {
+	ret := _m.Called(ctx, userID, page)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetBoardsByUser")
@@ -92,19 +94,19 @@ func (_m *BoardRepository) GetBoardsByUser(ctx context.Context, userID uuid.UUID
 
 	var r0 []entity.Board
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, int) ([]entity.Board, error)); ok {
-		return rf(ctx, userID, limit, offset)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, repository.Page) ([]entity.Board, error)); ok {
+		return rf(ctx, userID, page)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, int) []entity.Board); ok {
-		r0 = rf(ctx, userID, limit, offset)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, repository.Page) []entity.Board); ok {
+		r0 = rf(ctx, userID, page)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]entity.Board)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, int, int) error); ok {
-		r1 = rf(ctx, userID, limit, offset)
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, repository.Page) error); ok {
+		r1 = rf(ctx, userID, page)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -112,6 +114,24 @@ func (_m *BoardRepository) GetBoardsByUser(ctx context.Context, userID uuid.UUID
 	return r0, r1
 }
 
+// TransferBoard provides a mock function with given fields: ctx, board
+func (_m *BoardRepository) TransferBoard(ctx context.Context, board *entity.Board) error {
+	ret := _m.Called(ctx, board)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TransferBoard")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.Board) error); ok {
+		r0 = rf(ctx, board)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // UpdateBoard provides a mock function with given fields: ctx, board
 func (_m *BoardRepository) UpdateBoard(ctx context.Context, board *entity.Board) error {
 	ret := _m.Called(ctx, board)
@@ -8,6 +8,8 @@ import (
 
 	mock "github.com/stretchr/testify/mock"
 
+	repository "todo/internal/repository"
+
 	time "time"
 
 	uuid "github.com/google/uuid"
@@ -18,6 +20,36 @@ type CardRepository struct {
 	mock.Mock
 }
 
+// ApplyCardBatch provides a mock function with given fields: ctx, batch
+func (_m *CardRepository) ApplyCardBatch(ctx context.Context, batch repository.CardBatch) ([]repository.CardOpResult, error) {
+	ret := _m.Called(ctx, batch)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ApplyCardBatch")
+	}
+
+	var r0 []repository.CardOpResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, repository.CardBatch) ([]repository.CardOpResult, error)); ok {
+		return rf(ctx, batch)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, repository.CardBatch) []repository.CardOpResult); ok {
+		r0 = rf(ctx, batch)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]repository.CardOpResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, repository.CardBatch) error); ok {
+		r1 = rf(ctx, batch)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CreateCard provides a mock function with given fields: ctx, card
 func (_m *CardRepository) CreateCard(ctx context.Context, card *entity.Card) error {
 	ret := _m.Called(ctx, card)
@@ -36,17 +68,17 @@ func (_m *CardRepository) CreateCard(ctx context.Context, card *entity.Card) err
 	return r0
 }
 
-// DeleteCard provides a mock function with given fields: ctx, id
-func (_m *CardRepository) DeleteCard(ctx context.Context, id uuid.UUID) error {
-	ret := _m.Called(ctx, id)
+// DeleteCard provides a mock function with given fields: ctx, id, version
+func (_m *CardRepository) DeleteCard(ctx context.Context, id uuid.UUID, version int) error {
+	ret := _m.Called(ctx, id, version)
 
 	if len(ret) == 0 {
 		panic("no return value specified for DeleteCard")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
-		r0 = rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int) error); ok {
+		r0 = rf(ctx, id, version)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -54,6 +86,36 @@ func (_m *CardRepository) DeleteCard(ctx context.Context, id uuid.UUID) error {
 	return r0
 }
 
+// GetCardAncestors provides a mock function with given fields: ctx, id
+func (_m *CardRepository) GetCardAncestors(ctx context.Context, id uuid.UUID) ([]entity.Card, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCardAncestors")
+	}
+
+	var r0 []entity.Card
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]entity.Card, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []entity.Card); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Card)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetCardByID provides a mock function with given fields: ctx, id
 func (_m *CardRepository) GetCardByID(ctx context.Context, id uuid.UUID) (*entity.Card, error) {
 	ret := _m.Called(ctx, id)
@@ -84,9 +146,39 @@ func (_m *CardRepository) GetCardByID(ctx context.Context, id uuid.UUID) (*entit
 	return r0, r1
 }
 
-// GetCardsByColumn provides a mock function with given fields: ctx, columnID, limit, offset
-func (_m *CardRepository) GetCardsByColumn(ctx context.Context, columnID uuid.UUID, limit int, offset int) ([]entity.Card, error) {
-	ret := _m.Called(ctx, columnID, limit, offset)
+// GetCards provides a mock function with given fields: ctx, query
+func (_m *CardRepository) GetCards(ctx context.Context, query repository.CardQuery) ([]entity.Card, error) {
+	ret := _m.Called(ctx, query)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCards")
+	}
+
+	var r0 []entity.Card
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, repository.CardQuery) ([]entity.Card, error)); ok {
+		return rf(ctx, query)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, repository.CardQuery) []entity.Card); ok {
+		r0 = rf(ctx, query)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Card)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, repository.CardQuery) error); ok {
+		r1 = rf(ctx, query)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetCardsByColumn provides a mock function with given fields: ctx, columnID, page
+func (_m *CardRepository) GetCardsByColumn(ctx context.Context, columnID uuid.UUID, page repository.Page) ([]entity.Card, error) {
+	ret := _m.Called(ctx, columnID, page)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetCardsByColumn")
@@ -94,19 +186,19 @@ func (_m *CardRepository) GetCardsByColumn(ctx context.Context, columnID uuid.UU
 
 	var r0 []entity.Card
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, int) ([]entity.Card, error)); ok {
-		return rf(ctx, columnID, limit, offset)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, repository.Page) ([]entity.Card, error)); ok {
+		return rf(ctx, columnID, page)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, int) []entity.Card); ok {
-		r0 = rf(ctx, columnID, limit, offset)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, repository.Page) []entity.Card); ok {
+		r0 = rf(ctx, columnID, page)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]entity.Card)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, int, int) error); ok {
-		r1 = rf(ctx, columnID, limit, offset)
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, repository.Page) error); ok {
+		r1 = rf(ctx, columnID, page)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -114,9 +206,9 @@ func (_m *CardRepository) GetCardsByColumn(ctx context.Context, columnID uuid.UU
 	return r0, r1
 }
 
-// GetNewCards provides a mock function with given fields: ctx, from, to
-func (_m *CardRepository) GetNewCards(ctx context.Context, from time.Time, to time.Time) ([]entity.Card, error) {
-	ret := _m.Called(ctx, from, to)
+// GetNewCards provides a mock function with given fields: ctx, from, to, page
+func (_m *CardRepository) GetNewCards(ctx context.Context, from time.Time, to time.Time, page repository.Page) ([]entity.Card, error) {
+	ret := _m.Called(ctx, from, to, page)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetNewCards")
@@ -124,19 +216,19 @@ func (_m *CardRepository) GetNewCards(ctx context.Context, from time.Time, to ti
 
 	var r0 []entity.Card
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, time.Time, time.Time) ([]entity.Card, error)); ok {
-		return rf(ctx, from, to)
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, time.Time, repository.Page) ([]entity.Card, error)); ok {
+		return rf(ctx, from, to, page)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, time.Time, time.Time) []entity.Card); ok {
-		r0 = rf(ctx, from, to)
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, time.Time, repository.Page) []entity.Card); ok {
+		r0 = rf(ctx, from, to, page)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]entity.Card)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, time.Time, time.Time) error); ok {
-		r1 = rf(ctx, from, to)
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time, time.Time, repository.Page) error); ok {
+		r1 = rf(ctx, from, to, page)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -162,6 +254,24 @@ func (_m *CardRepository) MoveCard(ctx context.Context, card *entity.Card) error
 	return r0
 }
 
+// SetCardParent provides a mock function with given fields: ctx, card
+func (_m *CardRepository) SetCardParent(ctx context.Context, card *entity.Card) error {
+	ret := _m.Called(ctx, card)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetCardParent")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.Card) error); ok {
+		r0 = rf(ctx, card)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // UpdateCard provides a mock function with given fields: ctx, card
 func (_m *CardRepository) UpdateCard(ctx context.Context, card *entity.Card) error {
 	ret := _m.Called(ctx, card)
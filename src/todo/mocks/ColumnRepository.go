@@ -8,6 +8,8 @@ import (
 
 	mock "github.com/stretchr/testify/mock"
 
+	repository "todo/internal/repository"
+
 	uuid "github.com/google/uuid"
 )
 
@@ -34,17 +36,17 @@ func (_m *ColumnRepository) CreateColumn(ctx context.Context, column *entity.Col
 	return r0
 }
 
-// DeleteColumn provides a mock function with given fields: ctx, id
-func (_m *ColumnRepository) DeleteColumn(ctx context.Context, id uuid.UUID) error {
-	ret := _m.Called(ctx, id)
+// DeleteColumn provides a mock function with given fields: ctx, id, version
+func (_m *ColumnRepository) DeleteColumn(ctx context.Context, id uuid.UUID, version int) error {
+	ret := _m.Called(ctx, id, version)
 
 	if len(ret) == 0 {
 		panic("no return value specified for DeleteColumn")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
-		r0 = rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int) error); ok {
+		r0 = rf(ctx, id, version)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -82,9 +84,9 @@ func (_m *ColumnRepository) GetColumnByID(ctx context.Context, id uuid.UUID) (*e
 	return r0, r1
 }
 
-// GetColumnsByBoard provides a mock function with given fields: ctx, boardID, limit, offset
-func (_m *ColumnRepository) GetColumnsByBoard(ctx context.Context, boardID uuid.UUID, limit int, offset int) ([]entity.Column, error) {
-	ret := _m.Called(ctx, boardID, limit, offset)
+// GetColumnsByBoard provides a mock function with given fields: ctx, boardID, page
+func (_m *ColumnRepository) GetColumnsByBoard(ctx context.Context, boardID uuid.UUID, page repository.Page) ([]entity.Column, error) {
+	ret := _m.Called(ctx, boardID, page)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetColumnsByBoard")
@@ -92,19 +94,19 @@ func (_m *ColumnRepository) GetColumnsByBoard(ctx context.Context, boardID uuid.
 
 	var r0 []entity.Column
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, int) ([]entity.Column, error)); ok {
-		return rf(ctx, boardID, limit, offset)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, repository.Page) ([]entity.Column, error)); ok {
+		return rf(ctx, boardID, page)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, int) []entity.Column); ok {
-		r0 = rf(ctx, boardID, limit, offset)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, repository.Page) []entity.Column); ok {
+		r0 = rf(ctx, boardID, page)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]entity.Column)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, int, int) error); ok {
-		r1 = rf(ctx, boardID, limit, offset)
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, repository.Page) error); ok {
+		r1 = rf(ctx, boardID, page)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -112,6 +114,42 @@ func (_m *ColumnRepository) GetColumnsByBoard(ctx context.Context, boardID uuid.
 	return r0, r1
 }
 
+// MergeColumn provides a mock function with given fields: ctx, source, targetID
+func (_m *ColumnRepository) MergeColumn(ctx context.Context, source *entity.Column, targetID uuid.UUID) error {
+	ret := _m.Called(ctx, source, targetID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MergeColumn")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.Column, uuid.UUID) error); ok {
+		r0 = rf(ctx, source, targetID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MoveColumn provides a mock function with given fields: ctx, column
+func (_m *ColumnRepository) MoveColumn(ctx context.Context, column *entity.Column) error {
+	ret := _m.Called(ctx, column)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MoveColumn")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.Column) error); ok {
+		r0 = rf(ctx, column)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // UpdateColumn provides a mock function with given fields: ctx, column
 func (_m *ColumnRepository) UpdateColumn(ctx context.Context, column *entity.Column) error {
 	ret := _m.Called(ctx, column)
@@ -8,6 +8,8 @@ import (
 
 	mock "github.com/stretchr/testify/mock"
 
+	repository "todo/internal/repository"
+
 	time "time"
 
 	uuid "github.com/google/uuid"
@@ -18,6 +20,36 @@ type TodoUseCase struct {
 	mock.Mock
 }
 
+// ApplyCardOps provides a mock function with given fields: ctx, ops, allOrNothing
+func (_m *TodoUseCase) ApplyCardOps(ctx context.Context, ops []repository.CardOp, allOrNothing bool) ([]repository.CardOpResult, error) {
+	ret := _m.Called(ctx, ops, allOrNothing)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ApplyCardOps")
+	}
+
+	var r0 []repository.CardOpResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []repository.CardOp, bool) ([]repository.CardOpResult, error)); ok {
+		return rf(ctx, ops, allOrNothing)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []repository.CardOp, bool) []repository.CardOpResult); ok {
+		r0 = rf(ctx, ops, allOrNothing)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]repository.CardOpResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []repository.CardOp, bool) error); ok {
+		r1 = rf(ctx, ops, allOrNothing)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CreateBoard provides a mock function with given fields: ctx, board
 func (_m *TodoUseCase) CreateBoard(ctx context.Context, board *entity.Board) error {
 	ret := _m.Called(ctx, board)
@@ -72,17 +104,35 @@ func (_m *TodoUseCase) CreateColumn(ctx context.Context, column *entity.Column)
 	return r0
 }
 
-// DeleteBoard provides a mock function with given fields: ctx, id
-func (_m *TodoUseCase) DeleteBoard(ctx context.Context, id uuid.UUID) error {
-	ret := _m.Called(ctx, id)
+// CreateSwimlane provides a mock function with given fields: ctx, swimlane
+func (_m *TodoUseCase) CreateSwimlane(ctx context.Context, swimlane *entity.Swimlane) error {
+	ret := _m.Called(ctx, swimlane)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateSwimlane")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.Swimlane) error); ok {
+		r0 = rf(ctx, swimlane)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteBoard provides a mock function with given fields: ctx, id, version
+func (_m *TodoUseCase) DeleteBoard(ctx context.Context, id uuid.UUID, version int) error {
+	ret := _m.Called(ctx, id, version)
 
 	if len(ret) == 0 {
 		panic("no return value specified for DeleteBoard")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
-		r0 = rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int) error); ok {
+		r0 = rf(ctx, id, version)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -90,17 +140,17 @@ func (_m *TodoUseCase) DeleteBoard(ctx context.Context, id uuid.UUID) error {
 	return r0
 }
 
-// DeleteCard provides a mock function with given fields: ctx, id
-func (_m *TodoUseCase) DeleteCard(ctx context.Context, id uuid.UUID) error {
-	ret := _m.Called(ctx, id)
+// DeleteCard provides a mock function with given fields: ctx, id, version
+func (_m *TodoUseCase) DeleteCard(ctx context.Context, id uuid.UUID, version int) error {
+	ret := _m.Called(ctx, id, version)
 
 	if len(ret) == 0 {
 		panic("no return value specified for DeleteCard")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
-		r0 = rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int) error); ok {
+		r0 = rf(ctx, id, version)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -108,17 +158,35 @@ func (_m *TodoUseCase) DeleteCard(ctx context.Context, id uuid.UUID) error {
 	return r0
 }
 
-// DeleteColumn provides a mock function with given fields: ctx, id
-func (_m *TodoUseCase) DeleteColumn(ctx context.Context, id uuid.UUID) error {
-	ret := _m.Called(ctx, id)
+// DeleteColumn provides a mock function with given fields: ctx, id, version
+func (_m *TodoUseCase) DeleteColumn(ctx context.Context, id uuid.UUID, version int) error {
+	ret := _m.Called(ctx, id, version)
 
 	if len(ret) == 0 {
 		panic("no return value specified for DeleteColumn")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
-		r0 = rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int) error); ok {
+		r0 = rf(ctx, id, version)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteSwimlane provides a mock function with given fields: ctx, id, version
+func (_m *TodoUseCase) DeleteSwimlane(ctx context.Context, id uuid.UUID, version int) error {
+	ret := _m.Called(ctx, id, version)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteSwimlane")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int) error); ok {
+		r0 = rf(ctx, id, version)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -156,29 +224,68 @@ func (_m *TodoUseCase) GetBoardByID(ctx context.Context, id uuid.UUID) (*entity.
 	return r0, r1
 }
 
-// GetBoardsByUser provides a mock function with given fields: ctx, userID, limit, offset
-func (_m *TodoUseCase) GetBoardsByUser(ctx context.Context, userID uuid.UUID, limit int, offset int) ([]entity.Board, error) {
-	ret := _m.Called(ctx, userID, limit, offset)
+// GetBoardsByUser provides a mock function with given fields: ctx, userID, page
+func (_m *TodoUseCase) GetBoardsByUser(ctx context.Context, userID uuid.UUID, page repository.Page) ([]entity.Board, *repository.Cursor, error) {
+	ret := _m.Called(ctx, userID, page)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetBoardsByUser")
 	}
 
 	var r0 []entity.Board
-	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, int) ([]entity.Board, error)); ok {
-		return rf(ctx, userID, limit, offset)
+	var r1 *repository.Cursor
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, repository.Page) ([]entity.Board, *repository.Cursor, error)); ok {
+		return rf(ctx, userID, page)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, int) []entity.Board); ok {
-		r0 = rf(ctx, userID, limit, offset)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, repository.Page) []entity.Board); ok {
+		r0 = rf(ctx, userID, page)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]entity.Board)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, int, int) error); ok {
-		r1 = rf(ctx, userID, limit, offset)
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, repository.Page) *repository.Cursor); ok {
+		r1 = rf(ctx, userID, page)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*repository.Cursor)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, uuid.UUID, repository.Page) error); ok {
+		r2 = rf(ctx, userID, page)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// GetCardAncestors provides a mock function with given fields: ctx, id
+func (_m *TodoUseCase) GetCardAncestors(ctx context.Context, id uuid.UUID) ([]entity.Card, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCardAncestors")
+	}
+
+	var r0 []entity.Card
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]entity.Card, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []entity.Card); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Card)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, id)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -216,34 +323,82 @@ func (_m *TodoUseCase) GetCardByID(ctx context.Context, id uuid.UUID) (*entity.C
 	return r0, r1
 }
 
-// GetCardsByColumn provides a mock function with given fields: ctx, columnID, limit, offset
-func (_m *TodoUseCase) GetCardsByColumn(ctx context.Context, columnID uuid.UUID, limit int, offset int) ([]entity.Card, error) {
-	ret := _m.Called(ctx, columnID, limit, offset)
+// GetCards provides a mock function with given fields: ctx, query
+func (_m *TodoUseCase) GetCards(ctx context.Context, query repository.CardQuery) ([]entity.Card, *repository.Cursor, error) {
+	ret := _m.Called(ctx, query)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCards")
+	}
+
+	var r0 []entity.Card
+	var r1 *repository.Cursor
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, repository.CardQuery) ([]entity.Card, *repository.Cursor, error)); ok {
+		return rf(ctx, query)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, repository.CardQuery) []entity.Card); ok {
+		r0 = rf(ctx, query)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Card)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, repository.CardQuery) *repository.Cursor); ok {
+		r1 = rf(ctx, query)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*repository.Cursor)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, repository.CardQuery) error); ok {
+		r2 = rf(ctx, query)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// GetCardsByColumn provides a mock function with given fields: ctx, columnID, page
+func (_m *TodoUseCase) GetCardsByColumn(ctx context.Context, columnID uuid.UUID, page repository.Page) ([]entity.Card, *repository.Cursor, error) {
+	ret := _m.Called(ctx, columnID, page)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetCardsByColumn")
 	}
 
 	var r0 []entity.Card
-	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, int) ([]entity.Card, error)); ok {
-		return rf(ctx, columnID, limit, offset)
+	var r1 *repository.Cursor
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, repository.Page) ([]entity.Card, *repository.Cursor, error)); ok {
+		return rf(ctx, columnID, page)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, int) []entity.Card); ok {
-		r0 = rf(ctx, columnID, limit, offset)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, repository.Page) []entity.Card); ok {
+		r0 = rf(ctx, columnID, page)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]entity.Card)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, int, int) error); ok {
-		r1 = rf(ctx, columnID, limit, offset)
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, repository.Page) *repository.Cursor); ok {
+		r1 = rf(ctx, columnID, page)
 	} else {
-		r1 = ret.Error(1)
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*repository.Cursor)
+		}
 	}
 
-	return r0, r1
+	if rf, ok := ret.Get(2).(func(context.Context, uuid.UUID, repository.Page) error); ok {
+		r2 = rf(ctx, columnID, page)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
 }
 
 // GetColumnByID provides a mock function with given fields: ctx, id
@@ -276,59 +431,107 @@ func (_m *TodoUseCase) GetColumnByID(ctx context.Context, id uuid.UUID) (*entity
 	return r0, r1
 }
 
-// GetColumnsByBoard provides a mock function with given fields: ctx, boardID, limit, offset
-func (_m *TodoUseCase) GetColumnsByBoard(ctx context.Context, boardID uuid.UUID, limit int, offset int) ([]entity.Column, error) {
-	ret := _m.Called(ctx, boardID, limit, offset)
+// GetColumnsByBoard provides a mock function with given fields: ctx, boardID, page
+func (_m *TodoUseCase) GetColumnsByBoard(ctx context.Context, boardID uuid.UUID, page repository.Page) ([]entity.Column, *repository.Cursor, error) {
+	ret := _m.Called(ctx, boardID, page)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetColumnsByBoard")
 	}
 
 	var r0 []entity.Column
-	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, int) ([]entity.Column, error)); ok {
-		return rf(ctx, boardID, limit, offset)
+	var r1 *repository.Cursor
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, repository.Page) ([]entity.Column, *repository.Cursor, error)); ok {
+		return rf(ctx, boardID, page)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, int) []entity.Column); ok {
-		r0 = rf(ctx, boardID, limit, offset)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, repository.Page) []entity.Column); ok {
+		r0 = rf(ctx, boardID, page)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]entity.Column)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, int, int) error); ok {
-		r1 = rf(ctx, boardID, limit, offset)
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, repository.Page) *repository.Cursor); ok {
+		r1 = rf(ctx, boardID, page)
 	} else {
-		r1 = ret.Error(1)
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*repository.Cursor)
+		}
 	}
 
-	return r0, r1
+	if rf, ok := ret.Get(2).(func(context.Context, uuid.UUID, repository.Page) error); ok {
+		r2 = rf(ctx, boardID, page)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
 }
 
-// GetNewCards provides a mock function with given fields: ctx, from, to
-func (_m *TodoUseCase) GetNewCards(ctx context.Context, from time.Time, to time.Time) ([]entity.Card, error) {
-	ret := _m.Called(ctx, from, to)
+// GetNewCards provides a mock function with given fields: ctx, from, to, page
+func (_m *TodoUseCase) GetNewCards(ctx context.Context, from time.Time, to time.Time, page repository.Page) ([]entity.Card, *repository.Cursor, error) {
+	ret := _m.Called(ctx, from, to, page)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetNewCards")
 	}
 
 	var r0 []entity.Card
-	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, time.Time, time.Time) ([]entity.Card, error)); ok {
-		return rf(ctx, from, to)
+	var r1 *repository.Cursor
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, time.Time, repository.Page) ([]entity.Card, *repository.Cursor, error)); ok {
+		return rf(ctx, from, to, page)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, time.Time, time.Time) []entity.Card); ok {
-		r0 = rf(ctx, from, to)
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, time.Time, repository.Page) []entity.Card); ok {
+		r0 = rf(ctx, from, to, page)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]entity.Card)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, time.Time, time.Time) error); ok {
-		r1 = rf(ctx, from, to)
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time, time.Time, repository.Page) *repository.Cursor); ok {
+		r1 = rf(ctx, from, to, page)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*repository.Cursor)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, time.Time, time.Time, repository.Page) error); ok {
+		r2 = rf(ctx, from, to, page)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// GetSwimlaneByID provides a mock function with given fields: ctx, id
+func (_m *TodoUseCase) GetSwimlaneByID(ctx context.Context, id uuid.UUID) (*entity.Swimlane, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSwimlaneByID")
+	}
+
+	var r0 *entity.Swimlane
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*entity.Swimlane, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *entity.Swimlane); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.Swimlane)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, id)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -336,6 +539,123 @@ func (_m *TodoUseCase) GetNewCards(ctx context.Context, from time.Time, to time.
 	return r0, r1
 }
 
+// GetSwimlanesByBoard provides a mock function with given fields: ctx, boardID, page
+func (_m *TodoUseCase) GetSwimlanesByBoard(ctx context.Context, boardID uuid.UUID, page repository.Page) ([]entity.Swimlane, *repository.Cursor, error) {
+	ret := _m.Called(ctx, boardID, page)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSwimlanesByBoard")
+	}
+
+	var r0 []entity.Swimlane
+	var r1 *repository.Cursor
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, repository.Page) ([]entity.Swimlane, *repository.Cursor, error)); ok {
+		return rf(ctx, boardID, page)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, repository.Page) []entity.Swimlane); ok {
+		r0 = rf(ctx, boardID, page)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Swimlane)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, repository.Page) *repository.Cursor); ok {
+		r1 = rf(ctx, boardID, page)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*repository.Cursor)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, uuid.UUID, repository.Page) error); ok {
+		r2 = rf(ctx, boardID, page)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MergeBoards provides a mock function with given fields: ctx, userID, sourceID, targetID, strategy
+func (_m *TodoUseCase) MergeBoards(ctx context.Context, userID uuid.UUID, sourceID uuid.UUID, targetID uuid.UUID, strategy string) (*entity.BoardMerge, error) {
+	ret := _m.Called(ctx, userID, sourceID, targetID, strategy)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MergeBoards")
+	}
+
+	var r0 *entity.BoardMerge
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, string) (*entity.BoardMerge, error)); ok {
+		return rf(ctx, userID, sourceID, targetID, strategy)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, string) *entity.BoardMerge); ok {
+		r0 = rf(ctx, userID, sourceID, targetID, strategy)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.BoardMerge)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, string) error); ok {
+		r1 = rf(ctx, userID, sourceID, targetID, strategy)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MoveColumn provides a mock function with given fields: ctx, userID, id, boardID, version
+func (_m *TodoUseCase) MoveColumn(ctx context.Context, userID uuid.UUID, id uuid.UUID, boardID uuid.UUID, version int) (*entity.Column, error) {
+	ret := _m.Called(ctx, userID, id, boardID, version)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MoveColumn")
+	}
+
+	var r0 *entity.Column
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, int) (*entity.Column, error)); ok {
+		return rf(ctx, userID, id, boardID, version)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, int) *entity.Column); ok {
+		r0 = rf(ctx, userID, id, boardID, version)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.Column)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, int) error); ok {
+		r1 = rf(ctx, userID, id, boardID, version)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SetCardParent provides a mock function with given fields: ctx, card
+func (_m *TodoUseCase) SetCardParent(ctx context.Context, card *entity.Card) error {
+	ret := _m.Called(ctx, card)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetCardParent")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.Card) error); ok {
+		r0 = rf(ctx, card)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // UpdateBoard provides a mock function with given fields: ctx, board
 func (_m *TodoUseCase) UpdateBoard(ctx context.Context, board *entity.Board) error {
 	ret := _m.Called(ctx, board)
@@ -390,6 +710,24 @@ func (_m *TodoUseCase) UpdateColumn(ctx context.Context, column *entity.Column)
 	return r0
 }
 
+// UpdateSwimlane provides a mock function with given fields: ctx, swimlane
+func (_m *TodoUseCase) UpdateSwimlane(ctx context.Context, swimlane *entity.Swimlane) error {
+	ret := _m.Called(ctx, swimlane)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateSwimlane")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.Swimlane) error); ok {
+		r0 = rf(ctx, swimlane)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // NewTodoUseCase creates a new instance of TodoUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewTodoUseCase(t interface {
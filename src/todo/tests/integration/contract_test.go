@@ -0,0 +1,35 @@
+package integration_test
+
+import (
+	"testing"
+	"todo/internal/repository/repotest"
+)
+
+// TestRepositoryContract runs the repository contract against every
+// backend, each test of it on an empty database.
+func TestRepositoryContract(t *testing.T) {
+	for _, b := range backends {
+		newRepos := func(t *testing.T) repotest.Repos {
+			ts := b.setup()
+			if err := b.reset(); err != nil {
+				t.Fatalf("Failed to reset %s database: %v", b.name, err)
+			}
+
+			return repotest.Repos{
+				Board:     ts.boardRepo,
+				Column:    ts.columnRepo,
+				Swimlane:  ts.swimlaneRepo,
+				Card:      ts.cardRepo,
+				Workspace: ts.workspaceRepo,
+				Quota:     ts.quotaRepo,
+			}
+		}
+
+		t.Run(b.name, func(t *testing.T) {
+			t.Run("Board", func(t *testing.T) { repotest.TestBoardRepository(t, newRepos) })
+			t.Run("Column", func(t *testing.T) { repotest.TestColumnRepository(t, newRepos) })
+			t.Run("Card", func(t *testing.T) { repotest.TestCardRepository(t, newRepos) })
+			t.Run("Quota", func(t *testing.T) { repotest.TestQuotaRepository(t, newRepos) })
+		})
+	}
+}
@@ -2,11 +2,19 @@ package integration_test
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"os"
+	"path/filepath"
 	"testing"
+	"time"
+	"todo/internal/adapter/database"
+	logger "todo/internal/adapter/logger"
+	memoryRepository "todo/internal/adapter/repository/memory"
+	mongoRepository "todo/internal/adapter/repository/mongo"
 	sqlxRepository "todo/internal/adapter/repository/sqlx"
+	"todo/internal/config"
 	"todo/internal/entity"
 	"todo/internal/repository"
 	"todo/internal/usecase"
@@ -20,36 +28,156 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/testcontainers/testcontainers-go"
 	"github.com/testcontainers/testcontainers-go/wait"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
 
 	_ "github.com/lib/pq"
 )
 
-var db *sqlx.DB
+var (
+	db       *sqlx.DB
+	sqliteDB *sqlx.DB
+	mongoDB  *mongo.Database
+)
 
 type testSetup struct {
-	ctx        context.Context
-	boardRepo  repository.BoardRepository
-	columnRepo repository.ColumnRepository
-	cardRepo   repository.CardRepository
-	uc         usecase.TodoUseCase
+	ctx           context.Context
+	boardRepo     repository.BoardRepository
+	columnRepo    repository.ColumnRepository
+	swimlaneRepo  repository.SwimlaneRepository
+	cardRepo      repository.CardRepository
+	workspaceRepo repository.WorkspaceRepository
+	activityRepo  repository.ActivityRepository
+	quotaRepo     repository.QuotaRepository
+	tx            repository.TxManager
+	uc            usecase.TodoUseCase
 }
 
 func sqlxSetup() *testSetup {
+	return sqlxSetupOn(db)
+}
+
+func sqliteSetup() *testSetup {
+	return sqlxSetupOn(sqliteDB)
+}
+
+func sqlxSetupOn(db *sqlx.DB) *testSetup {
 	ctx := context.TODO()
 	boardRepo := sqlxRepository.NewSQLXBoardRepository(db)
 	columnRepo := sqlxRepository.NewSQLXColumnRepository(db)
+	swimlaneRepo := sqlxRepository.NewSQLXSwimlaneRepository(db)
 	cardRepo := sqlxRepository.NewSQLXCardRepository(db)
-	uc := v1.NewTodoUseCase(boardRepo, columnRepo, cardRepo)
+	workspaceRepo := sqlxRepository.NewSQLXWorkspaceRepository(db)
+	tx := sqlxRepository.NewSQLXTxManager(db)
+	uc := v1.NewTodoUseCase(boardRepo, columnRepo, swimlaneRepo, cardRepo, workspaceRepo, tx, logger.NewEmptyLogger())
+
+	return &testSetup{
+		ctx:           ctx,
+		boardRepo:     boardRepo,
+		columnRepo:    columnRepo,
+		swimlaneRepo:  swimlaneRepo,
+		cardRepo:      cardRepo,
+		workspaceRepo: workspaceRepo,
+		activityRepo:  sqlxRepository.NewSQLXActivityRepository(db),
+		quotaRepo:     sqlxRepository.NewSQLXQuotaRepository(db),
+		tx:            tx,
+		uc:            uc,
+	}
+}
+
+func mongoSetup() *testSetup {
+	ctx := context.TODO()
+	boardRepo := mongoRepository.NewMongoBoardRepository(mongoDB)
+	columnRepo := mongoRepository.NewMongoColumnRepository(mongoDB)
+	swimlaneRepo := mongoRepository.NewMongoSwimlaneRepository(mongoDB)
+	cardRepo := mongoRepository.NewMongoCardRepository(mongoDB)
+	workspaceRepo := mongoRepository.NewMongoWorkspaceRepository(mongoDB)
+	tx := mongoRepository.NewMongoTxManager(mongoDB)
+	uc := v1.NewTodoUseCase(boardRepo, columnRepo, swimlaneRepo, cardRepo, workspaceRepo, tx, logger.NewEmptyLogger())
+
+	return &testSetup{
+		ctx:           ctx,
+		boardRepo:     boardRepo,
+		columnRepo:    columnRepo,
+		swimlaneRepo:  swimlaneRepo,
+		cardRepo:      cardRepo,
+		workspaceRepo: workspaceRepo,
+		activityRepo:  mongoRepository.NewMongoActivityRepository(mongoDB),
+		quotaRepo:     mongoRepository.NewMongoQuotaRepository(mongoDB),
+		tx:            tx,
+		uc:            uc,
+	}
+}
+
+func memorySetup() *testSetup {
+	ctx := context.TODO()
+	store := memoryRepository.NewStore()
+	boardRepo := memoryRepository.NewMemoryBoardRepository(store)
+	columnRepo := memoryRepository.NewMemoryColumnRepository(store)
+	swimlaneRepo := memoryRepository.NewMemorySwimlaneRepository(store)
+	cardRepo := memoryRepository.NewMemoryCardRepository(store)
+	workspaceRepo := memoryRepository.NewMemoryWorkspaceRepository(store)
+	tx := memoryRepository.NewStoreTxManager(store)
+	uc := v1.NewTodoUseCase(boardRepo, columnRepo, swimlaneRepo, cardRepo, workspaceRepo, tx, logger.NewEmptyLogger())
 
 	return &testSetup{
-		ctx:        ctx,
-		boardRepo:  boardRepo,
-		columnRepo: columnRepo,
-		cardRepo:   cardRepo,
-		uc:         uc,
+		ctx:           ctx,
+		boardRepo:     boardRepo,
+		columnRepo:    columnRepo,
+		swimlaneRepo:  swimlaneRepo,
+		cardRepo:      cardRepo,
+		workspaceRepo: workspaceRepo,
+		activityRepo:  memoryRepository.NewMemoryActivityRepository(store),
+		quotaRepo:     memoryRepository.NewMemoryQuotaRepository(store),
+		tx:            tx,
+		uc:            uc,
+	}
+}
+
+// backend is a storage backend the tests of the core repositories run
+// against. Time tracking, analytics, sprints and board marks are tested on
+// sqlx alone, the only backend that has them.
+type backend struct {
+	name  string
+	setup func() *testSetup
+	reset func() error
+}
+
+var backends = []backend{
+	{name: "sqlx", setup: sqlxSetup, reset: resetDatabase},
+	{name: "sqlite", setup: sqliteSetup, reset: resetSQLiteDatabase},
+	{name: "mongo", setup: mongoSetup, reset: resetMongoDatabase},
+	// Every setup makes a new, empty store.
+	{name: "memory", setup: memorySetup, reset: func() error { return nil }},
+}
+
+// forEachBackend runs the test against an empty database of every backend.
+func forEachBackend(t *testing.T, test func(t *testing.T, ts *testSetup)) {
+	for _, b := range backends {
+		t.Run(b.name, func(t *testing.T) {
+			ts := b.setup()
+			if err := b.reset(); err != nil {
+				log.Fatalf("Failed to reset %s database: %v", b.name, err)
+			}
+
+			test(t, ts)
+		})
 	}
 }
 
+// insertBoard stores the board in the personal workspace of its user
+// straight through the repository.
+func insertBoard(ts *testSetup, board *entity.Board) error {
+	workspace, err := ts.workspaceRepo.EnsurePersonalWorkspace(ts.ctx, board.UserID, time.Now())
+	if err != nil {
+		return err
+	}
+
+	board.WorkspaceID = workspace.ID
+
+	return ts.boardRepo.CreateBoard(ts.ctx, board)
+}
+
 func applyMigrations(dsn string) error {
 	driver, err := postgres.WithInstance(db.DB, &postgres.Config{})
 	if err != nil {
@@ -57,7 +185,7 @@ func applyMigrations(dsn string) error {
 	}
 
 	m, err := migrate.NewWithDatabaseInstance(
-		"file:///home/rukost/University/software-design-s6-bmstu.git/lab4/src/todo/migrations/sql",
+		"file://../../migrations/sql",
 		"nigger",
 		driver,
 	)
@@ -121,12 +249,90 @@ func TestMain(m *testing.M) {
 		log.Fatalf("Failed to apply migrations: %v", err)
 	}
 
+	sqliteDir, err := os.MkdirTemp("", "todo-sqlite")
+	if err != nil {
+		log.Fatalf("Failed to make SQLite directory: %v", err)
+	}
+	defer os.RemoveAll(sqliteDir)
+
+	sqliteDB, err = database.NewSQLiteDB(config.SQLiteConfig{Path: filepath.Join(sqliteDir, "todo.db")})
+	if err != nil {
+		log.Fatalf("Failed to open SQLite database: %v", err)
+	}
+
+	mongoReq := testcontainers.ContainerRequest{
+		Image:        "mongo:6-jammy",
+		ExposedPorts: []string{"27017/tcp"},
+		Cmd:          []string{"--replSet", "rs0", "--bind_ip_all"},
+		Env: map[string]string{
+			"TZ": "Europe/Moscow",
+		},
+		WaitingFor: wait.ForListeningPort("27017/tcp"),
+	}
+
+	mongoContainer, err := testcontainers.GenericContainer(ctx, testcontainers.GenericContainerRequest{
+		ContainerRequest: mongoReq,
+		Started:          true,
+	})
+	if err != nil {
+		log.Fatalf("Failed to start MongoDB container: %v", err)
+	}
+	defer mongoContainer.Terminate(ctx)
+
+	if err := initiateReplicaSet(ctx, mongoContainer); err != nil {
+		log.Fatalf("Failed to initiate MongoDB replica set: %v", err)
+	}
+
+	mongoHost, err := mongoContainer.Host(ctx)
+	if err != nil {
+		log.Fatalf("Failed to get container host: %v", err)
+	}
+
+	mongoPort, err := mongoContainer.MappedPort(ctx, "27017")
+	if err != nil {
+		log.Fatalf("Failed to get container port: %v", err)
+	}
+
+	// The member of the replica set is known by its name inside Docker, so
+	// the client talks to the mapped port directly instead.
+	uri := fmt.Sprintf("mongodb://%s:%s/?directConnection=true", mongoHost, mongoPort.Port())
+	client, err := mongo.Connect(ctx, options.Client().ApplyURI(uri))
+	if err != nil {
+		log.Fatalf("Failed to connect to MongoDB: %v", err)
+	}
+
+	mongoDB = client.Database("testdb")
+
 	code := m.Run()
 
 	db.Close()
+	sqliteDB.Close()
+	client.Disconnect(ctx)
 	os.Exit(code)
 }
 
+// initiateReplicaSet makes the Mongo server the primary of a replica set of
+// its own, which transactions need, and waits for it to take writes.
+func initiateReplicaSet(ctx context.Context, container testcontainers.Container) error {
+	code, _, err := container.Exec(ctx, []string{"mongosh", "--quiet", "--eval", "rs.initiate()"})
+	if err != nil {
+		return err
+	}
+	if code != 0 {
+		return fmt.Errorf("rs.initiate() exited with code %d", code)
+	}
+
+	for i := 0; i < 60; i++ {
+		code, _, err := container.Exec(ctx, []string{"mongosh", "--quiet", "--eval", "quit(db.hello().isWritablePrimary ? 0 : 1)"})
+		if err == nil && code == 0 {
+			return nil
+		}
+		time.Sleep(500 * time.Millisecond)
+	}
+
+	return errors.New("replica set has elected no primary")
+}
+
 // CreateBoard(ctx context.Context, board *entity.Board) error
 func resetDatabase() error {
 	_, err := db.Exec(`
@@ -142,13 +348,56 @@ func resetDatabase() error {
 		TRUNCATE TABLE cards RESTART IDENTITY CASCADE
 		`)
 	}
+	if err == nil {
+		_, err = db.Exec(`
+		TRUNCATE TABLE activities RESTART IDENTITY
+		`)
+	}
+	if err == nil {
+		_, err = db.Exec(`
+		TRUNCATE TABLE card_column_stays RESTART IDENTITY
+		`)
+	}
+	if err == nil {
+		_, err = db.Exec(`
+		TRUNCATE TABLE workspaces RESTART IDENTITY CASCADE
+		`)
+	}
+	if err == nil {
+		_, err = db.Exec(`
+		TRUNCATE TABLE quota_overrides
+		`)
+	}
 	return err
 }
 
+// resetSQLiteDatabase empties the tables resetDatabase does; deleting the
+// workspaces cascades to their boards and the rest.
+func resetSQLiteDatabase() error {
+	for _, table := range []string{"workspaces", "activities", "card_column_stays", "quota_overrides", "sqlite_sequence"} {
+		if _, err := sqliteDB.Exec(`DELETE FROM ` + table); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func resetMongoDatabase() error {
+	ctx := context.TODO()
+
+	if err := mongoDB.Drop(ctx); err != nil {
+		return err
+	}
+
+	return mongoRepository.CreateIndexes(ctx, mongoDB)
+}
+
 func TestCreate(t *testing.T) {
-	ts := sqlxSetup()
-	resetDatabase()
+	forEachBackend(t, testCreate)
+}
 
+func testCreate(t *testing.T, ts *testSetup) {
 	userID := uuid.New()
 
 	board := entity.Board{
@@ -162,15 +411,14 @@ func TestCreate(t *testing.T) {
 		log.Fatalf("Failed to execute CreateBoard usecase: %v", err)
 	}
 
-	var createdBoard repository.Board
-	err = db.GetContext(ts.ctx, &createdBoard, `
-		SELECT * FROM boards WHERE user_id = $1
-	`, userID)
+	boards, err := ts.boardRepo.GetBoardsByUser(ts.ctx, userID, repository.Page{Limit: 1})
 
-	if err != nil {
+	if err != nil || len(boards) == 0 {
 		log.Fatalf("Failed to select created board: %v", err)
 	}
 
+	createdBoard := boards[0]
+
 	assert.Equal(t, board.UserID, createdBoard.UserID)
 	assert.Equal(t, board.Title, createdBoard.Title)
 
@@ -189,15 +437,14 @@ func TestCreate(t *testing.T) {
 		log.Fatalf("Failed to execute CreateColumn usecase: %v", err)
 	}
 
-	var createdColumn repository.Column
-	err = db.GetContext(ts.ctx, &createdColumn, `
-		SELECT * FROM columns WHERE user_id = $1
-	`, userID)
+	columns, err := ts.columnRepo.GetColumnsByBoard(ts.ctx, boardID, repository.Page{Limit: 1})
 
-	if err != nil {
+	if err != nil || len(columns) == 0 {
 		log.Fatalf("Failed to select created column: %v", err)
 	}
 
+	createdColumn := columns[0]
+
 	assert.Equal(t, column.UserID, createdColumn.UserID)
 	assert.Equal(t, column.BoardID, createdColumn.BoardID)
 	assert.Equal(t, column.Title, createdColumn.Title)
@@ -217,15 +464,14 @@ func TestCreate(t *testing.T) {
 		log.Fatalf("Failed to execute CreateCard usecase: %v", err)
 	}
 
-	var createdCard repository.Card
-	err = db.GetContext(ts.ctx, &createdCard, `
-		SELECT * FROM cards WHERE user_id = $1
-	`, userID)
+	cards, err := ts.cardRepo.GetCardsByColumn(ts.ctx, columnID, repository.Page{Limit: 1})
 
-	if err != nil {
+	if err != nil || len(cards) == 0 {
 		log.Fatalf("Failed to select created card: %v", err)
 	}
 
+	createdCard := cards[0]
+
 	assert.Equal(t, card.UserID, createdCard.UserID)
 	assert.Equal(t, card.ColumnID, createdCard.ColumnID)
 	assert.Equal(t, card.Title, createdCard.Title)
@@ -233,20 +479,18 @@ func TestCreate(t *testing.T) {
 
 // GetBoardByID(ctx context.Context, id uuid.UUID) (*entity.Board, error)
 func TestGetByID(t *testing.T) {
-	ts := sqlxSetup()
-	resetDatabase()
+	forEachBackend(t, testGetByID)
+}
 
+func testGetByID(t *testing.T, ts *testSetup) {
 	boardID := uuid.New()
-	board := repository.Board{
-		ID:     boardID,
-		UserID: uuid.New(),
-		Title:  "Board Title",
+	board := entity.Board{
+		ID:      boardID,
+		UserID:  uuid.New(),
+		Title:   "Board Title",
+		Version: 1,
 	}
-	query := `
-		INSERT INTO boards (id, user_id, title)
-		VALUES (:id, :user_id, :title)
-	`
-	_, err := db.NamedExecContext(ts.ctx, query, &board)
+	err := insertBoard(ts, &board)
 
 	if err != nil {
 		log.Fatalf("Failed to insert into boards: %v", err)
@@ -267,26 +511,24 @@ func TestGetByID(t *testing.T) {
 
 // UpdateBoard(ctx context.Context, board *entity.Board) error
 func TestUpdate(t *testing.T) {
-	ts := sqlxSetup()
-	resetDatabase()
+	forEachBackend(t, testUpdate)
+}
 
+func testUpdate(t *testing.T, ts *testSetup) {
 	boardID := uuid.New()
-	board := repository.Board{
-		ID:     boardID,
-		UserID: uuid.New(),
-		Title:  "Board Title",
+	board := entity.Board{
+		ID:      boardID,
+		UserID:  uuid.New(),
+		Title:   "Board Title",
+		Version: 1,
 	}
-	query := `
-		INSERT INTO boards (id, user_id, title)
-		VALUES (:id, :user_id, :title)
-	`
-	_, err := db.NamedExecContext(ts.ctx, query, &board)
+	err := insertBoard(ts, &board)
 
 	if err != nil {
 		log.Fatalf("Failed to insert into boards: %v", err)
 	}
 
-	newBoard := repository.BoardToEntity(board)
+	newBoard := board
 	newBoard.Title = "New Board Title"
 
 	err = ts.uc.UpdateBoard(ts.ctx, &newBoard)
@@ -295,54 +537,1054 @@ func TestUpdate(t *testing.T) {
 		log.Fatalf("Failed to execute UpdateBoard usecase: %v", err)
 	}
 
-	var updatedBoard repository.Board
-
-	err = db.GetContext(ts.ctx, &updatedBoard, `
-		SELECT * FROM boards WHERE id = $1
-	`, boardID)
+	updatedBoard, err := ts.boardRepo.GetBoardByID(ts.ctx, boardID)
 
 	if err != nil {
 		log.Fatalf("Failed to select updated board: %v", err)
 	}
 
 	assert.Equal(t, newBoard.Title, updatedBoard.Title)
+	assert.Equal(t, 2, updatedBoard.Version)
 
 	// TODO: Columns, cards
 }
 
-// DeleteBoard(ctx context.Context, id uuid.UUID) error
+// DeleteBoard(ctx context.Context, id uuid.UUID, version int) error
 func TestDelete(t *testing.T) {
-	ts := sqlxSetup()
-	resetDatabase()
+	forEachBackend(t, testDelete)
+}
 
+func testDelete(t *testing.T, ts *testSetup) {
 	boardID := uuid.New()
-	board := repository.Board{
-		ID:     boardID,
-		UserID: uuid.New(),
-		Title:  "Board Title",
+	board := entity.Board{
+		ID:      boardID,
+		UserID:  uuid.New(),
+		Title:   "Board Title",
+		Version: 1,
 	}
-	query := `
-		INSERT INTO boards (id, user_id, title)
-		VALUES (:id, :user_id, :title)
-	`
-	_, err := db.NamedExecContext(ts.ctx, query, &board)
+	err := insertBoard(ts, &board)
 
 	if err != nil {
 		log.Fatalf("Failed to insert into boards: %v", err)
 	}
 
-	err = ts.uc.DeleteBoard(ts.ctx, boardID)
+	err = ts.uc.DeleteBoard(ts.ctx, boardID, 1)
 
 	if err != nil {
 		log.Fatalf("Failed to execute DeleteBoard usecase: %v", err)
 	}
 
-	var tmp repository.Board
-	err = db.GetContext(ts.ctx, &tmp, `
-		SELECT * FROM boards WHERE id = $1
-	`, boardID)
+	_, err = ts.boardRepo.GetBoardByID(ts.ctx, boardID)
 
 	assert.NotNil(t, err)
 
 	// TODO: Columns, cards
 }
+
+// ApplyCardOps(ctx context.Context, ops []repository.CardOp, allOrNothing bool) ([]repository.CardOpResult, error)
+func TestApplyCardOps(t *testing.T) {
+	forEachBackend(t, testApplyCardOps)
+}
+
+func testApplyCardOps(t *testing.T, ts *testSetup) {
+	userID := uuid.New()
+
+	board := entity.Board{UserID: userID, Title: "Board Title"}
+	if err := ts.uc.CreateBoard(ts.ctx, &board); err != nil {
+		log.Fatalf("Failed to execute CreateBoard usecase: %v", err)
+	}
+
+	column := entity.Column{UserID: userID, BoardID: board.ID, Title: "Column Title"}
+	if err := ts.uc.CreateColumn(ts.ctx, &column); err != nil {
+		log.Fatalf("Failed to execute CreateColumn usecase: %v", err)
+	}
+
+	card := entity.Card{UserID: userID, ColumnID: column.ID, Title: "Card Title"}
+	if err := ts.uc.CreateCard(ts.ctx, &card); err != nil {
+		log.Fatalf("Failed to execute CreateCard usecase: %v", err)
+	}
+
+	ops := []repository.CardOp{
+		{Kind: repository.CardOpArchive, CardID: card.ID, Version: 1},
+		{Kind: repository.CardOpDelete, CardID: uuid.New(), Version: 1},
+	}
+
+	results, err := ts.uc.ApplyCardOps(ts.ctx, ops, true)
+
+	if err != nil {
+		log.Fatalf("Failed to execute ApplyCardOps usecase: %v", err)
+	}
+
+	assert.Equal(t, repository.CardOpRolledBack, results[0].Status)
+	assert.Equal(t, repository.CardOpFailed, results[1].Status)
+	assert.ErrorIs(t, results[1].Err, repository.ErrCardNotFound)
+
+	stored, err := ts.cardRepo.GetCardByID(ts.ctx, card.ID)
+
+	if err != nil {
+		log.Fatalf("Failed to select card: %v", err)
+	}
+
+	assert.Nil(t, stored.ArchivedAt)
+	assert.Equal(t, 1, stored.Version)
+
+	results, err = ts.uc.ApplyCardOps(ts.ctx, ops, false)
+
+	if err != nil {
+		log.Fatalf("Failed to execute ApplyCardOps usecase: %v", err)
+	}
+
+	assert.Equal(t, repository.CardOpApplied, results[0].Status)
+	assert.Equal(t, repository.CardOpFailed, results[1].Status)
+
+	stored, err = ts.cardRepo.GetCardByID(ts.ctx, card.ID)
+
+	if err != nil {
+		log.Fatalf("Failed to select card: %v", err)
+	}
+
+	assert.NotNil(t, stored.ArchivedAt)
+	assert.Equal(t, 2, stored.Version)
+}
+
+// WithinTx(ctx context.Context, fn func(ctx context.Context) error) error
+func TestWithinTx(t *testing.T) {
+	forEachBackend(t, testWithinTx)
+}
+
+func testWithinTx(t *testing.T, ts *testSetup) {
+	txManager := ts.tx
+	userID := uuid.New()
+
+	workspace, err := ts.workspaceRepo.EnsurePersonalWorkspace(ts.ctx, userID, time.Now())
+	if err != nil {
+		log.Fatalf("Failed to ensure personal workspace: %v", err)
+	}
+
+	board := entity.Board{ID: uuid.New(), UserID: userID, WorkspaceID: workspace.ID, Title: "Board Title", Version: 1}
+	column := entity.Column{ID: uuid.New(), UserID: userID, BoardID: board.ID, Title: "Column Title", Version: 1}
+
+	errAbort := errors.New("abort")
+	err = txManager.WithinTx(ts.ctx, func(ctx context.Context) error {
+		if err := ts.boardRepo.CreateBoard(ctx, &board); err != nil {
+			return err
+		}
+		if err := ts.columnRepo.CreateColumn(ctx, &column); err != nil {
+			return err
+		}
+		return errAbort
+	})
+
+	assert.ErrorIs(t, err, errAbort)
+
+	boards, err := ts.boardRepo.GetBoardsByUser(ts.ctx, userID, repository.Page{Limit: 10})
+	if err != nil {
+		log.Fatalf("Failed to count boards: %v", err)
+	}
+	assert.Empty(t, boards)
+
+	err = txManager.WithinTx(ts.ctx, func(ctx context.Context) error {
+		if err := ts.boardRepo.CreateBoard(ctx, &board); err != nil {
+			return err
+		}
+		return ts.columnRepo.CreateColumn(ctx, &column)
+	})
+
+	assert.NoError(t, err)
+
+	columns, err := ts.columnRepo.GetColumnsByBoard(ts.ctx, board.ID, repository.Page{Limit: 10})
+	if err != nil {
+		log.Fatalf("Failed to count columns: %v", err)
+	}
+	assert.Len(t, columns, 1)
+}
+
+// AddActivity(ctx context.Context, activity *entity.Activity) error
+// GetActivities(ctx context.Context, boardID uuid.UUID, afterID int64, limit int) ([]entity.Activity, error)
+func TestActivities(t *testing.T) {
+	forEachBackend(t, testActivities)
+}
+
+func testActivities(t *testing.T, ts *testSetup) {
+	activityRepo := ts.activityRepo
+	boardID := uuid.New()
+
+	first := entity.Activity{BoardID: boardID, Kind: entity.ActivityBoardCreated, Board: &entity.Board{ID: boardID, Title: "Board Title"}}
+	second := entity.Activity{BoardID: boardID, Kind: entity.ActivityBoardUpdated, Board: &entity.Board{ID: boardID, Title: "New Title"}}
+
+	for _, a := range []*entity.Activity{&first, &second} {
+		if err := activityRepo.AddActivity(ts.ctx, a); err != nil {
+			log.Fatalf("Failed to add activity: %v", err)
+		}
+	}
+
+	assert.Less(t, first.ID, second.ID)
+
+	activities, err := activityRepo.GetActivities(ts.ctx, boardID, first.ID, 10)
+
+	if err != nil {
+		log.Fatalf("Failed to get activities: %v", err)
+	}
+
+	assert.Len(t, activities, 1)
+	assert.Equal(t, entity.ActivityBoardUpdated, activities[0].Kind)
+	assert.Equal(t, "New Title", activities[0].Board.Title)
+}
+
+func TestSwimlanes(t *testing.T) {
+	forEachBackend(t, testSwimlanes)
+}
+
+func testSwimlanes(t *testing.T, ts *testSetup) {
+	userID := uuid.New()
+
+	board := entity.Board{UserID: userID, Title: "Board Title"}
+	if err := ts.uc.CreateBoard(ts.ctx, &board); err != nil {
+		log.Fatalf("Failed to execute CreateBoard usecase: %v", err)
+	}
+
+	swimlanes, _, err := ts.uc.GetSwimlanesByBoard(ts.ctx, board.ID, repository.Page{Limit: 10})
+	if err != nil {
+		log.Fatalf("Failed to execute GetSwimlanesByBoard usecase: %v", err)
+	}
+
+	assert.Len(t, swimlanes, 1)
+	assert.Equal(t, repository.DefaultSwimlaneTitle, swimlanes[0].Title)
+	defaultLane := swimlanes[0]
+
+	column := entity.Column{UserID: userID, BoardID: board.ID, Title: "Column Title"}
+	if err := ts.uc.CreateColumn(ts.ctx, &column); err != nil {
+		log.Fatalf("Failed to execute CreateColumn usecase: %v", err)
+	}
+
+	card := entity.Card{UserID: userID, ColumnID: column.ID, Title: "Card Title"}
+	if err := ts.uc.CreateCard(ts.ctx, &card); err != nil {
+		log.Fatalf("Failed to execute CreateCard usecase: %v", err)
+	}
+
+	assert.Equal(t, defaultLane.ID, card.SwimlaneID)
+
+	lane := entity.Swimlane{UserID: userID, BoardID: board.ID, Title: "Lane Title", Position: 1}
+	if err := ts.uc.CreateSwimlane(ts.ctx, &lane); err != nil {
+		log.Fatalf("Failed to execute CreateSwimlane usecase: %v", err)
+	}
+
+	move := entity.Card{ID: card.ID, SwimlaneID: lane.ID, Title: card.Title, Version: card.Version}
+	if err := ts.uc.UpdateCard(ts.ctx, &move); err != nil {
+		log.Fatalf("Failed to execute UpdateCard usecase: %v", err)
+	}
+
+	cards, _, err := ts.uc.GetCards(ts.ctx, repository.CardQuery{SwimlaneID: &lane.ID, Page: repository.Page{Limit: 10}})
+	if err != nil {
+		log.Fatalf("Failed to execute GetCards usecase: %v", err)
+	}
+
+	assert.Len(t, cards, 1)
+	assert.Equal(t, column.ID, cards[0].ColumnID)
+
+	// A card moved to another board leaves its swimlane for the first one
+	// there.
+	other := entity.Board{UserID: userID, Title: "Other Board"}
+	if err := ts.uc.CreateBoard(ts.ctx, &other); err != nil {
+		log.Fatalf("Failed to execute CreateBoard usecase: %v", err)
+	}
+
+	otherColumn := entity.Column{UserID: userID, BoardID: other.ID, Title: "Column Title"}
+	if err := ts.uc.CreateColumn(ts.ctx, &otherColumn); err != nil {
+		log.Fatalf("Failed to execute CreateColumn usecase: %v", err)
+	}
+
+	move = entity.Card{ID: card.ID, ColumnID: otherColumn.ID, Title: card.Title, Version: move.Version}
+	if err := ts.uc.UpdateCard(ts.ctx, &move); err != nil {
+		log.Fatalf("Failed to execute UpdateCard usecase: %v", err)
+	}
+
+	otherLanes, _, err := ts.uc.GetSwimlanesByBoard(ts.ctx, other.ID, repository.Page{Limit: 10})
+	if err != nil {
+		log.Fatalf("Failed to execute GetSwimlanesByBoard usecase: %v", err)
+	}
+
+	moved, err := ts.uc.GetCardByID(ts.ctx, card.ID)
+	if err != nil {
+		log.Fatalf("Failed to execute GetCardByID usecase: %v", err)
+	}
+
+	assert.Equal(t, otherLanes[0].ID, moved.SwimlaneID)
+
+	move = entity.Card{ID: card.ID, SwimlaneID: lane.ID, Title: card.Title, Version: moved.Version}
+	err = ts.uc.UpdateCard(ts.ctx, &move)
+	assert.ErrorIs(t, err, v1.ErrCardSwimlaneBoard)
+
+	if err := ts.uc.DeleteSwimlane(ts.ctx, lane.ID, lane.Version); err != nil {
+		log.Fatalf("Failed to execute DeleteSwimlane usecase: %v", err)
+	}
+
+	err = ts.uc.DeleteSwimlane(ts.ctx, defaultLane.ID, defaultLane.Version)
+	assert.ErrorIs(t, err, repository.ErrSwimlaneLast)
+}
+
+func TestCardHierarchy(t *testing.T) {
+	forEachBackend(t, testCardHierarchy)
+}
+
+func testCardHierarchy(t *testing.T, ts *testSetup) {
+	userID := uuid.New()
+
+	board := entity.Board{UserID: userID, Title: "Board Title"}
+	if err := ts.uc.CreateBoard(ts.ctx, &board); err != nil {
+		log.Fatalf("Failed to execute CreateBoard usecase: %v", err)
+	}
+
+	todo := entity.Column{UserID: userID, BoardID: board.ID, Title: "To Do"}
+	if err := ts.uc.CreateColumn(ts.ctx, &todo); err != nil {
+		log.Fatalf("Failed to execute CreateColumn usecase: %v", err)
+	}
+
+	done := entity.Column{UserID: userID, BoardID: board.ID, Title: "Done", Position: 1, Done: true}
+	if err := ts.uc.CreateColumn(ts.ctx, &done); err != nil {
+		log.Fatalf("Failed to execute CreateColumn usecase: %v", err)
+	}
+
+	epic := entity.Card{UserID: userID, ColumnID: todo.ID, Title: "Epic"}
+	if err := ts.uc.CreateCard(ts.ctx, &epic); err != nil {
+		log.Fatalf("Failed to execute CreateCard usecase: %v", err)
+	}
+
+	task := entity.Card{UserID: userID, ColumnID: todo.ID, ParentID: epic.ID, Title: "Task"}
+	if err := ts.uc.CreateCard(ts.ctx, &task); err != nil {
+		log.Fatalf("Failed to execute CreateCard usecase: %v", err)
+	}
+
+	finished := entity.Card{UserID: userID, ColumnID: done.ID, ParentID: epic.ID, Title: "Finished Task"}
+	if err := ts.uc.CreateCard(ts.ctx, &finished); err != nil {
+		log.Fatalf("Failed to execute CreateCard usecase: %v", err)
+	}
+
+	subtask := entity.Card{UserID: userID, ColumnID: todo.ID, Title: "Subtask"}
+	if err := ts.uc.CreateCard(ts.ctx, &subtask); err != nil {
+		log.Fatalf("Failed to execute CreateCard usecase: %v", err)
+	}
+
+	subtask.ParentID = task.ID
+	if err := ts.uc.SetCardParent(ts.ctx, &subtask); err != nil {
+		log.Fatalf("Failed to execute SetCardParent usecase: %v", err)
+	}
+
+	stored, err := ts.uc.GetCardByID(ts.ctx, epic.ID)
+	if err != nil {
+		log.Fatalf("Failed to execute GetCardByID usecase: %v", err)
+	}
+
+	assert.Equal(t, 2, stored.ChildCount)
+	assert.Equal(t, 1, stored.DoneChildCount)
+
+	children, _, err := ts.uc.GetCards(ts.ctx, repository.CardQuery{ParentID: &epic.ID, Page: repository.Page{Limit: 10}})
+	if err != nil {
+		log.Fatalf("Failed to execute GetCards usecase: %v", err)
+	}
+
+	assert.Len(t, children, 2)
+
+	ancestors, err := ts.uc.GetCardAncestors(ts.ctx, subtask.ID)
+	if err != nil {
+		log.Fatalf("Failed to execute GetCardAncestors usecase: %v", err)
+	}
+
+	assert.Len(t, ancestors, 2)
+	assert.Equal(t, task.ID, ancestors[0].ID)
+	assert.Equal(t, epic.ID, ancestors[1].ID)
+
+	stored.ParentID = subtask.ID
+	err = ts.uc.SetCardParent(ts.ctx, stored)
+	assert.ErrorIs(t, err, repository.ErrCardParentCycle)
+
+	results, err := ts.uc.ApplyCardOps(ts.ctx, []repository.CardOp{{Kind: repository.CardOpArchive, CardID: epic.ID, Version: stored.Version}}, false)
+	if err != nil {
+		log.Fatalf("Failed to execute ApplyCardOps usecase: %v", err)
+	}
+
+	assert.ErrorIs(t, results[0].Err, repository.ErrCardHasChildren)
+
+	cascade := true
+	results, err = ts.uc.ApplyCardOps(ts.ctx, []repository.CardOp{{Kind: repository.CardOpArchive, CardID: epic.ID, Version: stored.Version, Cascade: &cascade}}, false)
+	if err != nil {
+		log.Fatalf("Failed to execute ApplyCardOps usecase: %v", err)
+	}
+
+	assert.Equal(t, repository.CardOpApplied, results[0].Status)
+
+	archived, err := ts.uc.GetCardByID(ts.ctx, subtask.ID)
+	if err != nil {
+		log.Fatalf("Failed to execute GetCardByID usecase: %v", err)
+	}
+
+	assert.NotNil(t, archived.ArchivedAt)
+}
+
+func TestTimeEntries(t *testing.T) {
+	ts := sqlxSetup()
+	resetDatabase()
+
+	timeUC := v1.NewTimeUseCase(sqlxRepository.NewSQLXTimeEntryRepository(db), ts.cardRepo, ts.columnRepo, ts.boardRepo,
+		ts.workspaceRepo, sqlxRepository.NewSQLXTxManager(db), logger.NewEmptyLogger())
+
+	userID := uuid.New()
+	ctx := usecase.WithCaller(ts.ctx, usecase.Caller{UserID: userID})
+
+	board := entity.Board{UserID: userID, Title: "Board Title"}
+	if err := ts.uc.CreateBoard(ts.ctx, &board); err != nil {
+		log.Fatalf("Failed to execute CreateBoard usecase: %v", err)
+	}
+
+	column := entity.Column{UserID: userID, BoardID: board.ID, Title: "To Do"}
+	if err := ts.uc.CreateColumn(ts.ctx, &column); err != nil {
+		log.Fatalf("Failed to execute CreateColumn usecase: %v", err)
+	}
+
+	card := entity.Card{UserID: userID, ColumnID: column.ID, Title: "Card Title"}
+	if err := ts.uc.CreateCard(ts.ctx, &card); err != nil {
+		log.Fatalf("Failed to execute CreateCard usecase: %v", err)
+	}
+
+	if err := timeUC.StartTimer(ctx, &entity.TimeEntry{UserID: userID, CardID: card.ID}); err != nil {
+		log.Fatalf("Failed to execute StartTimer usecase: %v", err)
+	}
+
+	err := timeUC.StartTimer(ctx, &entity.TimeEntry{UserID: userID, CardID: card.ID})
+	assert.ErrorIs(t, err, repository.ErrTimerRunning)
+
+	stopped, err := timeUC.StopTimer(ts.ctx, userID)
+	if err != nil {
+		log.Fatalf("Failed to execute StopTimer usecase: %v", err)
+	}
+
+	assert.NotNil(t, stopped.EndedAt)
+
+	_, err = timeUC.StopTimer(ts.ctx, userID)
+	assert.ErrorIs(t, err, repository.ErrNoTimerRunning)
+
+	start := time.Now().Add(-3 * time.Hour).Truncate(time.Second)
+	end := start.Add(90 * time.Minute)
+	if err := timeUC.LogTime(ctx, &entity.TimeEntry{UserID: userID, CardID: card.ID, StartedAt: start, EndedAt: &end, Note: "Review"}); err != nil {
+		log.Fatalf("Failed to execute LogTime usecase: %v", err)
+	}
+
+	entries, err := timeUC.GetCardTimeEntries(ctx, card.ID)
+	if err != nil {
+		log.Fatalf("Failed to execute GetCardTimeEntries usecase: %v", err)
+	}
+
+	assert.Len(t, entries, 2)
+	assert.Equal(t, "Review", entries[0].Note)
+
+	rows, err := timeUC.GetTimeReport(ctx, repository.TimeReportQuery{
+		BoardID: &board.ID,
+		From:    start.Add(-time.Hour),
+		To:      time.Now().Add(time.Hour),
+	})
+	if err != nil {
+		log.Fatalf("Failed to execute GetTimeReport usecase: %v", err)
+	}
+
+	var total time.Duration
+	for _, row := range rows {
+		assert.Equal(t, card.ID, row.CardID)
+		assert.Equal(t, "Card Title", row.CardTitle)
+		total += row.Duration
+	}
+
+	assert.GreaterOrEqual(t, total, 90*time.Minute)
+
+	stranger := usecase.WithCaller(ts.ctx, usecase.Caller{UserID: uuid.New()})
+
+	_, err = timeUC.GetCardTimeEntries(stranger, card.ID)
+	assert.ErrorIs(t, err, repository.ErrBoardAccess)
+}
+
+func TestBoardAnalytics(t *testing.T) {
+	ts := sqlxSetup()
+	resetDatabase()
+
+	analyticsUC := v1.NewAnalyticsUseCase(sqlxRepository.NewSQLXCardFlowRepository(db), ts.boardRepo,
+		ts.workspaceRepo, logger.NewEmptyLogger())
+
+	userID := uuid.New()
+	ctx := usecase.WithCaller(ts.ctx, usecase.Caller{UserID: userID})
+
+	board := entity.Board{UserID: userID, Title: "Board Title"}
+	if err := ts.uc.CreateBoard(ts.ctx, &board); err != nil {
+		log.Fatalf("Failed to execute CreateBoard usecase: %v", err)
+	}
+
+	todo := entity.Column{UserID: userID, BoardID: board.ID, Title: "To Do"}
+	if err := ts.uc.CreateColumn(ts.ctx, &todo); err != nil {
+		log.Fatalf("Failed to execute CreateColumn usecase: %v", err)
+	}
+
+	done := entity.Column{UserID: userID, BoardID: board.ID, Title: "Done", Done: true}
+	if err := ts.uc.CreateColumn(ts.ctx, &done); err != nil {
+		log.Fatalf("Failed to execute CreateColumn usecase: %v", err)
+	}
+
+	moved := entity.Card{UserID: userID, ColumnID: todo.ID, Title: "Moved"}
+	if err := ts.uc.CreateCard(ts.ctx, &moved); err != nil {
+		log.Fatalf("Failed to execute CreateCard usecase: %v", err)
+	}
+
+	waiting := entity.Card{UserID: userID, ColumnID: todo.ID, Title: "Waiting"}
+	if err := ts.uc.CreateCard(ts.ctx, &waiting); err != nil {
+		log.Fatalf("Failed to execute CreateCard usecase: %v", err)
+	}
+
+	moved.ColumnID = done.ID
+	if err := ts.uc.UpdateCard(ts.ctx, &moved); err != nil {
+		log.Fatalf("Failed to execute UpdateCard usecase: %v", err)
+	}
+
+	from := time.Now().UTC().Truncate(24*time.Hour).AddDate(0, 0, -1)
+	to := from.AddDate(0, 0, 3)
+
+	analytics, err := analyticsUC.GetBoardAnalytics(ctx, board.ID, from, to)
+	if err != nil {
+		log.Fatalf("Failed to execute GetBoardAnalytics usecase: %v", err)
+	}
+
+	assert.Equal(t, 1, analytics.LeadTime.Cards)
+	assert.Equal(t, 1, analytics.CycleTime.Cards)
+	assert.Len(t, analytics.Days, 3)
+
+	if assert.Len(t, analytics.Flow, 2) {
+		assert.Equal(t, todo.ID, analytics.Flow[0].ColumnID)
+		assert.Equal(t, []int{0, 1, 1}, analytics.Flow[0].Counts)
+		assert.Equal(t, done.ID, analytics.Flow[1].ColumnID)
+		assert.Equal(t, []int{0, 1, 1}, analytics.Flow[1].Counts)
+	}
+
+	stranger := usecase.WithCaller(ts.ctx, usecase.Caller{UserID: uuid.New()})
+
+	_, err = analyticsUC.GetBoardAnalytics(stranger, board.ID, from, to)
+	assert.ErrorIs(t, err, repository.ErrBoardAccess)
+}
+
+func TestBoardStats(t *testing.T) {
+	ts := sqlxSetup()
+	resetDatabase()
+
+	statsUC := v1.NewBoardStatsUseCase(sqlxRepository.NewSQLXBoardStatsRepository(db), ts.boardRepo, ts.workspaceRepo,
+		logger.NewEmptyLogger())
+
+	userID := uuid.New()
+	assigneeID := uuid.New()
+
+	board := entity.Board{UserID: userID, Title: "Board Title"}
+	if err := ts.uc.CreateBoard(ts.ctx, &board); err != nil {
+		log.Fatalf("Failed to execute CreateBoard usecase: %v", err)
+	}
+
+	todo := entity.Column{UserID: userID, BoardID: board.ID, Title: "To Do"}
+	if err := ts.uc.CreateColumn(ts.ctx, &todo); err != nil {
+		log.Fatalf("Failed to execute CreateColumn usecase: %v", err)
+	}
+
+	done := entity.Column{UserID: userID, BoardID: board.ID, Title: "Done", Done: true}
+	if err := ts.uc.CreateColumn(ts.ctx, &done); err != nil {
+		log.Fatalf("Failed to execute CreateColumn usecase: %v", err)
+	}
+
+	moved := entity.Card{UserID: userID, ColumnID: todo.ID, Title: "Moved", AssigneeID: assigneeID}
+	if err := ts.uc.CreateCard(ts.ctx, &moved); err != nil {
+		log.Fatalf("Failed to execute CreateCard usecase: %v", err)
+	}
+
+	waiting := entity.Card{UserID: userID, ColumnID: todo.ID, Title: "Waiting"}
+	if err := ts.uc.CreateCard(ts.ctx, &waiting); err != nil {
+		log.Fatalf("Failed to execute CreateCard usecase: %v", err)
+	}
+
+	moved.ColumnID = done.ID
+	if err := ts.uc.UpdateCard(ts.ctx, &moved); err != nil {
+		log.Fatalf("Failed to execute UpdateCard usecase: %v", err)
+	}
+
+	if _, err := db.Exec(`UPDATE cards SET updated_at = $1 WHERE id = $2`, time.Now().AddDate(0, 0, -30), waiting.ID); err != nil {
+		log.Fatalf("Failed to age card: %v", err)
+	}
+
+	from := time.Now().UTC().Truncate(24*time.Hour).AddDate(0, 0, -1)
+	to := from.AddDate(0, 0, 3)
+
+	stats, err := statsUC.GetBoardStats(ts.ctx, userID, board.ID, from, to, 14)
+	if err != nil {
+		log.Fatalf("Failed to execute GetBoardStats usecase: %v", err)
+	}
+
+	if assert.Len(t, stats.Columns, 2) {
+		assert.Equal(t, 1, stats.Columns[0].Cards)
+		assert.Equal(t, 1, stats.Columns[1].Cards)
+	}
+
+	if assert.Len(t, stats.Days, 3) {
+		assert.Equal(t, 2, stats.Days[1].Created)
+		assert.Equal(t, 1, stats.Days[1].Completed)
+	}
+
+	if assert.Len(t, stats.Stale, 1) {
+		assert.Equal(t, waiting.ID, stats.Stale[0].CardID)
+	}
+
+	if assert.Len(t, stats.Contributors, 2) {
+		assert.Equal(t, entity.Contributor{UserID: userID, Created: 2}, stats.Contributors[0])
+		assert.Equal(t, entity.Contributor{UserID: assigneeID, Completed: 1}, stats.Contributors[1])
+	}
+
+	_, err = statsUC.GetBoardStats(ts.ctx, uuid.New(), board.ID, from, to, 14)
+	assert.ErrorIs(t, err, repository.ErrBoardAccess)
+}
+
+func TestSprints(t *testing.T) {
+	ts := sqlxSetup()
+	resetDatabase()
+
+	sprintUC := v1.NewSprintUseCase(sqlxRepository.NewSQLXSprintRepository(db), ts.boardRepo, ts.columnRepo, ts.cardRepo,
+		ts.workspaceRepo, sqlxRepository.NewSQLXTxManager(db), logger.NewEmptyLogger())
+
+	userID := uuid.New()
+	ctx := usecase.WithCaller(ts.ctx, usecase.Caller{UserID: userID})
+
+	board := entity.Board{UserID: userID, Title: "Board Title"}
+	if err := ts.uc.CreateBoard(ts.ctx, &board); err != nil {
+		log.Fatalf("Failed to execute CreateBoard usecase: %v", err)
+	}
+
+	todo := entity.Column{UserID: userID, BoardID: board.ID, Title: "To Do"}
+	if err := ts.uc.CreateColumn(ts.ctx, &todo); err != nil {
+		log.Fatalf("Failed to execute CreateColumn usecase: %v", err)
+	}
+
+	done := entity.Column{UserID: userID, BoardID: board.ID, Title: "Done", Done: true}
+	if err := ts.uc.CreateColumn(ts.ctx, &done); err != nil {
+		log.Fatalf("Failed to execute CreateColumn usecase: %v", err)
+	}
+
+	today := time.Now()
+
+	first := entity.Sprint{BoardID: board.ID, Name: "Sprint 1", StartDate: today.AddDate(0, 0, -1), EndDate: today.AddDate(0, 0, 5)}
+	if err := sprintUC.CreateSprint(ctx, &first); err != nil {
+		log.Fatalf("Failed to execute CreateSprint usecase: %v", err)
+	}
+
+	second := entity.Sprint{BoardID: board.ID, Name: "Sprint 2", StartDate: today.AddDate(0, 0, 6), EndDate: today.AddDate(0, 0, 12)}
+	if err := sprintUC.CreateSprint(ctx, &second); err != nil {
+		log.Fatalf("Failed to execute CreateSprint usecase: %v", err)
+	}
+
+	finished := entity.Card{UserID: userID, ColumnID: todo.ID, Title: "Finished"}
+	if err := ts.uc.CreateCard(ts.ctx, &finished); err != nil {
+		log.Fatalf("Failed to execute CreateCard usecase: %v", err)
+	}
+
+	unfinished := entity.Card{UserID: userID, ColumnID: todo.ID, Title: "Unfinished"}
+	if err := ts.uc.CreateCard(ts.ctx, &unfinished); err != nil {
+		log.Fatalf("Failed to execute CreateCard usecase: %v", err)
+	}
+
+	for _, c := range []entity.SprintCard{{SprintID: first.ID, CardID: finished.ID, Points: 3}, {SprintID: first.ID, CardID: unfinished.ID, Points: 5}} {
+		if err := sprintUC.AddSprintCard(ctx, &c); err != nil {
+			log.Fatalf("Failed to execute AddSprintCard usecase: %v", err)
+		}
+	}
+
+	finished.ColumnID = done.ID
+	if err := ts.uc.UpdateCard(ts.ctx, &finished); err != nil {
+		log.Fatalf("Failed to execute UpdateCard usecase: %v", err)
+	}
+
+	burndown, err := sprintUC.GetSprintBurndown(ctx, first.ID)
+	if err != nil {
+		log.Fatalf("Failed to execute GetSprintBurndown usecase: %v", err)
+	}
+
+	if assert.Len(t, burndown, 2) {
+		assert.Equal(t, 0, burndown[0].Points)
+		assert.Equal(t, 5, burndown[1].Points)
+		assert.Equal(t, 1, burndown[1].Cards)
+	}
+
+	next, carried, err := sprintUC.CloseSprint(ctx, first.ID, nil)
+	if err != nil {
+		log.Fatalf("Failed to execute CloseSprint usecase: %v", err)
+	}
+
+	assert.Equal(t, second.ID, next.ID)
+	assert.Equal(t, 1, carried)
+
+	cards, err := sprintUC.GetSprintCards(ctx, second.ID)
+	if err != nil {
+		log.Fatalf("Failed to execute GetSprintCards usecase: %v", err)
+	}
+
+	if assert.Len(t, cards, 1) {
+		assert.Equal(t, unfinished.ID, cards[0].CardID)
+		assert.Equal(t, 5, cards[0].Points)
+	}
+
+	_, _, err = sprintUC.CloseSprint(ctx, first.ID, nil)
+	assert.ErrorIs(t, err, repository.ErrSprintClosed)
+
+	stranger := usecase.WithCaller(ts.ctx, usecase.Caller{UserID: uuid.New()})
+
+	_, err = sprintUC.GetSprintCards(stranger, second.ID)
+	assert.ErrorIs(t, err, repository.ErrBoardAccess)
+}
+
+func TestMoveColumnAndMergeBoards(t *testing.T) {
+	forEachBackend(t, testMoveColumnAndMergeBoards)
+}
+
+func testMoveColumnAndMergeBoards(t *testing.T, ts *testSetup) {
+	userID := uuid.New()
+
+	source := entity.Board{UserID: userID, Title: "Source"}
+	if err := ts.uc.CreateBoard(ts.ctx, &source); err != nil {
+		log.Fatalf("Failed to execute CreateBoard usecase: %v", err)
+	}
+
+	target := entity.Board{UserID: userID, Title: "Target"}
+	if err := ts.uc.CreateBoard(ts.ctx, &target); err != nil {
+		log.Fatalf("Failed to execute CreateBoard usecase: %v", err)
+	}
+
+	doing := entity.Column{UserID: userID, BoardID: source.ID, Title: "Doing"}
+	if err := ts.uc.CreateColumn(ts.ctx, &doing); err != nil {
+		log.Fatalf("Failed to execute CreateColumn usecase: %v", err)
+	}
+
+	done := entity.Column{UserID: userID, BoardID: source.ID, Title: "Done", Position: 1}
+	if err := ts.uc.CreateColumn(ts.ctx, &done); err != nil {
+		log.Fatalf("Failed to execute CreateColumn usecase: %v", err)
+	}
+
+	targetDone := entity.Column{UserID: userID, BoardID: target.ID, Title: "done"}
+	if err := ts.uc.CreateColumn(ts.ctx, &targetDone); err != nil {
+		log.Fatalf("Failed to execute CreateColumn usecase: %v", err)
+	}
+
+	card := entity.Card{UserID: userID, ColumnID: doing.ID, Title: "Card Title"}
+	if err := ts.uc.CreateCard(ts.ctx, &card); err != nil {
+		log.Fatalf("Failed to execute CreateCard usecase: %v", err)
+	}
+
+	finished := entity.Card{UserID: userID, ColumnID: done.ID, Title: "Finished"}
+	if err := ts.uc.CreateCard(ts.ctx, &finished); err != nil {
+		log.Fatalf("Failed to execute CreateCard usecase: %v", err)
+	}
+
+	targetLanes, _, err := ts.uc.GetSwimlanesByBoard(ts.ctx, target.ID, repository.Page{Limit: 10})
+	if err != nil {
+		log.Fatalf("Failed to execute GetSwimlanesByBoard usecase: %v", err)
+	}
+
+	moved, err := ts.uc.MoveColumn(ts.ctx, userID, doing.ID, target.ID, doing.Version)
+	if err != nil {
+		log.Fatalf("Failed to execute MoveColumn usecase: %v", err)
+	}
+
+	assert.Equal(t, target.ID, moved.BoardID)
+	assert.Equal(t, float64(1), moved.Position)
+
+	movedCard, err := ts.uc.GetCardByID(ts.ctx, card.ID)
+	if err != nil {
+		log.Fatalf("Failed to execute GetCardByID usecase: %v", err)
+	}
+
+	assert.Equal(t, doing.ID, movedCard.ColumnID)
+	assert.Equal(t, targetLanes[0].ID, movedCard.SwimlaneID)
+
+	_, err = ts.uc.MoveColumn(ts.ctx, userID, doing.ID, source.ID, doing.Version)
+	assert.ErrorIs(t, err, repository.ErrVersionMismatch)
+
+	_, err = ts.uc.MoveColumn(ts.ctx, uuid.New(), doing.ID, source.ID, moved.Version)
+	assert.ErrorIs(t, err, repository.ErrBoardAccess)
+
+	merge, err := ts.uc.MergeBoards(ts.ctx, userID, source.ID, target.ID, entity.MergeColumnsCombine)
+	if err != nil {
+		log.Fatalf("Failed to execute MergeBoards usecase: %v", err)
+	}
+
+	assert.Empty(t, merge.Moved)
+	assert.Len(t, merge.Combined, 1)
+
+	cards, _, err := ts.uc.GetCardsByColumn(ts.ctx, targetDone.ID, repository.Page{Limit: 10})
+	if err != nil {
+		log.Fatalf("Failed to execute GetCardsByColumn usecase: %v", err)
+	}
+
+	assert.Len(t, cards, 1)
+	assert.Equal(t, finished.ID, cards[0].ID)
+
+	columns, _, err := ts.uc.GetColumnsByBoard(ts.ctx, source.ID, repository.Page{Limit: 10})
+	if err != nil {
+		log.Fatalf("Failed to execute GetColumnsByBoard usecase: %v", err)
+	}
+
+	assert.Empty(t, columns)
+}
+
+func TestBoardMarks(t *testing.T) {
+	ts := sqlxSetup()
+	resetDatabase()
+
+	markUC := v1.NewBoardMarkUseCase(sqlxRepository.NewSQLXBoardMarkRepository(db), ts.boardRepo, ts.workspaceRepo, logger.NewEmptyLogger())
+
+	userID := uuid.New()
+
+	boards := make([]entity.Board, 4)
+	for i := range boards {
+		boards[i] = entity.Board{UserID: userID, Title: fmt.Sprintf("Board %d", i)}
+		if err := ts.uc.CreateBoard(ts.ctx, &boards[i]); err != nil {
+			log.Fatalf("Failed to execute CreateBoard usecase: %v", err)
+		}
+	}
+
+	if err := markUC.StarBoard(ts.ctx, userID, boards[3].ID); err != nil {
+		log.Fatalf("Failed to execute StarBoard usecase: %v", err)
+	}
+
+	for _, board := range []entity.Board{boards[1], boards[2], boards[1]} {
+		if err := markUC.RecordBoardView(ts.ctx, userID, board.ID); err != nil {
+			log.Fatalf("Failed to execute RecordBoardView usecase: %v", err)
+		}
+	}
+
+	err := markUC.StarBoard(ts.ctx, uuid.New(), boards[0].ID)
+	assert.ErrorIs(t, err, repository.ErrBoardAccess)
+
+	recent, err := markUC.GetRecentBoards(ts.ctx, userID)
+	if err != nil {
+		log.Fatalf("Failed to execute GetRecentBoards usecase: %v", err)
+	}
+
+	assert.Len(t, recent, 2)
+	assert.Equal(t, boards[1].ID, recent[0].ID)
+	assert.Equal(t, boards[2].ID, recent[1].ID)
+
+	var got []uuid.UUID
+	page := repository.Page{Limit: 1}
+	for {
+		marked, next, err := markUC.GetMarkedBoards(ts.ctx, userID, page)
+		if err != nil {
+			log.Fatalf("Failed to execute GetMarkedBoards usecase: %v", err)
+		}
+
+		for _, board := range marked {
+			got = append(got, board.ID)
+		}
+
+		if next == nil {
+			break
+		}
+		page.After = next
+	}
+
+	assert.Equal(t, []uuid.UUID{boards[3].ID, boards[1].ID, boards[2].ID, boards[0].ID}, got)
+
+	if err := markUC.UnstarBoard(ts.ctx, userID, boards[3].ID); err != nil {
+		log.Fatalf("Failed to execute UnstarBoard usecase: %v", err)
+	}
+
+	marked, _, err := markUC.GetMarkedBoards(ts.ctx, userID, repository.Page{Limit: 10})
+	if err != nil {
+		log.Fatalf("Failed to execute GetMarkedBoards usecase: %v", err)
+	}
+
+	assert.Equal(t, boards[1].ID, marked[0].ID)
+	assert.Nil(t, marked[3].StarredAt)
+}
+
+func TestWorkspaces(t *testing.T) {
+	ts := sqlxSetup()
+	resetDatabase()
+
+	workspaceUC := v1.NewWorkspaceUseCase(ts.workspaceRepo, sqlxRepository.NewSQLXTxManager(db), logger.NewEmptyLogger())
+	markUC := v1.NewBoardMarkUseCase(sqlxRepository.NewSQLXBoardMarkRepository(db), ts.boardRepo, ts.workspaceRepo, logger.NewEmptyLogger())
+
+	adminID, memberID, viewerID := uuid.New(), uuid.New(), uuid.New()
+
+	personal := entity.Board{UserID: adminID, Title: "Own"}
+	if err := ts.uc.CreateBoard(ts.ctx, &personal); err != nil {
+		log.Fatalf("Failed to execute CreateBoard usecase: %v", err)
+	}
+
+	workspace := entity.Workspace{Name: "Team", CreatedBy: adminID}
+	if err := workspaceUC.CreateWorkspace(ts.ctx, &workspace); err != nil {
+		log.Fatalf("Failed to execute CreateWorkspace usecase: %v", err)
+	}
+
+	for _, member := range []entity.WorkspaceMember{
+		{WorkspaceID: workspace.ID, UserID: memberID, Role: entity.RoleMember},
+		{WorkspaceID: workspace.ID, UserID: viewerID, Role: entity.RoleViewer},
+	} {
+		if err := workspaceUC.AddWorkspaceMember(ts.ctx, adminID, &member); err != nil {
+			log.Fatalf("Failed to execute AddWorkspaceMember usecase: %v", err)
+		}
+	}
+
+	err := workspaceUC.AddWorkspaceMember(ts.ctx, adminID, &entity.WorkspaceMember{WorkspaceID: workspace.ID, UserID: memberID, Role: entity.RoleViewer})
+	assert.ErrorIs(t, err, repository.ErrWorkspaceMemberExists)
+
+	err = workspaceUC.AddWorkspaceMember(ts.ctx, adminID, &entity.WorkspaceMember{WorkspaceID: personal.WorkspaceID, UserID: memberID, Role: entity.RoleViewer})
+	assert.ErrorIs(t, err, repository.ErrWorkspacePersonal)
+
+	shared := entity.Board{UserID: memberID, WorkspaceID: workspace.ID, Title: "Shared"}
+	if err := ts.uc.CreateBoard(ts.ctx, &shared); err != nil {
+		log.Fatalf("Failed to execute CreateBoard usecase: %v", err)
+	}
+
+	err = ts.uc.CreateBoard(ts.ctx, &entity.Board{UserID: viewerID, WorkspaceID: workspace.ID, Title: "Viewed"})
+	assert.ErrorIs(t, err, repository.ErrWorkspaceAccess)
+
+	other := entity.Board{UserID: adminID, WorkspaceID: workspace.ID, Title: "Other"}
+	if err := ts.uc.CreateBoard(ts.ctx, &other); err != nil {
+		log.Fatalf("Failed to execute CreateBoard usecase: %v", err)
+	}
+
+	_, err = ts.uc.MergeBoards(ts.ctx, adminID, shared.ID, other.ID, entity.MergeColumnsKeep)
+	assert.NoError(t, err)
+
+	_, err = ts.uc.MergeBoards(ts.ctx, viewerID, shared.ID, other.ID, entity.MergeColumnsKeep)
+	assert.ErrorIs(t, err, repository.ErrBoardAccess)
+
+	assert.NoError(t, markUC.StarBoard(ts.ctx, viewerID, shared.ID))
+	assert.ErrorIs(t, markUC.StarBoard(ts.ctx, viewerID, personal.ID), repository.ErrBoardAccess)
+	assert.NoError(t, markUC.RecordBoardView(ts.ctx, viewerID, shared.ID))
+
+	starred, _, err := markUC.GetMarkedBoards(ts.ctx, viewerID, repository.Page{Limit: 10})
+	if err != nil {
+		log.Fatalf("Failed to execute GetMarkedBoards usecase: %v", err)
+	}
+
+	if assert.NotEmpty(t, starred) {
+		assert.Equal(t, shared.ID, starred[0].ID)
+		assert.NotNil(t, starred[0].StarredAt)
+	}
+
+	boards, _, err := workspaceUC.GetWorkspaceBoards(ts.ctx, adminID, workspace.ID, repository.Page{Limit: 10})
+	if err != nil {
+		log.Fatalf("Failed to execute GetWorkspaceBoards usecase: %v", err)
+	}
+
+	assert.Len(t, boards, 2)
+	assert.Equal(t, shared.ID, boards[0].ID)
+
+	_, _, err = workspaceUC.GetWorkspaceBoards(ts.ctx, memberID, workspace.ID, repository.Page{Limit: 10})
+	assert.ErrorIs(t, err, repository.ErrWorkspaceAccess)
+
+	err = workspaceUC.RemoveWorkspaceMember(ts.ctx, adminID, workspace.ID, adminID)
+	assert.ErrorIs(t, err, repository.ErrWorkspaceLastAdmin)
+
+	if err := workspaceUC.RemoveWorkspaceMember(ts.ctx, viewerID, workspace.ID, viewerID); err != nil {
+		log.Fatalf("Failed to execute RemoveWorkspaceMember usecase: %v", err)
+	}
+
+	recent, err := markUC.GetRecentBoards(ts.ctx, viewerID)
+	if err != nil {
+		log.Fatalf("Failed to execute GetRecentBoards usecase: %v", err)
+	}
+
+	assert.Empty(t, recent)
+
+	starred, _, err = markUC.GetMarkedBoards(ts.ctx, viewerID, repository.Page{Limit: 10})
+	if err != nil {
+		log.Fatalf("Failed to execute GetMarkedBoards usecase: %v", err)
+	}
+
+	for _, board := range starred {
+		assert.NotEqual(t, shared.ID, board.ID)
+	}
+
+	workspaces, err := workspaceUC.GetWorkspacesByUser(ts.ctx, adminID)
+	if err != nil {
+		log.Fatalf("Failed to execute GetWorkspacesByUser usecase: %v", err)
+	}
+
+	assert.Len(t, workspaces, 2)
+	assert.True(t, workspaces[0].Personal)
+	assert.Equal(t, entity.RoleAdmin, workspaces[1].Role)
+
+	members, err := workspaceUC.GetWorkspaceMembers(ts.ctx, memberID, workspace.ID)
+	if err != nil {
+		log.Fatalf("Failed to execute GetWorkspaceMembers usecase: %v", err)
+	}
+
+	assert.Len(t, members, 2)
+}
+
+func TestCalendarCards(t *testing.T) {
+	ts := sqlxSetup()
+	resetDatabase()
+
+	workspaceUC := v1.NewWorkspaceUseCase(ts.workspaceRepo, sqlxRepository.NewSQLXTxManager(db), logger.NewEmptyLogger())
+	calendarRepo := sqlxRepository.NewSQLXCalendarRepository(db)
+
+	adminID, memberID := uuid.New(), uuid.New()
+
+	workspace := entity.Workspace{Name: "Team", CreatedBy: adminID}
+	if err := workspaceUC.CreateWorkspace(ts.ctx, &workspace); err != nil {
+		log.Fatalf("Failed to execute CreateWorkspace usecase: %v", err)
+	}
+
+	member := entity.WorkspaceMember{WorkspaceID: workspace.ID, UserID: memberID, Role: entity.RoleViewer}
+	if err := workspaceUC.AddWorkspaceMember(ts.ctx, adminID, &member); err != nil {
+		log.Fatalf("Failed to execute AddWorkspaceMember usecase: %v", err)
+	}
+
+	due := time.Now().Add(24 * time.Hour).Truncate(time.Second)
+
+	card := func(board entity.Board, title string, assigneeID uuid.UUID) entity.Card {
+		if err := ts.uc.CreateBoard(ts.ctx, &board); err != nil {
+			log.Fatalf("Failed to execute CreateBoard usecase: %v", err)
+		}
+
+		column := entity.Column{UserID: board.UserID, BoardID: board.ID, Title: "To Do"}
+		if err := ts.uc.CreateColumn(ts.ctx, &column); err != nil {
+			log.Fatalf("Failed to execute CreateColumn usecase: %v", err)
+		}
+
+		card := entity.Card{UserID: board.UserID, ColumnID: column.ID, Title: title, AssigneeID: assigneeID, DueDate: &due}
+		if err := ts.uc.CreateCard(ts.ctx, &card); err != nil {
+			log.Fatalf("Failed to execute CreateCard usecase: %v", err)
+		}
+
+		return card
+	}
+
+	shared := card(entity.Board{UserID: adminID, WorkspaceID: workspace.ID, Title: "Shared"}, "Shared", uuid.Nil)
+	card(entity.Board{UserID: adminID, Title: "Own"}, "Assigned", memberID)
+
+	cards, err := calendarRepo.GetCalendarCards(ts.ctx, memberID)
+	if err != nil {
+		log.Fatalf("Failed to get calendar cards: %v", err)
+	}
+
+	if assert.Len(t, cards, 1) {
+		assert.Equal(t, shared.ID, cards[0].CardID)
+	}
+
+	cards, err = calendarRepo.GetCalendarCards(ts.ctx, adminID)
+	if err != nil {
+		log.Fatalf("Failed to get calendar cards: %v", err)
+	}
+
+	assert.Len(t, cards, 2)
+
+	if err := workspaceUC.RemoveWorkspaceMember(ts.ctx, adminID, workspace.ID, memberID); err != nil {
+		log.Fatalf("Failed to execute RemoveWorkspaceMember usecase: %v", err)
+	}
+
+	cards, err = calendarRepo.GetCalendarCards(ts.ctx, memberID)
+	if err != nil {
+		log.Fatalf("Failed to get calendar cards: %v", err)
+	}
+
+	assert.Empty(t, cards)
+}
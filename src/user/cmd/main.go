@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"net/http"
@@ -8,15 +9,18 @@ import (
 	_ "time/tzdata"
 	"user/internal/adapter/database"
 	"user/internal/adapter/logger"
+	memoryRepo "user/internal/adapter/repository/memory"
 	mongoRepo "user/internal/adapter/repository/mongo"
 	sqlxRepo "user/internal/adapter/repository/sqlx"
 	api "user/internal/api/v1"
 	"user/internal/config"
+	"user/internal/entity"
 	handler "user/internal/handler/v1"
 	"user/internal/middleware"
 	"user/internal/repository"
 	usecase "user/internal/usecase/v1"
 
+	"github.com/google/uuid"
 	"github.com/gorilla/mux"
 	"github.com/jmoiron/sqlx"
 	"go.mongodb.org/mongo-driver/mongo"
@@ -49,13 +53,28 @@ func (p *postgres) Repo(db any) any {
 	return sqlxRepo.NewSQLXUserRepository(db.(*sqlx.DB))
 }
 
+// sqlite runs the SQLX repository of postgres on a database file.
+type sqlite struct {
+	postgres
+}
+
+// func (s *sqlite) DB(cfg config.SQLiteConfig) (*sqlx.DB, error) {
+func (s *sqlite) DB() (any, error) {
+	return database.NewSQLiteDB(s.cfg.User.SQLite)
+}
+
 type mongodb struct {
 	cfg *config.Config
 }
 
 // func (m *mongodb) DB(cfg config.MongoConfig) (*mongo.Database, error) {
 func (m *mongodb) DB() (any, error) {
-	return database.NewMongoDB(m.cfg.User.Mongo)
+	db, err := database.NewMongoDB(m.cfg.User.Mongo)
+	if err != nil {
+		return nil, err
+	}
+
+	return db, mongoRepo.CreateIndexes(context.TODO(), db)
 }
 
 // func (m *mongodb) Repo(db *mongo.Database) *mongoRepo.MongoUserRepository {
@@ -63,6 +82,40 @@ func (m *mongodb) Repo(db any) any {
 	return mongoRepo.NewMongoUserRepository(db.(*mongo.Database))
 }
 
+type memory struct{}
+
+// func (m *memory) DB() (struct{}, error) {
+func (m *memory) DB() (any, error) {
+	return struct{}{}, nil
+}
+
+// The in-memory repository starts with the accounts the SQL migrations add.
+// func (m *memory) Repo(struct{}) *memoryRepo.MemoryUserRepository {
+func (m *memory) Repo(any) any {
+	now := time.Now()
+
+	return memoryRepo.NewMemoryUserRepository(
+		entity.User{
+			ID:           uuid.MustParse("aaaaaaaa-dddd-0000-0000-000000000000"),
+			Username:     "admin",
+			Email:        "admin@gmail.com",
+			Role:         "admin",
+			PasswordHash: "$2a$10$tMXCVXRe/SHD0TzRkO107.ezmuNaDPrdLZpb4u6zOQbwbha2wRY3S", // Password: 'admin'
+			CreatedAt:    now,
+			UpdatedAt:    now,
+		},
+		entity.User{
+			ID:           uuid.MustParse("00000000-0000-eeee-0000-000000000000"),
+			Username:     "user",
+			Email:        "user@gmail.com",
+			Role:         "user",
+			PasswordHash: "$2a$10$Yis8vzqawFADIzXY1NLwMu24gh/VR6TsMFYrXEizAyNEENKWJdXb6", // Password: 'user'
+			CreatedAt:    now,
+			UpdatedAt:    now,
+		},
+	)
+}
+
 func main() {
 	config, err := config.LoadConfig("config.toml")
 	if err != nil {
@@ -71,14 +124,19 @@ func main() {
 
 	dbmap := make(map[string]dbrepo)
 	dbmap["postgres"] = &postgres{cfg: config}
+	dbmap["sqlite"] = &sqlite{postgres{cfg: config}}
 	dbmap["mongo"] = &mongodb{cfg: config}
+	dbmap["memory"] = &memory{}
 
-	dbRepo := dbmap[config.User.Database]
+	dbRepo, ok := dbmap[config.User.Database]
+	if !ok {
+		log.Fatalf("Unknown database %q, exiting", config.User.Database)
+	}
 
 	// db, err := database.NewPostgresDB(config.User.Postgres)
 	db, err := dbRepo.DB()
 	if err != nil {
-		log.Fatalf("Couldn't connect to database, exiting: %w", err)
+		log.Fatalf("Couldn't connect to database, exiting: %v", err)
 	}
 
 	logger := logger.NewZapLogger(config.User.Log)
@@ -0,0 +1,189 @@
+package repository
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+	"user/internal/entity"
+	"user/internal/repository"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrUserNotFound = errors.New("user not found")
+	ErrDuplicateKey = errors.New("duplicate key")
+)
+
+// MemoryUserRepository keeps users in memory, with the keys of the users
+// table: ids, usernames and emails are unique.
+type MemoryUserRepository struct {
+	mu    sync.RWMutex
+	users map[uuid.UUID]repository.User
+}
+
+// NewMemoryUserRepository starts with the given users, such as the ones the
+// SQL migrations add.
+func NewMemoryUserRepository(users ...entity.User) *MemoryUserRepository {
+	r := &MemoryUserRepository{users: make(map[uuid.UUID]repository.User)}
+
+	for _, u := range users {
+		r.users[u.ID] = repository.RepoUser(u)
+	}
+
+	return r
+}
+
+// CreateUser leaves the role to its default, as the SQLX repository does.
+func (r *MemoryUserRepository) CreateUser(ctx context.Context, user *entity.User) error {
+	repoUser := repository.RepoUser(*user)
+	repoUser.Role = "user"
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.users[repoUser.ID]; ok {
+		return fmt.Errorf("%w: id %s", ErrDuplicateKey, repoUser.ID)
+	}
+
+	if err := r.checkUnique(repoUser); err != nil {
+		return err
+	}
+
+	r.users[repoUser.ID] = repoUser
+
+	return nil
+}
+
+// checkUnique returns ErrDuplicateKey if another user has the username or
+// the email of u.
+func (r *MemoryUserRepository) checkUnique(u repository.User) error {
+	for _, other := range r.users {
+		if other.ID == u.ID {
+			continue
+		}
+		if other.Username == u.Username {
+			return fmt.Errorf("%w: username %s", ErrDuplicateKey, u.Username)
+		}
+		if other.Email == u.Email {
+			return fmt.Errorf("%w: email %s", ErrDuplicateKey, u.Email)
+		}
+	}
+
+	return nil
+}
+
+func (r *MemoryUserRepository) GetUserByID(ctx context.Context, id uuid.UUID) (*entity.User, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	repoUser, ok := r.users[id]
+	if !ok {
+		return nil, ErrUserNotFound
+	}
+
+	user := repository.UserToEntity(repoUser)
+
+	return &user, nil
+}
+
+func (r *MemoryUserRepository) GetUsers(ctx context.Context, filter repository.UserFilter) ([]entity.User, error) {
+	var id uuid.UUID
+	if filter.ID != nil {
+		var err error
+		if id, err = uuid.Parse(*filter.ID); err != nil {
+			return nil, err
+		}
+	}
+
+	return r.find(func(u repository.User) bool {
+		return (filter.ID == nil || u.ID == id) &&
+			(filter.Email == nil || u.Email == *filter.Email) &&
+			(filter.Username == nil || u.Username == *filter.Username)
+	}, -1), nil
+}
+
+func (r *MemoryUserRepository) GetUsersBatch(ctx context.Context, page repository.Page) ([]entity.User, error) {
+	after := page.After
+
+	return r.find(func(u repository.User) bool {
+		return after == nil || u.CreatedAt.After(after.Time) ||
+			(u.CreatedAt.Equal(after.Time) && bytes.Compare(u.ID[:], after.ID[:]) > 0)
+	}, page.Limit), nil
+}
+
+func (r *MemoryUserRepository) GetNewUsers(ctx context.Context, from time.Time, to time.Time) ([]entity.User, error) {
+	return r.find(func(u repository.User) bool {
+		return !u.CreatedAt.Before(from) && !u.CreatedAt.After(to)
+	}, -1), nil
+}
+
+// find lists up to limit of the users match selects, by creation time and
+// then id. A negative limit lists them all.
+func (r *MemoryUserRepository) find(match func(u repository.User) bool, limit int) []entity.User {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var repoUsers []repository.User
+	for _, u := range r.users {
+		if match(u) {
+			repoUsers = append(repoUsers, u)
+		}
+	}
+
+	sort.Slice(repoUsers, func(i, j int) bool {
+		a, b := repoUsers[i], repoUsers[j]
+		if !a.CreatedAt.Equal(b.CreatedAt) {
+			return a.CreatedAt.Before(b.CreatedAt)
+		}
+		return bytes.Compare(a.ID[:], b.ID[:]) < 0
+	})
+
+	if limit >= 0 && len(repoUsers) > limit {
+		repoUsers = repoUsers[:limit]
+	}
+
+	users := make([]entity.User, len(repoUsers))
+	for i, u := range repoUsers {
+		users[i] = repository.UserToEntity(u)
+	}
+
+	return users
+}
+
+// UpdateUser does nothing for a user that does not exist, as the SQLX
+// repository does.
+func (r *MemoryUserRepository) UpdateUser(ctx context.Context, user *entity.User) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	repoUser, ok := r.users[user.ID]
+	if !ok {
+		return nil
+	}
+
+	repoUser.Username = user.Username
+	repoUser.Email = user.Email
+	repoUser.UpdatedAt = user.UpdatedAt
+
+	if err := r.checkUnique(repoUser); err != nil {
+		return err
+	}
+
+	r.users[user.ID] = repoUser
+
+	return nil
+}
+
+func (r *MemoryUserRepository) DeleteUser(ctx context.Context, id uuid.UUID) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.users, id)
+
+	return nil
+}
@@ -0,0 +1,14 @@
+package repository_test
+
+import (
+	"testing"
+	repository "user/internal/adapter/repository/memory"
+	userRepository "user/internal/repository"
+	"user/internal/repository/repotest"
+)
+
+func TestUserRepository(t *testing.T) {
+	repotest.TestUserRepository(t, func(t *testing.T) userRepository.UserRepository {
+		return repository.NewMemoryUserRepository()
+	})
+}
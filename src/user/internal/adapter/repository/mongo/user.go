@@ -24,11 +24,10 @@ func NewMongoUserRepository(db *mongo.Database) *MongoUserRepository {
 	}
 }
 
+// CreateUser leaves the role to its default, as the SQLX repository does.
 func (r *MongoUserRepository) CreateUser(ctx context.Context, user *entity.User) error {
 	repoUser := repository.RepoUser(*user)
-
 	repoUser.Role = "user"
-	repoUser.CreatedAt = time.Now()
 
 	_, err := r.collection.InsertOne(ctx, repoUser)
 
@@ -52,7 +51,11 @@ func (r *MongoUserRepository) GetUsers(ctx context.Context, filter repository.Us
 	query := bson.M{}
 
 	if filter.ID != nil {
-		query["_id"] = *filter.ID
+		id, err := uuid.Parse(*filter.ID)
+		if err != nil {
+			return nil, err
+		}
+		query["_id"] = id
 	}
 	if filter.Email != nil {
 		query["email"] = *filter.Email
@@ -84,10 +87,18 @@ func (r *MongoUserRepository) GetUsers(ctx context.Context, filter repository.Us
 	return users, nil
 }
 
-func (r *MongoUserRepository) GetUsersBatch(ctx context.Context, limit, offset int) ([]entity.User, error) {
-	options := options.Find().SetLimit(int64(limit)).SetSkip(int64(offset)).SetSort(bson.M{"created_at": 1})
+func (r *MongoUserRepository) GetUsersBatch(ctx context.Context, page repository.Page) ([]entity.User, error) {
+	options := options.Find().SetLimit(int64(page.Limit)).SetSort(bson.D{{Key: "created_at", Value: 1}, {Key: "_id", Value: 1}})
+
+	filter := bson.M{}
+	if page.After != nil {
+		filter = bson.M{"$or": bson.A{
+			bson.M{"created_at": bson.M{"$gt": page.After.Time}},
+			bson.M{"created_at": page.After.Time, "_id": bson.M{"$gt": page.After.ID}},
+		}}
+	}
 
-	cursor, err := r.collection.Find(ctx, bson.M{}, options)
+	cursor, err := r.collection.Find(ctx, filter, options)
 	if err != nil {
 		return nil, err
 	}
@@ -143,7 +154,6 @@ func (r *MongoUserRepository) GetNewUsers(ctx context.Context, from time.Time, t
 
 func (r *MongoUserRepository) UpdateUser(ctx context.Context, user *entity.User) error {
 	repoUser := repository.RepoUser(*user)
-	repoUser.UpdatedAt = time.Now()
 
 	update := bson.M{
 		"$set": bson.M{
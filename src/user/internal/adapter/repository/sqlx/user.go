@@ -89,10 +89,23 @@ func (r *SQLXUserRepository) GetUsers(ctx context.Context, filter repository.Use
 	return users, nil
 }
 
-func (r *SQLXUserRepository) GetUsersBatch(ctx context.Context, limit, offset int) ([]entity.User, error) {
+func (r *SQLXUserRepository) GetUsersBatch(ctx context.Context, page repository.Page) ([]entity.User, error) {
 	var repoUsers []repository.User
 
-	err := r.db.SelectContext(ctx, &repoUsers, "SELECT * FROM users ORDER BY created_at ASC LIMIT $1 OFFSET $2", limit, offset)
+	query := "SELECT * FROM users ORDER BY created_at ASC, id ASC LIMIT $1"
+	args := []interface{}{page.Limit}
+
+	if page.After != nil {
+		query = `
+		SELECT * FROM users
+		WHERE created_at > $2 OR (created_at = $2 AND id > $3)
+		ORDER BY created_at ASC, id ASC
+		LIMIT $1
+		`
+		args = append(args, page.After.Time, page.After.ID)
+	}
+
+	err := r.db.SelectContext(ctx, &repoUsers, query, args...)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,29 @@
+package repository_test
+
+import (
+	"path/filepath"
+	"testing"
+	"user/internal/adapter/database"
+	repository "user/internal/adapter/repository/sqlx"
+	"user/internal/config"
+	userRepository "user/internal/repository"
+	"user/internal/repository/repotest"
+
+	"github.com/stretchr/testify/require"
+)
+
+// The contract runs here on SQLite, which needs no server; the integration
+// tests run it on Postgres.
+func TestUserRepository(t *testing.T) {
+	repotest.TestUserRepository(t, func(t *testing.T) userRepository.UserRepository {
+		db, err := database.NewSQLiteDB(config.SQLiteConfig{Path: filepath.Join(t.TempDir(), "user.db")})
+		require.NoError(t, err)
+		t.Cleanup(func() { db.Close() })
+
+		// The migrations add the first accounts.
+		_, err = db.Exec(`DELETE FROM users`)
+		require.NoError(t, err)
+
+		return repository.NewSQLXUserRepository(db)
+	})
+}
@@ -18,8 +18,7 @@ type LogConfig struct {
 }
 
 type PaginationConfig struct {
-	Limit  int `toml:"limit"`
-	Offset int `toml:"offset"`
+	Limit int `toml:"limit"`
 }
 
 type UserConfig struct {
@@ -32,6 +31,7 @@ type UserConfig struct {
 	Log           LogConfig      `toml:"log"`
 	Postgres      PostgresConfig `toml:"postgres"`
 	Mongo         MongoConfig    `toml:"mongo"`
+	SQLite        SQLiteConfig   `toml:"sqlite"`
 }
 
 type PostgresConfig struct {
@@ -51,6 +51,11 @@ type MongoConfig struct {
 	DBName   string `toml:"dbname"`
 }
 
+// SQLiteConfig names the database file, made on first start.
+type SQLiteConfig struct {
+	Path string `toml:"path"`
+}
+
 func LoadConfig(configPath string) (*Config, error) {
 	var config Config
 
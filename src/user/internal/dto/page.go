@@ -0,0 +1,17 @@
+package dto
+
+import "user/internal/repository"
+
+// Page is one page of a listing. NextCursor is empty on the last page.
+type Page[T any] struct {
+	Items      []T    `json:"items"`
+	NextCursor string `json:"next_cursor,omitempty"`
+}
+
+func NewPage[T any](items []T, next *repository.Cursor) Page[T] {
+	page := Page[T]{Items: items}
+	if next != nil {
+		page.NextCursor = next.Encode()
+	}
+	return page
+}
@@ -127,14 +127,17 @@ func (h *UserHandler) GetUsersBatch(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	offsetParam := r.URL.Query().Get("offset")
-	offset, err := strconv.Atoi(offsetParam)
-	if err != nil {
-		http.Error(w, "Invalid offset", http.StatusBadRequest)
-		return
+	page := repository.Page{Limit: limit}
+
+	if cursorParam := r.URL.Query().Get("cursor"); cursorParam != "" {
+		page.After, err = repository.DecodeCursor(cursorParam)
+		if err != nil {
+			http.Error(w, "Invalid cursor", http.StatusBadRequest)
+			return
+		}
 	}
 
-	users, err := h.userUseCase.GetUsersBatch(r.Context(), limit, offset)
+	users, next, err := h.userUseCase.GetUsersBatch(r.Context(), page)
 	if err != nil {
 		http.Error(w, "Users not found", http.StatusNotFound)
 		return
@@ -142,7 +145,7 @@ func (h *UserHandler) GetUsersBatch(w http.ResponseWriter, r *http.Request) {
 
 	userDTOs := dto.ToUserDTOs(users)
 
-	json.NewEncoder(w).Encode(userDTOs)
+	json.NewEncoder(w).Encode(dto.NewPage(userDTOs, next))
 }
 
 func (h *UserHandler) GetNewUsers(w http.ResponseWriter, r *http.Request) {
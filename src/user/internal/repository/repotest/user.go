@@ -0,0 +1,226 @@
+// Package repotest holds the contract the user repository keeps whatever it
+// stores users in. A backend runs the suite from its own tests, so that it
+// cannot drift from the others unnoticed.
+package repotest
+
+import (
+	"context"
+	"testing"
+	"time"
+	"user/internal/entity"
+	"user/internal/repository"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// NewUserRepository returns the repository of a backend over an empty
+// store. It is called once for every test of the suite.
+type NewUserRepository func(t *testing.T) repository.UserRepository
+
+// base is the time the users of the suite are made around. It is in whole
+// seconds and in UTC, so that every backend stores it as it is.
+var base = time.Date(2024, time.March, 1, 12, 0, 0, 0, time.UTC)
+
+func at(seconds int) time.Time {
+	return base.Add(time.Duration(seconds) * time.Second)
+}
+
+func newUser(name string, createdAt time.Time) entity.User {
+	return entity.User{
+		ID:           uuid.New(),
+		Username:     name,
+		Email:        name + "@example.com",
+		PasswordHash: "hash of " + name,
+		CreatedAt:    createdAt,
+		UpdatedAt:    createdAt,
+	}
+}
+
+// TestUserRepository runs the user repository contract against the backend
+// newRepo makes.
+func TestUserRepository(t *testing.T, newRepo NewUserRepository) {
+	ctx := context.Background()
+
+	create := func(t *testing.T, repo repository.UserRepository, users ...entity.User) {
+		t.Helper()
+		for i := range users {
+			require.NoError(t, repo.CreateUser(ctx, &users[i]))
+		}
+	}
+
+	t.Run("CreateAndGet", func(t *testing.T) {
+		repo := newRepo(t)
+		user := newUser("alice", at(0))
+		user.UpdatedAt = at(1)
+		user.Role = "admin"
+		create(t, repo, user)
+
+		got, err := repo.GetUserByID(ctx, user.ID)
+		require.NoError(t, err)
+
+		user.Role = "user"
+		assertUser(t, user, *got, "users are made with the user role, whatever they ask for")
+	})
+
+	t.Run("GetMissing", func(t *testing.T) {
+		repo := newRepo(t)
+
+		_, err := repo.GetUserByID(ctx, uuid.New())
+		assert.Error(t, err)
+	})
+
+	t.Run("CreateKeepsKeysUnique", func(t *testing.T) {
+		repo := newRepo(t)
+		user := newUser("alice", at(0))
+		create(t, repo, user)
+
+		sameID := newUser("bob", at(0))
+		sameID.ID = user.ID
+		assert.Error(t, repo.CreateUser(ctx, &sameID), "id")
+
+		sameName := newUser("alice", at(0))
+		sameName.Email = "other@example.com"
+		assert.Error(t, repo.CreateUser(ctx, &sameName), "username")
+
+		sameEmail := newUser("carol", at(0))
+		sameEmail.Email = user.Email
+		assert.Error(t, repo.CreateUser(ctx, &sameEmail), "email")
+	})
+
+	t.Run("GetUsersFilters", func(t *testing.T) {
+		repo := newRepo(t)
+		alice, bob := newUser("alice", at(0)), newUser("bob", at(1))
+		create(t, repo, alice, bob)
+
+		id := bob.ID.String()
+		got, err := repo.GetUsers(ctx, repository.UserFilter{ID: &id})
+		require.NoError(t, err)
+		assert.Equal(t, []uuid.UUID{bob.ID}, userIDs(got), "by id")
+
+		got, err = repo.GetUsers(ctx, repository.UserFilter{Email: &alice.Email})
+		require.NoError(t, err)
+		assert.Equal(t, []uuid.UUID{alice.ID}, userIDs(got), "by email")
+
+		got, err = repo.GetUsers(ctx, repository.UserFilter{Username: &bob.Username})
+		require.NoError(t, err)
+		assert.Equal(t, []uuid.UUID{bob.ID}, userIDs(got), "by username")
+
+		got, err = repo.GetUsers(ctx, repository.UserFilter{Username: &bob.Username, Email: &alice.Email})
+		require.NoError(t, err)
+		assert.Empty(t, got, "filters all have to match")
+
+		got, err = repo.GetUsers(ctx, repository.UserFilter{})
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []uuid.UUID{alice.ID, bob.ID}, userIDs(got), "no filter")
+	})
+
+	t.Run("GetUsersBatchPages", func(t *testing.T) {
+		repo := newRepo(t)
+		second, first, middle := newUser("second", at(2)), newUser("first", at(0)), newUser("middle", at(1))
+		create(t, repo, second, first, middle)
+
+		page, err := repo.GetUsersBatch(ctx, repository.Page{Limit: 2})
+		require.NoError(t, err)
+		assert.Equal(t, []uuid.UUID{first.ID, middle.ID}, userIDs(page), "users are listed in the order they were made")
+
+		last := page[len(page)-1]
+		page, err = repo.GetUsersBatch(ctx, repository.Page{
+			After: &repository.Cursor{Time: last.CreatedAt, ID: last.ID},
+			Limit: 2,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []uuid.UUID{second.ID}, userIDs(page))
+	})
+
+	t.Run("GetNewUsers", func(t *testing.T) {
+		repo := newRepo(t)
+		before, from, to, after := newUser("before", at(-1)), newUser("from", at(0)), newUser("to", at(10)), newUser("after", at(11))
+		create(t, repo, before, from, to, after)
+
+		got, err := repo.GetNewUsers(ctx, at(0), at(10))
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []uuid.UUID{from.ID, to.ID}, userIDs(got), "both ends of the range are in it")
+	})
+
+	t.Run("Update", func(t *testing.T) {
+		repo := newRepo(t)
+		user := newUser("alice", at(0))
+		create(t, repo, user)
+
+		changed := user
+		changed.Username = "alicia"
+		changed.Email = "alicia@example.com"
+		changed.Role = "admin"
+		changed.PasswordHash = "new hash"
+		changed.CreatedAt = at(5)
+		changed.UpdatedAt = at(10)
+		require.NoError(t, repo.UpdateUser(ctx, &changed))
+
+		got, err := repo.GetUserByID(ctx, user.ID)
+		require.NoError(t, err)
+
+		want := user
+		want.Role = "user"
+		want.Username = changed.Username
+		want.Email = changed.Email
+		want.UpdatedAt = changed.UpdatedAt
+		assertUser(t, want, *got, "only the username, the email and the update time change")
+	})
+
+	t.Run("UpdateKeepsKeysUnique", func(t *testing.T) {
+		repo := newRepo(t)
+		alice, bob := newUser("alice", at(0)), newUser("bob", at(0))
+		create(t, repo, alice, bob)
+
+		bob.Email = alice.Email
+		assert.Error(t, repo.UpdateUser(ctx, &bob))
+	})
+
+	t.Run("UpdateMissing", func(t *testing.T) {
+		repo := newRepo(t)
+		user := newUser("alice", at(0))
+
+		assert.NoError(t, repo.UpdateUser(ctx, &user))
+
+		_, err := repo.GetUserByID(ctx, user.ID)
+		assert.Error(t, err, "updating a user that does not exist does not make it")
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		repo := newRepo(t)
+		alice, bob := newUser("alice", at(0)), newUser("bob", at(0))
+		create(t, repo, alice, bob)
+
+		require.NoError(t, repo.DeleteUser(ctx, alice.ID))
+
+		_, err := repo.GetUserByID(ctx, alice.ID)
+		assert.Error(t, err)
+		_, err = repo.GetUserByID(ctx, bob.ID)
+		assert.NoError(t, err)
+
+		assert.NoError(t, repo.DeleteUser(ctx, alice.ID), "deleting a user twice is not an error")
+	})
+}
+
+func userIDs(users []entity.User) []uuid.UUID {
+	ids := make([]uuid.UUID, len(users))
+	for i, u := range users {
+		ids[i] = u.ID
+	}
+	return ids
+}
+
+// assertUser compares the instants of the times only: backends hand them
+// back in the location they keep them in.
+func assertUser(t *testing.T, want, got entity.User, msg string) {
+	t.Helper()
+	assert.Equal(t, want.ID, got.ID, msg)
+	assert.Equal(t, want.Username, got.Username, msg)
+	assert.Equal(t, want.Email, got.Email, msg)
+	assert.Equal(t, want.Role, got.Role, msg)
+	assert.Equal(t, want.PasswordHash, got.PasswordHash, msg)
+	assert.True(t, want.CreatedAt.Equal(got.CreatedAt), "%s: CreatedAt: want %v, got %v", msg, want.CreatedAt, got.CreatedAt)
+	assert.True(t, want.UpdatedAt.Equal(got.UpdatedAt), "%s: UpdatedAt: want %v, got %v", msg, want.UpdatedAt, got.UpdatedAt)
+}
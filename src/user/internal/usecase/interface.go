@@ -13,7 +13,7 @@ type UserUseCase interface {
 	CreateUser(ctx context.Context, user entity.User) error
 	GetUserByID(ctx context.Context, id uuid.UUID) (*entity.User, error)
 	GetUsers(ctx context.Context, filter repository.UserFilter) ([]entity.User, error)
-	GetUsersBatch(ctx context.Context, limit, offset int) ([]entity.User, error)
+	GetUsersBatch(ctx context.Context, page repository.Page) ([]entity.User, *repository.Cursor, error)
 	GetNewUsers(ctx context.Context, from time.Time, to time.Time) ([]entity.User, error)
 	UpdateUser(ctx context.Context, user *entity.User) error
 	DeleteUser(ctx context.Context, id uuid.UUID) error
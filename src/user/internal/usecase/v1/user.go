@@ -29,7 +29,7 @@ var (
 	ErrUserNotExist          = errors.New("user doesn't exist")
 	ErrUpdateUser            = errors.New("failed to update user")
 	ErrDeleteUser            = errors.New("failed to delete user")
-	ErrNegativeLimitOrOffset = errors.New("limit and offset can't be negative")
+	ErrNegativeLimit         = errors.New("limit can't be negative")
 	ErrLimitNotPositive      = errors.New("limit should be greater than zero")
 )
 
@@ -199,34 +199,40 @@ func (u *userUseCase) GetUsers(ctx context.Context, filter repository.UserFilter
 	return users, nil
 }
 
-func (u *userUseCase) GetUsersBatch(ctx context.Context, limit, offset int) ([]entity.User, error) {
+func (u *userUseCase) GetUsersBatch(ctx context.Context, page repository.Page) ([]entity.User, *repository.Cursor, error) {
 	header := "GetUsersBatch: "
 
-	u.log.Info(ctx, header+"Usecase called; Validating limit and offset", "limit", limit, "offset", offset)
+	u.log.Info(ctx, header+"Usecase called; Validating page", "page", page)
 
-	if limit < 0 || offset < 0 {
-		info := "Limit and offset can't be negative"
-		u.log.Info(ctx, header+info, "limit", limit, "offset", offset)
-		return nil, ErrNegativeLimitOrOffset
-	} else if limit == 0 {
+	if page.Limit < 0 {
+		info := "Limit can't be negative"
+		u.log.Info(ctx, header+info, "page", page)
+		return nil, nil, ErrNegativeLimit
+	} else if page.Limit == 0 {
 		info := "Limit should be greater than zero"
-		u.log.Info(ctx, header+info, "limit", limit, "offset", offset)
-		return nil, ErrLimitNotPositive
+		u.log.Info(ctx, header+info, "page", page)
+		return nil, nil, ErrLimitNotPositive
 	}
 
-	u.log.Info(ctx, header+"Successful validation; Making request to repo", "limit", limit, "offset", offset)
+	u.log.Info(ctx, header+"Successful validation; Making request to repo", "page", page)
 
-	users, err := u.repo.GetUsersBatch(ctx, limit, offset)
+	users, err := u.repo.GetUsersBatch(ctx, page)
 
 	if err != nil {
 		info := "Failed to get users batch"
 		u.log.Error(ctx, header+info, "err", err.Error())
-		return nil, fmt.Errorf(header+info+": %w", ErrGetUsersBatch)
+		return nil, nil, fmt.Errorf(header+info+": %w", ErrGetUsersBatch)
 	}
 
 	u.log.Info(ctx, header+"Got users", "users", users)
 
-	return users, nil
+	var next *repository.Cursor
+	if len(users) == page.Limit {
+		last := users[len(users)-1]
+		next = &repository.Cursor{Time: last.CreatedAt, ID: last.ID}
+	}
+
+	return users, next, nil
 }
 
 func (u *userUseCase) GetNewUsers(ctx context.Context, from time.Time, to time.Time) ([]entity.User, error) {
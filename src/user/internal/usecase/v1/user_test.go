@@ -284,17 +284,15 @@ func TestGetUsersBatch(t *testing.T) {
 	runner.Run(t, "Test GetUsersBatch", func(pt provider.T) {
 		tests := []struct {
 			name      string
-			limit     int
-			offset    int
-			mockSetup func(mockRepo *mocks.UserRepository, limit, offset int)
+			page      repository.Page
+			mockSetup func(mockRepo *mocks.UserRepository, page repository.Page)
 			wantErr   bool
 			err       error
 		}{
 			{
-				name:   "positive",
-				limit:  10,
-				offset: 0,
-				mockSetup: func(mockRepo *mocks.UserRepository, limit, offset int) {
+				name: "positive",
+				page: repository.Page{Limit: 10},
+				mockSetup: func(mockRepo *mocks.UserRepository, page repository.Page) {
 					user1 := testdata.NewUserBuilder().
 						WithUsername("User1").
 						Build()
@@ -305,16 +303,15 @@ func TestGetUsersBatch(t *testing.T) {
 
 					users := []entity.User{user1, user2}
 
-					mockRepo.On("GetUsersBatch", context.Background(), limit, offset).Return(users, nil)
+					mockRepo.On("GetUsersBatch", context.Background(), page).Return(users, nil)
 				},
 				wantErr: false,
 			},
 			{
-				name:   "negative",
-				limit:  10,
-				offset: 0,
-				mockSetup: func(mockRepo *mocks.UserRepository, limit, offset int) {
-					mockRepo.On("GetUsersBatch", context.Background(), limit, offset).Return(nil, errors.New(""))
+				name: "negative",
+				page: repository.Page{Limit: 10},
+				mockSetup: func(mockRepo *mocks.UserRepository, page repository.Page) {
+					mockRepo.On("GetUsersBatch", context.Background(), page).Return(nil, errors.New(""))
 				},
 				wantErr: true,
 				err:     v1.ErrGetUsersBatch,
@@ -330,10 +327,10 @@ func TestGetUsersBatch(t *testing.T) {
 					logger := log.NewEmptyLogger()
 					userUC := v1.NewUserUseCase(mockRepo, logger)
 
-					tt.mockSetup(mockRepo, tt.limit, tt.offset)
+					tt.mockSetup(mockRepo, tt.page)
 
 					pt.WithNewStep("Call GetUsersBatch", func(sCtx provider.StepCtx) {
-						_, err := userUC.GetUsersBatch(context.Background(), tt.limit, tt.offset)
+						_, _, err := userUC.GetUsersBatch(context.Background(), tt.page)
 
 						if tt.wantErr {
 							sCtx.Assert().Error(err, "Expected error")
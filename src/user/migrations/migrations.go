@@ -0,0 +1,9 @@
+package migrations
+
+import "embed"
+
+// SQLite is the schema a SQLite database is brought up to on start; the
+// Postgres one in sql is applied by the migrate container.
+//
+//go:embed sqlite/*.sql
+var SQLite embed.FS
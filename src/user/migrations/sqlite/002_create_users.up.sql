@@ -0,0 +1,8 @@
+INSERT INTO users
+(id, username, email, role, password_hash) -- Password: 'admin'
+VALUES
+('aaaaaaaa-dddd-0000-0000-000000000000', 'admin', 'admin@gmail.com', 'admin', '$2a$10$tMXCVXRe/SHD0TzRkO107.ezmuNaDPrdLZpb4u6zOQbwbha2wRY3S');
+INSERT INTO users
+(id, username, email, role, password_hash) -- Password: 'user'
+VALUES
+('00000000-0000-eeee-0000-000000000000', 'user', 'user@gmail.com', 'user', '$2a$10$Yis8vzqawFADIzXY1NLwMu24gh/VR6TsMFYrXEizAyNEENKWJdXb6');
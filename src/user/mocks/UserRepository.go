@@ -146,9 +146,9 @@ func (_m *UserRepository) GetUsers(ctx context.Context, filter repository.UserFi
 	return r0, r1
 }
 
-// GetUsersBatch provides a mock function with given fields: ctx, limit, offset
-func (_m *UserRepository) GetUsersBatch(ctx context.Context, limit int, offset int) ([]entity.User, error) {
-	ret := _m.Called(ctx, limit, offset)
+// GetUsersBatch provides a mock function with given fields: ctx, page
+func (_m *UserRepository) GetUsersBatch(ctx context.Context, page repository.Page) ([]entity.User, error) {
+	ret := _m.Called(ctx, page)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetUsersBatch")
@@ -156,19 +156,19 @@ func (_m *UserRepository) GetUsersBatch(ctx context.Context, limit int, offset i
 
 	var r0 []entity.User
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, int, int) ([]entity.User, error)); ok {
-		return rf(ctx, limit, offset)
+	if rf, ok := ret.Get(0).(func(context.Context, repository.Page) ([]entity.User, error)); ok {
+		return rf(ctx, page)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, int, int) []entity.User); ok {
-		r0 = rf(ctx, limit, offset)
+	if rf, ok := ret.Get(0).(func(context.Context, repository.Page) []entity.User); ok {
+		r0 = rf(ctx, page)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]entity.User)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, int, int) error); ok {
-		r1 = rf(ctx, limit, offset)
+	if rf, ok := ret.Get(1).(func(context.Context, repository.Page) error); ok {
+		r1 = rf(ctx, page)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -146,34 +146,43 @@ func (_m *UserUseCase) GetUsers(ctx context.Context, filter repository.UserFilte
 	return r0, r1
 }
 
-// GetUsersBatch provides a mock function with given fields: ctx, limit, offset
-func (_m *UserUseCase) GetUsersBatch(ctx context.Context, limit int, offset int) ([]entity.User, error) {
-	ret := _m.Called(ctx, limit, offset)
+// GetUsersBatch provides a mock function with given fields: ctx, page
+func (_m *UserUseCase) GetUsersBatch(ctx context.Context, page repository.Page) ([]entity.User, *repository.Cursor, error) {
+	ret := _m.Called(ctx, page)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetUsersBatch")
 	}
 
 	var r0 []entity.User
-	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, int, int) ([]entity.User, error)); ok {
-		return rf(ctx, limit, offset)
+	var r1 *repository.Cursor
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, repository.Page) ([]entity.User, *repository.Cursor, error)); ok {
+		return rf(ctx, page)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, int, int) []entity.User); ok {
-		r0 = rf(ctx, limit, offset)
+	if rf, ok := ret.Get(0).(func(context.Context, repository.Page) []entity.User); ok {
+		r0 = rf(ctx, page)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]entity.User)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, int, int) error); ok {
-		r1 = rf(ctx, limit, offset)
+	if rf, ok := ret.Get(1).(func(context.Context, repository.Page) *repository.Cursor); ok {
+		r1 = rf(ctx, page)
 	} else {
-		r1 = ret.Error(1)
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*repository.Cursor)
+		}
 	}
 
-	return r0, r1
+	if rf, ok := ret.Get(2).(func(context.Context, repository.Page) error); ok {
+		r2 = rf(ctx, page)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
 }
 
 // UpdateUser provides a mock function with given fields: ctx, user
@@ -0,0 +1,33 @@
+package integration_test
+
+import (
+	"testing"
+	mongoRepository "user/internal/adapter/repository/mongo"
+	sqlxRepository "user/internal/adapter/repository/sqlx"
+	"user/internal/repository"
+	"user/internal/repository/repotest"
+)
+
+// TestRepositoryContract runs the user repository contract against Postgres
+// and MongoDB, each test of it on an empty database.
+func TestRepositoryContract(t *testing.T) {
+	t.Run("sqlx", func(t *testing.T) {
+		repotest.TestUserRepository(t, func(t *testing.T) repository.UserRepository {
+			if err := resetDatabase(); err != nil {
+				t.Fatalf("Failed to reset database: %v", err)
+			}
+
+			return sqlxRepository.NewSQLXUserRepository(db)
+		})
+	})
+
+	t.Run("mongo", func(t *testing.T) {
+		repotest.TestUserRepository(t, func(t *testing.T) repository.UserRepository {
+			if err := resetMongoDatabase(); err != nil {
+				t.Fatalf("Failed to reset MongoDB database: %v", err)
+			}
+
+			return mongoRepository.NewMongoUserRepository(mongoDB)
+		})
+	})
+}
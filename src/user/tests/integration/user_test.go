@@ -6,6 +6,8 @@ import (
 	"log"
 	"os"
 	"testing"
+	logger "user/internal/adapter/logger"
+	mongoRepository "user/internal/adapter/repository/mongo"
 	sqlxRepository "user/internal/adapter/repository/sqlx"
 	"user/internal/entity"
 	"user/internal/repository"
@@ -20,12 +22,17 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/testcontainers/testcontainers-go"
 	"github.com/testcontainers/testcontainers-go/wait"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
 	"golang.org/x/crypto/bcrypt"
 
 	_ "github.com/lib/pq"
 )
 
-var db *sqlx.DB
+var (
+	db      *sqlx.DB
+	mongoDB *mongo.Database
+)
 
 type testSetup struct {
 	ctx  context.Context
@@ -36,7 +43,7 @@ type testSetup struct {
 func sqlxSetup() *testSetup {
 	ctx := context.TODO()
 	repo := sqlxRepository.NewSQLXUserRepository(db)
-	uc := v1.NewUserUseCase(repo)
+	uc := v1.NewUserUseCase(repo, logger.NewEmptyLogger())
 
 	return &testSetup{
 		ctx:  ctx,
@@ -52,7 +59,7 @@ func applyMigrations(dsn string) error {
 	}
 
 	m, err := migrate.NewWithDatabaseInstance(
-		"file:///home/rukost/University/software-design-s6-bmstu.git/lab4/src/user/migrations/sql",
+		"file://../../migrations/sql",
 		"nigger",
 		driver,
 	)
@@ -116,9 +123,46 @@ func TestMain(m *testing.M) {
 		log.Fatalf("Failed to apply migrations: %v", err)
 	}
 
+	mongoReq := testcontainers.ContainerRequest{
+		Image:        "mongo:6-jammy",
+		ExposedPorts: []string{"27017/tcp"},
+		Env: map[string]string{
+			"TZ": "Europe/Moscow",
+		},
+		WaitingFor: wait.ForListeningPort("27017/tcp"),
+	}
+
+	mongoContainer, err := testcontainers.GenericContainer(ctx, testcontainers.GenericContainerRequest{
+		ContainerRequest: mongoReq,
+		Started:          true,
+	})
+	if err != nil {
+		log.Fatalf("Failed to start MongoDB container: %v", err)
+	}
+	defer mongoContainer.Terminate(ctx)
+
+	mongoHost, err := mongoContainer.Host(ctx)
+	if err != nil {
+		log.Fatalf("Failed to get container host: %v", err)
+	}
+
+	mongoPort, err := mongoContainer.MappedPort(ctx, "27017")
+	if err != nil {
+		log.Fatalf("Failed to get container port: %v", err)
+	}
+
+	uri := fmt.Sprintf("mongodb://%s:%s", mongoHost, mongoPort.Port())
+	client, err := mongo.Connect(ctx, options.Client().ApplyURI(uri))
+	if err != nil {
+		log.Fatalf("Failed to connect to MongoDB: %v", err)
+	}
+
+	mongoDB = client.Database("testdb")
+
 	code := m.Run()
 
 	db.Close()
+	client.Disconnect(ctx)
 	os.Exit(code)
 }
 
@@ -129,6 +173,16 @@ func resetDatabase() error {
 	return err
 }
 
+func resetMongoDatabase() error {
+	ctx := context.TODO()
+
+	if err := mongoDB.Drop(ctx); err != nil {
+		return err
+	}
+
+	return mongoRepository.CreateIndexes(ctx, mongoDB)
+}
+
 func TestCreateUser(t *testing.T) {
 	ts := sqlxSetup()
 	resetDatabase()